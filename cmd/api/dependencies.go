@@ -3,7 +3,11 @@ package main
 import (
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
 	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/mailers/inbox"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/mailers/smtp"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/payments/fake"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/postgres"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/storage/local"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/handlers"
 )
 
@@ -14,24 +18,99 @@ func initializeDependencies(config Config) (ports.Database, error) {
 		return nil, err
 	}
 
+	// Initialize object storage
+	storage, err := local.NewStorage(config.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize mailer
+	mailer, err := newMailer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize payment gateway
+	paymentGateway := fake.NewGateway(config.PaymentWebhookSecret)
+
 	// Initialize repos
 	citiesRepository := postgres.NewCitiesRepository(carsRentDB)
+	branchesRepository := postgres.NewBranchesRepository(carsRentDB)
+	oneWayFeesRepository := postgres.NewOneWayFeesRepository(carsRentDB)
+	addOnsRepository := postgres.NewAddOnsRepository(carsRentDB)
+	protectionPlansRepository := postgres.NewProtectionPlansRepository(carsRentDB)
+	taxRulesRepository := postgres.NewTaxRulesRepository(carsRentDB)
+	exchangeRatesRepository := postgres.NewExchangeRatesRepository(carsRentDB)
 	carsRepository := postgres.NewCarsRepository(carsRentDB, citiesRepository)
 	usersRepository := postgres.NewUsersRepository(carsRentDB)
+	userTokensRepository := postgres.NewUserTokensRepository(carsRentDB)
 	reservationsRepository := postgres.NewReservationsRepository(carsRentDB)
+	maintenancesRepository := postgres.NewMaintenancesRepository(carsRentDB, citiesRepository)
+	inspectionsRepository := postgres.NewInspectionsRepository(carsRentDB)
+	handoversRepository := postgres.NewHandoversRepository(carsRentDB)
+	carMileagesRepository := postgres.NewCarMileagesRepository(carsRentDB)
+	lateReturnsRepository := postgres.NewLateReturnsRepository(carsRentDB)
+	damageReportsRepository := postgres.NewDamageReportsRepository(carsRentDB)
+	transfersRepository := postgres.NewTransfersRepository(carsRentDB)
+	paymentsRepository := postgres.NewPaymentsRepository(carsRentDB)
+	depositsRepository := postgres.NewDepositsRepository(carsRentDB)
+	ledgerRepository := postgres.NewLedgerRepository(carsRentDB)
+	invoicesRepository := postgres.NewInvoicesRepository(carsRentDB)
+	billingProfilesRepository := postgres.NewBillingProfilesRepository(carsRentDB)
 
 	// Initialize services
-	carsService := services.NewCars(carsRepository)
-	usersService := services.NewUsers(usersRepository)
+	carsService := services.NewCars(carsRepository, reservationsRepository)
+	usersService := services.NewUsers(usersRepository, reservationsRepository, userTokensRepository, mailer, config.TokensSecret)
 	citiesService := services.NewCities(citiesRepository)
-	reservationsService := services.NewReservations(reservationsRepository)
+	branchesService := services.NewBranches(branchesRepository)
+	oneWayFeesService := services.NewOneWayFees(oneWayFeesRepository)
+	addOnsService := services.NewAddOns(addOnsRepository)
+	protectionPlansService := services.NewProtectionPlans(protectionPlansRepository)
+	taxRulesService := services.NewTaxRules(taxRulesRepository, branchesRepository)
+	exchangeRatesService := services.NewExchangeRates(exchangeRatesRepository)
+	reservationsService := services.NewReservations(reservationsRepository, maintenancesRepository, citiesRepository, branchesRepository, oneWayFeesRepository, transfersRepository, usersRepository, carsRepository, addOnsRepository, protectionPlansRepository, taxRulesRepository, exchangeRatesRepository)
+	maintenancesService := services.NewMaintenances(maintenancesRepository, reservationsRepository)
+	invoicesService := services.NewInvoices(invoicesRepository, billingProfilesRepository, reservationsRepository, lateReturnsRepository, usersRepository, storage)
+	handoversService := services.NewHandovers(inspectionsRepository, handoversRepository, carMileagesRepository, lateReturnsRepository, reservationsRepository, carsRepository, depositsRepository, exchangeRatesRepository, paymentGateway, invoicesService)
+	lateReturnsService := services.NewLateReturns(lateReturnsRepository, reservationsRepository, carsRepository, citiesRepository, usersRepository, exchangeRatesRepository, mailer)
+	damageReportsService := services.NewDamageReports(damageReportsRepository, reservationsRepository, storage)
+	transfersService := services.NewTransfers(transfersRepository, reservationsRepository, citiesRepository, branchesRepository)
+	paymentsService := services.NewPayments(paymentsRepository, reservationsRepository, paymentGateway)
+	depositsService := services.NewDeposits(depositsRepository, paymentGateway)
+	ledgerService := services.NewLedger(ledgerRepository, reservationsRepository)
 
 	//Initialize handlers
 	healthHandler = handlers.NewHealth()
 	carsHandler = handlers.NewCars(carsService)
 	usersHandler = handlers.NewUsers(usersService)
 	citiesHandler = handlers.NewCities(citiesService)
+	branchesHandler = handlers.NewBranches(branchesService)
+	oneWayFeesHandler = handlers.NewOneWayFees(oneWayFeesService)
+	addOnsHandler = handlers.NewAddOns(addOnsService)
+	protectionPlansHandler = handlers.NewProtectionPlans(protectionPlansService)
+	taxRulesHandler = handlers.NewTaxRules(taxRulesService)
+	exchangeRatesHandler = handlers.NewExchangeRates(exchangeRatesService)
 	reservationsHandler = handlers.NewReservations(reservationsService)
+	maintenancesHandler = handlers.NewMaintenances(maintenancesService)
+	handoversHandler = handlers.NewHandovers(handoversService)
+	lateReturnsHandler = handlers.NewLateReturns(lateReturnsService)
+	damageReportsHandler = handlers.NewDamageReports(damageReportsService)
+	transfersHandler = handlers.NewTransfers(transfersService)
+	paymentsHandler = handlers.NewPayments(paymentsService)
+	depositsHandler = handlers.NewDeposits(depositsService)
+	ledgerHandler = handlers.NewLedger(ledgerService)
+	invoicesHandler = handlers.NewInvoices(invoicesService)
+	constantsHandler = handlers.NewConstants()
 
 	return carsRentDB, nil
 }
+
+// Sends emails through SMTP when a server is configured, otherwise they are
+// written to files to be read during development.
+func newMailer(config Config) (ports.Mailer, error) {
+	if config.SMTPAddress != "" {
+		return smtp.NewMailer(config.SMTPAddress, config.SMTPUsername, config.SMTPPassword, config.MailFrom)
+	}
+
+	return inbox.NewFile(config.MailPath)
+}
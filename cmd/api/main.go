@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	_ "time/tzdata"
 
 	_ "github.com/Edigiraldo/car-rent/doc/swagger"
 	"github.com/joho/godotenv"
@@ -34,11 +35,47 @@ func main() {
 		log.Fatal("DATABASE_URL environment variable was not found")
 	}
 
+	// Photos and other uploaded objects are kept in the local file system
+	STORAGE_PATH := os.Getenv("STORAGE_PATH")
+	if STORAGE_PATH == "" {
+		STORAGE_PATH = defaultStoragePath
+	}
+
+	TOKENS_SECRET := os.Getenv("TOKENS_SECRET")
+	if TOKENS_SECRET == "" {
+		log.Fatal("TOKENS_SECRET environment variable was not found")
+	}
+
+	PAYMENT_WEBHOOK_SECRET := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if PAYMENT_WEBHOOK_SECRET == "" {
+		log.Fatal("PAYMENT_WEBHOOK_SECRET environment variable was not found")
+	}
+
+	// Emails are written to MAIL_PATH unless an SMTP server is configured
+	MAIL_PATH := os.Getenv("MAIL_PATH")
+	if MAIL_PATH == "" {
+		MAIL_PATH = defaultMailPath
+	}
+
+	SMTP_ADDRESS := os.Getenv("SMTP_ADDRESS")
+	MAIL_FROM := os.Getenv("MAIL_FROM")
+	if SMTP_ADDRESS != "" && MAIL_FROM == "" {
+		log.Fatal("MAIL_FROM environment variable is required to send emails through SMTP")
+	}
+
 	swag.SetCodeExampleFilesDirectory("../../doc")
 
 	config := Config{
-		Port:        PORT,
-		DatabaseURL: DATABASE_URL,
+		Port:                 PORT,
+		DatabaseURL:          DATABASE_URL,
+		StoragePath:          STORAGE_PATH,
+		TokensSecret:         TOKENS_SECRET,
+		PaymentWebhookSecret: PAYMENT_WEBHOOK_SECRET,
+		SMTPAddress:          SMTP_ADDRESS,
+		SMTPUsername:         os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:         os.Getenv("SMTP_PASSWORD"),
+		MailFrom:             MAIL_FROM,
+		MailPath:             MAIL_PATH,
 	}
 
 	fmt.Println("Config:", config)
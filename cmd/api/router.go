@@ -12,11 +12,27 @@ import (
 )
 
 var (
-	healthHandler       ports.HeathController
-	carsHandler         ports.CarsController
-	usersHandler        ports.UsersController
-	citiesHandler       ports.CitiesController
-	reservationsHandler ports.ReservationsController
+	healthHandler          ports.HeathController
+	carsHandler            ports.CarsController
+	usersHandler           ports.UsersController
+	citiesHandler          ports.CitiesController
+	branchesHandler        ports.BranchesController
+	oneWayFeesHandler      ports.OneWayFeesController
+	addOnsHandler          ports.AddOnsController
+	protectionPlansHandler ports.ProtectionPlansController
+	taxRulesHandler        ports.TaxRulesController
+	exchangeRatesHandler   ports.ExchangeRatesController
+	reservationsHandler    ports.ReservationsController
+	maintenancesHandler    ports.MaintenancesController
+	handoversHandler       ports.HandoversController
+	lateReturnsHandler     ports.LateReturnsController
+	damageReportsHandler   ports.DamageReportsController
+	transfersHandler       ports.TransfersController
+	paymentsHandler        ports.PaymentsController
+	depositsHandler        ports.DepositsController
+	ledgerHandler          ports.LedgerController
+	invoicesHandler        ports.InvoicesController
+	constantsHandler       ports.ConstantsController
 )
 
 func BindRoutes(b *Server) {
@@ -36,6 +52,7 @@ func BindRoutes(b *Server) {
 
 	// Cars routes
 	rv1.HandleFunc("/cars", carsHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/cars/nearby", carsHandler.SearchNearby).Methods(http.MethodGet)
 	rv1.HandleFunc("/cars/{id}", carsHandler.Get).Methods(http.MethodGet)
 	rv1.HandleFunc("/cars/{id}", carsHandler.FullUpdate).Methods(http.MethodPut)
 	rv1.HandleFunc("/cars/{id}", carsHandler.Delete).Methods(http.MethodDelete)
@@ -43,22 +60,126 @@ func BindRoutes(b *Server) {
 
 	// Users routes
 	rv1.HandleFunc("/users", usersHandler.SignUp).Methods(http.MethodPost)
+	rv1.HandleFunc("/users", usersHandler.List).Methods(http.MethodGet)
+	rv1.HandleFunc("/users/email-verification", usersHandler.VerifyEmail).Methods(http.MethodPost)
+	rv1.HandleFunc("/users/password-reset", usersHandler.RequestPasswordReset).Methods(http.MethodPost)
+	rv1.HandleFunc("/users/password-reset/confirm", usersHandler.ResetPassword).Methods(http.MethodPost)
 	rv1.HandleFunc("/users/{id}", usersHandler.Get).Methods(http.MethodGet)
 	rv1.HandleFunc("/users/{id}", usersHandler.FullUpdate).Methods(http.MethodPut)
 	rv1.HandleFunc("/users/{id}", usersHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/users/{id}/email-verification", usersHandler.SendEmailVerification).Methods(http.MethodPost)
 
 	// Cities routes
-	rv1.HandleFunc("/cities/names", citiesHandler.ListNames).Methods(http.MethodGet)
+	rv1.HandleFunc("/cities", citiesHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/cities", citiesHandler.List).Methods(http.MethodGet)
+	rv1.HandleFunc("/cities/{id}", citiesHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/cities/{id}", citiesHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/cities/{id}", citiesHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/cities/{id}/branches", branchesHandler.ListByCityID).Methods(http.MethodGet)
+	rv1.HandleFunc("/cities/{id}/one-way-fees", oneWayFeesHandler.ListByCityID).Methods(http.MethodGet)
+	rv1.HandleFunc("/cities/{id}/one-way-fees/{to_city_id}", oneWayFeesHandler.Set).Methods(http.MethodPut)
+	rv1.HandleFunc("/cities/{id}/one-way-fees/{to_city_id}", oneWayFeesHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/cities/{id}/tax-rules", taxRulesHandler.ListByCityID).Methods(http.MethodGet)
+
+	// Branches routes
+	rv1.HandleFunc("/branches", branchesHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/branches/{id}", branchesHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/branches/{id}", branchesHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/branches/{id}", branchesHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/branches/{id}/add-ons", addOnsHandler.ListByBranchID).Methods(http.MethodGet)
+
+	// Add-ons routes
+	rv1.HandleFunc("/add-ons", addOnsHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/add-ons/{id}", addOnsHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/add-ons/{id}", addOnsHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/add-ons/{id}", addOnsHandler.Delete).Methods(http.MethodDelete)
+
+	// Protection plans routes
+	rv1.HandleFunc("/protection-plans", protectionPlansHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/protection-plans", protectionPlansHandler.List).Methods(http.MethodGet)
+	rv1.HandleFunc("/protection-plans/{id}", protectionPlansHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/protection-plans/{id}", protectionPlansHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/protection-plans/{id}", protectionPlansHandler.Delete).Methods(http.MethodDelete)
+
+	// Tax rules routes
+	rv1.HandleFunc("/tax-rules", taxRulesHandler.Register).Methods(http.MethodPost)
+	rv1.HandleFunc("/tax-rules/{id}", taxRulesHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/tax-rules/{id}", taxRulesHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/tax-rules/{id}", taxRulesHandler.Delete).Methods(http.MethodDelete)
 
 	// Reservations routes
 	rv1.HandleFunc("/reservations", reservationsHandler.Book).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/quote", reservationsHandler.Quote).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/overdue", lateReturnsHandler.ListOverdue).Methods(http.MethodGet)
+	rv1.HandleFunc("/reservations/overdue/notify", lateReturnsHandler.NotifyOverdue).Methods(http.MethodPost)
 	rv1.HandleFunc("/reservations/{id}", reservationsHandler.Get).Methods(http.MethodGet)
 	rv1.HandleFunc("/reservations/{id}", reservationsHandler.FullUpdate).Methods(http.MethodPut)
 	rv1.HandleFunc("/reservations/{id}", reservationsHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/reservations/{id}/extend", reservationsHandler.Extend).Methods(http.MethodPost)
 	rv1.HandleFunc("/reservations", reservationsHandler.List).Methods(http.MethodGet)
 	rv1.HandleFunc("/cars/{id}/reservations", reservationsHandler.GetByCarID).Methods(http.MethodGet)
 	rv1.HandleFunc("/users/{id}/reservations", reservationsHandler.GetByUserID).Methods(http.MethodGet)
 
+	// Maintenances routes
+	rv1.HandleFunc("/cars/{id}/maintenances", maintenancesHandler.Schedule).Methods(http.MethodPost)
+	rv1.HandleFunc("/cars/{id}/maintenances", maintenancesHandler.GetByCarID).Methods(http.MethodGet)
+	rv1.HandleFunc("/maintenances/{id}", maintenancesHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/maintenances/{id}", maintenancesHandler.FullUpdate).Methods(http.MethodPut)
+	rv1.HandleFunc("/maintenances/{id}", maintenancesHandler.Delete).Methods(http.MethodDelete)
+	rv1.HandleFunc("/maintenances/", maintenancesHandler.List).Methods(http.MethodGet)
+
+	// Handovers routes
+	rv1.HandleFunc("/reservations/{id}/inspections", handoversHandler.RecordInspection).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/handover", handoversHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/cars/{id}/mileage", handoversHandler.GetCarMileage).Methods(http.MethodGet)
+	rv1.HandleFunc("/cars/{id}/mileage/service", handoversHandler.RegisterCarService).Methods(http.MethodPost)
+
+	// Damage reports routes
+	rv1.HandleFunc("/damage-reports", damageReportsHandler.File).Methods(http.MethodPost)
+	rv1.HandleFunc("/damage-reports/{id}", damageReportsHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/damage-reports/{id}/status", damageReportsHandler.UpdateStatus).Methods(http.MethodPut)
+	rv1.HandleFunc("/damage-reports/{id}/photos", damageReportsHandler.AddPhotos).Methods(http.MethodPost)
+	rv1.HandleFunc("/damage-reports/{id}/photos/{photo_id}", damageReportsHandler.GetPhoto).Methods(http.MethodGet)
+	rv1.HandleFunc("/damage-reports/{id}/photos/{photo_id}/thumbnail", damageReportsHandler.GetThumbnail).Methods(http.MethodGet)
+	rv1.HandleFunc("/cars/{id}/damage-reports", damageReportsHandler.ListByCarID).Methods(http.MethodGet)
+
+	// Transfers routes
+	rv1.HandleFunc("/cars/{id}/transfers", transfersHandler.Schedule).Methods(http.MethodPost)
+	rv1.HandleFunc("/cars/{id}/transfers", transfersHandler.GetByCarID).Methods(http.MethodGet)
+	rv1.HandleFunc("/transfers/{id}", transfersHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/transfers/{id}", transfersHandler.Cancel).Methods(http.MethodDelete)
+	rv1.HandleFunc("/transfers/{id}/complete", transfersHandler.Complete).Methods(http.MethodPost)
+
+	// Payments routes
+	rv1.HandleFunc("/reservations/{id}/payment", paymentsHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/reservations/{id}/payment/authorize", paymentsHandler.Authorize).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/payment/capture", paymentsHandler.Capture).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/payment/void", paymentsHandler.Void).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/payment/refund", paymentsHandler.Refund).Methods(http.MethodPost)
+	rv1.HandleFunc("/payments/webhook", paymentsHandler.Webhook).Methods(http.MethodPost)
+
+	// Deposits routes
+	rv1.HandleFunc("/reservations/{id}/deposit", depositsHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/reservations/{id}/deposit/capture", depositsHandler.Capture).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/deposit/release", depositsHandler.Release).Methods(http.MethodPost)
+
+	// Ledger routes
+	rv1.HandleFunc("/reservations/{id}/balance", ledgerHandler.GetBalance).Methods(http.MethodGet)
+
+	// Invoices routes
+	rv1.HandleFunc("/reservations/{id}/invoice", invoicesHandler.Issue).Methods(http.MethodPost)
+	rv1.HandleFunc("/reservations/{id}/invoice", invoicesHandler.GetByReservationID).Methods(http.MethodGet)
+	rv1.HandleFunc("/invoices/{id}", invoicesHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/invoices/{id}/download", invoicesHandler.Download).Methods(http.MethodGet)
+	rv1.HandleFunc("/users/{id}/billing-profile", invoicesHandler.GetBillingProfile).Methods(http.MethodGet)
+	rv1.HandleFunc("/users/{id}/billing-profile", invoicesHandler.SaveBillingProfile).Methods(http.MethodPut)
+
+	// Admin routes
+	rv1.HandleFunc("/admin/constants", constantsHandler.Get).Methods(http.MethodGet)
+	rv1.HandleFunc("/admin/exchange-rates", exchangeRatesHandler.List).Methods(http.MethodGet)
+	rv1.HandleFunc("/admin/exchange-rates/{from}/{to}", exchangeRatesHandler.Set).Methods(http.MethodPut)
+	rv1.HandleFunc("/admin/exchange-rates/{from}/{to}", exchangeRatesHandler.Delete).Methods(http.MethodDelete)
+
 }
 
 func recovery(next http.Handler) http.Handler {
@@ -11,13 +11,51 @@ import (
 )
 
 var (
-	ErrEmptyDabaseURL = "database url must be specified in server configuration"
-	ErrEmptyPort      = "port must be specified in server configuration"
+	ErrEmptyDabaseURL            = "database url must be specified in server configuration"
+	ErrEmptyPort                 = "port must be specified in server configuration"
+	ErrEmptyTokensSecret         = "tokens secret must be specified in server configuration"
+	ErrEmptyPaymentWebhookSecret = "payment webhook secret must be specified in server configuration"
+)
+
+const (
+	// Directory used for uploaded objects when STORAGE_PATH is not set
+	defaultStoragePath = "storage"
+	// Directory where emails are written when SMTP_ADDRESS is not set
+	defaultMailPath = "mails"
 )
 
 type Config struct {
 	Port        string
 	DatabaseURL string
+	StoragePath string
+	// Key used to sign the tokens sent by email
+	TokensSecret string
+	// Key used to check the signature of the payment provider events
+	PaymentWebhookSecret string
+	// Emails are sent through SMTP when an address is given, otherwise they
+	// are written to MailPath
+	SMTPAddress  string
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	MailPath     string
+}
+
+// Formats the configuration hiding its secrets
+func (c Config) String() string {
+	redacted := c
+	if redacted.TokensSecret != "" {
+		redacted.TokensSecret = "[redacted]"
+	}
+	if redacted.PaymentWebhookSecret != "" {
+		redacted.PaymentWebhookSecret = "[redacted]"
+	}
+	if redacted.SMTPPassword != "" {
+		redacted.SMTPPassword = "[redacted]"
+	}
+
+	type config Config
+	return fmt.Sprintf("%+v", config(redacted))
 }
 
 type Server struct {
@@ -34,6 +72,14 @@ func NewServer(config Config) (*Server, error) {
 		return nil, errors.New(ErrEmptyDabaseURL)
 	}
 
+	if config.TokensSecret == "" {
+		return nil, errors.New(ErrEmptyTokensSecret)
+	}
+
+	if config.PaymentWebhookSecret == "" {
+		return nil, errors.New(ErrEmptyPaymentWebhookSecret)
+	}
+
 	server := &Server{
 		config: &config,
 		router: mux.NewRouter(),
@@ -53,6 +99,12 @@ func (b *Server) Start() {
 		log.Fatal("error while loading constants file: ", err)
 	}
 
+	stopWatchingConstants, err := constants.Watch()
+	if err != nil {
+		log.Fatal("error while watching constants file: ", err)
+	}
+	defer stopWatchingConstants()
+
 	BindRoutes(b)
 	log.Printf("starting server on port %s\n", b.config.Port)
 
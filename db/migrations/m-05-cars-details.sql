@@ -0,0 +1,15 @@
+CREATE TYPE TRANSMISSIONS AS ENUM('Manual', 'Automatic');
+CREATE TYPE FUEL_TYPES AS ENUM('Gasoline', 'Diesel', 'Hybrid', 'Electric');
+ALTER TABLE cars
+    ADD COLUMN make VARCHAR(50) NOT NULL,
+    ADD COLUMN model VARCHAR(50) NOT NULL,
+    ADD COLUMN year SMALLINT NOT NULL,
+    ADD COLUMN license_plate VARCHAR(15) NOT NULL,
+    ADD COLUMN vin CHAR(17) NOT NULL,
+    ADD COLUMN transmission TRANSMISSIONS NOT NULL,
+    ADD COLUMN fuel_type FUEL_TYPES NOT NULL,
+    ADD COLUMN color VARCHAR(30) NOT NULL,
+    ADD COLUMN features TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT unique_license_plate UNIQUE (license_plate),
+    ADD CONSTRAINT unique_vin UNIQUE (vin);
+CREATE INDEX cars_make_model_idx ON cars (lower(make), lower(model));
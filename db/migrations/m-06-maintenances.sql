@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS maintenances;
+CREATE TABLE maintenances (
+    id uuid PRIMARY KEY NOT NULL,
+    car_id uuid NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    reason VARCHAR(255) NOT NULL
+);
+CREATE INDEX maintenances_car_id_idx ON maintenances (car_id);
+CREATE INDEX maintenances_start_date_idx ON maintenances (start_date);
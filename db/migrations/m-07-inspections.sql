@@ -0,0 +1,19 @@
+DROP TABLE IF EXISTS inspections;
+DROP TABLE IF EXISTS car_mileages;
+CREATE TYPE INSPECTION_TYPES AS ENUM('Pickup', 'Return');
+CREATE TABLE inspections (
+    id uuid PRIMARY KEY NOT NULL,
+    reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    type INSPECTION_TYPES NOT NULL,
+    mileage INTEGER NOT NULL CHECK (mileage >= 0),
+    fuel_level SMALLINT NOT NULL CHECK (fuel_level BETWEEN 0 AND 100),
+    damages TEXT[] NOT NULL DEFAULT '{}',
+    notes TEXT NOT NULL DEFAULT '',
+    inspected_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT unique_reservation_inspection_type UNIQUE (reservation_id, type)
+);
+CREATE TABLE car_mileages (
+    car_id uuid PRIMARY KEY NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    mileage INTEGER NOT NULL DEFAULT 0,
+    last_service_mileage INTEGER NOT NULL DEFAULT 0
+);
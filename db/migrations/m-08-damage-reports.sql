@@ -0,0 +1,25 @@
+DROP TABLE IF EXISTS damage_photos;
+DROP TABLE IF EXISTS damage_reports;
+CREATE TYPE DAMAGE_REPORT_STATUSES AS ENUM('Open', 'In Repair', 'Resolved');
+CREATE TABLE damage_reports (
+    id uuid PRIMARY KEY NOT NULL,
+    car_id uuid NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    reservation_id uuid REFERENCES reservations(id) ON DELETE SET NULL,
+    reporter_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    status DAMAGE_REPORT_STATUSES NOT NULL DEFAULT 'Open',
+    resolution TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ
+);
+CREATE INDEX damage_reports_car_id_idx ON damage_reports (car_id);
+CREATE TABLE damage_photos (
+    id uuid PRIMARY KEY NOT NULL,
+    report_id uuid NOT NULL REFERENCES damage_reports(id) ON DELETE CASCADE,
+    content_type VARCHAR(50) NOT NULL,
+    size INTEGER NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    thumbnail_key VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX damage_photos_report_id_idx ON damage_photos (report_id);
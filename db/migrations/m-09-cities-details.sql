@@ -0,0 +1,5 @@
+ALTER TABLE cities
+    ADD COLUMN time_zone VARCHAR(64) NOT NULL,
+    ADD COLUMN country CHAR(2) NOT NULL,
+    ADD COLUMN currency CHAR(3) NOT NULL,
+    ADD CONSTRAINT unique_city_name UNIQUE (name);
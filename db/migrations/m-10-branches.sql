@@ -0,0 +1,32 @@
+DROP TABLE IF EXISTS branch_closures;
+DROP TABLE IF EXISTS branch_opening_hours;
+DROP TABLE IF EXISTS branches;
+CREATE TABLE branches (
+    id uuid PRIMARY KEY NOT NULL,
+    city_id uuid NOT NULL REFERENCES cities(id),
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255) NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    CONSTRAINT unique_branch_city UNIQUE (id, city_id)
+);
+CREATE INDEX branches_city_id_idx ON branches (city_id);
+-- weekday follows EXTRACT(DOW) numbering, 0 is Sunday. Days without a row are closed.
+CREATE TABLE branch_opening_hours (
+    branch_id uuid NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL CHECK (closes_at > opens_at),
+    PRIMARY KEY (branch_id, weekday)
+);
+CREATE TABLE branch_closures (
+    branch_id uuid NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (branch_id, date)
+);
+-- A car can only be assigned to a branch of its own city
+ALTER TABLE cars
+    ADD COLUMN branch_id uuid,
+    ADD CONSTRAINT cars_branch_city_fkey FOREIGN KEY (branch_id, city_id) REFERENCES branches(id, city_id);
+CREATE INDEX cars_branch_id_idx ON cars (branch_id);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS one_way_fees;
+-- Fee charged when a car is returned in a city other than the one it was picked up in.
+-- City pairs without a row do not allow one-way rentals.
+CREATE TABLE one_way_fees (
+    from_city_id uuid NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+    to_city_id uuid NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+    fee NUMERIC(8,2) NOT NULL CHECK (fee >= 0),
+    PRIMARY KEY (from_city_id, to_city_id),
+    CHECK (from_city_id <> to_city_id)
+);
+ALTER TABLE reservations
+    ADD COLUMN pickup_branch_id uuid REFERENCES branches(id),
+    ADD COLUMN return_branch_id uuid REFERENCES branches(id),
+    ADD COLUMN one_way_fee NUMERIC(8,2) NOT NULL DEFAULT 0;
+CREATE INDEX reservations_car_id_start_date_idx ON reservations (car_id, start_date);
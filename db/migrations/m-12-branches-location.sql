@@ -0,0 +1,2 @@
+-- Nearby searches pre-filter branches with a bounding box before computing distances
+CREATE INDEX branches_location_idx ON branches (latitude, longitude);
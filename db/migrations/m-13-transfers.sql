@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS transfers;
+-- Moves a car between cities. Pending transfers have no completed_at, the car
+-- can not be rented from their departure until they are completed.
+CREATE TABLE transfers (
+    id uuid PRIMARY KEY NOT NULL,
+    car_id uuid NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    from_city_id uuid NOT NULL REFERENCES cities(id),
+    to_city_id uuid NOT NULL REFERENCES cities(id),
+    to_branch_id uuid,
+    departure_date TIMESTAMPTZ NOT NULL,
+    arrival_date TIMESTAMPTZ NOT NULL CHECK (arrival_date > departure_date),
+    completed_at TIMESTAMPTZ,
+    CONSTRAINT transfers_to_branch_city_fkey FOREIGN KEY (to_branch_id, to_city_id) REFERENCES branches(id, city_id),
+    CHECK (from_city_id <> to_city_id)
+);
+CREATE INDEX transfers_car_id_departure_date_idx ON transfers (car_id, departure_date);
+-- A car can only have one pending transfer
+CREATE UNIQUE INDEX transfers_pending_car_id_idx ON transfers (car_id) WHERE completed_at IS NULL;
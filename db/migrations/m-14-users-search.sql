@@ -0,0 +1,6 @@
+-- Supports the case insensitive prefix search of the users listing.
+-- text_pattern_ops lets LIKE 'prefix%' use the indexes under any collation.
+CREATE INDEX users_lower_email_idx ON users (lower(email) text_pattern_ops);
+CREATE INDEX users_lower_first_name_idx ON users (lower(first_name) text_pattern_ops);
+CREATE INDEX users_lower_last_name_idx ON users (lower(last_name) text_pattern_ops);
+CREATE INDEX users_lower_full_name_idx ON users (lower(first_name || ' ' || last_name) text_pattern_ops);
@@ -0,0 +1,19 @@
+-- Users must verify their email before booking
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMPTZ;
+DROP TABLE IF EXISTS user_credentials;
+-- Users set their password through the password reset flow
+CREATE TABLE user_credentials (
+    user_id uuid PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    password_hash TEXT NOT NULL
+);
+DROP TABLE IF EXISTS user_tokens;
+CREATE TYPE USER_TOKEN_PURPOSES AS ENUM('Email Verification', 'Password Reset');
+-- Tokens sent by email. They are single use, used_at is set when redeemed.
+CREATE TABLE user_tokens (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose USER_TOKEN_PURPOSES NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ
+);
+CREATE INDEX user_tokens_user_id_idx ON user_tokens (user_id);
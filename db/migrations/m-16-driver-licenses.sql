@@ -0,0 +1,12 @@
+-- Drivers must meet the age and license requirements of the car type to book
+ALTER TABLE users
+    ADD COLUMN date_of_birth DATE,
+    ADD COLUMN driver_license_number TEXT,
+    ADD COLUMN driver_license_country CHAR(2),
+    ADD COLUMN driver_license_issue_date DATE,
+    ADD COLUMN driver_license_expiry_date DATE,
+    -- license fields are set all together or not at all
+    ADD CONSTRAINT complete_driver_license CHECK (
+        (driver_license_number IS NULL AND driver_license_country IS NULL AND driver_license_issue_date IS NULL AND driver_license_expiry_date IS NULL) OR
+        (driver_license_number IS NOT NULL AND driver_license_country IS NOT NULL AND driver_license_issue_date IS NOT NULL AND driver_license_expiry_date IS NOT NULL AND driver_license_issue_date < driver_license_expiry_date)
+    );
@@ -0,0 +1,17 @@
+-- Registered users allowed to drive the car besides the renter, and the prices
+-- the reservation was booked with
+ALTER TABLE reservations
+    ADD COLUMN additional_driver_ids uuid[] NOT NULL DEFAULT '{}',
+    ADD COLUMN rental_cost NUMERIC(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN additional_drivers_fee NUMERIC(8,2) NOT NULL DEFAULT 0;
+CREATE INDEX reservations_additional_driver_ids_idx ON reservations USING GIN (additional_driver_ids);
+-- Arrays can not reference users, so deleted users are removed from them
+CREATE FUNCTION remove_additional_driver() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE reservations SET additional_driver_ids = array_remove(additional_driver_ids, OLD.id)
+    WHERE additional_driver_ids @> ARRAY[OLD.id];
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER users_remove_additional_driver AFTER DELETE ON users
+    FOR EACH ROW EXECUTE PROCEDURE remove_additional_driver();
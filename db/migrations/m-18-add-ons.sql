@@ -0,0 +1,23 @@
+DROP TABLE IF EXISTS reservation_add_ons;
+DROP TABLE IF EXISTS add_ons;
+CREATE TYPE ADD_ON_PRICING_UNITS AS ENUM('Per Day', 'Per Rental');
+-- Accessories rented along with the cars of a branch
+CREATE TABLE add_ons (
+    id uuid PRIMARY KEY NOT NULL,
+    branch_id uuid NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    stock INTEGER NOT NULL CHECK (stock >= 0),
+    price NUMERIC(8,2) NOT NULL CHECK (price >= 0),
+    pricing_unit ADD_ON_PRICING_UNITS NOT NULL,
+    CONSTRAINT unique_branch_add_on_name UNIQUE (branch_id, name)
+);
+-- Units of each add-on taken by a reservation and what they cost when it was booked
+CREATE TABLE reservation_add_ons (
+    reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    add_on_id uuid NOT NULL REFERENCES add_ons(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    cost NUMERIC(10,2) NOT NULL,
+    PRIMARY KEY (reservation_id, add_on_id)
+);
+CREATE INDEX reservation_add_ons_add_on_id_idx ON reservation_add_ons (add_on_id);
+ALTER TABLE reservations ADD COLUMN add_ons_cost NUMERIC(10,2) NOT NULL DEFAULT 0;
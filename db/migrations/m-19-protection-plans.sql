@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS protection_plans;
+CREATE TYPE PROTECTION_LEVELS AS ENUM('Basic', 'Standard', 'Full');
+-- Protection levels offered for each car type
+CREATE TABLE protection_plans (
+    id uuid PRIMARY KEY NOT NULL,
+    level PROTECTION_LEVELS NOT NULL,
+    car_type CAR_TYPES NOT NULL,
+    daily_price NUMERIC(8,2) NOT NULL CHECK (daily_price >= 0),
+    deductible NUMERIC(10,2) NOT NULL CHECK (deductible >= 0),
+    CONSTRAINT unique_car_type_protection_level UNIQUE (car_type, level)
+);
+-- Terms of the plan chosen for each reservation, copied when it is booked
+ALTER TABLE reservations
+    ADD COLUMN protection_level PROTECTION_LEVELS NOT NULL DEFAULT 'Basic',
+    ADD COLUMN protection_daily_price NUMERIC(8,2) NOT NULL DEFAULT 0,
+    ADD COLUMN protection_deductible NUMERIC(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN protection_cost NUMERIC(10,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,22 @@
+DROP TABLE IF EXISTS payments;
+-- Statuses reached through the payment gateway
+ALTER TYPE PAYMENT_STATUSES ADD VALUE 'Authorized';
+ALTER TYPE PAYMENT_STATUSES ADD VALUE 'Refunded';
+ALTER TYPE PAYMENT_STATUSES ADD VALUE 'Failed';
+-- Charges of the reservations made through the payment gateway. The payment
+-- status of a reservation is the status of its latest payment.
+CREATE TABLE payments (
+    id uuid PRIMARY KEY NOT NULL,
+    reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    reference VARCHAR(100) NOT NULL UNIQUE,
+    amount NUMERIC(10,2) NOT NULL CHECK (amount >= 0),
+    currency CHAR(3) NOT NULL,
+    captured_amount NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (captured_amount >= 0),
+    refunded_amount NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (refunded_amount >= 0 AND refunded_amount <= captured_amount),
+    status PAYMENT_STATUSES NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX payments_reservation_id_idx ON payments (reservation_id, created_at);
+-- A reservation can only have one payment that did not fail nor was voided
+CREATE UNIQUE INDEX payments_in_progress_idx ON payments (reservation_id) WHERE status NOT IN ('Failed', 'Canceled');
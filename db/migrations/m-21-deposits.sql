@@ -0,0 +1,20 @@
+DROP TABLE IF EXISTS deposits;
+CREATE TYPE DEPOSIT_STATUSES AS ENUM('Not Required', 'Pending', 'Held', 'Released', 'Captured');
+-- Reservations of car types with a security deposit are booked with a
+-- Pending deposit, which is then only changed through the deposit hold
+ALTER TABLE reservations
+    ADD COLUMN deposit_status DEPOSIT_STATUSES NOT NULL DEFAULT 'Not Required';
+-- Security deposits held through the payment gateway from pickup until the
+-- car is returned
+CREATE TABLE deposits (
+    id uuid PRIMARY KEY NOT NULL,
+    reservation_id uuid NOT NULL UNIQUE REFERENCES reservations(id) ON DELETE CASCADE,
+    reference VARCHAR(100) NOT NULL UNIQUE,
+    amount NUMERIC(10,2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    captured_amount NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (captured_amount >= 0 AND captured_amount <= amount),
+    capture_reason VARCHAR(255) NOT NULL DEFAULT '',
+    status DEPOSIT_STATUSES NOT NULL,
+    held_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ
+);
@@ -0,0 +1,29 @@
+DROP TABLE IF EXISTS ledger_entries;
+CREATE TYPE LEDGER_ACCOUNTS AS ENUM('Customer', 'Revenue', 'Deposits', 'Tax');
+CREATE TYPE LEDGER_MOVEMENTS AS ENUM('Charge', 'Discount', 'Refund', 'Deposit Hold', 'Deposit Capture', 'Deposit Release');
+-- Double-entry ledger of the money moved for the reservations. Debits are
+-- positive amounts and credits negative ones. Entries are only appended.
+CREATE TABLE ledger_entries (
+    id uuid PRIMARY KEY NOT NULL,
+    transaction_id uuid NOT NULL,
+    reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    account LEDGER_ACCOUNTS NOT NULL,
+    movement LEDGER_MOVEMENTS NOT NULL,
+    amount NUMERIC(10,2) NOT NULL CHECK (amount <> 0),
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX ledger_entries_reservation_id_idx ON ledger_entries (reservation_id, created_at);
+CREATE INDEX ledger_entries_transaction_id_idx ON ledger_entries (transaction_id);
+-- The entries of a transaction must sum to zero once all of them were inserted
+CREATE FUNCTION check_ledger_transaction_balance() RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM ledger_entries WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced', NEW.transaction_id USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE PROCEDURE check_ledger_transaction_balance();
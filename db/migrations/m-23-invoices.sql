@@ -0,0 +1,70 @@
+DROP TABLE IF EXISTS invoice_taxes;
+DROP TABLE IF EXISTS invoice_lines;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequences;
+DROP TABLE IF EXISTS billing_profiles;
+-- Company details corporate customers want on their invoices
+CREATE TABLE billing_profiles (
+    user_id uuid PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    company_name VARCHAR(100) NOT NULL,
+    tax_id VARCHAR(30) NOT NULL,
+    address VARCHAR(255) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    country CHAR(2) NOT NULL
+);
+-- Last invoice number issued every year. It is taken in the transaction that
+-- inserts the invoice, so a failed insert gives the number back.
+CREATE TABLE invoice_sequences (
+    year SMALLINT PRIMARY KEY NOT NULL,
+    last_number INTEGER NOT NULL
+);
+-- Invoices issued for completed reservations. They keep a copy of the billing
+-- details and the prices, and are never changed once issued. Reservations
+-- with an invoice can not be deleted.
+CREATE TABLE invoices (
+    id uuid PRIMARY KEY NOT NULL,
+    year SMALLINT NOT NULL,
+    sequence INTEGER NOT NULL,
+    reservation_id uuid NOT NULL UNIQUE REFERENCES reservations(id),
+    billing_name VARCHAR(201) NOT NULL,
+    billing_email VARCHAR(255) NOT NULL,
+    company_name VARCHAR(100) NOT NULL DEFAULT '',
+    tax_id VARCHAR(30) NOT NULL DEFAULT '',
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    country VARCHAR(2) NOT NULL DEFAULT '',
+    subtotal NUMERIC(10,2) NOT NULL,
+    tax_total NUMERIC(10,2) NOT NULL,
+    total NUMERIC(10,2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (year, sequence)
+);
+CREATE TABLE invoice_lines (
+    invoice_id uuid NOT NULL REFERENCES invoices(id),
+    position SMALLINT NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    amount NUMERIC(10,2) NOT NULL,
+    PRIMARY KEY (invoice_id, position)
+);
+CREATE TABLE invoice_taxes (
+    invoice_id uuid NOT NULL REFERENCES invoices(id),
+    position SMALLINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    rate NUMERIC(6,4) NOT NULL,
+    amount NUMERIC(10,2) NOT NULL,
+    PRIMARY KEY (invoice_id, position)
+);
+CREATE FUNCTION reject_invoice_changes() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'issued invoices can not be changed' USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER invoices_immutable BEFORE UPDATE OR DELETE ON invoices
+    FOR EACH ROW EXECUTE PROCEDURE reject_invoice_changes();
+CREATE TRIGGER invoice_lines_immutable BEFORE UPDATE OR DELETE ON invoice_lines
+    FOR EACH ROW EXECUTE PROCEDURE reject_invoice_changes();
+CREATE TRIGGER invoice_taxes_immutable BEFORE UPDATE OR DELETE ON invoice_taxes
+    FOR EACH ROW EXECUTE PROCEDURE reject_invoice_changes();
@@ -0,0 +1,32 @@
+DROP TABLE IF EXISTS reservation_taxes;
+DROP TABLE IF EXISTS tax_rules;
+CREATE TYPE TAX_BASES AS ENUM('Rental', 'Add-ons', 'Protection', 'Fees');
+CREATE TYPE TAX_ROUNDINGS AS ENUM('Half Up', 'Half Even', 'Up', 'Down');
+-- Taxes levied on the rentals of the cars of a city, such as a sales tax or a
+-- tourism levy. Rules with a branch, such as an airport surcharge, only apply to
+-- rentals picked up at that branch. Each jurisdiction rounds its taxes its own way.
+CREATE TABLE tax_rules (
+    id uuid PRIMARY KEY NOT NULL,
+    city_id uuid NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+    branch_id uuid REFERENCES branches(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    jurisdiction VARCHAR(100) NOT NULL,
+    rate NUMERIC(7,5) NOT NULL CHECK (rate > 0 AND rate < 1),
+    applies_to TAX_BASES[] NOT NULL CHECK (cardinality(applies_to) > 0),
+    rounding TAX_ROUNDINGS NOT NULL,
+    CONSTRAINT unique_city_tax_rule_name UNIQUE (city_id, name)
+);
+-- Taxes charged to each reservation, computed when it was booked
+CREATE TABLE reservation_taxes (
+    reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    rate NUMERIC(7,5) NOT NULL,
+    amount NUMERIC(10,2) NOT NULL,
+    PRIMARY KEY (reservation_id, position)
+);
+-- Rates such as 8.875% need five decimals
+ALTER TABLE invoice_taxes ALTER COLUMN rate TYPE NUMERIC(7,5);
+ALTER TABLE reservations ADD COLUMN tax_total NUMERIC(10,2) NOT NULL DEFAULT 0;
+-- Part of the payment amount that is owed in taxes
+ALTER TABLE payments ADD COLUMN tax_amount NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (tax_amount >= 0);
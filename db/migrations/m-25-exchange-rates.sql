@@ -0,0 +1,46 @@
+DROP TABLE IF EXISTS exchange_rates;
+-- Units of to_currency one unit of from_currency is worth. Rates are only used
+-- to show quotes in the currency preferred by the customer, reservations are
+-- charged in the currency of their city.
+CREATE TABLE exchange_rates (
+    from_currency CHAR(3) NOT NULL,
+    to_currency CHAR(3) NOT NULL,
+    rate NUMERIC(18,8) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (from_currency, to_currency),
+    CHECK (from_currency <> to_currency)
+);
+-- Prices are set in the currency of the city, which can take many more digits
+-- than dollars do, such as Colombian pesos
+ALTER TABLE cars ALTER COLUMN hourly_rent_cost TYPE NUMERIC(12,2);
+ALTER TABLE one_way_fees ALTER COLUMN fee TYPE NUMERIC(12,2);
+ALTER TABLE add_ons ALTER COLUMN price TYPE NUMERIC(12,2);
+ALTER TABLE protection_plans
+    ALTER COLUMN daily_price TYPE NUMERIC(12,2),
+    ALTER COLUMN deductible TYPE NUMERIC(14,2);
+ALTER TABLE reservations
+    ALTER COLUMN one_way_fee TYPE NUMERIC(12,2),
+    ALTER COLUMN rental_cost TYPE NUMERIC(14,2),
+    ALTER COLUMN additional_drivers_fee TYPE NUMERIC(12,2),
+    ALTER COLUMN add_ons_cost TYPE NUMERIC(14,2),
+    ALTER COLUMN protection_daily_price TYPE NUMERIC(12,2),
+    ALTER COLUMN protection_deductible TYPE NUMERIC(14,2),
+    ALTER COLUMN protection_cost TYPE NUMERIC(14,2),
+    ALTER COLUMN tax_total TYPE NUMERIC(14,2);
+ALTER TABLE reservation_add_ons ALTER COLUMN cost TYPE NUMERIC(14,2);
+ALTER TABLE reservation_taxes ALTER COLUMN amount TYPE NUMERIC(14,2);
+ALTER TABLE payments
+    ALTER COLUMN amount TYPE NUMERIC(14,2),
+    ALTER COLUMN captured_amount TYPE NUMERIC(14,2),
+    ALTER COLUMN refunded_amount TYPE NUMERIC(14,2),
+    ALTER COLUMN tax_amount TYPE NUMERIC(14,2);
+ALTER TABLE deposits
+    ALTER COLUMN amount TYPE NUMERIC(14,2),
+    ALTER COLUMN captured_amount TYPE NUMERIC(14,2);
+ALTER TABLE ledger_entries ALTER COLUMN amount TYPE NUMERIC(14,2);
+ALTER TABLE invoices
+    ALTER COLUMN subtotal TYPE NUMERIC(14,2),
+    ALTER COLUMN tax_total TYPE NUMERIC(14,2),
+    ALTER COLUMN total TYPE NUMERIC(14,2);
+ALTER TABLE invoice_lines ALTER COLUMN amount TYPE NUMERIC(14,2);
+ALTER TABLE invoice_taxes ALTER COLUMN amount TYPE NUMERIC(14,2);
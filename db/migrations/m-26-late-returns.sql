@@ -0,0 +1,23 @@
+DROP TABLE IF EXISTS overdue_notices;
+DROP TABLE IF EXISTS late_returns;
+-- Cars returned after the end of their reservation and past the grace period,
+-- with the late fee charged for every started hour
+CREATE TABLE late_returns (
+    reservation_id uuid PRIMARY KEY NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    due_at TIMESTAMPTZ NOT NULL,
+    returned_at TIMESTAMPTZ NOT NULL CHECK (returned_at > due_at),
+    minutes_late INTEGER NOT NULL CHECK (minutes_late > 0),
+    hours_charged INTEGER NOT NULL CHECK (hours_charged > 0),
+    hourly_fee NUMERIC(12,2) NOT NULL CHECK (hourly_fee >= 0),
+    fee NUMERIC(12,2) NOT NULL CHECK (fee >= 0),
+    currency CHAR(3) NOT NULL
+);
+-- Renters of the next reservation on a car that were told the rental before
+-- theirs was not returned on time. Each overdue rental is notified once.
+CREATE TABLE overdue_notices (
+    reservation_id uuid PRIMARY KEY NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    next_reservation_id uuid NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    notified_at TIMESTAMPTZ NOT NULL
+);
+-- Overdue rentals are looked up by the end of reserved reservations
+CREATE INDEX IF NOT EXISTS reservations_status_end_date_idx ON reservations (status, end_date);
@@ -0,0 +1,5 @@
+-- Supports the case insensitive prefix search of the cars listing.
+-- text_pattern_ops lets LIKE 'prefix%' use the indexes under any collation.
+CREATE INDEX cars_lower_make_idx ON cars (lower(make) text_pattern_ops);
+CREATE INDEX cars_lower_model_idx ON cars (lower(model) text_pattern_ops);
+CREATE INDEX cars_lower_license_plate_idx ON cars (lower(license_plate) text_pattern_ops);
@@ -0,0 +1,5 @@
+-- Statuses of deposits being settled through the payment gateway. A deposit
+-- left in one of them was settled by the gateway but could not be recorded,
+-- and has to be reconciled with the gateway.
+ALTER TYPE DEPOSIT_STATUSES ADD VALUE 'Capturing';
+ALTER TYPE DEPOSIT_STATUSES ADD VALUE 'Releasing';
@@ -0,0 +1,49 @@
+-- Rates are updated from different time zones, so the moment they were set
+-- is kept with its offset like every other timestamp. Existing rates were
+-- stored in UTC.
+ALTER TABLE exchange_rates ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+-- Currency the prices of the reservation were set in, the one of the city of
+-- its car when it was booked. Existing reservations take the currency of the
+-- city their car is in now.
+ALTER TABLE reservations ADD COLUMN currency CHAR(3);
+UPDATE reservations SET currency = cities.currency
+    FROM cars JOIN cities ON cities.id = cars.city_id
+    WHERE cars.id = reservations.car_id;
+ALTER TABLE reservations ALTER COLUMN currency SET NOT NULL;
+-- Amounts are kept to the minor unit of their currency, which takes three
+-- decimals in currencies such as Kuwaiti dinars
+ALTER TABLE cars ALTER COLUMN hourly_rent_cost TYPE NUMERIC(13,3);
+ALTER TABLE one_way_fees ALTER COLUMN fee TYPE NUMERIC(13,3);
+ALTER TABLE add_ons ALTER COLUMN price TYPE NUMERIC(13,3);
+ALTER TABLE protection_plans
+    ALTER COLUMN daily_price TYPE NUMERIC(13,3),
+    ALTER COLUMN deductible TYPE NUMERIC(15,3);
+ALTER TABLE reservations
+    ALTER COLUMN one_way_fee TYPE NUMERIC(13,3),
+    ALTER COLUMN rental_cost TYPE NUMERIC(15,3),
+    ALTER COLUMN additional_drivers_fee TYPE NUMERIC(13,3),
+    ALTER COLUMN add_ons_cost TYPE NUMERIC(15,3),
+    ALTER COLUMN protection_daily_price TYPE NUMERIC(13,3),
+    ALTER COLUMN protection_deductible TYPE NUMERIC(15,3),
+    ALTER COLUMN protection_cost TYPE NUMERIC(15,3),
+    ALTER COLUMN tax_total TYPE NUMERIC(15,3);
+ALTER TABLE reservation_add_ons ALTER COLUMN cost TYPE NUMERIC(15,3);
+ALTER TABLE reservation_taxes ALTER COLUMN amount TYPE NUMERIC(15,3);
+ALTER TABLE payments
+    ALTER COLUMN amount TYPE NUMERIC(15,3),
+    ALTER COLUMN captured_amount TYPE NUMERIC(15,3),
+    ALTER COLUMN refunded_amount TYPE NUMERIC(15,3),
+    ALTER COLUMN tax_amount TYPE NUMERIC(15,3);
+ALTER TABLE deposits
+    ALTER COLUMN amount TYPE NUMERIC(15,3),
+    ALTER COLUMN captured_amount TYPE NUMERIC(15,3);
+ALTER TABLE ledger_entries ALTER COLUMN amount TYPE NUMERIC(15,3);
+ALTER TABLE invoices
+    ALTER COLUMN subtotal TYPE NUMERIC(15,3),
+    ALTER COLUMN tax_total TYPE NUMERIC(15,3),
+    ALTER COLUMN total TYPE NUMERIC(15,3);
+ALTER TABLE invoice_lines ALTER COLUMN amount TYPE NUMERIC(15,3);
+ALTER TABLE invoice_taxes ALTER COLUMN amount TYPE NUMERIC(15,3);
+ALTER TABLE late_returns
+    ALTER COLUMN hourly_fee TYPE NUMERIC(13,3),
+    ALTER COLUMN fee TYPE NUMERIC(13,3);
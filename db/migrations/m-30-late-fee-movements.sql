@@ -0,0 +1,2 @@
+-- Late fees charged on the payment method of the renter at return
+ALTER TYPE LEDGER_MOVEMENTS ADD VALUE 'Late Fee';
@@ -0,0 +1,5 @@
+-- Statuses of payments being captured or refunded through the payment
+-- gateway. A payment left in one of them was settled by the gateway but could
+-- not be recorded, and has to be reconciled with the gateway.
+ALTER TYPE PAYMENT_STATUSES ADD VALUE 'Capturing';
+ALTER TYPE PAYMENT_STATUSES ADD VALUE 'Refunding';
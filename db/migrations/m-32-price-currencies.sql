@@ -0,0 +1,18 @@
+-- Currency each price is set in, so prices keep their value when a car is
+-- transferred to a city with another currency.
+-- Reservations convert them to the currency of the city of the car. Existing
+-- cars, add-ons and one-way fees take the currency of their city, and existing
+-- protection plans, which are not tied to a city, the default FEES_CURRENCY.
+ALTER TABLE cars ADD COLUMN currency CHAR(3);
+UPDATE cars SET currency = cities.currency FROM cities WHERE cities.id = cars.city_id;
+ALTER TABLE cars ALTER COLUMN currency SET NOT NULL;
+ALTER TABLE add_ons ADD COLUMN currency CHAR(3);
+UPDATE add_ons SET currency = cities.currency
+    FROM branches JOIN cities ON cities.id = branches.city_id
+    WHERE branches.id = add_ons.branch_id;
+ALTER TABLE add_ons ALTER COLUMN currency SET NOT NULL;
+ALTER TABLE one_way_fees ADD COLUMN currency CHAR(3);
+UPDATE one_way_fees SET currency = cities.currency FROM cities WHERE cities.id = one_way_fees.from_city_id;
+ALTER TABLE one_way_fees ALTER COLUMN currency SET NOT NULL;
+ALTER TABLE protection_plans ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE protection_plans ALTER COLUMN currency DROP DEFAULT;
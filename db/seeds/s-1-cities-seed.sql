@@ -1,5 +1,5 @@
-INSERT INTO cities (id, name)
+INSERT INTO cities (id, name, time_zone, country, currency)
 VALUES
-    ('1105a953-1dfe-470a-b6e7-f97f004f440b', 'Chicago'),
-    ('f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'New York'),
-    ('ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Los Angeles');
+    ('1105a953-1dfe-470a-b6e7-f97f004f440b', 'Chicago', 'America/Chicago', 'US', 'USD'),
+    ('f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'New York', 'America/New_York', 'US', 'USD'),
+    ('ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Los Angeles', 'America/Los_Angeles', 'US', 'USD');
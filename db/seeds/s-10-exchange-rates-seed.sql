@@ -0,0 +1,8 @@
+INSERT INTO exchange_rates (from_currency, to_currency, rate, updated_at)
+VALUES
+    ('USD', 'EUR', 0.92150000, '2026-10-19 12:00:00'),
+    ('USD', 'GBP', 0.78940000, '2026-10-19 12:00:00'),
+    ('USD', 'CAD', 1.37210000, '2026-10-19 12:00:00'),
+    ('USD', 'MXN', 18.26400000, '2026-10-19 12:00:00'),
+    ('USD', 'COP', 3947.50000000, '2026-10-19 12:00:00'),
+    ('USD', 'JPY', 149.83000000, '2026-10-19 12:00:00');
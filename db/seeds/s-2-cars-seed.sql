@@ -1,152 +1,152 @@
-INSERT INTO cars (id, type, seats, hourly_rent_cost, city_id, status)
+INSERT INTO cars (id, type, seats, hourly_rent_cost, city_id, status, make, model, year, license_plate, vin, transmission, fuel_type, color, features, currency)
 VALUES
-    ('c7d2dded-4b7c-4cb8-badc-766b4d4b5c5a', 'Sedan', 5, 30.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('f6bfc954-1c26-40a2-a6a3-3d3c6f195bb6', 'Luxury', 4, 99.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('7a2a2b4c-4f8c-4a7d-bad4-6939ac57f8e4', 'Sports Car', 2, 55.75, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('5bc5e8dc-6ce9-40b3-96c7-6d3c3f3e6f16', 'Limousine', 6, 150.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('d13d2ed9-3767-4f4d-a43d-29e4f9254c2f', 'Sedan', 4, 42.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c3b76fc3-987a-4f77-9d44-24aa62e7f8c1', 'Luxury', 4, 89.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('2a1e903e-fc06-42d3-8ec3-fbb991cccd0b', 'Sports Car', 2, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('74e6d1b6-d34a-4271-9cfc-b78cb88b6f20', 'Limousine', 6, 200.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c6d55f54-56c2-44d6-bc48-5ca72f621db7', 'Sedan', 5, 35.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c6f96cd6-b64e-40ee-9b25-2be743a6aa75', 'Luxury', 4, 110.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('9f7b23f4-33e7-4f39-a434-124784f4a4f4', 'Sports Car', 2, 65.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('0b13dcb1-9868-44f5-9a1d-6b3713166f13', 'Limousine', 6, 180.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1', 'Sedan', 4, 43.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('f15aa59d-54fc-424c-a24f-7a5578e1d11c', 'Luxury', 5, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('0d42a6e9-dae5-4c32-b99d-4a6d933bb70b', 'Sports Car', 2, 70.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('738c7cf9-1b07-4c3d-964f-7c2edfe207a5', 'Sedan', 5, 33.75, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('4c7ed4e4-b04d-49d1-9c70-8729decc8028', 'Luxury', 4, 95.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('f3af0a84-2fc2-49a8-822d-3e7c559345a1', 'Sports Car', 2, 60.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('3141b069-1844-4b16-8aa4-fa4a4d6b3ec3', 'Sedan', 4, 45.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('61f4c6fc-9eb9-4e16-9a58-07c7f0258b5f', 'Luxury', 5, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('a536c7e8-121a-4c52-87f2-272b5072d9f7', 'Sports Car', 2, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('0e0df647-f06d-4ca9-a523-0aef87d7f6a1', 'Limousine', 6, 175.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c26f2f4a-faed-4e25-b6f1-6b87bb6d8c88', 'Sedan', 5, 40.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('cc2f450c-87e9-49f9-9b96-1635c5df8f84', 'Luxury', 4, 100.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c31d09ad-ef4a-4c90-8faa-618e7e2d31f9', 'Sedan', 4, 75.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('b40e77b6-7d12-4c32-af88-c67c14d32ec8', 'Sports Car', 2, 130.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('22108ebd-d52d-4fb5-a5e5-80d5c5a8747f', 'Luxury', 4, 140.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('7e1d316a-6347-4be9-b9b4-74ef0c4d4fa4', 'Limousine', 6, 220.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('e82aeb07-fa7f-49f5-82e5-167c5e5f5b0c', 'Sedan', 4, 85.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c6ab2e57-9ce6-4f6c-ae50-0a8b02f6b40d', 'Sports Car', 2, 125.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('a87a71f3-b0a3-44b3-bc57-2e6da9f72ed2', 'Luxury', 4, 155.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('28475c19-c34a-4e91-a04b-8cc7deedfbd5', 'Sedan', 5, 70.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('424e10c4-f4a4-4aee-9aa2-d5a5f5d5af5e', 'Sedan', 4, 70.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c3cfdd3b-8f90-4df3-89af-f3d0732f8b0f', 'Sports Car', 2, 110.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('58d694b1-8db9-4f38-80e5-944238d1678f', 'Luxury', 4, 135.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('bbd03a7b-81ba-4f7e-a2d3-3e3d2e8c09b9', 'Limousine', 6, 210.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('20d0b9c9-b523-4683-8e83-fd2a90a0f53c', 'Sedan', 4, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('f5da5c5a-55e6-4ef6-a7b6-9dc1abcb83fb', 'Sports Car', 2, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('4df9a34d-93b6-4645-ae84-7945bc5ecf21', 'Luxury', 4, 150.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('d2129ad2-1c12-4a19-ae90-399f8e00d07f', 'Sedan', 5, 65.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('a11a0f95-ec88-4c9f-a9f7-1b225f23a73a', 'Sports Car', 2, 185.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('2cc47a3d-8d2c-42af-b7f3-35c88f8edbf1', 'Luxury', 4, 125.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('dc3c3d1a-2e9c-4587-8892-936105da8db6', 'Sedan', 4, 75.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('6cfc6ce8-b6f2-4e7e-8af1-240f8abdbf74', 'Limousine', 6, 180.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('3e3a1a54-f53a-49b6-a0d3-8b2dfb840c29', 'Sports Car', 2, 155.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('775e8e5b-94a4-4bb4-9a4c-0c0f9e7ad2ea', 'Luxury', 4, 115.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('88f7a6f9-d6dc-44f8-8d95-30d0b37c4942', 'Sedan', 5, 90.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('b173da37-97c7-4059-9f08-3e52d8f45a98', 'Limousine', 6, 195.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('812d293a-858c-4cbf-9091-b7dcf5cf5a5d', 'Sports Car', 2, 165.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('c37e61d2-4c4d-4f18-bc77-6d77dc6e7c6f', 'Luxury', 4, 135.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available'),
-    ('bf39093e-5407-4011-a93c-8c106b1bdc36', 'Sedan', 5, 29.99, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('a2668e2b-5c87-4149-9c70-7adad068eed4', 'Luxury', 4, 100.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('e7775f5a-3aa3-4615-b5e5-8d013f408291', 'Sports Car', 2, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('4f4d63d8-57c6-4b26-9541-9fb5f5a3d5b5', 'Sedan', 5, 27.50, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('ae42e172-108d-49fd-87f1-d045fd877d8a', 'Luxury', 4, 89.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('30d06cf8-99c7-4d39-bc70-358765e61f89', 'Sports Car', 2, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('5ae5d956-5a8d-40dd-9aef-5340fda345e8', 'Limousine', 6, 200.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('2696c098-2e3b-4f24-8821-6f7d6f58c6d4', 'Sedan', 4, 35.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('72850ea6-92d6-4379-9a06-0b42da1dbae6', 'Luxury', 5, 110.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('bfa0a1a2-9a2f-4316-aadc-6a95a6e914ee', 'Luxury', 4, 110.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('3a55d28b-184f-442f-af44-5572b8f1b9c9', 'Sedan', 4, 80.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('4b4da8b9-0c61-4e25-9191-03f5b5e261ed', 'Limousine', 6, 180.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('f81cc8d2-2455-4a84-b31b-8e8ed9d34d4f', 'Sports Car', 2, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('77e2669d-1d67-4c3d-b31d-7e58cfe66b6d', 'Luxury', 4, 125.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('6a840fb6-83d6-443a-a1e8-8f52b6a15a6a', 'Sedan', 5, 90.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('a3ce1603-7e9b-422a-a7ea-1f6a2fa294e3', 'Limousine', 6, 200.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('cf0eb68c-bdca-41b8-8c0d-b48c1a127aa4', 'Sports Car', 2, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('d1a2dbd6-4607-4358-8c45-2f07815b4259', 'Luxury', 4, 135.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('5a7fda67-28d9-4482-8d12-7d59df5b5af7', 'Sedan', 4, 85.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('34ab50f2-6203-40cc-a8c3-f7942bfe74f3', 'Limousine', 6, 190.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('6f414e6d-4d05-4e3e-aa1d-6c63139e1c05', 'Sports Car', 2, 145.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('0f3471cf-6a8a-4487-a391-945be44e54b6', 'Luxury', 4, 120.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('b3d26f31-f2d1-4cf1-9cb9-06b47aebd974', 'Sedan', 5, 95.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('33c43b6a-d16f-4a09-853f-bc755f43a931', 'Limousine', 6, 195.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('8aee6cbf-c7d4-4f4c-8a8a-08b7d16b93c9', 'Sports Car', 2, 160.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('738c5477-228e-46fc-9e1f-60f77b727a44', 'Luxury', 4, 130.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('a2f3c1eb-fb26-42f2-bbd8-07b7f52db26d', 'Sedan', 4, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('0c91b843-bf5c-4d0a-8e98-c1ed3b6f3357', 'Limousine', 6, 185.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('ea34e81e-7364-4fb4-8e97-7bf7492bc2ad', 'Sports Car', 2, 155.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('fbc7e931-2b4d-4d99-8ab4-4f4a54a033d7', 'Luxury', 4, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('6baf04fa-94e7-4d67-83d5-c778e5d5ba5c', 'Sedan', 5, 100.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('131c526d-9ef9-4a4a-bc32-25ee922cf848', 'Limousine', 6, 180.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('e291a469-76e2-4c52-bd2a-423f7de119a9', 'Sports Car', 2, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('d26697fb-28f2-4d02-9a07-13df4078c310', 'Luxury', 4, 125.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('256a5a3f-8f61-4c89-aaec-55e91c8fc77a', 'Sedan', 4, 80.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('47ed8219-c6f9-41d8-8c23-6fbc82a1b67d', 'Limousine', 6, 190.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('f5c4ed87-b7d4-4ec4-bfb4-9c934555a7dd', 'Sports Car', 2, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('0cfab09d-f60d-4ea3-a8fa-bb3c2f2f7a58', 'Luxury', 4, 135.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('3cb3ad4f-7e4d-4c8b-b14a-9d9bcb54d757', 'Sedan', 5, 90.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('8be23471-6f60-4a5c-ba77-8f979afceca9', 'Sports Car', 2, 165.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('b52aa8d8-9b9d-4dbd-b37d-05bc15a3b7cb', 'Luxury', 4, 120.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('ee1cb2fc-3d3a-4243-8ea7-8d820fa9ac03', 'Sedan', 4, 70.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('b2408dc8-92f9-48db-979f-cdd8a83d6eaa', 'Limousine', 6, 170.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('4c4a06a6-38c6-4e6b-8746-2a3c0e4433b3', 'Sports Car', 2, 175.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('c8f50156-1e13-4b6f-87d8-d3945ec5ba5c', 'Luxury', 4, 115.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('c033b9e2-72dc-460d-b617-f0a8f0ccddba', 'Sedan', 5, 85.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('b8e8b834-30db-44bb-828c-c2ed2c1e5dc5', 'Limousine', 6, 195.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('23e2a1b1-7d95-4b4a-bf02-9c4d99d4de89', 'Sports Car', 2, 145.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('3c5e5e5c-17b3-4aa3-894c-e43b2ef2b874', 'Luxury', 4, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('0a16e68e-5726-471a-8a18-1c0426a95f46', 'Sedan', 4, 65.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available'),
-    ('a2a7ce10-53b7-47c1-bf11-23c46c8f3b3f', 'Sports Car', 2, 79.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('b1287c97-9e58-471c-9c6d-b5b5c5f5ba5e', 'Sedan', 4, 39.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('1c0ec09b-3f6a-4e2d-b6c2-6d30ba6da02d', 'Luxury', 4, 129.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('b9a38a27-3896-41db-8d5b-fa5e5a5f5d4a', 'Sports Car', 2, 89.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('e960a7f1-193d-49e7-8839-58d7aa77a0f2', 'Limousine', 6, 159.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('d480df11-65c5-4a71-9032-0d2a0f686c42', 'Sports Car', 2, 75.50, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('d1276fa3-6a2a-4232-b6c9-6a9a2e2af530', 'Sedan', 4, 45.25, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('9fa3ee36-034c-4bb4-bd5b-f83a0eb4aa4c', 'Luxury', 4, 115.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('f365dcb5-7c28-4a0e-a34e-cde3d82a8615', 'Limousine', 6, 150.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('04258e37-9781-42e6-a23b-8b2462c18a6a', 'Sports Car', 2, 85.75, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('a1d1e2c1-fb08-442c-a6eb-9a7b75f95b0d', 'Sports Car', 2, 75.25, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('b9d7e3a1-1fc7-4fcf-bddb-7f11c95e0fc7', 'Sedan', 4, 45.50, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('f4c4e9b9-7c8d-4dc9-bb1a-35f09b94e8d4', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('c5a1f7b8-5d5c-4c3f-a5db-b7c8e9d9a7b6', 'Limousine', 6, 165.75, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('e8b9f6d7-6c5a-4b8f-93e1-2d1c3f4a5e9d', 'Sports Car', 2, 95.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('9fc8c24e-7034-439a-89dc-7d1c95d81a7a', 'Sedan', 4, 40.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('fd288c8d-1b84-4151-a99c-2459b8e547d8', 'Sports Car', 2, 80.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('0e69f9b8-5d1e-4a3f-85ed-8c2d05e7f59d', 'Limousine', 6, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('cf42c8b7-3712-4a56-bdd9-dabf1976d510', 'Luxury', 4, 150.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('7a042ba6-fc8d-49b6-b746-0fba20c4240c', 'Sedan', 5, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('9825d045-d5a5-47b9-9c3f-8d719b3c1331', 'Sports Car', 2, 85.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('3fb8f3d3-37f1-4e11-8ea8-43d955f902dc', 'Sedan', 5, 45.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('0d8b83c2-1774-4e9a-ae63-4f4b4a15b840', 'Luxury', 4, 120.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('fbcd12a9-daf3-4043-9572-cd3b3c3f3e12', 'Sedan', 4, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('d0fb82a4-92b1-4476-9c8d-50d480a0ef03', 'Sports Car', 2, 100.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('ff8eb3f3-643d-4081-af78-23a8fb08cced', 'Limousine', 6, 180.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('c1e5d34c-2e57-47df-bf99-9cda8dd97ec6', 'Luxury', 4, 115.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('a2c9bae8-3b3d-40f5-ae4c-6c1dfb51d4e4', 'Sedan', 5, 55.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('cda29c2b-aae6-4b72-b320-83340a9ec9e1', 'Sports Car', 2, 90.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('8d4f36b9-9a3b-4c2d-bc34-1d49cb32d5a5', 'Sedan', 5, 40.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('ce02ec4d-7d11-4f3f-9241-d2a076a7c53b', 'Luxury', 4, 105.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('c54fbc17-2388-4a70-a7fc-4e53cc4b4d4d', 'Sedan', 4, 60.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('2fbb6d51-8cf5-4647-97e3-8115dcb58d11', 'Sports Car', 2, 95.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('7746b73d-33d8-43b1-954f-1c83fa70d09e', 'Luxury', 4, 110.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('3a6a2b6f-8998-4c6d-af03-e5a5a5d8a5b6', 'Sedan', 5, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('2d1a6858-062b-4f7e-bca2-b74e19c9f2d1', 'Sports Car', 2, 80.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('931b34d2-1a3a-4eb7-9c4b-9a13cc90d732', 'Limousine', 6, 190.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('04510df9-fb9e-42ce-8c57-0902ef117f22', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('ce5a6f5d-8380-4a5a-98b3-3b3c9f9df526', 'Sedan', 4, 55.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('d48dd1c4-9dc4-4f24-8cd4-afbd28fa29da', 'Sports Car', 2, 90.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('a357d5d2-c2c5-4652-9f7f-5e5d5b3c0783', 'Sports Car', 2, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('f342de08-c1b1-4842-a2e7-03145a988880', 'Luxury', 4, 130.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('008bdf33-40d4-4c0b-b30c-79c8d438313d', 'Sedan', 4, 70.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('0f7af8c6-4485-437e-b63e-3b3c316ee023', 'Limousine', 6, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('16f2e54e-1f19-4d89-9304-9f522c8a4b2d', 'Sports Car', 2, 155.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('f9ee4b8c-4a2e-4c20-9441-7c8df0d92fb7', 'Luxury', 4, 110.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('0f16a8f6-56fb-4e53-9b5c-8d6f2615b5f3', 'Sedan', 5, 85.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('8a95d178-303e-45e3-8a3d-ee463ceef615', 'Limousine', 6, 195.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('2228d843-62c1-43b7-bb32-6516d9b27a14', 'Sports Car', 2, 145.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available'),
-    ('2c4b3cb9-eed5-4c5f-85e1-84cf7f6d4c6a', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available');
+    ('c7d2dded-4b7c-4cb8-badc-766b4d4b5c5a', 'Sedan', 5, 30.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Toyota', 'Camry', 2017, 'ALX0001', 'EA42UFHL3FXJ8A7KP', 'Manual', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('f6bfc954-1c26-40a2-a6a3-3d3c6f195bb6', 'Luxury', 4, 99.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', '5 Series', 2018, 'BLX0002', '8G26WPX8B29W9LXX5', 'Automatic', 'Hybrid', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('7a2a2b4c-4f8c-4a7d-bad4-6939ac57f8e4', 'Sports Car', 2, 55.75, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Ford', 'Mustang', 2019, 'CLX0003', 'D3FCBJ8BTGVBZ1ZEW', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('5bc5e8dc-6ce9-40b3-96c7-6d3c3f3e6f16', 'Limousine', 6, 150.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Chrysler', '300', 2020, 'DLX0004', '1BB7V1UNPDRY7UV3B', 'Automatic', 'Diesel', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('d13d2ed9-3767-4f4d-a43d-29e4f9254c2f', 'Sedan', 4, 42.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volkswagen', 'Passat', 2021, 'ELX0005', '1Y4TZWPTBULBSHBP6', 'Automatic', 'Electric', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('c3b76fc3-987a-4f77-9d44-24aa62e7f8c1', 'Luxury', 4, 89.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'E-Class', 2022, 'FLX0006', 'GJ7VZZDVFAYKJFMTG', 'Automatic', 'Gasoline', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('2a1e903e-fc06-42d3-8ec3-fbb991cccd0b', 'Sports Car', 2, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Chevrolet', 'Corvette', 2023, 'GLX0007', 'HDJCFMMUNTTDV06S5', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('74e6d1b6-d34a-4271-9cfc-b78cb88b6f20', 'Limousine', 6, 200.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'S-Class', 2017, 'HLX0008', '6UV1DE55RPY7PY9BT', 'Automatic', 'Diesel', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('c6d55f54-56c2-44d6-bc48-5ca72f621db7', 'Sedan', 5, 35.99, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Nissan', 'Altima', 2018, 'JLX0009', 'YZ46RRY78L1AMDBXT', 'Automatic', 'Diesel', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('c6f96cd6-b64e-40ee-9b25-2be743a6aa75', 'Luxury', 4, 110.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volvo', 'S90', 2019, 'KLX0010', '2SU02FGWPUY3D2LZM', 'Automatic', 'Electric', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('9f7b23f4-33e7-4f39-a434-124784f4a4f4', 'Sports Car', 2, 65.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Porsche', '911', 2020, 'AMX0011', '847JJ0N3C798LV5VF', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('0b13dcb1-9868-44f5-9a1d-6b3713166f13', 'Limousine', 6, 180.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Cadillac', 'XTS', 2021, 'BMX0012', '85L02CWNAZWD1LLVK', 'Automatic', 'Diesel', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1', 'Sedan', 4, 43.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Hyundai', 'Sonata', 2022, 'CMX0013', '3SJVK7URK7RFV6JA5', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('f15aa59d-54fc-424c-a24f-7a5578e1d11c', 'Luxury', 5, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Lexus', 'ES', 2023, 'DMX0014', '3PXAXS3ZB300E751D', 'Automatic', 'Gasoline', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('0d42a6e9-dae5-4c32-b99d-4a6d933bb70b', 'Sports Car', 2, 70.50, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', 'M4', 2017, 'EMX0015', 'TC9LAZFSW17GFP1HC', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('738c7cf9-1b07-4c3d-964f-7c2edfe207a5', 'Sedan', 5, 33.75, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Toyota', 'Camry', 2018, 'FMX0016', 'HR12XY9WNRYUBNM3C', 'Automatic', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('4c7ed4e4-b04d-49d1-9c70-8729decc8028', 'Luxury', 4, 95.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', '5 Series', 2019, 'GMX0017', 'A4XEG7HPWDM9BCZ9Z', 'Automatic', 'Hybrid', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('f3af0a84-2fc2-49a8-822d-3e7c559345a1', 'Sports Car', 2, 60.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Ford', 'Mustang', 2020, 'HMX0018', 'B4GNL9G9A0D0CJMM0', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('3141b069-1844-4b16-8aa4-fa4a4d6b3ec3', 'Sedan', 4, 45.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Nissan', 'Altima', 2021, 'JMX0019', 'HY93JLPHELACARDRM', 'Automatic', 'Diesel', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('61f4c6fc-9eb9-4e16-9a58-07c7f0258b5f', 'Luxury', 5, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volvo', 'S90', 2022, 'KMX0020', 'EVH96S3YRP6PS2PAF', 'Automatic', 'Electric', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('a536c7e8-121a-4c52-87f2-272b5072d9f7', 'Sports Car', 2, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Porsche', '911', 2023, 'ANX0021', 'DZVCE3J4BFMFXF0KG', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('0e0df647-f06d-4ca9-a523-0aef87d7f6a1', 'Limousine', 6, 175.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Cadillac', 'XTS', 2017, 'BNX0022', 'MZDK6HB149212U9UL', 'Automatic', 'Diesel', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('c26f2f4a-faed-4e25-b6f1-6b87bb6d8c88', 'Sedan', 5, 40.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Hyundai', 'Sonata', 2018, 'CNX0023', 'V5XCBH2Y4HHC6XEVC', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('cc2f450c-87e9-49f9-9b96-1635c5df8f84', 'Luxury', 4, 100.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Lexus', 'ES', 2019, 'DNX0024', '4LVE17485YSTN844W', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('c31d09ad-ef4a-4c90-8faa-618e7e2d31f9', 'Sedan', 4, 75.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volkswagen', 'Passat', 2020, 'ENX0025', 'CE0CWDUJ5B7P330D2', 'Automatic', 'Electric', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('b40e77b6-7d12-4c32-af88-c67c14d32ec8', 'Sports Car', 2, 130.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Porsche', '911', 2021, 'FNX0026', 'SWHEY9MX8D34FFLZS', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('22108ebd-d52d-4fb5-a5e5-80d5c5a8747f', 'Luxury', 4, 140.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', '5 Series', 2022, 'GNX0027', 'AYZMJ9DLJDV0Z893P', 'Automatic', 'Hybrid', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('7e1d316a-6347-4be9-b9b4-74ef0c4d4fa4', 'Limousine', 6, 220.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'S-Class', 2023, 'HNX0028', '4M3W47CETJVFMDGKY', 'Automatic', 'Diesel', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('e82aeb07-fa7f-49f5-82e5-167c5e5f5b0c', 'Sedan', 4, 85.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Nissan', 'Altima', 2017, 'JNX0029', 'GYR0ZMJJ1Y7GKNH6Y', 'Manual', 'Diesel', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('c6ab2e57-9ce6-4f6c-ae50-0a8b02f6b40d', 'Sports Car', 2, 125.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', 'M4', 2018, 'KNX0030', 'CZWYX7J13UCVLYB2T', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('a87a71f3-b0a3-44b3-bc57-2e6da9f72ed2', 'Luxury', 4, 155.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'E-Class', 2019, 'APX0031', '26XFBJ1T7BKNW9ZAE', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('28475c19-c34a-4e91-a04b-8cc7deedfbd5', 'Sedan', 5, 70.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Honda', 'Accord', 2020, 'BPX0032', '8TFK3NHL0C47AACW7', 'Automatic', 'Hybrid', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('424e10c4-f4a4-4aee-9aa2-d5a5f5d5af5e', 'Sedan', 4, 70.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Hyundai', 'Sonata', 2021, 'CPX0033', 'YK1P6B46KR2XH80FN', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('c3cfdd3b-8f90-4df3-89af-f3d0732f8b0f', 'Sports Car', 2, 110.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Nissan', '370Z', 2022, 'DPX0034', 'DZU12CJATNEG4VC1P', 'Manual', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('58d694b1-8db9-4f38-80e5-944238d1678f', 'Luxury', 4, 135.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volvo', 'S90', 2023, 'EPX0035', 'LCF1PLLNTPTBWCMLS', 'Automatic', 'Electric', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('bbd03a7b-81ba-4f7e-a2d3-3e3d2e8c09b9', 'Limousine', 6, 210.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Lincoln', 'Town Car', 2017, 'FPX0036', 'GU6R1TUK2PFUPDT6Z', 'Automatic', 'Diesel', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('20d0b9c9-b523-4683-8e83-fd2a90a0f53c', 'Sedan', 4, 80.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Honda', 'Accord', 2018, 'GPX0037', 'JHL1VUYB5U0TCM6YH', 'Automatic', 'Hybrid', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('f5da5c5a-55e6-4ef6-a7b6-9dc1abcb83fb', 'Sports Car', 2, 120.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Ford', 'Mustang', 2019, 'HPX0038', 'K96WMWDDZ1RDY3FNP', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('4df9a34d-93b6-4645-ae84-7945bc5ecf21', 'Luxury', 4, 150.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Lexus', 'ES', 2020, 'JPX0039', 'GENZ7K9X1TK9LMM16', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('d2129ad2-1c12-4a19-ae90-399f8e00d07f', 'Sedan', 5, 65.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volkswagen', 'Passat', 2021, 'KPX0040', '7AS7MGKXSJL2C6XK4', 'Automatic', 'Electric', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('a11a0f95-ec88-4c9f-a9f7-1b225f23a73a', 'Sports Car', 2, 185.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Porsche', '911', 2022, 'ARX0041', 'CJ2GC213UYRJN6MFX', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('2cc47a3d-8d2c-42af-b7f3-35c88f8edbf1', 'Luxury', 4, 125.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', '5 Series', 2023, 'BRX0042', 'FJBKYC708G2C8W6L9', 'Automatic', 'Hybrid', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('dc3c3d1a-2e9c-4587-8892-936105da8db6', 'Sedan', 4, 75.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Hyundai', 'Sonata', 2017, 'CRX0043', 'NNCMSAYPZ8YVBS14R', 'Manual', 'Gasoline', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('6cfc6ce8-b6f2-4e7e-8af1-240f8abdbf74', 'Limousine', 6, 180.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Chrysler', '300', 2018, 'DRX0044', '2KFLRDYX0NFBYS9JZ', 'Automatic', 'Diesel', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('3e3a1a54-f53a-49b6-a0d3-8b2dfb840c29', 'Sports Car', 2, 155.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'BMW', 'M4', 2019, 'ERX0045', '9XUKV9LBRR24T3WK0', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('775e8e5b-94a4-4bb4-9a4c-0c0f9e7ad2ea', 'Luxury', 4, 115.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'E-Class', 2020, 'FRX0046', '6MYSEL2EDYZ9A9HYL', 'Automatic', 'Gasoline', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('88f7a6f9-d6dc-44f8-8d95-30d0b37c4942', 'Sedan', 5, 90.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Honda', 'Accord', 2021, 'GRX0047', 'G45YZB5ZL1DGZR1EU', 'Automatic', 'Hybrid', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('b173da37-97c7-4059-9f08-3e52d8f45a98', 'Limousine', 6, 195.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Mercedes-Benz', 'S-Class', 2022, 'HRX0048', '1PF5GHX6JP45TG9PT', 'Automatic', 'Diesel', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('812d293a-858c-4cbf-9091-b7dcf5cf5a5d', 'Sports Car', 2, 165.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Nissan', '370Z', 2023, 'JRX0049', 'BBZKCKM3770U0N8VR', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('c37e61d2-4c4d-4f18-bc77-6d77dc6e7c6f', 'Luxury', 4, 135.00, 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Available', 'Volvo', 'S90', 2017, 'KRX0050', '7TU4ELZ9KYMMPEHYN', 'Automatic', 'Electric', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('bf39093e-5407-4011-a93c-8c106b1bdc36', 'Sedan', 5, 29.99, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Toyota', 'Camry', 2018, 'ASX0051', 'DAGXYTNGL7L7HH6LM', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('a2668e2b-5c87-4149-9c70-7adad068eed4', 'Luxury', 4, 100.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'BMW', '5 Series', 2019, 'BSX0052', 'AMNFAEVVLJSBN2TJ6', 'Automatic', 'Hybrid', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('e7775f5a-3aa3-4615-b5e5-8d013f408291', 'Sports Car', 2, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Ford', 'Mustang', 2020, 'CSX0053', 'K51RGPRVCJVKYXCL3', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('4f4d63d8-57c6-4b26-9541-9fb5f5a3d5b5', 'Sedan', 5, 27.50, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Nissan', 'Altima', 2021, 'DSX0054', '7DB9AKJ52KDA42R2Y', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('ae42e172-108d-49fd-87f1-d045fd877d8a', 'Luxury', 4, 89.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volvo', 'S90', 2022, 'ESX0055', 'CWZMDSYCGYYAXJUAL', 'Automatic', 'Electric', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('30d06cf8-99c7-4d39-bc70-358765e61f89', 'Sports Car', 2, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Porsche', '911', 2023, 'FSX0056', 'KMRZ1K5K56VM56VRY', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('5ae5d956-5a8d-40dd-9aef-5340fda345e8', 'Limousine', 6, 200.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Cadillac', 'XTS', 2017, 'GSX0057', 'CK1HWPFAU0ERG8CTA', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('2696c098-2e3b-4f24-8821-6f7d6f58c6d4', 'Sedan', 4, 35.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Hyundai', 'Sonata', 2018, 'HSX0058', 'R2L1WGRAPX8SJ2CB9', 'Automatic', 'Gasoline', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('72850ea6-92d6-4379-9a06-0b42da1dbae6', 'Luxury', 5, 110.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lexus', 'ES', 2019, 'JSX0059', 'H2ZTJCYVXFAWUJD3W', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('bfa0a1a2-9a2f-4316-aadc-6a95a6e914ee', 'Luxury', 4, 110.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volvo', 'S90', 2020, 'KSX0060', 'JG7V8RHDHBZEG7063', 'Automatic', 'Electric', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('3a55d28b-184f-442f-af44-5572b8f1b9c9', 'Sedan', 4, 80.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Toyota', 'Camry', 2021, 'ATX0061', 'UYJA3JSGEMZ35V7CX', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('4b4da8b9-0c61-4e25-9191-03f5b5e261ed', 'Limousine', 6, 180.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Cadillac', 'XTS', 2022, 'BTX0062', '1TENAYFA6C7N3UL4X', 'Automatic', 'Diesel', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('f81cc8d2-2455-4a84-b31b-8e8ed9d34d4f', 'Sports Car', 2, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Ford', 'Mustang', 2023, 'CTX0063', 'TAS1DD0VJS1XVPERV', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('77e2669d-1d67-4c3d-b31d-7e58cfe66b6d', 'Luxury', 4, 125.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lexus', 'ES', 2017, 'DTX0064', '4CT7ETJWYYENHSH5B', 'Automatic', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('6a840fb6-83d6-443a-a1e8-8f52b6a15a6a', 'Sedan', 5, 90.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volkswagen', 'Passat', 2018, 'ETX0065', '7R70JUUM1UKTXYG3K', 'Automatic', 'Electric', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('a3ce1603-7e9b-422a-a7ea-1f6a2fa294e3', 'Limousine', 6, 200.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lincoln', 'Town Car', 2019, 'FTX0066', '0FNPVZVJPTW0884YD', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('cf0eb68c-bdca-41b8-8c0d-b48c1a127aa4', 'Sports Car', 2, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Chevrolet', 'Corvette', 2020, 'GTX0067', '6WJNKDE2C2DK97608', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('d1a2dbd6-4607-4358-8c45-2f07815b4259', 'Luxury', 4, 135.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Audi', 'A6', 2021, 'HTX0068', 'CRTG016XDZ7HPKACE', 'Automatic', 'Diesel', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('5a7fda67-28d9-4482-8d12-7d59df5b5af7', 'Sedan', 4, 85.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Nissan', 'Altima', 2022, 'JTX0069', '8L9U0FVFPJ98GWU7L', 'Automatic', 'Diesel', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('34ab50f2-6203-40cc-a8c3-f7942bfe74f3', 'Limousine', 6, 190.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'BMW', '7 Series', 2023, 'KTX0070', 'KS1LJ5HEGCFGB1DGA', 'Automatic', 'Diesel', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('6f414e6d-4d05-4e3e-aa1d-6c63139e1c05', 'Sports Car', 2, 145.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Porsche', '911', 2017, 'AUX0071', 'R9L40FEHRRPFA8V14', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('0f3471cf-6a8a-4487-a391-945be44e54b6', 'Luxury', 4, 120.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'BMW', '5 Series', 2018, 'BUX0072', '4V2TRNT9HBSWZWYUJ', 'Automatic', 'Hybrid', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('b3d26f31-f2d1-4cf1-9cb9-06b47aebd974', 'Sedan', 5, 95.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Hyundai', 'Sonata', 2019, 'CUX0073', '2TJ3BZEDXUEHC8BBR', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('33c43b6a-d16f-4a09-853f-bc755f43a931', 'Limousine', 6, 195.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Chrysler', '300', 2020, 'DUX0074', 'RCWN5DNGL4258NX8H', 'Automatic', 'Diesel', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('8aee6cbf-c7d4-4f4c-8a8a-08b7d16b93c9', 'Sports Car', 2, 160.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'BMW', 'M4', 2021, 'EUX0075', 'BBG26Y2FMZ29FH799', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('738c5477-228e-46fc-9e1f-60f77b727a44', 'Luxury', 4, 130.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Mercedes-Benz', 'E-Class', 2022, 'FUX0076', 'YENSWD7CG4NT5PCEP', 'Automatic', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('a2f3c1eb-fb26-42f2-bbd8-07b7f52db26d', 'Sedan', 4, 75.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Honda', 'Accord', 2023, 'GUX0077', 'CKRR079UEHY6ZZKJ3', 'Automatic', 'Hybrid', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('0c91b843-bf5c-4d0a-8e98-c1ed3b6f3357', 'Limousine', 6, 185.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Mercedes-Benz', 'S-Class', 2017, 'HUX0078', '1H8NWPXVUV4CTNLN5', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('ea34e81e-7364-4fb4-8e97-7bf7492bc2ad', 'Sports Car', 2, 155.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Nissan', '370Z', 2018, 'JUX0079', 'F1ATU5S3CHPSA6ZKY', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('fbc7e931-2b4d-4d99-8ab4-4f4a54a033d7', 'Luxury', 4, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volvo', 'S90', 2019, 'KUX0080', 'U9XNRWW4UFHLJA6ZY', 'Automatic', 'Electric', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('6baf04fa-94e7-4d67-83d5-c778e5d5ba5c', 'Sedan', 5, 100.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Toyota', 'Camry', 2020, 'AVX0081', 'L7LS2PSLYSPN5Z8CS', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('131c526d-9ef9-4a4a-bc32-25ee922cf848', 'Limousine', 6, 180.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Cadillac', 'XTS', 2021, 'BVX0082', 'WCNM5K080L5FCVMN2', 'Automatic', 'Diesel', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('e291a469-76e2-4c52-bd2a-423f7de119a9', 'Sports Car', 2, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Ford', 'Mustang', 2022, 'CVX0083', 'CS7BNWTBC5RL1KXAS', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('d26697fb-28f2-4d02-9a07-13df4078c310', 'Luxury', 4, 125.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lexus', 'ES', 2023, 'DVX0084', 'VYT31HMCZRZM66H20', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('256a5a3f-8f61-4c89-aaec-55e91c8fc77a', 'Sedan', 4, 80.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volkswagen', 'Passat', 2017, 'EVX0085', 'VMD1CV5XRU7AM33F0', 'Manual', 'Electric', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('47ed8219-c6f9-41d8-8c23-6fbc82a1b67d', 'Limousine', 6, 190.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lincoln', 'Town Car', 2018, 'FVX0086', 'AT8379G11JETSG86L', 'Automatic', 'Diesel', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('f5c4ed87-b7d4-4ec4-bfb4-9c934555a7dd', 'Sports Car', 2, 140.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Chevrolet', 'Corvette', 2019, 'GVX0087', 'TZF04BL2FM20HSTN6', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('0cfab09d-f60d-4ea3-a8fa-bb3c2f2f7a58', 'Luxury', 4, 135.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Audi', 'A6', 2020, 'HVX0088', '1LPVWYKGCKSGTDJW3', 'Automatic', 'Diesel', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('3cb3ad4f-7e4d-4c8b-b14a-9d9bcb54d757', 'Sedan', 5, 90.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Nissan', 'Altima', 2021, 'JVX0089', 'E5C77Y7F6ZBPPT86V', 'Automatic', 'Diesel', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('8be23471-6f60-4a5c-ba77-8f979afceca9', 'Sports Car', 2, 165.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'BMW', 'M4', 2022, 'KVX0090', 'TE35JT9F5FSFY1BTF', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('b52aa8d8-9b9d-4dbd-b37d-05bc15a3b7cb', 'Luxury', 4, 120.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Mercedes-Benz', 'E-Class', 2023, 'AWX0091', 'K6XX1UYETU0RM431S', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('ee1cb2fc-3d3a-4243-8ea7-8d820fa9ac03', 'Sedan', 4, 70.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Honda', 'Accord', 2017, 'BWX0092', 'NU8GN3TGRVE9M40LH', 'Manual', 'Hybrid', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('b2408dc8-92f9-48db-979f-cdd8a83d6eaa', 'Limousine', 6, 170.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Mercedes-Benz', 'S-Class', 2018, 'CWX0093', 'EE3SEDJ36SLXPZ2MD', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('4c4a06a6-38c6-4e6b-8746-2a3c0e4433b3', 'Sports Car', 2, 175.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Nissan', '370Z', 2019, 'DWX0094', 'BZ464S03L7AVT2JR2', 'Manual', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('c8f50156-1e13-4b6f-87d8-d3945ec5ba5c', 'Luxury', 4, 115.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volvo', 'S90', 2020, 'EWX0095', 'YEXTFKTAFPYTWRKAM', 'Automatic', 'Electric', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('c033b9e2-72dc-460d-b617-f0a8f0ccddba', 'Sedan', 5, 85.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Toyota', 'Camry', 2021, 'FWX0096', 'HHVRDR94FEM4YUZ8G', 'Automatic', 'Gasoline', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('b8e8b834-30db-44bb-828c-c2ed2c1e5dc5', 'Limousine', 6, 195.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Cadillac', 'XTS', 2022, 'GWX0097', '4GGF07ZSGVZ11NL34', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('23e2a1b1-7d95-4b4a-bf02-9c4d99d4de89', 'Sports Car', 2, 145.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Ford', 'Mustang', 2023, 'HWX0098', '6A81FKK9VUA6LJHDX', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('3c5e5e5c-17b3-4aa3-894c-e43b2ef2b874', 'Luxury', 4, 150.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Lexus', 'ES', 2017, 'JWX0099', 'C62WE89M6TZWSNCUH', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('0a16e68e-5726-471a-8a18-1c0426a95f46', 'Sedan', 4, 65.00, 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'Available', 'Volkswagen', 'Passat', 2018, 'KWX0100', '0VGZL1W1ZA5LYWGPW', 'Automatic', 'Electric', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('a2a7ce10-53b7-47c1-bf11-23c46c8f3b3f', 'Sports Car', 2, 79.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2019, 'ALY0101', 'RRJE1K5WYF5DZPBLG', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('b1287c97-9e58-471c-9c6d-b5b5c5f5ba5e', 'Sedan', 4, 39.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2020, 'BLY0102', '2GWAC4642BB302CTK', 'Automatic', 'Hybrid', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('1c0ec09b-3f6a-4e2d-b6c2-6d30ba6da02d', 'Luxury', 4, 129.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Audi', 'A6', 2021, 'CLY0103', 'CH8EL4BNBT861XFSS', 'Automatic', 'Diesel', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('b9a38a27-3896-41db-8d5b-fa5e5a5f5d4a', 'Sports Car', 2, 89.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Nissan', '370Z', 2022, 'DLY0104', 'YAWA06DAANBP1SLXZ', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('e960a7f1-193d-49e7-8839-58d7aa77a0f2', 'Limousine', 6, 159.99, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', '7 Series', 2023, 'ELY0105', 'K4317C9APKF07Y7G0', 'Automatic', 'Gasoline', 'Silver', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('d480df11-65c5-4a71-9032-0d2a0f686c42', 'Sports Car', 2, 75.50, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2017, 'FLY0106', 'Y4HP5RR504U2S9A3D', 'Manual', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('d1276fa3-6a2a-4232-b6c9-6a9a2e2af530', 'Sedan', 4, 45.25, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2018, 'GLY0107', 'G2PAX8D2RHSZ6TSC4', 'Automatic', 'Hybrid', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('9fa3ee36-034c-4bb4-bd5b-f83a0eb4aa4c', 'Luxury', 4, 115.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Audi', 'A6', 2019, 'HLY0108', '06EBFHTE506X91C46', 'Automatic', 'Diesel', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('f365dcb5-7c28-4a0e-a34e-cde3d82a8615', 'Limousine', 6, 150.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Chrysler', '300', 2020, 'JLY0109', 'SGW33P1SMJAB6PZB3', 'Automatic', 'Gasoline', 'Black', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('04258e37-9781-42e6-a23b-8b2462c18a6a', 'Sports Car', 2, 85.75, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', 'M4', 2021, 'KLY0110', 'KRWBGWMF2AYZGKBZ3', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('a1d1e2c1-fb08-442c-a6eb-9a7b75f95b0d', 'Sports Car', 2, 75.25, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2022, 'AMY0111', 'Z8XAHBZPBRBPEVG6G', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('b9d7e3a1-1fc7-4fcf-bddb-7f11c95e0fc7', 'Sedan', 4, 45.50, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2023, 'BMY0112', 'VXV3TZCR6E5LEJBW5', 'Automatic', 'Hybrid', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('f4c4e9b9-7c8d-4dc9-bb1a-35f09b94e8d4', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Audi', 'A6', 2017, 'CMY0113', 'RAYFDK39PBDM24V8N', 'Automatic', 'Diesel', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('c5a1f7b8-5d5c-4c3f-a5db-b7c8e9d9a7b6', 'Limousine', 6, 165.75, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Chrysler', '300', 2018, 'DMY0114', 'WS80EPN87ZMV9UN7N', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('e8b9f6d7-6c5a-4b8f-93e1-2d1c3f4a5e9d', 'Sports Car', 2, 95.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', 'M4', 2019, 'EMY0115', '8SEF78FC6SEECRD1Z', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('9fc8c24e-7034-439a-89dc-7d1c95d81a7a', 'Sedan', 4, 40.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Toyota', 'Camry', 2020, 'FMY0116', 'W6JLEJAATUA2DCNRW', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('fd288c8d-1b84-4151-a99c-2459b8e547d8', 'Sports Car', 2, 80.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Chevrolet', 'Corvette', 2021, 'GMY0117', '4K0UZAS9KVYK7JRD9', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('0e69f9b8-5d1e-4a3f-85ed-8c2d05e7f59d', 'Limousine', 6, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Mercedes-Benz', 'S-Class', 2022, 'HMY0118', 'KWV56RXR2N1UVXC1T', 'Automatic', 'Diesel', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('cf42c8b7-3712-4a56-bdd9-dabf1976d510', 'Luxury', 4, 150.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Lexus', 'ES', 2023, 'JMY0119', 'UZ0RMJKA1JSF79Z4S', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('7a042ba6-fc8d-49b6-b746-0fba20c4240c', 'Sedan', 5, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Volkswagen', 'Passat', 2017, 'KMY0120', '3VSTANS0MZ7RYJKGU', 'Manual', 'Electric', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('9825d045-d5a5-47b9-9c3f-8d719b3c1331', 'Sports Car', 2, 85.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2018, 'ANY0121', 'ZLFP6DMMD37VGGAEZ', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('3fb8f3d3-37f1-4e11-8ea8-43d955f902dc', 'Sedan', 5, 45.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2019, 'BNY0122', '6NMX7K0JE9CLH19AH', 'Automatic', 'Hybrid', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('0d8b83c2-1774-4e9a-ae63-4f4b4a15b840', 'Luxury', 4, 120.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Audi', 'A6', 2020, 'CNY0123', 'B41N098X32WF10MWP', 'Automatic', 'Diesel', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('fbcd12a9-daf3-4043-9572-cd3b3c3f3e12', 'Sedan', 4, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Nissan', 'Altima', 2021, 'DNY0124', 'BTUJZCTXUB5H6APUP', 'Automatic', 'Diesel', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('d0fb82a4-92b1-4476-9c8d-50d480a0ef03', 'Sports Car', 2, 100.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', 'M4', 2022, 'ENY0125', 'E78603FL4RKWPTL5Y', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('ff8eb3f3-643d-4081-af78-23a8fb08cced', 'Limousine', 6, 180.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Lincoln', 'Town Car', 2023, 'FNY0126', '5WGHDYBLLXXX7WLHC', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('c1e5d34c-2e57-47df-bf99-9cda8dd97ec6', 'Luxury', 4, 115.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', '5 Series', 2017, 'GNY0127', 'EC0FBRJXUYD4SU5DV', 'Automatic', 'Hybrid', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('a2c9bae8-3b3d-40f5-ae4c-6c1dfb51d4e4', 'Sedan', 5, 55.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Hyundai', 'Sonata', 2018, 'HNY0128', 'JTNFXEMM9S9BVWTLU', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('cda29c2b-aae6-4b72-b320-83340a9ec9e1', 'Sports Car', 2, 90.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Nissan', '370Z', 2019, 'JNY0129', 'MVJXJT2TGSYKTA1FD', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('8d4f36b9-9a3b-4c2d-bc34-1d49cb32d5a5', 'Sedan', 5, 40.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Volkswagen', 'Passat', 2020, 'KNY0130', '35G25HY1MYM1140N2', 'Automatic', 'Electric', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('ce02ec4d-7d11-4f3f-9241-d2a076a7c53b', 'Luxury', 4, 105.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Mercedes-Benz', 'E-Class', 2021, 'APY0131', '6G98HS52D2ET42ALZ', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('c54fbc17-2388-4a70-a7fc-4e53cc4b4d4d', 'Sedan', 4, 60.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2022, 'BPY0132', '7RHDTTUBZNNHRJ9TF', 'Automatic', 'Hybrid', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('2fbb6d51-8cf5-4647-97e3-8115dcb58d11', 'Sports Car', 2, 95.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Ford', 'Mustang', 2023, 'CPY0133', '14NU1PLU2TBSNEBE3', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('7746b73d-33d8-43b1-954f-1c83fa70d09e', 'Luxury', 4, 110.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Lexus', 'ES', 2017, 'DPY0134', 'DFCG3CKR1AEJSZE09', 'Automatic', 'Gasoline', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('3a6a2b6f-8998-4c6d-af03-e5a5a5d8a5b6', 'Sedan', 5, 50.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Volkswagen', 'Passat', 2018, 'EPY0135', 'MD54LK5X1HF5VB198', 'Automatic', 'Electric', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('2d1a6858-062b-4f7e-bca2-b74e19c9f2d1', 'Sports Car', 2, 80.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2019, 'FPY0136', 'HHEU0JP6N1NEK1UL5', 'Manual', 'Gasoline', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('931b34d2-1a3a-4eb7-9c4b-9a13cc90d732', 'Limousine', 6, 190.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Cadillac', 'XTS', 2020, 'GPY0137', 'XA72JZPMF8EWZ3NA4', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,Bluetooth}', 'USD'),
+    ('04510df9-fb9e-42ce-8c57-0902ef117f22', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Audi', 'A6', 2021, 'HPY0138', '1R3BZ0ECL0DT10CVH', 'Automatic', 'Diesel', 'Red', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('ce5a6f5d-8380-4a5a-98b3-3b3c9f9df526', 'Sedan', 4, 55.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Nissan', 'Altima', 2022, 'JPY0139', 'CL5B1GBEN515CAMBZ', 'Automatic', 'Diesel', 'Black', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('d48dd1c4-9dc4-4f24-8cd4-afbd28fa29da', 'Sports Car', 2, 90.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', 'M4', 2023, 'KPY0140', '03BAR7MF6W79CGEFV', 'Automatic', 'Gasoline', 'White', '{"A/C",Bluetooth}', 'USD'),
+    ('a357d5d2-c2c5-4652-9f7f-5e5d5b3c0783', 'Sports Car', 2, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Porsche', '911', 2017, 'ARY0141', 'ZVDPRF9ZGV2BX130R', 'Automatic', 'Gasoline', 'Silver', '{"A/C",Bluetooth}', 'USD'),
+    ('f342de08-c1b1-4842-a2e7-03145a988880', 'Luxury', 4, 130.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', '5 Series', 2018, 'BRY0142', '7LTHNSFUG5ZGJ7AKG', 'Automatic', 'Hybrid', 'Gray', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('008bdf33-40d4-4c0b-b30c-79c8d438313d', 'Sedan', 4, 70.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Hyundai', 'Sonata', 2019, 'CRY0143', 'W0AL2D8W4487ZUDCW', 'Automatic', 'Gasoline', 'Blue', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('0f7af8c6-4485-437e-b63e-3b3c316ee023', 'Limousine', 6, 175.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Chrysler', '300', 2020, 'DRY0144', '9PA9W8ZS5V6577TUZ', 'Automatic', 'Diesel', 'Red', '{"A/C",Bluetooth}', 'USD'),
+    ('16f2e54e-1f19-4d89-9304-9f522c8a4b2d', 'Sports Car', 2, 155.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'BMW', 'M4', 2021, 'ERY0145', 'C2TYBV7BXELPTV7YD', 'Manual', 'Gasoline', 'Black', '{"A/C",Bluetooth}', 'USD'),
+    ('f9ee4b8c-4a2e-4c20-9441-7c8df0d92fb7', 'Luxury', 4, 110.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Mercedes-Benz', 'E-Class', 2022, 'FRY0146', 'C5T3NJZ6KR565RKUE', 'Automatic', 'Gasoline', 'White', '{"A/C","Child Seat Ready"}', 'USD'),
+    ('0f16a8f6-56fb-4e53-9b5c-8d6f2615b5f3', 'Sedan', 5, 85.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Honda', 'Accord', 2023, 'GRY0147', 'P3CHRP15K8B5N7N1B', 'Automatic', 'Hybrid', 'Silver', '{"A/C",GPS,"Child Seat Ready",Bluetooth}', 'USD'),
+    ('8a95d178-303e-45e3-8a3d-ee463ceef615', 'Limousine', 6, 195.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Mercedes-Benz', 'S-Class', 2017, 'HRY0148', '3KM9E22U67FLGU4TT', 'Automatic', 'Diesel', 'Gray', '{"A/C",Bluetooth}', 'USD'),
+    ('2228d843-62c1-43b7-bb32-6516d9b27a14', 'Sports Car', 2, 145.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Nissan', '370Z', 2018, 'JRY0149', 'JP3R7WN52ZNFE0X5T', 'Automatic', 'Gasoline', 'Blue', '{"A/C",Bluetooth}', 'USD'),
+    ('2c4b3cb9-eed5-4c5f-85e1-84cf7f6d4c6a', 'Luxury', 4, 125.00, '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Available', 'Volvo', 'S90', 2019, 'KRY0150', '2UENRM0R2AWS08ZZ4', 'Automatic', 'Electric', 'Red', '{"A/C","Child Seat Ready"}', 'USD');
@@ -1,100 +1,107 @@
-INSERT INTO users (id, first_name, last_name, email, type, status)
+INSERT INTO users (id, first_name, last_name, email, type, status, email_verified_at)
 VALUES
-    ('d359514d-4bd6-4f9a-b9c9-9deaf4e08969', 'Richard', 'Feynman', 'richard.feynman@caltech.edu.us', 'Customer', 'Active'),
-    ('b6dcf3b3-fd6f-4b4e-8d4a-3d6883a0a128', 'Marie', 'Curie', 'm.curie@sorbonne.fr', 'Admin', 'Active'),
-    ('44ed118f-ec0a-4d15-98e7-794b10d8cbde', 'Stephen', 'Hawking', 's.hawking@cam.ac.uk', 'Customer', 'Inactive'),
-    ('1f8a2c92-14d7-448c-9f31-9c9a520a20b1', 'Alan', 'Turing', 'a.turing@kings.cam.ac.uk', 'Admin', 'Active'),
-    ('59a58b25-b141-4e27-ae97-53d1ab7f2d91', 'Ada', 'Lovelace', 'a.lovelace@ucl.ac.uk', 'Customer', 'Active'),
-    ('b2f6eaa5-f252-4066-9f6d-1866845f5eab', 'Isaac', 'Newton', 'i.newton@cam.ac.uk', 'Admin', 'Inactive'),
-    ('dca782fb-1530-4da2-a0e7-66bb663f76b4', 'Galileo', 'Galilei', 'g.galilei@unipi.it', 'Customer', 'Active'),
-    ('a29b1af4-9650-4379-8a8b-7f6c4d374e7f', 'Nikola', 'Tesla', 'n.tesla@columbia.edu', 'Admin', 'Inactive'),
-    ('c63d543a-758e-4de1-ae38-72165e9d9aa5', 'Max', 'Planck', 'm.planck@berlin.mpg.de', 'Customer', 'Active'),
-    ('9a99d28e-1325-456e-a75d-26290e6c64e6', 'Erwin', 'Schrödinger', 'e.schrodinger@univie.ac.at', 'Admin', 'Active'),
-    ('48a14728-6aa9-428d-a5c8-39a97c5d5f5a', 'Werner', 'Heisenberg', 'w.heisenberg@mpq.mpg.de', 'Customer', 'Active'),
-    ('237a063a-cb8f-4e9e-9347-348f2778b8f7', 'Albert', 'Einstein', 'a.einstein@ias.edu', 'Admin', 'Inactive'),
-    ('84aa2ea1-81e8-4950-97b5-57eab940f72d', 'Carl', 'Sagan', 'c.sagan@cornell.edu', 'Customer', 'Active'),
-    ('5e5d0d62-8fc2-4b4f-87b4-4b8e7b94a387', 'Charles', 'Darwin', 'c.darwin@cam.ac.uk', 'Customer', 'Active'),
-    ('1266f2a6-293d-4b10-9e4e-9ec25882fc72', 'Louis', 'Pasteur', 'l.pasteur@pasteur.fr', 'Admin', 'Active'),
-    ('9f2c5d5e-7e80-469c-88b5-23d5d7aa5de5', 'Robert', 'Boyle', 'r.boyle@ox.ac.uk', 'Customer', 'Inactive'),
-    ('70736b1d-ff8c-43b6-af96-33a6242f0688', 'Antoine', 'Lavoisier', 'a.lavoisier@ens.fr', 'Admin', 'Active'),
-    ('88c8c231-8836-4b6d-bb6d-8f9561dd342d', 'Ernest', 'Rutherford', 'e.rutherford@manchester.ac.uk', 'Customer', 'Active'),
-    ('6f1d72b4-6b63-4c8c-8360-cad4a63ce05b', 'Wilhelm', 'Röntgen', 'w.roentgen@uni-wuerzburg.de', 'Admin', 'Inactive'),
-    ('1ba2b9e9-0fb2-483d-8597-f0a6bfa7f71f', 'Niels', 'Bohr', 'n.bohr@nbi.ku.dk', 'Customer', 'Active'),
-    ('212b3602-34d9-441c-9f8a-cc63d67b1d7a', 'James', 'Clerk Maxwell', 'j.c.maxwell@ed.ac.uk', 'Admin', 'Active'),
-    ('dbf7fb1d-3b72-48f3-9f85-95453d2f7a8d', 'John', 'Dalton', 'j.dalton@manchester.ac.uk', 'Customer', 'Active'),
-    ('14682b91-e04a-4357-bdbd-a48d3d82bdc3', 'Gregor', 'Mendel', 'g.mendel@brno.cz', 'Admin', 'Inactive'),
-    ('85fbd74e-ced9-432a-9fb4-6b929dd6b4e6', 'Michael', 'Faraday', 'm.faraday@ri.ac.uk', 'Customer', 'Active'),
-    ('e1c4d4af-4e4e-4be2-8b9a-1f0c6f390775', 'Christiaan', 'Huygens', 'c.huygens@tudelft.nl', 'Admin', 'Active'),
-    ('91b74551-cbb7-46a1-96f7-d8a3e54f9ab9', 'Blaise', 'Pascal', 'b.pascal@clermond-ferrand.fr', 'Customer', 'Active'),
-    ('d9a862f9-2c25-49d2-80c9-3b93d3f2a9e6', 'Gottfried', 'Leibniz', 'g.leibniz@unihannover.de', 'Admin', 'Inactive'),
-    ('9df27e36-6c34-461d-975d-5d3073173b24', 'Alessandro', 'Volta', 'a.volta@unipv.it', 'Customer', 'Active'),
-    ('38f0e14c-200d-4d9e-9a62-4bb8e4d1a027', 'Joseph', 'Priestley', 'j.priestley@birmingham.ac.uk', 'Admin', 'Active'),
-    ('97e03d70-7a2a-4718-b44a-60b55c158e1a', 'Benjamin', 'Franklin', 'b.franklin@upenn.edu', 'Customer', 'Active'),
-    ('62d9f6c3-d6e3-4e72-a27b-42afaa12c6e7', 'Robert', 'Hooke', 'r.hooke@royalsociety.org.uk', 'Admin', 'Inactive'),
-    ('f8a200b4-665f-4c9f-bbca-4b4e5ee59b5d', 'André-Marie', 'Ampère', 'a.ampere@ens-lyon.fr', 'Customer', 'Active'),
-    ('52cb5cf5-888d-404f-a321-7c1c5b2c54b6', 'Enrico', 'Fermi', 'e.fermi@uniroma1.it', 'Admin', 'Active'),
-    ('e0bcb4ec-8264-4ebf-844d-f4631e2b6154', 'Pierre-Simon', 'Laplace', 'p.laplace@ens.fr', 'Customer', 'Active'),
-    ('06dbf83e-c07a-41c4-9bb9-d4c12633ad1f', 'William', 'Herschel', 'w.herschel@bath.ac.uk', 'Admin', 'Inactive'),
-    ('04e8df09-7f91-4cbf-87a1-60f5275c7b5d', 'Carolus', 'Linnaeus', 'c.linnaeus@uu.se', 'Customer', 'Active'),
-    ('7c101ced-02f0-4165-8f79-25b85e5f1c7f', 'Johannes', 'Kepler', 'j.kepler@taufkirchen.de', 'Admin', 'Active'),
-    ('59f71ee1-2710-4f9d-b4f4-bad7311a8fda', 'Daniel', 'Bernoulli', 'd.bernoulli@unibas.ch', 'Customer', 'Active'),
-    ('8b3a3ea1-b67d-4e13-8fb5-5bf3b7c5e5f5', 'Georges', 'Cuvier', 'g.cuvier@mnhn.fr', 'Admin', 'Inactive'),
-    ('aefa8bd9-1a7c-4c2b-909c-2c4c392f4d32', 'William', 'Harvey', 'w.harvey@kcl.ac.uk', 'Customer', 'Active'),
-    ('a4a4c4ea-ae4b-4e8f-a0b1-21c820f7e0d8', 'Edward', 'Jenner', 'e.jenner@imperial.ac.uk', 'Admin', 'Active'),
-    ('b24c4912-19fb-4399-b3ea-7aae2b2ebdba', 'Charles-Augustin', 'Coulomb', 'c.coulomb@polytechnique.edu', 'Customer', 'Active'),
-    ('5c6e34e6-e1e6-44d6-9c4a-78b354a47e8d', 'Tycho', 'Brahe', 't.brahe@utsc.utoronto.ca', 'Admin', 'Inactive'),
-    ('80dd94cb-7643-44e7-8c04-3fa7b9d3c3f7', 'Hermann', 'von Helmholtz', 'h.helmholtz@physik.uni-berlin.de', 'Customer', 'Active'),
-    ('406015bb-7f2a-49ed-a51c-b5f5aa6d9ec1', 'Hans', 'Geiger', 'h.geiger@uni-kiel.de', 'Admin', 'Active'),
-    ('6f0913f3-eb3e-4f7a-a72c-71bc9e1baf22', 'Joseph-Louis', 'Lagrange', 'j.l.lagrange@academie-sciences.fr', 'Customer', 'Active'),
-    ('6b0be8c1-6e05-4ab9-9207-1c8de51c7a68', 'Ernst', 'Mach', 'e.mach@univie.ac.at', 'Admin', 'Inactive'),
-    ('a4c4b3e4-07ce-427c-a311-b60c1f8581ad', 'Ludwig', 'Boltzmann', 'l.boltzmann@tuwien.ac.at', 'Customer', 'Active'),
-    ('fc183d05-8709-46c9-bcbf-f031d1f8a947', 'Giovanni', 'Alfonso Borelli', 'g.a.borelli@uniroma1.it', 'Admin', 'Active'),
-    ('ebc3da3e-af67-4c8d-9b1c-0dfcc13ec0fd', 'John', 'Herschel', 'j.herschel@joh.cam.ac.uk', 'Customer', 'Active'),
-    ('7a2769e3-8127-4e48-b0a7-62a9586a4077', 'Johann', 'Lambert', 'j.lambert@uni-freiburg.de', 'Customer', 'Active'),
-    ('e6f83a92-3c8a-470d-89a6-37c6b891f8d8', 'Rudolf', 'Clausius', 'r.clausius@unibonn.de', 'Admin', 'Active'),
-    ('a201f757-7a1c-44a5-9548-9a9d7c27caed', 'Hans', 'Christian Ørsted', 'h.c.orsted@ou.dk', 'Customer', 'Active'),
-    ('aefc8243-3d3e-4a2a-aa4c-41a1a854a8d4', 'Svante', 'Arrhenius', 's.arrhenius@su.se', 'Admin', 'Inactive'),
-    ('5b5d41e5-180c-4485-a98f-69e06b1f7a6c', 'Pafnuty', 'Chebyshev', 'p.chebyshev@msu.ru', 'Customer', 'Active'),
-    ('42b3d078-1052-4297-9866-48bde9980078', 'Arthur', 'Eddington', 'a.eddington@cam.ac.uk', 'Admin', 'Active'),
-    ('6a48a6c3-b6f2-4c67-bba4-c4557e98b20f', 'Gabriel', 'Daniel Fahrenheit', 'g.d.fahrenheit@wroc.pl', 'Customer', 'Active'),
-    ('e99ad6a4-9be9-4dcb-b4c3-02e1e4c8256f', 'Johannes', 'Stark', 'j.stark@uni-greifswald.de', 'Admin', 'Inactive'),
-    ('00d10c91-91a8-4cd9-9b60-c1d7c90557c8', 'Amedeo', 'Avogadro', 'a.avogadro@unito.it', 'Customer', 'Active'),
-    ('87b5f5b5-49dc-472e-b3f8-5e9e9c5e5d68', 'George', 'Simon Ohm', 'g.s.ohm@fau.de', 'Admin', 'Active'),
-    ('f4c2a689-c1a2-43c2-b7b7-64304d9977b9', 'Wilhelm', 'Ostwald', 'w.ostwald@uni-leipzig.de', 'Customer', 'Active'),
-    ('9e190be2-ff2d-435a-964f-f5b5d10b5f3c', 'William', 'Rankine', 'w.rankine@uofg.edu', 'Admin', 'Inactive'),
-    ('6775a5c3-3f2f-4eab-8b90-72c551fc33ec', 'Edwin', 'Hubble', 'e.hubble@mtwilson.edu', 'Customer', 'Active'),
-    ('d37fbae9-85b1-4d02-865f-a1edc8d7abfa', 'Albert', 'Michelson', 'a.michelson@uchicago.edu', 'Admin', 'Active'),
-    ('70f441da-3f3a-43df-bd7f-38371e2a6f4d', 'Lise', 'Meitner', 'l.meitner@kth.se', 'Admin', 'Inactive'),
-    ('db66eb3a-f3c3-41e9-9c57-0c2ca0eb879f', 'James', 'Chadwick', 'j.chadwick@liv.ac.uk', 'Admin', 'Active'),
-    ('a0890130-0cd2-4b48-9d2c-dbb7fbd71179', 'Robert', 'Millikan', 'r.millikan@caltech.edu', 'Customer', 'Active'),
-    ('63ed9387-1a2c-404f-81e2-cbc44148db6d', 'Hendrik', 'Lorentz', 'h.lorentz@science.ru.nl', 'Admin', 'Inactive'),
-    ('4e4a3173-aa67-4ad7-af56-8b3e3ebec3c3', 'Paul', 'Dirac', 'p.dirac@phy.cam.ac.uk', 'Admin', 'Active'),
-    ('03b2a43b-60d9-4919-b9bb-032f03b634ab', 'Roger', 'Penrose', 'r.penrose@maths.ox.ac.uk', 'Admin', 'Inactive'),
-    ('f6188df6-5f63-4ee0-8cb5-5f5b13c5b0ad', 'Vera', 'Rubin', 'v.rubin@carnegiescience.edu', 'Customer', 'Active'),
-    ('d9f7f2f8-38fd-4356-a715-58a21f758fe3', 'Neil', 'Turok', 'n.turok@perimeterinstitute.ca', 'Admin', 'Active'),
-    ('3f5fb75c-7545-42d5-a2ce-822ae12f035a', 'Saul', 'Perlmutter', 's.perlmutter@lbl.gov', 'Customer', 'Active'),
-    ('01f41eb6-682d-4f0d-8f25-35d7c1f44292', 'Ada', 'Yonath', 'a.yonath@weizmann.ac.il', 'Admin', 'Inactive'),
-    ('e06b0d08-5af1-4a0f-9365-93e5d5dd28de', 'Peter', 'Higgs', 'p.higgs@ed.ac.uk', 'Customer', 'Active'),
-    ('7556fae4-4c1f-42d3-8e4d-4c01ad9a9d8c', 'Francois', 'Englert', 'f.englert@ulb.ac.be', 'Admin', 'Active'),
-    ('8e0ceaa4-22c1-41fb-bddf-7f41a044d1aa', 'Takaaki', 'Kajita', 't.kajita@icrr.u-tokyo.ac.jp', 'Customer', 'Active'),
-    ('88f20c9b-1d3d-4753-bf5f-96bfe1450632', 'Yoichiro', 'Nambu', 'y.nambu@uchicago.edu', 'Admin', 'Inactive'),
-    ('f8c40108-a41b-422e-a48a-5515b3d5d5e5', 'Kip', 'Thorne', 'k.thorne@caltech.edu', 'Customer', 'Active'),
-    ('b0c71b2d-b41c-4e61-81f8-0c9cf9d7d56c', 'Serge', 'Haroche', 's.haroche@college-de-france.fr', 'Admin', 'Inactive'),
-    ('5b5c6f15-2a06-4d61-9da5-81671ce003a9', 'John', 'Mather', 'j.mather@nasa.gov', 'Admin', 'Active'),
-    ('9aa9c7d4-1a81-4ba5-8b5d-54e0d9c9cbdf', 'Niels', 'Henrik Abel', 'n.h.abel@uio.no', 'Admin', 'Inactive'),
-    ('8d9be2e2-3c3f-44e8-8e91-55a1c214697e', 'Emmy', 'Noether', 'e.noether@uni-goettingen.de', 'Customer', 'Active'),
-    ('1185d5d5-bf0d-4c5b-8534-cad0d2a4c4dd', 'Max', 'Born', 'm.born@uni-goe.de', 'Admin', 'Active'),
-    ('695b8e51-0356-4693-a3f3-f3b8ce4209e6', 'George', 'Boole', 'g.boole@qub.ac.uk', 'Customer', 'Active'),
-    ('34382b8a-3c3d-4242-b496-85b9e329b1f9', 'Jocelyn', 'Bell Burnell', 'j.b.burnell@qmul.ac.uk', 'Customer', 'Active'),
-    ('e36d70cc-1b94-40df-93b5-8b38f48e65e1', 'George', 'Gamow', 'g.gamow@ucolorado.edu', 'Customer', 'Active'),
-    ('f1df7a26-f741-4153-b88f-ae30cc35d26c', 'Maria', 'Mayer', 'm.mayer@uchicago.edu', 'Admin', 'Active'),
-    ('600fd56e-0cf5-4d1b-8d72-6f7575f3474b', 'Subrahmanyan', 'Chandrasekhar', 's.chandra@uchicago.edu', 'Customer', 'Active'),
-    ('9fc25b09-8c23-4977-9e3f-3d2e4840b11a', 'Andrei', 'Sakharov', 'a.sakharov@yandex.ru', 'Admin', 'Inactive'),
-    ('e60e7ed6-4a89-4d8f-9a50-0e19f14d63b8', 'Shirley', 'Tilghman', 's.tilghman@princeton.edu', 'Customer', 'Active'),
-    ('10f900b1-d255-4f8a-b48c-23c9d9e37a2b', 'Freeman', 'Dyson', 'f.dyson@ias.edu', 'Admin', 'Active'),
-    ('9f9566bb-49a8-4e0c-82d2-153b3098ee06', 'Mary', 'Somerville', 'm.somerville@ucam.edu', 'Customer', 'Active'),
-    ('6f4687a4-4f3c-4ef1-ba60-02a8a0cde6d2', 'Edmund', 'Halley', 'e.halley@cam.ac.uk', 'Admin', 'Inactive'),
-    ('c77f2e4d-4bde-4a7a-b00c-9e7a02f3125f', 'Brian', 'Greene', 'b.greene@columbia.edu', 'Customer', 'Active'),
-    ('6952022f-2b71-47b5-8d75-cd935fe86ec8', 'Hans', 'Bethe', 'h.bethe@cornell.edu', 'Admin', 'Active'),
-    ('901ea1c6-0718-47c5-9b5d-6345de5d1af3', 'John', 'Polkinghorne', 'j.polkinghorne@quns.cam.ac.uk', 'Customer', 'Active'),
-    ('b0752e34-6d0d-4f50-8c3b-98f2567a87ec', 'Edward', 'Witten', 'e.witten@ias.edu', 'Admin', 'Inactive');
+    ('d359514d-4bd6-4f9a-b9c9-9deaf4e08969', 'Richard', 'Feynman', 'richard.feynman@caltech.edu.us', 'Customer', 'Active', NOW()),
+    ('b6dcf3b3-fd6f-4b4e-8d4a-3d6883a0a128', 'Marie', 'Curie', 'm.curie@sorbonne.fr', 'Admin', 'Active', NOW()),
+    ('44ed118f-ec0a-4d15-98e7-794b10d8cbde', 'Stephen', 'Hawking', 's.hawking@cam.ac.uk', 'Customer', 'Inactive', NOW()),
+    ('1f8a2c92-14d7-448c-9f31-9c9a520a20b1', 'Alan', 'Turing', 'a.turing@kings.cam.ac.uk', 'Admin', 'Active', NOW()),
+    ('59a58b25-b141-4e27-ae97-53d1ab7f2d91', 'Ada', 'Lovelace', 'a.lovelace@ucl.ac.uk', 'Customer', 'Active', NOW()),
+    ('b2f6eaa5-f252-4066-9f6d-1866845f5eab', 'Isaac', 'Newton', 'i.newton@cam.ac.uk', 'Admin', 'Inactive', NOW()),
+    ('dca782fb-1530-4da2-a0e7-66bb663f76b4', 'Galileo', 'Galilei', 'g.galilei@unipi.it', 'Customer', 'Active', NOW()),
+    ('a29b1af4-9650-4379-8a8b-7f6c4d374e7f', 'Nikola', 'Tesla', 'n.tesla@columbia.edu', 'Admin', 'Inactive', NOW()),
+    ('c63d543a-758e-4de1-ae38-72165e9d9aa5', 'Max', 'Planck', 'm.planck@berlin.mpg.de', 'Customer', 'Active', NOW()),
+    ('9a99d28e-1325-456e-a75d-26290e6c64e6', 'Erwin', 'Schrödinger', 'e.schrodinger@univie.ac.at', 'Admin', 'Active', NOW()),
+    ('48a14728-6aa9-428d-a5c8-39a97c5d5f5a', 'Werner', 'Heisenberg', 'w.heisenberg@mpq.mpg.de', 'Customer', 'Active', NOW()),
+    ('237a063a-cb8f-4e9e-9347-348f2778b8f7', 'Albert', 'Einstein', 'a.einstein@ias.edu', 'Admin', 'Inactive', NOW()),
+    ('84aa2ea1-81e8-4950-97b5-57eab940f72d', 'Carl', 'Sagan', 'c.sagan@cornell.edu', 'Customer', 'Active', NOW()),
+    ('5e5d0d62-8fc2-4b4f-87b4-4b8e7b94a387', 'Charles', 'Darwin', 'c.darwin@cam.ac.uk', 'Customer', 'Active', NOW()),
+    ('1266f2a6-293d-4b10-9e4e-9ec25882fc72', 'Louis', 'Pasteur', 'l.pasteur@pasteur.fr', 'Admin', 'Active', NOW()),
+    ('9f2c5d5e-7e80-469c-88b5-23d5d7aa5de5', 'Robert', 'Boyle', 'r.boyle@ox.ac.uk', 'Customer', 'Inactive', NOW()),
+    ('70736b1d-ff8c-43b6-af96-33a6242f0688', 'Antoine', 'Lavoisier', 'a.lavoisier@ens.fr', 'Admin', 'Active', NOW()),
+    ('88c8c231-8836-4b6d-bb6d-8f9561dd342d', 'Ernest', 'Rutherford', 'e.rutherford@manchester.ac.uk', 'Customer', 'Active', NOW()),
+    ('6f1d72b4-6b63-4c8c-8360-cad4a63ce05b', 'Wilhelm', 'Röntgen', 'w.roentgen@uni-wuerzburg.de', 'Admin', 'Inactive', NOW()),
+    ('1ba2b9e9-0fb2-483d-8597-f0a6bfa7f71f', 'Niels', 'Bohr', 'n.bohr@nbi.ku.dk', 'Customer', 'Active', NOW()),
+    ('212b3602-34d9-441c-9f8a-cc63d67b1d7a', 'James', 'Clerk Maxwell', 'j.c.maxwell@ed.ac.uk', 'Admin', 'Active', NOW()),
+    ('dbf7fb1d-3b72-48f3-9f85-95453d2f7a8d', 'John', 'Dalton', 'j.dalton@manchester.ac.uk', 'Customer', 'Active', NOW()),
+    ('14682b91-e04a-4357-bdbd-a48d3d82bdc3', 'Gregor', 'Mendel', 'g.mendel@brno.cz', 'Admin', 'Inactive', NOW()),
+    ('85fbd74e-ced9-432a-9fb4-6b929dd6b4e6', 'Michael', 'Faraday', 'm.faraday@ri.ac.uk', 'Customer', 'Active', NOW()),
+    ('e1c4d4af-4e4e-4be2-8b9a-1f0c6f390775', 'Christiaan', 'Huygens', 'c.huygens@tudelft.nl', 'Admin', 'Active', NOW()),
+    ('91b74551-cbb7-46a1-96f7-d8a3e54f9ab9', 'Blaise', 'Pascal', 'b.pascal@clermond-ferrand.fr', 'Customer', 'Active', NOW()),
+    ('d9a862f9-2c25-49d2-80c9-3b93d3f2a9e6', 'Gottfried', 'Leibniz', 'g.leibniz@unihannover.de', 'Admin', 'Inactive', NOW()),
+    ('9df27e36-6c34-461d-975d-5d3073173b24', 'Alessandro', 'Volta', 'a.volta@unipv.it', 'Customer', 'Active', NOW()),
+    ('38f0e14c-200d-4d9e-9a62-4bb8e4d1a027', 'Joseph', 'Priestley', 'j.priestley@birmingham.ac.uk', 'Admin', 'Active', NOW()),
+    ('97e03d70-7a2a-4718-b44a-60b55c158e1a', 'Benjamin', 'Franklin', 'b.franklin@upenn.edu', 'Customer', 'Active', NOW()),
+    ('62d9f6c3-d6e3-4e72-a27b-42afaa12c6e7', 'Robert', 'Hooke', 'r.hooke@royalsociety.org.uk', 'Admin', 'Inactive', NOW()),
+    ('f8a200b4-665f-4c9f-bbca-4b4e5ee59b5d', 'André-Marie', 'Ampère', 'a.ampere@ens-lyon.fr', 'Customer', 'Active', NOW()),
+    ('52cb5cf5-888d-404f-a321-7c1c5b2c54b6', 'Enrico', 'Fermi', 'e.fermi@uniroma1.it', 'Admin', 'Active', NOW()),
+    ('e0bcb4ec-8264-4ebf-844d-f4631e2b6154', 'Pierre-Simon', 'Laplace', 'p.laplace@ens.fr', 'Customer', 'Active', NOW()),
+    ('06dbf83e-c07a-41c4-9bb9-d4c12633ad1f', 'William', 'Herschel', 'w.herschel@bath.ac.uk', 'Admin', 'Inactive', NOW()),
+    ('04e8df09-7f91-4cbf-87a1-60f5275c7b5d', 'Carolus', 'Linnaeus', 'c.linnaeus@uu.se', 'Customer', 'Active', NOW()),
+    ('7c101ced-02f0-4165-8f79-25b85e5f1c7f', 'Johannes', 'Kepler', 'j.kepler@taufkirchen.de', 'Admin', 'Active', NOW()),
+    ('59f71ee1-2710-4f9d-b4f4-bad7311a8fda', 'Daniel', 'Bernoulli', 'd.bernoulli@unibas.ch', 'Customer', 'Active', NOW()),
+    ('8b3a3ea1-b67d-4e13-8fb5-5bf3b7c5e5f5', 'Georges', 'Cuvier', 'g.cuvier@mnhn.fr', 'Admin', 'Inactive', NOW()),
+    ('aefa8bd9-1a7c-4c2b-909c-2c4c392f4d32', 'William', 'Harvey', 'w.harvey@kcl.ac.uk', 'Customer', 'Active', NOW()),
+    ('a4a4c4ea-ae4b-4e8f-a0b1-21c820f7e0d8', 'Edward', 'Jenner', 'e.jenner@imperial.ac.uk', 'Admin', 'Active', NOW()),
+    ('b24c4912-19fb-4399-b3ea-7aae2b2ebdba', 'Charles-Augustin', 'Coulomb', 'c.coulomb@polytechnique.edu', 'Customer', 'Active', NOW()),
+    ('5c6e34e6-e1e6-44d6-9c4a-78b354a47e8d', 'Tycho', 'Brahe', 't.brahe@utsc.utoronto.ca', 'Admin', 'Inactive', NOW()),
+    ('80dd94cb-7643-44e7-8c04-3fa7b9d3c3f7', 'Hermann', 'von Helmholtz', 'h.helmholtz@physik.uni-berlin.de', 'Customer', 'Active', NOW()),
+    ('406015bb-7f2a-49ed-a51c-b5f5aa6d9ec1', 'Hans', 'Geiger', 'h.geiger@uni-kiel.de', 'Admin', 'Active', NOW()),
+    ('6f0913f3-eb3e-4f7a-a72c-71bc9e1baf22', 'Joseph-Louis', 'Lagrange', 'j.l.lagrange@academie-sciences.fr', 'Customer', 'Active', NOW()),
+    ('6b0be8c1-6e05-4ab9-9207-1c8de51c7a68', 'Ernst', 'Mach', 'e.mach@univie.ac.at', 'Admin', 'Inactive', NOW()),
+    ('a4c4b3e4-07ce-427c-a311-b60c1f8581ad', 'Ludwig', 'Boltzmann', 'l.boltzmann@tuwien.ac.at', 'Customer', 'Active', NOW()),
+    ('fc183d05-8709-46c9-bcbf-f031d1f8a947', 'Giovanni', 'Alfonso Borelli', 'g.a.borelli@uniroma1.it', 'Admin', 'Active', NOW()),
+    ('ebc3da3e-af67-4c8d-9b1c-0dfcc13ec0fd', 'John', 'Herschel', 'j.herschel@joh.cam.ac.uk', 'Customer', 'Active', NOW()),
+    ('7a2769e3-8127-4e48-b0a7-62a9586a4077', 'Johann', 'Lambert', 'j.lambert@uni-freiburg.de', 'Customer', 'Active', NOW()),
+    ('e6f83a92-3c8a-470d-89a6-37c6b891f8d8', 'Rudolf', 'Clausius', 'r.clausius@unibonn.de', 'Admin', 'Active', NOW()),
+    ('a201f757-7a1c-44a5-9548-9a9d7c27caed', 'Hans', 'Christian Ørsted', 'h.c.orsted@ou.dk', 'Customer', 'Active', NOW()),
+    ('aefc8243-3d3e-4a2a-aa4c-41a1a854a8d4', 'Svante', 'Arrhenius', 's.arrhenius@su.se', 'Admin', 'Inactive', NOW()),
+    ('5b5d41e5-180c-4485-a98f-69e06b1f7a6c', 'Pafnuty', 'Chebyshev', 'p.chebyshev@msu.ru', 'Customer', 'Active', NOW()),
+    ('42b3d078-1052-4297-9866-48bde9980078', 'Arthur', 'Eddington', 'a.eddington@cam.ac.uk', 'Admin', 'Active', NOW()),
+    ('6a48a6c3-b6f2-4c67-bba4-c4557e98b20f', 'Gabriel', 'Daniel Fahrenheit', 'g.d.fahrenheit@wroc.pl', 'Customer', 'Active', NOW()),
+    ('e99ad6a4-9be9-4dcb-b4c3-02e1e4c8256f', 'Johannes', 'Stark', 'j.stark@uni-greifswald.de', 'Admin', 'Inactive', NOW()),
+    ('00d10c91-91a8-4cd9-9b60-c1d7c90557c8', 'Amedeo', 'Avogadro', 'a.avogadro@unito.it', 'Customer', 'Active', NOW()),
+    ('87b5f5b5-49dc-472e-b3f8-5e9e9c5e5d68', 'George', 'Simon Ohm', 'g.s.ohm@fau.de', 'Admin', 'Active', NOW()),
+    ('f4c2a689-c1a2-43c2-b7b7-64304d9977b9', 'Wilhelm', 'Ostwald', 'w.ostwald@uni-leipzig.de', 'Customer', 'Active', NOW()),
+    ('9e190be2-ff2d-435a-964f-f5b5d10b5f3c', 'William', 'Rankine', 'w.rankine@uofg.edu', 'Admin', 'Inactive', NOW()),
+    ('6775a5c3-3f2f-4eab-8b90-72c551fc33ec', 'Edwin', 'Hubble', 'e.hubble@mtwilson.edu', 'Customer', 'Active', NOW()),
+    ('d37fbae9-85b1-4d02-865f-a1edc8d7abfa', 'Albert', 'Michelson', 'a.michelson@uchicago.edu', 'Admin', 'Active', NOW()),
+    ('70f441da-3f3a-43df-bd7f-38371e2a6f4d', 'Lise', 'Meitner', 'l.meitner@kth.se', 'Admin', 'Inactive', NOW()),
+    ('db66eb3a-f3c3-41e9-9c57-0c2ca0eb879f', 'James', 'Chadwick', 'j.chadwick@liv.ac.uk', 'Admin', 'Active', NOW()),
+    ('a0890130-0cd2-4b48-9d2c-dbb7fbd71179', 'Robert', 'Millikan', 'r.millikan@caltech.edu', 'Customer', 'Active', NOW()),
+    ('63ed9387-1a2c-404f-81e2-cbc44148db6d', 'Hendrik', 'Lorentz', 'h.lorentz@science.ru.nl', 'Admin', 'Inactive', NOW()),
+    ('4e4a3173-aa67-4ad7-af56-8b3e3ebec3c3', 'Paul', 'Dirac', 'p.dirac@phy.cam.ac.uk', 'Admin', 'Active', NOW()),
+    ('03b2a43b-60d9-4919-b9bb-032f03b634ab', 'Roger', 'Penrose', 'r.penrose@maths.ox.ac.uk', 'Admin', 'Inactive', NOW()),
+    ('f6188df6-5f63-4ee0-8cb5-5f5b13c5b0ad', 'Vera', 'Rubin', 'v.rubin@carnegiescience.edu', 'Customer', 'Active', NOW()),
+    ('d9f7f2f8-38fd-4356-a715-58a21f758fe3', 'Neil', 'Turok', 'n.turok@perimeterinstitute.ca', 'Admin', 'Active', NOW()),
+    ('3f5fb75c-7545-42d5-a2ce-822ae12f035a', 'Saul', 'Perlmutter', 's.perlmutter@lbl.gov', 'Customer', 'Active', NOW()),
+    ('01f41eb6-682d-4f0d-8f25-35d7c1f44292', 'Ada', 'Yonath', 'a.yonath@weizmann.ac.il', 'Admin', 'Inactive', NOW()),
+    ('e06b0d08-5af1-4a0f-9365-93e5d5dd28de', 'Peter', 'Higgs', 'p.higgs@ed.ac.uk', 'Customer', 'Active', NOW()),
+    ('7556fae4-4c1f-42d3-8e4d-4c01ad9a9d8c', 'Francois', 'Englert', 'f.englert@ulb.ac.be', 'Admin', 'Active', NOW()),
+    ('8e0ceaa4-22c1-41fb-bddf-7f41a044d1aa', 'Takaaki', 'Kajita', 't.kajita@icrr.u-tokyo.ac.jp', 'Customer', 'Active', NOW()),
+    ('88f20c9b-1d3d-4753-bf5f-96bfe1450632', 'Yoichiro', 'Nambu', 'y.nambu@uchicago.edu', 'Admin', 'Inactive', NOW()),
+    ('f8c40108-a41b-422e-a48a-5515b3d5d5e5', 'Kip', 'Thorne', 'k.thorne@caltech.edu', 'Customer', 'Active', NOW()),
+    ('b0c71b2d-b41c-4e61-81f8-0c9cf9d7d56c', 'Serge', 'Haroche', 's.haroche@college-de-france.fr', 'Admin', 'Inactive', NOW()),
+    ('5b5c6f15-2a06-4d61-9da5-81671ce003a9', 'John', 'Mather', 'j.mather@nasa.gov', 'Admin', 'Active', NOW()),
+    ('9aa9c7d4-1a81-4ba5-8b5d-54e0d9c9cbdf', 'Niels', 'Henrik Abel', 'n.h.abel@uio.no', 'Admin', 'Inactive', NOW()),
+    ('8d9be2e2-3c3f-44e8-8e91-55a1c214697e', 'Emmy', 'Noether', 'e.noether@uni-goettingen.de', 'Customer', 'Active', NOW()),
+    ('1185d5d5-bf0d-4c5b-8534-cad0d2a4c4dd', 'Max', 'Born', 'm.born@uni-goe.de', 'Admin', 'Active', NOW()),
+    ('695b8e51-0356-4693-a3f3-f3b8ce4209e6', 'George', 'Boole', 'g.boole@qub.ac.uk', 'Customer', 'Active', NOW()),
+    ('34382b8a-3c3d-4242-b496-85b9e329b1f9', 'Jocelyn', 'Bell Burnell', 'j.b.burnell@qmul.ac.uk', 'Customer', 'Active', NOW()),
+    ('e36d70cc-1b94-40df-93b5-8b38f48e65e1', 'George', 'Gamow', 'g.gamow@ucolorado.edu', 'Customer', 'Active', NOW()),
+    ('f1df7a26-f741-4153-b88f-ae30cc35d26c', 'Maria', 'Mayer', 'm.mayer@uchicago.edu', 'Admin', 'Active', NOW()),
+    ('600fd56e-0cf5-4d1b-8d72-6f7575f3474b', 'Subrahmanyan', 'Chandrasekhar', 's.chandra@uchicago.edu', 'Customer', 'Active', NOW()),
+    ('9fc25b09-8c23-4977-9e3f-3d2e4840b11a', 'Andrei', 'Sakharov', 'a.sakharov@yandex.ru', 'Admin', 'Inactive', NOW()),
+    ('e60e7ed6-4a89-4d8f-9a50-0e19f14d63b8', 'Shirley', 'Tilghman', 's.tilghman@princeton.edu', 'Customer', 'Active', NOW()),
+    ('10f900b1-d255-4f8a-b48c-23c9d9e37a2b', 'Freeman', 'Dyson', 'f.dyson@ias.edu', 'Admin', 'Active', NOW()),
+    ('9f9566bb-49a8-4e0c-82d2-153b3098ee06', 'Mary', 'Somerville', 'm.somerville@ucam.edu', 'Customer', 'Active', NOW()),
+    ('6f4687a4-4f3c-4ef1-ba60-02a8a0cde6d2', 'Edmund', 'Halley', 'e.halley@cam.ac.uk', 'Admin', 'Inactive', NOW()),
+    ('c77f2e4d-4bde-4a7a-b00c-9e7a02f3125f', 'Brian', 'Greene', 'b.greene@columbia.edu', 'Customer', 'Active', NOW()),
+    ('6952022f-2b71-47b5-8d75-cd935fe86ec8', 'Hans', 'Bethe', 'h.bethe@cornell.edu', 'Admin', 'Active', NOW()),
+    ('901ea1c6-0718-47c5-9b5d-6345de5d1af3', 'John', 'Polkinghorne', 'j.polkinghorne@quns.cam.ac.uk', 'Customer', 'Active', NOW()),
+    ('b0752e34-6d0d-4f50-8c3b-98f2567a87ec', 'Edward', 'Witten', 'e.witten@ias.edu', 'Admin', 'Inactive', NOW());
+-- Every seeded user holds a long standing license, so they can book any car
+UPDATE users SET
+    date_of_birth = '1970-01-01',
+    driver_license_number = upper(substr(md5(id::text), 1, 10)),
+    driver_license_country = 'US',
+    driver_license_issue_date = '1990-01-01',
+    driver_license_expiry_date = '2040-01-01';
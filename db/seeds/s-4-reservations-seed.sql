@@ -65,3 +65,9 @@ FROM temp_reservations;
 
 -- Clean up the temporary table
 DROP TABLE temp_reservations;
+
+-- Price the generated reservations with the hourly rent of their cars
+UPDATE reservations r
+SET rental_cost = ROUND(CEIL(EXTRACT(EPOCH FROM (r.end_date - r.start_date)) / 3600) * c.hourly_rent_cost, 2)
+FROM cars c
+WHERE c.id = r.car_id;
@@ -0,0 +1,66 @@
+INSERT INTO branches (id, city_id, name, address, latitude, longitude)
+VALUES
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Chicago Downtown', '55 W Randolph St, Chicago, IL 60601', 41.883700, -87.628900),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', '1105a953-1dfe-470a-b6e7-f97f004f440b', 'Chicago O''Hare Airport', '10000 W O''Hare Ave, Chicago, IL 60666', 41.978600, -87.904800),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'New York Midtown', '10 W 40th St, New York, NY 10018', 40.754900, -73.984000),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'New York JFK Airport', 'JFK Access Rd, Jamaica, NY 11430', 40.641300, -73.778100),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Los Angeles Downtown', '350 S Grand Ave, Los Angeles, CA 90071', 34.052200, -118.243700),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'Los Angeles LAX Airport', '9020 Aviation Blvd, Inglewood, CA 90301', 33.941600, -118.408500);
+
+INSERT INTO branch_opening_hours (branch_id, weekday, opens_at, closes_at)
+VALUES
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 0, '08:00', '18:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 1, '07:00', '21:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 2, '07:00', '21:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 3, '07:00', '21:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 4, '07:00', '21:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 5, '07:00', '21:00'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 6, '08:00', '18:00'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 0, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 1, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 2, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 3, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 4, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 5, '05:00', '23:59'),
+    ('8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 6, '05:00', '23:59'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 0, '08:00', '18:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 1, '07:00', '21:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 2, '07:00', '21:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 3, '07:00', '21:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 4, '07:00', '21:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 5, '07:00', '21:00'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 6, '08:00', '18:00'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 0, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 1, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 2, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 3, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 4, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 5, '05:00', '23:59'),
+    ('b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 6, '05:00', '23:59'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 0, '08:00', '18:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 1, '07:00', '21:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 2, '07:00', '21:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 3, '07:00', '21:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 4, '07:00', '21:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 5, '07:00', '21:00'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 6, '08:00', '18:00'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 0, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 1, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 2, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 3, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 4, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 5, '05:00', '23:59'),
+    ('c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 6, '05:00', '23:59');
+
+INSERT INTO branch_closures (branch_id, date, reason)
+VALUES
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', '2030-12-25', 'Christmas Day'),
+    ('3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', '2031-01-01', 'New Year''s Day'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', '2030-12-25', 'Christmas Day'),
+    ('5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', '2031-01-01', 'New Year''s Day'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', '2030-12-25', 'Christmas Day'),
+    ('e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', '2031-01-01', 'New Year''s Day');
+
+UPDATE cars SET branch_id = CASE WHEN id::text < '8' THEN '3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31'::uuid ELSE '8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0'::uuid END WHERE city_id = '1105a953-1dfe-470a-b6e7-f97f004f440b';
+UPDATE cars SET branch_id = CASE WHEN id::text < '8' THEN '5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24'::uuid ELSE 'b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9'::uuid END WHERE city_id = 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b';
+UPDATE cars SET branch_id = CASE WHEN id::text < '8' THEN 'e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68'::uuid ELSE 'c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20'::uuid END WHERE city_id = 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a';
@@ -0,0 +1,6 @@
+INSERT INTO one_way_fees (from_city_id, to_city_id, fee, currency)
+VALUES
+    ('1105a953-1dfe-470a-b6e7-f97f004f440b', 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 150.00, 'USD'),
+    ('f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', '1105a953-1dfe-470a-b6e7-f97f004f440b', 150.00, 'USD'),
+    ('ede18d97-0f24-4bea-a0fb-c3896fcccd1a', '1105a953-1dfe-470a-b6e7-f97f004f440b', 275.00, 'USD'),
+    ('1105a953-1dfe-470a-b6e7-f97f004f440b', 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 275.00, 'USD');
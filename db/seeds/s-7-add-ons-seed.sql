@@ -0,0 +1,11 @@
+INSERT INTO add_ons (id, branch_id, name, stock, price, pricing_unit, currency)
+VALUES
+    ('4c1e7a93-2b5d-4f8e-9a6c-0d3b5e7f9a12', '3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 'Child Seat', 6, 9.00, 'Per Day', 'USD'),
+    ('7e3a9c15-4d6f-4b2a-8c0e-1f5a7b9d3c24', '3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 'GPS Unit', 4, 7.50, 'Per Day', 'USD'),
+    ('a2f4c6e8-1b3d-4e5f-9a7c-2d4f6b8e0a36', '3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31', 'Roof Rack', 2, 35.00, 'Per Rental', 'USD'),
+    ('d5b7e9a1-3c2f-4a6d-8e0b-4f6a8c0e2b48', '8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 'Child Seat', 10, 9.00, 'Per Day', 'USD'),
+    ('f8d0a2c4-5e7b-4c9a-a1d3-6b8e0f2a4c50', '8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 'GPS Unit', 8, 7.50, 'Per Day', 'USD'),
+    ('1a3c5e7f-9b2d-4f6a-b8c0-7e9a1c3e5f62', '5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24', 'Child Seat', 5, 11.00, 'Per Day', 'USD'),
+    ('3c5e7a9b-0d4f-4a8c-9e2a-8f0b2d4f6a74', 'b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 'GPS Unit', 6, 8.50, 'Per Day', 'USD'),
+    ('6e8a0c2d-4f1b-4d3e-8a5c-9b1d3f5a7c86', 'e1a3c5b7-4f6d-4a8e-9c2b-7d9f1e3a5c68', 'Roof Rack', 3, 40.00, 'Per Rental', 'USD'),
+    ('9a1c3e5f-7b0d-4f2a-9c4e-0a2c4e6b8d98', 'c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 'Child Seat', 8, 10.00, 'Per Day', 'USD');
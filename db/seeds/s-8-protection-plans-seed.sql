@@ -0,0 +1,23 @@
+INSERT INTO protection_plans (id, level, car_type, daily_price, deductible, currency)
+VALUES
+    ('14408e8d-2106-4cc9-bb05-3a7ebdb9829f', 'Basic', 'Sedan', 12.00, 1500.00, 'USD'),
+    ('d4f42a99-2f26-43ef-be35-f8193988a9ae', 'Standard', 'Sedan', 19.00, 800.00, 'USD'),
+    ('ef72f6e2-b93b-479c-aabc-2dc3c8d6572a', 'Full', 'Sedan', 28.00, 0.00, 'USD'),
+    ('d2c51d80-1d46-471d-bf7e-d07adaabec3c', 'Basic', 'Luxury', 20.00, 2500.00, 'USD'),
+    ('1df1c8a1-23ca-47e0-a632-4c6df0c0b7ea', 'Standard', 'Luxury', 32.00, 1200.00, 'USD'),
+    ('706ae627-9a1a-48e2-924c-f0d707906920', 'Full', 'Luxury', 45.00, 0.00, 'USD'),
+    ('bbafbd24-16a2-4f73-a6c4-0af81c741390', 'Basic', 'Sports Car', 25.00, 3000.00, 'USD'),
+    ('7fdab6f3-674b-45ce-bac2-5f41800db819', 'Standard', 'Sports Car', 40.00, 1500.00, 'USD'),
+    ('1bbb986c-5ca4-4a8a-824d-a0082d2ecfee', 'Full', 'Sports Car', 58.00, 0.00, 'USD'),
+    ('bcc3cc41-5a76-4a65-8089-d5871cdd1287', 'Basic', 'Limousine', 30.00, 3500.00, 'USD'),
+    ('09e685c4-b4d4-40d1-85cd-27fd1998c1f1', 'Standard', 'Limousine', 48.00, 1800.00, 'USD'),
+    ('b8febdf7-7ba4-4610-89e2-96963501fcdf', 'Full', 'Limousine', 65.00, 0.00, 'USD');
+-- Seeded reservations are booked with the basic plan of their car type
+UPDATE reservations r
+SET protection_level = p.level,
+    protection_daily_price = p.daily_price,
+    protection_deductible = p.deductible,
+    protection_cost = CEIL(EXTRACT(EPOCH FROM (r.end_date - r.start_date)) / 86400) * p.daily_price
+FROM cars c
+JOIN protection_plans p ON p.car_type = c.type AND p.level = 'Basic'
+WHERE c.id = r.car_id;
@@ -0,0 +1,9 @@
+INSERT INTO tax_rules (id, city_id, branch_id, name, jurisdiction, rate, applies_to, rounding)
+VALUES
+    ('0b6f3d2e-8a41-4c7b-9e15-6d2a8f4c1b73', '1105a953-1dfe-470a-b6e7-f97f004f440b', NULL, 'Sales tax', 'Illinois', 0.1025, '{Rental,Add-ons,Protection,Fees}', 'Half Up'),
+    ('5a2c7e91-3d4b-4f68-a1c9-e7b5d3f0a826', '1105a953-1dfe-470a-b6e7-f97f004f440b', NULL, 'Tourism levy', 'City of Chicago', 0.0900, '{Rental}', 'Half Up'),
+    ('c83e1f5a-6b9d-4a27-8e40-2f7c9b1d5e64', '1105a953-1dfe-470a-b6e7-f97f004f440b', '8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0', 'Airport surcharge', 'Chicago Department of Aviation', 0.1100, '{Rental}', 'Up'),
+    ('e4d9a7b2-1c6f-4e83-b5a0-9f2e6c8d3a17', 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', NULL, 'Sales tax', 'New York', 0.08875, '{Rental,Add-ons,Protection,Fees}', 'Half Up'),
+    ('7f1b5c3d-9e2a-4d60-8c74-a3e1f6b9d205', 'f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b', 'b2c4e6a8-9d1f-4b3e-a5c7-e9f1a3b5d7c9', 'Airport surcharge', 'Port Authority of New York and New Jersey', 0.1000, '{Rental,Add-ons}', 'Half Even'),
+    ('2d8e4a6c-5b3f-4197-9a1e-c6f8d2b4e031', 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', NULL, 'Sales tax', 'California', 0.0950, '{Rental,Add-ons,Protection}', 'Half Up'),
+    ('9c5a3e7f-2b1d-4f84-a6e2-d0b8c4f6a159', 'ede18d97-0f24-4bea-a0fb-c3896fcccd1a', 'c9e7a5b3-1d2f-4e6a-8b4c-5f7d9a1c3e20', 'Airport surcharge', 'Los Angeles World Airports', 0.1111, '{Rental}', 'Down');
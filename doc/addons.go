@@ -0,0 +1,37 @@
+package docs
+
+import "github.com/google/uuid"
+
+type AddOnRequest struct {
+	BranchID    uuid.UUID `json:"branch_id" example:"3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"`
+	Name        string    `json:"name" example:"Child seat"`
+	Stock       int       `json:"stock" example:"6"`
+	Price       float64   `json:"price" example:"12.5"`
+	Currency    string    `json:"currency" example:"USD"`
+	PricingUnit string    `json:"pricing_unit" example:"Per Day"`
+}
+
+type AddOnResponse struct {
+	ID          uuid.UUID `json:"id" example:"9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"`
+	BranchID    uuid.UUID `json:"branch_id" example:"3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"`
+	Name        string    `json:"name" example:"Child seat"`
+	Stock       int       `json:"stock" example:"6"`
+	Price       float64   `json:"price" example:"12.5"`
+	Currency    string    `json:"currency" example:"USD"`
+	PricingUnit string    `json:"pricing_unit" example:"Per Day"`
+}
+
+type ListAddOnsResponse struct {
+	AddOns []AddOnResponse `json:"add_ons"`
+}
+
+type ReservationAddOnRequest struct {
+	AddOnID  uuid.UUID `json:"add_on_id" example:"9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"`
+	Quantity int       `json:"quantity" example:"1"`
+}
+
+type ReservationAddOnResponse struct {
+	AddOnID  uuid.UUID `json:"add_on_id" example:"9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"`
+	Quantity int       `json:"quantity" example:"1"`
+	Cost     float64   `json:"cost" example:"100"`
+}
@@ -0,0 +1,39 @@
+package docs
+
+import "github.com/google/uuid"
+
+type BranchRequest struct {
+	CityID       uuid.UUID             `json:"city_id" example:"1105a953-1dfe-470a-b6e7-f97f004f440b"`
+	Name         string                `json:"name" example:"Chicago Downtown"`
+	Address      string                `json:"address" example:"55 W Randolph St, Chicago, IL 60601"`
+	Latitude     float64               `json:"latitude" example:"41.8837"`
+	Longitude    float64               `json:"longitude" example:"-87.6289"`
+	OpeningHours []OpeningHoursRequest `json:"opening_hours"`
+	Closures     []BranchClosure       `json:"closures"`
+}
+
+type OpeningHoursRequest struct {
+	Weekday  int    `json:"weekday" example:"1"`
+	OpensAt  string `json:"opens_at" example:"07:00"`
+	ClosesAt string `json:"closes_at" example:"21:00"`
+}
+
+type BranchClosure struct {
+	Date   string `json:"date" example:"2030-12-25"`
+	Reason string `json:"reason" example:"Christmas Day"`
+}
+
+type BranchResponse struct {
+	ID           uuid.UUID             `json:"id" example:"3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"`
+	CityID       uuid.UUID             `json:"city_id" example:"1105a953-1dfe-470a-b6e7-f97f004f440b"`
+	Name         string                `json:"name" example:"Chicago Downtown"`
+	Address      string                `json:"address" example:"55 W Randolph St, Chicago, IL 60601"`
+	Latitude     float64               `json:"latitude" example:"41.8837"`
+	Longitude    float64               `json:"longitude" example:"-87.6289"`
+	OpeningHours []OpeningHoursRequest `json:"opening_hours"`
+	Closures     []BranchClosure       `json:"closures"`
+}
+
+type ListBranchesResponse struct {
+	Branches []BranchResponse `json:"branches"`
+}
@@ -3,11 +3,22 @@ package docs
 import "github.com/google/uuid"
 
 type CarRequest struct {
-	Type           string  `json:"type" example:"Luxury"`
-	Seats          int16   `json:"seats" example:"4"`
-	HourlyRentCost float64 `json:"hourly_rent_cost" example:"99.99"`
-	CityName       string  `json:"city_name" example:"New York"`
-	Status         string  `json:"status" example:"Available"`
+	Type           string     `json:"type" example:"Luxury"`
+	Seats          int16      `json:"seats" example:"4"`
+	HourlyRentCost float64    `json:"hourly_rent_cost" example:"99.99"`
+	Currency       string     `json:"currency" example:"USD"`
+	CityName       string     `json:"city_name" example:"New York"`
+	Status         string     `json:"status" example:"Available"`
+	Make           string     `json:"make" example:"Mercedes-Benz"`
+	Model          string     `json:"model" example:"E-Class"`
+	Year           int16      `json:"year" example:"2022"`
+	LicensePlate   string     `json:"license_plate" example:"NYC4821"`
+	VIN            string     `json:"vin" example:"WDDZF4JB0KA512345"`
+	Transmission   string     `json:"transmission" example:"Automatic"`
+	FuelType       string     `json:"fuel_type" example:"Hybrid"`
+	Color          string     `json:"color" example:"Black"`
+	Features       []string   `json:"features" example:"A/C,GPS,Bluetooth"`
+	BranchID       *uuid.UUID `json:"branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
 }
 
 type ListCarsResponse struct {
@@ -15,10 +26,45 @@ type ListCarsResponse struct {
 }
 
 type CarResponse struct {
-	ID             uuid.UUID `json:"id,omitempty" example:"bdaf243e-b4d3-49d7-8be4-5ed1fb4dba0e"`
-	Type           string    `json:"type" example:"Luxury"`
-	Seats          int16     `json:"seats" example:"4"`
-	HourlyRentCost float64   `json:"hourly_rent_cost" example:"99.99"`
-	CityName       string    `json:"city_name" example:"New York"`
-	Status         string    `json:"status" example:"Available"`
+	ID             uuid.UUID  `json:"id,omitempty" example:"bdaf243e-b4d3-49d7-8be4-5ed1fb4dba0e"`
+	Type           string     `json:"type" example:"Luxury"`
+	Seats          int16      `json:"seats" example:"4"`
+	HourlyRentCost float64    `json:"hourly_rent_cost" example:"99.99"`
+	Currency       string     `json:"currency" example:"USD"`
+	CityName       string     `json:"city_name" example:"New York"`
+	Status         string     `json:"status" example:"Available"`
+	Make           string     `json:"make" example:"Mercedes-Benz"`
+	Model          string     `json:"model" example:"E-Class"`
+	Year           int16      `json:"year" example:"2022"`
+	LicensePlate   string     `json:"license_plate" example:"NYC4821"`
+	VIN            string     `json:"vin" example:"WDDZF4JB0KA512345"`
+	Transmission   string     `json:"transmission" example:"Automatic"`
+	FuelType       string     `json:"fuel_type" example:"Hybrid"`
+	Color          string     `json:"color" example:"Black"`
+	Features       []string   `json:"features" example:"A/C,GPS,Bluetooth"`
+	BranchID       *uuid.UUID `json:"branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+}
+
+type ListNearbyCarsResponse struct {
+	Cars []NearbyCarResponse `json:"cars"`
+}
+
+type NearbyCarResponse struct {
+	CarResponse
+	PickupBranch PickupBranchResponse `json:"pickup_branch"`
+	DistanceKm   float64              `json:"distance_km" example:"3.482"`
+}
+
+type PickupBranchResponse struct {
+	ID        uuid.UUID `json:"id" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+	Name      string    `json:"name" example:"O'Hare Airport"`
+	Address   string    `json:"address" example:"10000 W O'Hare Ave"`
+	Latitude  float64   `json:"latitude" example:"41.9786"`
+	Longitude float64   `json:"longitude" example:"-87.9048"`
+}
+
+type CarWithAffectedReservationsResponse struct {
+	CarResponse
+	AffectedReservations []ReservationResponse `json:"affected_reservations"`
+	Warning              string                `json:"warning,omitempty" example:"the unavailable car has upcoming reservations"`
 }
@@ -1,5 +1,22 @@
 package docs
 
-type ListCitiesNameResponse struct {
-	CitiesName []string `json:"cities_name" example:"Chicago,Los Angeles,New York"`
+import "github.com/google/uuid"
+
+type CityRequest struct {
+	Name     string `json:"name" example:"Chicago"`
+	TimeZone string `json:"time_zone" example:"America/Chicago"`
+	Country  string `json:"country" example:"US"`
+	Currency string `json:"currency" example:"USD"`
+}
+
+type CityResponse struct {
+	ID       uuid.UUID `json:"id,omitempty" example:"a1c6b0a4-3d4e-4f5b-9a2f-1e7c3d9b8f10"`
+	Name     string    `json:"name" example:"Chicago"`
+	TimeZone string    `json:"time_zone" example:"America/Chicago"`
+	Country  string    `json:"country" example:"US"`
+	Currency string    `json:"currency" example:"USD"`
+}
+
+type ListCitiesResponse struct {
+	Cities []CityResponse `json:"cities"`
 }
@@ -0,0 +1,73 @@
+package docs
+
+import "time"
+
+type ConstantsResponse struct {
+	Version  uint64         `json:"version" example:"3"`
+	LoadedAt time.Time      `json:"loaded_at" example:"2023-05-15T10:00:00Z"`
+	Values   ConstantValues `json:"values"`
+}
+
+type ConstantValues struct {
+	CarsPerPage                 uint16              `json:"CARS_PER_PAGE" example:"20"`
+	ReservationsPerPage         uint16              `json:"RESERVATIONS_PER_PAGE" example:"20"`
+	MaintenancesPerPage         uint16              `json:"MAINTENANCES_PER_PAGE" example:"20"`
+	CitiesPerPage               uint16              `json:"CITIES_PER_PAGE" example:"20"`
+	UsersPerPage                uint16              `json:"USERS_PER_PAGE" example:"20"`
+	MinimumReservationHours     uint16              `json:"MINIMUM_RESERVATION_HOURS" example:"6"`
+	MaintenanceIntervalKm       uint32              `json:"MAINTENANCE_INTERVAL_KM" example:"10000"`
+	MaximumPhotoBytes           uint32              `json:"MAXIMUM_PHOTO_BYTES" example:"5242880"`
+	MaximumPhotoPixels          uint32              `json:"MAXIMUM_PHOTO_PIXELS" example:"40000000"`
+	ThumbnailSize               uint16              `json:"THUMBNAIL_SIZE" example:"256"`
+	MaximumSearchRadiusKm       uint16              `json:"MAXIMUM_SEARCH_RADIUS_KM" example:"50"`
+	EmailVerificationTokenHours uint16              `json:"EMAIL_VERIFICATION_TOKEN_HOURS" example:"48"`
+	PasswordResetTokenMinutes   uint16              `json:"PASSWORD_RESET_TOKEN_MINUTES" example:"60"`
+	MinimumPasswordLength       uint16              `json:"MINIMUM_PASSWORD_LENGTH" example:"8"`
+	MaximumAdditionalDrivers    uint16              `json:"MAXIMUM_ADDITIONAL_DRIVERS" example:"3"`
+	AdditionalDriverDailyFee    float64             `json:"ADDITIONAL_DRIVER_DAILY_FEE" example:"12.5"`
+	FeesCurrency                string              `json:"FEES_CURRENCY" example:"USD"`
+	DefaultProtectionLevel      string              `json:"DEFAULT_PROTECTION_LEVEL" example:"Basic"`
+	LateReturnGraceMinutes      uint16              `json:"LATE_RETURN_GRACE_MINUTES" example:"30"`
+	InspectionSkewMinutes       uint16              `json:"INSPECTION_SKEW_MINUTES" example:"15"`
+	MinimumDriverAge            uint16              `json:"MINIMUM_DRIVER_AGE" example:"18"`
+	NullUUID                    string              `json:"NULL_UUID" example:"00000000-0000-0000-0000-000000000000"`
+	DatetimeLayout              string              `json:"DATETIME_LAYOUT" example:"2006-01-02T15:04:05Z07:00"`
+	CarTypes                    map[string]string   `json:"CAR_TYPES"`
+	CarStatuses                 map[string]string   `json:"CAR_STATUSES"`
+	Transmissions               map[string]string   `json:"TRANSMISSIONS"`
+	FuelTypes                   map[string]string   `json:"FUEL_TYPES"`
+	CarFeatures                 map[string]string   `json:"CAR_FEATURES"`
+	UserTypes                   map[string]string   `json:"USER_TYPES"`
+	UserStatuses                map[string]string   `json:"USER_STATUSES"`
+	ReservationStatuses         map[string]string   `json:"RESERVATION_STATUSES"`
+	PaymentStatuses             map[string]string   `json:"PAYMENT_STATUSES"`
+	InspectionTypes             map[string]string   `json:"INSPECTION_TYPES"`
+	DamageReportStatuses        map[string]string   `json:"DAMAGE_REPORT_STATUSES"`
+	UserTokenPurposes           map[string]string   `json:"USER_TOKEN_PURPOSES"`
+	AddOnPricingUnits           map[string]string   `json:"ADD_ON_PRICING_UNITS"`
+	ProtectionLevels            map[string]string   `json:"PROTECTION_LEVELS"`
+	DepositStatuses             map[string]string   `json:"DEPOSIT_STATUSES"`
+	LedgerAccounts              map[string]string   `json:"LEDGER_ACCOUNTS"`
+	LedgerMovements             map[string]string   `json:"LEDGER_MOVEMENTS"`
+	TaxBases                    map[string]string   `json:"TAX_BASES"`
+	TaxRoundings                map[string]string   `json:"TAX_ROUNDINGS"`
+	DriverRequirements          []DriverRequirement `json:"DRIVER_REQUIREMENTS"`
+	SecurityDeposits            []SecurityDeposit   `json:"SECURITY_DEPOSITS"`
+	LateFees                    []LateFee           `json:"LATE_FEES"`
+}
+
+type DriverRequirement struct {
+	CarType             string `json:"CAR_TYPE" example:"Sports Car"`
+	MinimumAge          uint16 `json:"MINIMUM_AGE" example:"25"`
+	MinimumLicenseYears uint16 `json:"MINIMUM_LICENSE_YEARS" example:"5"`
+}
+
+type SecurityDeposit struct {
+	CarType string  `json:"CAR_TYPE" example:"Sports Car"`
+	Amount  float64 `json:"AMOUNT" example:"1500"`
+}
+
+type LateFee struct {
+	CarType   string  `json:"CAR_TYPE" example:"Sports Car"`
+	HourlyFee float64 `json:"HOURLY_FEE" example:"60"`
+}
@@ -0,0 +1,38 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DamageReportStatusRequest struct {
+	Status     string `json:"status" example:"Resolved"`
+	Resolution string `json:"resolution" example:"Rear bumper repainted"`
+}
+
+type DamagePhotoResponse struct {
+	ID           uuid.UUID `json:"id" example:"7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c"`
+	ContentType  string    `json:"content_type" example:"image/jpeg"`
+	Size         int32     `json:"size" example:"482133"`
+	URL          string    `json:"url" example:"/api/v1/damage-reports/5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60/photos/7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c"`
+	ThumbnailURL string    `json:"thumbnail_url" example:"/api/v1/damage-reports/5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60/photos/7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c/thumbnail"`
+	CreatedAt    time.Time `json:"created_at" example:"2027-05-16T18:05:00Z"`
+}
+
+type DamageReportResponse struct {
+	ID            uuid.UUID             `json:"id" example:"5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60"`
+	CarID         uuid.UUID             `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	ReservationID *uuid.UUID            `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	ReporterID    uuid.UUID             `json:"reporter_id" example:"c7d6e5f4-a3b2-4c1d-9e8f-7a6b5c4d3e2f"`
+	Description   string                `json:"description" example:"Scratch on rear bumper"`
+	Status        string                `json:"status" example:"Open"`
+	Resolution    string                `json:"resolution" example:""`
+	CreatedAt     time.Time             `json:"created_at" example:"2027-05-16T18:05:00Z"`
+	ResolvedAt    *time.Time            `json:"resolved_at"`
+	Photos        []DamagePhotoResponse `json:"photos"`
+}
+
+type DamageReportsResponse struct {
+	DamageReports []DamageReportResponse `json:"damage_reports"`
+}
@@ -0,0 +1,25 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DepositCaptureRequest struct {
+	Amount float64 `json:"amount" example:"350"`
+	Reason string  `json:"reason" example:"Dent on the rear door"`
+}
+
+type DepositResponse struct {
+	ID             uuid.UUID  `json:"id" example:"6c8e0a2b-4d6f-4a1c-9e3b-5d7f9a1c3e5b"`
+	ReservationID  uuid.UUID  `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Reference      string     `json:"reference" example:"fake_000002"`
+	Amount         float64    `json:"amount" example:"1500"`
+	Currency       string     `json:"currency" example:"USD"`
+	CapturedAmount float64    `json:"captured_amount" example:"350"`
+	CaptureReason  string     `json:"capture_reason,omitempty" example:"Dent on the rear door"`
+	Status         string     `json:"status" example:"Captured"`
+	HeldAt         time.Time  `json:"held_at" example:"2027-05-15T10:00:00Z"`
+	SettledAt      *time.Time `json:"settled_at,omitempty" example:"2027-05-22T19:00:00Z"`
+}
@@ -18,6 +18,24 @@ type ErrorUserNotFound struct {
 	Detail string `json:"detail" example:"user not found"`
 }
 
+type ErrorUserEmailNotVerified struct {
+	Title  string `json:"title" example:"Forbidden"`
+	Status int    `json:"status" example:"403"`
+	Detail string `json:"detail" example:"user email is not verified"`
+}
+
+type ErrorInvalidToken struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"token is invalid, expired or was already used"`
+}
+
+type ErrorEmailAlreadyVerified struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"email was already verified"`
+}
+
 type ErrorCarNotFound struct {
 	Title  string `json:"title" example:"Not Found"`
 	Status int    `json:"status" example:"404"`
@@ -42,6 +60,12 @@ type ErrorInvalidEmail struct {
 	Detail string `json:"detail" example:"invalid email"`
 }
 
+type ErrorInvalidUserType struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"invalid user type"`
+}
+
 type ErrorinvalidUUID struct {
 	Title  string `json:"title" example:"Bad Request"`
 	Status int    `json:"status" example:"400"`
@@ -72,6 +96,12 @@ type ErrorCityQueryParamEmpty struct {
 	Detail string `json:"detail" example:"car not available"`
 }
 
+type ErrorTooManyAdditionalDrivers struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"reservation has more additional drivers than allowed (3 drivers)"`
+}
+
 type ErrorInvalidReservationStatus struct {
 	Title  string `json:"title" example:"Bad Request"`
 	Status int    `json:"status" example:"400"`
@@ -95,3 +125,315 @@ type ErrorInvalidTimeFrame struct {
 	Status int    `json:"status" example:"400"`
 	Detail string `json:"detail" example:"invalid time frame"`
 }
+
+type ErrorLicensePlateAlreadyRegistered struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"license plate already registered"`
+}
+
+type ErrorMaintenanceNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"maintenance was not found"`
+}
+
+type ErrorInvalidMaintenanceTimeFrame struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"maintenance time frame is invalid"`
+}
+
+type ErrorMaintenanceOverlap struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"car already has a maintenance scheduled during the requested time frame"`
+}
+
+type ErrorReturnMileageBelowPickup struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"return mileage cannot be lower than pickup mileage"`
+}
+
+type ErrorDamageReportNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"damage report was not found"`
+}
+
+type ErrorDamagePhotoNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"damage photo was not found"`
+}
+
+type ErrorUnsupportedPhotoType struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"photo content type is not supported"`
+}
+
+type ErrorPhotoTooLarge struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"photo exceeds the maximum allowed size"`
+}
+
+type ErrorDamageResolutionRequired struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"resolution is required to resolve a damage report"`
+}
+
+type ErrorCityNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"city not found"`
+}
+
+type ErrorCityNameAlreadyRegistered struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"city name already registered"`
+}
+
+type ErrorInvalidTimeZone struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"time zone is not a valid IANA time zone"`
+}
+
+type ErrorCityHasCars struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"city can not be deleted while it has cars"`
+}
+
+type ErrorBranchNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"branch not found"`
+}
+
+type ErrorBranchHasCars struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"branch can not be moved or deleted while it has cars"`
+}
+
+type ErrorInvalidOpeningHours struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"closing time must be after opening time"`
+}
+
+type ErrorPickupOutsideOpeningHours struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"pickup time is outside the branch opening hours"`
+}
+
+type ErrorOneWayFeeNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"one-way fee not found"`
+}
+
+type ErrorOneWayFeeSameCity struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"one-way fee cities must be different"`
+}
+
+type ErrorInvalidSearchRadius struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"search radius must be greater than 0 and not exceed the maximum allowed (50 km)"`
+}
+
+type ErrorTransferNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"transfer was not found"`
+}
+
+type ErrorTransferOverlapsReservations struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"car has reservations ending after the departure of the transfer"`
+}
+
+type ErrorTransferCompleted struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"transfer was already completed"`
+}
+
+type ErrorAddOnNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"add-on not found"`
+}
+
+type ErrorAddOnNameInUse struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"branch already has an add-on with that name"`
+}
+
+type ErrorAddOnHasReservations struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"add-on can not be deleted while it has reservations"`
+}
+
+type ErrorAddOnOutOfStock struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"add-on does not have enough units left for the reservation time frame (Child seat)"`
+}
+
+type ErrorInvalidCarType struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"invalid car type"`
+}
+
+type ErrorProtectionPlanNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"protection plan not found"`
+}
+
+type ErrorProtectionPlanExists struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"car type already has a plan of that protection level"`
+}
+
+type ErrorProtectionPlanNotOffered struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"protection level is not offered for the car type"`
+}
+
+type ErrorPaymentNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"payment not found"`
+}
+
+type ErrorPaymentInProgress struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"reservation already has a payment in progress"`
+}
+
+type ErrorInvalidPaymentTransition struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"payment can not change from its current status (Canceled to Paid)"`
+}
+
+type ErrorRefundExceedsCaptured struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"refund exceeds the captured amount left (80.00 left)"`
+}
+
+type ErrorInvalidPaymentSignature struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"invalid payment event signature"`
+}
+
+type ErrorDepositNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"deposit not found"`
+}
+
+type ErrorDepositNotHeld struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"deposit is not held"`
+}
+
+type ErrorReservationInvoiced struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"reservations with an invoice cannot be deleted"`
+}
+
+type ErrorInvoiceNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"invoice was not found"`
+}
+
+type ErrorReservationNotCompleted struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"invoices can only be issued for completed reservations"`
+}
+
+type ErrorUnsupportedInvoiceFormat struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"invoice format is not supported"`
+}
+
+type ErrorBillingProfileNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"billing profile was not found"`
+}
+
+type ErrorInvalidCountry struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"country must be an ISO 3166-1 alpha-2 code"`
+}
+
+type ErrorTaxRuleNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"tax rule not found"`
+}
+
+type ErrorTaxRuleExists struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"city already has a tax rule with that name"`
+}
+
+type ErrorInvalidCurrency struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"currency must be an ISO 4217 code"`
+}
+
+type ErrorExchangeRateNotFound struct {
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"exchange rate not found"`
+}
+
+type ErrorExchangeRateSameCurrency struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"exchange rate currencies must be different"`
+}
+
+type ErrorInvalidExtensionEndDate struct {
+	Title  string `json:"title" example:"Bad Request"`
+	Status int    `json:"status" example:"400"`
+	Detail string `json:"detail" example:"extension must end after the reservation and in the future"`
+}
+
+type ErrorDriverLicenseExpires struct {
+	Title  string `json:"title" example:"Forbidden"`
+	Status int    `json:"status" example:"403"`
+	Detail string `json:"detail" example:"driver license expires before the end of the reservation"`
+}
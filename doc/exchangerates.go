@@ -0,0 +1,35 @@
+package docs
+
+import "time"
+
+type ExchangeRateRequest struct {
+	Rate float64 `json:"rate" example:"0.9215"`
+}
+
+type ExchangeRateResponse struct {
+	From      string    `json:"from" example:"USD"`
+	To        string    `json:"to" example:"EUR"`
+	Rate      float64   `json:"rate" example:"0.9215"`
+	UpdatedAt time.Time `json:"updated_at" example:"2026-10-19T12:00:00Z"`
+}
+
+type ListExchangeRatesResponse struct {
+	ExchangeRates []ExchangeRateResponse `json:"exchange_rates"`
+}
+
+type MoneyResponse struct {
+	Amount   float64 `json:"amount" example:"2044.66"`
+	Currency string  `json:"currency" example:"EUR"`
+}
+
+type DisplayQuoteResponse struct {
+	Rate                 ExchangeRateResponse `json:"rate"`
+	RentalCost           MoneyResponse        `json:"rental_cost"`
+	OneWayFee            MoneyResponse        `json:"one_way_fee"`
+	AdditionalDriversFee MoneyResponse        `json:"additional_drivers_fee"`
+	AddOnsCost           MoneyResponse        `json:"add_ons_cost"`
+	ProtectionCost       MoneyResponse        `json:"protection_cost"`
+	Subtotal             MoneyResponse        `json:"subtotal"`
+	TaxTotal             MoneyResponse        `json:"tax_total"`
+	Total                MoneyResponse        `json:"total"`
+}
@@ -0,0 +1,75 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InspectionRequest struct {
+	Type        string    `json:"type" example:"Return"`
+	Mileage     int32     `json:"mileage" example:"15230"`
+	FuelLevel   int16     `json:"fuel_level" example:"75"`
+	Damages     []string  `json:"damages" example:"Scratch on rear bumper"`
+	Notes       string    `json:"notes" example:"Interior clean"`
+	InspectedAt time.Time `json:"inspected_at" example:"2027-05-16T18:00:00Z"`
+	// Holds the security deposit at pickup and is charged the late fee at return
+	PaymentMethod string `json:"payment_method,omitempty" example:"tok_visa"`
+}
+
+type InspectionResponse struct {
+	ID            uuid.UUID `json:"id,omitempty" example:"3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f"`
+	ReservationID uuid.UUID `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Type          string    `json:"type" example:"Return"`
+	Mileage       int32     `json:"mileage" example:"15230"`
+	FuelLevel     int16     `json:"fuel_level" example:"75"`
+	Damages       []string  `json:"damages" example:"Scratch on rear bumper"`
+	Notes         string    `json:"notes" example:"Interior clean"`
+	InspectedAt   time.Time `json:"inspected_at" example:"2027-05-16T18:00:00Z"`
+}
+
+type HandoverResponse struct {
+	ReservationID  uuid.UUID           `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Pickup         *InspectionResponse `json:"pickup"`
+	Return         *InspectionResponse `json:"return"`
+	DistanceDriven int32               `json:"distance_driven" example:"230"`
+	CarMileage     CarMileageResponse  `json:"car_mileage"`
+	Deposit        *DepositResponse    `json:"deposit"`
+	LateReturn     *LateReturnResponse `json:"late_return"`
+	// Settlements that failed after the handover was recorded
+	Warnings []string `json:"warnings,omitempty" example:"invoice could not be issued: connection refused"`
+}
+
+type LateReturnResponse struct {
+	ReservationID uuid.UUID `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	DueAt         time.Time `json:"due_at" example:"2027-05-22T23:00:00Z"`
+	ReturnedAt    time.Time `json:"returned_at" example:"2027-05-23T01:10:00Z"`
+	MinutesLate   int       `json:"minutes_late" example:"130"`
+	HoursCharged  int       `json:"hours_charged" example:"3"`
+	HourlyFee     float64   `json:"hourly_fee" example:"40"`
+	Fee           float64   `json:"fee" example:"120"`
+	Currency      string    `json:"currency" example:"USD"`
+}
+
+type ListOverdueRentalsResponse struct {
+	OverdueRentals []OverdueRentalResponse `json:"overdue_rentals"`
+}
+
+type OverdueRentalResponse struct {
+	Reservation     ReservationResponse    `json:"reservation"`
+	Lateness        LateReturnResponse     `json:"lateness"`
+	NextReservation *ReservationResponse   `json:"next_reservation,omitempty"`
+	Notice          *OverdueNoticeResponse `json:"notice,omitempty"`
+}
+
+type OverdueNoticeResponse struct {
+	NextReservationID uuid.UUID `json:"next_reservation_id" example:"9a4c6e82-0b1d-4f3a-8c5e-7d9f1b3a5c70"`
+	NotifiedAt        time.Time `json:"notified_at" example:"2027-05-23T00:15:00Z"`
+}
+
+type CarMileageResponse struct {
+	CarID              uuid.UUID `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	Mileage            int32     `json:"mileage" example:"15230"`
+	LastServiceMileage int32     `json:"last_service_mileage" example:"10000"`
+	MaintenanceDue     bool      `json:"maintenance_due" example:"false"`
+}
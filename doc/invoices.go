@@ -0,0 +1,62 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BillingInfoResponse struct {
+	Name        string `json:"name" example:"Ana Torres"`
+	Email       string `json:"email" example:"ana.torres@acme.com"`
+	CompanyName string `json:"company_name,omitempty" example:"Acme Logistics S.A.S."`
+	TaxID       string `json:"tax_id,omitempty" example:"900123456-7"`
+	Address     string `json:"address,omitempty" example:"Calle 93 #11-28"`
+	City        string `json:"city,omitempty" example:"Bogota"`
+	PostalCode  string `json:"postal_code,omitempty" example:"110221"`
+	Country     string `json:"country,omitempty" example:"CO"`
+}
+
+type InvoiceLineResponse struct {
+	Description string  `json:"description" example:"Car rental"`
+	Amount      float64 `json:"amount" example:"450"`
+}
+
+type InvoiceTaxResponse struct {
+	Name   string  `json:"name" example:"Sales tax"`
+	Rate   float64 `json:"rate" example:"0.0825"`
+	Amount float64 `json:"amount" example:"41.25"`
+}
+
+type InvoiceResponse struct {
+	ID            uuid.UUID             `json:"id" example:"5e2c7a9d-1b3f-4d6e-8a0c-2f4b6d8e0a1c"`
+	Number        string                `json:"number" example:"INV-2027-000042"`
+	ReservationID uuid.UUID             `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Billing       BillingInfoResponse   `json:"billing"`
+	Lines         []InvoiceLineResponse `json:"lines"`
+	Taxes         []InvoiceTaxResponse  `json:"taxes"`
+	Subtotal      float64               `json:"subtotal" example:"500"`
+	TaxTotal      float64               `json:"tax_total" example:"0"`
+	Total         float64               `json:"total" example:"500"`
+	Currency      string                `json:"currency" example:"USD"`
+	IssuedAt      time.Time             `json:"issued_at" example:"2027-05-22T19:00:00Z"`
+}
+
+type BillingProfileRequest struct {
+	CompanyName string `json:"company_name" example:"Acme Logistics S.A.S."`
+	TaxID       string `json:"tax_id" example:"900123456-7"`
+	Address     string `json:"address" example:"Calle 93 #11-28"`
+	City        string `json:"city" example:"Bogota"`
+	PostalCode  string `json:"postal_code" example:"110221"`
+	Country     string `json:"country" example:"CO"`
+}
+
+type BillingProfileResponse struct {
+	UserID      uuid.UUID `json:"user_id" example:"0b3c9a4e-2d8f-4c1a-b6e7-9f2d4a8c1e3b"`
+	CompanyName string    `json:"company_name" example:"Acme Logistics S.A.S."`
+	TaxID       string    `json:"tax_id" example:"900123456-7"`
+	Address     string    `json:"address" example:"Calle 93 #11-28"`
+	City        string    `json:"city" example:"Bogota"`
+	PostalCode  string    `json:"postal_code" example:"110221"`
+	Country     string    `json:"country" example:"CO"`
+}
@@ -0,0 +1,25 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerEntryResponse struct {
+	ID            uuid.UUID `json:"id" example:"1f0b6a3e-7c2d-4e9a-8b5f-3d1c7e9a2b4f"`
+	TransactionID uuid.UUID `json:"transaction_id" example:"a4c2e8f0-3b1d-4f6a-9c7e-5b3d1f9a7c2e"`
+	Account       string    `json:"account" example:"Customer"`
+	Movement      string    `json:"movement" example:"Charge"`
+	Amount        float64   `json:"amount" example:"500"`
+	Currency      string    `json:"currency" example:"USD"`
+	CreatedAt     time.Time `json:"created_at" example:"2027-05-15T10:00:00Z"`
+}
+
+type LedgerBalanceResponse struct {
+	ReservationID uuid.UUID             `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Currency      string                `json:"currency,omitempty" example:"USD"`
+	Accounts      map[string]float64    `json:"accounts"`
+	Total         float64               `json:"total" example:"0"`
+	Entries       []LedgerEntryResponse `json:"entries"`
+}
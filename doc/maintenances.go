@@ -0,0 +1,42 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Maintenances struct {
+	Maintenances []MaintenanceResponse `json:"maintenances"`
+}
+
+type MaintenanceRequest struct {
+	StartDate time.Time `json:"start_date" example:"2027-05-15T10:00:00Z"`
+	EndDate   time.Time `json:"end_date" example:"2027-05-16T18:00:00Z"`
+	Reason    string    `json:"reason" example:"Brakes replacement"`
+}
+
+type MaintenanceUpdateRequest struct {
+	CarID     uuid.UUID `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	StartDate time.Time `json:"start_date" example:"2027-05-15T10:00:00Z"`
+	EndDate   time.Time `json:"end_date" example:"2027-05-16T18:00:00Z"`
+	Reason    string    `json:"reason" example:"Brakes replacement"`
+}
+
+type MaintenanceResponse struct {
+	ID        uuid.UUID `json:"id,omitempty" example:"5b6f3c8e-3f0a-4d51-9b7a-1c2d3e4f5a6b"`
+	CarID     uuid.UUID `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	StartDate time.Time `json:"start_date" example:"2027-05-15T10:00:00Z"`
+	EndDate   time.Time `json:"end_date" example:"2027-05-16T18:00:00Z"`
+	Reason    string    `json:"reason" example:"Brakes replacement"`
+}
+
+type MaintenanceWithConflictsResponse struct {
+	ID                      uuid.UUID             `json:"id,omitempty" example:"5b6f3c8e-3f0a-4d51-9b7a-1c2d3e4f5a6b"`
+	CarID                   uuid.UUID             `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	StartDate               time.Time             `json:"start_date" example:"2027-05-15T10:00:00Z"`
+	EndDate                 time.Time             `json:"end_date" example:"2027-05-16T18:00:00Z"`
+	Reason                  string                `json:"reason" example:"Brakes replacement"`
+	ConflictingReservations []ReservationResponse `json:"conflicting_reservations"`
+	Warning                 string                `json:"warning,omitempty" example:"the maintenance collides with existing reservations"`
+}
@@ -0,0 +1,19 @@
+package docs
+
+import "github.com/google/uuid"
+
+type OneWayFeeRequest struct {
+	Fee      float64 `json:"fee" example:"150"`
+	Currency string  `json:"currency" example:"USD"`
+}
+
+type OneWayFeeResponse struct {
+	FromCityID uuid.UUID `json:"from_city_id" example:"1105a953-1dfe-470a-b6e7-f97f004f440b"`
+	ToCityID   uuid.UUID `json:"to_city_id" example:"f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b"`
+	Fee        float64   `json:"fee" example:"150"`
+	Currency   string    `json:"currency" example:"USD"`
+}
+
+type ListOneWayFeesResponse struct {
+	OneWayFees []OneWayFeeResponse `json:"one_way_fees"`
+}
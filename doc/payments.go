@@ -0,0 +1,29 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentAuthorizationRequest struct {
+	PaymentMethod string `json:"payment_method" example:"tok_visa"`
+}
+
+type RefundRequest struct {
+	Amount float64 `json:"amount" example:"50"`
+}
+
+type PaymentResponse struct {
+	ID             uuid.UUID `json:"id" example:"4e6a8c2d-1b3f-4d5a-9e7c-8b0d2f4a6c1e"`
+	ReservationID  uuid.UUID `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	Reference      string    `json:"reference" example:"fake_000001"`
+	Amount         float64   `json:"amount" example:"580"`
+	TaxAmount      float64   `json:"tax_amount" example:"52.73"`
+	Currency       string    `json:"currency" example:"USD"`
+	CapturedAmount float64   `json:"captured_amount" example:"580"`
+	RefundedAmount float64   `json:"refunded_amount" example:"0"`
+	Status         string    `json:"status" example:"Paid"`
+	CreatedAt      time.Time `json:"created_at" example:"2027-05-10T14:00:00Z"`
+	UpdatedAt      time.Time `json:"updated_at" example:"2027-05-15T10:05:00Z"`
+}
@@ -0,0 +1,34 @@
+package docs
+
+import "github.com/google/uuid"
+
+type ProtectionPlanRequest struct {
+	Level      string  `json:"level" example:"Standard"`
+	CarType    string  `json:"car_type" example:"Sedan"`
+	DailyPrice float64 `json:"daily_price" example:"15"`
+	Deductible float64 `json:"deductible" example:"500"`
+	Currency   string  `json:"currency" example:"USD"`
+}
+
+type ProtectionPlanResponse struct {
+	ID         uuid.UUID `json:"id" example:"7e3a1c52-8d4b-4f6e-a2c9-5b1d3f7e9a04"`
+	Level      string    `json:"level" example:"Standard"`
+	CarType    string    `json:"car_type" example:"Sedan"`
+	DailyPrice float64   `json:"daily_price" example:"15"`
+	Deductible float64   `json:"deductible" example:"500"`
+	Currency   string    `json:"currency" example:"USD"`
+}
+
+type ListProtectionPlansResponse struct {
+	ProtectionPlans []ProtectionPlanResponse `json:"protection_plans"`
+}
+
+type ReservationProtectionRequest struct {
+	Level string `json:"level" example:"Standard"`
+}
+
+type ReservationProtectionResponse struct {
+	Level      string  `json:"level" example:"Standard"`
+	DailyPrice float64 `json:"daily_price" example:"15"`
+	Deductible float64 `json:"deductible" example:"500"`
+}
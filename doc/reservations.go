@@ -11,20 +11,73 @@ type Reservations struct {
 }
 
 type ReservationRequest struct {
-	UserID        uuid.UUID `json:"user_id" example:"a29b1af4-9650-4379-8a8b-7f6c4d374e7f"`
-	CarID         uuid.UUID `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
-	Status        string    `json:"status" example:"Reserved"`
-	PaymentStatus string    `json:"payment_status" example:"Paid"`
-	StartDate     time.Time `json:"start_date" example:"2023-05-15T10:00:00Z"`
-	EndDate       time.Time `json:"end_date" example:"2023-05-16T18:00:00Z"`
+	UserID              uuid.UUID                    `json:"user_id" example:"a29b1af4-9650-4379-8a8b-7f6c4d374e7f"`
+	CarID               uuid.UUID                    `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	Status              string                       `json:"status" example:"Reserved"`
+	StartDate           time.Time                    `json:"start_date" example:"2023-05-15T10:00:00Z"`
+	EndDate             time.Time                    `json:"end_date" example:"2023-05-16T18:00:00Z"`
+	PickupBranchID      *uuid.UUID                   `json:"pickup_branch_id,omitempty" example:"3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"`
+	ReturnBranchID      *uuid.UUID                   `json:"return_branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+	AdditionalDriverIDs []uuid.UUID                  `json:"additional_driver_ids" example:"6b2e8c14-1f3a-4d5b-9c7e-2a4f6d8b0c13"`
+	AddOns              []ReservationAddOnRequest    `json:"add_ons"`
+	Protection          ReservationProtectionRequest `json:"protection"`
 }
 
 type ReservationResponse struct {
-	ID            uuid.UUID `json:"id,omitempty" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
-	UserID        uuid.UUID `json:"user_id" example:"a29b1af4-9650-4379-8a8b-7f6c4d374e7f"`
-	CarID         uuid.UUID `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
-	Status        string    `json:"status" example:"Reserved"`
-	PaymentStatus string    `json:"payment_status" example:"Paid"`
-	StartDate     time.Time `json:"start_date" example:"2027-05-15T10:00:00Z"`
-	EndDate       time.Time `json:"end_date" example:"2027-05-22T18:00:00Z"`
+	ID                  uuid.UUID                     `json:"id,omitempty" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	UserID              uuid.UUID                     `json:"user_id" example:"a29b1af4-9650-4379-8a8b-7f6c4d374e7f"`
+	CarID               uuid.UUID                     `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	Status              string                        `json:"status" example:"Reserved"`
+	PaymentStatus       string                        `json:"payment_status" example:"Paid"`
+	DepositStatus       string                        `json:"deposit_status" example:"Pending"`
+	StartDate           time.Time                     `json:"start_date" example:"2027-05-15T10:00:00-05:00"`
+	EndDate             time.Time                     `json:"end_date" example:"2027-05-22T18:00:00-05:00"`
+	TimeZone            string                        `json:"time_zone" example:"America/Chicago"`
+	PickupBranchID      *uuid.UUID                    `json:"pickup_branch_id,omitempty" example:"3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"`
+	ReturnBranchID      *uuid.UUID                    `json:"return_branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+	OneWayFee           float64                       `json:"one_way_fee" example:"150"`
+	Currency            string                        `json:"currency" example:"USD"`
+	AdditionalDriverIDs []uuid.UUID                   `json:"additional_driver_ids" example:"6b2e8c14-1f3a-4d5b-9c7e-2a4f6d8b0c13"`
+	AddOns              []ReservationAddOnResponse    `json:"add_ons"`
+	Protection          ReservationProtectionResponse `json:"protection"`
+	Quote               QuoteResponse                 `json:"quote"`
+}
+
+type QuoteResponse struct {
+	RentalCost           float64                  `json:"rental_cost" example:"1440"`
+	OneWayFee            float64                  `json:"one_way_fee" example:"150"`
+	AdditionalDriversFee float64                  `json:"additional_drivers_fee" example:"100"`
+	AddOnsCost           float64                  `json:"add_ons_cost" example:"100"`
+	ProtectionCost       float64                  `json:"protection_cost" example:"105"`
+	Subtotal             float64                  `json:"subtotal" example:"1895"`
+	Taxes                []ReservationTaxResponse `json:"taxes"`
+	TaxTotal             float64                  `json:"tax_total" example:"323.84"`
+	Total                float64                  `json:"total" example:"2218.84"`
+	Currency             string                   `json:"currency" example:"USD"`
+	Display              *DisplayQuoteResponse    `json:"display,omitempty"`
+}
+
+type ExtensionRequest struct {
+	EndDate time.Time `json:"end_date" example:"2027-05-24T18:00:00-05:00"`
+}
+
+type ExtensionResponse struct {
+	ReservationID   uuid.UUID           `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	PreviousEndDate time.Time           `json:"previous_end_date" example:"2027-05-22T18:00:00-05:00"`
+	EndDate         time.Time           `json:"end_date" example:"2027-05-24T18:00:00-05:00"`
+	Applied         bool                `json:"applied" example:"true"`
+	Cost            float64             `json:"cost" example:"480"`
+	Currency        string              `json:"currency" example:"USD"`
+	Reservation     ReservationResponse `json:"reservation"`
+}
+
+type ExtensionConflictResponse struct {
+	ReservationID          uuid.UUID           `json:"reservation_id" example:"882dfcf8-98c9-4a25-9637-ae4564928b10"`
+	PreviousEndDate        time.Time           `json:"previous_end_date" example:"2027-05-22T18:00:00-05:00"`
+	EndDate                time.Time           `json:"end_date" example:"2027-05-24T18:00:00-05:00"`
+	Applied                bool                `json:"applied" example:"false"`
+	Cost                   float64             `json:"cost" example:"0"`
+	Currency               string              `json:"currency" example:"USD"`
+	ConflictingReservation ReservationResponse `json:"conflicting_reservation"`
+	MaximumEndDate         time.Time           `json:"maximum_end_date" example:"2027-05-23T10:00:00-05:00"`
 }
@@ -16,9 +16,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cars": {
+        "/add-ons": {
             "post": {
-                "description": "Register a new car with the provided information",
+                "description": "Register an add-on (child seat, GPS, snow chains...) offered at a branch with the units in stock.\nThe price is charged per day of rental or once per rental depending on the pricing unit.",
                 "consumes": [
                     "application/json"
                 ],
@@ -26,124 +26,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "AddOns"
                 ],
-                "summary": "Register a new car",
-                "operationId": "register-car",
+                "summary": "Register a new add-on",
+                "operationId": "register-add-on",
                 "parameters": [
                     {
-                        "description": "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable)",
-                        "name": "car",
+                        "description": "Add-on information",
+                        "name": "add_on",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.CarRequest"
+                            "$ref": "#/definitions/docs.AddOnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created car",
-                        "schema": {
-                            "$ref": "#/definitions/docs.CarResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidCityName"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorInternalServer"
-                        }
-                    }
-                }
-            }
-        },
-        "/cars/": {
-            "get": {
-                "description": "Lists cars from a city in pages of 20 elements. from_car_id parameter\nis taken as the last seen car in a previous page.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Cars"
-                ],
-                "summary": "List cars",
-                "operationId": "list-cars",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "City name",
-                        "name": "city",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Last seen car ID",
-                        "name": "from_car_id",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Obtained car",
+                        "description": "Created add-on",
                         "schema": {
-                            "$ref": "#/definitions/docs.ListCarsResponse"
+                            "$ref": "#/definitions/docs.AddOnResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorCityQueryParamEmpty"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorInternalServer"
-                        }
-                    }
-                }
-            }
-        },
-        "/cars/{car_id}/reservations": {
-            "get": {
-                "description": "Get reservations by Car id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Reservations"
-                ],
-                "summary": "Get reservations by Car id",
-                "operationId": "get-reservation-by-car",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Car id",
-                        "name": "car_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Obtained reservations",
-                        "schema": {
-                            "$ref": "#/definitions/docs.Reservations"
+                            "$ref": "#/definitions/docs.ErrorAddOnNameInUse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                            "$ref": "#/definitions/docs.ErrorBranchNotFound"
                         }
                     },
                     "500": {
@@ -155,22 +69,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/cars/{id}": {
+        "/add-ons/{id}": {
             "get": {
-                "description": "Get a car by UUID",
+                "description": "Get an add-on by UUID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "AddOns"
                 ],
-                "summary": "Get a car",
-                "operationId": "get-car",
+                "summary": "Get an add-on",
+                "operationId": "get-add-on",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Car UUID",
+                        "description": "Add-on UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -178,9 +92,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Obtained car",
+                        "description": "Obtained add-on",
                         "schema": {
-                            "$ref": "#/definitions/docs.CarResponse"
+                            "$ref": "#/definitions/docs.AddOnResponse"
                         }
                     },
                     "400": {
@@ -192,7 +106,7 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                            "$ref": "#/definitions/docs.ErrorAddOnNotFound"
                         }
                     },
                     "500": {
@@ -204,7 +118,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a car by UUID",
+                "description": "Update an add-on by UUID",
                 "consumes": [
                     "application/json"
                 ],
@@ -212,46 +126,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "AddOns"
                 ],
-                "summary": "Update a car",
-                "operationId": "update-car",
+                "summary": "Update an add-on",
+                "operationId": "update-add-on",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Car UUID",
+                        "description": "Add-on UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable)",
-                        "name": "car",
+                        "description": "Add-on information",
+                        "name": "add_on",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.CarRequest"
+                            "$ref": "#/definitions/docs.AddOnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated car",
+                        "description": "Updated add-on",
                         "schema": {
-                            "$ref": "#/definitions/docs.CarResponse"
+                            "$ref": "#/definitions/docs.AddOnResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidCarStatus"
+                            "$ref": "#/definitions/docs.ErrorAddOnNameInUse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                            "$ref": "#/definitions/docs.ErrorAddOnNotFound"
                         }
                     },
                     "500": {
@@ -263,20 +177,20 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a car by UUID",
+                "description": "Delete an add-on by UUID. Add-ons booked in reservations can not be deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "AddOns"
                 ],
-                "summary": "Delete a car",
-                "operationId": "delete-car",
+                "summary": "Delete an add-on",
+                "operationId": "delete-add-on",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Car UUID",
+                        "description": "Add-on UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -289,13 +203,13 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                            "$ref": "#/definitions/docs.ErrorAddOnHasReservations"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                            "$ref": "#/definitions/docs.ErrorAddOnNotFound"
                         }
                     },
                     "500": {
@@ -307,22 +221,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/cities/names": {
+        "/admin/constants": {
             "get": {
-                "description": "Lists the names of all the currently supported cities",
+                "description": "Get the business constants currently in use and their version.\nThe version increases every time the constants file is reloaded successfully.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cities"
+                    "Admin"
                 ],
-                "summary": "List cities",
-                "operationId": "list-cities",
+                "summary": "Get active constants",
+                "operationId": "get-constants",
+                "responses": {
+                    "200": {
+                        "description": "Active constants",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ConstantsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/exchange-rates": {
+            "get": {
+                "description": "List the exchange rates used to display quotes in other currencies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List exchange rates",
+                "operationId": "list-exchange-rates",
                 "responses": {
                     "200": {
-                        "description": "Cities name",
+                        "description": "Exchange rates",
                         "schema": {
-                            "$ref": "#/definitions/docs.ListCitiesNameResponse"
+                            "$ref": "#/definitions/docs.ListExchangeRatesResponse"
                         }
                     },
                     "500": {
@@ -334,9 +269,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/reservations": {
-            "post": {
-                "description": "Create a reservation with the provided information",
+        "/admin/exchange-rates/{from}/{to}": {
+            "put": {
+                "description": "Set how many units of the target currency one unit of the source currency is worth.\nRates are used to display quotes in other currencies, the inverse pair is used when there is no direct one.",
                 "consumes": [
                     "application/json"
                 ],
@@ -344,32 +279,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Admin"
                 ],
-                "summary": "Create a reservation",
-                "operationId": "create-reservation",
+                "summary": "Set an exchange rate",
+                "operationId": "set-exchange-rate",
                 "parameters": [
                     {
-                        "description": "Reservation information (allowed statuses: Reserved, Canceled, Completed; allowed payment statuses: Paid, Pending, Canceled)",
-                        "name": "reservation",
+                        "type": "string",
+                        "description": "ISO 4217 code of the source currency",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 code of the target currency",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Exchange rate",
+                        "name": "rate",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.ReservationRequest"
+                            "$ref": "#/definitions/docs.ExchangeRateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created reservation",
+                    "200": {
+                        "description": "Exchange rate of the pair",
                         "schema": {
-                            "$ref": "#/definitions/docs.ReservationResponse"
+                            "$ref": "#/definitions/docs.ExchangeRateResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorMinimumReservationHours"
+                            "$ref": "#/definitions/docs.ErrorExchangeRateSameCurrency"
                         }
                     },
                     "500": {
@@ -379,51 +328,100 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/reservations/": {
-            "get": {
-                "description": "Get reservations",
+            },
+            "delete": {
+                "description": "Delete the rate of a currency pair. Quotes cannot be displayed in the pair anymore unless its inverse is set.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Admin"
                 ],
-                "summary": "Get reservations",
-                "operationId": "get-reservations",
+                "summary": "Delete an exchange rate",
+                "operationId": "delete-exchange-rate",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Last seen reservation",
-                        "name": "from_reservation_id",
-                        "in": "query"
+                        "description": "ISO 4217 code of the source currency",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Star date",
-                        "name": "start_date",
-                        "in": "query"
+                        "description": "ISO 4217 code of the target currency",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidCurrency"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorExchangeRateNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/branches": {
+            "post": {
+                "description": "Register a branch of a city with its opening hours and holiday closures.\nOpening hours are given in the city local time; weekdays without opening hours are closed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Branches"
+                ],
+                "summary": "Register a new branch",
+                "operationId": "register-branch",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "End date",
-                        "name": "end_date",
-                        "in": "query"
+                        "description": "Branch information (weekday goes from 0 for Sunday to 6 for Saturday)",
+                        "name": "branch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.BranchRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Obtained reservations",
+                    "201": {
+                        "description": "Created branch",
                         "schema": {
-                            "$ref": "#/definitions/docs.Reservations"
+                            "$ref": "#/definitions/docs.BranchResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidTimeFrame"
+                            "$ref": "#/definitions/docs.ErrorInvalidOpeningHours"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
                         }
                     },
                     "500": {
@@ -435,22 +433,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/reservations/{id}": {
+        "/branches/{id}": {
             "get": {
-                "description": "Get a reservation by UUID",
+                "description": "Get a branch by UUID along with its opening hours and closures",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Branches"
                 ],
-                "summary": "Get a reservation",
-                "operationId": "get-reservation",
+                "summary": "Get a branch",
+                "operationId": "get-branch",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Reservation UUID",
+                        "description": "Branch UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -458,21 +456,21 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Obtained reservation",
+                        "description": "Obtained branch",
                         "schema": {
-                            "$ref": "#/definitions/docs.ReservationResponse"
+                            "$ref": "#/definitions/docs.BranchResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidReservationStatus"
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                            "$ref": "#/definitions/docs.ErrorBranchNotFound"
                         }
                     },
                     "500": {
@@ -484,7 +482,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update a reservation by UUID",
+                "description": "Update a branch by UUID. Opening hours and closures are replaced by the given ones.",
                 "consumes": [
                     "application/json"
                 ],
@@ -492,46 +490,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Branches"
                 ],
-                "summary": "Update a reservation",
-                "operationId": "update-reservation",
+                "summary": "Update a branch",
+                "operationId": "update-branch",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Reservation UUID",
+                        "description": "Branch UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Reservation information (allowed statuses: Reserved, Canceled, Completed; allowed payment statuses: Paid, Pending, Canceled)",
-                        "name": "reservation",
+                        "description": "Branch information (weekday goes from 0 for Sunday to 6 for Saturday)",
+                        "name": "branch",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.ReservationRequest"
+                            "$ref": "#/definitions/docs.BranchRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated reservation",
+                        "description": "Updated branch",
                         "schema": {
-                            "$ref": "#/definitions/docs.ReservationResponse"
+                            "$ref": "#/definitions/docs.BranchResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidReservationTimeFrame"
+                            "$ref": "#/definitions/docs.ErrorBranchHasCars"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                            "$ref": "#/definitions/docs.ErrorBranchNotFound"
                         }
                     },
                     "500": {
@@ -543,20 +541,20 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a reservation by UUID",
+                "description": "Delete a branch by UUID. Branches with cars or reservations can not be deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Branches"
                 ],
-                "summary": "Delete a reservation",
-                "operationId": "delete-reservation",
+                "summary": "Delete a branch",
+                "operationId": "delete-branch",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Reservation UUID",
+                        "description": "Branch UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -569,13 +567,13 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                            "$ref": "#/definitions/docs.ErrorBranchHasCars"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                            "$ref": "#/definitions/docs.ErrorBranchNotFound"
                         }
                     },
                     "500": {
@@ -587,9 +585,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/branches/{id}/add-ons": {
+            "get": {
+                "description": "List the add-ons offered at a branch ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AddOns"
+                ],
+                "summary": "List the add-ons of a branch",
+                "operationId": "list-branch-add-ons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Branch UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Add-ons of the branch",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListAddOnsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars": {
             "post": {
-                "description": "Register a new user with the provided information",
+                "description": "Register a new car with the provided information",
                 "consumes": [
                     "application/json"
                 ],
@@ -597,32 +638,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Cars"
                 ],
-                "summary": "Register a new user",
-                "operationId": "register-user",
+                "summary": "Register a new car",
+                "operationId": "register-car",
                 "parameters": [
                     {
-                        "description": "User information (allowed types: Customer, Admin; allowed statuses: Active, Inactive)",
-                        "name": "user",
+                        "description": "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable; allowed transmissions: Manual, Automatic; allowed fuel types: Gasoline, Diesel, Hybrid, Electric; allowed features: A/C, GPS, Child Seat Ready, Bluetooth)",
+                        "name": "car",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/docs.UserRequest"
+                            "$ref": "#/definitions/docs.CarRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created user",
+                        "description": "Created car",
                         "schema": {
-                            "$ref": "#/definitions/docs.UserResponse"
+                            "$ref": "#/definitions/docs.CarResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorEmailAlreadyRegistered"
+                            "$ref": "#/definitions/docs.ErrorLicensePlateAlreadyRegistered"
                         }
                     },
                     "500": {
@@ -634,44 +675,98 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/cars/": {
             "get": {
-                "description": "Get a user by UUID",
+                "description": "Lists cars from a city in pages of 20 elements. from_car_id parameter\nis taken as the last seen car in a previous page.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Cars"
                 ],
-                "summary": "Get a user",
-                "operationId": "get-user",
+                "summary": "List cars",
+                "operationId": "list-cars",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "User UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last seen car ID",
+                        "name": "from_car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Car type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Make (case insensitive)",
+                        "name": "make",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Model (case insensitive)",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Model year",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transmission (Manual, Automatic)",
+                        "name": "transmission",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fuel type (Gasoline, Diesel, Hybrid, Electric)",
+                        "name": "fuel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Color (case insensitive)",
+                        "name": "color",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated features the car must have (A/C, GPS, Child Seat Ready, Bluetooth)",
+                        "name": "features",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case insensitive prefix of the make, model or license plate",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Obtained user",
+                        "description": "Obtained car",
                         "schema": {
-                            "$ref": "#/definitions/docs.UserResponse"
+                            "$ref": "#/definitions/docs.ListCarsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                            "$ref": "#/definitions/docs.ErrorCityQueryParamEmpty"
                         }
                     },
                     "500": {
@@ -681,56 +776,67 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a user by UUID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/cars/nearby": {
+            "get": {
+                "description": "Lists the cars available during the time window that can be picked up within\nradius_km of the location, nearest first. The distance is measured to the branch\nwhere each car will be when the window starts. At most 20 cars are returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Cars"
                 ],
-                "summary": "Update a user",
-                "operationId": "update-user",
+                "summary": "Search cars near a location",
+                "operationId": "search-nearby-cars",
                 "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Search radius in kilometers (maximum 50)",
+                        "name": "radius_km",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "User UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Start date",
+                        "name": "start_date",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "User information (allowed types: Customer, Admin; allowed statuses: Active, Inactive)",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/docs.UserRequest"
-                        }
+                        "type": "string",
+                        "description": "End date",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated user",
+                        "description": "Obtained cars",
                         "schema": {
-                            "$ref": "#/definitions/docs.UserResponse"
+                            "$ref": "#/definitions/docs.ListNearbyCarsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/docs.ErrorInvalidEmail"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                            "$ref": "#/definitions/docs.ErrorInvalidSearchRadius"
                         }
                     },
                     "500": {
@@ -740,30 +846,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a user by UUID",
+            }
+        },
+        "/cars/{car_id}/damage-reports": {
+            "get": {
+                "description": "List the damage reports of a car, newest first, optionally filtered by status",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Damage Reports"
                 ],
-                "summary": "Delete a user",
-                "operationId": "delete-user",
+                "summary": "List the damage reports of a car",
+                "operationId": "list-car-damage-reports",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User UUID",
-                        "name": "id",
+                        "description": "Car id",
+                        "name": "car_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status of the reports (Open, In Repair, Resolved)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Damage reports of the car",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -771,12 +888,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/docs.ErrorinvalidUUID"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/docs.ErrorUserNotFound"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -786,32 +897,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{user_id}/reservations": {
+        "/cars/{car_id}/maintenances": {
             "get": {
-                "description": "Get reservations by User id",
+                "description": "Get maintenances by Car id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reservations"
+                    "Maintenances"
                 ],
-                "summary": "Get reservations by User id",
-                "operationId": "get-reservation-by-user",
+                "summary": "Get maintenances by Car id",
+                "operationId": "get-maintenances-by-car",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User id",
-                        "name": "user_id",
+                        "description": "Car id",
+                        "name": "car_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Obtained reservations",
+                        "description": "Obtained maintenances",
                         "schema": {
-                            "$ref": "#/definitions/docs.Reservations"
+                            "$ref": "#/definitions/docs.Maintenances"
                         }
                     },
                     "400": {
@@ -827,42 +938,6846 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "docs.CarRequest": {
-            "type": "object",
-            "properties": {
-                "city_name": {
-                    "type": "string",
-                    "example": "New York"
-                },
-                "hourly_rent_cost": {
-                    "type": "number",
-                    "example": 99.99
-                },
-                "seats": {
-                    "type": "integer",
-                    "example": 4
-                },
-                "status": {
-                    "type": "string",
-                    "example": "Available"
-                },
-                "type": {
-                    "type": "string",
-                    "example": "Luxury"
-                }
-            }
-        },
+            },
+            "post": {
+                "description": "Schedule a maintenance window for a car. Reservations colliding with the window are listed in the response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenances"
+                ],
+                "summary": "Schedule a maintenance",
+                "operationId": "schedule-maintenance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Maintenance information",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MaintenanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Scheduled maintenance",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MaintenanceWithConflictsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorMaintenanceOverlap"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{car_id}/mileage": {
+            "get": {
+                "description": "Get the cumulative mileage of a car and whether it is due for maintenance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Handovers"
+                ],
+                "summary": "Get the mileage of a car",
+                "operationId": "get-car-mileage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mileage of the car",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CarMileageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{car_id}/mileage/service": {
+            "post": {
+                "description": "Register that a car was serviced at its current mileage, clearing its maintenance due flag",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Handovers"
+                ],
+                "summary": "Register the service of a car",
+                "operationId": "register-car-service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mileage of the car",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CarMileageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{car_id}/reservations": {
+            "get": {
+                "description": "Get reservations by Car id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get reservations by Car id",
+                "operationId": "get-reservation-by-car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained reservations",
+                        "schema": {
+                            "$ref": "#/definitions/docs.Reservations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{car_id}/transfers": {
+            "get": {
+                "description": "Get the transfers of a car ordered by departure",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Get transfers by Car id",
+                "operationId": "get-transfers-by-car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained transfers",
+                        "schema": {
+                            "$ref": "#/definitions/docs.Transfers"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Schedule the transfer of a car from its current city to another one. The car can not\nbe rented from the departure until the transfer is completed, and it can not have\nreservations ending after the departure.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Schedule a transfer",
+                "operationId": "schedule-transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer information",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.TransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Scheduled transfer",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferOverlapsReservations"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}": {
+            "get": {
+                "description": "Get a car by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cars"
+                ],
+                "summary": "Get a car",
+                "operationId": "get-car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained car",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a car by UUID. Marking a car as unavailable lists its upcoming reservations in the response, as unavailable cars can not be booked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cars"
+                ],
+                "summary": "Update a car",
+                "operationId": "update-car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable; allowed transmissions: Manual, Automatic; allowed fuel types: Gasoline, Diesel, Hybrid, Electric; allowed features: A/C, GPS, Child Seat Ready, Bluetooth)",
+                        "name": "car",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.CarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated car",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CarWithAffectedReservationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidCarStatus"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a car by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cars"
+                ],
+                "summary": "Delete a car",
+                "operationId": "delete-car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities": {
+            "get": {
+                "description": "Lists the supported cities in pages of 20 elements. from_city_id parameter\nis taken as the last seen city in a previous page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "List cities",
+                "operationId": "list-cities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last seen city ID",
+                        "name": "from_city_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained cities",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListCitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new city where cars can be rented. Reservation dates of its cars are shown in its time zone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Register a new city",
+                "operationId": "register-city",
+                "parameters": [
+                    {
+                        "description": "City information (time zone must be an IANA time zone, country an ISO 3166-1 alpha-2 code and currency an ISO 4217 code)",
+                        "name": "city",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.CityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidTimeZone"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities/{city_id}/branches": {
+            "get": {
+                "description": "List the branches of a city ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Branches"
+                ],
+                "summary": "List the branches of a city",
+                "operationId": "list-city-branches",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "City UUID",
+                        "name": "city_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Branches of the city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListBranchesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities/{city_id}/one-way-fees": {
+            "get": {
+                "description": "List the routes starting at a city that allow one-way rentals, with their fees",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "List the one-way fees of a city",
+                "operationId": "list-city-one-way-fees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Pickup city UUID",
+                        "name": "city_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One-way fees of the city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListOneWayFeesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities/{city_id}/one-way-fees/{to_city_id}": {
+            "put": {
+                "description": "Set the fee charged for returning in another city a car picked up in the given one.\nRoutes without a fee do not allow one-way rentals.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Set a one-way fee",
+                "operationId": "set-one-way-fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Pickup city UUID",
+                        "name": "city_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Return city UUID",
+                        "name": "to_city_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "One-way fee",
+                        "name": "fee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.OneWayFeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One-way fee of the route",
+                        "schema": {
+                            "$ref": "#/definitions/docs.OneWayFeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorOneWayFeeSameCity"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the fee of a route, which stops allowing one-way rentals. Existing reservations keep their fee.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Delete a one-way fee",
+                "operationId": "delete-one-way-fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Pickup city UUID",
+                        "name": "city_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Return city UUID",
+                        "name": "to_city_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorOneWayFeeNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities/{id}": {
+            "get": {
+                "description": "Get a city by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Get a city",
+                "operationId": "get-city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "City UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a city by UUID. The currency of cities with cars can not change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Update a city",
+                "operationId": "update-city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "City UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "City information (time zone must be an IANA time zone, country an ISO 3166-1 alpha-2 code and currency an ISO 4217 code)",
+                        "name": "city",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.CityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.CityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidTimeZone"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a city by UUID. Cities with cars or branches can not be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "Delete a city",
+                "operationId": "delete-city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "City UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityHasCars"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/cities/{id}/tax-rules": {
+            "get": {
+                "description": "List the tax rules of a city ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cities"
+                ],
+                "summary": "List the tax rules of a city",
+                "operationId": "list-city-tax-rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "City UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tax rules of the city",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListTaxRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports": {
+            "post": {
+                "description": "File a damage report for a car with at least one photo. Photos must be JPEG or PNG images within the maximum photo size.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "File a damage report",
+                "operationId": "file-damage-report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Car id",
+                        "name": "car_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Id of the user reporting the damage",
+                        "name": "reporter_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation during which the damage happened",
+                        "name": "reservation_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description of the damage",
+                        "name": "description",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photos of the damage",
+                        "name": "photos",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Filed damage report",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUnsupportedPhotoType"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCarNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports/{id}": {
+            "get": {
+                "description": "Get a damage report by UUID along with its photos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "Get a damage report",
+                "operationId": "get-damage-report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Damage report UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained damage report",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamageReportNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports/{id}/photos": {
+            "post": {
+                "description": "Upload more photos of the damage. Photos must be JPEG or PNG images within the maximum photo size.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "Add photos to a damage report",
+                "operationId": "add-damage-photos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Damage report UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photos of the damage",
+                        "name": "photos",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Damage report with the added photos",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPhotoTooLarge"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamageReportNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports/{id}/photos/{photo_id}": {
+            "get": {
+                "description": "Download the original photo of a damage report",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "Download a damage photo",
+                "operationId": "get-damage-photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Damage report UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Photo UUID",
+                        "name": "photo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Photo content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamagePhotoNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports/{id}/photos/{photo_id}/thumbnail": {
+            "get": {
+                "description": "Download the JPEG thumbnail generated for a photo of a damage report",
+                "produces": [
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "Download the thumbnail of a damage photo",
+                "operationId": "get-damage-photo-thumbnail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Damage report UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Photo UUID",
+                        "name": "photo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thumbnail content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamagePhotoNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/damage-reports/{id}/status": {
+            "put": {
+                "description": "Move a damage report through its statuses. Resolving a report requires a resolution.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damage Reports"
+                ],
+                "summary": "Update the status of a damage report",
+                "operationId": "update-damage-report-status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Damage report UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status information (allowed statuses: Open, In Repair, Resolved)",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated damage report",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DamageReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamageResolutionRequired"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDamageReportNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}": {
+            "get": {
+                "description": "Get an invoice by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Get an invoice",
+                "operationId": "get-invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Invoice UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice",
+                        "schema": {
+                            "$ref": "#/definitions/docs.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvoiceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/download": {
+            "get": {
+                "description": "Download the document of an invoice as PDF, the default, or HTML",
+                "produces": [
+                    "application/pdf",
+                    "text/html"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Download an invoice",
+                "operationId": "download-invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Invoice UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pdf",
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Document format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice document",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUnsupportedInvoiceFormat"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvoiceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenances/": {
+            "get": {
+                "description": "Get the maintenances of the cars in a city overlapping a time frame (next seven days by default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenances"
+                ],
+                "summary": "Get upcoming maintenances in a city",
+                "operationId": "get-maintenances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last seen maintenance",
+                        "name": "from_maintenance_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained maintenances",
+                        "schema": {
+                            "$ref": "#/definitions/docs.Maintenances"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityQueryParamEmpty"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenances/{id}": {
+            "get": {
+                "description": "Get a maintenance by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenances"
+                ],
+                "summary": "Get a maintenance",
+                "operationId": "get-maintenance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Maintenance UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained maintenance",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MaintenanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorMaintenanceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a maintenance by UUID. Reservations colliding with the new window are listed in the response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenances"
+                ],
+                "summary": "Update a maintenance",
+                "operationId": "update-maintenance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Maintenance UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Maintenance information",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.MaintenanceUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated maintenance",
+                        "schema": {
+                            "$ref": "#/definitions/docs.MaintenanceWithConflictsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidMaintenanceTimeFrame"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorMaintenanceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a maintenance by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenances"
+                ],
+                "summary": "Delete a maintenance",
+                "operationId": "delete-maintenance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Maintenance UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorMaintenanceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/webhook": {
+            "post": {
+                "description": "Receive the events the payment provider sends when a payment changes, such as the confirmation of a pending authorization.\nEvents must be signed with the signature header of the provider. Events already applied are ignored, and captures of more than the authorized amount or in another currency are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Receive payment events",
+                "operationId": "payment-webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signature of the event",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidPaymentSignature"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/protection-plans": {
+            "get": {
+                "description": "List the protection plans ordered by car type and protection level",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProtectionPlans"
+                ],
+                "summary": "List protection plans",
+                "operationId": "list-protection-plans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only list the plans of this car type",
+                        "name": "car_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Protection plans",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListProtectionPlansResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidCarType"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register the daily price and deductible of a protection level (Basic, Standard, Full) for a car type.\nA car type can only have one plan per protection level.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProtectionPlans"
+                ],
+                "summary": "Register a new protection plan",
+                "operationId": "register-protection-plan",
+                "parameters": [
+                    {
+                        "description": "Protection plan information",
+                        "name": "protection_plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ProtectionPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created protection plan",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ProtectionPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorProtectionPlanExists"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/protection-plans/{id}": {
+            "get": {
+                "description": "Get a protection plan by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProtectionPlans"
+                ],
+                "summary": "Get a protection plan",
+                "operationId": "get-protection-plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Protection plan UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained protection plan",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ProtectionPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorProtectionPlanNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a protection plan by UUID. Reservations already booked keep the terms they were booked with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProtectionPlans"
+                ],
+                "summary": "Update a protection plan",
+                "operationId": "update-protection-plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Protection plan UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Protection plan information",
+                        "name": "protection_plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ProtectionPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated protection plan",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ProtectionPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorProtectionPlanExists"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorProtectionPlanNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a protection plan by UUID. Reservations already booked keep the terms they were booked with.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProtectionPlans"
+                ],
+                "summary": "Delete a protection plan",
+                "operationId": "delete-protection-plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Protection plan UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorProtectionPlanNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations": {
+            "post": {
+                "description": "Create a reservation with the provided information. Pickup and return times must fall inside the opening hours of the car branch, in the city local time.\nThe car is picked up where it will be when the reservation starts. It can be returned at a branch of another city when the route has a one-way fee, which is added to the reservation.\nOnly active users with a verified email can book cars that are available. Drivers must meet the minimum age and license tenure of the car type when the reservation starts, and their license must not expire before it ends.\nOther active users can be listed as additional drivers when they meet the same requirements. Each of them adds a fee per started day to the quote of the reservation.\nAdd-ons offered at the pickup branch can be added while they have units left for the whole time frame of the reservation.\nA protection level offered for the car type can be chosen, otherwise the default level is used. Its daily price and deductible are kept with the reservation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Create a reservation",
+                "operationId": "create-reservation",
+                "parameters": [
+                    {
+                        "description": "Reservation information (allowed statuses: Reserved, Canceled, Completed)",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorMinimumReservationHours"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserEmailNotVerified"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/": {
+            "get": {
+                "description": "Get reservations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get reservations",
+                "operationId": "get-reservations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last seen reservation",
+                        "name": "from_reservation_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Star date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained reservations",
+                        "schema": {
+                            "$ref": "#/definitions/docs.Reservations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidTimeFrame"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/overdue": {
+            "get": {
+                "description": "List the rentals whose car was picked up and is still out past the grace period after their end, the ones overdue the longest first.\nTheir lateness is measured as if the car were returned now, and the next reservation on the car is the one that may not get it on time.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "List overdue rentals",
+                "operationId": "list-overdue-rentals",
+                "responses": {
+                    "200": {
+                        "description": "Overdue rentals",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListOverdueRentalsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/overdue/notify": {
+            "post": {
+                "description": "Email the renter of the next reservation on the car of every overdue rental that the car was not returned on time.\nEach overdue rental is notified once, so it can be called periodically.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Notify overdue rentals",
+                "operationId": "notify-overdue-rentals",
+                "responses": {
+                    "200": {
+                        "description": "Overdue rentals with their notices",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListOverdueRentalsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/quote": {
+            "post": {
+                "description": "Get the price breakdown a reservation would be booked with, after the same checks made to book it.\nThe car is rented by started hours of the city local time, and each additional driver is charged by started days.\nAdd-ons are charged once per rental or by started days, depending on their pricing unit.\nThe protection plan is charged by started days.\nThe quote is charged in the currency of the city, and can also be displayed in another currency.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Quote a reservation",
+                "operationId": "quote-reservation",
+                "parameters": [
+                    {
+                        "description": "Reservation information (allowed statuses: Reserved, Canceled, Completed)",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 code of the currency to display the quote in",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price breakdown",
+                        "schema": {
+                            "$ref": "#/definitions/docs.QuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTooManyAdditionalDrivers"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserEmailNotVerified"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}": {
+            "get": {
+                "description": "Get a reservation by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get a reservation",
+                "operationId": "get-reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidReservationStatus"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a reservation by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Update a reservation",
+                "operationId": "update-reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reservation information (allowed statuses: Reserved, Canceled, Completed)",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidReservationTimeFrame"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserEmailNotVerified"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a reservation by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Delete a reservation",
+                "operationId": "delete-reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationInvoiced"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/balance": {
+            "get": {
+                "description": "Get the balance of the Customer, Revenue, Deposits and Tax accounts for the money moved by a reservation, along with the ledger entries it was computed from.\nDebits are positive and credits negative, so the total is zero.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get the ledger balance of a reservation",
+                "operationId": "get-reservation-balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balance of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.LedgerBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/deposit": {
+            "get": {
+                "description": "Get the security deposit held at pickup, its status and what was captured of it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deposits"
+                ],
+                "summary": "Get the security deposit of a reservation",
+                "operationId": "get-deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deposit of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DepositResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDepositNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/deposit/capture": {
+            "post": {
+                "description": "Capture part of the held deposit to cover damages or late fees. The rest of the hold is released.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deposits"
+                ],
+                "summary": "Capture part of a security deposit",
+                "operationId": "capture-deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Amount to capture and what it covers",
+                        "name": "capture",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.DepositCaptureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Captured deposit",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DepositResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDepositNotHeld"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDepositNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/deposit/release": {
+            "post": {
+                "description": "Release the whole held deposit, for example once the damages observed at return were assessed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deposits"
+                ],
+                "summary": "Release a security deposit",
+                "operationId": "release-deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Released deposit",
+                        "schema": {
+                            "$ref": "#/definitions/docs.DepositResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDepositNotHeld"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDepositNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/extend": {
+            "post": {
+                "description": "Keep the car of a reservation until a later end date. Only the extra time is checked for availability, leaving the reservation itself out.\nThe extension costs what pricing the reservation until the new end date adds to pricing it until its current end, at current prices and with the protection terms of the reservation. Its cost is added to the reservation along with the new end date in a single transaction.\nReservations whose payment was already authorized or captured can not be extended.\nWhen the car is reserved, in maintenance or transferred away during the extra time, nothing is changed and the earliest reservation in the way and the latest end date it can be extended to are returned with status 409.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Extend a reservation",
+                "operationId": "extend-reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New end date of the reservation",
+                        "name": "extension",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.ExtensionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Applied extension",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ExtensionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidExtensionEndDate"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorDriverLicenseExpires"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "409": {
+                        "description": "Car not available for the whole extension",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ExtensionConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/invoice": {
+            "get": {
+                "description": "Get the invoice issued for a reservation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Get the invoice of a reservation",
+                "operationId": "get-reservation-invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvoiceNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Issue the invoice of a completed reservation. Returned cars are invoiced on their own, so this is only needed for reservations completed by hand. Issuing it again returns the invoice issued first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Issue the invoice of a reservation",
+                "operationId": "issue-invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Invoice of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotCompleted"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/payment": {
+            "get": {
+                "description": "Get the latest payment of a reservation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Get the payment of a reservation",
+                "operationId": "get-payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/payment/authorize": {
+            "post": {
+                "description": "Hold the total of the reservation quote on a payment method, in the currency of the city of the car.\nThe payment is Authorized, Failed when the payment method is declined, or Pending until the provider confirms it through the webhook.\nA new payment can only be started when the previous one failed or was voided.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Authorize the payment of a reservation",
+                "operationId": "authorize-payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment method",
+                        "name": "authorization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentAuthorizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Payment of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentInProgress"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/payment/capture": {
+            "post": {
+                "description": "Charge the authorized amount of the reservation payment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Capture the payment of a reservation",
+                "operationId": "capture-payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Captured payment",
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidPaymentTransition"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/payment/refund": {
+            "post": {
+                "description": "Give back part of the captured amount of the reservation payment, or all that is left of it when no amount is given.\nThe payment is Refunded once the whole captured amount was given back.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Refund the payment of a reservation",
+                "operationId": "refund-payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Amount to refund",
+                        "name": "refund",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/docs.RefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Refunded payment",
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorRefundExceedsCaptured"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{id}/payment/void": {
+            "post": {
+                "description": "Release the authorization of the reservation payment without charging it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Void the payment of a reservation",
+                "operationId": "void-payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Voided payment",
+                        "schema": {
+                            "$ref": "#/definitions/docs.PaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidPaymentTransition"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorPaymentNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{reservation_id}/handover": {
+            "get": {
+                "description": "Get the pickup and return inspections of a reservation and the distance driven",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Handovers"
+                ],
+                "summary": "Get the handover of a reservation",
+                "operationId": "get-handover",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation id",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Handover of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.HandoverResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{reservation_id}/inspections": {
+            "post": {
+                "description": "Record the pickup or return inspection of a reservation. The return mileage must not be lower than the pickup one.\nPickups can be recorded from EARLY_PICKUP_MINUTES before the reservation starts, and neither inspection can be recorded once the reservation is canceled or completed.\nThe inspection time, the current time when none is given, can not differ from the current time by more than INSPECTION_SKEW_MINUTES.\nAt pickup the security deposit of the car type is held on the deposit payment method. It is released when the car is returned without damages that were not there at pickup.\nA return after the grace period past the end of the reservation is charged a late fee per started hour by car type, captured from the held deposit up to its amount.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Handovers"
+                ],
+                "summary": "Record an inspection",
+                "operationId": "record-inspection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Reservation id",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inspection information (allowed types: Pickup, Return)",
+                        "name": "inspection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.InspectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Handover of the reservation",
+                        "schema": {
+                            "$ref": "#/definitions/docs.HandoverResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReturnMileageBelowPickup"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorReservationNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-rules": {
+            "post": {
+                "description": "Register a tax charged on the rentals of a city, such as a sales tax, a tourism levy or an airport surcharge.\nThe rule applies to the given parts of the price (Rental, Add-ons, Protection, Fees) and is rounded to cents as its jurisdiction does.\nRules with a branch are only charged on rentals picked up at that branch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TaxRules"
+                ],
+                "summary": "Register a new tax rule",
+                "operationId": "register-tax-rule",
+                "parameters": [
+                    {
+                        "description": "Tax rule information",
+                        "name": "tax_rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.TaxRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created tax rule",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TaxRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTaxRuleExists"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorCityNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-rules/{id}": {
+            "get": {
+                "description": "Get a tax rule by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TaxRules"
+                ],
+                "summary": "Get a tax rule",
+                "operationId": "get-tax-rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Tax rule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained tax rule",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TaxRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTaxRuleNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a tax rule by UUID. Reservations already booked keep the taxes they were booked with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TaxRules"
+                ],
+                "summary": "Update a tax rule",
+                "operationId": "update-tax-rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Tax rule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax rule information",
+                        "name": "tax_rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.TaxRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated tax rule",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TaxRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTaxRuleExists"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTaxRuleNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tax rule by UUID. Reservations already booked keep the taxes they were booked with.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TaxRules"
+                ],
+                "summary": "Delete a tax rule",
+                "operationId": "delete-tax-rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Tax rule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTaxRuleNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}": {
+            "get": {
+                "description": "Get a transfer by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Get a transfer",
+                "operationId": "get-transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Transfer UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained transfer",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancel a pending transfer by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Cancel a transfer",
+                "operationId": "cancel-transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Transfer UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferCompleted"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/complete": {
+            "post": {
+                "description": "Complete a transfer once the car has departed. The car is moved to the destination city and branch.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Complete a transfer",
+                "operationId": "complete-transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Transfer UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Completed transfer",
+                        "schema": {
+                            "$ref": "#/definitions/docs.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferCompleted"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorTransferNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Lists users in pages of 20 elements. from_user_id parameter\nis taken as the last seen user in a previous page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List users",
+                "operationId": "list-users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last seen user ID",
+                        "name": "from_user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User type (Customer, Admin)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User status (Active, Inactive)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case insensitive prefix of the email, first name, last name or full name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained users",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ListUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidUserType"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new user with the provided information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Register a new user",
+                "operationId": "register-user",
+                "parameters": [
+                    {
+                        "description": "User information (allowed types: Customer, Admin; allowed statuses: Active, Inactive)",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created user",
+                        "schema": {
+                            "$ref": "#/definitions/docs.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorEmailAlreadyRegistered"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/email-verification": {
+            "post": {
+                "description": "Verifies the email of a user with the token sent to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Verify an email",
+                "operationId": "verify-email",
+                "parameters": [
+                    {
+                        "description": "Email verification token",
+                        "name": "verification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.EmailVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidToken"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password-reset": {
+            "post": {
+                "description": "Sends a password reset token to the user with the given email. The response is the same\nwhether the email is registered or not. Tokens expire after PASSWORD_RESET_TOKEN_MINUTES.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Request a password reset",
+                "operationId": "request-password-reset",
+                "parameters": [
+                    {
+                        "description": "User email",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.PasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidEmail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password-reset/confirm": {
+            "post": {
+                "description": "Sets a new password with the token sent by email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reset a password",
+                "operationId": "reset-password",
+                "parameters": [
+                    {
+                        "description": "Password reset token and new password",
+                        "name": "reset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.PasswordResetConfirmationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidToken"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get a user by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user",
+                "operationId": "get-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained user",
+                        "schema": {
+                            "$ref": "#/definitions/docs.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a user by UUID. Deactivating a user lists their upcoming reservations in the response, as inactive users can not book.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "operationId": "update-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User information (allowed types: Customer, Admin; allowed statuses: Active, Inactive)",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated user",
+                        "schema": {
+                            "$ref": "#/definitions/docs.UserWithAffectedReservationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidEmail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a user by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "operationId": "delete-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/billing-profile": {
+            "get": {
+                "description": "Get the company details put on the invoices of a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Get the billing profile of a user",
+                "operationId": "get-billing-profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Billing profile",
+                        "schema": {
+                            "$ref": "#/definitions/docs.BillingProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorBillingProfileNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create or replace the company details put on the invoices of a user. Invoices already issued keep the details they were issued with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Save the billing profile of a user",
+                "operationId": "save-billing-profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Company details",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/docs.BillingProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Billing profile",
+                        "schema": {
+                            "$ref": "#/definitions/docs.BillingProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInvalidCountry"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/email-verification": {
+            "post": {
+                "description": "Sends a new email verification token to a user whose email is not verified yet.\nTokens expire after EMAIL_VERIFICATION_TOKEN_HOURS and can only be used once.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Send an email verification",
+                "operationId": "send-email-verification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorEmailAlreadyVerified"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorUserNotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/reservations": {
+            "get": {
+                "description": "Get reservations by User id, including those where the user is an additional driver",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reservations"
+                ],
+                "summary": "Get reservations by User id",
+                "operationId": "get-reservation-by-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User id",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Obtained reservations",
+                        "schema": {
+                            "$ref": "#/definitions/docs.Reservations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorinvalidUUID"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docs.ErrorInternalServer"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "docs.AddOnRequest": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Child seat"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "pricing_unit": {
+                    "type": "string",
+                    "example": "Per Day"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "docs.AddOnResponse": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Child seat"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "pricing_unit": {
+                    "type": "string",
+                    "example": "Per Day"
+                },
+                "stock": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "docs.BillingInfoResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Calle 93 #11-28"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "Bogota"
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Acme Logistics S.A.S."
+                },
+                "country": {
+                    "type": "string",
+                    "example": "CO"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ana.torres@acme.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ana Torres"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "example": "110221"
+                },
+                "tax_id": {
+                    "type": "string",
+                    "example": "900123456-7"
+                }
+            }
+        },
+        "docs.BillingProfileRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Calle 93 #11-28"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "Bogota"
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Acme Logistics S.A.S."
+                },
+                "country": {
+                    "type": "string",
+                    "example": "CO"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "example": "110221"
+                },
+                "tax_id": {
+                    "type": "string",
+                    "example": "900123456-7"
+                }
+            }
+        },
+        "docs.BillingProfileResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Calle 93 #11-28"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "Bogota"
+                },
+                "company_name": {
+                    "type": "string",
+                    "example": "Acme Logistics S.A.S."
+                },
+                "country": {
+                    "type": "string",
+                    "example": "CO"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "example": "110221"
+                },
+                "tax_id": {
+                    "type": "string",
+                    "example": "900123456-7"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "0b3c9a4e-2d8f-4c1a-b6e7-9f2d4a8c1e3b"
+                }
+            }
+        },
+        "docs.BranchClosure": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2030-12-25"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Christmas Day"
+                }
+            }
+        },
+        "docs.BranchRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "55 W Randolph St, Chicago, IL 60601"
+                },
+                "city_id": {
+                    "type": "string",
+                    "example": "1105a953-1dfe-470a-b6e7-f97f004f440b"
+                },
+                "closures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.BranchClosure"
+                    }
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 41.8837
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": -87.6289
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicago Downtown"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.OpeningHoursRequest"
+                    }
+                }
+            }
+        },
+        "docs.BranchResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "55 W Randolph St, Chicago, IL 60601"
+                },
+                "city_id": {
+                    "type": "string",
+                    "example": "1105a953-1dfe-470a-b6e7-f97f004f440b"
+                },
+                "closures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.BranchClosure"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 41.8837
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": -87.6289
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicago Downtown"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.OpeningHoursRequest"
+                    }
+                }
+            }
+        },
+        "docs.CarMileageResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "last_service_mileage": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "maintenance_due": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "mileage": {
+                    "type": "integer",
+                    "example": 15230
+                }
+            }
+        },
+        "docs.CarRequest": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
+                "city_name": {
+                    "type": "string",
+                    "example": "New York"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "Black"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "A/C",
+                        "GPS",
+                        "Bluetooth"
+                    ]
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "example": "Hybrid"
+                },
+                "hourly_rent_cost": {
+                    "type": "number",
+                    "example": 99.99
+                },
+                "license_plate": {
+                    "type": "string",
+                    "example": "NYC4821"
+                },
+                "make": {
+                    "type": "string",
+                    "example": "Mercedes-Benz"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "E-Class"
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Available"
+                },
+                "transmission": {
+                    "type": "string",
+                    "example": "Automatic"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Luxury"
+                },
+                "vin": {
+                    "type": "string",
+                    "example": "WDDZF4JB0KA512345"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
+                }
+            }
+        },
         "docs.CarResponse": {
             "type": "object",
             "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
+                "city_name": {
+                    "type": "string",
+                    "example": "New York"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "Black"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "A/C",
+                        "GPS",
+                        "Bluetooth"
+                    ]
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "example": "Hybrid"
+                },
+                "hourly_rent_cost": {
+                    "type": "number",
+                    "example": 99.99
+                },
+                "id": {
+                    "type": "string",
+                    "example": "bdaf243e-b4d3-49d7-8be4-5ed1fb4dba0e"
+                },
+                "license_plate": {
+                    "type": "string",
+                    "example": "NYC4821"
+                },
+                "make": {
+                    "type": "string",
+                    "example": "Mercedes-Benz"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "E-Class"
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Available"
+                },
+                "transmission": {
+                    "type": "string",
+                    "example": "Automatic"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Luxury"
+                },
+                "vin": {
+                    "type": "string",
+                    "example": "WDDZF4JB0KA512345"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
+                }
+            }
+        },
+        "docs.CarWithAffectedReservationsResponse": {
+            "type": "object",
+            "properties": {
+                "affected_reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReservationResponse"
+                    }
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
+                "city_name": {
+                    "type": "string",
+                    "example": "New York"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "Black"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "A/C",
+                        "GPS",
+                        "Bluetooth"
+                    ]
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "example": "Hybrid"
+                },
+                "hourly_rent_cost": {
+                    "type": "number",
+                    "example": 99.99
+                },
+                "id": {
+                    "type": "string",
+                    "example": "bdaf243e-b4d3-49d7-8be4-5ed1fb4dba0e"
+                },
+                "license_plate": {
+                    "type": "string",
+                    "example": "NYC4821"
+                },
+                "make": {
+                    "type": "string",
+                    "example": "Mercedes-Benz"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "E-Class"
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Available"
+                },
+                "transmission": {
+                    "type": "string",
+                    "example": "Automatic"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Luxury"
+                },
+                "vin": {
+                    "type": "string",
+                    "example": "WDDZF4JB0KA512345"
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "the unavailable car has upcoming reservations"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
+                }
+            }
+        },
+        "docs.CityRequest": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "US"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicago"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "America/Chicago"
+                }
+            }
+        },
+        "docs.CityResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "US"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a1c6b0a4-3d4e-4f5b-9a2f-1e7c3d9b8f10"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicago"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "America/Chicago"
+                }
+            }
+        },
+        "docs.ConstantValues": {
+            "type": "object",
+            "properties": {
+                "ADDITIONAL_DRIVER_DAILY_FEE": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "ADD_ON_PRICING_UNITS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "CARS_PER_PAGE": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "CAR_FEATURES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "CAR_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "CAR_TYPES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "CITIES_PER_PAGE": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "DAMAGE_REPORT_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "DATETIME_LAYOUT": {
+                    "type": "string",
+                    "example": "2006-01-02T15:04:05Z07:00"
+                },
+                "DEFAULT_PROTECTION_LEVEL": {
+                    "type": "string",
+                    "example": "Basic"
+                },
+                "DEPOSIT_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "DRIVER_REQUIREMENTS": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.DriverRequirement"
+                    }
+                },
+                "EMAIL_VERIFICATION_TOKEN_HOURS": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "FEES_CURRENCY": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "FUEL_TYPES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "INSPECTION_SKEW_MINUTES": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "INSPECTION_TYPES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "LATE_FEES": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.LateFee"
+                    }
+                },
+                "LATE_RETURN_GRACE_MINUTES": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "LEDGER_ACCOUNTS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "LEDGER_MOVEMENTS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "MAINTENANCES_PER_PAGE": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "MAINTENANCE_INTERVAL_KM": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "MAXIMUM_ADDITIONAL_DRIVERS": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "MAXIMUM_PHOTO_BYTES": {
+                    "type": "integer",
+                    "example": 5242880
+                },
+                "MAXIMUM_PHOTO_PIXELS": {
+                    "type": "integer",
+                    "example": 40000000
+                },
+                "MAXIMUM_SEARCH_RADIUS_KM": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "MINIMUM_DRIVER_AGE": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "MINIMUM_PASSWORD_LENGTH": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "MINIMUM_RESERVATION_HOURS": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "NULL_UUID": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "PASSWORD_RESET_TOKEN_MINUTES": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "PAYMENT_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "PROTECTION_LEVELS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "RESERVATIONS_PER_PAGE": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "RESERVATION_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "SECURITY_DEPOSITS": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.SecurityDeposit"
+                    }
+                },
+                "TAX_BASES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "TAX_ROUNDINGS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "THUMBNAIL_SIZE": {
+                    "type": "integer",
+                    "example": 256
+                },
+                "TRANSMISSIONS": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "USERS_PER_PAGE": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "USER_STATUSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "USER_TOKEN_PURPOSES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "USER_TYPES": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "docs.ConstantsResponse": {
+            "type": "object",
+            "properties": {
+                "loaded_at": {
+                    "type": "string",
+                    "example": "2023-05-15T10:00:00Z"
+                },
+                "values": {
+                    "$ref": "#/definitions/docs.ConstantValues"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "docs.DamagePhotoResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2027-05-16T18:05:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 482133
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "/api/v1/damage-reports/5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60/photos/7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c/thumbnail"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/v1/damage-reports/5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60/photos/7b0c3a1e-2f4d-4e5a-9b6c-1d2e3f4a5b6c"
+                }
+            }
+        },
+        "docs.DamageReportResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2027-05-16T18:05:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Scratch on rear bumper"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5e9a2f1c-8b3d-4c6e-a7f0-2b1c3d4e5f60"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.DamagePhotoResponse"
+                    }
+                },
+                "reporter_id": {
+                    "type": "string",
+                    "example": "c7d6e5f4-a3b2-4c1d-9e8f-7a6b5c4d3e2f"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "resolution": {
+                    "type": "string",
+                    "example": ""
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Open"
+                }
+            }
+        },
+        "docs.DamageReportStatusRequest": {
+            "type": "object",
+            "properties": {
+                "resolution": {
+                    "type": "string",
+                    "example": "Rear bumper repainted"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Resolved"
+                }
+            }
+        },
+        "docs.DamageReportsResponse": {
+            "type": "object",
+            "properties": {
+                "damage_reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.DamageReportResponse"
+                    }
+                }
+            }
+        },
+        "docs.DepositCaptureRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 350
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Dent on the rear door"
+                }
+            }
+        },
+        "docs.DepositResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 1500
+                },
+                "capture_reason": {
+                    "type": "string",
+                    "example": "Dent on the rear door"
+                },
+                "captured_amount": {
+                    "type": "number",
+                    "example": 350
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "held_at": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6c8e0a2b-4d6f-4a1c-9e3b-5d7f9a1c3e5b"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "fake_000002"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "settled_at": {
+                    "type": "string",
+                    "example": "2027-05-22T19:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Captured"
+                }
+            }
+        },
+        "docs.DisplayQuoteResponse": {
+            "type": "object",
+            "properties": {
+                "add_ons_cost": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "additional_drivers_fee": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "one_way_fee": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "protection_cost": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "rate": {
+                    "$ref": "#/definitions/docs.ExchangeRateResponse"
+                },
+                "rental_cost": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "tax_total": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                },
+                "total": {
+                    "$ref": "#/definitions/docs.MoneyResponse"
+                }
+            }
+        },
+        "docs.DriverLicense": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "GB"
+                },
+                "expiry_date": {
+                    "type": "string",
+                    "example": "2030-03-20T00:00:00Z"
+                },
+                "issue_date": {
+                    "type": "string",
+                    "example": "2010-03-20T00:00:00Z"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "N4172G"
+                }
+            }
+        },
+        "docs.DriverRequirement": {
+            "type": "object",
+            "properties": {
+                "CAR_TYPE": {
+                    "type": "string",
+                    "example": "Sports Car"
+                },
+                "MINIMUM_AGE": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "MINIMUM_LICENSE_YEARS": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "docs.EmailVerificationRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "0b7ae0a9-6c4a-4b41-9f7d-1c2f8e1a9d3e.q3Vh8C0x2mJzN5yQe1bR7tK4wL9uS6pD0fH2gA8iXcE"
+                }
+            }
+        },
+        "docs.ErrorAddOnHasReservations": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "add-on can not be deleted while it has reservations"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorAddOnNameInUse": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "branch already has an add-on with that name"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorAddOnNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "add-on not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorBillingProfileNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "billing profile was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorBranchHasCars": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "branch can not be moved or deleted while it has cars"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorBranchNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "branch not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorCarNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "car not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorCityHasCars": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "city can not be deleted while it has cars"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorCityNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "city not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorCityQueryParamEmpty": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "car not available"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "city query param can not be empty"
+                }
+            }
+        },
+        "docs.ErrorDamagePhotoNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "damage photo was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorDamageReportNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "damage report was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorDamageResolutionRequired": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "resolution is required to resolve a damage report"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorDepositNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "deposit not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorDepositNotHeld": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "deposit is not held"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorDriverLicenseExpires": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "driver license expires before the end of the reservation"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Forbidden"
+                }
+            }
+        },
+        "docs.ErrorEmailAlreadyRegistered": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "email already registered"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorEmailAlreadyVerified": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "email was already verified"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorExchangeRateNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "exchange rate not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorExchangeRateSameCurrency": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "exchange rate currencies must be different"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInternalServer": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "internal server error"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Internal server error"
+                }
+            }
+        },
+        "docs.ErrorInvalidCarStatus": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid car status"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidCarType": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid car type"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidCountry": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "country must be an ISO 3166-1 alpha-2 code"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidCurrency": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "currency must be an ISO 4217 code"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidEmail": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid email"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidExtensionEndDate": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "extension must end after the reservation and in the future"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidMaintenanceTimeFrame": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "maintenance time frame is invalid"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidOpeningHours": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "closing time must be after opening time"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidPaymentSignature": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid payment event signature"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidPaymentTransition": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "payment can not change from its current status (Canceled to Paid)"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidReservationStatus": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid reservation status"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidReservationTimeFrame": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "reservation time frame is invalid"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidSearchRadius": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "search radius must be greater than 0 and not exceed the maximum allowed (50 km)"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidTimeFrame": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid time frame"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidTimeZone": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "time zone is not a valid IANA time zone"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidToken": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "token is invalid, expired or was already used"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvalidUserType": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid user type"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorInvoiceNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invoice was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorLicensePlateAlreadyRegistered": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "license plate already registered"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorMaintenanceNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "maintenance was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorMaintenanceOverlap": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "car already has a maintenance scheduled during the requested time frame"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorMinimumReservationHours": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "period is shorter than minimun allowed (6 hours)"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorOneWayFeeNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "one-way fee not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorOneWayFeeSameCity": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "one-way fee cities must be different"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorPaymentInProgress": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "reservation already has a payment in progress"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorPaymentNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "payment not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorPhotoTooLarge": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "photo exceeds the maximum allowed size"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorProtectionPlanExists": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "car type already has a plan of that protection level"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorProtectionPlanNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "protection plan not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorRefundExceedsCaptured": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "refund exceeds the captured amount left (80.00 left)"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorReservationInvoiced": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "reservations with an invoice cannot be deleted"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorReservationNotCompleted": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invoices can only be issued for completed reservations"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorReservationNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "reservation was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorReturnMileageBelowPickup": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "return mileage cannot be lower than pickup mileage"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorTaxRuleExists": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "city already has a tax rule with that name"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorTaxRuleNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "tax rule not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorTooManyAdditionalDrivers": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "reservation has more additional drivers than allowed (3 drivers)"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorTransferCompleted": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "transfer was already completed"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorTransferNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "transfer was not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorTransferOverlapsReservations": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "car has reservations ending after the departure of the transfer"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorUnsupportedInvoiceFormat": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invoice format is not supported"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorUnsupportedPhotoType": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "photo content type is not supported"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ErrorUserEmailNotVerified": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "user email is not verified"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 403
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Forbidden"
+                }
+            }
+        },
+        "docs.ErrorUserNotFound": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "user not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "docs.ErrorinvalidUUID": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "id could not be converted to uuid"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "docs.ExchangeRateRequest": {
+            "type": "object",
+            "properties": {
+                "rate": {
+                    "type": "number",
+                    "example": 0.9215
+                }
+            }
+        },
+        "docs.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.9215
+                },
+                "to": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-10-19T12:00:00Z"
+                }
+            }
+        },
+        "docs.ExtensionConflictResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "conflicting_reservation": {
+                    "$ref": "#/definitions/docs.ReservationResponse"
+                },
+                "cost": {
+                    "type": "number",
+                    "example": 0
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-24T18:00:00-05:00"
+                },
+                "maximum_end_date": {
+                    "type": "string",
+                    "example": "2027-05-23T10:00:00-05:00"
+                },
+                "previous_end_date": {
+                    "type": "string",
+                    "example": "2027-05-22T18:00:00-05:00"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                }
+            }
+        },
+        "docs.ExtensionRequest": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-24T18:00:00-05:00"
+                }
+            }
+        },
+        "docs.ExtensionResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "cost": {
+                    "type": "number",
+                    "example": 480
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-24T18:00:00-05:00"
+                },
+                "previous_end_date": {
+                    "type": "string",
+                    "example": "2027-05-22T18:00:00-05:00"
+                },
+                "reservation": {
+                    "$ref": "#/definitions/docs.ReservationResponse"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                }
+            }
+        },
+        "docs.HandoverResponse": {
+            "type": "object",
+            "properties": {
+                "car_mileage": {
+                    "$ref": "#/definitions/docs.CarMileageResponse"
+                },
+                "deposit": {
+                    "$ref": "#/definitions/docs.DepositResponse"
+                },
+                "distance_driven": {
+                    "type": "integer",
+                    "example": 230
+                },
+                "late_return": {
+                    "$ref": "#/definitions/docs.LateReturnResponse"
+                },
+                "pickup": {
+                    "$ref": "#/definitions/docs.InspectionResponse"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "return": {
+                    "$ref": "#/definitions/docs.InspectionResponse"
+                },
+                "warnings": {
+                    "description": "Settlements that failed after the handover was recorded",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "invoice could not be issued: connection refused"
+                    ]
+                }
+            }
+        },
+        "docs.InspectionRequest": {
+            "type": "object",
+            "properties": {
+                "damages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Scratch on rear bumper"
+                    ]
+                },
+                "fuel_level": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "inspected_at": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "mileage": {
+                    "type": "integer",
+                    "example": 15230
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Interior clean"
+                },
+                "payment_method": {
+                    "description": "Holds the security deposit at pickup and is charged the late fee at return",
+                    "type": "string",
+                    "example": "tok_visa"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Return"
+                }
+            }
+        },
+        "docs.InspectionResponse": {
+            "type": "object",
+            "properties": {
+                "damages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Scratch on rear bumper"
+                    ]
+                },
+                "fuel_level": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+                },
+                "inspected_at": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "mileage": {
+                    "type": "integer",
+                    "example": 15230
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Interior clean"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Return"
+                }
+            }
+        },
+        "docs.InvoiceLineResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 450
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Car rental"
+                }
+            }
+        },
+        "docs.InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "billing": {
+                    "$ref": "#/definitions/docs.BillingInfoResponse"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5e2c7a9d-1b3f-4d6e-8a0c-2f4b6d8e0a1c"
+                },
+                "issued_at": {
+                    "type": "string",
+                    "example": "2027-05-22T19:00:00Z"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.InvoiceLineResponse"
+                    }
+                },
+                "number": {
+                    "type": "string",
+                    "example": "INV-2027-000042"
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "subtotal": {
+                    "type": "number",
+                    "example": 500
+                },
+                "tax_total": {
+                    "type": "number",
+                    "example": 0
+                },
+                "taxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.InvoiceTaxResponse"
+                    }
+                },
+                "total": {
+                    "type": "number",
+                    "example": 500
+                }
+            }
+        },
+        "docs.InvoiceTaxResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 41.25
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Sales tax"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.0825
+                }
+            }
+        },
+        "docs.LateFee": {
+            "type": "object",
+            "properties": {
+                "CAR_TYPE": {
+                    "type": "string",
+                    "example": "Sports Car"
+                },
+                "HOURLY_FEE": {
+                    "type": "number",
+                    "example": 60
+                }
+            }
+        },
+        "docs.LateReturnResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "due_at": {
+                    "type": "string",
+                    "example": "2027-05-22T23:00:00Z"
+                },
+                "fee": {
+                    "type": "number",
+                    "example": 120
+                },
+                "hourly_fee": {
+                    "type": "number",
+                    "example": 40
+                },
+                "hours_charged": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "minutes_late": {
+                    "type": "integer",
+                    "example": 130
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "returned_at": {
+                    "type": "string",
+                    "example": "2027-05-23T01:10:00Z"
+                }
+            }
+        },
+        "docs.LedgerBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.LedgerEntryResponse"
+                    }
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
+                },
+                "total": {
+                    "type": "number",
+                    "example": 0
+                }
+            }
+        },
+        "docs.LedgerEntryResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string",
+                    "example": "Customer"
+                },
+                "amount": {
+                    "type": "number",
+                    "example": 500
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1f0b6a3e-7c2d-4e9a-8b5f-3d1c7e9a2b4f"
+                },
+                "movement": {
+                    "type": "string",
+                    "example": "Charge"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "example": "a4c2e8f0-3b1d-4f6a-9c7e-5b3d1f9a7c2e"
+                }
+            }
+        },
+        "docs.ListAddOnsResponse": {
+            "type": "object",
+            "properties": {
+                "add_ons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.AddOnResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListBranchesResponse": {
+            "type": "object",
+            "properties": {
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.BranchResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListCarsResponse": {
+            "type": "object",
+            "properties": {
+                "cars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.CarResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListCitiesResponse": {
+            "type": "object",
+            "properties": {
+                "cities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.CityResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "exchange_rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ExchangeRateResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListNearbyCarsResponse": {
+            "type": "object",
+            "properties": {
+                "cars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.NearbyCarResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListOneWayFeesResponse": {
+            "type": "object",
+            "properties": {
+                "one_way_fees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.OneWayFeeResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListOverdueRentalsResponse": {
+            "type": "object",
+            "properties": {
+                "overdue_rentals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.OverdueRentalResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListProtectionPlansResponse": {
+            "type": "object",
+            "properties": {
+                "protection_plans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ProtectionPlanResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListTaxRulesResponse": {
+            "type": "object",
+            "properties": {
+                "tax_rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TaxRuleResponse"
+                    }
+                }
+            }
+        },
+        "docs.ListUsersResponse": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.UserResponse"
+                    }
+                }
+            }
+        },
+        "docs.MaintenanceRequest": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Brakes replacement"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                }
+            }
+        },
+        "docs.MaintenanceResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5b6f3c8e-3f0a-4d51-9b7a-1c2d3e4f5a6b"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Brakes replacement"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                }
+            }
+        },
+        "docs.MaintenanceUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Brakes replacement"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                }
+            }
+        },
+        "docs.MaintenanceWithConflictsResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "conflicting_reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReservationResponse"
+                    }
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5b6f3c8e-3f0a-4d51-9b7a-1c2d3e4f5a6b"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Brakes replacement"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2027-05-15T10:00:00Z"
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "the maintenance collides with existing reservations"
+                }
+            }
+        },
+        "docs.Maintenances": {
+            "type": "object",
+            "properties": {
+                "maintenances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.MaintenanceResponse"
+                    }
+                }
+            }
+        },
+        "docs.MoneyResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 2044.66
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                }
+            }
+        },
+        "docs.NearbyCarResponse": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
                 "city_name": {
                     "type": "string",
                     "example": "New York"
                 },
+                "color": {
+                    "type": "string",
+                    "example": "Black"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "distance_km": {
+                    "type": "number",
+                    "example": 3.482
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "A/C",
+                        "GPS",
+                        "Bluetooth"
+                    ]
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "example": "Hybrid"
+                },
                 "hourly_rent_cost": {
                     "type": "number",
                     "example": 99.99
@@ -871,6 +7786,21 @@ const docTemplate = `{
                     "type": "string",
                     "example": "bdaf243e-b4d3-49d7-8be4-5ed1fb4dba0e"
                 },
+                "license_plate": {
+                    "type": "string",
+                    "example": "NYC4821"
+                },
+                "make": {
+                    "type": "string",
+                    "example": "Mercedes-Benz"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "E-Class"
+                },
+                "pickup_branch": {
+                    "$ref": "#/definitions/docs.PickupBranchResponse"
+                },
                 "seats": {
                     "type": "integer",
                     "example": 4
@@ -879,280 +7809,397 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Available"
                 },
+                "transmission": {
+                    "type": "string",
+                    "example": "Automatic"
+                },
                 "type": {
                     "type": "string",
                     "example": "Luxury"
+                },
+                "vin": {
+                    "type": "string",
+                    "example": "WDDZF4JB0KA512345"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2022
                 }
             }
         },
-        "docs.ErrorCarNotFound": {
+        "docs.OneWayFeeRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "currency": {
                     "type": "string",
-                    "example": "car not found"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 404
+                    "example": "USD"
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Not Found"
+                "fee": {
+                    "type": "number",
+                    "example": 150
                 }
             }
         },
-        "docs.ErrorCityQueryParamEmpty": {
+        "docs.OneWayFeeResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "currency": {
                     "type": "string",
-                    "example": "car not available"
+                    "example": "USD"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "fee": {
+                    "type": "number",
+                    "example": 150
                 },
-                "title": {
+                "from_city_id": {
                     "type": "string",
-                    "example": "city query param can not be empty"
+                    "example": "1105a953-1dfe-470a-b6e7-f97f004f440b"
+                },
+                "to_city_id": {
+                    "type": "string",
+                    "example": "f5a6bac3-bb97-4de5-acb1-f7c2a7bc243b"
                 }
             }
         },
-        "docs.ErrorEmailAlreadyRegistered": {
+        "docs.OpeningHoursRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "closes_at": {
                     "type": "string",
-                    "example": "email already registered"
+                    "example": "21:00"
                 },
-                "status": {
+                "opens_at": {
+                    "type": "string",
+                    "example": "07:00"
+                },
+                "weekday": {
                     "type": "integer",
-                    "example": 400
+                    "example": 1
+                }
+            }
+        },
+        "docs.OverdueNoticeResponse": {
+            "type": "object",
+            "properties": {
+                "next_reservation_id": {
+                    "type": "string",
+                    "example": "9a4c6e82-0b1d-4f3a-8c5e-7d9f1b3a5c70"
                 },
-                "title": {
+                "notified_at": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "2027-05-23T00:15:00Z"
                 }
             }
         },
-        "docs.ErrorInternalServer": {
+        "docs.OverdueRentalResponse": {
             "type": "object",
             "properties": {
-                "detail": {
-                    "type": "string",
-                    "example": "internal server error"
+                "lateness": {
+                    "$ref": "#/definitions/docs.LateReturnResponse"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 500
+                "next_reservation": {
+                    "$ref": "#/definitions/docs.ReservationResponse"
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Internal server error"
+                "notice": {
+                    "$ref": "#/definitions/docs.OverdueNoticeResponse"
+                },
+                "reservation": {
+                    "$ref": "#/definitions/docs.ReservationResponse"
                 }
             }
         },
-        "docs.ErrorInvalidCarStatus": {
+        "docs.PasswordResetConfirmationRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "password": {
                     "type": "string",
-                    "example": "invalid car status"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                    "example": "correct horse battery staple"
                 },
-                "title": {
+                "token": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "0b7ae0a9-6c4a-4b41-9f7d-1c2f8e1a9d3e.q3Vh8C0x2mJzN5yQe1bR7tK4wL9uS6pD0fH2gA8iXcE"
                 }
             }
         },
-        "docs.ErrorInvalidCityName": {
+        "docs.PasswordResetRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "email": {
+                    "type": "string",
+                    "example": "isaac.newton@cam.ac.uk"
+                }
+            }
+        },
+        "docs.PaymentAuthorizationRequest": {
+            "type": "object",
+            "properties": {
+                "payment_method": {
+                    "type": "string",
+                    "example": "tok_visa"
+                }
+            }
+        },
+        "docs.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 580
+                },
+                "captured_amount": {
+                    "type": "number",
+                    "example": 580
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2027-05-10T14:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
                     "type": "string",
-                    "example": "city name is not valid"
+                    "example": "4e6a8c2d-1b3f-4d5a-9e7c-8b0d2f4a6c1e"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "fake_000001"
+                },
+                "refunded_amount": {
+                    "type": "number",
+                    "example": 0
+                },
+                "reservation_id": {
+                    "type": "string",
+                    "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
                 },
                 "status": {
-                    "type": "integer",
-                    "example": 400
+                    "type": "string",
+                    "example": "Paid"
                 },
-                "title": {
+                "tax_amount": {
+                    "type": "number",
+                    "example": 52.73
+                },
+                "updated_at": {
                     "type": "string",
-                    "example": "Bad request"
+                    "example": "2027-05-15T10:05:00Z"
                 }
             }
         },
-        "docs.ErrorInvalidEmail": {
+        "docs.PickupBranchResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "address": {
                     "type": "string",
-                    "example": "invalid email"
+                    "example": "10000 W O'Hare Ave"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
                 },
-                "title": {
+                "latitude": {
+                    "type": "number",
+                    "example": 41.9786
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": -87.9048
+                },
+                "name": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "O'Hare Airport"
                 }
             }
         },
-        "docs.ErrorInvalidReservationStatus": {
+        "docs.ProtectionPlanRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "car_type": {
                     "type": "string",
-                    "example": "invalid reservation status"
+                    "example": "Sedan"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
                 },
-                "title": {
+                "daily_price": {
+                    "type": "number",
+                    "example": 15
+                },
+                "deductible": {
+                    "type": "number",
+                    "example": 500
+                },
+                "level": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "Standard"
                 }
             }
         },
-        "docs.ErrorInvalidReservationTimeFrame": {
+        "docs.ProtectionPlanResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "car_type": {
                     "type": "string",
-                    "example": "reservation time frame is invalid"
+                    "example": "Sedan"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
                 },
-                "title": {
+                "daily_price": {
+                    "type": "number",
+                    "example": 15
+                },
+                "deductible": {
+                    "type": "number",
+                    "example": 500
+                },
+                "id": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "7e3a1c52-8d4b-4f6e-a2c9-5b1d3f7e9a04"
+                },
+                "level": {
+                    "type": "string",
+                    "example": "Standard"
                 }
             }
         },
-        "docs.ErrorInvalidTimeFrame": {
+        "docs.QuoteResponse": {
             "type": "object",
             "properties": {
-                "detail": {
-                    "type": "string",
-                    "example": "invalid time frame"
+                "add_ons_cost": {
+                    "type": "number",
+                    "example": 100
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "additional_drivers_fee": {
+                    "type": "number",
+                    "example": 100
                 },
-                "title": {
+                "currency": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "USD"
+                },
+                "display": {
+                    "$ref": "#/definitions/docs.DisplayQuoteResponse"
+                },
+                "one_way_fee": {
+                    "type": "number",
+                    "example": 150
+                },
+                "protection_cost": {
+                    "type": "number",
+                    "example": 105
+                },
+                "rental_cost": {
+                    "type": "number",
+                    "example": 1440
+                },
+                "subtotal": {
+                    "type": "number",
+                    "example": 1895
+                },
+                "tax_total": {
+                    "type": "number",
+                    "example": 323.84
+                },
+                "taxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReservationTaxResponse"
+                    }
+                },
+                "total": {
+                    "type": "number",
+                    "example": 2218.84
+                }
+            }
+        },
+        "docs.RefundRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 50
                 }
             }
         },
-        "docs.ErrorMinimumReservationHours": {
+        "docs.ReservationAddOnRequest": {
             "type": "object",
             "properties": {
-                "detail": {
+                "add_on_id": {
                     "type": "string",
-                    "example": "period is shorter than minimun allowed (6 hours)"
+                    "example": "9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"
                 },
-                "status": {
+                "quantity": {
                     "type": "integer",
-                    "example": 400
-                },
-                "title": {
-                    "type": "string",
-                    "example": "Bad Request"
+                    "example": 1
                 }
             }
         },
-        "docs.ErrorReservationNotFound": {
+        "docs.ReservationAddOnResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "add_on_id": {
                     "type": "string",
-                    "example": "reservation was not found"
+                    "example": "9c4e2b17-5a3d-4f8e-b1c6-7d2a9e0f3b58"
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 404
+                "cost": {
+                    "type": "number",
+                    "example": 100
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Not Found"
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "docs.ErrorUserNotFound": {
+        "docs.ReservationProtectionRequest": {
             "type": "object",
             "properties": {
-                "detail": {
-                    "type": "string",
-                    "example": "user not found"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 404
-                },
-                "title": {
+                "level": {
                     "type": "string",
-                    "example": "Not Found"
+                    "example": "Standard"
                 }
             }
         },
-        "docs.ErrorinvalidUUID": {
+        "docs.ReservationProtectionResponse": {
             "type": "object",
             "properties": {
-                "detail": {
-                    "type": "string",
-                    "example": "id could not be converted to uuid"
+                "daily_price": {
+                    "type": "number",
+                    "example": 15
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 400
+                "deductible": {
+                    "type": "number",
+                    "example": 500
                 },
-                "title": {
+                "level": {
                     "type": "string",
-                    "example": "Bad Request"
+                    "example": "Standard"
                 }
             }
         },
-        "docs.ListCarsResponse": {
+        "docs.ReservationRequest": {
             "type": "object",
             "properties": {
-                "cars": {
+                "add_ons": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docs.CarResponse"
+                        "$ref": "#/definitions/docs.ReservationAddOnRequest"
                     }
-                }
-            }
-        },
-        "docs.ListCitiesNameResponse": {
-            "type": "object",
-            "properties": {
-                "cities_name": {
+                },
+                "additional_driver_ids": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "Chicago",
-                        "Los Angeles",
-                        "New York"
+                        "6b2e8c14-1f3a-4d5b-9c7e-2a4f6d8b0c13"
                     ]
-                }
-            }
-        },
-        "docs.ReservationRequest": {
-            "type": "object",
-            "properties": {
+                },
                 "car_id": {
                     "type": "string",
                     "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
@@ -1161,9 +8208,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2023-05-16T18:00:00Z"
                 },
-                "payment_status": {
+                "pickup_branch_id": {
                     "type": "string",
-                    "example": "Paid"
+                    "example": "3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"
+                },
+                "protection": {
+                    "$ref": "#/definitions/docs.ReservationProtectionRequest"
+                },
+                "return_branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
                 },
                 "start_date": {
                     "type": "string",
@@ -1182,36 +8236,98 @@ const docTemplate = `{
         "docs.ReservationResponse": {
             "type": "object",
             "properties": {
+                "add_ons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReservationAddOnResponse"
+                    }
+                },
+                "additional_driver_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "6b2e8c14-1f3a-4d5b-9c7e-2a4f6d8b0c13"
+                    ]
+                },
                 "car_id": {
                     "type": "string",
                     "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "deposit_status": {
+                    "type": "string",
+                    "example": "Pending"
+                },
                 "end_date": {
                     "type": "string",
-                    "example": "2027-05-22T18:00:00Z"
+                    "example": "2027-05-22T18:00:00-05:00"
                 },
                 "id": {
                     "type": "string",
                     "example": "882dfcf8-98c9-4a25-9637-ae4564928b10"
                 },
+                "one_way_fee": {
+                    "type": "number",
+                    "example": 150
+                },
                 "payment_status": {
                     "type": "string",
                     "example": "Paid"
                 },
+                "pickup_branch_id": {
+                    "type": "string",
+                    "example": "3f1c2a9e-6b7d-4e21-9a55-2c8d1e0f7a31"
+                },
+                "protection": {
+                    "$ref": "#/definitions/docs.ReservationProtectionResponse"
+                },
+                "quote": {
+                    "$ref": "#/definitions/docs.QuoteResponse"
+                },
+                "return_branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
                 "start_date": {
                     "type": "string",
-                    "example": "2027-05-15T10:00:00Z"
+                    "example": "2027-05-15T10:00:00-05:00"
                 },
                 "status": {
                     "type": "string",
                     "example": "Reserved"
                 },
+                "time_zone": {
+                    "type": "string",
+                    "example": "America/Chicago"
+                },
                 "user_id": {
                     "type": "string",
                     "example": "a29b1af4-9650-4379-8a8b-7f6c4d374e7f"
                 }
             }
         },
+        "docs.ReservationTaxResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 194.24
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Sales tax"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.1025
+                }
+            }
+        },
         "docs.Reservations": {
             "type": "object",
             "properties": {
@@ -1223,9 +8339,180 @@ const docTemplate = `{
                 }
             }
         },
+        "docs.SecurityDeposit": {
+            "type": "object",
+            "properties": {
+                "AMOUNT": {
+                    "type": "number",
+                    "example": 1500
+                },
+                "CAR_TYPE": {
+                    "type": "string",
+                    "example": "Sports Car"
+                }
+            }
+        },
+        "docs.TaxRuleRequest": {
+            "type": "object",
+            "properties": {
+                "applies_to": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Rental",
+                        "Add-ons"
+                    ]
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0"
+                },
+                "city_id": {
+                    "type": "string",
+                    "example": "1105a953-1dfe-470a-b6e7-f97f004f440b"
+                },
+                "jurisdiction": {
+                    "type": "string",
+                    "example": "Chicago Department of Aviation"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Airport surcharge"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.11
+                },
+                "rounding": {
+                    "type": "string",
+                    "example": "Up"
+                }
+            }
+        },
+        "docs.TaxRuleResponse": {
+            "type": "object",
+            "properties": {
+                "applies_to": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Rental",
+                        "Add-ons"
+                    ]
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0"
+                },
+                "city_id": {
+                    "type": "string",
+                    "example": "1105a953-1dfe-470a-b6e7-f97f004f440b"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5d2f8b14-7a3c-4e9d-b6f1-0c2e4a6b8d13"
+                },
+                "jurisdiction": {
+                    "type": "string",
+                    "example": "Chicago Department of Aviation"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Airport surcharge"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 0.11
+                },
+                "rounding": {
+                    "type": "string",
+                    "example": "Up"
+                }
+            }
+        },
+        "docs.TransferRequest": {
+            "type": "object",
+            "properties": {
+                "arrival_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "departure_date": {
+                    "type": "string",
+                    "example": "2027-05-15T08:00:00Z"
+                },
+                "to_branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
+                "to_city_id": {
+                    "type": "string",
+                    "example": "7f1c9a52-3d4e-4b8a-9c6d-2e5f8a1b3c4d"
+                }
+            }
+        },
+        "docs.TransferResponse": {
+            "type": "object",
+            "properties": {
+                "arrival_date": {
+                    "type": "string",
+                    "example": "2027-05-16T18:00:00Z"
+                },
+                "car_id": {
+                    "type": "string",
+                    "example": "0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2027-05-16T17:30:00Z"
+                },
+                "departure_date": {
+                    "type": "string",
+                    "example": "2027-05-15T08:00:00Z"
+                },
+                "from_city_id": {
+                    "type": "string",
+                    "example": "3a8b6c4d-2e1f-4a9b-8c7d-6e5f4a3b2c1d"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9c2e4b7a-1f3d-4a6c-8e5b-7d9f1a3c5e2b"
+                },
+                "to_branch_id": {
+                    "type": "string",
+                    "example": "5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"
+                },
+                "to_city_id": {
+                    "type": "string",
+                    "example": "7f1c9a52-3d4e-4b8a-9c6d-2e5f8a1b3c4d"
+                }
+            }
+        },
+        "docs.Transfers": {
+            "type": "object",
+            "properties": {
+                "transfers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.TransferResponse"
+                    }
+                }
+            }
+        },
         "docs.UserRequest": {
             "type": "object",
             "properties": {
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1990-01-04T00:00:00Z"
+                },
+                "driver_license": {
+                    "$ref": "#/definitions/docs.DriverLicense"
+                },
                 "email": {
                     "type": "string",
                     "example": "isaac.newton@cam.ac.uk"
@@ -1251,10 +8538,67 @@ const docTemplate = `{
         "docs.UserResponse": {
             "type": "object",
             "properties": {
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1990-01-04T00:00:00Z"
+                },
+                "driver_license": {
+                    "$ref": "#/definitions/docs.DriverLicense"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "isaac.newton@cam.ac.uk"
+                },
+                "email_verified_at": {
+                    "type": "string",
+                    "example": "2023-05-15T10:00:00Z"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "Isaac"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Newton"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Active"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Customer"
+                }
+            }
+        },
+        "docs.UserWithAffectedReservationsResponse": {
+            "type": "object",
+            "properties": {
+                "affected_reservations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docs.ReservationResponse"
+                    }
+                },
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1990-01-04T00:00:00Z"
+                },
+                "driver_license": {
+                    "$ref": "#/definitions/docs.DriverLicense"
+                },
                 "email": {
                     "type": "string",
                     "example": "isaac.newton@cam.ac.uk"
                 },
+                "email_verified_at": {
+                    "type": "string",
+                    "example": "2023-05-15T10:00:00Z"
+                },
                 "first_name": {
                     "type": "string",
                     "example": "Isaac"
@@ -1274,6 +8618,10 @@ const docTemplate = `{
                 "type": {
                     "type": "string",
                     "example": "Customer"
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "the inactive user has upcoming reservations"
                 }
             }
         }
@@ -0,0 +1,34 @@
+package docs
+
+import "github.com/google/uuid"
+
+type TaxRuleRequest struct {
+	CityID       uuid.UUID  `json:"city_id" example:"1105a953-1dfe-470a-b6e7-f97f004f440b"`
+	BranchID     *uuid.UUID `json:"branch_id" example:"8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0"`
+	Name         string     `json:"name" example:"Airport surcharge"`
+	Jurisdiction string     `json:"jurisdiction" example:"Chicago Department of Aviation"`
+	Rate         float64    `json:"rate" example:"0.11"`
+	AppliesTo    []string   `json:"applies_to" example:"Rental,Add-ons"`
+	Rounding     string     `json:"rounding" example:"Up"`
+}
+
+type TaxRuleResponse struct {
+	ID           uuid.UUID  `json:"id" example:"5d2f8b14-7a3c-4e9d-b6f1-0c2e4a6b8d13"`
+	CityID       uuid.UUID  `json:"city_id" example:"1105a953-1dfe-470a-b6e7-f97f004f440b"`
+	BranchID     *uuid.UUID `json:"branch_id" example:"8a4e6b12-0c3d-4f5a-b7e9-91d2c4a6e8f0"`
+	Name         string     `json:"name" example:"Airport surcharge"`
+	Jurisdiction string     `json:"jurisdiction" example:"Chicago Department of Aviation"`
+	Rate         float64    `json:"rate" example:"0.11"`
+	AppliesTo    []string   `json:"applies_to" example:"Rental,Add-ons"`
+	Rounding     string     `json:"rounding" example:"Up"`
+}
+
+type ListTaxRulesResponse struct {
+	TaxRules []TaxRuleResponse `json:"tax_rules"`
+}
+
+type ReservationTaxResponse struct {
+	Name   string  `json:"name" example:"Sales tax"`
+	Rate   float64 `json:"rate" example:"0.1025"`
+	Amount float64 `json:"amount" example:"194.24"`
+}
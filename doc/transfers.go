@@ -0,0 +1,29 @@
+package docs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Transfers struct {
+	Transfers []TransferResponse `json:"transfers"`
+}
+
+type TransferRequest struct {
+	ToCityID      uuid.UUID  `json:"to_city_id" example:"7f1c9a52-3d4e-4b8a-9c6d-2e5f8a1b3c4d"`
+	ToBranchID    *uuid.UUID `json:"to_branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+	DepartureDate time.Time  `json:"departure_date" example:"2027-05-15T08:00:00Z"`
+	ArrivalDate   time.Time  `json:"arrival_date" example:"2027-05-16T18:00:00Z"`
+}
+
+type TransferResponse struct {
+	ID            uuid.UUID  `json:"id,omitempty" example:"9c2e4b7a-1f3d-4a6c-8e5b-7d9f1a3c5e2b"`
+	CarID         uuid.UUID  `json:"car_id" example:"0ddac1d8-c7f2-44a6-8c7e-3d06410f7be1"`
+	FromCityID    uuid.UUID  `json:"from_city_id" example:"3a8b6c4d-2e1f-4a9b-8c7d-6e5f4a3b2c1d"`
+	ToCityID      uuid.UUID  `json:"to_city_id" example:"7f1c9a52-3d4e-4b8a-9c6d-2e5f8a1b3c4d"`
+	ToBranchID    *uuid.UUID `json:"to_branch_id,omitempty" example:"5d7f9b31-2e4a-4c6d-8f1b-3a5c7e9d1b24"`
+	DepartureDate time.Time  `json:"departure_date" example:"2027-05-15T08:00:00Z"`
+	ArrivalDate   time.Time  `json:"arrival_date" example:"2027-05-16T18:00:00Z"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty" example:"2027-05-16T17:30:00Z"`
+}
@@ -1,24 +1,59 @@
 package docs
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type ListUsersResponse struct {
 	Users []UserResponse `json:"users"`
 }
 
 type UserRequest struct {
-	FirstName string `json:"first_name" example:"Isaac"`
-	LastName  string `json:"last_name" example:"Newton"`
-	Email     string `json:"email" example:"isaac.newton@cam.ac.uk"`
-	Type      string `json:"type" example:"Customer"`
-	Status    string `json:"status" example:"Active"`
+	FirstName     string         `json:"first_name" example:"Isaac"`
+	LastName      string         `json:"last_name" example:"Newton"`
+	Email         string         `json:"email" example:"isaac.newton@cam.ac.uk"`
+	Type          string         `json:"type" example:"Customer"`
+	Status        string         `json:"status" example:"Active"`
+	DateOfBirth   *time.Time     `json:"date_of_birth,omitempty" example:"1990-01-04T00:00:00Z"`
+	DriverLicense *DriverLicense `json:"driver_license,omitempty"`
+}
+
+type DriverLicense struct {
+	Number     string    `json:"number" example:"N4172G"`
+	Country    string    `json:"country" example:"GB"`
+	IssueDate  time.Time `json:"issue_date" example:"2010-03-20T00:00:00Z"`
+	ExpiryDate time.Time `json:"expiry_date" example:"2030-03-20T00:00:00Z"`
 }
 
 type UserResponse struct {
-	ID        uuid.UUID `json:"id,omitempty" example:"b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387"`
-	FirstName string    `json:"first_name" example:"Isaac"`
-	LastName  string    `json:"last_name" example:"Newton"`
-	Email     string    `json:"email" example:"isaac.newton@cam.ac.uk"`
-	Type      string    `json:"type" example:"Customer"`
-	Status    string    `json:"status" example:"Active"`
+	ID              uuid.UUID      `json:"id,omitempty" example:"b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387"`
+	FirstName       string         `json:"first_name" example:"Isaac"`
+	LastName        string         `json:"last_name" example:"Newton"`
+	Email           string         `json:"email" example:"isaac.newton@cam.ac.uk"`
+	Type            string         `json:"type" example:"Customer"`
+	Status          string         `json:"status" example:"Active"`
+	EmailVerifiedAt *time.Time     `json:"email_verified_at,omitempty" example:"2023-05-15T10:00:00Z"`
+	DateOfBirth     *time.Time     `json:"date_of_birth,omitempty" example:"1990-01-04T00:00:00Z"`
+	DriverLicense   *DriverLicense `json:"driver_license,omitempty"`
+}
+
+type UserWithAffectedReservationsResponse struct {
+	UserResponse
+	AffectedReservations []ReservationResponse `json:"affected_reservations"`
+	Warning              string                `json:"warning,omitempty" example:"the inactive user has upcoming reservations"`
+}
+
+type EmailVerificationRequest struct {
+	Token string `json:"token" example:"0b7ae0a9-6c4a-4b41-9f7d-1c2f8e1a9d3e.q3Vh8C0x2mJzN5yQe1bR7tK4wL9uS6pD0fH2gA8iXcE"`
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email" example:"isaac.newton@cam.ac.uk"`
+}
+
+type PasswordResetConfirmationRequest struct {
+	Token    string `json:"token" example:"0b7ae0a9-6c4a-4b41-9f7d-1c2f8e1a9d3e.q3Vh8C0x2mJzN5yQe1bR7tK4wL9uS6pD0fH2gA8iXcE"`
+	Password string `json:"password" example:"correct horse battery staple"`
 }
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Accessory, such as a child seat or a GPS unit, rented along with the cars of
+// a branch. Stock is the number of units the branch has.
+type AddOn struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	BranchID    uuid.UUID `json:"branch_id"`
+	Name        string    `json:"name"`
+	Stock       int       `json:"stock"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	PricingUnit string    `json:"pricing_unit"`
+}
+
+// Units of an add-on selected for a reservation and what they cost for the
+// whole reservation
+type ReservationAddOn struct {
+	AddOnID  uuid.UUID `json:"add_on_id"`
+	Quantity int       `json:"quantity"`
+	Cost     Money     `json:"cost"`
+}
+
+// Units of an add-on taken by a reservation during its time frame
+type AddOnBooking struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Quantity      int       `json:"quantity"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+}
+
+// Gets the largest number of units of an add-on taken at the same time by the
+// bookings during the given time frame
+func PeakBookedUnits(bookings []AddOnBooking, startDate time.Time, endDate time.Time) int {
+	// the number of units taken only grows when a booking starts, so it is
+	// enough to count them at the start of the time frame and of each booking
+	instants := []time.Time{startDate}
+	for _, booking := range bookings {
+		if booking.StartDate.After(startDate) && booking.StartDate.Before(endDate) {
+			instants = append(instants, booking.StartDate)
+		}
+	}
+
+	peak := 0
+	for _, instant := range instants {
+		units := 0
+		for _, booking := range bookings {
+			if !booking.StartDate.After(instant) && booking.EndDate.After(instant) {
+				units += booking.Quantity
+			}
+		}
+		if units > peak {
+			peak = units
+		}
+	}
+
+	return peak
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeakBookedUnits(t *testing.T) {
+	start := time.Date(2030, 5, 10, 10, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 5)
+
+	tests := []struct {
+		name     string
+		bookings []AddOnBooking
+		want     int
+	}{
+		{
+			name:     "returns zero when there are no bookings",
+			bookings: nil,
+			want:     0,
+		},
+		{
+			name: "adds the units of bookings taken at the same time",
+			bookings: []AddOnBooking{
+				{Quantity: 1, StartDate: start.AddDate(0, 0, -1), EndDate: start.AddDate(0, 0, 2)},
+				{Quantity: 2, StartDate: start.AddDate(0, 0, 1), EndDate: start.AddDate(0, 0, 3)},
+			},
+			want: 3,
+		},
+		{
+			name: "does not add the units of bookings that do not overlap each other",
+			bookings: []AddOnBooking{
+				{Quantity: 2, StartDate: start, EndDate: start.AddDate(0, 0, 2)},
+				{Quantity: 1, StartDate: start.AddDate(0, 0, 2), EndDate: start.AddDate(0, 0, 4)},
+				{Quantity: 1, StartDate: start.AddDate(0, 0, 4), EndDate: end},
+			},
+			want: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, PeakBookedUnits(test.bookings, start, end))
+		})
+	}
+}
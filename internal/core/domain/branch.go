@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lot inside a city where cars are picked up and returned
+type Branch struct {
+	ID           uuid.UUID       `json:"id,omitempty"`
+	CityID       uuid.UUID       `json:"city_id"`
+	Name         string          `json:"name"`
+	Address      string          `json:"address"`
+	Latitude     float64         `json:"latitude"`
+	Longitude    float64         `json:"longitude"`
+	OpeningHours []OpeningHours  `json:"opening_hours"`
+	Closures     []BranchClosure `json:"closures"`
+}
+
+// Opening hours of a branch for a weekday, in "15:04" format and the city's
+// local time. Weekdays without opening hours are closed.
+type OpeningHours struct {
+	Weekday  time.Weekday `json:"weekday"`
+	OpensAt  string       `json:"opens_at"`
+	ClosesAt string       `json:"closes_at"`
+}
+
+// Day, in "2006-01-02" format, on which a branch is closed regardless of its opening hours
+type BranchClosure struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
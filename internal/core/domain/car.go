@@ -1,12 +1,59 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Car struct {
-	ID             uuid.UUID `json:"id"`
-	Type           string    `json:"type"`
-	Seats          int16     `json:"seats"`
-	HourlyRentCost float64   `json:"hourly_rent_cost"`
-	CityName       string    `json:"city_name"`
-	Status         string    `json:"status"`
+	ID             uuid.UUID  `json:"id"`
+	Type           string     `json:"type"`
+	Seats          int16      `json:"seats"`
+	HourlyRentCost float64    `json:"hourly_rent_cost"`
+	Currency       string     `json:"currency"`
+	CityName       string     `json:"city_name"`
+	Status         string     `json:"status"`
+	Make           string     `json:"make"`
+	Model          string     `json:"model"`
+	Year           int16      `json:"year"`
+	LicensePlate   string     `json:"license_plate"`
+	VIN            string     `json:"vin"`
+	Transmission   string     `json:"transmission"`
+	FuelType       string     `json:"fuel_type"`
+	Color          string     `json:"color"`
+	Features       []string   `json:"features"`
+	BranchID       *uuid.UUID `json:"branch_id"`
+}
+
+// Optional criteria to search cars in a city. Empty fields are not taken into account.
+type CarFilters struct {
+	Type         string
+	Make         string
+	Model        string
+	Year         int16
+	Transmission string
+	FuelType     string
+	Color        string
+	// Cars must have all the given features
+	Features []string
+	// Text to look for in make, model and license plate
+	Search string
+}
+
+// Criteria to search the cars available near a location during a time window
+type NearbyCarsSearch struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// Car available during a time window, with the branch where it can be picked
+// up and the distance in kilometers from the searched location to that branch
+type NearbyCar struct {
+	Car          Car
+	PickupBranch Branch
+	DistanceKm   float64
 }
@@ -0,0 +1,11 @@
+package domain
+
+import "github.com/google/uuid"
+
+type City struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	TimeZone string    `json:"time_zone"`
+	Country  string    `json:"country"`
+	Currency string    `json:"currency"`
+}
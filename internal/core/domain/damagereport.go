@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Damage found on a car, optionally tied to the reservation during which it happened
+type DamageReport struct {
+	ID            uuid.UUID     `json:"id,omitempty"`
+	CarID         uuid.UUID     `json:"car_id"`
+	ReservationID *uuid.UUID    `json:"reservation_id"`
+	ReporterID    uuid.UUID     `json:"reporter_id"`
+	Description   string        `json:"description"`
+	Status        string        `json:"status"`
+	Resolution    string        `json:"resolution"`
+	CreatedAt     time.Time     `json:"created_at"`
+	ResolvedAt    *time.Time    `json:"resolved_at"`
+	Photos        []DamagePhoto `json:"photos"`
+}
+
+type DamagePhoto struct {
+	ID           uuid.UUID `json:"id,omitempty"`
+	ReportID     uuid.UUID `json:"report_id"`
+	ContentType  string    `json:"content_type"`
+	Size         int32     `json:"size"`
+	Key          string    `json:"key"`
+	ThumbnailKey string    `json:"thumbnail_key"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Photo received from a client, before it is validated and stored
+type PhotoUpload struct {
+	Filename string `json:"filename"`
+	Content  []byte `json:"content"`
+}
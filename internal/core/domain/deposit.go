@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Security deposit held on the renter payment method through the payment
+// gateway from pickup until the car is returned. It is released when the car
+// comes back without new damages, or partially captured to cover damages or
+// late fees, the rest of the hold being released.
+type Deposit struct {
+	ID             uuid.UUID  `json:"id,omitempty"`
+	ReservationID  uuid.UUID  `json:"reservation_id"`
+	Reference      string     `json:"reference"`
+	Amount         Money      `json:"amount"`
+	CapturedAmount Money      `json:"captured_amount"`
+	CaptureReason  string     `json:"capture_reason"`
+	Status         string     `json:"status"`
+	HeldAt         time.Time  `json:"held_at"`
+	SettledAt      *time.Time `json:"settled_at"`
+}
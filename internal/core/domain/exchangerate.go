@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Units of the To currency one unit of the From currency is worth
+type ExchangeRate struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Gets the rate converting the other way around
+func (er ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		From:      er.To,
+		To:        er.From,
+		Rate:      1 / er.Rate,
+		UpdatedAt: er.UpdatedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Request to keep the car of a reservation until a later end date. The
+// extension is applied only when the car is available for the whole extra
+// time; otherwise the earliest reservation in the way, if any, and the latest
+// end date the reservation can be extended to are given instead.
+type Extension struct {
+	ReservationID          uuid.UUID    `json:"reservation_id"`
+	PreviousEndDate        time.Time    `json:"previous_end_date"`
+	EndDate                time.Time    `json:"end_date"`
+	Applied                bool         `json:"applied"`
+	Cost                   Money        `json:"cost"`
+	Reservation            *Reservation `json:"reservation"`
+	ConflictingReservation *Reservation `json:"conflicting_reservation"`
+	MaximumEndDate         *time.Time   `json:"maximum_end_date"`
+}
@@ -0,0 +1,9 @@
+package domain
+
+// Area limited by latitudes and longitudes given in decimal degrees
+type GeoBounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
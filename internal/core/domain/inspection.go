@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Record of the state of a car when it is handed over to or back from a customer
+type Inspection struct {
+	ID            uuid.UUID `json:"id,omitempty"`
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Type          string    `json:"type"`
+	Mileage       int32     `json:"mileage"`
+	FuelLevel     int16     `json:"fuel_level"`
+	Damages       []string  `json:"damages"`
+	Notes         string    `json:"notes"`
+	InspectedAt   time.Time `json:"inspected_at"`
+}
+
+// Pickup and return inspections of a reservation
+type Handover struct {
+	ReservationID  uuid.UUID   `json:"reservation_id"`
+	Pickup         *Inspection `json:"pickup"`
+	Return         *Inspection `json:"return"`
+	DistanceDriven int32       `json:"distance_driven"`
+	CarMileage     CarMileage  `json:"car_mileage"`
+	Deposit        *Deposit    `json:"deposit"`
+	LateReturn     *LateReturn `json:"late_return"`
+	Warnings       []string    `json:"warnings,omitempty"`
+}
+
+// Cumulative mileage of a car
+type CarMileage struct {
+	CarID              uuid.UUID `json:"car_id"`
+	Mileage            int32     `json:"mileage"`
+	LastServiceMileage int32     `json:"last_service_mileage"`
+	MaintenanceDue     bool      `json:"maintenance_due"`
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invoice issued for a completed reservation. It keeps a copy of the billing
+// details and the prices the reservation was charged with, so later changes
+// to them do not alter it. Sequence numbers the invoices of a year without gaps.
+type Invoice struct {
+	ID            uuid.UUID     `json:"id,omitempty"`
+	Number        string        `json:"number"`
+	Year          int           `json:"year"`
+	Sequence      int           `json:"sequence"`
+	ReservationID uuid.UUID     `json:"reservation_id"`
+	Billing       BillingInfo   `json:"billing"`
+	Lines         []InvoiceLine `json:"lines"`
+	Taxes         []InvoiceTax  `json:"taxes"`
+	Subtotal      Money         `json:"subtotal"`
+	TaxTotal      Money         `json:"tax_total"`
+	Total         Money         `json:"total"`
+	IssuedAt      time.Time     `json:"issued_at"`
+}
+
+// Who the invoice is addressed to. Company details are only set for
+// customers with a billing profile.
+type BillingInfo struct {
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	CompanyName string `json:"company_name"`
+	TaxID       string `json:"tax_id"`
+	Address     string `json:"address"`
+	City        string `json:"city"`
+	PostalCode  string `json:"postal_code"`
+	Country     string `json:"country"`
+}
+
+type InvoiceLine struct {
+	Description string `json:"description"`
+	Amount      Money  `json:"amount"`
+}
+
+type InvoiceTax struct {
+	Name   string  `json:"name"`
+	Rate   float64 `json:"rate"`
+	Amount Money   `json:"amount"`
+}
+
+// Company details of a customer, copied to the invoices issued to them
+type BillingProfile struct {
+	UserID      uuid.UUID `json:"user_id"`
+	CompanyName string    `json:"company_name"`
+	TaxID       string    `json:"tax_id"`
+	Address     string    `json:"address"`
+	City        string    `json:"city"`
+	PostalCode  string    `json:"postal_code"`
+	// ISO 3166-1 alpha-2 code of the country
+	Country string `json:"country"`
+}
+
+// Formats the number shown on an invoice, such as INV-2027-000042
+func InvoiceNumber(year int, sequence int) string {
+	return fmt.Sprintf("INV-%d-%06d", year, sequence)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Car returned after the end of its reservation and past the grace period.
+// Every started hour since the end of the reservation is charged at the
+// hourly late fee of the car type.
+type LateReturn struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	DueAt         time.Time `json:"due_at"`
+	ReturnedAt    time.Time `json:"returned_at"`
+	MinutesLate   int       `json:"minutes_late"`
+	HoursCharged  int       `json:"hours_charged"`
+	HourlyFee     Money     `json:"hourly_fee"`
+	Fee           Money     `json:"fee"`
+}
+
+// Rental whose car was picked up and is still out past the grace period after
+// its end. Lateness is measured as if the car were returned now, and the next
+// reservation on the car, if any, is the one that may not get it on time.
+type OverdueRental struct {
+	Reservation     Reservation    `json:"reservation"`
+	Lateness        LateReturn     `json:"lateness"`
+	NextReservation *Reservation   `json:"next_reservation"`
+	Notice          *OverdueNotice `json:"notice"`
+}
+
+// Record of the renter of the next reservation being told the car was not
+// returned on time by the previous one
+type OverdueNotice struct {
+	ReservationID     uuid.UUID `json:"reservation_id"`
+	NextReservationID uuid.UUID `json:"next_reservation_id"`
+	NotifiedAt        time.Time `json:"notified_at"`
+}
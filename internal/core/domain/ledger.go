@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entry of the double-entry ledger. Debits are positive amounts and credits
+// negative ones, so the entries of a transaction always sum to zero.
+// Movement tells what moved the money, such as a charge or a deposit hold.
+type LedgerEntry struct {
+	ID            uuid.UUID `json:"id,omitempty"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Account       string    `json:"account"`
+	Movement      string    `json:"movement"`
+	Amount        Money     `json:"amount"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Balance of the ledger accounts of a reservation. Total is the sum of all
+// its entries, which is zero while the ledger is consistent.
+type LedgerBalance struct {
+	ReservationID uuid.UUID        `json:"reservation_id"`
+	Accounts      map[string]Money `json:"accounts"`
+	Total         Money            `json:"total"`
+	Entries       []LedgerEntry    `json:"entries"`
+}
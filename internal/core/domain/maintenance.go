@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Maintenance struct {
+	ID        uuid.UUID `json:"id,omitempty"`
+	CarID     uuid.UUID `json:"car_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+}
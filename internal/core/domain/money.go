@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// Amount of money in the minor units of its ISO 4217 currency, such as cents
+// of dollar or yen, which has no minor unit. The zero Money has no currency
+// and stands for no money in any currency.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Currencies whose minor unit is not the hundredth
+var currencyDecimals = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0, "KWD": 3,
+	"OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0, "XAF": 0, "XOF": 0,
+}
+
+// Gets the number of decimals of the minor unit of a currency
+func CurrencyDecimals(currency string) int {
+	if decimals, ok := currencyDecimals[currency]; ok {
+		return decimals
+	}
+
+	return 2
+}
+
+// Gets the money of an amount in major units, rounded to the minor unit of
+// the currency
+func NewMoney(amount float64, currency string) Money {
+	return Money{
+		Amount:   roundMinorUnits(amount * math.Pow10(CurrencyDecimals(currency))),
+		Currency: currency,
+	}
+}
+
+// Gets the amount in major units
+func (m Money) Major() float64 {
+	return float64(m.Amount) / math.Pow10(CurrencyDecimals(m.Currency))
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Adds money of the same currency. Adding money of another currency is a
+// programming error, as amounts must be converted first, and panics.
+func (m Money) Add(other Money) Money {
+	currency := m.sameCurrency(other)
+
+	return Money{Amount: m.Amount + other.Amount, Currency: currency}
+}
+
+// Subtracts money of the same currency, panicking like Add on another currency
+func (m Money) Sub(other Money) Money {
+	return m.Add(other.Neg())
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Multiplies the money by a whole number, such as the hours of a rental
+func (m Money) Times(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Checks whether the money is more than other money of the same currency,
+// panicking like Add on another currency
+func (m Money) GreaterThan(other Money) bool {
+	m.sameCurrency(other)
+
+	return m.Amount > other.Amount
+}
+
+// Gets the lesser of two amounts of the same currency, panicking like Add on
+// another currency
+func (m Money) Min(other Money) Money {
+	if m.GreaterThan(other) {
+		return other
+	}
+
+	return m
+}
+
+// Converts the money to the currency the rate converts to, rounded to its
+// minor unit. The rate must convert from the currency of the money.
+func (m Money) Convert(rate ExchangeRate) Money {
+	if m.Currency != rate.From && m != (Money{}) {
+		panic(fmt.Sprintf("converting %s with a rate from %s", m.Currency, rate.From))
+	}
+	scale := math.Pow10(CurrencyDecimals(rate.To) - CurrencyDecimals(m.Currency))
+
+	return Money{
+		Amount:   roundMinorUnits(float64(m.Amount) * rate.Rate * scale),
+		Currency: rate.To,
+	}
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%.*f %s", CurrencyDecimals(m.Currency), m.Major(), m.Currency)
+}
+
+// Gets the currency two amounts share. The zero Money takes the currency of
+// the other amount.
+func (m Money) sameCurrency(other Money) string {
+	switch {
+	case m.Currency == other.Currency:
+		return m.Currency
+	case m == (Money{}):
+		return other.Currency
+	case other == (Money{}):
+		return m.Currency
+	default:
+		panic(fmt.Sprintf("adding %s to %s", other.Currency, m.Currency))
+	}
+}
+
+// Rounds half away from zero once the noise of float products, such as
+// 1000 * 1.1, has been removed
+func roundMinorUnits(amount float64) int64 {
+	return int64(math.Round(math.Round(amount*1e6) / 1e6))
+}
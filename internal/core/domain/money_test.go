@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		want     Money
+	}{
+		{
+			name:     "rounds to cents",
+			amount:   320.005,
+			currency: "USD",
+			want:     Money{Amount: 32001, Currency: "USD"},
+		},
+		{
+			name:     "rounds to yens, which have no minor unit",
+			amount:   1500.5,
+			currency: "JPY",
+			want:     Money{Amount: 1501, Currency: "JPY"},
+		},
+		{
+			name:     "rounds to fils, which are thousandths of dinar",
+			amount:   12.3456,
+			currency: "KWD",
+			want:     Money{Amount: 12346, Currency: "KWD"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			money := NewMoney(test.amount, test.currency)
+
+			assert.Equal(t, test.want, money)
+		})
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	t.Run("adds money of the same currency", func(t *testing.T) {
+		sum := Money{Amount: 1050, Currency: "USD"}.Add(Money{Amount: 250, Currency: "USD"})
+
+		assert.Equal(t, Money{Amount: 1300, Currency: "USD"}, sum)
+	})
+
+	t.Run("adds no money to money of any currency", func(t *testing.T) {
+		assert.Equal(t, Money{Amount: 1300, Currency: "JPY"}, Money{}.Add(Money{Amount: 1300, Currency: "JPY"}))
+		assert.Equal(t, Money{Amount: 1300, Currency: "JPY"}, Money{Amount: 1300, Currency: "JPY"}.Add(Money{}))
+	})
+
+	t.Run("panics when the currencies differ", func(t *testing.T) {
+		assert.PanicsWithValue(t, "adding EUR to USD", func() {
+			Money{Amount: 1050, Currency: "USD"}.Add(Money{Amount: 250, Currency: "EUR"})
+		})
+		assert.Panics(t, func() {
+			Money{Amount: 1050, Currency: "USD"}.GreaterThan(Money{Amount: 250, Currency: "EUR"})
+		})
+	})
+}
+
+func TestMoneyConvert(t *testing.T) {
+	rate := ExchangeRate{From: "USD", To: "JPY", Rate: 151.237}
+
+	t.Run("converts to the minor unit of the other currency", func(t *testing.T) {
+		converted := Money{Amount: 1999, Currency: "USD"}.Convert(rate)
+
+		assert.Equal(t, Money{Amount: 3023, Currency: "JPY"}, converted)
+	})
+
+	t.Run("panics when the rate is from another currency", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Money{Amount: 1999, Currency: "EUR"}.Convert(rate)
+		})
+	})
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "github.com/google/uuid"
+
+// OneWayFee is charged when a car picked up in a city is returned in another one
+type OneWayFee struct {
+	FromCityID uuid.UUID `json:"from_city_id"`
+	ToCityID   uuid.UUID `json:"to_city_id"`
+	Fee        float64   `json:"fee"`
+	Currency   string    `json:"currency"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Charge of a reservation made through the payment gateway. Reference is the
+// id the gateway gave to the payment. TaxAmount is the part of the amount
+// owed in taxes.
+type Payment struct {
+	ID             uuid.UUID `json:"id,omitempty"`
+	ReservationID  uuid.UUID `json:"reservation_id"`
+	Reference      string    `json:"reference"`
+	Amount         Money     `json:"amount"`
+	CapturedAmount Money     `json:"captured_amount"`
+	RefundedAmount Money     `json:"refunded_amount"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	TaxAmount      Money     `json:"tax_amount"`
+}
+
+// Result of asking the gateway to authorize a payment. Status is Authorized,
+// Failed when the payment method was declined or Pending when the gateway
+// confirms it later through a webhook event.
+type PaymentAuthorization struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+}
+
+// Change of the status of a payment notified by the gateway. Amount is the
+// amount captured or refunded when the event is about a capture or a refund.
+type PaymentEvent struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Amount    Money  `json:"amount"`
+}
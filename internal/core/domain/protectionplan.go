@@ -0,0 +1,22 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Protection level offered for a car type, with the deductible the customer
+// pays in case of damage and its price per day
+type ProtectionPlan struct {
+	ID         uuid.UUID `json:"id,omitempty"`
+	Level      string    `json:"level"`
+	CarType    string    `json:"car_type"`
+	DailyPrice float64   `json:"daily_price"`
+	Deductible float64   `json:"deductible"`
+	Currency   string    `json:"currency"`
+}
+
+// Terms of the protection plan a reservation was booked with, kept so later
+// changes to the plan do not alter it
+type ReservationProtection struct {
+	Level      string `json:"level"`
+	DailyPrice Money  `json:"daily_price"`
+	Deductible Money  `json:"deductible"`
+}
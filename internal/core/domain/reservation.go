@@ -7,11 +7,98 @@ import (
 )
 
 type Reservation struct {
-	ID            uuid.UUID `json:"id,omitempty"`
-	UserID        uuid.UUID `json:"user_id"`
-	CarID         uuid.UUID `json:"car_id"`
-	Status        string    `json:"status"`
-	PaymentStatus string    `json:"payment_status"`
-	StartDate     time.Time `json:"start_date"`
-	EndDate       time.Time `json:"end_date"`
+	ID             uuid.UUID  `json:"id,omitempty"`
+	UserID         uuid.UUID  `json:"user_id"`
+	CarID          uuid.UUID  `json:"car_id"`
+	Status         string     `json:"status"`
+	PaymentStatus  string     `json:"payment_status"`
+	StartDate      time.Time  `json:"start_date"`
+	EndDate        time.Time  `json:"end_date"`
+	TimeZone       string     `json:"time_zone"`
+	PickupBranchID *uuid.UUID `json:"pickup_branch_id"`
+	ReturnBranchID *uuid.UUID `json:"return_branch_id"`
+	// Currency of the city of the car, which all the prices are in
+	Currency  string `json:"currency"`
+	OneWayFee Money  `json:"one_way_fee"`
+	// Registered users allowed to drive the car besides the renter
+	AdditionalDriverIDs  []uuid.UUID           `json:"additional_driver_ids"`
+	RentalCost           Money                 `json:"rental_cost"`
+	AdditionalDriversFee Money                 `json:"additional_drivers_fee"`
+	AddOns               []ReservationAddOn    `json:"add_ons"`
+	AddOnsCost           Money                 `json:"add_ons_cost"`
+	Protection           ReservationProtection `json:"protection"`
+	ProtectionCost       Money                 `json:"protection_cost"`
+	// Taxes of the city of the car, in the order their rules were listed
+	Taxes    []ReservationTax `json:"taxes"`
+	TaxTotal Money            `json:"tax_total"`
+	// Stage of the security deposit, set through the deposit hold
+	DepositStatus string `json:"deposit_status"`
+}
+
+// Price breakdown of a reservation. Subtotal is the price before taxes.
+// Prices are in Currency, the currency of the city of the car. Display is the
+// quote in the currency preferred by the customer, if any.
+type Quote struct {
+	RentalCost           Money            `json:"rental_cost"`
+	OneWayFee            Money            `json:"one_way_fee"`
+	AdditionalDriversFee Money            `json:"additional_drivers_fee"`
+	AddOnsCost           Money            `json:"add_ons_cost"`
+	ProtectionCost       Money            `json:"protection_cost"`
+	Subtotal             Money            `json:"subtotal"`
+	Taxes                []ReservationTax `json:"taxes"`
+	TaxTotal             Money            `json:"tax_total"`
+	Total                Money            `json:"total"`
+	Currency             string           `json:"currency"`
+	Display              *DisplayQuote    `json:"display"`
+}
+
+// Quote converted to another currency to show it to the customer. It is only
+// informative, reservations are always charged in the currency of their city.
+// Each part is converted on its own and the totals are their sums, so they add up.
+type DisplayQuote struct {
+	Rate                 ExchangeRate `json:"rate"`
+	RentalCost           Money        `json:"rental_cost"`
+	OneWayFee            Money        `json:"one_way_fee"`
+	AdditionalDriversFee Money        `json:"additional_drivers_fee"`
+	AddOnsCost           Money        `json:"add_ons_cost"`
+	ProtectionCost       Money        `json:"protection_cost"`
+	Subtotal             Money        `json:"subtotal"`
+	TaxTotal             Money        `json:"tax_total"`
+	Total                Money        `json:"total"`
+}
+
+// Gets the price breakdown of the reservation from the prices it was booked with
+func (r Reservation) Quote() Quote {
+	subtotal := r.RentalCost.Add(r.OneWayFee).Add(r.AdditionalDriversFee).Add(r.AddOnsCost).Add(r.ProtectionCost)
+
+	return Quote{
+		RentalCost:           r.RentalCost,
+		OneWayFee:            r.OneWayFee,
+		AdditionalDriversFee: r.AdditionalDriversFee,
+		AddOnsCost:           r.AddOnsCost,
+		ProtectionCost:       r.ProtectionCost,
+		Subtotal:             subtotal,
+		Taxes:                r.Taxes,
+		TaxTotal:             r.TaxTotal,
+		Total:                subtotal.Add(r.TaxTotal),
+		Currency:             r.Currency,
+	}
+}
+
+// Converts the quote with a rate from its currency
+func (q Quote) In(rate ExchangeRate) DisplayQuote {
+	display := DisplayQuote{
+		Rate:                 rate,
+		RentalCost:           q.RentalCost.Convert(rate),
+		OneWayFee:            q.OneWayFee.Convert(rate),
+		AdditionalDriversFee: q.AdditionalDriversFee.Convert(rate),
+		AddOnsCost:           q.AddOnsCost.Convert(rate),
+		ProtectionCost:       q.ProtectionCost.Convert(rate),
+		TaxTotal:             q.TaxTotal.Convert(rate),
+	}
+	display.Subtotal = display.RentalCost.Add(display.OneWayFee).Add(display.AdditionalDriversFee).
+		Add(display.AddOnsCost).Add(display.ProtectionCost)
+	display.Total = display.Subtotal.Add(display.TaxTotal)
+
+	return display
 }
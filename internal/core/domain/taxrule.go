@@ -0,0 +1,25 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Tax levied by a jurisdiction on the rentals of the cars of a city. Rate is
+// the fraction of the parts of the price it applies to that is charged, and
+// Rounding how the jurisdiction rounds it to cents. Rules with a branch only
+// apply to rentals picked up at that branch.
+type TaxRule struct {
+	ID           uuid.UUID  `json:"id,omitempty"`
+	CityID       uuid.UUID  `json:"city_id"`
+	BranchID     *uuid.UUID `json:"branch_id"`
+	Name         string     `json:"name"`
+	Jurisdiction string     `json:"jurisdiction"`
+	Rate         float64    `json:"rate"`
+	AppliesTo    []string   `json:"applies_to"`
+	Rounding     string     `json:"rounding"`
+}
+
+// Tax charged to a reservation, kept so later changes to its rule do not alter it
+type ReservationTax struct {
+	Name   string  `json:"name"`
+	Rate   float64 `json:"rate"`
+	Amount Money   `json:"amount"`
+}
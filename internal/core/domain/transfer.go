@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Move of a car between cities. The car is in transit from departure to
+// arrival and it is relocated when the transfer is completed.
+type Transfer struct {
+	ID            uuid.UUID  `json:"id,omitempty"`
+	CarID         uuid.UUID  `json:"car_id"`
+	FromCityID    uuid.UUID  `json:"from_city_id"`
+	ToCityID      uuid.UUID  `json:"to_city_id"`
+	ToBranchID    *uuid.UUID `json:"to_branch_id"`
+	DepartureDate time.Time  `json:"departure_date"`
+	ArrivalDate   time.Time  `json:"arrival_date"`
+	CompletedAt   *time.Time `json:"completed_at"`
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type User struct {
 	ID        uuid.UUID `json:"id"`
@@ -9,4 +13,34 @@ type User struct {
 	Email     string    `json:"email"`
 	Type      string    `json:"type"`
 	Status    string    `json:"status"`
+	// Users with an unverified email can not book
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	// Date of birth and driver license are required to book
+	DateOfBirth   *time.Time     `json:"date_of_birth"`
+	DriverLicense *DriverLicense `json:"driver_license"`
+}
+
+type DriverLicense struct {
+	Number string `json:"number"`
+	// ISO 3166-1 alpha-2 code of the issuing country
+	Country    string    `json:"country"`
+	IssueDate  time.Time `json:"issue_date"`
+	ExpiryDate time.Time `json:"expiry_date"`
+}
+
+type UserFilters struct {
+	Type   string
+	Status string
+	// Case insensitive prefix of the email, first name, last name or full name
+	Search string
+}
+
+// Single use token sent by email to verify the email of a user or to reset
+// their password
+type UserToken struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Purpose   string     `json:"purpose"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
 }
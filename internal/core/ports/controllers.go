@@ -14,6 +14,7 @@ type CarsController interface {
 	FullUpdate(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
 	List(w http.ResponseWriter, r *http.Request)
+	SearchNearby(w http.ResponseWriter, r *http.Request)
 }
 
 type UsersController interface {
@@ -21,18 +22,144 @@ type UsersController interface {
 	Get(w http.ResponseWriter, r *http.Request)
 	FullUpdate(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+	SendEmailVerification(w http.ResponseWriter, r *http.Request)
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
+	RequestPasswordReset(w http.ResponseWriter, r *http.Request)
+	ResetPassword(w http.ResponseWriter, r *http.Request)
 }
 
 type CitiesController interface {
-	ListNames(w http.ResponseWriter, r *http.Request)
+	Register(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+}
+
+type BranchesController interface {
+	Register(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	ListByCityID(w http.ResponseWriter, r *http.Request)
+}
+
+type AddOnsController interface {
+	Register(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	ListByBranchID(w http.ResponseWriter, r *http.Request)
+}
+
+type ProtectionPlansController interface {
+	Register(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+}
+
+type TaxRulesController interface {
+	Register(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	ListByCityID(w http.ResponseWriter, r *http.Request)
+}
+
+type ExchangeRatesController interface {
+	Set(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+}
+
+type OneWayFeesController interface {
+	Set(w http.ResponseWriter, r *http.Request)
+	ListByCityID(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
 }
 
 type ReservationsController interface {
 	Book(w http.ResponseWriter, r *http.Request)
+	Quote(w http.ResponseWriter, r *http.Request)
 	Get(w http.ResponseWriter, r *http.Request)
 	FullUpdate(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
 	List(w http.ResponseWriter, r *http.Request)
 	GetByCarID(w http.ResponseWriter, r *http.Request)
 	GetByUserID(w http.ResponseWriter, r *http.Request)
+	Extend(w http.ResponseWriter, r *http.Request)
+}
+
+type PaymentsController interface {
+	Authorize(w http.ResponseWriter, r *http.Request)
+	Capture(w http.ResponseWriter, r *http.Request)
+	Void(w http.ResponseWriter, r *http.Request)
+	Refund(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	Webhook(w http.ResponseWriter, r *http.Request)
+}
+
+type DepositsController interface {
+	Get(w http.ResponseWriter, r *http.Request)
+	Capture(w http.ResponseWriter, r *http.Request)
+	Release(w http.ResponseWriter, r *http.Request)
+}
+
+type LedgerController interface {
+	GetBalance(w http.ResponseWriter, r *http.Request)
+}
+
+type InvoicesController interface {
+	Issue(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	GetByReservationID(w http.ResponseWriter, r *http.Request)
+	Download(w http.ResponseWriter, r *http.Request)
+	GetBillingProfile(w http.ResponseWriter, r *http.Request)
+	SaveBillingProfile(w http.ResponseWriter, r *http.Request)
+}
+
+type ConstantsController interface {
+	Get(w http.ResponseWriter, r *http.Request)
+}
+
+type MaintenancesController interface {
+	Schedule(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	FullUpdate(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+	GetByCarID(w http.ResponseWriter, r *http.Request)
+}
+
+type TransfersController interface {
+	Schedule(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	GetByCarID(w http.ResponseWriter, r *http.Request)
+	Complete(w http.ResponseWriter, r *http.Request)
+	Cancel(w http.ResponseWriter, r *http.Request)
+}
+
+type HandoversController interface {
+	RecordInspection(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	GetCarMileage(w http.ResponseWriter, r *http.Request)
+	RegisterCarService(w http.ResponseWriter, r *http.Request)
+}
+
+type LateReturnsController interface {
+	ListOverdue(w http.ResponseWriter, r *http.Request)
+	NotifyOverdue(w http.ResponseWriter, r *http.Request)
+}
+
+type DamageReportsController interface {
+	File(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+	UpdateStatus(w http.ResponseWriter, r *http.Request)
+	ListByCarID(w http.ResponseWriter, r *http.Request)
+	AddPhotos(w http.ResponseWriter, r *http.Request)
+	GetPhoto(w http.ResponseWriter, r *http.Request)
+	GetThumbnail(w http.ResponseWriter, r *http.Request)
 }
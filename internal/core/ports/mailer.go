@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+)
+
+// mockgen -source=internal/core/ports/mailer.go -destination=internal/pkg/mocks/mailer.go
+
+// Mailer delivers emails to the users
+type Mailer interface {
+	Send(ctx context.Context, email domain.Email) error
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+)
+
+// mockgen -source=internal/core/ports/paymentgateway.go -destination=internal/pkg/mocks/paymentgateway.go
+
+// PaymentGateway charges the payment methods of the users through a payment
+// provider. Payments are identified by the reference given when authorized.
+type PaymentGateway interface {
+	// Holds the amount on the payment method
+	Authorize(ctx context.Context, amount domain.Money, paymentMethod string) (domain.PaymentAuthorization, error)
+	// Charges an authorized amount
+	Capture(ctx context.Context, reference string, amount domain.Money) error
+	// Releases an authorization without charging it
+	Void(ctx context.Context, reference string) error
+	// Gives back part or all of a captured amount
+	Refund(ctx context.Context, reference string, amount domain.Money) error
+	// Verifies and reads an event sent by the provider to the webhook
+	ParseEvent(payload []byte, signature string) (domain.PaymentEvent, error)
+}
@@ -21,7 +21,8 @@ type CarsRepo interface {
 	Get(ctx context.Context, ID uuid.UUID) (dc domain.Car, err error)
 	FullUpdate(ctx context.Context, dc domain.Car) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, cityName string, from_car_id string, limit uint16) ([]domain.Car, error)
+	List(ctx context.Context, cityName string, filters domain.CarFilters, from_car_id string, limit uint16) ([]domain.Car, error)
+	ListAvailableInArea(ctx context.Context, bounds domain.GeoBounds, status string, startDate time.Time, endDate time.Time) ([]domain.NearbyCar, error)
 }
 
 type UsersRepo interface {
@@ -29,12 +30,70 @@ type UsersRepo interface {
 	Get(ctx context.Context, ID uuid.UUID) (dc domain.User, err error)
 	FullUpdate(ctx context.Context, du domain.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters domain.UserFilters, fromUserID string, limit uint16) ([]domain.User, error)
+	GetByEmail(ctx context.Context, email string) (du domain.User, err error)
+}
+
+type UserTokensRepo interface {
+	Insert(ctx context.Context, dut domain.UserToken) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (dut domain.UserToken, err error)
+	VerifyEmail(ctx context.Context, dut domain.UserToken) error
+	ResetPassword(ctx context.Context, dut domain.UserToken, passwordHash string) error
 }
 
 type CitiesRepo interface {
+	Insert(ctx context.Context, dc domain.City) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (dc domain.City, err error)
+	FullUpdate(ctx context.Context, dc domain.City) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, fromCityID string, limit uint16) ([]domain.City, error)
 	GetIdByName(ctx context.Context, name string) (ID uuid.UUID, err error)
 	GetNameByID(ctx context.Context, ID uuid.UUID) (name string, err error)
-	ListNames(ctx context.Context) ([]string, error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) (dc domain.City, err error)
+	GetTimeZonesByCarIDs(ctx context.Context, carIDs []uuid.UUID) (map[uuid.UUID]string, error)
+}
+
+type BranchesRepo interface {
+	Insert(ctx context.Context, db domain.Branch) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (db domain.Branch, err error)
+	FullUpdate(ctx context.Context, db domain.Branch) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) (db domain.Branch, err error)
+}
+
+type AddOnsRepo interface {
+	Insert(ctx context.Context, dao domain.AddOn) error
+	Get(ctx context.Context, ID uuid.UUID) (domain.AddOn, error)
+	FullUpdate(ctx context.Context, dao domain.AddOn) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error)
+	GetBookings(ctx context.Context, addOnID uuid.UUID, excludedReservationID uuid.UUID, startDate time.Time, endDate time.Time) ([]domain.AddOnBooking, error)
+}
+
+type ProtectionPlansRepo interface {
+	Insert(ctx context.Context, dpp domain.ProtectionPlan) error
+	Get(ctx context.Context, ID uuid.UUID) (domain.ProtectionPlan, error)
+	GetByLevelAndCarType(ctx context.Context, level string, carType string) (domain.ProtectionPlan, error)
+	FullUpdate(ctx context.Context, dpp domain.ProtectionPlan) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error)
+}
+
+type TaxRulesRepo interface {
+	Insert(ctx context.Context, dtr domain.TaxRule) error
+	Get(ctx context.Context, ID uuid.UUID) (domain.TaxRule, error)
+	FullUpdate(ctx context.Context, dtr domain.TaxRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error)
+}
+
+type ExchangeRatesRepo interface {
+	// Inserts the rate of a pair of currencies or replaces the one it had
+	Upsert(ctx context.Context, der domain.ExchangeRate) error
+	Get(ctx context.Context, from string, to string) (domain.ExchangeRate, error)
+	List(ctx context.Context) ([]domain.ExchangeRate, error)
+	Delete(ctx context.Context, from string, to string) error
 }
 
 type ReservationsRepo interface {
@@ -46,4 +105,121 @@ type ReservationsRepo interface {
 	GetByUserID(ctx context.Context, userID uuid.UUID) (dr []domain.Reservation, err error)
 	GetByCarID(ctx context.Context, CarID uuid.UUID) (dr []domain.Reservation, err error)
 	GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate time.Time, endDate time.Time) (dr []domain.Reservation, err error)
+	GetPreviousByCarID(ctx context.Context, carID uuid.UUID, excludedID uuid.UUID, from time.Time, to time.Time) (dr domain.Reservation, err error)
+	GetNextByCarID(ctx context.Context, carID uuid.UUID, excludedID uuid.UUID, from time.Time) (dr domain.Reservation, err error)
+	// Lists the reservations with the given status ending before dueBefore
+	// whose car was picked up and not returned yet
+	ListOverdue(ctx context.Context, status string, dueBefore time.Time) (dr []domain.Reservation, err error)
+	// Moves the end of the reservation from previousEndDate to its new end
+	// date along with its new prices, as long as the car is still free
+	Extend(ctx context.Context, dr domain.Reservation, previousEndDate time.Time) error
+}
+
+type PaymentsRepo interface {
+	Insert(ctx context.Context, dp domain.Payment) error
+	GetLatestByReservationID(ctx context.Context, reservationID uuid.UUID) (dp domain.Payment, err error)
+	GetByReference(ctx context.Context, reference string) (dp domain.Payment, err error)
+	// Updates the payment while it still has the status and amounts of
+	// previous, appending the ledger entries of the money it moved in the
+	// same transaction
+	Update(ctx context.Context, dp domain.Payment, previous domain.Payment, entries []domain.LedgerEntry) error
+}
+
+type DepositsRepo interface {
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) (dd domain.Deposit, err error)
+	// Updates the deposit while it still has previousStatus, appending the
+	// ledger entries of the money it moved in the same transaction
+	Update(ctx context.Context, dd domain.Deposit, previousStatus string, entries []domain.LedgerEntry) error
+}
+
+type LedgerRepo interface {
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) ([]domain.LedgerEntry, error)
+}
+
+type InvoicesRepo interface {
+	// Numbers the invoice with the next sequence of its year and inserts it
+	// along with its lines and taxes in a single transaction
+	Insert(ctx context.Context, di domain.Invoice) (domain.Invoice, error)
+	Get(ctx context.Context, ID uuid.UUID) (di domain.Invoice, err error)
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) (di domain.Invoice, err error)
+}
+
+type BillingProfilesRepo interface {
+	Get(ctx context.Context, userID uuid.UUID) (dbp domain.BillingProfile, err error)
+	Upsert(ctx context.Context, dbp domain.BillingProfile) error
+}
+
+type OneWayFeesRepo interface {
+	Upsert(ctx context.Context, dowf domain.OneWayFee) (err error)
+	Get(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) (dowf domain.OneWayFee, err error)
+	ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error)
+	Delete(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) error
+}
+
+type MaintenancesRepo interface {
+	Insert(ctx context.Context, dm domain.Maintenance) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (dm domain.Maintenance, err error)
+	FullUpdate(ctx context.Context, dm domain.Maintenance) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, cityName string, fromMaintenanceID string, startDate time.Time, endDate time.Time, limit uint16) ([]domain.Maintenance, error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) (dm []domain.Maintenance, err error)
+	GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate time.Time, endDate time.Time) (dm []domain.Maintenance, err error)
+}
+
+type TransfersRepo interface {
+	Insert(ctx context.Context, dt domain.Transfer) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (dt domain.Transfer, err error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error)
+	// Gets the transfer of the car that has not been completed yet
+	GetPendingByCarID(ctx context.Context, carID uuid.UUID) (dt domain.Transfer, err error)
+	// Marks the transfer as completed and moves the car to its destination
+	// city and branch atomically.
+	Complete(ctx context.Context, dt domain.Transfer) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type InspectionsRepo interface {
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) (di []domain.Inspection, err error)
+}
+
+type HandoversRepo interface {
+	// Begins a unit of work recording a handover
+	Begin(ctx context.Context) (HandoverTx, error)
+}
+
+// Unit of work recording a pickup or return along with the changes it brings
+// to the car, the reservation and its money. Nothing is stored until it is
+// committed, and rolling it back once committed does nothing.
+type HandoverTx interface {
+	InsertInspection(ctx context.Context, di domain.Inspection) error
+	// Moves the odometer of the car forward to the given mileage
+	UpdateCarMileage(ctx context.Context, carID uuid.UUID, mileage int32) error
+	// Moves the car to the branch and its city
+	RelocateCar(ctx context.Context, carID uuid.UUID, branchID uuid.UUID) error
+	UpdateReservationStatus(ctx context.Context, reservationID uuid.UUID, status string) error
+	InsertLateReturn(ctx context.Context, dlr domain.LateReturn) error
+	InsertDeposit(ctx context.Context, dd domain.Deposit) error
+	InsertLedgerEntries(ctx context.Context, entries []domain.LedgerEntry) error
+	Commit() error
+	Rollback() error
+}
+
+type LateReturnsRepo interface {
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) (dlr domain.LateReturn, err error)
+	GetNotice(ctx context.Context, reservationID uuid.UUID) (don domain.OverdueNotice, err error)
+	InsertNotice(ctx context.Context, don domain.OverdueNotice) error
+}
+
+type CarMileagesRepo interface {
+	Get(ctx context.Context, carID uuid.UUID) (dcm domain.CarMileage, err error)
+	RegisterService(ctx context.Context, carID uuid.UUID) error
+}
+
+type DamageReportsRepo interface {
+	Insert(ctx context.Context, ddr domain.DamageReport) (err error)
+	Get(ctx context.Context, ID uuid.UUID) (ddr domain.DamageReport, err error)
+	UpdateStatus(ctx context.Context, ddr domain.DamageReport) error
+	ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error)
+	InsertPhoto(ctx context.Context, ddp domain.DamagePhoto) (err error)
+	GetPhoto(ctx context.Context, reportID uuid.UUID, photoID uuid.UUID) (ddp domain.DamagePhoto, err error)
 }
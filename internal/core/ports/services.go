@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
@@ -13,28 +14,150 @@ import (
 type CarsService interface {
 	Register(ctx context.Context, car domain.Car) (domain.Car, error)
 	Get(ctx context.Context, id uuid.UUID) (domain.Car, error)
-	FullUpdate(ctx context.Context, dc domain.Car) error
+	FullUpdate(ctx context.Context, dc domain.Car) ([]domain.Reservation, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, city string, from_car_id string) ([]domain.Car, error)
+	List(ctx context.Context, city string, filters domain.CarFilters, from_car_id string) ([]domain.Car, error)
+	SearchNearby(ctx context.Context, search domain.NearbyCarsSearch) ([]domain.NearbyCar, error)
 }
 
 type UsersService interface {
 	Register(ctx context.Context, car domain.User) (domain.User, error)
 	Get(ctx context.Context, id uuid.UUID) (domain.User, error)
-	FullUpdate(ctx context.Context, du domain.User) error
+	FullUpdate(ctx context.Context, du domain.User) ([]domain.Reservation, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters domain.UserFilters, fromUserID string) ([]domain.User, error)
+	SendEmailVerification(ctx context.Context, id uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token string, password string) error
 }
 
 type CitiesService interface {
-	ListNames(ctx context.Context) ([]string, error)
+	Register(ctx context.Context, city domain.City) (domain.City, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.City, error)
+	FullUpdate(ctx context.Context, city domain.City) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, fromCityID string) ([]domain.City, error)
+}
+
+type BranchesService interface {
+	Register(ctx context.Context, branch domain.Branch) (domain.Branch, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Branch, error)
+	FullUpdate(ctx context.Context, branch domain.Branch) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error)
+}
+
+type AddOnsService interface {
+	Register(ctx context.Context, addOn domain.AddOn) (domain.AddOn, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.AddOn, error)
+	FullUpdate(ctx context.Context, addOn domain.AddOn) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error)
+}
+
+type ProtectionPlansService interface {
+	Register(ctx context.Context, plan domain.ProtectionPlan) (domain.ProtectionPlan, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.ProtectionPlan, error)
+	FullUpdate(ctx context.Context, plan domain.ProtectionPlan) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error)
+}
+
+type TaxRulesService interface {
+	Register(ctx context.Context, rule domain.TaxRule) (domain.TaxRule, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.TaxRule, error)
+	FullUpdate(ctx context.Context, rule domain.TaxRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error)
+}
+
+type ExchangeRatesService interface {
+	Set(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error)
+	List(ctx context.Context) ([]domain.ExchangeRate, error)
+	Delete(ctx context.Context, from string, to string) error
+}
+
+type OneWayFeesService interface {
+	Set(ctx context.Context, oneWayFee domain.OneWayFee) error
+	ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error)
+	Delete(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) error
 }
 
 type ReservationsService interface {
 	Book(ctx context.Context, reservation domain.Reservation) (domain.Reservation, error)
+	Quote(ctx context.Context, reservation domain.Reservation, displayCurrency string) (domain.Quote, error)
 	Get(ctx context.Context, id uuid.UUID) (domain.Reservation, error)
 	FullUpdate(ctx context.Context, dr domain.Reservation) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, fromReservationID string, startDate time.Time, endDate time.Time) ([]domain.Reservation, error)
 	GetByCarID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error)
+	Extend(ctx context.Context, reservationID uuid.UUID, endDate time.Time) (domain.Extension, error)
+}
+
+type PaymentsService interface {
+	Authorize(ctx context.Context, reservationID uuid.UUID, paymentMethod string) (domain.Payment, error)
+	Capture(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error)
+	Void(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error)
+	Refund(ctx context.Context, reservationID uuid.UUID, amount float64) (domain.Payment, error)
+	Get(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error)
+	HandleEvent(ctx context.Context, payload []byte, signature string) error
+}
+
+type DepositsService interface {
+	Get(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error)
+	Capture(ctx context.Context, reservationID uuid.UUID, amount float64, reason string) (domain.Deposit, error)
+	Release(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error)
+}
+
+type LedgerService interface {
+	GetBalance(ctx context.Context, reservationID uuid.UUID) (domain.LedgerBalance, error)
+}
+
+type InvoicesService interface {
+	Issue(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Invoice, error)
+	GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error)
+	Open(ctx context.Context, id uuid.UUID, format string) (content io.ReadCloser, contentType string, err error)
+	GetBillingProfile(ctx context.Context, userID uuid.UUID) (domain.BillingProfile, error)
+	SaveBillingProfile(ctx context.Context, profile domain.BillingProfile) (domain.BillingProfile, error)
+}
+
+type MaintenancesService interface {
+	Schedule(ctx context.Context, maintenance domain.Maintenance) (domain.Maintenance, []domain.Reservation, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Maintenance, error)
+	FullUpdate(ctx context.Context, dm domain.Maintenance) ([]domain.Reservation, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, city string, fromMaintenanceID string, startDate time.Time, endDate time.Time) ([]domain.Maintenance, error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Maintenance, error)
+}
+
+type TransfersService interface {
+	Schedule(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Transfer, error)
+	GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error)
+	Complete(ctx context.Context, id uuid.UUID) (domain.Transfer, error)
+	Cancel(ctx context.Context, id uuid.UUID) error
+}
+
+type HandoversService interface {
+	RecordInspection(ctx context.Context, inspection domain.Inspection, paymentMethod string) (domain.Handover, error)
+	Get(ctx context.Context, reservationID uuid.UUID) (domain.Handover, error)
+	GetCarMileage(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error)
+	RegisterCarService(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error)
+}
+
+type LateReturnsService interface {
+	ListOverdue(ctx context.Context) ([]domain.OverdueRental, error)
+	NotifyOverdue(ctx context.Context) ([]domain.OverdueRental, error)
+}
+
+type DamageReportsService interface {
+	File(ctx context.Context, report domain.DamageReport, photos []domain.PhotoUpload) (domain.DamageReport, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.DamageReport, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status string, resolution string) (domain.DamageReport, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error)
+	AddPhotos(ctx context.Context, reportID uuid.UUID, photos []domain.PhotoUpload) (domain.DamageReport, error)
+	OpenPhoto(ctx context.Context, reportID uuid.UUID, photoID uuid.UUID, thumbnail bool) (content io.ReadCloser, contentType string, err error)
 }
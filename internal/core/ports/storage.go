@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"io"
+)
+
+// mockgen -source=internal/core/ports/storage.go -destination=internal/pkg/mocks/storage.go
+
+// Storage keeps binary objects, such as photos, identified by a key
+type Storage interface {
+	Save(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
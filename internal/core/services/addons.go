@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAddOnNotFound          = "add-on not found"
+	ErrAddOnNameInUse         = "branch already has an add-on with that name"
+	ErrAddOnHasReservations   = "add-on can not be deleted while it has reservations"
+	ErrRepeatedAddOn          = "add-ons can not be repeated in a reservation"
+	ErrAddOnNotAtPickupBranch = "add-ons must be offered at the pickup branch of the reservation"
+	ErrAddOnOutOfStock        = "add-on does not have enough units left for the reservation time frame"
+)
+
+type AddOns struct {
+	addOnsRepository ports.AddOnsRepo
+}
+
+func NewAddOns(aor ports.AddOnsRepo) AddOns {
+	return AddOns{
+		addOnsRepository: aor,
+	}
+}
+
+func (aos AddOns) Register(ctx context.Context, addOn domain.AddOn) (domain.AddOn, error) {
+	addOn.ID = uuid.New()
+
+	if err := aos.addOnsRepository.Insert(ctx, addOn); err != nil {
+		return domain.AddOn{}, err
+	}
+
+	return addOn, nil
+}
+
+func (aos AddOns) Get(ctx context.Context, ID uuid.UUID) (domain.AddOn, error) {
+	return aos.addOnsRepository.Get(ctx, ID)
+}
+
+func (aos AddOns) FullUpdate(ctx context.Context, addOn domain.AddOn) error {
+	return aos.addOnsRepository.FullUpdate(ctx, addOn)
+}
+
+func (aos AddOns) Delete(ctx context.Context, ID uuid.UUID) error {
+	return aos.addOnsRepository.Delete(ctx, ID)
+}
+
+func (aos AddOns) ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error) {
+	return aos.addOnsRepository.ListByBranchID(ctx, branchID)
+}
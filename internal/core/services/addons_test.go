@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type addOnsDependencies struct {
+	addOnsRepository *mocks.MockAddOnsRepo
+}
+
+func NewAddOnsDependencies(addOnsRepo *mocks.MockAddOnsRepo) *addOnsDependencies {
+	return &addOnsDependencies{
+		addOnsRepository: addOnsRepo,
+	}
+}
+
+func TestAddOnsRegister(t *testing.T) {
+	addOn := domain.AddOn{
+		BranchID:    uuid.New(),
+		Name:        "Child seat",
+		Stock:       4,
+		Price:       12.5,
+		PricingUnit: "Per Day",
+	}
+
+	type args struct {
+		addOn domain.AddOn
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*addOnsDependencies)
+	}{
+		{
+			name: "returns nil error when the add-on was registered",
+			args: args{
+				addOn: addOn,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the branch already has an add-on with the same name",
+			args: args{
+				addOn: addOn,
+			},
+			wants: wants{
+				err: errors.New(ErrAddOnNameInUse),
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrAddOnNameInUse))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			d := NewAddOnsDependencies(addOnsRepo)
+			test.setMocks(d)
+
+			addOnsService := NewAddOns(addOnsRepo)
+			dao, err := addOnsService.Register(context.TODO(), test.args.addOn)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, dao.ID)
+				assert.Equal(t, test.args.addOn.Name, dao.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBranchNotFound            = "branch not found"
+	ErrBranchHasCars             = "branch can not be moved or deleted while it has cars"
+	ErrBranchNotInCarCity        = "branch does not belong to the car city"
+	ErrBranchHasReservations     = "branch can not be deleted while it has reservations"
+	ErrPickupOutsideOpeningHours = "pickup time is outside the branch opening hours"
+	ErrReturnOutsideOpeningHours = "return time is outside the branch opening hours"
+)
+
+type Branches struct {
+	branchesRepository ports.BranchesRepo
+}
+
+func NewBranches(br ports.BranchesRepo) Branches {
+	return Branches{
+		branchesRepository: br,
+	}
+}
+
+func (bs Branches) Register(ctx context.Context, branch domain.Branch) (domain.Branch, error) {
+	branch.ID = uuid.New()
+
+	if err := bs.branchesRepository.Insert(ctx, branch); err != nil {
+		return domain.Branch{}, err
+	}
+
+	return branch, nil
+}
+
+func (bs Branches) Get(ctx context.Context, ID uuid.UUID) (domain.Branch, error) {
+	return bs.branchesRepository.Get(ctx, ID)
+}
+
+func (bs Branches) FullUpdate(ctx context.Context, branch domain.Branch) error {
+	return bs.branchesRepository.FullUpdate(ctx, branch)
+}
+
+func (bs Branches) Delete(ctx context.Context, ID uuid.UUID) error {
+	return bs.branchesRepository.Delete(ctx, ID)
+}
+
+func (bs Branches) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error) {
+	return bs.branchesRepository.ListByCityID(ctx, cityID)
+}
+
+// Tells whether a branch is open at the given time, which must be in the city's location.
+// Closing times are inclusive so a car can be returned right when the branch closes.
+func isBranchOpenAt(branch domain.Branch, t time.Time) bool {
+	date := t.Format("2006-01-02")
+	for _, closure := range branch.Closures {
+		if closure.Date == date {
+			return false
+		}
+	}
+
+	clock := t.Format("15:04:05")
+	for _, hours := range branch.OpeningHours {
+		if hours.Weekday == t.Weekday() {
+			return clock >= hours.OpensAt+":00" && clock <= hours.ClosesAt+":00"
+		}
+	}
+
+	return false
+}
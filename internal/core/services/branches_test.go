@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type branchesDependencies struct {
+	branchesRepository *mocks.MockBranchesRepo
+}
+
+func NewBranchesDependencies(branchesRepo *mocks.MockBranchesRepo) *branchesDependencies {
+	return &branchesDependencies{
+		branchesRepository: branchesRepo,
+	}
+}
+
+func TestBranchesRegister(t *testing.T) {
+	branch := domain.Branch{
+		CityID:    uuid.New(),
+		Name:      "Chicago Downtown",
+		Address:   "55 W Randolph St, Chicago, IL 60601",
+		Latitude:  41.8837,
+		Longitude: -87.6289,
+		OpeningHours: []domain.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "07:00", ClosesAt: "21:00"},
+		},
+	}
+
+	type args struct {
+		branch domain.Branch
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*branchesDependencies)
+	}{
+		{
+			name: "returns nil error when the branch was registered",
+			args: args{
+				branch: branch,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the city was not found",
+			args: args{
+				branch: branch,
+			},
+			wants: wants{
+				err: errors.New(ErrCityNotFound),
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrCityNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			d := NewBranchesDependencies(branchesRepo)
+			test.setMocks(d)
+
+			branchesService := NewBranches(branchesRepo)
+			registered, err := branchesService.Register(context.TODO(), test.args.branch)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, registered.ID)
+				assert.Equal(t, test.args.branch.Name, registered.Name)
+			}
+		})
+	}
+}
+
+func TestIsBranchOpenAt(t *testing.T) {
+	branch := domain.Branch{
+		OpeningHours: []domain.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "08:00", ClosesAt: "18:00"},
+		},
+		Closures: []domain.BranchClosure{
+			{Date: "2030-07-08"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		open bool
+	}{
+		{name: "is open right when it opens", at: time.Date(2030, time.July, 1, 8, 0, 0, 0, time.UTC), open: true},
+		{name: "is open right when it closes", at: time.Date(2030, time.July, 1, 18, 0, 0, 0, time.UTC), open: true},
+		{name: "is closed a second after closing", at: time.Date(2030, time.July, 1, 18, 0, 1, 0, time.UTC), open: false},
+		{name: "is closed before opening", at: time.Date(2030, time.July, 1, 7, 59, 0, 0, time.UTC), open: false},
+		{name: "is closed on weekdays without opening hours", at: time.Date(2030, time.July, 2, 12, 0, 0, 0, time.UTC), open: false},
+		{name: "is closed on holiday closures", at: time.Date(2030, time.July, 8, 12, 0, 0, 0, time.UTC), open: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.open, isBranchOpenAt(branch, test.at))
+		})
+	}
+}
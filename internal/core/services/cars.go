@@ -2,25 +2,38 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
 	"github.com/google/uuid"
 )
 
 var (
 	ErrCarNotFound     = "car not found"
 	ErrCarNotAvailable = "car not available"
+	ErrCarUnavailable  = "car is marked as unavailable"
+
+	ErrLicensePlateAlreadyRegistered = "license plate already registered"
+	ErrVINAlreadyRegistered          = "vin already registered"
+
+	ErrInvalidSearchRadius = "search radius must be greater than 0 and not exceed the maximum allowed"
 )
 
 type Cars struct {
-	carsRepository ports.CarsRepo
+	carsRepository         ports.CarsRepo
+	reservationsRepository ports.ReservationsRepo
 }
 
-func NewCars(cr ports.CarsRepo) Cars {
+func NewCars(cr ports.CarsRepo, rr ports.ReservationsRepo) Cars {
 	return Cars{
-		carsRepository: cr,
+		carsRepository:         cr,
+		reservationsRepository: rr,
 	}
 }
 
@@ -43,24 +56,80 @@ func (cs Cars) Get(ctx context.Context, ID uuid.UUID) (domain.Car, error) {
 	return dc, nil
 }
 
-func (cs Cars) FullUpdate(ctx context.Context, car domain.Car) error {
-	return cs.carsRepository.FullUpdate(ctx, car)
+// Updates the car. Unavailable cars can not be booked, so marking a car as
+// unavailable returns its upcoming reservations to be handled.
+func (cs Cars) FullUpdate(ctx context.Context, car domain.Car) ([]domain.Reservation, error) {
+	if err := cs.carsRepository.FullUpdate(ctx, car); err != nil {
+		return nil, err
+	}
+
+	if car.Status != constants.Values().CAR_STATUSES.UNAVAILABLE {
+		return nil, nil
+	}
+
+	reservations, err := cs.reservationsRepository.GetByCarID(ctx, car.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return upcomingReservations(reservations, time.Now()), nil
 }
 
 func (cs Cars) Delete(ctx context.Context, id uuid.UUID) error {
 	return cs.carsRepository.Delete(ctx, id)
 }
 
-// List cars by city name.
+// List cars by city name matching the given filters.
 // from_car_id is the last document retrieved in the last page
-func (cs Cars) List(ctx context.Context, city string, from_car_id string) ([]domain.Car, error) {
+func (cs Cars) List(ctx context.Context, city string, filters domain.CarFilters, from_car_id string) ([]domain.Car, error) {
+	values := constants.Values()
 	if from_car_id == "" {
-		from_car_id = constants.Values.NULL_UUID
+		from_car_id = values.NULL_UUID
 	}
-	cars, err := cs.carsRepository.List(ctx, city, from_car_id, constants.Values.CARS_PER_PAGE)
+	cars, err := cs.carsRepository.List(ctx, city, filters, from_car_id, values.CARS_PER_PAGE)
 	if err != nil {
 		return []domain.Car{}, err
 	}
 
 	return cars, nil
 }
+
+// Searches the cars available during the time window that can be picked up
+// within the radius of the location, nearest first. Distances are measured
+// from the location to the branch where each car will be when the window
+// starts. At most CARS_PER_PAGE cars are returned.
+func (cs Cars) SearchNearby(ctx context.Context, search domain.NearbyCarsSearch) ([]domain.NearbyCar, error) {
+	values := constants.Values()
+	if search.RadiusKm <= 0 || search.RadiusKm > float64(values.MAXIMUM_SEARCH_RADIUS_KM) {
+		return nil, fmt.Errorf("%s (%d km)", ErrInvalidSearchRadius, values.MAXIMUM_SEARCH_RADIUS_KM)
+	}
+
+	if !utils.IsValidTimeFrame(search.StartDate, search.EndDate) || search.StartDate.Before(time.Now()) {
+		return nil, errors.New(ErrInvalidReservationTimeFrame)
+	}
+
+	var bounds domain.GeoBounds
+	bounds.MinLatitude, bounds.MaxLatitude, bounds.MinLongitude, bounds.MaxLongitude = utils.BoundingBox(search.Latitude, search.Longitude, search.RadiusKm)
+	cars, err := cs.carsRepository.ListAvailableInArea(ctx, bounds, values.CAR_STATUSES.AVAILABLE, search.StartDate, search.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// the bounds hold the circle of the radius, but their corners fall outside of it
+	nearbyCars := make([]domain.NearbyCar, 0, len(cars))
+	for _, car := range cars {
+		car.DistanceKm = utils.HaversineDistanceKm(search.Latitude, search.Longitude, car.PickupBranch.Latitude, car.PickupBranch.Longitude)
+		if car.DistanceKm <= search.RadiusKm {
+			nearbyCars = append(nearbyCars, car)
+		}
+	}
+
+	sort.SliceStable(nearbyCars, func(i, j int) bool {
+		return nearbyCars[i].DistanceKm < nearbyCars[j].DistanceKm
+	})
+	if len(nearbyCars) > int(values.CARS_PER_PAGE) {
+		nearbyCars = nearbyCars[:values.CARS_PER_PAGE]
+	}
+
+	return nearbyCars, nil
+}
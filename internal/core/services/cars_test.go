@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
@@ -97,7 +99,7 @@ func TestCarsRegister(t *testing.T) {
 			d := NewCarsDependencies(carsRepo, reservationsRepo)
 			test.setMocks(d)
 
-			carsService := NewCars(carsRepo)
+			carsService := NewCars(carsRepo, reservationsRepo)
 			_, err := carsService.Register(test.args.ctx, test.args.car)
 
 			assert.Equal(t, test.wants.withError, err != nil)
@@ -167,7 +169,7 @@ func TestCarsGet(t *testing.T) {
 			d := NewCarsDependencies(carsRepo, reservationsRepo)
 			test.setMocks(d)
 
-			carsService := NewCars(carsRepo)
+			carsService := NewCars(carsRepo, reservationsRepo)
 			car, err := carsService.Get(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.car, car)
@@ -177,6 +179,8 @@ func TestCarsGet(t *testing.T) {
 }
 
 func TestCarsFullUpdate(t *testing.T) {
+	initConstantsFromServices(t)
+
 	car := domain.Car{
 		ID:             uuid.New(),
 		Type:           "Luxury",
@@ -185,13 +189,30 @@ func TestCarsFullUpdate(t *testing.T) {
 		CityName:       "Austin",
 		Status:         "Available",
 	}
+	unavailableCar := car
+	unavailableCar.Status = "Unavailable"
+	upcomingReservation := domain.Reservation{
+		ID:        uuid.New(),
+		CarID:     car.ID,
+		Status:    "Reserved",
+		StartDate: time.Now().AddDate(0, 0, 1),
+		EndDate:   time.Now().AddDate(0, 0, 3),
+	}
+	pastReservation := upcomingReservation
+	pastReservation.ID = uuid.New()
+	pastReservation.StartDate = time.Now().AddDate(0, 0, -3)
+	pastReservation.EndDate = time.Now().AddDate(0, 0, -1)
+	canceledReservation := upcomingReservation
+	canceledReservation.ID = uuid.New()
+	canceledReservation.Status = "Canceled"
 
 	type args struct {
 		ctx context.Context
 		car domain.Car
 	}
 	type wants struct {
-		err error
+		affectedReservations []domain.Reservation
+		err                  error
 	}
 	tests := []struct {
 		name     string
@@ -206,12 +227,28 @@ func TestCarsFullUpdate(t *testing.T) {
 				car: car,
 			},
 			wants: wants{
-				err: nil,
+				affectedReservations: nil,
+				err:                  nil,
 			},
 			setMocks: func(d *carsDependencies) {
 				d.carsRepository.EXPECT().FullUpdate(gomock.Any(), car).Return(nil)
 			},
 		},
+		{
+			name: "returns the upcoming reservations when car is marked as unavailable",
+			args: args{
+				ctx: context.TODO(),
+				car: unavailableCar,
+			},
+			wants: wants{
+				affectedReservations: []domain.Reservation{upcomingReservation},
+				err:                  nil,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsRepository.EXPECT().FullUpdate(gomock.Any(), unavailableCar).Return(nil)
+				d.reservationsRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return([]domain.Reservation{pastReservation, upcomingReservation, canceledReservation}, nil)
+			},
+		},
 		{
 			name: "returns an error when car update fails",
 			args: args{
@@ -219,7 +256,8 @@ func TestCarsFullUpdate(t *testing.T) {
 				car: car,
 			},
 			wants: wants{
-				err: errors.New("failure while updating car"),
+				affectedReservations: nil,
+				err:                  errors.New("failure while updating car"),
 			},
 			setMocks: func(d *carsDependencies) {
 				d.carsRepository.EXPECT().FullUpdate(gomock.Any(), car).Return(errors.New("failure while updating car"))
@@ -235,9 +273,10 @@ func TestCarsFullUpdate(t *testing.T) {
 			d := NewCarsDependencies(carsRepo, reservationsRepo)
 			test.setMocks(d)
 
-			carsService := NewCars(carsRepo)
-			err := carsService.FullUpdate(test.args.ctx, test.args.car)
+			carsService := NewCars(carsRepo, reservationsRepo)
+			affectedReservations, err := carsService.FullUpdate(test.args.ctx, test.args.car)
 
+			assert.Equal(t, test.wants.affectedReservations, affectedReservations)
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
@@ -293,7 +332,7 @@ func TestCarsDelete(t *testing.T) {
 			d := NewCarsDependencies(carsRepo, reservationsRepo)
 			test.setMocks(d)
 
-			carsService := NewCars(carsRepo)
+			carsService := NewCars(carsRepo, reservationsRepo)
 			err := carsService.Delete(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.err, err)
@@ -326,6 +365,7 @@ func TestCarsList(t *testing.T) {
 	type args struct {
 		ctx         context.Context
 		city        string
+		filters     domain.CarFilters
 		from_car_id string
 	}
 	type wants struct {
@@ -350,7 +390,7 @@ func TestCarsList(t *testing.T) {
 				err:  nil,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsRepository.EXPECT().List(gomock.Any(), "New York", "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any()).Return(foundCars, nil)
+				d.carsRepository.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any()).Return(foundCars, nil)
 			},
 		},
 		{
@@ -365,7 +405,23 @@ func TestCarsList(t *testing.T) {
 				err:  nil,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsRepository.EXPECT().List(gomock.Any(), "New York", "00000000-0000-0000-0000-000000000000", gomock.Any()).Return(foundCars, nil)
+				d.carsRepository.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "00000000-0000-0000-0000-000000000000", gomock.Any()).Return(foundCars, nil)
+			},
+		},
+		{
+			name: "calls repository with the given filters",
+			args: args{
+				ctx:         context.TODO(),
+				city:        "New York",
+				filters:     domain.CarFilters{Make: "Toyota", Features: []string{"GPS"}},
+				from_car_id: "5ae5d956-5a8d-40dd-9aef-5340fda345e8",
+			},
+			wants: wants{
+				cars: foundCars,
+				err:  nil,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsRepository.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{Make: "Toyota", Features: []string{"GPS"}}, "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any()).Return(foundCars, nil)
 			},
 		},
 		{
@@ -380,7 +436,7 @@ func TestCarsList(t *testing.T) {
 				err:  errors.New("there was some internal error"),
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsRepository.EXPECT().List(gomock.Any(), "New York", "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any()).Return([]domain.Car{}, errors.New("there was some internal error"))
+				d.carsRepository.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any()).Return([]domain.Car{}, errors.New("there was some internal error"))
 			},
 		},
 	}
@@ -393,11 +449,122 @@ func TestCarsList(t *testing.T) {
 			d := NewCarsDependencies(carsRepo, reservationsRepo)
 			test.setMocks(d)
 
-			carsService := NewCars(carsRepo)
-			cars, err := carsService.List(test.args.ctx, test.args.city, test.args.from_car_id)
+			carsService := NewCars(carsRepo, reservationsRepo)
+			cars, err := carsService.List(test.args.ctx, test.args.city, test.args.filters, test.args.from_car_id)
 
 			assert.Equal(t, test.wants.cars, cars)
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
 }
+
+func TestCarsSearchNearby(t *testing.T) {
+	initConstantsFromServices(t)
+
+	startDate := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	endDate := startDate.Add(24 * time.Hour)
+	downtownBranch := domain.Branch{ID: uuid.New(), Name: "Downtown", Latitude: 41.8837, Longitude: -87.6289}
+	oHareBranch := domain.Branch{ID: uuid.New(), Name: "O'Hare Airport", Latitude: 41.9786, Longitude: -87.9048}
+	// inside the bounding box of a 30 km radius but about 31 km away
+	cornerBranch := domain.Branch{ID: uuid.New(), Name: "Corner", Latitude: 42.0837, Longitude: -87.3689}
+	oHareCar := domain.NearbyCar{Car: domain.Car{ID: uuid.New()}, PickupBranch: oHareBranch}
+	downtownCar := domain.NearbyCar{Car: domain.Car{ID: uuid.New()}, PickupBranch: downtownBranch}
+	cornerCar := domain.NearbyCar{Car: domain.Car{ID: uuid.New()}, PickupBranch: cornerBranch}
+
+	type args struct {
+		search domain.NearbyCarsSearch
+	}
+	type wants struct {
+		carIDs      []uuid.UUID
+		distancesKm []float64
+		err         error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*carsDependencies)
+	}{
+		{
+			name: "returns the cars within the radius ordered by distance",
+			args: args{
+				search: domain.NearbyCarsSearch{Latitude: 41.8837, Longitude: -87.6289, RadiusKm: 30, StartDate: startDate, EndDate: endDate},
+			},
+			wants: wants{
+				carIDs:      []uuid.UUID{downtownCar.Car.ID, oHareCar.Car.ID},
+				distancesKm: []float64{0, 25.145},
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsRepository.EXPECT().ListAvailableInArea(gomock.Any(), gomock.Any(), "Available", startDate, endDate).
+					DoAndReturn(func(_ context.Context, bounds domain.GeoBounds, _ string, _ time.Time, _ time.Time) ([]domain.NearbyCar, error) {
+						assert.Less(t, bounds.MinLatitude, 41.8837)
+						assert.Greater(t, bounds.MaxLongitude, -87.6289)
+
+						return []domain.NearbyCar{oHareCar, cornerCar, downtownCar}, nil
+					})
+			},
+		},
+		{
+			name: "returns an error when the radius exceeds the maximum allowed",
+			args: args{
+				search: domain.NearbyCarsSearch{Latitude: 41.8837, Longitude: -87.6289, RadiusKm: 51, StartDate: startDate, EndDate: endDate},
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (50 km)", ErrInvalidSearchRadius),
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns an error when the radius is not positive",
+			args: args{
+				search: domain.NearbyCarsSearch{Latitude: 41.8837, Longitude: -87.6289, RadiusKm: 0, StartDate: startDate, EndDate: endDate},
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (50 km)", ErrInvalidSearchRadius),
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns an error when the time window is in the past",
+			args: args{
+				search: domain.NearbyCarsSearch{Latitude: 41.8837, Longitude: -87.6289, RadiusKm: 10, StartDate: startDate.Add(-96 * time.Hour), EndDate: endDate},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidReservationTimeFrame),
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns an error when repository fails",
+			args: args{
+				search: domain.NearbyCarsSearch{Latitude: 41.8837, Longitude: -87.6289, RadiusKm: 10, StartDate: startDate, EndDate: endDate},
+			},
+			wants: wants{
+				err: errors.New("there was some internal error"),
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsRepository.EXPECT().ListAvailableInArea(gomock.Any(), gomock.Any(), "Available", startDate, endDate).Return(nil, errors.New("there was some internal error"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			d := NewCarsDependencies(carsRepo, reservationsRepo)
+			test.setMocks(d)
+
+			carsService := NewCars(carsRepo, reservationsRepo)
+			cars, err := carsService.SearchNearby(context.TODO(), test.args.search)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Len(t, cars, len(test.wants.carIDs))
+			for i, car := range cars {
+				assert.Equal(t, test.wants.carIDs[i], car.Car.ID)
+				assert.InDelta(t, test.wants.distancesKm[i], car.DistanceKm, 0.001)
+			}
+		})
+	}
+}
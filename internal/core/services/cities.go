@@ -2,25 +2,84 @@ package services
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
 )
 
 var (
-	ErrInvalidCityName = "city name is not valid"
-	ErrCityNotFound    = "city not found"
+	ErrInvalidCityName           = "city name is not valid"
+	ErrCityNotFound              = "city not found"
+	ErrCityNameAlreadyRegistered = "city name already registered"
+	ErrCityHasCars               = "city can not be deleted while it has cars"
+	ErrCityHasBranches           = "city can not be deleted while it has branches"
+	ErrCityCurrencyInUse         = "city currency can not change while it has cars"
+	ErrInvalidTimeZone           = "time zone is not a valid IANA time zone"
 )
 
 type Cities struct {
-	carsRepository ports.CitiesRepo
+	citiesRepository ports.CitiesRepo
 }
 
 func NewCities(cr ports.CitiesRepo) Cities {
 	return Cities{
-		carsRepository: cr,
+		citiesRepository: cr,
 	}
 }
 
-func (cs Cities) ListNames(ctx context.Context) ([]string, error) {
-	return cs.carsRepository.ListNames(ctx)
+func (cs Cities) Register(ctx context.Context, city domain.City) (domain.City, error) {
+	if _, err := LoadCityLocation(city.TimeZone); err != nil {
+		return domain.City{}, err
+	}
+
+	city.ID = uuid.New()
+	if err := cs.citiesRepository.Insert(ctx, city); err != nil {
+		return domain.City{}, err
+	}
+
+	return city, nil
+}
+
+func (cs Cities) Get(ctx context.Context, ID uuid.UUID) (domain.City, error) {
+	return cs.citiesRepository.Get(ctx, ID)
+}
+
+func (cs Cities) FullUpdate(ctx context.Context, city domain.City) error {
+	if _, err := LoadCityLocation(city.TimeZone); err != nil {
+		return err
+	}
+
+	return cs.citiesRepository.FullUpdate(ctx, city)
+}
+
+func (cs Cities) Delete(ctx context.Context, id uuid.UUID) error {
+	return cs.citiesRepository.Delete(ctx, id)
+}
+
+func (cs Cities) List(ctx context.Context, fromCityID string) ([]domain.City, error) {
+	values := constants.Values()
+	if fromCityID == "" {
+		fromCityID = values.NULL_UUID
+	}
+
+	return cs.citiesRepository.List(ctx, fromCityID, values.CITIES_PER_PAGE)
+}
+
+// Loads the location of an IANA time zone such as America/Chicago. Empty and
+// "Local" names are rejected since they do not identify a city time zone.
+func LoadCityLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" || timeZone == "Local" {
+		return nil, errors.New(ErrInvalidTimeZone)
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.New(ErrInvalidTimeZone)
+	}
+
+	return location, nil
 }
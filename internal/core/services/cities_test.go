@@ -5,8 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,13 +22,104 @@ func NewCitiesDependencies(citiesRepo *mocks.MockCitiesRepo) *citiesDependencies
 	}
 }
 
-func TestCitiesListNames(t *testing.T) {
+func TestCitiesRegister(t *testing.T) {
+	city := domain.City{
+		Name:     "Bogota",
+		TimeZone: "America/Bogota",
+		Country:  "CO",
+		Currency: "COP",
+	}
+
+	type args struct {
+		city domain.City
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*citiesDependencies)
+	}{
+		{
+			name: "returns nil error when the city was registered",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the time zone does not exist",
+			args: args{
+				city: domain.City{Name: city.Name, TimeZone: "America/Atlantis", Country: city.Country, Currency: city.Currency},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidTimeZone),
+			},
+			setMocks: func(d *citiesDependencies) {},
+		},
+		{
+			name: "returns an error when the time zone is the server local one",
+			args: args{
+				city: domain.City{Name: city.Name, TimeZone: "Local", Country: city.Country, Currency: city.Currency},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidTimeZone),
+			},
+			setMocks: func(d *citiesDependencies) {},
+		},
+		{
+			name: "returns an error when the city name is already registered",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				err: errors.New(ErrCityNameAlreadyRegistered),
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrCityNameAlreadyRegistered))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			d := NewCitiesDependencies(citiesRepo)
+			test.setMocks(d)
+
+			citiesService := NewCities(citiesRepo)
+			registeredCity, err := citiesService.Register(context.TODO(), test.args.city)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, registeredCity.ID)
+			}
+		})
+	}
+}
+
+func TestCitiesList(t *testing.T) {
+	initConstantsFromServices(t)
+
+	cities := []domain.City{
+		{ID: uuid.New(), Name: "Chicago", TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+		{ID: uuid.New(), Name: "New York", TimeZone: "America/New_York", Country: "US", Currency: "USD"},
+	}
+
 	type args struct {
-		ctx context.Context
+		fromCityID string
 	}
 	type wants struct {
-		cityNames []string
-		err       error
+		cities []domain.City
+		err    error
 	}
 	tests := []struct {
 		name     string
@@ -35,28 +128,29 @@ func TestCitiesListNames(t *testing.T) {
 		setMocks func(*citiesDependencies)
 	}{
 		{
-			name: "returns nil error when the cities name list was successful retrieved",
+			name: "lists from the first city when no city id is given",
 			args: args{
-				ctx: context.TODO(),
+				fromCityID: "",
 			},
 			wants: wants{
-				cityNames: []string{"CityName A", "CityName B", "CityName C"},
-				err:       nil,
+				cities: cities,
+				err:    nil,
 			},
 			setMocks: func(d *citiesDependencies) {
-				d.citiesRepository.EXPECT().ListNames(gomock.Any()).Return([]string{"CityName A", "CityName B", "CityName C"}, nil)
+				d.citiesRepository.EXPECT().List(gomock.Any(), "00000000-0000-0000-0000-000000000000", uint16(20)).Return(cities, nil)
 			},
 		},
 		{
-			name: "returns an error when server fails retrieving cities name",
+			name: "returns an error when the repository fails",
 			args: args{
-				ctx: context.TODO(),
+				fromCityID: cities[0].ID.String(),
 			},
 			wants: wants{
-				err: errors.New("failure listing cities name"),
+				cities: nil,
+				err:    errors.New("failure listing cities"),
 			},
 			setMocks: func(d *citiesDependencies) {
-				d.citiesRepository.EXPECT().ListNames(gomock.Any()).Return(nil, errors.New("failure listing cities name"))
+				d.citiesRepository.EXPECT().List(gomock.Any(), cities[0].ID.String(), uint16(20)).Return(nil, errors.New("failure listing cities"))
 			},
 		},
 	}
@@ -69,9 +163,9 @@ func TestCitiesListNames(t *testing.T) {
 			test.setMocks(d)
 
 			citiesService := NewCities(citiesRepo)
-			cityNames, err := citiesService.ListNames(test.args.ctx)
+			foundCities, err := citiesService.List(context.TODO(), test.args.fromCityID)
 
-			assert.Equal(t, test.wants.cityNames, cityNames)
+			assert.Equal(t, test.wants.cities, foundCities)
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
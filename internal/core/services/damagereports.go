@@ -0,0 +1,258 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/images"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDamageReportNotFound            = "damage report was not found"
+	ErrDamagePhotoNotFound             = "damage photo was not found"
+	ErrDamageReportReservationMismatch = "reservation does not belong to the damaged car"
+	ErrUnsupportedPhotoType            = "photo content type is not supported"
+	ErrPhotoTooLarge                   = "photo exceeds the maximum allowed size"
+	ErrPhotoDimensionsTooLarge         = "photo exceeds the maximum allowed dimensions"
+	ErrInvalidPhoto                    = "photo could not be decoded"
+	ErrDamageResolutionRequired        = "resolution is required to resolve a damage report"
+)
+
+// Content types accepted for damage photos
+var photoContentTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+}
+
+type DamageReports struct {
+	damageReportsRepository ports.DamageReportsRepo
+	reservationsRepository  ports.ReservationsRepo
+	storage                 ports.Storage
+}
+
+func NewDamageReports(drr ports.DamageReportsRepo, rr ports.ReservationsRepo, s ports.Storage) DamageReports {
+	return DamageReports{
+		damageReportsRepository: drr,
+		reservationsRepository:  rr,
+		storage:                 s,
+	}
+}
+
+// A photo that passed validation, along with its thumbnail
+type processedPhoto struct {
+	photo     domain.DamagePhoto
+	content   []byte
+	thumbnail []byte
+}
+
+// Files a damage report for a car. Photos are validated before anything is
+// stored, so an invalid photo rejects the whole report.
+func (drs DamageReports) File(ctx context.Context, report domain.DamageReport, photos []domain.PhotoUpload) (domain.DamageReport, error) {
+	if report.ReservationID != nil {
+		reservation, err := drs.reservationsRepository.Get(ctx, *report.ReservationID)
+		if err != nil {
+			return domain.DamageReport{}, err
+		}
+		if reservation.CarID != report.CarID {
+			return domain.DamageReport{}, errors.New(ErrDamageReportReservationMismatch)
+		}
+	}
+
+	report.ID = uuid.New()
+	processedPhotos, err := processPhotos(report.ID, photos)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	report.Status = constants.Values().DAMAGE_REPORT_STATUSES.OPEN
+	report.Resolution = ""
+	report.CreatedAt = time.Now()
+	report.ResolvedAt = nil
+	report.Photos = []domain.DamagePhoto{}
+	if err := drs.damageReportsRepository.Insert(ctx, report); err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	if report.Photos, err = drs.storePhotos(ctx, processedPhotos); err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	return report, nil
+}
+
+func (drs DamageReports) Get(ctx context.Context, id uuid.UUID) (domain.DamageReport, error) {
+	report, err := drs.damageReportsRepository.Get(ctx, id)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	return report, nil
+}
+
+// Moves a damage report to the given status. Resolving a report requires a
+// resolution and records when it happened.
+func (drs DamageReports) UpdateStatus(ctx context.Context, id uuid.UUID, status string, resolution string) (domain.DamageReport, error) {
+	report, err := drs.damageReportsRepository.Get(ctx, id)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	report.Status = status
+	report.Resolution = resolution
+	report.ResolvedAt = nil
+	if status == constants.Values().DAMAGE_REPORT_STATUSES.RESOLVED {
+		if resolution == "" {
+			return domain.DamageReport{}, errors.New(ErrDamageResolutionRequired)
+		}
+
+		resolvedAt := time.Now()
+		report.ResolvedAt = &resolvedAt
+	}
+
+	if err := drs.damageReportsRepository.UpdateStatus(ctx, report); err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	return report, nil
+}
+
+func (drs DamageReports) ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error) {
+	return drs.damageReportsRepository.ListByCarID(ctx, carID, status)
+}
+
+// Adds photos to an existing damage report
+func (drs DamageReports) AddPhotos(ctx context.Context, reportID uuid.UUID, photos []domain.PhotoUpload) (domain.DamageReport, error) {
+	report, err := drs.damageReportsRepository.Get(ctx, reportID)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	processedPhotos, err := processPhotos(report.ID, photos)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	storedPhotos, err := drs.storePhotos(ctx, processedPhotos)
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+	report.Photos = append(report.Photos, storedPhotos...)
+
+	return report, nil
+}
+
+// Opens a damage photo, or its thumbnail, returning its content type along with it.
+// The caller must close the returned content.
+func (drs DamageReports) OpenPhoto(ctx context.Context, reportID uuid.UUID, photoID uuid.UUID, thumbnail bool) (io.ReadCloser, string, error) {
+	photo, err := drs.damageReportsRepository.GetPhoto(ctx, reportID, photoID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, contentType := photo.Key, photo.ContentType
+	if thumbnail {
+		key, contentType = photo.ThumbnailKey, "image/jpeg"
+	}
+
+	content, err := drs.storage.Open(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return content, contentType, nil
+}
+
+// Saves the photos and their thumbnails and records them. Files of a photo
+// that could not be recorded are removed from the storage.
+func (drs DamageReports) storePhotos(ctx context.Context, processedPhotos []processedPhoto) ([]domain.DamagePhoto, error) {
+	photos := make([]domain.DamagePhoto, 0, len(processedPhotos))
+
+	for _, pp := range processedPhotos {
+		if err := drs.storage.Save(ctx, pp.photo.Key, bytes.NewReader(pp.content)); err != nil {
+			return nil, err
+		}
+		if err := drs.storage.Save(ctx, pp.photo.ThumbnailKey, bytes.NewReader(pp.thumbnail)); err != nil {
+			drs.storage.Delete(ctx, pp.photo.Key)
+			return nil, err
+		}
+
+		if err := drs.damageReportsRepository.InsertPhoto(ctx, pp.photo); err != nil {
+			drs.storage.Delete(ctx, pp.photo.Key)
+			drs.storage.Delete(ctx, pp.photo.ThumbnailKey)
+			return nil, err
+		}
+
+		photos = append(photos, pp.photo)
+	}
+
+	return photos, nil
+}
+
+// Validates the content type, size and dimensions of the photos and builds their thumbnails
+func processPhotos(reportID uuid.UUID, uploads []domain.PhotoUpload) ([]processedPhoto, error) {
+	maximumPhotoBytes := int(constants.Values().MAXIMUM_PHOTO_BYTES)
+	maximumPhotoPixels := int64(constants.Values().MAXIMUM_PHOTO_PIXELS)
+	thumbnailSize := int(constants.Values().THUMBNAIL_SIZE)
+	processedPhotos := make([]processedPhoto, 0, len(uploads))
+
+	for _, upload := range uploads {
+		if len(upload.Content) > maximumPhotoBytes {
+			return nil, errors.New(ErrPhotoTooLarge)
+		}
+
+		contentType := http.DetectContentType(upload.Content)
+		format, ok := photoContentTypes[contentType]
+		if !ok {
+			return nil, errors.New(ErrUnsupportedPhotoType)
+		}
+
+		// the dimensions are read from the header before decoding, as small
+		// compressed files can stand for images too large to hold in memory
+		config, configFormat, err := image.DecodeConfig(bytes.NewReader(upload.Content))
+		if err != nil || configFormat != format {
+			return nil, errors.New(ErrInvalidPhoto)
+		}
+		if int64(config.Width)*int64(config.Height) > maximumPhotoPixels {
+			return nil, errors.New(ErrPhotoDimensionsTooLarge)
+		}
+
+		img, decodedFormat, err := image.Decode(bytes.NewReader(upload.Content))
+		if err != nil || decodedFormat != format {
+			return nil, errors.New(ErrInvalidPhoto)
+		}
+
+		var thumbnail bytes.Buffer
+		if err := jpeg.Encode(&thumbnail, images.Thumbnail(img, thumbnailSize), nil); err != nil {
+			return nil, err
+		}
+
+		photoID := uuid.New()
+		key := "damage-reports/" + reportID.String() + "/" + photoID.String() + "." + format
+		processedPhotos = append(processedPhotos, processedPhoto{
+			photo: domain.DamagePhoto{
+				ID:           photoID,
+				ReportID:     reportID,
+				ContentType:  contentType,
+				Size:         int32(len(upload.Content)),
+				Key:          key,
+				ThumbnailKey: "damage-reports/" + reportID.String() + "/" + photoID.String() + "-thumbnail.jpg",
+				CreatedAt:    time.Now(),
+			},
+			content:   upload.Content,
+			thumbnail: thumbnail.Bytes(),
+		})
+	}
+
+	return processedPhotos, nil
+}
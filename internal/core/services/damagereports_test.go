@@ -0,0 +1,282 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type damageReportsDependencies struct {
+	damageReportsRepository *mocks.MockDamageReportsRepo
+	reservationsRepository  *mocks.MockReservationsRepo
+	storage                 *mocks.MockStorage
+}
+
+func NewDamageReportsDependencies(damageReportsRepo *mocks.MockDamageReportsRepo, reservationsRepo *mocks.MockReservationsRepo, storage *mocks.MockStorage) *damageReportsDependencies {
+	return &damageReportsDependencies{
+		damageReportsRepository: damageReportsRepo,
+		reservationsRepository:  reservationsRepo,
+		storage:                 storage,
+	}
+}
+
+func encodedPNG(t *testing.T, width int, height int) []byte {
+	var content bytes.Buffer
+	if err := png.Encode(&content, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+
+	return content.Bytes()
+}
+
+// Builds the signature and header of a PNG image of the given dimensions,
+// without its pixels
+func pngHeader(width uint32, height uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], width)
+	binary.BigEndian.PutUint32(ihdr[8:], height)
+	// 8 bit RGBA, default compression, filter and no interlace
+	ihdr[12], ihdr[13] = 8, 6
+
+	content := []byte("\x89PNG\r\n\x1a\n")
+	content = binary.BigEndian.AppendUint32(content, uint32(len(ihdr)-4))
+	content = append(content, ihdr...)
+
+	return binary.BigEndian.AppendUint32(content, crc32.ChecksumIEEE(ihdr))
+}
+
+func TestDamageReportsFile(t *testing.T) {
+	initConstantsFromServices(t)
+
+	carID := uuid.New()
+	reservation := domain.Reservation{ID: uuid.New(), CarID: carID}
+	report := domain.DamageReport{
+		CarID:         carID,
+		ReservationID: &reservation.ID,
+		ReporterID:    uuid.New(),
+		Description:   "Scratch on rear bumper",
+	}
+	photo := domain.PhotoUpload{Filename: "bumper.png", Content: encodedPNG(t, 640, 480)}
+
+	type args struct {
+		report domain.DamageReport
+		photos []domain.PhotoUpload
+	}
+	type wants struct {
+		photos int
+		err    error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*damageReportsDependencies)
+	}{
+		{
+			name: "stores the photo and its thumbnail when the report is filed",
+			args: args{
+				report: report,
+				photos: []domain.PhotoUpload{photo},
+			},
+			wants: wants{
+				photos: 1,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.damageReportsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, ddr domain.DamageReport) error {
+						assert.Equal(t, "Open", ddr.Status)
+						return nil
+					})
+				d.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				d.damageReportsRepository.EXPECT().InsertPhoto(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, ddp domain.DamagePhoto) error {
+						assert.Equal(t, "image/png", ddp.ContentType)
+						assert.NotEqual(t, ddp.Key, ddp.ThumbnailKey)
+						return nil
+					})
+			},
+		},
+		{
+			name: "returns an error when the reservation belongs to another car",
+			args: args{
+				report: report,
+				photos: []domain.PhotoUpload{photo},
+			},
+			wants: wants{
+				err: errors.New(ErrDamageReportReservationMismatch),
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(domain.Reservation{ID: reservation.ID, CarID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns an error when the photo is not an image",
+			args: args{
+				report: domain.DamageReport{CarID: carID, ReporterID: report.ReporterID, Description: report.Description},
+				photos: []domain.PhotoUpload{{Filename: "notes.txt", Content: []byte("not an image")}},
+			},
+			wants: wants{
+				err: errors.New(ErrUnsupportedPhotoType),
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+		{
+			name: "returns an error when the photo exceeds the maximum size",
+			args: args{
+				report: domain.DamageReport{CarID: carID, ReporterID: report.ReporterID, Description: report.Description},
+				photos: []domain.PhotoUpload{{Filename: "huge.png", Content: append(encodedPNG(t, 1, 1), make([]byte, 6<<20)...)}},
+			},
+			wants: wants{
+				err: errors.New(ErrPhotoTooLarge),
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+		{
+			name: "returns an error before decoding the photo when it exceeds the maximum dimensions",
+			args: args{
+				report: domain.DamageReport{CarID: carID, ReporterID: report.ReporterID, Description: report.Description},
+				photos: []domain.PhotoUpload{{Filename: "bomb.png", Content: pngHeader(8000, 6000)}},
+			},
+			wants: wants{
+				err: errors.New(ErrPhotoDimensionsTooLarge),
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+		{
+			name: "removes the stored files when the photo could not be recorded",
+			args: args{
+				report: domain.DamageReport{CarID: carID, ReporterID: report.ReporterID, Description: report.Description},
+				photos: []domain.PhotoUpload{photo},
+			},
+			wants: wants{
+				err: errors.New("error inserting photo"),
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				d.damageReportsRepository.EXPECT().InsertPhoto(gomock.Any(), gomock.Any()).Return(errors.New("error inserting photo"))
+				d.storage.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			damageReportsRepo := mocks.NewMockDamageReportsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			storage := mocks.NewMockStorage(mockCtlr)
+			d := NewDamageReportsDependencies(damageReportsRepo, reservationsRepo, storage)
+			test.setMocks(d)
+
+			damageReportsService := NewDamageReports(damageReportsRepo, reservationsRepo, storage)
+			filedReport, err := damageReportsService.File(context.TODO(), test.args.report, test.args.photos)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Len(t, filedReport.Photos, test.wants.photos)
+		})
+	}
+}
+
+func TestDamageReportsUpdateStatus(t *testing.T) {
+	initConstantsFromServices(t)
+
+	report := domain.DamageReport{ID: uuid.New(), CarID: uuid.New(), Status: "Open"}
+
+	type args struct {
+		status     string
+		resolution string
+	}
+	type wants struct {
+		resolved bool
+		err      error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*damageReportsDependencies)
+	}{
+		{
+			name: "records the resolution date when the report is resolved",
+			args: args{
+				status:     "Resolved",
+				resolution: "Rear bumper repainted",
+			},
+			wants: wants{
+				resolved: true,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsRepository.EXPECT().Get(gomock.Any(), report.ID).Return(report, nil)
+				d.damageReportsRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "does not record the resolution date when the report is in repair",
+			args: args{
+				status: "In Repair",
+			},
+			wants: wants{
+				resolved: false,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsRepository.EXPECT().Get(gomock.Any(), report.ID).Return(report, nil)
+				d.damageReportsRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the report is resolved without a resolution",
+			args: args{
+				status: "Resolved",
+			},
+			wants: wants{
+				err: errors.New(ErrDamageResolutionRequired),
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsRepository.EXPECT().Get(gomock.Any(), report.ID).Return(report, nil)
+			},
+		},
+		{
+			name: "returns an error when the report was not found",
+			args: args{
+				status: "In Repair",
+			},
+			wants: wants{
+				err: errors.New(ErrDamageReportNotFound),
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsRepository.EXPECT().Get(gomock.Any(), report.ID).Return(domain.DamageReport{}, errors.New(ErrDamageReportNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			damageReportsRepo := mocks.NewMockDamageReportsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			storage := mocks.NewMockStorage(mockCtlr)
+			d := NewDamageReportsDependencies(damageReportsRepo, reservationsRepo, storage)
+			test.setMocks(d)
+
+			damageReportsService := NewDamageReports(damageReportsRepo, reservationsRepo, storage)
+			updatedReport, err := damageReportsService.UpdateStatus(context.TODO(), report.ID, test.args.status, test.args.resolution)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.resolved, updatedReport.ResolvedAt != nil)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDepositNotFound              = "deposit not found"
+	ErrDepositPaymentMethodRequired = "a payment method is required to hold the security deposit"
+	ErrDepositDeclined              = "security deposit hold was declined"
+	ErrDepositNotHeld               = "deposit is not held"
+	ErrDepositCaptureExceedsHeld    = "capture exceeds the deposit held"
+	ErrDepositStatusChanged         = "deposit status changed while it was being updated"
+)
+
+type Deposits struct {
+	depositsRepository ports.DepositsRepo
+	paymentGateway     ports.PaymentGateway
+}
+
+func NewDeposits(dr ports.DepositsRepo, pg ports.PaymentGateway) Deposits {
+	return Deposits{
+		depositsRepository: dr,
+		paymentGateway:     pg,
+	}
+}
+
+func (ds Deposits) Get(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error) {
+	return ds.depositsRepository.GetByReservationID(ctx, reservationID)
+}
+
+// Captures part of the held deposit, an amount in its currency, to cover
+// damages or late fees. The rest of the hold is released by the gateway along
+// with the capture.
+func (ds Deposits) Capture(ctx context.Context, reservationID uuid.UUID, amount float64, reason string) (domain.Deposit, error) {
+	deposit, err := ds.depositsRepository.GetByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.Deposit{}, err
+	}
+
+	return captureDeposit(ctx, ds.depositsRepository, ds.paymentGateway, deposit, domain.NewMoney(amount, deposit.Amount.Currency), reason)
+}
+
+// Releases the whole held deposit
+func (ds Deposits) Release(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error) {
+	deposit, err := ds.depositsRepository.GetByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.Deposit{}, err
+	}
+
+	return releaseDeposit(ctx, ds.depositsRepository, ds.paymentGateway, deposit)
+}
+
+// Captures the amount of the held deposit in the gateway and marks it as captured
+func captureDeposit(ctx context.Context, dr ports.DepositsRepo, pg ports.PaymentGateway, deposit domain.Deposit, amount domain.Money, reason string) (domain.Deposit, error) {
+	statuses := constants.Values().DEPOSIT_STATUSES
+	if deposit.Status != statuses.HELD {
+		return domain.Deposit{}, errors.New(ErrDepositNotHeld)
+	}
+
+	if amount.GreaterThan(deposit.Amount) {
+		return domain.Deposit{}, fmt.Errorf("%s (%s held)", ErrDepositCaptureExceedsHeld, deposit.Amount)
+	}
+
+	capturing := deposit
+	capturing.Status = statuses.CAPTURING
+	capturing.CapturedAmount = amount
+	capturing.CaptureReason = reason
+
+	return settleDeposit(ctx, dr, deposit, capturing, statuses.CAPTURED, depositCaptureEntries, func() error {
+		return pg.Capture(ctx, deposit.Reference, amount)
+	})
+}
+
+// Voids the hold of the deposit in the gateway and marks it as released
+func releaseDeposit(ctx context.Context, dr ports.DepositsRepo, pg ports.PaymentGateway, deposit domain.Deposit) (domain.Deposit, error) {
+	statuses := constants.Values().DEPOSIT_STATUSES
+	if deposit.Status != statuses.HELD {
+		return domain.Deposit{}, errors.New(ErrDepositNotHeld)
+	}
+
+	releasing := deposit
+	releasing.Status = statuses.RELEASING
+
+	return settleDeposit(ctx, dr, deposit, releasing, statuses.RELEASED, depositReleaseEntries, func() error {
+		return pg.Void(ctx, deposit.Reference)
+	})
+}
+
+// Settles the held deposit in the gateway. The settlement is recorded before
+// calling the gateway, so the deposit can not be settled twice, and it is held
+// again if the gateway fails. If the gateway settled it but it can not be marked
+// as settled, it stays capturing or releasing to be reconciled with the gateway.
+func settleDeposit(ctx context.Context, dr ports.DepositsRepo, held domain.Deposit, settling domain.Deposit, settledStatus string,
+	entriesFor func(domain.Deposit) []domain.LedgerEntry, settle func() error) (domain.Deposit, error) {
+	if err := dr.Update(ctx, settling, held.Status, nil); err != nil {
+		return domain.Deposit{}, err
+	}
+
+	if err := settle(); err != nil {
+		// no money was moved, so the deposit is held again
+		dr.Update(ctx, held, settling.Status, nil)
+
+		return domain.Deposit{}, err
+	}
+
+	settledAt := time.Now().UTC()
+	settled := settling
+	settled.Status = settledStatus
+	settled.SettledAt = &settledAt
+	if err := dr.Update(ctx, settled, settling.Status, entriesFor(settled)); err != nil {
+		return domain.Deposit{}, err
+	}
+
+	return settled, nil
+}
+
+// Gets the deposit status reservations of a car type are booked with
+func depositStatusFor(carType string) string {
+	values := constants.Values()
+	if values.DepositFor(carType) > 0 {
+		return values.DEPOSIT_STATUSES.PENDING
+	}
+
+	return values.DEPOSIT_STATUSES.NOT_REQUIRED
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type depositsDependencies struct {
+	depositsRepository *mocks.MockDepositsRepo
+	paymentGateway     *mocks.MockPaymentGateway
+}
+
+func NewDepositsDependencies(depositsRepo *mocks.MockDepositsRepo, paymentGateway *mocks.MockPaymentGateway) *depositsDependencies {
+	return &depositsDependencies{
+		depositsRepository: depositsRepo,
+		paymentGateway:     paymentGateway,
+	}
+}
+
+func newDepositsService(t *testing.T, setMocks func(*depositsDependencies)) Deposits {
+	mockCtlr := gomock.NewController(t)
+	depositsRepo := mocks.NewMockDepositsRepo(mockCtlr)
+	paymentGateway := mocks.NewMockPaymentGateway(mockCtlr)
+	setMocks(NewDepositsDependencies(depositsRepo, paymentGateway))
+
+	return NewDeposits(depositsRepo, paymentGateway)
+}
+
+func TestDepositsCapture(t *testing.T) {
+	initConstantsFromServices(t)
+	held := domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000001",
+		Amount:        usd(1500),
+		Status:        "Held",
+		HeldAt:        time.Now(),
+	}
+	released := held
+	released.Status = "Released"
+
+	type args struct {
+		amount float64
+	}
+	type wants struct {
+		captured domain.Money
+		err      error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*depositsDependencies)
+	}{
+		{
+			name: "captures part of the held deposit",
+			args: args{
+				amount: 320.005,
+			},
+			wants: wants{
+				captured: usd(320.01),
+				err:      nil,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).DoAndReturn(func(_ context.Context, dd domain.Deposit, _ string, _ []domain.LedgerEntry) error {
+					assert.Equal(t, "Capturing", dd.Status)
+					assert.Equal(t, usd(320.01), dd.CapturedAmount)
+					assert.Nil(t, dd.SettledAt)
+
+					return nil
+				})
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), held.Reference, usd(320.01)).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", gomock.Any()).DoAndReturn(func(_ context.Context, dd domain.Deposit, _ string, entries []domain.LedgerEntry) error {
+					assert.Equal(t, "Captured", dd.Status)
+					assert.Equal(t, "Scratch on rear bumper", dd.CaptureReason)
+					assert.NotNil(t, dd.SettledAt)
+					// the captured part is revenue and the rest goes back to the customer
+					assertLedgerBalanced(t, entries)
+					balance := ledgerBalance(dd.ReservationID, entries)
+					assert.Equal(t, usd(-320.01), balance.Accounts["Revenue"])
+					assert.Equal(t, usd(-1179.99), balance.Accounts["Customer"])
+					assert.Equal(t, usd(1500.0), balance.Accounts["Deposits"])
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "returns an error when the capture exceeds the deposit held",
+			args: args{
+				amount: 1500.01,
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (1500.00 USD held)", ErrDepositCaptureExceedsHeld),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+			},
+		},
+		{
+			name: "returns an error when the deposit was already released",
+			args: args{
+				amount: 100,
+			},
+			wants: wants{
+				err: errors.New(ErrDepositNotHeld),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(released, nil)
+			},
+		},
+		{
+			name: "returns an error without capturing when the deposit changed before the capture",
+			args: args{
+				amount: 100,
+			},
+			wants: wants{
+				err: errors.New(ErrDepositStatusChanged),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(errors.New(ErrDepositStatusChanged))
+			},
+		},
+		{
+			name: "holds the deposit again when the gateway fails to capture it",
+			args: args{
+				amount: 100,
+			},
+			wants: wants{
+				err: errors.New(ErrPaymentGatewayRejected),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), held.Reference, usd(100)).Return(errors.New(ErrPaymentGatewayRejected))
+				d.depositsRepository.EXPECT().Update(gomock.Any(), held, "Capturing", nil).Return(nil)
+			},
+		},
+		{
+			name: "leaves the deposit capturing when the capture can not be recorded",
+			args: args{
+				amount: 100,
+			},
+			wants: wants{
+				err: errors.New("connection refused"),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), held.Reference, usd(100)).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", gomock.Any()).Return(errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			depositsService := newDepositsService(t, test.setMocks)
+			deposit, err := depositsService.Capture(context.TODO(), held.ReservationID, test.args.amount, "Scratch on rear bumper")
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.wants.captured, deposit.CapturedAmount)
+			}
+		})
+	}
+}
+
+func TestDepositsRelease(t *testing.T) {
+	initConstantsFromServices(t)
+	held := domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000001",
+		Amount:        usd(1000),
+		Status:        "Held",
+		HeldAt:        time.Now(),
+	}
+	captured := held
+	captured.Status = "Captured"
+
+	type wants struct {
+		status string
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*depositsDependencies)
+	}{
+		{
+			name: "releases the held deposit",
+			wants: wants{
+				status: "Released",
+				err:    nil,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), held.Reference).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Releasing", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "holds the deposit again when the gateway fails to void it",
+			wants: wants{
+				err: errors.New(ErrPaymentGatewayRejected),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(held, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), held.Reference).Return(errors.New(ErrPaymentGatewayRejected))
+				d.depositsRepository.EXPECT().Update(gomock.Any(), held, "Releasing", nil).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the deposit was already captured",
+			wants: wants{
+				err: errors.New(ErrDepositNotHeld),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(captured, nil)
+			},
+		},
+		{
+			name: "returns an error when the reservation has no deposit",
+			wants: wants{
+				err: errors.New(ErrDepositNotFound),
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), held.ReservationID).Return(domain.Deposit{}, errors.New(ErrDepositNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			depositsService := newDepositsService(t, test.setMocks)
+			deposit, err := depositsService.Release(context.TODO(), held.ReservationID)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.wants.status, deposit.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+)
+
+var (
+	ErrExchangeRateNotFound     = "exchange rate not found"
+	ErrExchangeRateSameCurrency = "exchange rate currencies must be different"
+)
+
+type ExchangeRates struct {
+	exchangeRatesRepository ports.ExchangeRatesRepo
+}
+
+func NewExchangeRates(xrr ports.ExchangeRatesRepo) ExchangeRates {
+	return ExchangeRates{
+		exchangeRatesRepository: xrr,
+	}
+}
+
+// Sets the rate of a currency pair, replacing the one it had
+func (xrs ExchangeRates) Set(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	if rate.From == rate.To {
+		return domain.ExchangeRate{}, errors.New(ErrExchangeRateSameCurrency)
+	}
+
+	rate.UpdatedAt = time.Now().UTC()
+	if err := xrs.exchangeRatesRepository.Upsert(ctx, rate); err != nil {
+		return domain.ExchangeRate{}, err
+	}
+
+	return rate, nil
+}
+
+func (xrs ExchangeRates) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return xrs.exchangeRatesRepository.List(ctx)
+}
+
+func (xrs ExchangeRates) Delete(ctx context.Context, from string, to string) error {
+	return xrs.exchangeRatesRepository.Delete(ctx, from, to)
+}
+
+// Gets the rate converting from a currency to another. When only the rate of
+// the opposite pair is known its inverse is used.
+func exchangeRate(ctx context.Context, xrr ports.ExchangeRatesRepo, from string, to string) (domain.ExchangeRate, error) {
+	rate, err := xrr.Get(ctx, from, to)
+	if err == nil {
+		return rate, nil
+	}
+	if err.Error() != ErrExchangeRateNotFound {
+		return domain.ExchangeRate{}, err
+	}
+
+	rate, err = xrr.Get(ctx, to, from)
+	if err != nil {
+		if err.Error() == ErrExchangeRateNotFound {
+			return domain.ExchangeRate{}, fmt.Errorf("%s (%s to %s)", ErrExchangeRateNotFound, from, to)
+		}
+		return domain.ExchangeRate{}, err
+	}
+
+	return rate.Inverse(), nil
+}
+
+// Gets an amount of FEES_CURRENCY, such as a late fee, in the given currency,
+// converted with the exchange rates
+func feeIn(ctx context.Context, xrr ports.ExchangeRatesRepo, amount float64, currency string) (domain.Money, error) {
+	return priceIn(ctx, xrr, domain.NewMoney(amount, constants.Values().FEES_CURRENCY), currency)
+}
+
+// Gets a price, such as the hourly rent of a car, in the given currency,
+// converted with the exchange rates when it was set in another one
+func priceIn(ctx context.Context, xrr ports.ExchangeRatesRepo, price domain.Money, currency string) (domain.Money, error) {
+	if price.Currency == currency || price.IsZero() {
+		return domain.Money{Amount: price.Amount, Currency: currency}, nil
+	}
+
+	rate, err := exchangeRate(ctx, xrr, price.Currency, currency)
+	if err != nil {
+		return domain.Money{}, err
+	}
+
+	return price.Convert(rate), nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type exchangeRatesDependencies struct {
+	exchangeRatesRepository *mocks.MockExchangeRatesRepo
+}
+
+func NewExchangeRatesDependencies(exchangeRatesRepo *mocks.MockExchangeRatesRepo) *exchangeRatesDependencies {
+	return &exchangeRatesDependencies{
+		exchangeRatesRepository: exchangeRatesRepo,
+	}
+}
+
+func TestExchangeRatesSet(t *testing.T) {
+	type args struct {
+		rate domain.ExchangeRate
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*exchangeRatesDependencies)
+	}{
+		{
+			name: "returns nil error when the rate was stored",
+			args: args{
+				rate: domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when both currencies are the same",
+			args: args{
+				rate: domain.ExchangeRate{From: "USD", To: "USD", Rate: 1},
+			},
+			wants: wants{
+				err: errors.New(ErrExchangeRateSameCurrency),
+			},
+			setMocks: func(d *exchangeRatesDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewExchangeRatesDependencies(exchangeRatesRepo)
+			test.setMocks(d)
+
+			exchangeRatesService := NewExchangeRates(exchangeRatesRepo)
+			rate, err := exchangeRatesService.Set(context.TODO(), test.args.rate)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.args.rate.Rate, rate.Rate)
+				assert.False(t, rate.UpdatedAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestExchangeRate(t *testing.T) {
+	updatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	type wants struct {
+		rate domain.ExchangeRate
+		err  error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*exchangeRatesDependencies)
+	}{
+		{
+			name: "returns the rate of the pair",
+			wants: wants{
+				rate: domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: updatedAt},
+				err:  nil,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "EUR").
+					Return(domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: updatedAt}, nil)
+			},
+		},
+		{
+			name: "returns the inverse of the opposite rate when the pair has none",
+			wants: wants{
+				rate: domain.ExchangeRate{From: "USD", To: "EUR", Rate: 1 / 1.25, UpdatedAt: updatedAt},
+				err:  nil,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "EUR").Return(domain.ExchangeRate{}, errors.New(ErrExchangeRateNotFound))
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "EUR", "USD").
+					Return(domain.ExchangeRate{From: "EUR", To: "USD", Rate: 1.25, UpdatedAt: updatedAt}, nil)
+			},
+		},
+		{
+			name: "returns an error naming the pair when neither rate exists",
+			wants: wants{
+				rate: domain.ExchangeRate{},
+				err:  fmt.Errorf("%s (%s to %s)", ErrExchangeRateNotFound, "USD", "EUR"),
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "EUR").Return(domain.ExchangeRate{}, errors.New(ErrExchangeRateNotFound))
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "EUR", "USD").Return(domain.ExchangeRate{}, errors.New(ErrExchangeRateNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewExchangeRatesDependencies(exchangeRatesRepo)
+			test.setMocks(d)
+
+			rate, err := exchangeRate(context.TODO(), exchangeRatesRepo, "USD", "EUR")
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.rate, rate)
+		})
+	}
+}
@@ -0,0 +1,473 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInspectionAlreadyRecorded      = "inspection was already recorded for the reservation"
+	ErrPickupInspectionMissing        = "pickup inspection must be recorded before the return one"
+	ErrReturnMileageBelowPickup       = "return mileage cannot be lower than pickup mileage"
+	ErrMileageBelowCarMileage         = "mileage cannot be lower than the one recorded for the car"
+	ErrReturnInspectionBeforePickup   = "return inspection cannot be done before the pickup one"
+	ErrInspectionCanceledReservation  = "inspections cannot be recorded for canceled reservations"
+	ErrInspectionCompletedReservation = "inspections cannot be recorded for completed reservations"
+	ErrPickupTooEarly                 = "pickup cannot be recorded more than EARLY_PICKUP_MINUTES before the reservation starts"
+	ErrInspectionTimeOutOfRange       = "inspection time cannot differ from the current time by more than INSPECTION_SKEW_MINUTES"
+)
+
+type Handovers struct {
+	inspectionsRepository   ports.InspectionsRepo
+	handoversRepository     ports.HandoversRepo
+	carMileagesRepository   ports.CarMileagesRepo
+	lateReturnsRepository   ports.LateReturnsRepo
+	reservationsRepository  ports.ReservationsRepo
+	carsRepository          ports.CarsRepo
+	depositsRepository      ports.DepositsRepo
+	exchangeRatesRepository ports.ExchangeRatesRepo
+	paymentGateway          ports.PaymentGateway
+	invoicesService         ports.InvoicesService
+}
+
+func NewHandovers(ir ports.InspectionsRepo, hr ports.HandoversRepo, cmr ports.CarMileagesRepo, lrr ports.LateReturnsRepo, rr ports.ReservationsRepo, carr ports.CarsRepo, dr ports.DepositsRepo, xrr ports.ExchangeRatesRepo, pg ports.PaymentGateway, is ports.InvoicesService) Handovers {
+	return Handovers{
+		inspectionsRepository:   ir,
+		handoversRepository:     hr,
+		carMileagesRepository:   cmr,
+		lateReturnsRepository:   lrr,
+		reservationsRepository:  rr,
+		carsRepository:          carr,
+		depositsRepository:      dr,
+		exchangeRatesRepository: xrr,
+		paymentGateway:          pg,
+		invoicesService:         is,
+	}
+}
+
+// Records the pickup or return inspection of a reservation and moves the
+// mileage of the car forward. Returned cars are moved to the return branch.
+// At pickup the security deposit of the car type is held on the payment
+// method, and it is released when the car is returned without new damages.
+// Cars returned past the grace period are charged the late fee of their type
+// on the payment method, or from the deposit when there are no new damages
+// and the charge fails. The return completes the reservation, which is then
+// invoiced. Settlements that fail after the handover was stored are reported
+// in its warnings, as they have to be finished by hand.
+func (hs Handovers) RecordInspection(ctx context.Context, inspection domain.Inspection, paymentMethod string) (domain.Handover, error) {
+	return hs.recordInspection(ctx, inspection, paymentMethod, time.Now())
+}
+
+// Records the inspection at the given current time, which the time of the
+// inspection defaults to and must be close to
+func (hs Handovers) recordInspection(ctx context.Context, inspection domain.Inspection, paymentMethod string, now time.Time) (domain.Handover, error) {
+	if inspection.InspectedAt.IsZero() {
+		inspection.InspectedAt = now
+	}
+
+	// late fees are charged from the time sent by the staff, so it can not be
+	// set far from the time the inspection is recorded
+	tolerance := time.Duration(constants.Values().INSPECTION_SKEW_MINUTES) * time.Minute
+	if inspection.InspectedAt.Before(now.Add(-tolerance)) || inspection.InspectedAt.After(now.Add(tolerance)) {
+		return domain.Handover{}, errors.New(ErrInspectionTimeOutOfRange)
+	}
+
+	reservation, err := hs.reservationsRepository.Get(ctx, inspection.ReservationID)
+	if err != nil {
+		return domain.Handover{}, err
+	}
+
+	switch reservation.Status {
+	case constants.Values().RESERVATION_STATUSES.CANCELED:
+		return domain.Handover{}, errors.New(ErrInspectionCanceledReservation)
+	case constants.Values().RESERVATION_STATUSES.COMPLETED:
+		return domain.Handover{}, errors.New(ErrInspectionCompletedReservation)
+	}
+
+	inspections, err := hs.inspectionsRepository.GetByReservationID(ctx, reservation.ID)
+	if err != nil {
+		return domain.Handover{}, err
+	}
+	handover := newHandover(reservation.ID, inspections)
+
+	if err := hs.checkInspection(ctx, inspection, handover, reservation); err != nil {
+		return domain.Handover{}, err
+	}
+
+	var warnings []string
+	var lateReturn *domain.LateReturn
+	var lateFeeReference string
+	if inspection.Type == constants.Values().INSPECTION_TYPES.RETURN {
+		if lateReturn, err = hs.lateReturn(ctx, reservation, inspection.InspectedAt); err != nil {
+			return domain.Handover{}, err
+		}
+
+		if lateReturn != nil && !lateReturn.Fee.IsZero() && paymentMethod != "" {
+			if lateFeeReference, err = hs.chargeLateFee(ctx, *lateReturn, paymentMethod); err != nil {
+				warnings = append(warnings, fmt.Sprintf("late fee could not be charged on the payment method: %s", err))
+			}
+		}
+	}
+
+	var deposit *domain.Deposit
+	if inspection.Type == constants.Values().INSPECTION_TYPES.PICKUP {
+		if deposit, err = hs.holdDeposit(ctx, reservation, paymentMethod); err != nil {
+			return domain.Handover{}, err
+		}
+	}
+
+	inspection.ID = uuid.New()
+	if err := hs.storeHandover(ctx, inspection, reservation, lateReturn, lateFeeReference != "", deposit); err != nil {
+		// the hold is released and the late fee given back so the renter is
+		// not charged for a handover that was not recorded
+		if deposit != nil {
+			hs.paymentGateway.Void(ctx, deposit.Reference)
+		}
+		if lateFeeReference != "" {
+			hs.paymentGateway.Refund(ctx, lateFeeReference, lateReturn.Fee)
+		}
+
+		return domain.Handover{}, err
+	}
+
+	handover = newHandover(reservation.ID, append(inspections, inspection))
+	if handover.CarMileage, err = hs.GetCarMileage(ctx, reservation.CarID); err != nil {
+		return domain.Handover{}, err
+	}
+
+	if inspection.Type == constants.Values().INSPECTION_TYPES.RETURN {
+		if deposit, err = hs.deposit(ctx, reservation.ID); err != nil {
+			return domain.Handover{}, err
+		}
+
+		var uncollected domain.Money
+		if lateReturn != nil && lateFeeReference == "" {
+			uncollected = lateReturn.Fee
+		}
+
+		// a failed capture or release leaves the deposit held, so it can still
+		// be settled by hand, as it is when there are new damages to cover
+		if deposit != nil && deposit.Status == constants.Values().DEPOSIT_STATUSES.HELD && len(newDamages(*handover.Pickup, inspection)) == 0 {
+			if !uncollected.IsZero() {
+				amount := uncollected.Min(deposit.Amount)
+				if captured, err := captureDeposit(ctx, hs.depositsRepository, hs.paymentGateway, *deposit, amount, lateFeeCaptureReason); err != nil {
+					warnings = append(warnings, fmt.Sprintf("deposit could not be captured: %s", err))
+				} else {
+					deposit = &captured
+					uncollected = uncollected.Sub(amount)
+				}
+			} else if released, err := releaseDeposit(ctx, hs.depositsRepository, hs.paymentGateway, *deposit); err != nil {
+				warnings = append(warnings, fmt.Sprintf("deposit could not be released: %s", err))
+			} else {
+				deposit = &released
+			}
+		}
+		if !uncollected.IsZero() {
+			warnings = append(warnings, fmt.Sprintf("late fee of %s was not collected", uncollected))
+		}
+
+		if _, err := hs.invoicesService.Issue(ctx, reservation.ID); err != nil {
+			warnings = append(warnings, fmt.Sprintf("invoice could not be issued: %s", err))
+		}
+	}
+	handover.Deposit = deposit
+	handover.LateReturn = lateReturn
+	handover.Warnings = warnings
+
+	return handover, nil
+}
+
+func (hs Handovers) Get(ctx context.Context, reservationID uuid.UUID) (domain.Handover, error) {
+	reservation, err := hs.reservationsRepository.Get(ctx, reservationID)
+	if err != nil {
+		return domain.Handover{}, err
+	}
+
+	inspections, err := hs.inspectionsRepository.GetByReservationID(ctx, reservation.ID)
+	if err != nil {
+		return domain.Handover{}, err
+	}
+
+	handover := newHandover(reservation.ID, inspections)
+	if handover.CarMileage, err = hs.GetCarMileage(ctx, reservation.CarID); err != nil {
+		return domain.Handover{}, err
+	}
+
+	if handover.Deposit, err = hs.deposit(ctx, reservation.ID); err != nil {
+		return domain.Handover{}, err
+	}
+
+	lateReturn, err := hs.lateReturnsRepository.GetByReservationID(ctx, reservation.ID)
+	if err != nil && err.Error() != ErrLateReturnNotFound {
+		return domain.Handover{}, err
+	}
+	if err == nil {
+		handover.LateReturn = &lateReturn
+	}
+
+	return handover, nil
+}
+
+// Gets the cumulative mileage of a car, flagging whether it is due for maintenance
+func (hs Handovers) GetCarMileage(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error) {
+	carMileage, err := hs.carMileagesRepository.Get(ctx, carID)
+	if err != nil {
+		return domain.CarMileage{}, err
+	}
+
+	maintenanceInterval := int64(constants.Values().MAINTENANCE_INTERVAL_KM)
+	carMileage.MaintenanceDue = int64(carMileage.Mileage)-int64(carMileage.LastServiceMileage) >= maintenanceInterval
+
+	return carMileage, nil
+}
+
+// Records that the car was serviced at its current mileage, clearing the maintenance due flag
+func (hs Handovers) RegisterCarService(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error) {
+	if err := hs.carMileagesRepository.RegisterService(ctx, carID); err != nil {
+		return domain.CarMileage{}, err
+	}
+
+	return hs.GetCarMileage(ctx, carID)
+}
+
+// Stores the inspection along with the changes it brings to the car, the
+// reservation and its money in a single unit of work
+func (hs Handovers) storeHandover(ctx context.Context, inspection domain.Inspection, reservation domain.Reservation, lateReturn *domain.LateReturn, lateFeeCharged bool, deposit *domain.Deposit) error {
+	tx, err := hs.handoversRepository.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.InsertInspection(ctx, inspection); err != nil {
+		return err
+	}
+	if err := tx.UpdateCarMileage(ctx, reservation.CarID, inspection.Mileage); err != nil {
+		return err
+	}
+
+	if inspection.Type == constants.Values().INSPECTION_TYPES.RETURN {
+		// the car stays where it is returned, which may be another city for one-way rentals
+		if reservation.ReturnBranchID != nil {
+			if err := tx.RelocateCar(ctx, reservation.CarID, *reservation.ReturnBranchID); err != nil {
+				return err
+			}
+		}
+		if err := tx.UpdateReservationStatus(ctx, reservation.ID, constants.Values().RESERVATION_STATUSES.COMPLETED); err != nil {
+			return err
+		}
+		if lateReturn != nil {
+			if err := tx.InsertLateReturn(ctx, *lateReturn); err != nil {
+				return err
+			}
+			if lateFeeCharged {
+				if err := tx.InsertLedgerEntries(ctx, lateFeeEntries(*lateReturn)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if deposit != nil {
+		if err := tx.InsertDeposit(ctx, *deposit); err != nil {
+			return err
+		}
+		if err := tx.InsertLedgerEntries(ctx, depositHoldEntries(*deposit)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (hs Handovers) checkInspection(ctx context.Context, inspection domain.Inspection, handover domain.Handover, reservation domain.Reservation) error {
+	inspectionTypes := constants.Values().INSPECTION_TYPES
+
+	switch inspection.Type {
+	case inspectionTypes.PICKUP:
+		if handover.Pickup != nil {
+			return errors.New(ErrInspectionAlreadyRecorded)
+		}
+
+		earliestPickup := reservation.StartDate.Add(-time.Duration(constants.Values().EARLY_PICKUP_MINUTES) * time.Minute)
+		if inspection.InspectedAt.Before(earliestPickup) {
+			return errors.New(ErrPickupTooEarly)
+		}
+
+		carMileage, err := hs.carMileagesRepository.Get(ctx, reservation.CarID)
+		if err != nil {
+			return err
+		}
+		if inspection.Mileage < carMileage.Mileage {
+			return errors.New(ErrMileageBelowCarMileage)
+		}
+	case inspectionTypes.RETURN:
+		if handover.Return != nil {
+			return errors.New(ErrInspectionAlreadyRecorded)
+		}
+		if handover.Pickup == nil {
+			return errors.New(ErrPickupInspectionMissing)
+		}
+		if inspection.Mileage < handover.Pickup.Mileage {
+			return errors.New(ErrReturnMileageBelowPickup)
+		}
+		if inspection.InspectedAt.Before(handover.Pickup.InspectedAt) {
+			return errors.New(ErrReturnInspectionBeforePickup)
+		}
+	}
+
+	return nil
+}
+
+// Holds the security deposit of the car type on the payment method. Returns
+// nil when the car type has no deposit. Holds the gateway does not approve
+// right away are declined, as the car can not be handed over without them.
+func (hs Handovers) holdDeposit(ctx context.Context, reservation domain.Reservation, paymentMethod string) (*domain.Deposit, error) {
+	values := constants.Values()
+
+	car, err := hs.carsRepository.Get(ctx, reservation.CarID)
+	if err != nil {
+		return nil, err
+	}
+	if values.DepositFor(car.Type) == 0 {
+		return nil, nil
+	}
+	if paymentMethod == "" {
+		return nil, errors.New(ErrDepositPaymentMethodRequired)
+	}
+
+	amount, err := feeIn(ctx, hs.exchangeRatesRepository, values.DepositFor(car.Type), reservation.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := hs.paymentGateway.Authorize(ctx, amount, paymentMethod)
+	if err != nil {
+		return nil, err
+	}
+	if authorization.Status != values.PAYMENT_STATUSES.AUTHORIZED {
+		if authorization.Status == values.PAYMENT_STATUSES.PENDING {
+			hs.paymentGateway.Void(ctx, authorization.Reference)
+		}
+
+		return nil, errors.New(ErrDepositDeclined)
+	}
+
+	return &domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: reservation.ID,
+		Reference:     authorization.Reference,
+		Amount:        amount,
+		Status:        values.DEPOSIT_STATUSES.HELD,
+		HeldAt:        time.Now().UTC(),
+	}, nil
+}
+
+// Charges the late fee on the payment method and returns the reference of the
+// charge. Charges the gateway does not approve right away are declined, so
+// the fee can be taken from the deposit instead.
+func (hs Handovers) chargeLateFee(ctx context.Context, lateReturn domain.LateReturn, paymentMethod string) (string, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	authorization, err := hs.paymentGateway.Authorize(ctx, lateReturn.Fee, paymentMethod)
+	if err != nil {
+		return "", err
+	}
+	if authorization.Status != statuses.AUTHORIZED {
+		if authorization.Status == statuses.PENDING {
+			hs.paymentGateway.Void(ctx, authorization.Reference)
+		}
+
+		return "", errors.New(ErrLateFeeDeclined)
+	}
+
+	if err := hs.paymentGateway.Capture(ctx, authorization.Reference, lateReturn.Fee); err != nil {
+		hs.paymentGateway.Void(ctx, authorization.Reference)
+
+		return "", err
+	}
+
+	return authorization.Reference, nil
+}
+
+// Gets the late return of a car returned at returnedAt, nil when it was
+// returned within the grace period
+func (hs Handovers) lateReturn(ctx context.Context, reservation domain.Reservation, returnedAt time.Time) (*domain.LateReturn, error) {
+	if !isReturnedLate(reservation, returnedAt) {
+		return nil, nil
+	}
+
+	car, err := hs.carsRepository.Get(ctx, reservation.CarID)
+	if err != nil {
+		return nil, err
+	}
+	hourlyFee, err := feeIn(ctx, hs.exchangeRatesRepository, constants.Values().LateFeeFor(car.Type), reservation.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	lateReturn := newLateReturn(reservation, returnedAt, hourlyFee)
+
+	return &lateReturn, nil
+}
+
+// Gets the deposit of the reservation, nil when none was held
+func (hs Handovers) deposit(ctx context.Context, reservationID uuid.UUID) (*domain.Deposit, error) {
+	deposit, err := hs.depositsRepository.GetByReservationID(ctx, reservationID)
+	if err != nil {
+		if err.Error() == ErrDepositNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &deposit, nil
+}
+
+// Gets the damages observed at return that were not there at pickup
+func newDamages(pickup domain.Inspection, returned domain.Inspection) []string {
+	var damages []string
+	for _, damage := range returned.Damages {
+		found := false
+		for _, previous := range pickup.Damages {
+			if strings.EqualFold(strings.TrimSpace(damage), strings.TrimSpace(previous)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			damages = append(damages, damage)
+		}
+	}
+
+	return damages
+}
+
+// Builds the handover of a reservation from its inspections
+func newHandover(reservationID uuid.UUID, inspections []domain.Inspection) domain.Handover {
+	inspectionTypes := constants.Values().INSPECTION_TYPES
+	handover := domain.Handover{ReservationID: reservationID}
+
+	for i := range inspections {
+		switch inspections[i].Type {
+		case inspectionTypes.PICKUP:
+			handover.Pickup = &inspections[i]
+		case inspectionTypes.RETURN:
+			handover.Return = &inspections[i]
+		}
+	}
+
+	if handover.Pickup != nil && handover.Return != nil {
+		handover.DistanceDriven = handover.Return.Mileage - handover.Pickup.Mileage
+	}
+
+	return handover
+}
@@ -0,0 +1,837 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type handoversDependencies struct {
+	inspectionsRepository   *mocks.MockInspectionsRepo
+	handoversRepository     *mocks.MockHandoversRepo
+	handoverTx              *mocks.MockHandoverTx
+	carMileagesRepository   *mocks.MockCarMileagesRepo
+	lateReturnsRepository   *mocks.MockLateReturnsRepo
+	reservationsRepository  *mocks.MockReservationsRepo
+	carsRepository          *mocks.MockCarsRepo
+	depositsRepository      *mocks.MockDepositsRepo
+	exchangeRatesRepository *mocks.MockExchangeRatesRepo
+	paymentGateway          *mocks.MockPaymentGateway
+	invoicesService         *mocks.MockInvoicesService
+}
+
+func NewHandoversDependencies(inspectionsRepo *mocks.MockInspectionsRepo, handoversRepo *mocks.MockHandoversRepo, handoverTx *mocks.MockHandoverTx, carMileagesRepo *mocks.MockCarMileagesRepo, lateReturnsRepo *mocks.MockLateReturnsRepo, reservationsRepo *mocks.MockReservationsRepo, carsRepo *mocks.MockCarsRepo, depositsRepo *mocks.MockDepositsRepo, exchangeRatesRepo *mocks.MockExchangeRatesRepo, paymentGateway *mocks.MockPaymentGateway, invoicesSrv *mocks.MockInvoicesService) *handoversDependencies {
+	return &handoversDependencies{
+		inspectionsRepository:   inspectionsRepo,
+		handoversRepository:     handoversRepo,
+		handoverTx:              handoverTx,
+		carMileagesRepository:   carMileagesRepo,
+		lateReturnsRepository:   lateReturnsRepo,
+		reservationsRepository:  reservationsRepo,
+		carsRepository:          carsRepo,
+		depositsRepository:      depositsRepo,
+		exchangeRatesRepository: exchangeRatesRepo,
+		paymentGateway:          paymentGateway,
+		invoicesService:         invoicesSrv,
+	}
+}
+
+func TestHandoversRecordInspection(t *testing.T) {
+	initConstantsFromServices(t)
+
+	now := time.Now()
+	reservation := domain.Reservation{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		CarID:         uuid.New(),
+		Status:        "Reserved",
+		PaymentStatus: "Paid",
+		StartDate:     now,
+		EndDate:       now.Add(48 * time.Hour),
+		Currency:      "USD",
+	}
+	pickup := domain.Inspection{
+		ID:            uuid.New(),
+		ReservationID: reservation.ID,
+		Type:          "Pickup",
+		Mileage:       9800,
+		FuelLevel:     100,
+		Damages:       []string{},
+		InspectedAt:   now,
+	}
+	returnInspection := domain.Inspection{
+		ReservationID: reservation.ID,
+		Type:          "Return",
+		Mileage:       10100,
+		FuelLevel:     50,
+		Damages:       []string{"Scratch on rear bumper"},
+		InspectedAt:   now.Add(47 * time.Hour),
+	}
+	euroReservation := reservation
+	euroReservation.Currency = "EUR"
+	canceledReservation := reservation
+	canceledReservation.Status = "Canceled"
+	completedReservation := reservation
+	completedReservation.Status = "Completed"
+	earlyPickup := pickup
+	earlyPickup.InspectedAt = reservation.StartDate.Add(-2 * time.Hour)
+	returnBranchID := uuid.New()
+	oneWayReservation := reservation
+	oneWayReservation.ReturnBranchID = &returnBranchID
+	cleanReturn := returnInspection
+	cleanReturn.Damages = []string{}
+	lateReturn := cleanReturn
+	lateReturn.InspectedAt = reservation.EndDate.Add(2*time.Hour + 10*time.Minute)
+	graceReturn := cleanReturn
+	graceReturn.InspectedAt = reservation.EndDate.Add(20 * time.Minute)
+	veryLateReturn := cleanReturn
+	veryLateReturn.InspectedAt = reservation.EndDate.Add(30 * time.Hour)
+	heldDeposit := domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: reservation.ID,
+		Reference:     "pay_123",
+		Amount:        usd(1500),
+		Status:        "Held",
+		HeldAt:        now,
+	}
+
+	type args struct {
+		ctx           context.Context
+		inspection    domain.Inspection
+		paymentMethod string
+		// time the inspection is recorded at, its own time when not set
+		recordedAt time.Time
+	}
+	type wants struct {
+		distanceDriven int32
+		maintenanceDue bool
+		depositStatus  string
+		capturedAmount domain.Money
+		lateFee        domain.Money
+		warnings       []string
+		err            error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*handoversDependencies)
+	}{
+		{
+			name: "returns the distance driven and maintenance due flag when return inspection is recorded",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: returnInspection,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				err:            nil,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Deposit{}, errors.New(ErrDepositNotFound))
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "moves the car to the return branch when return inspection is recorded",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: returnInspection,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				err:            nil,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(oneWayReservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().RelocateCar(gomock.Any(), reservation.CarID, returnBranchID).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Deposit{}, errors.New(ErrDepositNotFound))
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "holds the security deposit when pickup of a car type with deposit is recorded",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    pickup,
+				paymentMethod: "pm_card_visa",
+			},
+			wants: wants{
+				depositStatus: "Held",
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil).Times(2)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Sports Car"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(1500), "pm_card_visa").Return(domain.PaymentAuthorization{Reference: "pay_123", Status: "Authorized"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(9800)).Return(nil)
+				d.handoverTx.EXPECT().InsertDeposit(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().InsertLedgerEntries(gomock.Any(), gomock.Len(2)).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+			},
+		},
+		{
+			name: "holds the security deposit converted to the currency of the reservation",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    pickup,
+				paymentMethod: "pm_card_visa",
+			},
+			wants: wants{
+				depositStatus: "Held",
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(euroReservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil).Times(2)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Sports Car"}, nil)
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "EUR").Return(domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.92}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), domain.Money{Amount: 138000, Currency: "EUR"}, "pm_card_visa").Return(domain.PaymentAuthorization{Reference: "pay_123", Status: "Authorized"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(9800)).Return(nil)
+				d.handoverTx.EXPECT().InsertDeposit(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().InsertLedgerEntries(gomock.Any(), gomock.Len(2)).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+			},
+		},
+		{
+			name: "voids the security deposit hold when the handover cannot be stored",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    pickup,
+				paymentMethod: "pm_card_visa",
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionAlreadyRecorded),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Sports Car"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(1500), "pm_card_visa").Return(domain.PaymentAuthorization{Reference: "pay_123", Status: "Authorized"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(errors.New(ErrInspectionAlreadyRecorded))
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), "pay_123").Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the security deposit hold is declined",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    pickup,
+				paymentMethod: "pm_card_declined",
+			},
+			wants: wants{
+				err: errors.New(ErrDepositDeclined),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(1000), "pm_card_declined").Return(domain.PaymentAuthorization{Reference: "pay_123", Status: "Failed"}, nil)
+			},
+		},
+		{
+			name: "returns an error when the car type requires a deposit and no payment method is given",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{
+				err: errors.New(ErrDepositPaymentMethodRequired),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Sports Car"}, nil)
+			},
+		},
+		{
+			name: "records the pickup without deposit when the car type does not require one",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 9800}, nil).Times(2)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Sedan"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(9800)).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+			},
+		},
+		{
+			name: "releases the security deposit when the car is returned without new damages",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: cleanReturn,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Released",
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), heldDeposit.Reference).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Releasing", gomock.Len(2)).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "captures the late fee from the security deposit when the car is returned late",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: lateReturn,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Captured",
+				capturedAmount: usd(120),
+				lateFee:        usd(120),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().InsertLateReturn(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), heldDeposit.Reference, usd(120)).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", gomock.Any()).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "charges the late fee on the payment method and releases the security deposit",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    lateReturn,
+				paymentMethod: "pm_card_visa",
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Released",
+				lateFee:        usd(120),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(120), "pm_card_visa").Return(domain.PaymentAuthorization{Reference: "pay_late", Status: "Authorized"}, nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), "pay_late", usd(120)).Return(nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().InsertLateReturn(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().InsertLedgerEntries(gomock.Any(), gomock.Len(2)).DoAndReturn(func(_ context.Context, entries []domain.LedgerEntry) error {
+					assert.Equal(t, "Late Fee", entries[0].Movement)
+					assert.Equal(t, "Customer", entries[0].Account)
+					assert.Equal(t, usd(120), entries[0].Amount)
+					assert.Equal(t, "Revenue", entries[1].Account)
+
+					return nil
+				})
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), heldDeposit.Reference).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Releasing", gomock.Len(2)).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "captures the late fee from the security deposit when the payment method declines it",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    lateReturn,
+				paymentMethod: "pm_card_declined",
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Captured",
+				capturedAmount: usd(120),
+				lateFee:        usd(120),
+				warnings:       []string{"late fee could not be charged on the payment method: " + ErrLateFeeDeclined},
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(120), "pm_card_declined").Return(domain.PaymentAuthorization{Reference: "pay_late", Status: "Failed"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().InsertLateReturn(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), heldDeposit.Reference, usd(120)).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", gomock.Any()).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "refunds the late fee when the return could not be stored",
+			args: args{
+				ctx:           context.TODO(),
+				inspection:    lateReturn,
+				paymentMethod: "pm_card_visa",
+			},
+			wants: wants{
+				err: errors.New("connection reset"),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(120), "pm_card_visa").Return(domain.PaymentAuthorization{Reference: "pay_late", Status: "Authorized"}, nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), "pay_late", usd(120)).Return(nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(errors.New("connection reset"))
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.paymentGateway.EXPECT().Refund(gomock.Any(), "pay_late", usd(120)).Return(nil)
+			},
+		},
+		{
+			name: "reports the late fee and the deposit that could not be settled when the car was returned",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: lateReturn,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Held",
+				lateFee:        usd(120),
+				warnings: []string{
+					"deposit could not be captured: gateway timeout",
+					"late fee of 120.00 USD was not collected",
+				},
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Luxury"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().InsertLateReturn(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), heldDeposit.Reference, usd(120)).Return(errors.New("gateway timeout"))
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", nil).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "captures at most the held amount when the late fee exceeds the security deposit",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: veryLateReturn,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Captured",
+				capturedAmount: usd(1500),
+				lateFee:        usd(2400),
+				warnings:       []string{"late fee of 900.00 USD was not collected"},
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.Car{ID: reservation.CarID, Type: "Limousine"}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().InsertLateReturn(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), heldDeposit.Reference, usd(1500)).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Capturing", gomock.Any()).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "releases the security deposit when the car is returned within the grace period",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: graceReturn,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Released",
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Held", nil).Return(nil)
+				d.paymentGateway.EXPECT().Void(gomock.Any(), heldDeposit.Reference).Return(nil)
+				d.depositsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), "Releasing", gomock.Len(2)).Return(nil)
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{ReservationID: reservation.ID}, nil)
+			},
+		},
+		{
+			name: "keeps the security deposit held when the car is returned with new damages",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: returnInspection,
+			},
+			wants: wants{
+				distanceDriven: 300,
+				maintenanceDue: true,
+				depositStatus:  "Held",
+				warnings:       []string{"invoice could not be issued: storage unavailable"},
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+				d.handoversRepository.EXPECT().Begin(gomock.Any()).Return(d.handoverTx, nil)
+				d.handoverTx.EXPECT().InsertInspection(gomock.Any(), gomock.Any()).Return(nil)
+				d.handoverTx.EXPECT().UpdateCarMileage(gomock.Any(), reservation.CarID, int32(10100)).Return(nil)
+				d.handoverTx.EXPECT().UpdateReservationStatus(gomock.Any(), reservation.ID, "Completed").Return(nil)
+				d.handoverTx.EXPECT().Commit().Return(nil)
+				d.handoverTx.EXPECT().Rollback().Return(nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 10100, LastServiceMileage: 0}, nil)
+				d.depositsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(heldDeposit, nil)
+				// the return is recorded even when the invoice could not be issued
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservation.ID).Return(domain.Invoice{}, errors.New("storage unavailable"))
+			},
+		},
+		{
+			name: "returns an error when return mileage is lower than pickup mileage",
+			args: args{
+				ctx: context.TODO(),
+				inspection: domain.Inspection{
+					ReservationID: reservation.ID,
+					Type:          "Return",
+					Mileage:       9700,
+					FuelLevel:     50,
+					InspectedAt:   now.Add(47 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrReturnMileageBelowPickup),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+			},
+		},
+		{
+			name: "returns an error when return is recorded without pickup",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: returnInspection,
+			},
+			wants: wants{
+				err: errors.New(ErrPickupInspectionMissing),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns an error when pickup was already recorded",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionAlreadyRecorded),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return([]domain.Inspection{pickup}, nil)
+			},
+		},
+		{
+			name: "returns an error when pickup mileage is lower than the car mileage",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{
+				err: errors.New(ErrMileageBelowCarMileage),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(domain.CarMileage{CarID: reservation.CarID, Mileage: 12000}, nil)
+			},
+		},
+		{
+			name: "returns an error when reservation was canceled",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionCanceledReservation),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(canceledReservation, nil)
+			},
+		},
+		{
+			name: "returns an error when reservation was completed",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: returnInspection,
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionCompletedReservation),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(completedReservation, nil)
+			},
+		},
+		{
+			name: "returns an error when the return is dated before the time it is recorded to avoid the late fee",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: graceReturn,
+				recordedAt: lateReturn.InspectedAt,
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionTimeOutOfRange),
+			},
+			setMocks: func(d *handoversDependencies) {},
+		},
+		{
+			name: "returns an error when the inspection is dated in the future",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+				recordedAt: pickup.InspectedAt.Add(-time.Hour),
+			},
+			wants: wants{
+				err: errors.New(ErrInspectionTimeOutOfRange),
+			},
+			setMocks: func(d *handoversDependencies) {},
+		},
+		{
+			name: "returns an error when pickup is recorded long before the reservation starts",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: earlyPickup,
+			},
+			wants: wants{
+				err: errors.New(ErrPickupTooEarly),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.inspectionsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns an error when reservation was not found",
+			args: args{
+				ctx:        context.TODO(),
+				inspection: pickup,
+			},
+			wants: wants{
+				err: errors.New(ErrReservationNotFound),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			inspectionsRepo := mocks.NewMockInspectionsRepo(mockCtlr)
+			handoversRepo := mocks.NewMockHandoversRepo(mockCtlr)
+			handoverTx := mocks.NewMockHandoverTx(mockCtlr)
+			carMileagesRepo := mocks.NewMockCarMileagesRepo(mockCtlr)
+			lateReturnsRepo := mocks.NewMockLateReturnsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			depositsRepo := mocks.NewMockDepositsRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			paymentGateway := mocks.NewMockPaymentGateway(mockCtlr)
+			invoicesSrv := mocks.NewMockInvoicesService(mockCtlr)
+			d := NewHandoversDependencies(inspectionsRepo, handoversRepo, handoverTx, carMileagesRepo, lateReturnsRepo, reservationsRepo, carsRepo, depositsRepo, exchangeRatesRepo, paymentGateway, invoicesSrv)
+			test.setMocks(d)
+
+			handoversService := NewHandovers(inspectionsRepo, handoversRepo, carMileagesRepo, lateReturnsRepo, reservationsRepo, carsRepo, depositsRepo, exchangeRatesRepo, paymentGateway, invoicesSrv)
+			recordedAt := test.args.recordedAt
+			if recordedAt.IsZero() {
+				recordedAt = test.args.inspection.InspectedAt
+			}
+			handover, err := handoversService.recordInspection(test.args.ctx, test.args.inspection, test.args.paymentMethod, recordedAt)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.distanceDriven, handover.DistanceDriven)
+			assert.Equal(t, test.wants.maintenanceDue, handover.CarMileage.MaintenanceDue)
+			if test.wants.depositStatus == "" {
+				assert.Nil(t, handover.Deposit)
+			} else if assert.NotNil(t, handover.Deposit) {
+				assert.Equal(t, test.wants.depositStatus, handover.Deposit.Status)
+				assert.Equal(t, test.wants.capturedAmount, handover.Deposit.CapturedAmount)
+			}
+			if test.wants.lateFee.IsZero() {
+				assert.Nil(t, handover.LateReturn)
+			} else if assert.NotNil(t, handover.LateReturn) {
+				assert.Equal(t, test.wants.lateFee, handover.LateReturn.Fee)
+			}
+			assert.Equal(t, test.wants.warnings, handover.Warnings)
+		})
+	}
+}
+
+func TestHandoversGetCarMileage(t *testing.T) {
+	initConstantsFromServices(t)
+	carID := uuid.New()
+
+	type wants struct {
+		maintenanceDue bool
+		err            error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*handoversDependencies)
+	}{
+		{
+			name: "returns maintenance not due when interval was not reached",
+			wants: wants{
+				maintenanceDue: false,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), carID).Return(domain.CarMileage{CarID: carID, Mileage: 19999, LastServiceMileage: 10000}, nil)
+			},
+		},
+		{
+			name: "returns maintenance due when interval was reached",
+			wants: wants{
+				maintenanceDue: true,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), carID).Return(domain.CarMileage{CarID: carID, Mileage: 20000, LastServiceMileage: 10000}, nil)
+			},
+		},
+		{
+			name: "returns an error when car was not found",
+			wants: wants{
+				err: errors.New(ErrCarNotFound),
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.carMileagesRepository.EXPECT().Get(gomock.Any(), carID).Return(domain.CarMileage{}, errors.New(ErrCarNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			inspectionsRepo := mocks.NewMockInspectionsRepo(mockCtlr)
+			handoversRepo := mocks.NewMockHandoversRepo(mockCtlr)
+			handoverTx := mocks.NewMockHandoverTx(mockCtlr)
+			carMileagesRepo := mocks.NewMockCarMileagesRepo(mockCtlr)
+			lateReturnsRepo := mocks.NewMockLateReturnsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			depositsRepo := mocks.NewMockDepositsRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			paymentGateway := mocks.NewMockPaymentGateway(mockCtlr)
+			invoicesSrv := mocks.NewMockInvoicesService(mockCtlr)
+			d := NewHandoversDependencies(inspectionsRepo, handoversRepo, handoverTx, carMileagesRepo, lateReturnsRepo, reservationsRepo, carsRepo, depositsRepo, exchangeRatesRepo, paymentGateway, invoicesSrv)
+			test.setMocks(d)
+
+			handoversService := NewHandovers(inspectionsRepo, handoversRepo, carMileagesRepo, lateReturnsRepo, reservationsRepo, carsRepo, depositsRepo, exchangeRatesRepo, paymentGateway, invoicesSrv)
+			carMileage, err := handoversService.GetCarMileage(context.TODO(), carID)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.maintenanceDue, carMileage.MaintenanceDue)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/pdf"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvoiceNotFound          = "invoice was not found"
+	ErrInvoiceAlreadyIssued     = "invoice was already issued for the reservation"
+	ErrReservationNotCompleted  = "invoices can only be issued for completed reservations"
+	ErrReservationInvoiced      = "reservations with an invoice cannot be deleted"
+	ErrUnsupportedInvoiceFormat = "invoice format is not supported"
+	ErrBillingProfileNotFound   = "billing profile was not found"
+)
+
+// Formats invoices can be downloaded in, along with their content types
+var invoiceContentTypes = map[string]string{
+	"html": "text/html; charset=utf-8",
+	"pdf":  "application/pdf",
+}
+
+type Invoices struct {
+	invoicesRepository        ports.InvoicesRepo
+	billingProfilesRepository ports.BillingProfilesRepo
+	reservationsRepository    ports.ReservationsRepo
+	lateReturnsRepository     ports.LateReturnsRepo
+	usersRepository           ports.UsersRepo
+	storage                   ports.Storage
+}
+
+func NewInvoices(ir ports.InvoicesRepo, bpr ports.BillingProfilesRepo, rr ports.ReservationsRepo, lrr ports.LateReturnsRepo, ur ports.UsersRepo, s ports.Storage) Invoices {
+	return Invoices{
+		invoicesRepository:        ir,
+		billingProfilesRepository: bpr,
+		reservationsRepository:    rr,
+		lateReturnsRepository:     lrr,
+		usersRepository:           ur,
+		storage:                   s,
+	}
+}
+
+// Issues the invoice of a completed reservation from the prices it was booked
+// with, the late fee when it was returned late and the billing details of the
+// renter, and stores its documents.
+// A reservation has a single invoice, so issuing it again returns the one
+// issued first.
+func (is Invoices) Issue(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error) {
+	reservation, err := is.reservationsRepository.Get(ctx, reservationID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	if reservation.Status != constants.Values().RESERVATION_STATUSES.COMPLETED {
+		return domain.Invoice{}, errors.New(ErrReservationNotCompleted)
+	}
+
+	invoice, err := is.invoicesRepository.GetByReservationID(ctx, reservationID)
+	if err == nil || err.Error() != ErrInvoiceNotFound {
+		return invoice, err
+	}
+
+	billing, err := is.billingInfo(ctx, reservation.UserID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	var lateReturn *domain.LateReturn
+	if found, err := is.lateReturnsRepository.GetByReservationID(ctx, reservationID); err == nil {
+		lateReturn = &found
+	} else if err.Error() != ErrLateReturnNotFound {
+		return domain.Invoice{}, err
+	}
+
+	invoice, err = is.invoicesRepository.Insert(ctx, newInvoice(reservation, lateReturn, billing))
+	if err != nil {
+		// another request issued it in the meantime
+		if err.Error() == ErrInvoiceAlreadyIssued {
+			return is.invoicesRepository.GetByReservationID(ctx, reservationID)
+		}
+
+		return domain.Invoice{}, err
+	}
+
+	// documents that could not be stored are rendered again when downloaded
+	for format := range invoiceContentTypes {
+		if document, err := renderInvoice(invoice, format); err == nil {
+			is.storage.Save(ctx, invoiceKey(invoice, format), bytes.NewReader(document))
+		}
+	}
+
+	return invoice, nil
+}
+
+func (is Invoices) Get(ctx context.Context, id uuid.UUID) (domain.Invoice, error) {
+	return is.invoicesRepository.Get(ctx, id)
+}
+
+func (is Invoices) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error) {
+	return is.invoicesRepository.GetByReservationID(ctx, reservationID)
+}
+
+// Opens the document of an invoice in the given format, returning its content
+// type along with it. Documents missing from the storage are rendered again,
+// which gives the same content as invoices are never changed.
+// The caller must close the returned content.
+func (is Invoices) Open(ctx context.Context, id uuid.UUID, format string) (io.ReadCloser, string, error) {
+	contentType, ok := invoiceContentTypes[format]
+	if !ok {
+		return nil, "", errors.New(ErrUnsupportedInvoiceFormat)
+	}
+
+	invoice, err := is.invoicesRepository.Get(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := invoiceKey(invoice, format)
+	if content, err := is.storage.Open(ctx, key); err == nil {
+		return content, contentType, nil
+	}
+
+	document, err := renderInvoice(invoice, format)
+	if err != nil {
+		return nil, "", err
+	}
+	is.storage.Save(ctx, key, bytes.NewReader(document))
+
+	return io.NopCloser(bytes.NewReader(document)), contentType, nil
+}
+
+func (is Invoices) GetBillingProfile(ctx context.Context, userID uuid.UUID) (domain.BillingProfile, error) {
+	return is.billingProfilesRepository.Get(ctx, userID)
+}
+
+// Creates or replaces the billing profile of a user. Invoices already issued
+// keep the details they were issued with.
+func (is Invoices) SaveBillingProfile(ctx context.Context, profile domain.BillingProfile) (domain.BillingProfile, error) {
+	if _, err := is.usersRepository.Get(ctx, profile.UserID); err != nil {
+		return domain.BillingProfile{}, err
+	}
+
+	if err := is.billingProfilesRepository.Upsert(ctx, profile); err != nil {
+		return domain.BillingProfile{}, err
+	}
+
+	return profile, nil
+}
+
+// Gets who the invoices of a user are addressed to, with the company details
+// of their billing profile when they have one
+func (is Invoices) billingInfo(ctx context.Context, userID uuid.UUID) (domain.BillingInfo, error) {
+	user, err := is.usersRepository.Get(ctx, userID)
+	if err != nil {
+		return domain.BillingInfo{}, err
+	}
+
+	billing := domain.BillingInfo{
+		Name:  strings.TrimSpace(user.FirstName + " " + user.LastName),
+		Email: user.Email,
+	}
+
+	profile, err := is.billingProfilesRepository.Get(ctx, userID)
+	if err != nil {
+		if err.Error() == ErrBillingProfileNotFound {
+			return billing, nil
+		}
+		return domain.BillingInfo{}, err
+	}
+
+	billing.CompanyName = profile.CompanyName
+	billing.TaxID = profile.TaxID
+	billing.Address = profile.Address
+	billing.City = profile.City
+	billing.PostalCode = profile.PostalCode
+	billing.Country = profile.Country
+
+	return billing, nil
+}
+
+// Builds the invoice of the reservation with a line for every part of its
+// price, and one for the late fee when the car was returned late. The rental
+// is always listed, the other parts only when charged. Taxes are listed
+// separately as they were computed when booking, so the late fee is not taxed.
+// Amounts are in the currency the reservation was booked in.
+func newInvoice(reservation domain.Reservation, lateReturn *domain.LateReturn, billing domain.BillingInfo) domain.Invoice {
+	quote := reservation.Quote()
+	lines := []domain.InvoiceLine{{Description: "Car rental", Amount: quote.RentalCost}}
+	for _, line := range []domain.InvoiceLine{
+		{Description: "One-way fee", Amount: quote.OneWayFee},
+		{Description: fmt.Sprintf("Additional drivers (%d)", len(reservation.AdditionalDriverIDs)), Amount: quote.AdditionalDriversFee},
+		{Description: "Add-ons", Amount: quote.AddOnsCost},
+		{Description: strings.TrimSpace(reservation.Protection.Level + " protection"), Amount: quote.ProtectionCost},
+	} {
+		if !line.Amount.IsZero() {
+			lines = append(lines, line)
+		}
+	}
+	if lateReturn != nil && !lateReturn.Fee.IsZero() {
+		lines = append(lines, domain.InvoiceLine{Description: fmt.Sprintf("Late return (%d hours)", lateReturn.HoursCharged), Amount: lateReturn.Fee})
+	}
+
+	subtotal := domain.Money{Currency: reservation.Currency}
+	for _, line := range lines {
+		subtotal = subtotal.Add(line.Amount)
+	}
+
+	taxes := make([]domain.InvoiceTax, 0, len(reservation.Taxes))
+	taxTotal := domain.Money{Currency: reservation.Currency}
+	for _, tax := range reservation.Taxes {
+		taxes = append(taxes, domain.InvoiceTax(tax))
+		taxTotal = taxTotal.Add(tax.Amount)
+	}
+
+	issuedAt := time.Now().UTC()
+
+	return domain.Invoice{
+		ID:            uuid.New(),
+		Year:          issuedAt.Year(),
+		ReservationID: reservation.ID,
+		Billing:       billing,
+		Lines:         lines,
+		Taxes:         taxes,
+		Subtotal:      subtotal,
+		TaxTotal:      taxTotal,
+		Total:         subtotal.Add(taxTotal),
+		IssuedAt:      issuedAt,
+	}
+}
+
+// Key the document of an invoice is stored under
+func invoiceKey(invoice domain.Invoice, format string) string {
+	return fmt.Sprintf("invoices/%d/%s.%s", invoice.Year, invoice.Number, format)
+}
+
+// Renders the document of an invoice. The same invoice always gives the same
+// document.
+func renderInvoice(invoice domain.Invoice, format string) ([]byte, error) {
+	var document bytes.Buffer
+
+	switch format {
+	case "html":
+		if err := invoiceHTML.Execute(&document, invoiceView{Invoice: invoice, BillingLines: billingLines(invoice.Billing)}); err != nil {
+			return nil, err
+		}
+	case "pdf":
+		if _, err := invoicePDF(invoice).WriteTo(&document); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New(ErrUnsupportedInvoiceFormat)
+	}
+
+	return document.Bytes(), nil
+}
+
+type invoiceView struct {
+	domain.Invoice
+	BillingLines []string
+}
+
+var invoiceHTML = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"amount":  formatAmount,
+	"percent": formatRate,
+	"date":    formatInvoiceDate,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Invoice {{.Number}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 40px; color: #222; }
+table { width: 100%; border-collapse: collapse; margin-top: 32px; }
+th, td { padding: 6px 0; text-align: left; }
+th { border-bottom: 1px solid #222; }
+.amount { text-align: right; }
+.subtotal td { border-top: 1px solid #222; }
+.total td { font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>Invoice {{.Number}}</h1>
+<p>Issued on {{date .IssuedAt}}<br>Reservation {{.ReservationID}}</p>
+<h2>Billed to</h2>
+<p>{{range $i, $line := .BillingLines}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>
+<table>
+<thead><tr><th>Description</th><th class="amount">Amount ({{.Total.Currency}})</th></tr></thead>
+<tbody>
+{{range .Lines}}<tr><td>{{.Description}}</td><td class="amount">{{amount .Amount}}</td></tr>
+{{end}}<tr class="subtotal"><td>Subtotal</td><td class="amount">{{amount .Subtotal}}</td></tr>
+{{range .Taxes}}<tr><td>{{.Name}} ({{percent .Rate}})</td><td class="amount">{{amount .Amount}}</td></tr>
+{{end}}<tr class="total"><td>Total</td><td class="amount">{{amount .Total}}</td></tr>
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// Draws the invoice on a single A4 page
+func invoicePDF(invoice domain.Invoice) *pdf.Document {
+	document := pdf.New()
+	page := document.AddPage()
+	left, right := 50.0, pdf.PageWidth-50
+	y := pdf.PageHeight - 70
+
+	page.Text(left, y, 22, true, "Invoice")
+	page.TextRight(right, y, 12, true, invoice.Number)
+	y -= 20
+	page.TextRight(right, y, 10, false, "Issued on "+formatInvoiceDate(invoice.IssuedAt))
+	y -= 14
+	page.TextRight(right, y, 10, false, "Reservation "+invoice.ReservationID.String())
+
+	y -= 40
+	page.Text(left, y, 11, true, "Billed to")
+	for _, line := range billingLines(invoice.Billing) {
+		y -= 14
+		page.Text(left, y, 10, false, line)
+	}
+
+	y -= 40
+	page.Text(left, y, 10, true, "Description")
+	page.TextRight(right, y, 10, true, "Amount ("+invoice.Total.Currency+")")
+	y -= 6
+	page.Line(left, y, right, y, 0.5)
+
+	row := func(label string, amount domain.Money, bold bool) {
+		y -= 18
+		page.Text(left, y, 10, bold, label)
+		page.TextRight(right, y, 10, bold, formatAmount(amount))
+	}
+	for _, line := range invoice.Lines {
+		row(line.Description, line.Amount, false)
+	}
+	y -= 8
+	page.Line(left, y, right, y, 0.5)
+	row("Subtotal", invoice.Subtotal, false)
+	for _, tax := range invoice.Taxes {
+		row(fmt.Sprintf("%s (%s)", tax.Name, formatRate(tax.Rate)), tax.Amount, false)
+	}
+	row("Total", invoice.Total, true)
+
+	return document
+}
+
+// Lines of the address block of an invoice
+func billingLines(billing domain.BillingInfo) []string {
+	var lines []string
+	if billing.CompanyName != "" {
+		lines = append(lines, billing.CompanyName, "Tax ID "+billing.TaxID, billing.Address,
+			strings.TrimSpace(billing.PostalCode+" "+billing.City), billing.Country)
+	}
+
+	return append(lines, billing.Name, billing.Email)
+}
+
+// Formats an amount with the decimals of the minor unit of its currency
+func formatAmount(amount domain.Money) string {
+	return strconv.FormatFloat(amount.Major(), 'f', domain.CurrencyDecimals(amount.Currency), 64)
+}
+
+// Formats a rate, such as 0.08875, as a percentage
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(math.Round(rate*100000)/1000, 'f', -1, 64) + "%"
+}
+
+func formatInvoiceDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type invoicesDependencies struct {
+	invoicesRepository        *mocks.MockInvoicesRepo
+	billingProfilesRepository *mocks.MockBillingProfilesRepo
+	reservationsRepository    *mocks.MockReservationsRepo
+	lateReturnsRepository     *mocks.MockLateReturnsRepo
+	usersRepository           *mocks.MockUsersRepo
+	storage                   *mocks.MockStorage
+}
+
+func NewInvoicesDependencies(invoicesRepo *mocks.MockInvoicesRepo, billingProfilesRepo *mocks.MockBillingProfilesRepo, reservationsRepo *mocks.MockReservationsRepo, lateReturnsRepo *mocks.MockLateReturnsRepo, usersRepo *mocks.MockUsersRepo, storage *mocks.MockStorage) *invoicesDependencies {
+	return &invoicesDependencies{
+		invoicesRepository:        invoicesRepo,
+		billingProfilesRepository: billingProfilesRepo,
+		reservationsRepository:    reservationsRepo,
+		lateReturnsRepository:     lateReturnsRepo,
+		usersRepository:           usersRepo,
+		storage:                   storage,
+	}
+}
+
+func newInvoicesService(t *testing.T, setMocks func(*invoicesDependencies)) Invoices {
+	mockCtlr := gomock.NewController(t)
+	invoicesRepo := mocks.NewMockInvoicesRepo(mockCtlr)
+	billingProfilesRepo := mocks.NewMockBillingProfilesRepo(mockCtlr)
+	reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+	lateReturnsRepo := mocks.NewMockLateReturnsRepo(mockCtlr)
+	usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+	storage := mocks.NewMockStorage(mockCtlr)
+	setMocks(NewInvoicesDependencies(invoicesRepo, billingProfilesRepo, reservationsRepo, lateReturnsRepo, usersRepo, storage))
+
+	return NewInvoices(invoicesRepo, billingProfilesRepo, reservationsRepo, lateReturnsRepo, usersRepo, storage)
+}
+
+// Numbers the invoice as the repository does
+func numberedInvoice(sequence int) func(context.Context, domain.Invoice) (domain.Invoice, error) {
+	return func(_ context.Context, di domain.Invoice) (domain.Invoice, error) {
+		di.Sequence = sequence
+		di.Number = domain.InvoiceNumber(di.Year, sequence)
+
+		return di, nil
+	}
+}
+
+func TestInvoicesIssue(t *testing.T) {
+	initConstantsFromServices(t)
+	user := domain.User{ID: uuid.New(), FirstName: "Ana", LastName: "Torres", Email: "ana.torres@acme.com"}
+	cop := func(amount float64) domain.Money {
+		return domain.NewMoney(amount, "COP")
+	}
+	reservation := domain.Reservation{
+		ID:                   uuid.New(),
+		UserID:               user.ID,
+		CarID:                uuid.New(),
+		Status:               "Completed",
+		AdditionalDriverIDs:  []uuid.UUID{uuid.New()},
+		RentalCost:           cop(450),
+		AdditionalDriversFee: cop(30),
+		AddOnsCost:           cop(49.99),
+		Protection:           domain.ReservationProtection{Level: "Basic"},
+		Taxes:                []domain.ReservationTax{{Name: "Sales tax", Rate: 0.08875, Amount: cop(47.04)}},
+		TaxTotal:             cop(47.04),
+		Currency:             "COP",
+	}
+	reserved := reservation
+	reserved.Status = "Reserved"
+	profile := domain.BillingProfile{
+		UserID:      user.ID,
+		CompanyName: "Acme Logistics",
+		TaxID:       "900123456-7",
+		Address:     "Calle 93 #11-28",
+		City:        "Bogota",
+		PostalCode:  "110221",
+		Country:     "CO",
+	}
+	issued := domain.Invoice{ID: uuid.New(), Number: "INV-2027-000007", ReservationID: reservation.ID}
+
+	type wants struct {
+		invoice domain.Invoice
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*invoicesDependencies)
+	}{
+		{
+			name: "issues the invoice with a line for every charged part of the price",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Invoice{}, errors.New(ErrInvoiceNotFound))
+				d.usersRepository.EXPECT().Get(gomock.Any(), user.ID).Return(user, nil)
+				d.billingProfilesRepository.EXPECT().Get(gomock.Any(), user.ID).Return(profile, nil)
+				d.lateReturnsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.LateReturn{}, errors.New(ErrLateReturnNotFound))
+				d.invoicesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, di domain.Invoice) (domain.Invoice, error) {
+					assert.Equal(t, []domain.InvoiceLine{
+						{Description: "Car rental", Amount: cop(450)},
+						{Description: "Additional drivers (1)", Amount: cop(30)},
+						{Description: "Add-ons", Amount: cop(49.99)},
+					}, di.Lines)
+					assert.Equal(t, cop(529.99), di.Subtotal)
+					assert.Equal(t, []domain.InvoiceTax{{Name: "Sales tax", Rate: 0.08875, Amount: cop(47.04)}}, di.Taxes)
+					assert.Equal(t, cop(47.04), di.TaxTotal)
+					assert.Equal(t, cop(577.03), di.Total)
+					assert.Equal(t, "Ana Torres", di.Billing.Name)
+					assert.Equal(t, "Acme Logistics", di.Billing.CompanyName)
+					assert.Equal(t, di.IssuedAt.Year(), di.Year)
+
+					return numberedInvoice(1)(ctx, di)
+				})
+				d.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+		},
+		{
+			name: "issues the invoice to the name and email of the renter when there is no billing profile",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Invoice{}, errors.New(ErrInvoiceNotFound))
+				d.usersRepository.EXPECT().Get(gomock.Any(), user.ID).Return(user, nil)
+				d.billingProfilesRepository.EXPECT().Get(gomock.Any(), user.ID).Return(domain.BillingProfile{}, errors.New(ErrBillingProfileNotFound))
+				d.lateReturnsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.LateReturn{}, errors.New(ErrLateReturnNotFound))
+				d.invoicesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, di domain.Invoice) (domain.Invoice, error) {
+					assert.Equal(t, domain.BillingInfo{Name: "Ana Torres", Email: "ana.torres@acme.com"}, di.Billing)
+
+					return numberedInvoice(2)(ctx, di)
+				})
+				// documents that could not be stored are rendered again when downloaded
+				d.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("disk full")).Times(2)
+			},
+		},
+		{
+			name: "issues the invoice with a line for the late return fee, which is not taxed",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Invoice{}, errors.New(ErrInvoiceNotFound))
+				d.usersRepository.EXPECT().Get(gomock.Any(), user.ID).Return(user, nil)
+				d.billingProfilesRepository.EXPECT().Get(gomock.Any(), user.ID).Return(profile, nil)
+				d.lateReturnsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.LateReturn{ReservationID: reservation.ID, HoursCharged: 2, HourlyFee: cop(25), Fee: cop(50)}, nil)
+				d.invoicesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, di domain.Invoice) (domain.Invoice, error) {
+					assert.Equal(t, []domain.InvoiceLine{
+						{Description: "Car rental", Amount: cop(450)},
+						{Description: "Additional drivers (1)", Amount: cop(30)},
+						{Description: "Add-ons", Amount: cop(49.99)},
+						{Description: "Late return (2 hours)", Amount: cop(50)},
+					}, di.Lines)
+					assert.Equal(t, cop(579.99), di.Subtotal)
+					assert.Equal(t, cop(47.04), di.TaxTotal)
+					assert.Equal(t, cop(627.03), di.Total)
+
+					return numberedInvoice(3)(ctx, di)
+				})
+				d.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+		},
+		{
+			name: "returns the invoice issued first when the reservation was already invoiced",
+			wants: wants{
+				invoice: issued,
+				err:     nil,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(issued, nil)
+			},
+		},
+		{
+			name: "returns the invoice issued in the meantime by another request",
+			wants: wants{
+				invoice: issued,
+				err:     nil,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				gomock.InOrder(
+					d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.Invoice{}, errors.New(ErrInvoiceNotFound)),
+					d.invoicesRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(issued, nil),
+				)
+				d.usersRepository.EXPECT().Get(gomock.Any(), user.ID).Return(user, nil)
+				d.billingProfilesRepository.EXPECT().Get(gomock.Any(), user.ID).Return(profile, nil)
+				d.lateReturnsRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(domain.LateReturn{}, errors.New(ErrLateReturnNotFound))
+				d.invoicesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(domain.Invoice{}, errors.New(ErrInvoiceAlreadyIssued))
+			},
+		},
+		{
+			name: "returns an error when the reservation was not completed",
+			wants: wants{
+				err: errors.New(ErrReservationNotCompleted),
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reserved, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			invoicesService := newInvoicesService(t, test.setMocks)
+			invoice, err := invoicesService.Issue(context.TODO(), reservation.ID)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil && test.wants.invoice.ID != uuid.Nil {
+				assert.Equal(t, test.wants.invoice, invoice)
+			}
+		})
+	}
+}
+
+func TestInvoicesOpen(t *testing.T) {
+	invoice := domain.Invoice{
+		ID:            uuid.New(),
+		Number:        "INV-2027-000042",
+		Year:          2027,
+		Sequence:      42,
+		ReservationID: uuid.New(),
+		Billing:       domain.BillingInfo{Name: "Ana Torres", Email: "ana@example.com", CompanyName: "Smith & Sons", TaxID: "123"},
+		Lines:         []domain.InvoiceLine{{Description: "Car rental", Amount: usd(450)}},
+		Taxes:         []domain.InvoiceTax{{Name: "Sales tax", Rate: 0.08875, Amount: usd(39.94)}},
+		Subtotal:      usd(450),
+		TaxTotal:      usd(39.94),
+		Total:         usd(489.94),
+		IssuedAt:      time.Date(2027, 5, 22, 19, 0, 0, 0, time.UTC),
+	}
+
+	type args struct {
+		format string
+	}
+	type wants struct {
+		contentType string
+		contains    []string
+		err         error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*invoicesDependencies)
+	}{
+		{
+			name: "opens the stored document",
+			args: args{
+				format: "pdf",
+			},
+			wants: wants{
+				contentType: "application/pdf",
+				contains:    []string{"stored"},
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesRepository.EXPECT().Get(gomock.Any(), invoice.ID).Return(invoice, nil)
+				d.storage.EXPECT().Open(gomock.Any(), "invoices/2027/INV-2027-000042.pdf").Return(io.NopCloser(bytes.NewBufferString("stored")), nil)
+			},
+		},
+		{
+			name: "renders the pdf again when it is missing from the storage",
+			args: args{
+				format: "pdf",
+			},
+			wants: wants{
+				contentType: "application/pdf",
+				contains:    []string{"%PDF-1.4", "(INV-2027-000042) Tj", "(Sales tax \\(8.875%\\)) Tj", "(489.94) Tj"},
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesRepository.EXPECT().Get(gomock.Any(), invoice.ID).Return(invoice, nil)
+				d.storage.EXPECT().Open(gomock.Any(), "invoices/2027/INV-2027-000042.pdf").Return(nil, errors.New("stored object not found"))
+				d.storage.EXPECT().Save(gomock.Any(), "invoices/2027/INV-2027-000042.pdf", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "renders the html again when it is missing from the storage",
+			args: args{
+				format: "html",
+			},
+			wants: wants{
+				contentType: "text/html; charset=utf-8",
+				contains:    []string{"<h1>Invoice INV-2027-000042</h1>", "Smith &amp; Sons", "Issued on 2027-05-22", "489.94"},
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesRepository.EXPECT().Get(gomock.Any(), invoice.ID).Return(invoice, nil)
+				d.storage.EXPECT().Open(gomock.Any(), "invoices/2027/INV-2027-000042.html").Return(nil, errors.New("stored object not found"))
+				d.storage.EXPECT().Save(gomock.Any(), "invoices/2027/INV-2027-000042.html", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the format is not supported",
+			args: args{
+				format: "docx",
+			},
+			wants: wants{
+				err: errors.New(ErrUnsupportedInvoiceFormat),
+			},
+			setMocks: func(d *invoicesDependencies) {},
+		},
+		{
+			name: "returns an error when the invoice was not found",
+			args: args{
+				format: "pdf",
+			},
+			wants: wants{
+				err: errors.New(ErrInvoiceNotFound),
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesRepository.EXPECT().Get(gomock.Any(), invoice.ID).Return(domain.Invoice{}, errors.New(ErrInvoiceNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			invoicesService := newInvoicesService(t, test.setMocks)
+			content, contentType, err := invoicesService.Open(context.TODO(), invoice.ID, test.args.format)
+
+			assert.Equal(t, test.wants.err, err)
+			if err != nil {
+				return
+			}
+			defer content.Close()
+
+			document, err := io.ReadAll(content)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wants.contentType, contentType)
+			for _, s := range test.wants.contains {
+				assert.Contains(t, string(document), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+)
+
+var (
+	ErrLateReturnNotFound     = "late return not found"
+	ErrOverdueNoticeNotFound  = "overdue notice not found"
+	ErrOverdueAlreadyNotified = "overdue rental was already notified"
+	ErrLateFeeDeclined        = "late fee was declined by the payment gateway"
+)
+
+// Reason the deposit is captured with when it covers a late fee
+const lateFeeCaptureReason = "Late fee"
+
+type LateReturns struct {
+	lateReturnsRepository   ports.LateReturnsRepo
+	reservationsRepository  ports.ReservationsRepo
+	carsRepository          ports.CarsRepo
+	citiesRepository        ports.CitiesRepo
+	usersRepository         ports.UsersRepo
+	exchangeRatesRepository ports.ExchangeRatesRepo
+	mailer                  ports.Mailer
+}
+
+func NewLateReturns(lrr ports.LateReturnsRepo, rr ports.ReservationsRepo, carr ports.CarsRepo, cr ports.CitiesRepo, ur ports.UsersRepo, xrr ports.ExchangeRatesRepo, m ports.Mailer) LateReturns {
+	return LateReturns{
+		lateReturnsRepository:   lrr,
+		reservationsRepository:  rr,
+		carsRepository:          carr,
+		citiesRepository:        cr,
+		usersRepository:         ur,
+		exchangeRatesRepository: xrr,
+		mailer:                  m,
+	}
+}
+
+// Lists the rentals whose car is still out past the grace period after their
+// end, with the late fee they would be charged if it were returned now and
+// the next reservation on the car
+func (lrs LateReturns) ListOverdue(ctx context.Context) ([]domain.OverdueRental, error) {
+	return lrs.listOverdue(ctx, time.Now().UTC())
+}
+
+// Tells the renter of the next reservation on the car of every overdue rental
+// that the car was not returned on time. Each overdue rental is notified
+// once; when an email fails, the rentals left are notified the next time.
+func (lrs LateReturns) NotifyOverdue(ctx context.Context) ([]domain.OverdueRental, error) {
+	now := time.Now().UTC()
+	overdue, err := lrs.listOverdue(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, rental := range overdue {
+		if rental.NextReservation == nil || rental.Notice != nil {
+			continue
+		}
+
+		if err := lrs.notify(ctx, rental); err != nil {
+			return nil, err
+		}
+
+		notice := domain.OverdueNotice{
+			ReservationID:     rental.Reservation.ID,
+			NextReservationID: rental.NextReservation.ID,
+			NotifiedAt:        now,
+		}
+		if err := lrs.lateReturnsRepository.InsertNotice(ctx, notice); err != nil {
+			return nil, err
+		}
+		overdue[i].Notice = &notice
+	}
+
+	return overdue, nil
+}
+
+func (lrs LateReturns) listOverdue(ctx context.Context, now time.Time) ([]domain.OverdueRental, error) {
+	values := constants.Values()
+	grace := time.Duration(values.LATE_RETURN_GRACE_MINUTES) * time.Minute
+
+	reservations, err := lrs.reservationsRepository.ListOverdue(ctx, values.RESERVATION_STATUSES.RESERVED, now.Add(-grace))
+	if err != nil {
+		return nil, err
+	}
+
+	overdue := make([]domain.OverdueRental, 0, len(reservations))
+	for _, reservation := range reservations {
+		car, err := lrs.carsRepository.Get(ctx, reservation.CarID)
+		if err != nil {
+			return nil, err
+		}
+		hourlyFee, err := feeIn(ctx, lrs.exchangeRatesRepository, values.LateFeeFor(car.Type), reservation.Currency)
+		if err != nil {
+			return nil, err
+		}
+		rental := domain.OverdueRental{
+			Reservation: reservation,
+			Lateness:    newLateReturn(reservation, now, hourlyFee),
+		}
+
+		next, err := lrs.reservationsRepository.GetNextByCarID(ctx, reservation.CarID, reservation.ID, reservation.EndDate)
+		if err != nil && err.Error() != ErrReservationNotFound {
+			return nil, err
+		}
+		if err == nil && next.Status == values.RESERVATION_STATUSES.RESERVED {
+			rental.NextReservation = &next
+		}
+
+		notice, err := lrs.lateReturnsRepository.GetNotice(ctx, reservation.ID)
+		if err != nil && err.Error() != ErrOverdueNoticeNotFound {
+			return nil, err
+		}
+		if err == nil {
+			rental.Notice = &notice
+		}
+
+		overdue = append(overdue, rental)
+	}
+
+	return overdue, nil
+}
+
+// Emails the renter of the next reservation, with its start in the local time
+// of the city of the car
+func (lrs LateReturns) notify(ctx context.Context, rental domain.OverdueRental) error {
+	user, err := lrs.usersRepository.Get(ctx, rental.NextReservation.UserID)
+	if err != nil {
+		return err
+	}
+
+	city, err := lrs.citiesRepository.GetByCarID(ctx, rental.NextReservation.CarID)
+	if err != nil {
+		return err
+	}
+	location, err := LoadCityLocation(city.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	return lrs.mailer.Send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: "Your car may not be ready on time",
+		Body: fmt.Sprintf("Hi %s,\n\nThe car of your reservation starting on %s has not been returned yet by its previous renter. We will let you know as soon as it is back, or you can contact us to change your reservation.\n",
+			user.FirstName, rental.NextReservation.StartDate.In(location).Format("January 2, 2006 at 15:04 MST")),
+	})
+}
+
+// Checks whether a car returned at returnedAt is past the grace period after
+// the end of its reservation
+func isReturnedLate(reservation domain.Reservation, returnedAt time.Time) bool {
+	grace := time.Duration(constants.Values().LATE_RETURN_GRACE_MINUTES) * time.Minute
+
+	return returnedAt.Sub(reservation.EndDate) > grace
+}
+
+// Builds the late return of a car returned at returnedAt. Once past the grace
+// period, every started hour since the end of the reservation is charged at
+// the hourly fee, in the currency of the reservation.
+func newLateReturn(reservation domain.Reservation, returnedAt time.Time, hourlyFee domain.Money) domain.LateReturn {
+	late := returnedAt.Sub(reservation.EndDate)
+	hours := int(math.Ceil(late.Hours()))
+
+	return domain.LateReturn{
+		ReservationID: reservation.ID,
+		DueAt:         reservation.EndDate,
+		ReturnedAt:    returnedAt,
+		MinutesLate:   int(math.Ceil(late.Minutes())),
+		HoursCharged:  hours,
+		HourlyFee:     hourlyFee,
+		Fee:           hourlyFee.Times(int64(hours)),
+	}
+}
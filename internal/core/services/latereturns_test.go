@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type lateReturnsDependencies struct {
+	lateReturnsRepository   *mocks.MockLateReturnsRepo
+	reservationsRepository  *mocks.MockReservationsRepo
+	carsRepository          *mocks.MockCarsRepo
+	citiesRepository        *mocks.MockCitiesRepo
+	usersRepository         *mocks.MockUsersRepo
+	exchangeRatesRepository *mocks.MockExchangeRatesRepo
+	mailer                  *mocks.MockMailer
+}
+
+func NewLateReturnsDependencies(lateReturnsRepo *mocks.MockLateReturnsRepo, reservationsRepo *mocks.MockReservationsRepo, carsRepo *mocks.MockCarsRepo, citiesRepo *mocks.MockCitiesRepo, usersRepo *mocks.MockUsersRepo, exchangeRatesRepo *mocks.MockExchangeRatesRepo, mailer *mocks.MockMailer) *lateReturnsDependencies {
+	return &lateReturnsDependencies{
+		lateReturnsRepository:   lateReturnsRepo,
+		reservationsRepository:  reservationsRepo,
+		carsRepository:          carsRepo,
+		citiesRepository:        citiesRepo,
+		usersRepository:         usersRepo,
+		exchangeRatesRepository: exchangeRatesRepo,
+		mailer:                  mailer,
+	}
+}
+
+func TestLateReturnsListOverdue(t *testing.T) {
+	initConstantsFromServices(t)
+
+	now := time.Now().UTC()
+	overdue := domain.Reservation{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CarID:     uuid.New(),
+		Status:    "Reserved",
+		StartDate: now.Add(-72 * time.Hour),
+		EndDate:   now.Add(-2*time.Hour - 10*time.Minute),
+		Currency:  "USD",
+	}
+	next := domain.Reservation{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CarID:     overdue.CarID,
+		Status:    "Reserved",
+		StartDate: now.Add(time.Hour),
+		EndDate:   now.Add(48 * time.Hour),
+	}
+	canceledNext := next
+	canceledNext.Status = "Canceled"
+
+	type wants struct {
+		overdue int
+		fee     domain.Money
+		hasNext bool
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies)
+	}{
+		{
+			name: "returns the overdue rental with its late fee so far and the next reservation on the car",
+			wants: wants{
+				overdue: 1,
+				fee:     usd(120),
+				hasNext: true,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Luxury"}, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(next, nil)
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(domain.OverdueNotice{}, errors.New(ErrOverdueNoticeNotFound))
+			},
+		},
+		{
+			name: "returns the overdue rental without next reservation when the next one was canceled",
+			wants: wants{
+				overdue: 1,
+				fee:     usd(120),
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Luxury"}, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(canceledNext, nil)
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(domain.OverdueNotice{}, errors.New(ErrOverdueNoticeNotFound))
+			},
+		},
+		{
+			name: "returns the overdue rental without next reservation when there is none",
+			wants: wants{
+				overdue: 1,
+				fee:     usd(120),
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Luxury"}, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(domain.OverdueNotice{}, errors.New(ErrOverdueNoticeNotFound))
+			},
+		},
+		{
+			name: "returns error when overdue reservations could not be listed",
+			wants: wants{
+				err: errors.New("connection refused"),
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			lateReturnsRepo := mocks.NewMockLateReturnsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewLateReturnsDependencies(lateReturnsRepo, reservationsRepo, carsRepo, citiesRepo, usersRepo, exchangeRatesRepo, mailer)
+			test.setMocks(d)
+
+			lateReturnsService := NewLateReturns(lateReturnsRepo, reservationsRepo, carsRepo, citiesRepo, usersRepo, exchangeRatesRepo, mailer)
+			rentals, err := lateReturnsService.ListOverdue(context.TODO())
+
+			assert.Equal(t, test.wants.err, err)
+			if assert.Len(t, rentals, test.wants.overdue) && test.wants.overdue > 0 {
+				assert.Equal(t, test.wants.fee, rentals[0].Lateness.Fee)
+				assert.Equal(t, test.wants.hasNext, rentals[0].NextReservation != nil)
+				assert.Nil(t, rentals[0].Notice)
+			}
+		})
+	}
+}
+
+func TestLateReturnsNotifyOverdue(t *testing.T) {
+	initConstantsFromServices(t)
+
+	now := time.Now().UTC()
+	overdue := domain.Reservation{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CarID:     uuid.New(),
+		Status:    "Reserved",
+		StartDate: now.Add(-72 * time.Hour),
+		EndDate:   now.Add(-time.Hour),
+		Currency:  "USD",
+	}
+	next := domain.Reservation{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CarID:     overdue.CarID,
+		Status:    "Reserved",
+		StartDate: now.Add(time.Hour),
+		EndDate:   now.Add(48 * time.Hour),
+	}
+	notice := domain.OverdueNotice{
+		ReservationID:     overdue.ID,
+		NextReservationID: next.ID,
+		NotifiedAt:        now.Add(-10 * time.Minute),
+	}
+	city := domain.City{TimeZone: "America/Chicago"}
+	chicago, err := time.LoadLocation(city.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type wants struct {
+		notifiedAt time.Time
+		err        error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies)
+	}{
+		{
+			name: "emails the next renter and records the notice when the rental was not notified",
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Sedan"}, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), overdue.CarID).Return(city, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(next, nil)
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(domain.OverdueNotice{}, errors.New(ErrOverdueNoticeNotFound))
+				d.usersRepository.EXPECT().Get(gomock.Any(), next.UserID).Return(domain.User{ID: next.UserID, FirstName: "Ada", Email: "ada@example.com"}, nil)
+				d.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, email domain.Email) error {
+						assert.Equal(t, "ada@example.com", email.To)
+						assert.Contains(t, email.Body, next.StartDate.In(chicago).Format("January 2, 2006 at 15:04 MST"))
+						return nil
+					})
+				d.lateReturnsRepository.EXPECT().InsertNotice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, don domain.OverdueNotice) error {
+						assert.Equal(t, overdue.ID, don.ReservationID)
+						assert.Equal(t, next.ID, don.NextReservationID)
+						return nil
+					})
+			},
+		},
+		{
+			name: "does not email the next renter again when the rental was already notified",
+			wants: wants{
+				notifiedAt: notice.NotifiedAt,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Sedan"}, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(next, nil)
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(notice, nil)
+			},
+		},
+		{
+			name: "returns error and does not record the notice when the email could not be sent",
+			wants: wants{
+				err: errors.New("connection refused"),
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.reservationsRepository.EXPECT().ListOverdue(gomock.Any(), "Reserved", gomock.Any()).Return([]domain.Reservation{overdue}, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), overdue.CarID).Return(domain.Car{ID: overdue.CarID, Type: "Sedan"}, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), overdue.CarID).Return(city, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), overdue.CarID, overdue.ID, overdue.EndDate).Return(next, nil)
+				d.lateReturnsRepository.EXPECT().GetNotice(gomock.Any(), overdue.ID).Return(domain.OverdueNotice{}, errors.New(ErrOverdueNoticeNotFound))
+				d.usersRepository.EXPECT().Get(gomock.Any(), next.UserID).Return(domain.User{ID: next.UserID, FirstName: "Ada", Email: "ada@example.com"}, nil)
+				d.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			lateReturnsRepo := mocks.NewMockLateReturnsRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewLateReturnsDependencies(lateReturnsRepo, reservationsRepo, carsRepo, citiesRepo, usersRepo, exchangeRatesRepo, mailer)
+			test.setMocks(d)
+
+			lateReturnsService := NewLateReturns(lateReturnsRepo, reservationsRepo, carsRepo, citiesRepo, usersRepo, exchangeRatesRepo, mailer)
+			rentals, err := lateReturnsService.NotifyOverdue(context.TODO())
+
+			assert.Equal(t, test.wants.err, err)
+			if test.wants.err != nil {
+				return
+			}
+			if assert.Len(t, rentals, 1) && assert.NotNil(t, rentals[0].Notice) {
+				assert.Equal(t, next.ID, rentals[0].Notice.NextReservationID)
+				if !test.wants.notifiedAt.IsZero() {
+					assert.Equal(t, test.wants.notifiedAt, rentals[0].Notice.NotifiedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestNewLateReturn(t *testing.T) {
+	initConstantsFromServices(t)
+
+	endDate := time.Date(2027, time.May, 22, 18, 0, 0, 0, time.UTC)
+	reservation := domain.Reservation{ID: uuid.New(), EndDate: endDate, Currency: "USD"}
+
+	tests := []struct {
+		name         string
+		returnedAt   time.Time
+		carType      string
+		late         bool
+		hoursCharged int
+		fee          domain.Money
+	}{
+		{
+			name:       "is not late when returned within the grace period",
+			returnedAt: endDate.Add(30 * time.Minute),
+			carType:    "Sedan",
+			late:       false,
+		},
+		{
+			name:         "charges the first hour when returned just after the grace period",
+			returnedAt:   endDate.Add(31 * time.Minute),
+			carType:      "Sedan",
+			late:         true,
+			hoursCharged: 1,
+			fee:          usd(15),
+		},
+		{
+			name:         "charges whole hours when returned on the hour",
+			returnedAt:   endDate.Add(3 * time.Hour),
+			carType:      "Sports Car",
+			late:         true,
+			hoursCharged: 3,
+			fee:          usd(180),
+		},
+		{
+			name:         "charges every started hour",
+			returnedAt:   endDate.Add(3*time.Hour + time.Minute),
+			carType:      "Limousine",
+			late:         true,
+			hoursCharged: 4,
+			fee:          usd(320),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.late, isReturnedLate(reservation, test.returnedAt))
+			if !test.late {
+				return
+			}
+
+			hourlyFee := usd(constants.Values().LateFeeFor(test.carType))
+			lateReturn := newLateReturn(reservation, test.returnedAt, hourlyFee)
+			assert.Equal(t, endDate, lateReturn.DueAt)
+			assert.Equal(t, test.hoursCharged, lateReturn.HoursCharged)
+			assert.Equal(t, test.fee, lateReturn.Fee)
+			assert.Equal(t, hourlyFee, lateReturn.HourlyFee)
+		})
+	}
+}
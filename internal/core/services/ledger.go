@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+)
+
+type Ledger struct {
+	ledgerRepository       ports.LedgerRepo
+	reservationsRepository ports.ReservationsRepo
+}
+
+func NewLedger(lr ports.LedgerRepo, rr ports.ReservationsRepo) Ledger {
+	return Ledger{
+		ledgerRepository:       lr,
+		reservationsRepository: rr,
+	}
+}
+
+// Gets the balance of every ledger account for the reservation along with the
+// entries it was computed from
+func (ls Ledger) GetBalance(ctx context.Context, reservationID uuid.UUID) (domain.LedgerBalance, error) {
+	if _, err := ls.reservationsRepository.Get(ctx, reservationID); err != nil {
+		return domain.LedgerBalance{}, err
+	}
+
+	entries, err := ls.ledgerRepository.GetByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.LedgerBalance{}, err
+	}
+
+	return ledgerBalance(reservationID, entries), nil
+}
+
+// Money moved from the credited account to the debited one
+type posting struct {
+	debit  string
+	credit string
+	amount domain.Money
+}
+
+// Builds the entries of a ledger transaction from its postings. Every posting
+// debits and credits the same amount, so the entries always sum to zero.
+// Postings without amount are left out.
+func ledgerTransaction(reservationID uuid.UUID, movement string, postings ...posting) []domain.LedgerEntry {
+	transactionID := uuid.New()
+	createdAt := time.Now().UTC()
+
+	var entries []domain.LedgerEntry
+	for _, p := range postings {
+		if p.amount.IsZero() {
+			continue
+		}
+
+		for _, side := range []struct {
+			account string
+			amount  domain.Money
+		}{{p.debit, p.amount}, {p.credit, p.amount.Neg()}} {
+			entries = append(entries, domain.LedgerEntry{
+				ID:            uuid.New(),
+				TransactionID: transactionID,
+				ReservationID: reservationID,
+				Account:       side.account,
+				Movement:      movement,
+				Amount:        side.amount,
+				CreatedAt:     createdAt,
+			})
+		}
+	}
+
+	return entries
+}
+
+// Entries of charging the quoted amount of a payment to the customer, its
+// taxes going to the tax account. When less than quoted was captured the
+// difference is given back as a discount.
+func chargeEntries(payment domain.Payment) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+	movements := constants.Values().LEDGER_MOVEMENTS
+
+	charged := payment.Amount
+	if payment.CapturedAmount.GreaterThan(charged) {
+		charged = payment.CapturedAmount
+	}
+	entries := ledgerTransaction(payment.ReservationID, movements.CHARGE,
+		posting{debit: accounts.CUSTOMER, credit: accounts.REVENUE, amount: charged.Sub(payment.TaxAmount)},
+		posting{debit: accounts.CUSTOMER, credit: accounts.TAX, amount: payment.TaxAmount})
+
+	return append(entries, ledgerTransaction(payment.ReservationID, movements.DISCOUNT,
+		posting{debit: accounts.REVENUE, credit: accounts.CUSTOMER, amount: charged.Sub(payment.CapturedAmount)})...)
+}
+
+// Entries of giving back an amount of a captured payment to the customer
+func refundEntries(payment domain.Payment, amount domain.Money) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+
+	return ledgerTransaction(payment.ReservationID, constants.Values().LEDGER_MOVEMENTS.REFUND,
+		posting{debit: accounts.REVENUE, credit: accounts.CUSTOMER, amount: amount})
+}
+
+// Entries of holding the deposit of the customer
+func depositHoldEntries(deposit domain.Deposit) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+
+	return ledgerTransaction(deposit.ReservationID, constants.Values().LEDGER_MOVEMENTS.DEPOSIT_HOLD,
+		posting{debit: accounts.CUSTOMER, credit: accounts.DEPOSITS, amount: deposit.Amount})
+}
+
+// Entries of capturing part of the held deposit as revenue, the rest of it
+// going back to the customer
+func depositCaptureEntries(deposit domain.Deposit) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+
+	return ledgerTransaction(deposit.ReservationID, constants.Values().LEDGER_MOVEMENTS.DEPOSIT_CAPTURE,
+		posting{debit: accounts.DEPOSITS, credit: accounts.REVENUE, amount: deposit.CapturedAmount},
+		posting{debit: accounts.DEPOSITS, credit: accounts.CUSTOMER, amount: deposit.Amount.Sub(deposit.CapturedAmount)})
+}
+
+// Entries of giving the whole held deposit back to the customer
+func depositReleaseEntries(deposit domain.Deposit) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+
+	return ledgerTransaction(deposit.ReservationID, constants.Values().LEDGER_MOVEMENTS.DEPOSIT_RELEASE,
+		posting{debit: accounts.DEPOSITS, credit: accounts.CUSTOMER, amount: deposit.Amount})
+}
+
+// Entries of charging the late fee of a late return to the customer
+func lateFeeEntries(lateReturn domain.LateReturn) []domain.LedgerEntry {
+	accounts := constants.Values().LEDGER_ACCOUNTS
+
+	return ledgerTransaction(lateReturn.ReservationID, constants.Values().LEDGER_MOVEMENTS.LATE_FEE,
+		posting{debit: accounts.CUSTOMER, credit: accounts.REVENUE, amount: lateReturn.Fee})
+}
+
+// Sums the entries of a reservation per account. Amounts are added in minor
+// units so the total is exactly zero when the entries are balanced.
+func ledgerBalance(reservationID uuid.UUID, entries []domain.LedgerEntry) domain.LedgerBalance {
+	balance := domain.LedgerBalance{
+		ReservationID: reservationID,
+		Accounts:      map[string]domain.Money{},
+		Entries:       entries,
+	}
+
+	var currency string
+	if len(entries) > 0 {
+		currency = entries[0].Amount.Currency
+	}
+	for _, account := range constants.Values().LEDGER_ACCOUNTS.Values() {
+		balance.Accounts[account] = domain.Money{Currency: currency}
+	}
+	balance.Total = domain.Money{Currency: currency}
+
+	for _, entry := range entries {
+		balance.Accounts[entry.Account] = balance.Accounts[entry.Account].Add(entry.Amount)
+		balance.Total = balance.Total.Add(entry.Amount)
+	}
+
+	return balance
+}
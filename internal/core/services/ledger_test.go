@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type ledgerDependencies struct {
+	ledgerRepository       *mocks.MockLedgerRepo
+	reservationsRepository *mocks.MockReservationsRepo
+}
+
+func NewLedgerDependencies(ledgerRepo *mocks.MockLedgerRepo, reservationsRepo *mocks.MockReservationsRepo) *ledgerDependencies {
+	return &ledgerDependencies{
+		ledgerRepository:       ledgerRepo,
+		reservationsRepository: reservationsRepo,
+	}
+}
+
+// Gets an amount of dollars
+func usd(amount float64) domain.Money {
+	return domain.NewMoney(amount, "USD")
+}
+
+// Checks the entries of every ledger transaction sum to zero
+func assertLedgerBalanced(t *testing.T, entries []domain.LedgerEntry) {
+	t.Helper()
+
+	transactions := map[uuid.UUID]domain.Money{}
+	for _, entry := range entries {
+		transactions[entry.TransactionID] = transactions[entry.TransactionID].Add(entry.Amount)
+	}
+	for transactionID, sum := range transactions {
+		assert.True(t, sum.IsZero(), "ledger transaction %s is not balanced", transactionID)
+	}
+}
+
+func TestLedgerGetBalance(t *testing.T) {
+	initConstantsFromServices(t)
+	reservation := domain.Reservation{ID: uuid.New(), Currency: "USD"}
+	payment := domain.Payment{ReservationID: reservation.ID, Amount: usd(500), TaxAmount: usd(40), CapturedAmount: usd(500)}
+	deposit := domain.Deposit{ReservationID: reservation.ID, Amount: usd(1500), CapturedAmount: usd(200)}
+
+	var entries []domain.LedgerEntry
+	entries = append(entries, chargeEntries(payment)...)
+	entries = append(entries, refundEntries(payment, usd(50))...)
+	entries = append(entries, depositHoldEntries(deposit)...)
+	entries = append(entries, depositCaptureEntries(deposit)...)
+
+	type wants struct {
+		accounts map[string]domain.Money
+		err      error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*ledgerDependencies)
+	}{
+		{
+			name: "returns the balance of every account of the reservation",
+			wants: wants{
+				accounts: map[string]domain.Money{"Customer": usd(650), "Revenue": usd(-610), "Deposits": usd(0), "Tax": usd(-40)},
+				err:      nil,
+			},
+			setMocks: func(d *ledgerDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.ledgerRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(entries, nil)
+			},
+		},
+		{
+			name: "returns zero balances when nothing was charged yet",
+			wants: wants{
+				accounts: map[string]domain.Money{"Customer": {}, "Revenue": {}, "Deposits": {}, "Tax": {}},
+				err:      nil,
+			},
+			setMocks: func(d *ledgerDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.ledgerRepository.EXPECT().GetByReservationID(gomock.Any(), reservation.ID).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns an error when reservation was not found",
+			wants: wants{
+				err: errors.New(ErrReservationNotFound),
+			},
+			setMocks: func(d *ledgerDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			ledgerRepo := mocks.NewMockLedgerRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			d := NewLedgerDependencies(ledgerRepo, reservationsRepo)
+			test.setMocks(d)
+
+			ledgerService := NewLedger(ledgerRepo, reservationsRepo)
+			balance, err := ledgerService.GetBalance(context.TODO(), reservation.ID)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.wants.accounts, balance.Accounts)
+				assert.True(t, balance.Total.IsZero())
+			}
+		})
+	}
+}
+
+// Every money movement of a reservation is recorded with balanced entries, so
+// the entries of any reservation sum to zero whatever amounts it moved
+func TestLedgerReservationEntriesSumToZero(t *testing.T) {
+	initConstantsFromServices(t)
+	random := rand.New(rand.NewSource(45))
+	amount := func(max domain.Money) domain.Money {
+		return domain.Money{Amount: random.Int63n(max.Amount + 1), Currency: max.Currency}
+	}
+
+	for i := 0; i < 200; i++ {
+		reservationID := uuid.New()
+		payment := domain.Payment{ReservationID: reservationID, Amount: amount(usd(2000)).Add(usd(0.01))}
+		payment.CapturedAmount = payment.Amount.Sub(amount(payment.Amount))
+		deposit := domain.Deposit{ReservationID: reservationID, Amount: amount(usd(1500)).Add(usd(0.01))}
+
+		var entries []domain.LedgerEntry
+		entries = append(entries, chargeEntries(payment)...)
+		entries = append(entries, refundEntries(payment, amount(payment.CapturedAmount))...)
+		entries = append(entries, depositHoldEntries(deposit)...)
+		if random.Intn(2) == 0 {
+			deposit.CapturedAmount = amount(deposit.Amount)
+			entries = append(entries, depositCaptureEntries(deposit)...)
+		} else {
+			entries = append(entries, depositReleaseEntries(deposit)...)
+		}
+		entries = append(entries, lateFeeEntries(domain.LateReturn{ReservationID: reservationID, Fee: amount(usd(500)).Add(usd(0.01))})...)
+
+		assertLedgerBalanced(t, entries)
+		balance := ledgerBalance(reservationID, entries)
+		assert.True(t, balance.Total.IsZero())
+		// whatever was held is given back or captured once the deposit is settled
+		assert.True(t, balance.Accounts["Deposits"].IsZero())
+		for _, entry := range entries {
+			assert.Equal(t, reservationID, entry.ReservationID)
+			assert.False(t, entry.Amount.IsZero())
+		}
+	}
+}
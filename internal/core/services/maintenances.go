@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMaintenanceNotFound         = "maintenance was not found"
+	ErrInvalidMaintenanceTimeFrame = "maintenance time frame is invalid"
+	ErrMaintenanceOverlap          = "car already has a maintenance scheduled during the requested time frame"
+	ErrCarInMaintenance            = "car is scheduled for maintenance during the requested time frame"
+)
+
+type Maintenances struct {
+	maintenancesRepository ports.MaintenancesRepo
+	reservationsRepository ports.ReservationsRepo
+}
+
+func NewMaintenances(mr ports.MaintenancesRepo, rr ports.ReservationsRepo) Maintenances {
+	return Maintenances{
+		maintenancesRepository: mr,
+		reservationsRepository: rr,
+	}
+}
+
+// Schedules a maintenance window for a car. Reservations colliding with the
+// window are not modified, they are returned so they can be handled.
+func (ms Maintenances) Schedule(ctx context.Context, maintenance domain.Maintenance) (domain.Maintenance, []domain.Reservation, error) {
+	if err := ms.CheckMaintenance(ctx, maintenance); err != nil {
+		return domain.Maintenance{}, nil, err
+	}
+
+	maintenance.ID = uuid.New()
+	if err := ms.maintenancesRepository.Insert(ctx, maintenance); err != nil {
+		return domain.Maintenance{}, nil, err
+	}
+
+	conflictingReservations, err := ms.conflictingReservations(ctx, maintenance)
+	if err != nil {
+		return domain.Maintenance{}, nil, err
+	}
+
+	return maintenance, conflictingReservations, nil
+}
+
+func (ms Maintenances) Get(ctx context.Context, ID uuid.UUID) (domain.Maintenance, error) {
+	dm, err := ms.maintenancesRepository.Get(ctx, ID)
+	if err != nil {
+		return domain.Maintenance{}, err
+	}
+
+	return dm, nil
+}
+
+// Updates a maintenance window, returning the reservations colliding with it
+func (ms Maintenances) FullUpdate(ctx context.Context, maintenance domain.Maintenance) ([]domain.Reservation, error) {
+	if err := ms.CheckMaintenance(ctx, maintenance); err != nil {
+		return nil, err
+	}
+
+	if err := ms.maintenancesRepository.FullUpdate(ctx, maintenance); err != nil {
+		return nil, err
+	}
+
+	return ms.conflictingReservations(ctx, maintenance)
+}
+
+func (ms Maintenances) Delete(ctx context.Context, id uuid.UUID) error {
+	return ms.maintenancesRepository.Delete(ctx, id)
+}
+
+// Lists the maintenances of the cars in a city. By default the ones
+// overlapping the next seven days are listed.
+func (ms Maintenances) List(ctx context.Context, city string, fromMaintenanceID string, startDate time.Time, endDate time.Time) ([]domain.Maintenance, error) {
+	values := constants.Values()
+	if fromMaintenanceID == "" {
+		fromMaintenanceID = values.NULL_UUID
+	}
+	if startDate.IsZero() {
+		startDate = time.Now()
+	}
+	if endDate.IsZero() {
+		endDate = startDate.Add(7 * 24 * time.Hour)
+	}
+
+	maintenances, err := ms.maintenancesRepository.List(ctx, city, fromMaintenanceID, startDate, endDate, values.MAINTENANCES_PER_PAGE)
+	if err != nil {
+		return []domain.Maintenance{}, err
+	}
+
+	return maintenances, nil
+}
+
+func (ms Maintenances) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Maintenance, error) {
+	dms, err := ms.maintenancesRepository.GetByCarID(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dms, nil
+}
+
+// Checks the time frame of the maintenance and that it does not overlap
+// another maintenance of the same car
+func (ms Maintenances) CheckMaintenance(ctx context.Context, maintenance domain.Maintenance) error {
+	if isValid := utils.IsValidTimeFrame(maintenance.StartDate, maintenance.EndDate); !isValid {
+		return errors.New(ErrInvalidMaintenanceTimeFrame)
+	}
+
+	if maintenance.EndDate.Before(time.Now()) {
+		return errors.New(ErrInvalidMaintenanceTimeFrame)
+	}
+
+	maintenances, err := ms.maintenancesRepository.GetByCarIDAndTimeFrame(ctx, maintenance.CarID, maintenance.StartDate, maintenance.EndDate)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range maintenances {
+		// do not take into account the maintenance when is being updated
+		if m.ID != maintenance.ID {
+			return errors.New(ErrMaintenanceOverlap)
+		}
+	}
+
+	return nil
+}
+
+// Gets the non canceled reservations of the car colliding with the maintenance
+func (ms Maintenances) conflictingReservations(ctx context.Context, maintenance domain.Maintenance) ([]domain.Reservation, error) {
+	reservations, err := ms.reservationsRepository.GetByCarIDAndTimeFrame(ctx, maintenance.CarID, maintenance.StartDate, maintenance.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled := constants.Values().RESERVATION_STATUSES.CANCELED
+	conflictingReservations := make([]domain.Reservation, 0, len(reservations))
+	for _, reservation := range reservations {
+		if reservation.Status != canceled {
+			conflictingReservations = append(conflictingReservations, reservation)
+		}
+	}
+
+	return conflictingReservations, nil
+}
@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type maintenancesDependencies struct {
+	maintenancesRepository *mocks.MockMaintenancesRepo
+	reservationsRepository *mocks.MockReservationsRepo
+}
+
+func NewMaintenancesDependencies(maintenancesRepo *mocks.MockMaintenancesRepo, reservationsRepo *mocks.MockReservationsRepo) *maintenancesDependencies {
+	return &maintenancesDependencies{
+		maintenancesRepository: maintenancesRepo,
+		reservationsRepository: reservationsRepo,
+	}
+}
+
+func TestMaintenancesSchedule(t *testing.T) {
+	initConstantsFromServices(t)
+	now := time.Now()
+	maintenance := domain.Maintenance{
+		CarID:     uuid.New(),
+		StartDate: now.Add(24 * time.Hour),
+		EndDate:   now.Add(48 * time.Hour),
+		Reason:    "Oil change",
+	}
+	reservation := domain.Reservation{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		CarID:         maintenance.CarID,
+		Status:        "Reserved",
+		PaymentStatus: "Pending",
+		StartDate:     now.Add(12 * time.Hour),
+		EndDate:       now.Add(36 * time.Hour),
+	}
+	canceledReservation := reservation
+	canceledReservation.ID = uuid.New()
+	canceledReservation.Status = constants.Values().RESERVATION_STATUSES.CANCELED
+
+	type args struct {
+		ctx         context.Context
+		maintenance domain.Maintenance
+	}
+	type wants struct {
+		conflictingReservations []domain.Reservation
+		err                     error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name: "returns nil error when maintenance does not collide with reservations",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				conflictingReservations: []domain.Reservation{},
+				err:                     nil,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), maintenance.CarID, maintenance.StartDate, maintenance.EndDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), maintenance.CarID, maintenance.StartDate, maintenance.EndDate).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns the non canceled reservations colliding with the maintenance",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				conflictingReservations: []domain.Reservation{reservation},
+				err:                     nil,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Reservation{reservation, canceledReservation}, nil)
+			},
+		},
+		{
+			name: "returns an error when time frame is invalid",
+			args: args{
+				ctx: context.TODO(),
+				maintenance: domain.Maintenance{
+					CarID:     uuid.New(),
+					StartDate: now.Add(48 * time.Hour),
+					EndDate:   now.Add(24 * time.Hour),
+					Reason:    "Oil change",
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidMaintenanceTimeFrame),
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns an error when maintenance already ended",
+			args: args{
+				ctx: context.TODO(),
+				maintenance: domain.Maintenance{
+					CarID:     uuid.New(),
+					StartDate: now.Add(-48 * time.Hour),
+					EndDate:   now.Add(-24 * time.Hour),
+					Reason:    "Oil change",
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidMaintenanceTimeFrame),
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns an error when maintenance overlaps another one of the car",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				err: errors.New(ErrMaintenanceOverlap),
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Maintenance{
+					{
+						ID:        uuid.New(),
+						CarID:     maintenance.CarID,
+						StartDate: now,
+						EndDate:   now.Add(30 * time.Hour),
+						Reason:    "Tires rotation",
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "returns an error when maintenances repository fails to insert the maintenance",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				err: errors.New(ErrCarNotFound),
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrCarNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesRepo, reservationsRepo)
+			test.setMocks(d)
+
+			maintenancesService := NewMaintenances(maintenancesRepo, reservationsRepo)
+			dm, conflictingReservations, err := maintenancesService.Schedule(test.args.ctx, test.args.maintenance)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.conflictingReservations, conflictingReservations)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, dm.ID)
+			}
+		})
+	}
+}
+
+func TestMaintenancesFullUpdate(t *testing.T) {
+	initConstantsFromServices(t)
+	now := time.Now()
+	maintenance := domain.Maintenance{
+		ID:        uuid.New(),
+		CarID:     uuid.New(),
+		StartDate: now.Add(24 * time.Hour),
+		EndDate:   now.Add(48 * time.Hour),
+		Reason:    "Oil change",
+	}
+
+	type args struct {
+		ctx         context.Context
+		maintenance domain.Maintenance
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name: "returns nil error when the only overlapping maintenance is the one being updated",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Maintenance{maintenance}, nil)
+				d.maintenancesRepository.EXPECT().FullUpdate(gomock.Any(), maintenance).Return(nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns an error when maintenance was not found",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				err: errors.New(ErrMaintenanceNotFound),
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().FullUpdate(gomock.Any(), maintenance).Return(errors.New(ErrMaintenanceNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesRepo, reservationsRepo)
+			test.setMocks(d)
+
+			maintenancesService := NewMaintenances(maintenancesRepo, reservationsRepo)
+			_, err := maintenancesService.FullUpdate(test.args.ctx, test.args.maintenance)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestMaintenancesList(t *testing.T) {
+	initConstantsFromServices(t)
+	startDate := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	fromMaintenanceID := uuid.New().String()
+
+	type args struct {
+		ctx               context.Context
+		city              string
+		fromMaintenanceID string
+		startDate         time.Time
+		endDate           time.Time
+	}
+	type wants struct {
+		withError bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name: "uses null uuid and a seven days window when they are not given",
+			args: args{
+				ctx:       context.TODO(),
+				city:      "Seattle",
+				startDate: startDate,
+			},
+			wants: wants{
+				withError: false,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				values := constants.Values()
+				d.maintenancesRepository.EXPECT().List(gomock.Any(), "Seattle", values.NULL_UUID, startDate, startDate.Add(7*24*time.Hour), values.MAINTENANCES_PER_PAGE).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns an error when maintenances repository fails",
+			args: args{
+				ctx:               context.TODO(),
+				city:              "Seattle",
+				fromMaintenanceID: fromMaintenanceID,
+				startDate:         startDate,
+				endDate:           startDate.Add(24 * time.Hour),
+			},
+			wants: wants{
+				withError: true,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesRepository.EXPECT().List(gomock.Any(), "Seattle", fromMaintenanceID, startDate, startDate.Add(24*time.Hour), gomock.Any()).Return(nil, errors.New(ErrCityNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesRepo, reservationsRepo)
+			test.setMocks(d)
+
+			maintenancesService := NewMaintenances(maintenancesRepo, reservationsRepo)
+			_, err := maintenancesService.List(test.args.ctx, test.args.city, test.args.fromMaintenanceID, test.args.startDate, test.args.endDate)
+
+			assert.Equal(t, test.wants.withError, err != nil)
+		})
+	}
+}
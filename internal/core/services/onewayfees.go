@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOneWayFeeNotFound       = "one-way fee not found"
+	ErrOneWayFeeSameCity       = "one-way fee cities must be different"
+	ErrOneWayRouteNotAvailable = "one-way rentals are not available between the pickup and return cities"
+)
+
+type OneWayFees struct {
+	oneWayFeesRepository ports.OneWayFeesRepo
+}
+
+func NewOneWayFees(owfr ports.OneWayFeesRepo) OneWayFees {
+	return OneWayFees{
+		oneWayFeesRepository: owfr,
+	}
+}
+
+// Sets the fee charged for returning in toCityID a car picked up in fromCityID.
+// The route becomes available for one-way rentals if it was not.
+func (owfs OneWayFees) Set(ctx context.Context, oneWayFee domain.OneWayFee) error {
+	if oneWayFee.FromCityID == oneWayFee.ToCityID {
+		return errors.New(ErrOneWayFeeSameCity)
+	}
+
+	return owfs.oneWayFeesRepository.Upsert(ctx, oneWayFee)
+}
+
+func (owfs OneWayFees) ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error) {
+	return owfs.oneWayFeesRepository.ListByCityID(ctx, fromCityID)
+}
+
+func (owfs OneWayFees) Delete(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) error {
+	return owfs.oneWayFeesRepository.Delete(ctx, fromCityID, toCityID)
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type oneWayFeesDependencies struct {
+	oneWayFeesRepository *mocks.MockOneWayFeesRepo
+}
+
+func NewOneWayFeesDependencies(oneWayFeesRepo *mocks.MockOneWayFeesRepo) *oneWayFeesDependencies {
+	return &oneWayFeesDependencies{
+		oneWayFeesRepository: oneWayFeesRepo,
+	}
+}
+
+func TestOneWayFeesSet(t *testing.T) {
+	cityID := uuid.New()
+	oneWayFee := domain.OneWayFee{
+		FromCityID: cityID,
+		ToCityID:   uuid.New(),
+		Fee:        150,
+	}
+
+	type args struct {
+		oneWayFee domain.OneWayFee
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*oneWayFeesDependencies)
+	}{
+		{
+			name: "returns nil error when the fee was stored",
+			args: args{
+				oneWayFee: oneWayFee,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {
+				d.oneWayFeesRepository.EXPECT().Upsert(gomock.Any(), oneWayFee).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when both cities are the same",
+			args: args{
+				oneWayFee: domain.OneWayFee{FromCityID: cityID, ToCityID: cityID, Fee: 150},
+			},
+			wants: wants{
+				err: errors.New(ErrOneWayFeeSameCity),
+			},
+			setMocks: func(d *oneWayFeesDependencies) {},
+		},
+		{
+			name: "returns an error when a city was not found",
+			args: args{
+				oneWayFee: oneWayFee,
+			},
+			wants: wants{
+				err: errors.New(ErrCityNotFound),
+			},
+			setMocks: func(d *oneWayFeesDependencies) {
+				d.oneWayFeesRepository.EXPECT().Upsert(gomock.Any(), oneWayFee).Return(errors.New(ErrCityNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			d := NewOneWayFeesDependencies(oneWayFeesRepo)
+			test.setMocks(d)
+
+			oneWayFeesService := NewOneWayFees(oneWayFeesRepo)
+			err := oneWayFeesService.Set(context.TODO(), test.args.oneWayFee)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
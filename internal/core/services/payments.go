@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPaymentNotFound          = "payment not found"
+	ErrPaymentInProgress        = "reservation already has a payment in progress"
+	ErrReservationNotPayable    = "only reserved reservations can be paid"
+	ErrInvalidPaymentTransition = "payment can not change from its current status"
+	ErrRefundExceedsCaptured    = "refund exceeds the captured amount left"
+	ErrCaptureExceedsAuthorized = "captured amount exceeds the authorized one"
+	ErrPaymentCurrencyMismatch  = "payment event amount is not in the currency of the payment"
+	ErrPaymentStatusChanged     = "payment status changed while it was being updated"
+	ErrInvalidPaymentSignature  = "invalid payment event signature"
+	ErrUnsupportedPaymentEvent  = "unsupported payment event"
+	ErrPaymentGatewayRejected   = "payment gateway rejected the operation"
+)
+
+type Payments struct {
+	paymentsRepository     ports.PaymentsRepo
+	reservationsRepository ports.ReservationsRepo
+	paymentGateway         ports.PaymentGateway
+}
+
+func NewPayments(pr ports.PaymentsRepo, rr ports.ReservationsRepo, pg ports.PaymentGateway) Payments {
+	return Payments{
+		paymentsRepository:     pr,
+		reservationsRepository: rr,
+		paymentGateway:         pg,
+	}
+}
+
+// Authorizes the total of the reservation quote, taxes included, on the payment
+// method, in the currency the reservation was booked in. A new payment can
+// only be started when the previous one failed or was voided.
+func (ps Payments) Authorize(ctx context.Context, reservationID uuid.UUID, paymentMethod string) (domain.Payment, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	reservation, err := ps.reservationsRepository.Get(ctx, reservationID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	if reservation.Status != constants.Values().RESERVATION_STATUSES.RESERVED {
+		return domain.Payment{}, errors.New(ErrReservationNotPayable)
+	}
+
+	latest, err := ps.paymentsRepository.GetLatestByReservationID(ctx, reservationID)
+	if err != nil && err.Error() != ErrPaymentNotFound {
+		return domain.Payment{}, err
+	}
+	if err == nil && latest.Status != statuses.FAILED && latest.Status != statuses.CANCELED {
+		return domain.Payment{}, errors.New(ErrPaymentInProgress)
+	}
+
+	quote := reservation.Quote()
+	authorization, err := ps.paymentGateway.Authorize(ctx, quote.Total, paymentMethod)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	now := time.Now().UTC()
+	payment := domain.Payment{
+		ID:             uuid.New(),
+		ReservationID:  reservationID,
+		Reference:      authorization.Reference,
+		Amount:         quote.Total,
+		CapturedAmount: domain.Money{Currency: reservation.Currency},
+		RefundedAmount: domain.Money{Currency: reservation.Currency},
+		TaxAmount:      quote.TaxTotal,
+		Status:         authorization.Status,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := ps.paymentsRepository.Insert(ctx, payment); err != nil {
+		// the hold is released so the user is not left with an amount nobody will capture
+		if payment.Status != statuses.FAILED {
+			ps.paymentGateway.Void(ctx, payment.Reference)
+		}
+
+		return domain.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+// Charges the authorized amount of the reservation payment
+func (ps Payments) Capture(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	payment, err := ps.paymentsRepository.GetLatestByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	if err := checkPaymentTransition(payment.Status, statuses.PAID); err != nil {
+		return domain.Payment{}, err
+	}
+
+	captured := payment
+	captured.Status = statuses.PAID
+	captured.CapturedAmount = payment.Amount
+
+	return ps.settlePayment(ctx, payment, statuses.CAPTURING, captured, chargeEntries(captured), func() error {
+		return ps.paymentGateway.Capture(ctx, payment.Reference, payment.Amount)
+	})
+}
+
+// Releases the authorization of the reservation payment without charging it
+func (ps Payments) Void(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	canceled := constants.Values().PAYMENT_STATUSES.CANCELED
+
+	payment, err := ps.paymentsRepository.GetLatestByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	if err := checkPaymentTransition(payment.Status, canceled); err != nil {
+		return domain.Payment{}, err
+	}
+
+	if err := ps.paymentGateway.Void(ctx, payment.Reference); err != nil {
+		return domain.Payment{}, err
+	}
+
+	voided := payment
+	voided.Status = canceled
+	voided.UpdatedAt = time.Now().UTC()
+	// no money was moved yet, so voiding leaves nothing in the ledger
+	if err := ps.paymentsRepository.Update(ctx, voided, payment, nil); err != nil {
+		return domain.Payment{}, err
+	}
+
+	return voided, nil
+}
+
+// Gives back part of the captured amount of the reservation payment, an amount
+// in its currency, or all that is left of it when amount is zero. The payment
+// is refunded once the whole captured amount was given back.
+func (ps Payments) Refund(ctx context.Context, reservationID uuid.UUID, amount float64) (domain.Payment, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	payment, err := ps.paymentsRepository.GetLatestByReservationID(ctx, reservationID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+	if err := checkPaymentTransition(payment.Status, statuses.REFUNDED); err != nil {
+		return domain.Payment{}, err
+	}
+
+	left := payment.CapturedAmount.Sub(payment.RefundedAmount)
+	refund := domain.NewMoney(amount, payment.Amount.Currency)
+	if refund.IsZero() {
+		refund = left
+	}
+	if refund.GreaterThan(left) {
+		return domain.Payment{}, fmt.Errorf("%s (%s left)", ErrRefundExceedsCaptured, left)
+	}
+
+	refunded := payment
+	refunded.RefundedAmount = payment.RefundedAmount.Add(refund)
+	if refunded.RefundedAmount == refunded.CapturedAmount {
+		refunded.Status = statuses.REFUNDED
+	}
+
+	return ps.settlePayment(ctx, payment, statuses.REFUNDING, refunded, refundEntries(refunded, refund), func() error {
+		return ps.paymentGateway.Refund(ctx, payment.Reference, refund)
+	})
+}
+
+// Gets the latest payment of the reservation
+func (ps Payments) Get(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	return ps.paymentsRepository.GetLatestByReservationID(ctx, reservationID)
+}
+
+// Applies an event sent by the gateway to the webhook. Events already applied
+// are ignored, as gateways may deliver an event more than once. Captures can
+// not charge more than the authorized amount.
+func (ps Payments) HandleEvent(ctx context.Context, payload []byte, signature string) error {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	event, err := ps.paymentGateway.ParseEvent(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	payment, err := ps.paymentsRepository.GetByReference(ctx, event.Reference)
+	if err != nil {
+		return err
+	}
+	if payment.Status == event.Status {
+		return nil
+	}
+	if err := checkPaymentTransition(payment.Status, event.Status); err != nil {
+		return err
+	}
+
+	previous := payment
+	var entries []domain.LedgerEntry
+	switch event.Status {
+	case statuses.PAID:
+		payment.CapturedAmount = payment.Amount
+		if !event.Amount.IsZero() {
+			if event.Amount.Currency != payment.Amount.Currency {
+				return errors.New(ErrPaymentCurrencyMismatch)
+			}
+			if event.Amount.GreaterThan(payment.Amount) {
+				return errors.New(ErrCaptureExceedsAuthorized)
+			}
+			payment.CapturedAmount = event.Amount
+		}
+		entries = chargeEntries(payment)
+	case statuses.REFUNDED:
+		entries = refundEntries(payment, payment.CapturedAmount.Sub(payment.RefundedAmount))
+		payment.RefundedAmount = payment.CapturedAmount
+	}
+	payment.Status = event.Status
+	payment.UpdatedAt = time.Now().UTC()
+
+	return ps.paymentsRepository.Update(ctx, payment, previous, entries)
+}
+
+// Settles the payment in the gateway. The settlement is recorded before calling
+// the gateway, so the payment can not be captured or refunded twice at the same
+// time, and it goes back to its status if the gateway fails. If the gateway
+// settled it but it can not be marked as settled, it stays capturing or
+// refunding to be reconciled with the gateway.
+func (ps Payments) settlePayment(ctx context.Context, payment domain.Payment, settlingStatus string, settled domain.Payment,
+	entries []domain.LedgerEntry, settle func() error) (domain.Payment, error) {
+	settling := payment
+	settling.Status = settlingStatus
+	settling.UpdatedAt = time.Now().UTC()
+	if err := ps.paymentsRepository.Update(ctx, settling, payment, nil); err != nil {
+		return domain.Payment{}, err
+	}
+
+	if err := settle(); err != nil {
+		// no money was moved, so the payment goes back to its status
+		ps.paymentsRepository.Update(ctx, payment, settling, nil)
+
+		return domain.Payment{}, err
+	}
+
+	settled.UpdatedAt = time.Now().UTC()
+	if err := ps.paymentsRepository.Update(ctx, settled, settling, entries); err != nil {
+		return domain.Payment{}, err
+	}
+
+	return settled, nil
+}
+
+// Checks the payment can move between the statuses. Pending payments wait for
+// the gateway to authorize or decline them, authorized ones can be captured or
+// voided, and captured ones refunded. Payments being captured or refunded can
+// not move until the gateway answered.
+func checkPaymentTransition(from string, to string) error {
+	statuses := constants.Values().PAYMENT_STATUSES
+	transitions := map[string][]string{
+		statuses.PENDING:    {statuses.AUTHORIZED, statuses.FAILED, statuses.CANCELED},
+		statuses.AUTHORIZED: {statuses.PAID, statuses.FAILED, statuses.CANCELED},
+		statuses.PAID:       {statuses.REFUNDED},
+	}
+
+	if !utils.IsInSlice(transitions[from], to) {
+		return fmt.Errorf("%s (%s to %s)", ErrInvalidPaymentTransition, from, to)
+	}
+
+	return nil
+}
@@ -0,0 +1,488 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type paymentsDependencies struct {
+	paymentsRepository     *mocks.MockPaymentsRepo
+	reservationsRepository *mocks.MockReservationsRepo
+	paymentGateway         *mocks.MockPaymentGateway
+}
+
+func NewPaymentsDependencies(paymentsRepo *mocks.MockPaymentsRepo, reservationsRepo *mocks.MockReservationsRepo, paymentGateway *mocks.MockPaymentGateway) *paymentsDependencies {
+	return &paymentsDependencies{
+		paymentsRepository:     paymentsRepo,
+		reservationsRepository: reservationsRepo,
+		paymentGateway:         paymentGateway,
+	}
+}
+
+func newPaymentsService(t *testing.T, setMocks func(*paymentsDependencies)) Payments {
+	mockCtlr := gomock.NewController(t)
+	paymentsRepo := mocks.NewMockPaymentsRepo(mockCtlr)
+	reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+	paymentGateway := mocks.NewMockPaymentGateway(mockCtlr)
+	setMocks(NewPaymentsDependencies(paymentsRepo, reservationsRepo, paymentGateway))
+
+	return NewPayments(paymentsRepo, reservationsRepo, paymentGateway)
+}
+
+func TestPaymentsAuthorize(t *testing.T) {
+	initConstantsFromServices(t)
+	reservation := domain.Reservation{
+		ID:             uuid.New(),
+		CarID:          uuid.New(),
+		Status:         "Reserved",
+		PaymentStatus:  "Pending",
+		RentalCost:     usd(400),
+		AddOnsCost:     usd(30),
+		ProtectionCost: usd(70),
+		Currency:       "USD",
+	}
+	canceledReservation := reservation
+	canceledReservation.Status = "Canceled"
+	authorization := domain.PaymentAuthorization{Reference: "fake_000001", Status: "Authorized"}
+
+	type wants struct {
+		status string
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "authorizes the total of the quote in the currency of the reservation",
+			wants: wants{
+				status: "Authorized",
+				err:    nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), reservation.ID).Return(domain.Payment{}, errors.New(ErrPaymentNotFound))
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(500), "tok_visa").Return(authorization, nil)
+				d.paymentsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, dp domain.Payment) error {
+					assert.Equal(t, reservation.ID, dp.ReservationID)
+					assert.Equal(t, authorization.Reference, dp.Reference)
+					assert.Equal(t, usd(500), dp.Amount)
+					assert.NotEqual(t, uuid.Nil, dp.ID)
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "starts a new payment when the previous one was voided",
+			wants: wants{
+				status: "Authorized",
+				err:    nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), reservation.ID).Return(domain.Payment{Status: "Canceled"}, nil)
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(500), "tok_visa").Return(authorization, nil)
+				d.paymentsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the reservation already has a payment in progress",
+			wants: wants{
+				err: errors.New(ErrPaymentInProgress),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), reservation.ID).Return(domain.Payment{Status: "Authorized"}, nil)
+			},
+		},
+		{
+			name: "returns an error when the reservation is not reserved",
+			wants: wants{
+				err: errors.New(ErrReservationNotPayable),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(canceledReservation, nil)
+			},
+		},
+		{
+			name: "returns an error when the gateway rejects the payment method",
+			wants: wants{
+				err: errors.New(ErrPaymentGatewayRejected),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), reservation.ID).Return(domain.Payment{}, errors.New(ErrPaymentNotFound))
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(500), "tok_visa").Return(domain.PaymentAuthorization{}, errors.New(ErrPaymentGatewayRejected))
+			},
+		},
+		{
+			name: "voids the authorization when the payment can not be stored",
+			wants: wants{
+				err: errors.New(ErrPaymentInProgress),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), reservation.ID).Return(domain.Payment{}, errors.New(ErrPaymentNotFound))
+				d.paymentGateway.EXPECT().Authorize(gomock.Any(), usd(500), "tok_visa").Return(authorization, nil)
+				d.paymentsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrPaymentInProgress))
+				d.paymentGateway.EXPECT().Void(gomock.Any(), authorization.Reference).Return(nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paymentsService := newPaymentsService(t, test.setMocks)
+			payment, err := paymentsService.Authorize(context.TODO(), reservation.ID, "tok_visa")
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.wants.status, payment.Status)
+				assert.Equal(t, "USD", payment.Amount.Currency)
+			}
+		})
+	}
+}
+
+func TestPaymentsCapture(t *testing.T) {
+	initConstantsFromServices(t)
+	authorized := domain.Payment{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000001",
+		Amount:        usd(500),
+		Status:        "Authorized",
+	}
+	pending := authorized
+	pending.Status = "Pending"
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "captures the authorized amount",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), authorized.ReservationID).Return(authorized, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Capturing"), authorized, nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), authorized.Reference, usd(500)).Return(nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), paymentWithStatus("Capturing"), gomock.Any()).DoAndReturn(func(_ context.Context, dp domain.Payment, _ domain.Payment, entries []domain.LedgerEntry) error {
+					assert.Equal(t, "Paid", dp.Status)
+					assert.Equal(t, usd(500), dp.CapturedAmount)
+					assertLedgerBalanced(t, entries)
+					assert.Equal(t, usd(500), ledgerBalance(dp.ReservationID, entries).Accounts["Customer"])
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "returns an error when the payment was not authorized yet",
+			wants: wants{
+				err: fmt.Errorf("%s (Pending to Paid)", ErrInvalidPaymentTransition),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), authorized.ReservationID).Return(pending, nil)
+			},
+		},
+		{
+			name: "returns an error without calling the gateway when the payment changed before it was captured",
+			wants: wants{
+				err: errors.New(ErrPaymentStatusChanged),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), authorized.ReservationID).Return(authorized, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Capturing"), authorized, nil).Return(errors.New(ErrPaymentStatusChanged))
+			},
+		},
+		{
+			name: "authorizes the payment again when the gateway rejects the capture",
+			wants: wants{
+				err: errors.New(ErrPaymentGatewayRejected),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), authorized.ReservationID).Return(authorized, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Capturing"), authorized, nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), authorized.Reference, usd(500)).Return(errors.New(ErrPaymentGatewayRejected))
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), authorized, paymentWithStatus("Capturing"), nil).Return(nil)
+			},
+		},
+		{
+			name: "returns an error and leaves the payment capturing when it can not be marked as paid",
+			wants: wants{
+				err: errors.New("connection refused"),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), authorized.ReservationID).Return(authorized, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Capturing"), authorized, nil).Return(nil)
+				d.paymentGateway.EXPECT().Capture(gomock.Any(), authorized.Reference, usd(500)).Return(nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Paid"), paymentWithStatus("Capturing"), gomock.Any()).Return(errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paymentsService := newPaymentsService(t, test.setMocks)
+			_, err := paymentsService.Capture(context.TODO(), authorized.ReservationID)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestPaymentsRefund(t *testing.T) {
+	initConstantsFromServices(t)
+	paid := domain.Payment{
+		ID:             uuid.New(),
+		ReservationID:  uuid.New(),
+		Reference:      "fake_000001",
+		Amount:         usd(500),
+		CapturedAmount: usd(500),
+		RefundedAmount: usd(420),
+		Status:         "Paid",
+	}
+
+	type args struct {
+		amount float64
+	}
+	type wants struct {
+		refunded domain.Money
+		status   string
+		err      error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "refunds part of the captured amount",
+			args: args{
+				amount: 30,
+			},
+			wants: wants{
+				refunded: usd(450),
+				status:   "Paid",
+				err:      nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), paid.ReservationID).Return(paid, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Refunding"), paid, nil).Return(nil)
+				d.paymentGateway.EXPECT().Refund(gomock.Any(), paid.Reference, usd(30)).Return(nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), paymentWithStatus("Refunding"), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "refunds all that is left when no amount is given",
+			args: args{
+				amount: 0,
+			},
+			wants: wants{
+				refunded: usd(500),
+				status:   "Refunded",
+				err:      nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), paid.ReservationID).Return(paid, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Refunding"), paid, nil).Return(nil)
+				d.paymentGateway.EXPECT().Refund(gomock.Any(), paid.Reference, usd(80)).Return(nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), paymentWithStatus("Refunding"), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error without calling the gateway when another refund of the payment is in progress",
+			args: args{
+				amount: 30,
+			},
+			wants: wants{
+				err: errors.New(ErrPaymentStatusChanged),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), paid.ReservationID).Return(paid, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), paymentWithStatus("Refunding"), paid, nil).Return(errors.New(ErrPaymentStatusChanged))
+			},
+		},
+		{
+			name: "returns an error when the refund exceeds the captured amount left",
+			args: args{
+				amount: 80.01,
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (80.00 USD left)", ErrRefundExceedsCaptured),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsRepository.EXPECT().GetLatestByReservationID(gomock.Any(), paid.ReservationID).Return(paid, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paymentsService := newPaymentsService(t, test.setMocks)
+			payment, err := paymentsService.Refund(context.TODO(), paid.ReservationID, test.args.amount)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, test.wants.refunded, payment.RefundedAmount)
+				assert.Equal(t, test.wants.status, payment.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentsHandleEvent(t *testing.T) {
+	initConstantsFromServices(t)
+	payload := []byte(`{"type":"payment.authorized","reference":"fake_000001","amount":500}`)
+	pending := domain.Payment{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000001",
+		Amount:        usd(500),
+		Status:        "Pending",
+	}
+	canceled := pending
+	canceled.Status = "Canceled"
+	authorizedEvent := domain.PaymentEvent{Reference: pending.Reference, Status: "Authorized", Amount: usd(500)}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "moves the payment to the status of the event",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(authorizedEvent, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(pending, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), pending, gomock.Any()).DoAndReturn(func(_ context.Context, dp domain.Payment, _ domain.Payment, entries []domain.LedgerEntry) error {
+					assert.Equal(t, "Authorized", dp.Status)
+					assert.Empty(t, entries)
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "records the charge and the discount when less than authorized was captured",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				authorized := pending
+				authorized.Status = "Authorized"
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(domain.PaymentEvent{Reference: pending.Reference, Status: "Paid", Amount: usd(450)}, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(authorized, nil)
+				d.paymentsRepository.EXPECT().Update(gomock.Any(), gomock.Any(), authorized, gomock.Any()).DoAndReturn(func(_ context.Context, dp domain.Payment, _ domain.Payment, entries []domain.LedgerEntry) error {
+					assert.Equal(t, usd(450), dp.CapturedAmount)
+					assertLedgerBalanced(t, entries)
+					assert.Len(t, entries, 4)
+					assert.Equal(t, usd(450), ledgerBalance(dp.ReservationID, entries).Accounts["Customer"])
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "returns an error when more than authorized was captured",
+			wants: wants{
+				err: errors.New(ErrCaptureExceedsAuthorized),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				authorized := pending
+				authorized.Status = "Authorized"
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(domain.PaymentEvent{Reference: pending.Reference, Status: "Paid", Amount: usd(550)}, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(authorized, nil)
+			},
+		},
+		{
+			name: "returns an error when the capture is in another currency",
+			wants: wants{
+				err: errors.New(ErrPaymentCurrencyMismatch),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				authorized := pending
+				authorized.Status = "Authorized"
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(domain.PaymentEvent{Reference: pending.Reference, Status: "Paid", Amount: domain.NewMoney(450, "EUR")}, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(authorized, nil)
+			},
+		},
+		{
+			name: "ignores an event that was already applied",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				applied := pending
+				applied.Status = "Authorized"
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(authorizedEvent, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(applied, nil)
+			},
+		},
+		{
+			name: "returns an error when the payment can not move to the status of the event",
+			wants: wants{
+				err: fmt.Errorf("%s (Canceled to Authorized)", ErrInvalidPaymentTransition),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(authorizedEvent, nil)
+				d.paymentsRepository.EXPECT().GetByReference(gomock.Any(), pending.Reference).Return(canceled, nil)
+			},
+		},
+		{
+			name: "returns an error when the signature is not valid",
+			wants: wants{
+				err: errors.New(ErrInvalidPaymentSignature),
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentGateway.EXPECT().ParseEvent(payload, "signature").Return(domain.PaymentEvent{}, errors.New(ErrInvalidPaymentSignature))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paymentsService := newPaymentsService(t, test.setMocks)
+			err := paymentsService.HandleEvent(context.TODO(), payload, "signature")
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+// Matches the payments with a status
+type paymentWithStatus string
+
+func (m paymentWithStatus) Matches(x interface{}) bool {
+	payment, ok := x.(domain.Payment)
+
+	return ok && payment.Status == string(m)
+}
+
+func (m paymentWithStatus) String() string {
+	return "is a payment with status " + string(m)
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrProtectionPlanNotFound   = "protection plan not found"
+	ErrProtectionPlanExists     = "car type already has a plan of that protection level"
+	ErrProtectionPlanNotOffered = "protection level is not offered for the car type"
+)
+
+type ProtectionPlans struct {
+	protectionPlansRepository ports.ProtectionPlansRepo
+}
+
+func NewProtectionPlans(ppr ports.ProtectionPlansRepo) ProtectionPlans {
+	return ProtectionPlans{
+		protectionPlansRepository: ppr,
+	}
+}
+
+func (pps ProtectionPlans) Register(ctx context.Context, plan domain.ProtectionPlan) (domain.ProtectionPlan, error) {
+	plan.ID = uuid.New()
+
+	if err := pps.protectionPlansRepository.Insert(ctx, plan); err != nil {
+		return domain.ProtectionPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (pps ProtectionPlans) Get(ctx context.Context, ID uuid.UUID) (domain.ProtectionPlan, error) {
+	return pps.protectionPlansRepository.Get(ctx, ID)
+}
+
+// Updates a plan. Reservations already booked keep the terms they were booked with.
+func (pps ProtectionPlans) FullUpdate(ctx context.Context, plan domain.ProtectionPlan) error {
+	return pps.protectionPlansRepository.FullUpdate(ctx, plan)
+}
+
+func (pps ProtectionPlans) Delete(ctx context.Context, ID uuid.UUID) error {
+	return pps.protectionPlansRepository.Delete(ctx, ID)
+}
+
+func (pps ProtectionPlans) List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error) {
+	return pps.protectionPlansRepository.List(ctx, carType)
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type protectionPlansDependencies struct {
+	protectionPlansRepository *mocks.MockProtectionPlansRepo
+}
+
+func NewProtectionPlansDependencies(protectionPlansRepo *mocks.MockProtectionPlansRepo) *protectionPlansDependencies {
+	return &protectionPlansDependencies{
+		protectionPlansRepository: protectionPlansRepo,
+	}
+}
+
+func TestProtectionPlansRegister(t *testing.T) {
+	plan := domain.ProtectionPlan{
+		Level:      "Standard",
+		CarType:    "Sedan",
+		DailyPrice: 15,
+		Deductible: 500,
+	}
+
+	type args struct {
+		plan domain.ProtectionPlan
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*protectionPlansDependencies)
+	}{
+		{
+			name: "returns nil error when the protection plan was registered",
+			args: args{
+				plan: plan,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the car type already has a plan of the same level",
+			args: args{
+				plan: plan,
+			},
+			wants: wants{
+				err: errors.New(ErrProtectionPlanExists),
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrProtectionPlanExists))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			d := NewProtectionPlansDependencies(protectionPlansRepo)
+			test.setMocks(d)
+
+			protectionPlansService := NewProtectionPlans(protectionPlansRepo)
+			dpp, err := protectionPlansService.Register(context.TODO(), test.args.plan)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, dpp.ID)
+				assert.Equal(t, test.args.plan.Level, dpp.Level)
+			}
+		})
+	}
+}
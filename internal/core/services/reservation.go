@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
@@ -17,29 +18,97 @@ var (
 	ErrReservationNotFound         = "reservation was not found"
 	ErrInvalidReservationTimeFrame = "reservation time frame is invalid"
 	ErrMinimumReservationHours     = "period is shorter than minimun allowed"
+	ErrCarNotAtPickupBranch        = "car will not be at the pickup branch when the reservation starts"
+	ErrReturnBranchConflict        = "car must be returned at the pickup branch of its next reservation"
+	ErrCarWithoutBranch            = "pickup and return branches can not be chosen for cars without a branch"
+	ErrCarInTransfer               = "car is transferred to another city before the end of the requested time frame"
+	ErrDriverDataMissing           = "user must register their date of birth and driver license to book"
+	ErrDriverTooYoung              = "driver is younger than the minimum age for the car type"
+	ErrDriverLicenseTooRecent      = "driver license is more recent than the minimum required for the car type"
+	ErrDriverLicenseExpires        = "driver license expires before the end of the reservation"
+	ErrInvalidAdditionalDrivers    = "additional drivers must be distinct users other than the renter"
+	ErrTooManyAdditionalDrivers    = "reservation has more additional drivers than allowed"
+	ErrAdditionalDriverInactive    = "additional driver is not active"
+	ErrReservationNotExtendable    = "only reserved reservations can be extended"
+	ErrInvalidExtensionEndDate     = "extension must end after the reservation and in the future"
+	ErrReservationChanged          = "reservation changed while it was being extended"
+	ErrReservationAlreadyPaid      = "reservations with an authorized or captured payment can not be extended"
 )
 
 type Reservations struct {
-	reservationsRepository ports.ReservationsRepo
+	reservationsRepository    ports.ReservationsRepo
+	maintenancesRepository    ports.MaintenancesRepo
+	citiesRepository          ports.CitiesRepo
+	branchesRepository        ports.BranchesRepo
+	oneWayFeesRepository      ports.OneWayFeesRepo
+	transfersRepository       ports.TransfersRepo
+	usersRepository           ports.UsersRepo
+	carsRepository            ports.CarsRepo
+	addOnsRepository          ports.AddOnsRepo
+	protectionPlansRepository ports.ProtectionPlansRepo
+	taxRulesRepository        ports.TaxRulesRepo
+	exchangeRatesRepository   ports.ExchangeRatesRepo
 }
 
-func NewReservations(rr ports.ReservationsRepo) Reservations {
+func NewReservations(rr ports.ReservationsRepo, mr ports.MaintenancesRepo, cr ports.CitiesRepo, br ports.BranchesRepo, owfr ports.OneWayFeesRepo, tr ports.TransfersRepo, ur ports.UsersRepo, carr ports.CarsRepo, aor ports.AddOnsRepo, ppr ports.ProtectionPlansRepo, trr ports.TaxRulesRepo, xrr ports.ExchangeRatesRepo) Reservations {
 	return Reservations{
-		reservationsRepository: rr,
+		reservationsRepository:    rr,
+		maintenancesRepository:    mr,
+		citiesRepository:          cr,
+		branchesRepository:        br,
+		oneWayFeesRepository:      owfr,
+		transfersRepository:       tr,
+		usersRepository:           ur,
+		carsRepository:            carr,
+		addOnsRepository:          aor,
+		protectionPlansRepository: ppr,
+		taxRulesRepository:        trr,
+		exchangeRatesRepository:   xrr,
 	}
 }
 
+// Books the reservation. Users must be active, have verified their email and
+// be eligible to drive the car to book, as must be any additional driver.
+// The car must be available. Reservations are booked pending payment, their
+// payment status then follows the payment flow. Cars with a security deposit
+// are booked with the deposit pending until it is held at pickup.
 func (rs Reservations) Book(ctx context.Context, reservation domain.Reservation) (domain.Reservation, error) {
-	if err := rs.CheckReservation(ctx, reservation); err != nil {
+	reservation, city, err := rs.prepare(ctx, reservation)
+	if err != nil {
 		return domain.Reservation{}, err
 	}
 
 	reservation.ID = uuid.New()
+	reservation.PaymentStatus = constants.Values().PAYMENT_STATUSES.PENDING
 	if err := rs.reservationsRepository.Insert(ctx, reservation); err != nil {
 		return domain.Reservation{}, err
 	}
 
-	return reservation, nil
+	return localizedReservation(reservation, city.TimeZone), nil
+}
+
+// Gets the price breakdown the reservation would be booked with, in the
+// currency of the city of the car. When a display currency other than that
+// one is given, the quote is also converted to it to be shown to the customer.
+func (rs Reservations) Quote(ctx context.Context, reservation domain.Reservation, displayCurrency string) (domain.Quote, error) {
+	reservation, city, err := rs.prepare(ctx, reservation)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	quote := reservation.Quote()
+	if displayCurrency == "" || displayCurrency == city.Currency {
+		return quote, nil
+	}
+
+	rate, err := exchangeRate(ctx, rs.exchangeRatesRepository, city.Currency, displayCurrency)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+	display := quote.In(rate)
+	quote.Display = &display
+
+	return quote, nil
 }
 
 func (rs Reservations) Get(ctx context.Context, ID uuid.UUID) (domain.Reservation, error) {
@@ -48,11 +117,17 @@ func (rs Reservations) Get(ctx context.Context, ID uuid.UUID) (domain.Reservatio
 		return domain.Reservation{}, err
 	}
 
-	return dc, nil
+	reservations, err := rs.localize(ctx, []domain.Reservation{dc})
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+
+	return reservations[0], nil
 }
 
 func (rs Reservations) FullUpdate(ctx context.Context, reservation domain.Reservation) error {
-	if err := rs.CheckReservation(ctx, reservation); err != nil {
+	reservation, _, err := rs.prepare(ctx, reservation)
+	if err != nil {
 		return err
 	}
 
@@ -64,8 +139,9 @@ func (rs Reservations) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (rs Reservations) List(ctx context.Context, fromReservationID string, startDate time.Time, endDate time.Time) ([]domain.Reservation, error) {
+	values := constants.Values()
 	if fromReservationID == "" {
-		fromReservationID = constants.Values.NULL_UUID
+		fromReservationID = values.NULL_UUID
 	}
 	if startDate.IsZero() {
 		startDate = time.Now()
@@ -73,12 +149,12 @@ func (rs Reservations) List(ctx context.Context, fromReservationID string, start
 	if endDate.IsZero() {
 		endDate = time.Now().Add(7 * 24 * time.Hour)
 	}
-	reservations, err := rs.reservationsRepository.List(ctx, fromReservationID, startDate, endDate, constants.Values.RESERVATIONS_PER_PAGE)
+	reservations, err := rs.reservationsRepository.List(ctx, fromReservationID, startDate, endDate, values.RESERVATIONS_PER_PAGE)
 	if err != nil {
 		return []domain.Reservation{}, err
 	}
 
-	return reservations, nil
+	return rs.localize(ctx, reservations)
 }
 
 func (rs Reservations) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Reservation, error) {
@@ -87,7 +163,7 @@ func (rs Reservations) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domai
 		return nil, err
 	}
 
-	return drs, nil
+	return rs.localize(ctx, drs)
 }
 
 func (rs Reservations) GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
@@ -96,39 +172,728 @@ func (rs Reservations) GetByUserID(ctx context.Context, userID uuid.UUID) ([]dom
 		return nil, err
 	}
 
-	return drs, nil
+	return rs.localize(ctx, drs)
+}
+
+// Extends the reservation until endDate. Only the extra time is checked: the
+// car must not be reserved, in maintenance or transferred away between the
+// current end of the reservation and endDate, the drivers must still be
+// eligible and the return branch must be open at the new end. When the car is
+// not available the extension is not applied, and the earliest reservation in
+// the way and the latest end the reservation can be extended to are returned
+// instead. The extension costs what pricing the reservation until endDate
+// adds to pricing it until its current end, with the protection terms of the
+// reservation, and that is added to the prices of the reservation. Reservations
+// whose payment was already authorized or captured can not be extended.
+func (rs Reservations) Extend(ctx context.Context, reservationID uuid.UUID, endDate time.Time) (domain.Extension, error) {
+	reservation, err := rs.reservationsRepository.Get(ctx, reservationID)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+	if reservation.Status != constants.Values().RESERVATION_STATUSES.RESERVED {
+		return domain.Extension{}, errors.New(ErrReservationNotExtendable)
+	}
+	// the cost of the extension is only collected by the payment of the reservation
+	paymentStatuses := constants.Values().PAYMENT_STATUSES
+	if !utils.IsInSlice([]string{paymentStatuses.PENDING, paymentStatuses.FAILED, paymentStatuses.CANCELED}, reservation.PaymentStatus) {
+		return domain.Extension{}, errors.New(ErrReservationAlreadyPaid)
+	}
+	if !endDate.After(reservation.EndDate) || !endDate.After(time.Now()) {
+		return domain.Extension{}, errors.New(ErrInvalidExtensionEndDate)
+	}
+
+	city, err := rs.citiesRepository.GetByCarID(ctx, reservation.CarID)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+	location, err := LoadCityLocation(city.TimeZone)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+
+	extension := domain.Extension{
+		ReservationID:   reservation.ID,
+		PreviousEndDate: reservation.EndDate.In(location),
+		EndDate:         endDate.In(location),
+		Cost:            domain.Money{Currency: reservation.Currency},
+	}
+
+	maximumEndDate, conflicting, err := rs.extensionLimit(ctx, reservation, endDate)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+	if maximumEndDate != nil {
+		localEndDate := maximumEndDate.In(location)
+		extension.MaximumEndDate = &localEndDate
+		if conflicting != nil {
+			localized := localizedReservation(*conflicting, city.TimeZone)
+			extension.ConflictingReservation = &localized
+		}
+
+		return extension, nil
+	}
+
+	extended := reservation
+	extended.EndDate = endDate
+	car, err := rs.checkExtension(ctx, extended, city)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+
+	// add-ons already have their units booked until the current end
+	extraTime := extended
+	extraTime.StartDate = reservation.EndDate
+	addOns, err := rs.checkAddOns(ctx, extraTime)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+
+	taxRules, err := rs.taxRulesRepository.ListByCityID(ctx, city.ID)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+
+	prices, err := rs.unitPrices(ctx, extended, car, addOns)
+	if err != nil {
+		return domain.Extension{}, err
+	}
+
+	// both periods are priced at the current prices, so the hours and days
+	// already started are not charged again and what was charged stays as it was
+	original := taxedReservation(pricedReservation(reservation, prices, addOns, reservation.Protection, location), taxRules)
+	full := taxedReservation(pricedReservation(extended, prices, addOns, reservation.Protection, location), taxRules)
+	added := addedPrices(full, original)
+	extended = extendedReservation(reservation, added)
+	if err := rs.reservationsRepository.Extend(ctx, extended, reservation.EndDate); err != nil {
+		return domain.Extension{}, err
+	}
+
+	extension.Applied = true
+	extension.Cost = added.Quote().Total
+	localized := localizedReservation(extended, city.TimeZone)
+	extension.Reservation = &localized
+
+	return extension, nil
 }
 
 func (rs Reservations) CheckReservation(ctx context.Context, reservation domain.Reservation) error {
+	_, _, err := rs.checkReservation(ctx, reservation)
+
+	return err
+}
+
+// Checks the drivers, the car, the reserved period, the add-ons and the
+// protection plan, and prices the reservation along with the taxes of the
+// city of the car. Returns the reservation with its
+// route and prices resolved and the city where the car is located.
+func (rs Reservations) prepare(ctx context.Context, reservation domain.Reservation) (domain.Reservation, domain.City, error) {
+	car, err := rs.checkDrivers(ctx, reservation)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	reservation, city, err := rs.checkReservation(ctx, reservation)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	addOns, err := rs.checkAddOns(ctx, reservation)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	plan, err := rs.protectionPlan(ctx, reservation, car)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	location, err := LoadCityLocation(city.TimeZone)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	taxRules, err := rs.taxRulesRepository.ListByCityID(ctx, city.ID)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	// the prices are kept in the currency of the city the car is rented in
+	reservation.Currency = city.Currency
+	prices, err := rs.unitPrices(ctx, reservation, car, addOns)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	// the terms of the plan are copied so later changes to it do not alter the reservation
+	protection := domain.ReservationProtection{Level: plan.Level}
+	if protection.DailyPrice, err = priceIn(ctx, rs.exchangeRatesRepository, domain.NewMoney(plan.DailyPrice, plan.Currency), city.Currency); err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+	if protection.Deductible, err = priceIn(ctx, rs.exchangeRatesRepository, domain.NewMoney(plan.Deductible, plan.Currency), city.Currency); err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+	reservation = taxedReservation(pricedReservation(reservation, prices, addOns, protection, location), taxRules)
+	// the deposit is held at pickup, until then it is only marked as pending
+	reservation.DepositStatus = depositStatusFor(car.Type)
+
+	return reservation, city, nil
+}
+
+// Checks the renter is active, has verified their email and is eligible to
+// drive the car, which must be available. Additional drivers must be active
+// and eligible too. Returns the car.
+func (rs Reservations) checkDrivers(ctx context.Context, reservation domain.Reservation) (domain.Car, error) {
+	values := constants.Values()
+	user, err := rs.usersRepository.Get(ctx, reservation.UserID)
+	if err != nil {
+		return domain.Car{}, err
+	}
+	if user.Status != values.USER_STATUSES.ACTIVE {
+		return domain.Car{}, errors.New(ErrUserInactive)
+	}
+	if user.EmailVerifiedAt == nil {
+		return domain.Car{}, errors.New(ErrUserEmailNotVerified)
+	}
+
+	car, err := rs.carsRepository.Get(ctx, reservation.CarID)
+	if err != nil {
+		return domain.Car{}, err
+	}
+	if car.Status != values.CAR_STATUSES.AVAILABLE {
+		return domain.Car{}, errors.New(ErrCarUnavailable)
+	}
+	if err := checkDriverEligibility(user, car.Type, reservation); err != nil {
+		return domain.Car{}, err
+	}
+
+	if len(reservation.AdditionalDriverIDs) > int(values.MAXIMUM_ADDITIONAL_DRIVERS) {
+		return domain.Car{}, fmt.Errorf("%s (%d drivers)", ErrTooManyAdditionalDrivers, values.MAXIMUM_ADDITIONAL_DRIVERS)
+	}
+	for i, driverID := range reservation.AdditionalDriverIDs {
+		if driverID == reservation.UserID || utils.IsInSlice(reservation.AdditionalDriverIDs[:i], driverID) {
+			return domain.Car{}, errors.New(ErrInvalidAdditionalDrivers)
+		}
+
+		driver, err := rs.usersRepository.Get(ctx, driverID)
+		if err != nil {
+			return domain.Car{}, err
+		}
+		if driver.Status != values.USER_STATUSES.ACTIVE {
+			return domain.Car{}, errors.New(ErrAdditionalDriverInactive)
+		}
+		if err := checkDriverEligibility(driver, car.Type, reservation); err != nil {
+			return domain.Car{}, err
+		}
+	}
+
+	return car, nil
+}
+
+// Checks the add-ons of the reservation are offered at its pickup branch and
+// have enough units left during its time frame. Returns them in the order they
+// were selected.
+func (rs Reservations) checkAddOns(ctx context.Context, reservation domain.Reservation) ([]domain.AddOn, error) {
+	addOns := make([]domain.AddOn, 0, len(reservation.AddOns))
+	for i, selected := range reservation.AddOns {
+		for _, previous := range reservation.AddOns[:i] {
+			if previous.AddOnID == selected.AddOnID {
+				return nil, errors.New(ErrRepeatedAddOn)
+			}
+		}
+
+		addOn, err := rs.addOnsRepository.Get(ctx, selected.AddOnID)
+		if err != nil {
+			return nil, err
+		}
+		if reservation.PickupBranchID == nil || addOn.BranchID != *reservation.PickupBranchID {
+			return nil, errors.New(ErrAddOnNotAtPickupBranch)
+		}
+
+		bookings, err := rs.addOnsRepository.GetBookings(ctx, addOn.ID, reservation.ID, reservation.StartDate, reservation.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if domain.PeakBookedUnits(bookings, reservation.StartDate, reservation.EndDate)+selected.Quantity > addOn.Stock {
+			return nil, fmt.Errorf("%s (%s)", ErrAddOnOutOfStock, addOn.Name)
+		}
+
+		addOns = append(addOns, addOn)
+	}
+
+	return addOns, nil
+}
+
+// Gets the protection plan chosen for the reservation, or the plan of the
+// default protection level when none was chosen
+func (rs Reservations) protectionPlan(ctx context.Context, reservation domain.Reservation, car domain.Car) (domain.ProtectionPlan, error) {
+	level := reservation.Protection.Level
+	if level == "" {
+		level = constants.Values().DEFAULT_PROTECTION_LEVEL
+	}
+
+	return rs.protectionPlansRepository.GetByLevelAndCarType(ctx, level, car.Type)
+}
+
+// Prices of a reservation per unit, in its currency
+type unitPrices struct {
+	hourlyRent     domain.Money
+	driverDailyFee domain.Money
+	// prices of the add-ons selected for the reservation, in the same order
+	addOns []domain.Money
+}
+
+// Gets the prices of the car, the additional drivers and the add-ons in the
+// currency of the reservation, converted with the exchange rates from the
+// currencies they are set in
+func (rs Reservations) unitPrices(ctx context.Context, reservation domain.Reservation, car domain.Car, addOns []domain.AddOn) (prices unitPrices, err error) {
+	if prices.hourlyRent, err = priceIn(ctx, rs.exchangeRatesRepository, domain.NewMoney(car.HourlyRentCost, car.Currency), reservation.Currency); err != nil {
+		return unitPrices{}, err
+	}
+
+	// the fee is only converted when it is charged, so no rate is needed otherwise
+	prices.driverDailyFee = domain.Money{Currency: reservation.Currency}
+	if len(reservation.AdditionalDriverIDs) > 0 {
+		if prices.driverDailyFee, err = feeIn(ctx, rs.exchangeRatesRepository, constants.Values().ADDITIONAL_DRIVER_DAILY_FEE, reservation.Currency); err != nil {
+			return unitPrices{}, err
+		}
+	}
+
+	prices.addOns = make([]domain.Money, len(addOns))
+	for i, addOn := range addOns {
+		if prices.addOns[i], err = priceIn(ctx, rs.exchangeRatesRepository, domain.NewMoney(addOn.Price, addOn.Currency), reservation.Currency); err != nil {
+			return unitPrices{}, err
+		}
+	}
+
+	return prices, nil
+}
+
+// Sets the prices of the reservation in its currency, which the unit prices
+// are converted to. The car is rented by started hours, and each additional
+// driver, add-on priced per day and the protection plan are charged by
+// started days, as shown by the wall clocks of the location of the car.
+// addOns are the ones selected for the reservation, in the same order.
+func pricedReservation(reservation domain.Reservation, prices unitPrices, addOns []domain.AddOn, protection domain.ReservationProtection, location *time.Location) domain.Reservation {
+	values := constants.Values()
+	hours := int64(math.Ceil(utils.WallClockDuration(reservation.StartDate, reservation.EndDate, location).Hours()))
+	days := (hours + 23) / 24
+
+	reservation.RentalCost = prices.hourlyRent.Times(hours)
+	reservation.AdditionalDriversFee = prices.driverDailyFee.Times(days * int64(len(reservation.AdditionalDriverIDs)))
+
+	// the selection is copied so the costs are not set on the caller's reservation
+	reservation.AddOns = append([]domain.ReservationAddOn(nil), reservation.AddOns...)
+	reservation.AddOnsCost = domain.Money{Currency: reservation.Currency}
+	for i, addOn := range addOns {
+		cost := prices.addOns[i].Times(int64(reservation.AddOns[i].Quantity))
+		if addOn.PricingUnit == values.ADD_ON_PRICING_UNITS.PER_DAY {
+			cost = cost.Times(days)
+		}
+
+		reservation.AddOns[i].Cost = cost
+		reservation.AddOnsCost = reservation.AddOnsCost.Add(cost)
+	}
+
+	reservation.Protection = protection
+	reservation.ProtectionCost = protection.DailyPrice.Times(days)
+
+	return reservation
+}
+
+// Gets the prices the extended period adds to the original one, both priced
+// the same way. The one-way fee does not change with the period. Taxes of the
+// same name and rate are subtracted from each other.
+func addedPrices(extended domain.Reservation, original domain.Reservation) domain.Reservation {
+	added := extended
+	added.OneWayFee = domain.Money{Currency: extended.Currency}
+	added.RentalCost = extended.RentalCost.Sub(original.RentalCost)
+	added.AdditionalDriversFee = extended.AdditionalDriversFee.Sub(original.AdditionalDriversFee)
+	added.AddOnsCost = extended.AddOnsCost.Sub(original.AddOnsCost)
+	added.ProtectionCost = extended.ProtectionCost.Sub(original.ProtectionCost)
+	added.TaxTotal = extended.TaxTotal.Sub(original.TaxTotal)
+
+	added.AddOns = append([]domain.ReservationAddOn(nil), extended.AddOns...)
+	for i := range added.AddOns {
+		added.AddOns[i].Cost = extended.AddOns[i].Cost.Sub(original.AddOns[i].Cost)
+	}
+
+	added.Taxes = append([]domain.ReservationTax(nil), extended.Taxes...)
+	for i := range added.Taxes {
+		for _, tax := range original.Taxes {
+			if added.Taxes[i].Name == tax.Name && added.Taxes[i].Rate == tax.Rate {
+				added.Taxes[i].Amount = added.Taxes[i].Amount.Sub(tax.Amount)
+				break
+			}
+		}
+	}
+
+	return added
+}
+
+// Adds the prices the extra time adds, from the current end of the
+// reservation to its new end, to the ones of the reservation. Taxes of the
+// same name and rate are added together.
+func extendedReservation(reservation domain.Reservation, extraTime domain.Reservation) domain.Reservation {
+	extended := reservation
+	extended.EndDate = extraTime.EndDate
+	extended.RentalCost = reservation.RentalCost.Add(extraTime.RentalCost)
+	extended.AdditionalDriversFee = reservation.AdditionalDriversFee.Add(extraTime.AdditionalDriversFee)
+	extended.AddOnsCost = reservation.AddOnsCost.Add(extraTime.AddOnsCost)
+	extended.ProtectionCost = reservation.ProtectionCost.Add(extraTime.ProtectionCost)
+	extended.TaxTotal = reservation.TaxTotal.Add(extraTime.TaxTotal)
+
+	extended.AddOns = append([]domain.ReservationAddOn(nil), reservation.AddOns...)
+	for i := range extended.AddOns {
+		extended.AddOns[i].Cost = extended.AddOns[i].Cost.Add(extraTime.AddOns[i].Cost)
+	}
+
+	extended.Taxes = append([]domain.ReservationTax(nil), reservation.Taxes...)
+	for _, tax := range extraTime.Taxes {
+		found := false
+		for i := range extended.Taxes {
+			if extended.Taxes[i].Name == tax.Name && extended.Taxes[i].Rate == tax.Rate {
+				extended.Taxes[i].Amount = extended.Taxes[i].Amount.Add(tax.Amount)
+				found = true
+				break
+			}
+		}
+		if !found {
+			extended.Taxes = append(extended.Taxes, tax)
+		}
+	}
+
+	return extended
+}
+
+// Checks the reservation against the city where the car is located, whose
+// local time is used to measure the reserved period. Returns the reservation
+// with its route resolved and the city.
+func (rs Reservations) checkReservation(ctx context.Context, reservation domain.Reservation) (domain.Reservation, domain.City, error) {
 	if isValid := utils.IsValidTimeFrame(reservation.StartDate, reservation.EndDate); !isValid {
-		return errors.New(ErrInvalidReservationTimeFrame)
+		return domain.Reservation{}, domain.City{}, errors.New(ErrInvalidReservationTimeFrame)
 	}
 
 	if reservation.StartDate.Before(time.Now()) {
-		return errors.New(ErrInvalidReservationTimeFrame)
+		return domain.Reservation{}, domain.City{}, errors.New(ErrInvalidReservationTimeFrame)
+	}
+
+	city, err := rs.citiesRepository.GetByCarID(ctx, reservation.CarID)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+	location, err := LoadCityLocation(city.TimeZone)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	minimumReservationHours := constants.Values().MINIMUM_RESERVATION_HOURS
+	if utils.WallClockDuration(reservation.StartDate, reservation.EndDate, location).Hours() < float64(minimumReservationHours) {
+		return domain.Reservation{}, domain.City{}, fmt.Errorf("%s (%d hours)", ErrMinimumReservationHours, minimumReservationHours)
 	}
 
-	if reservation.EndDate.Sub(reservation.StartDate).Hours() < float64(constants.Values.MINIMUM_RESERVATION_HOURS) {
-		return fmt.Errorf("%s (%d hours)", ErrMinimumReservationHours, constants.Values.MINIMUM_RESERVATION_HOURS)
+	if reservation, err = rs.checkRoute(ctx, reservation, city); err != nil {
+		return domain.Reservation{}, domain.City{}, err
 	}
 
 	reservations, err := rs.reservationsRepository.GetByCarIDAndTimeFrame(ctx, reservation.CarID, reservation.StartDate, reservation.EndDate)
 	if err != nil {
-		return err
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	// do not take into account the reservation when is being updated nor the canceled ones
+	for _, r := range reservations {
+		if r.ID != reservation.ID && r.Status != constants.Values().RESERVATION_STATUSES.CANCELED {
+			return domain.Reservation{}, domain.City{}, errors.New(ErrCarNotAvailable)
+		}
 	}
 
-	// do not take into account the reservation when is being updated
+	maintenances, err := rs.maintenancesRepository.GetByCarIDAndTimeFrame(ctx, reservation.CarID, reservation.StartDate, reservation.EndDate)
+	if err != nil {
+		return domain.Reservation{}, domain.City{}, err
+	}
+
+	if len(maintenances) > 0 {
+		return domain.Reservation{}, domain.City{}, errors.New(ErrCarInMaintenance)
+	}
+
+	// the car leaves the city at the departure of its pending transfer
+	transfer, err := rs.transfersRepository.GetPendingByCarID(ctx, reservation.CarID)
+	if err != nil && err.Error() != ErrTransferNotFound {
+		return domain.Reservation{}, domain.City{}, err
+	}
+	if err == nil && reservation.EndDate.After(transfer.DepartureDate) {
+		return domain.Reservation{}, domain.City{}, errors.New(ErrCarInTransfer)
+	}
+
+	return reservation, city, nil
+}
+
+// Finds what keeps the car from being kept until endDate: the other
+// reservations and the maintenances overlapping the extra time, and the
+// departure of its pending transfer. Returns the latest end the reservation
+// can be extended to and the earliest reservation in the way, or a nil end
+// when the car is available for the whole extension.
+func (rs Reservations) extensionLimit(ctx context.Context, reservation domain.Reservation, endDate time.Time) (*time.Time, *domain.Reservation, error) {
+	var limit *time.Time
+	limitTo := func(t time.Time) {
+		// the reservation can not be shortened by an extension
+		if t.Before(reservation.EndDate) {
+			t = reservation.EndDate
+		}
+		if limit == nil || t.Before(*limit) {
+			limit = &t
+		}
+	}
+
+	reservations, err := rs.reservationsRepository.GetByCarIDAndTimeFrame(ctx, reservation.CarID, reservation.EndDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// do not take into account the reservation being extended nor the canceled ones
+	var conflicting *domain.Reservation
 	for i, r := range reservations {
-		if r.ID == reservation.ID {
-			reservations = append(reservations[:i], reservations[i+1:]...)
-			break
+		if r.ID == reservation.ID || r.Status == constants.Values().RESERVATION_STATUSES.CANCELED {
+			continue
+		}
+		if conflicting == nil || r.StartDate.Before(conflicting.StartDate) {
+			conflicting = &reservations[i]
 		}
 	}
+	if conflicting != nil {
+		limitTo(conflicting.StartDate)
+	}
 
-	if len(reservations) > 0 {
-		return errors.New(ErrCarNotAvailable)
+	maintenances, err := rs.maintenancesRepository.GetByCarIDAndTimeFrame(ctx, reservation.CarID, reservation.EndDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, maintenance := range maintenances {
+		limitTo(maintenance.StartDate)
+	}
+
+	transfer, err := rs.transfersRepository.GetPendingByCarID(ctx, reservation.CarID)
+	if err != nil && err.Error() != ErrTransferNotFound {
+		return nil, nil, err
+	}
+	if err == nil && endDate.After(transfer.DepartureDate) {
+		limitTo(transfer.DepartureDate)
+	}
+
+	return limit, conflicting, nil
+}
+
+// Checks the renter and the additional drivers are still eligible to drive the
+// car until the new end of the reservation, and that its return branch is open
+// then. Returns the car.
+func (rs Reservations) checkExtension(ctx context.Context, extended domain.Reservation, city domain.City) (domain.Car, error) {
+	car, err := rs.carsRepository.Get(ctx, extended.CarID)
+	if err != nil {
+		return domain.Car{}, err
+	}
+
+	driverIDs := append([]uuid.UUID{extended.UserID}, extended.AdditionalDriverIDs...)
+	for _, driverID := range driverIDs {
+		driver, err := rs.usersRepository.Get(ctx, driverID)
+		if err != nil {
+			return domain.Car{}, err
+		}
+		if err := checkDriverEligibility(driver, car.Type, extended); err != nil {
+			return domain.Car{}, err
+		}
+	}
+
+	if extended.ReturnBranchID == nil {
+		return car, nil
+	}
+
+	returnBranch, err := rs.branchesRepository.Get(ctx, *extended.ReturnBranchID)
+	if err != nil {
+		return domain.Car{}, err
+	}
+	returnLocation, err := rs.branchLocation(ctx, returnBranch, city)
+	if err != nil {
+		return domain.Car{}, err
+	}
+	if !isBranchOpenAt(returnBranch, extended.EndDate.In(returnLocation)) {
+		return domain.Car{}, errors.New(ErrReturnOutsideOpeningHours)
+	}
+
+	return car, nil
+}
+
+// Checks the driver meets the minimum age and license tenure of the car type
+// when the reservation starts, and that their license is valid until it ends
+func checkDriverEligibility(driver domain.User, carType string, reservation domain.Reservation) error {
+	if driver.DateOfBirth == nil || driver.DriverLicense == nil {
+		return errors.New(ErrDriverDataMissing)
+	}
+
+	requirement := constants.Values().DriverRequirementFor(carType)
+	if utils.CompletedYears(*driver.DateOfBirth, reservation.StartDate) < int(requirement.MINIMUM_AGE) {
+		return fmt.Errorf("%s (%d years)", ErrDriverTooYoung, requirement.MINIMUM_AGE)
+	}
+
+	if utils.CompletedYears(driver.DriverLicense.IssueDate, reservation.StartDate) < int(requirement.MINIMUM_LICENSE_YEARS) {
+		return fmt.Errorf("%s (%d years)", ErrDriverLicenseTooRecent, requirement.MINIMUM_LICENSE_YEARS)
+	}
+
+	if driver.DriverLicense.ExpiryDate.Before(reservation.EndDate) {
+		return errors.New(ErrDriverLicenseExpires)
 	}
 
 	return nil
+}
+
+// Resolves the branches where the car is picked up and returned. The car is
+// picked up where its previous reservation leaves it, or at its own branch,
+// and must be returned where its next reservation picks it up. Returning it
+// in another city charges the one-way fee of the route. Pickup and return
+// times must fall inside the opening hours of the branches, in their local
+// time. Cars that are not assigned to a branch are not restricted.
+func (rs Reservations) checkRoute(ctx context.Context, reservation domain.Reservation, city domain.City) (domain.Reservation, error) {
+	carBranch, err := rs.branchesRepository.GetByCarID(ctx, reservation.CarID)
+	if err != nil {
+		if err.Error() != ErrBranchNotFound {
+			return domain.Reservation{}, err
+		}
+		if reservation.PickupBranchID != nil || reservation.ReturnBranchID != nil {
+			return domain.Reservation{}, errors.New(ErrCarWithoutBranch)
+		}
+
+		reservation.OneWayFee = domain.Money{Currency: city.Currency}
+		return reservation, nil
+	}
+
+	pickupBranch := carBranch
+	previous, err := rs.reservationsRepository.GetPreviousByCarID(ctx, reservation.CarID, reservation.ID, time.Now(), reservation.StartDate)
+	if err != nil && err.Error() != ErrReservationNotFound {
+		return domain.Reservation{}, err
+	}
+	if err == nil && previous.ReturnBranchID != nil && *previous.ReturnBranchID != carBranch.ID {
+		if pickupBranch, err = rs.branchesRepository.Get(ctx, *previous.ReturnBranchID); err != nil {
+			return domain.Reservation{}, err
+		}
+	}
+
+	if reservation.PickupBranchID != nil && *reservation.PickupBranchID != pickupBranch.ID {
+		return domain.Reservation{}, errors.New(ErrCarNotAtPickupBranch)
+	}
+
+	pickupLocation, err := rs.branchLocation(ctx, pickupBranch, city)
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	if !isBranchOpenAt(pickupBranch, reservation.StartDate.In(pickupLocation)) {
+		return domain.Reservation{}, errors.New(ErrPickupOutsideOpeningHours)
+	}
+
+	returnBranch, returnLocation := pickupBranch, pickupLocation
+	reservation.OneWayFee = domain.Money{Currency: city.Currency}
+	if reservation.ReturnBranchID != nil && *reservation.ReturnBranchID != pickupBranch.ID {
+		if returnBranch, err = rs.branchesRepository.Get(ctx, *reservation.ReturnBranchID); err != nil {
+			return domain.Reservation{}, err
+		}
+
+		if returnBranch.CityID != pickupBranch.CityID {
+			oneWayFee, err := rs.oneWayFeesRepository.Get(ctx, pickupBranch.CityID, returnBranch.CityID)
+			if err != nil {
+				if err.Error() == ErrOneWayFeeNotFound {
+					return domain.Reservation{}, errors.New(ErrOneWayRouteNotAvailable)
+				}
+				return domain.Reservation{}, err
+			}
+			if reservation.OneWayFee, err = priceIn(ctx, rs.exchangeRatesRepository, domain.NewMoney(oneWayFee.Fee, oneWayFee.Currency), city.Currency); err != nil {
+				return domain.Reservation{}, err
+			}
+
+			if returnLocation, err = rs.branchLocation(ctx, returnBranch, city); err != nil {
+				return domain.Reservation{}, err
+			}
+		}
+	}
+
+	if !isBranchOpenAt(returnBranch, reservation.EndDate.In(returnLocation)) {
+		return domain.Reservation{}, errors.New(ErrReturnOutsideOpeningHours)
+	}
+
+	next, err := rs.reservationsRepository.GetNextByCarID(ctx, reservation.CarID, reservation.ID, reservation.EndDate)
+	if err != nil && err.Error() != ErrReservationNotFound {
+		return domain.Reservation{}, err
+	}
+	if err == nil && next.PickupBranchID != nil && *next.PickupBranchID != returnBranch.ID {
+		return domain.Reservation{}, errors.New(ErrReturnBranchConflict)
+	}
+
+	reservation.PickupBranchID = &pickupBranch.ID
+	reservation.ReturnBranchID = &returnBranch.ID
+
+	return reservation, nil
+}
+
+// Loads the time zone of the city of the branch. carCity is reused when the
+// branch belongs to it.
+func (rs Reservations) branchLocation(ctx context.Context, branch domain.Branch, carCity domain.City) (*time.Location, error) {
+	branchCity := carCity
+	if branch.CityID != carCity.ID {
+		var err error
+		if branchCity, err = rs.citiesRepository.Get(ctx, branch.CityID); err != nil {
+			return nil, err
+		}
+	}
+
+	return LoadCityLocation(branchCity.TimeZone)
+}
+
+// Gets the reserved reservations that have not ended yet
+func upcomingReservations(reservations []domain.Reservation, now time.Time) []domain.Reservation {
+	reserved := constants.Values().RESERVATION_STATUSES.RESERVED
+	upcoming := make([]domain.Reservation, 0, len(reservations))
+	for _, reservation := range reservations {
+		if reservation.Status == reserved && reservation.EndDate.After(now) {
+			upcoming = append(upcoming, reservation)
+		}
+	}
+
+	return upcoming
+}
+
+// Shows the dates of the reservations in the local time of the city of their cars
+func (rs Reservations) localize(ctx context.Context, reservations []domain.Reservation) ([]domain.Reservation, error) {
+	if len(reservations) == 0 {
+		return reservations, nil
+	}
+
+	carIDs := make([]uuid.UUID, 0, len(reservations))
+	for _, reservation := range reservations {
+		if !utils.IsInSlice(carIDs, reservation.CarID) {
+			carIDs = append(carIDs, reservation.CarID)
+		}
+	}
+
+	timeZones, err := rs.citiesRepository.GetTimeZonesByCarIDs(ctx, carIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range reservations {
+		reservations[i] = localizedReservation(reservations[i], timeZones[reservations[i].CarID])
+	}
+
+	return reservations, nil
+}
+
+// Moves the dates of the reservation to the given time zone. The reservation
+// is left untouched when the time zone is unknown.
+func localizedReservation(reservation domain.Reservation, timeZone string) domain.Reservation {
+	location, err := LoadCityLocation(timeZone)
+	if err != nil {
+		return reservation
+	}
+
+	reservation.StartDate = reservation.StartDate.In(location)
+	reservation.EndDate = reservation.EndDate.In(location)
+	reservation.TimeZone = timeZone
 
+	return reservation
 }
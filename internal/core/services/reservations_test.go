@@ -16,16 +16,86 @@ import (
 )
 
 type reservationsDependencies struct {
-	reservationsRepository *mocks.MockReservationsRepo
+	reservationsRepository    *mocks.MockReservationsRepo
+	maintenancesRepository    *mocks.MockMaintenancesRepo
+	citiesRepository          *mocks.MockCitiesRepo
+	branchesRepository        *mocks.MockBranchesRepo
+	oneWayFeesRepository      *mocks.MockOneWayFeesRepo
+	transfersRepository       *mocks.MockTransfersRepo
+	usersRepository           *mocks.MockUsersRepo
+	carsRepository            *mocks.MockCarsRepo
+	addOnsRepository          *mocks.MockAddOnsRepo
+	protectionPlansRepository *mocks.MockProtectionPlansRepo
+	taxRulesRepository        *mocks.MockTaxRulesRepo
+	exchangeRatesRepository   *mocks.MockExchangeRatesRepo
 }
 
-func NewReservationsDependencies(reservationsRepo *mocks.MockReservationsRepo) *reservationsDependencies {
+func NewReservationsDependencies(reservationsRepo *mocks.MockReservationsRepo, maintenancesRepo *mocks.MockMaintenancesRepo, citiesRepo *mocks.MockCitiesRepo, branchesRepo *mocks.MockBranchesRepo, oneWayFeesRepo *mocks.MockOneWayFeesRepo, transfersRepo *mocks.MockTransfersRepo, usersRepo *mocks.MockUsersRepo, carsRepo *mocks.MockCarsRepo, addOnsRepo *mocks.MockAddOnsRepo, protectionPlansRepo *mocks.MockProtectionPlansRepo, taxRulesRepo *mocks.MockTaxRulesRepo, exchangeRatesRepo *mocks.MockExchangeRatesRepo) *reservationsDependencies {
 	return &reservationsDependencies{
-		reservationsRepository: reservationsRepo,
+		reservationsRepository:    reservationsRepo,
+		maintenancesRepository:    maintenancesRepo,
+		citiesRepository:          citiesRepo,
+		branchesRepository:        branchesRepo,
+		oneWayFeesRepository:      oneWayFeesRepo,
+		transfersRepository:       transfersRepo,
+		usersRepository:           usersRepo,
+		carsRepository:            carsRepo,
+		addOnsRepository:          addOnsRepo,
+		protectionPlansRepository: protectionPlansRepo,
+		taxRulesRepository:        taxRulesRepo,
+		exchangeRatesRepository:   exchangeRatesRepo,
 	}
 }
 
+// City where the cars of the reservations are located
+var reservationsCity = domain.City{
+	ID:       uuid.New(),
+	Name:     "Chicago",
+	TimeZone: "America/Chicago",
+	Country:  "US",
+	Currency: "USD",
+}
+
+// User booking the reservations, with a verified email and a long standing license
+var reservationsUser = domain.User{
+	ID:              uuid.New(),
+	FirstName:       "Richard",
+	LastName:        "Feynman",
+	Email:           "richard.feynman@caltech.edu.us",
+	Type:            "Customer",
+	Status:          "Active",
+	EmailVerifiedAt: &time.Time{},
+	DateOfBirth:     &reservationsUserDateOfBirth,
+	DriverLicense: &domain.DriverLicense{
+		Number:     "F1918M",
+		Country:    "US",
+		IssueDate:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+var reservationsUserDateOfBirth = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Available car of the reservations, which every driver of legal age can book
+var reservationsCar = domain.Car{
+	Type:     "Sedan",
+	Status:   "Available",
+	Currency: "USD",
+}
+
+// Default protection plan of the car of the reservations
+var reservationsProtectionPlan = domain.ProtectionPlan{
+	ID:         uuid.New(),
+	Level:      "Basic",
+	CarType:    "Sedan",
+	DailyPrice: 10,
+	Deductible: 1500,
+	Currency:   "USD",
+}
+
 func TestReservationsRegister(t *testing.T) {
+	initConstantsFromServices(t)
+
 	type args struct {
 		ctx         context.Context
 		reservation domain.Reservation
@@ -56,8 +126,53 @@ func TestReservationsRegister(t *testing.T) {
 				withError: false,
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
+				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "does not convert the additional driver fee when there are no additional drivers",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now().Add(1 * time.Hour),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+				},
+			},
+			wants: wants{
+				withError: false,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				// no rate from FEES_CURRENCY to euros is expected to be looked up
+				paris := reservationsCity
+				paris.TimeZone = "Europe/Paris"
+				paris.Currency = "EUR"
+				car := reservationsCar
+				car.Currency = "EUR"
+				plan := reservationsProtectionPlan
+				plan.Currency = "EUR"
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(car, nil)
 				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(paris, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(plan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 		},
 		{
@@ -77,8 +192,16 @@ func TestReservationsRegister(t *testing.T) {
 				withError: true,
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
 				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error booking reservation"))
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 		},
 		{
@@ -98,19 +221,99 @@ func TestReservationsRegister(t *testing.T) {
 				withError: true,
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some validation failed"))
 			},
 		},
+		{
+			name: "returns an error when the email of the user is not verified",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now().Add(1 * time.Hour),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+				},
+			},
+			wants: wants{
+				withError: true,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(domain.User{Status: "Active"}, nil)
+			},
+		},
+		{
+			name: "returns an error when the user is inactive",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now().Add(1 * time.Hour),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+				},
+			},
+			wants: wants{
+				withError: true,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				inactiveUser := reservationsUser
+				inactiveUser.Status = "Inactive"
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(inactiveUser, nil)
+			},
+		},
+		{
+			name: "returns an error when the car is unavailable",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now().Add(1 * time.Hour),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+				},
+			},
+			wants: wants{
+				withError: true,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				unavailableCar := reservationsCar
+				unavailableCar.Status = "Unavailable"
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(unavailableCar, nil)
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			_, err := reservationsService.Book(test.args.ctx, test.args.reservation)
 
 			assert.Equal(t, test.wants.withError, err != nil)
@@ -154,6 +357,7 @@ func TestReservationsGet(t *testing.T) {
 			},
 			setMocks: func(d *reservationsDependencies) {
 				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), gomock.Any()).Return(map[uuid.UUID]string{}, nil)
 			},
 		},
 		{
@@ -176,10 +380,21 @@ func TestReservationsGet(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			reservation, err := reservationsService.Get(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.reservation, reservation)
@@ -189,6 +404,7 @@ func TestReservationsGet(t *testing.T) {
 }
 
 func TestReservationsFullUpdate(t *testing.T) {
+	initConstantsFromServices(t)
 	reservation := domain.Reservation{
 		UserID:        uuid.New(),
 		CarID:         uuid.New(),
@@ -197,6 +413,19 @@ func TestReservationsFullUpdate(t *testing.T) {
 		StartDate:     time.Now().Add(1 * time.Hour),
 		EndDate:       time.Now().AddDate(0, 0, 7),
 	}
+	// the reservation is updated with the terms and cost of the default protection plan,
+	// and the car type of the reservation does not require a deposit
+	updated := reservation
+	updated.Currency = "USD"
+	updated.RentalCost = usd(0)
+	updated.AdditionalDriversFee = usd(0)
+	updated.OneWayFee = usd(0)
+	updated.AddOnsCost = usd(0)
+	updated.TaxTotal = usd(0)
+	updated.Protection = domain.ReservationProtection{Level: "Basic", DailyPrice: usd(10), Deductible: usd(1500)}
+	updated.ProtectionCost = usd(70)
+	updated.DepositStatus = "Not Required"
+	updated.Taxes = []domain.ReservationTax{}
 
 	type args struct {
 		ctx         context.Context
@@ -221,8 +450,16 @@ func TestReservationsFullUpdate(t *testing.T) {
 				err: nil,
 			},
 			setMocks: func(d *reservationsDependencies) {
-				d.reservationsRepository.EXPECT().FullUpdate(gomock.Any(), reservation).Return(nil)
+				d.reservationsRepository.EXPECT().FullUpdate(gomock.Any(), updated).Return(nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 		},
 		{
@@ -235,8 +472,16 @@ func TestReservationsFullUpdate(t *testing.T) {
 				err: errors.New("failure while updating reservation"),
 			},
 			setMocks: func(d *reservationsDependencies) {
-				d.reservationsRepository.EXPECT().FullUpdate(gomock.Any(), reservation).Return(errors.New("failure while updating reservation"))
+				d.reservationsRepository.EXPECT().FullUpdate(gomock.Any(), updated).Return(errors.New("failure while updating reservation"))
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 		},
 		{
@@ -249,6 +494,10 @@ func TestReservationsFullUpdate(t *testing.T) {
 				err: errors.New("some validation failed"),
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsUser, nil)
+				d.carsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(reservationsCar, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some validation failed"))
 			},
 		},
@@ -257,10 +506,21 @@ func TestReservationsFullUpdate(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			err := reservationsService.FullUpdate(test.args.ctx, test.args.reservation)
 
 			assert.Equal(t, test.wants.err, err)
@@ -314,10 +574,21 @@ func TestReservationsDelete(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			err := reservationsService.Delete(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.err, err)
@@ -378,6 +649,7 @@ func TestReservationsList(t *testing.T) {
 			},
 			setMocks: func(d *reservationsDependencies) {
 				d.reservationsRepository.EXPECT().List(gomock.Any(), "00000000-0000-0000-0000-000000000000", gomock.Any(), gomock.Any(), gomock.Any()).Return(foundReservations, nil)
+				d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), gomock.Any()).Return(map[uuid.UUID]string{}, nil)
 			},
 		},
 		{
@@ -394,6 +666,7 @@ func TestReservationsList(t *testing.T) {
 			},
 			setMocks: func(d *reservationsDependencies) {
 				d.reservationsRepository.EXPECT().List(gomock.Any(), "5ae5d956-5a8d-40dd-9aef-5340fda345e8", gomock.Any(), gomock.Any(), gomock.Any()).Return(foundReservations, nil)
+				d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), gomock.Any()).Return(map[uuid.UUID]string{}, nil)
 			},
 		},
 		{
@@ -418,10 +691,21 @@ func TestReservationsList(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			reservations, err := reservationsService.List(test.args.ctx, test.args.fromReservationId, test.args.startDate, test.args.endDate)
 
 			assert.Equal(t, test.wants.reservations, reservations)
@@ -482,6 +766,7 @@ func TestGetByCarID(t *testing.T) {
 			},
 			setMocks: func(d *reservationsDependencies) {
 				d.reservationsRepository.EXPECT().GetByCarID(gomock.Any(), c_id).Return(foundReservations, nil)
+				d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), gomock.Any()).Return(map[uuid.UUID]string{}, nil)
 			},
 		},
 		{
@@ -504,10 +789,21 @@ func TestGetByCarID(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			carsService := NewReservations(reservationsRepo)
+			carsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			reservations, err := carsService.GetByCarID(test.args.ctx, test.args.CarID)
 
 			assert.Equal(t, test.wants.reservations, reservations)
@@ -568,6 +864,7 @@ func TestGetByUserID(t *testing.T) {
 			},
 			setMocks: func(d *reservationsDependencies) {
 				d.reservationsRepository.EXPECT().GetByUserID(gomock.Any(), u_id).Return(foundReservations, nil)
+				d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), gomock.Any()).Return(map[uuid.UUID]string{}, nil)
 			},
 		},
 		{
@@ -590,10 +887,21 @@ func TestGetByUserID(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			reservations, err := reservationsService.GetByUserID(test.args.ctx, test.args.userID)
 
 			assert.Equal(t, test.wants.reservations, reservations)
@@ -652,9 +960,10 @@ func TestCheckReservation(t *testing.T) {
 				},
 			},
 			wants: wants{
-				err: fmt.Errorf("%s (%d hours)", ErrMinimumReservationHours, constants.Values.MINIMUM_RESERVATION_HOURS),
+				err: fmt.Errorf("%s (%d hours)", ErrMinimumReservationHours, constants.Values().MINIMUM_RESERVATION_HOURS),
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
 			},
 		},
 		{
@@ -675,6 +984,8 @@ func TestCheckReservation(t *testing.T) {
 				err: errors.New(ErrCarNotAvailable),
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Reservation{
 					{
 						ID:            uuid.New(),
@@ -688,6 +999,41 @@ func TestCheckReservation(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "returns nil error when the car is only taken by canceled reservations",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					ID:            uuid.New(),
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     now.Add(1 * time.Hour),
+					EndDate:       now.Add(30 * 24 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Reservation{
+					{
+						ID:            uuid.New(),
+						UserID:        uuid.New(),
+						CarID:         uuid.New(),
+						Status:        "Canceled",
+						PaymentStatus: "Refunded",
+						StartDate:     now,
+						EndDate:       now.Add(30 * 24 * time.Hour),
+					},
+				}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
 		{
 			name: "returns nil error when new reservation time frame intersects the past one",
 			args: args{
@@ -705,6 +1051,8 @@ func TestCheckReservation(t *testing.T) {
 				err: nil,
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Reservation{
 					{
 						UserID:        uuid.New(),
@@ -715,6 +1063,118 @@ func TestCheckReservation(t *testing.T) {
 						EndDate:       now.Add(30 * 24 * time.Hour),
 					},
 				}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "returns an error when car is scheduled for maintenance",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     now.Add(1 * time.Hour),
+					EndDate:       now.Add(30 * 24 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrCarInMaintenance),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Maintenance{
+					{
+						ID:        uuid.New(),
+						CarID:     uuid.New(),
+						StartDate: now.Add(2 * 24 * time.Hour),
+						EndDate:   now.Add(3 * 24 * time.Hour),
+						Reason:    "Brakes replacement",
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "returns an error when maintenances repository fails",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     now.Add(1 * time.Hour),
+					EndDate:       now.Add(30 * 24 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: errors.New("error getting maintenances"),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error getting maintenances"))
+			},
+		},
+		{
+			name: "returns an error when car has a pending transfer departing before the reservation ends",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     now.Add(1 * time.Hour),
+					EndDate:       now.Add(30 * 24 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrCarInTransfer),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{
+					ID:            uuid.New(),
+					DepartureDate: now.Add(10 * 24 * time.Hour),
+					ArrivalDate:   now.Add(11 * 24 * time.Hour),
+				}, nil)
+			},
+		},
+		{
+			name: "returns nil error when the pending transfer of the car departs after the reservation ends",
+			args: args{
+				ctx: context.TODO(),
+				reservation: domain.Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     now.Add(1 * time.Hour),
+					EndDate:       now.Add(30 * 24 * time.Hour),
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{
+					ID:            uuid.New(),
+					DepartureDate: now.Add(30 * 24 * time.Hour),
+					ArrivalDate:   now.Add(31 * 24 * time.Hour),
+				}, nil)
 			},
 		},
 		{
@@ -753,7 +1213,11 @@ func TestCheckReservation(t *testing.T) {
 				err: nil,
 			},
 			setMocks: func(d *reservationsDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(reservationsCity, nil)
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), gomock.Any()).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
 				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
 			},
 		},
 	}
@@ -762,13 +1226,1626 @@ func TestCheckReservation(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewReservationsDependencies(reservationsRepo)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			test.setMocks(d)
 
-			reservationsService := NewReservations(reservationsRepo)
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
 			err := reservationsService.CheckReservation(test.args.ctx, test.args.reservation)
 
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
 }
+
+func TestReservationsLocalTime(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("measures the minimum reservation hours with the wall clock of the city", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+		citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+		branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+		oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+		transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+		addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+		protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+		taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+		exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+		d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+		// daylight saving time starts during the reservation, so only five hours go by
+		reservation := domain.Reservation{
+			UserID:        uuid.New(),
+			CarID:         uuid.New(),
+			Status:        "Reserved",
+			PaymentStatus: "Pending",
+			StartDate:     time.Date(2030, time.March, 10, 0, 30, 0, 0, chicago).UTC(),
+			EndDate:       time.Date(2030, time.March, 10, 6, 30, 0, 0, chicago).UTC(),
+		}
+		d.usersRepository.EXPECT().Get(gomock.Any(), reservation.UserID).Return(reservationsUser, nil)
+		d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(reservationsCar, nil)
+		d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(reservationsCity, nil)
+		d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+		d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+		d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+		d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
+		d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+		booked, err := reservationsService.Book(context.TODO(), reservation)
+
+		assert.Nil(t, err)
+		assert.Equal(t, reservationsCity.TimeZone, booked.TimeZone)
+		assert.Equal(t, "00:30 CST", booked.StartDate.Format("15:04 MST"))
+		assert.Equal(t, "06:30 CDT", booked.EndDate.Format("15:04 MST"))
+	})
+
+	t.Run("shows the reservation dates in the time zone of the city", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+		citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+		branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+		oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+		transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+		addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+		protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+		taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+		exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+		d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+		reservation := domain.Reservation{
+			ID:        uuid.New(),
+			CarID:     uuid.New(),
+			StartDate: time.Date(2030, time.July, 1, 15, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2030, time.July, 2, 15, 0, 0, 0, time.UTC),
+		}
+		d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+		d.citiesRepository.EXPECT().GetTimeZonesByCarIDs(gomock.Any(), []uuid.UUID{reservation.CarID}).
+			Return(map[uuid.UUID]string{reservation.CarID: reservationsCity.TimeZone}, nil)
+
+		reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+		found, err := reservationsService.Get(context.TODO(), reservation.ID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "2030-07-01T10:00:00-05:00", found.StartDate.Format(time.RFC3339))
+		assert.True(t, reservation.StartDate.Equal(found.StartDate))
+	})
+}
+
+func TestReservationsOpeningHours(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// open from Monday to Saturday, closed on Sundays and on a holiday
+	branch := domain.Branch{
+		ID:     uuid.New(),
+		CityID: reservationsCity.ID,
+		Name:   "Chicago Downtown",
+		OpeningHours: []domain.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "08:00", ClosesAt: "18:00"},
+			{Weekday: time.Tuesday, OpensAt: "08:00", ClosesAt: "18:00"},
+			{Weekday: time.Wednesday, OpensAt: "08:00", ClosesAt: "18:00"},
+			{Weekday: time.Thursday, OpensAt: "08:00", ClosesAt: "18:00"},
+			{Weekday: time.Friday, OpensAt: "08:00", ClosesAt: "18:00"},
+			{Weekday: time.Saturday, OpensAt: "09:00", ClosesAt: "13:00"},
+		},
+		Closures: []domain.BranchClosure{
+			{Date: "2030-12-25", Reason: "Christmas Day"},
+		},
+	}
+
+	type args struct {
+		startDate time.Time
+		endDate   time.Time
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "accepts pickup and return inside the opening hours in the city local time",
+			args: args{
+				// 13:00 UTC is 08:00 in Chicago during daylight saving time
+				startDate: time.Date(2030, time.July, 1, 13, 0, 0, 0, time.UTC),
+				endDate:   time.Date(2030, time.July, 3, 23, 0, 0, 0, time.UTC),
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "returns error when pickup is before the branch opens in the city local time",
+			args: args{
+				// 12:59 UTC is 07:59 in Chicago during daylight saving time
+				startDate: time.Date(2030, time.July, 1, 12, 59, 0, 0, time.UTC),
+				endDate:   time.Date(2030, time.July, 3, 15, 0, 0, 0, time.UTC),
+			},
+			wants: wants{
+				err: errors.New(ErrPickupOutsideOpeningHours),
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns error when return falls on a day the branch does not open",
+			args: args{
+				startDate: time.Date(2030, time.July, 5, 10, 0, 0, 0, chicago),
+				endDate:   time.Date(2030, time.July, 7, 10, 0, 0, 0, chicago),
+			},
+			wants: wants{
+				err: errors.New(ErrReturnOutsideOpeningHours),
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns error when return falls on a holiday closure",
+			args: args{
+				startDate: time.Date(2030, time.December, 23, 10, 0, 0, 0, chicago),
+				endDate:   time.Date(2030, time.December, 25, 10, 0, 0, 0, chicago),
+			},
+			wants: wants{
+				err: errors.New(ErrReturnOutsideOpeningHours),
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+			reservation := domain.Reservation{
+				UserID:    uuid.New(),
+				CarID:     uuid.New(),
+				StartDate: test.args.startDate,
+				EndDate:   test.args.endDate,
+			}
+			d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(reservationsCity, nil)
+			d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(branch, nil)
+			d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), reservation.CarID, reservation.ID, gomock.Any(), reservation.StartDate).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+			test.setMocks(d)
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			err := reservationsService.CheckReservation(context.TODO(), reservation)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestReservationsOneWay(t *testing.T) {
+	initConstantsFromServices(t)
+
+	newYork := domain.City{
+		ID:       uuid.New(),
+		Name:     "New York",
+		TimeZone: "America/New_York",
+		Country:  "US",
+		Currency: "USD",
+	}
+	alwaysOpen := make([]domain.OpeningHours, 0, 7)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		alwaysOpen = append(alwaysOpen, domain.OpeningHours{Weekday: weekday, OpensAt: "00:00", ClosesAt: "23:59"})
+	}
+	chicagoBranch := domain.Branch{ID: uuid.New(), CityID: reservationsCity.ID, Name: "Chicago Downtown", OpeningHours: alwaysOpen}
+	otherChicagoBranch := domain.Branch{ID: uuid.New(), CityID: reservationsCity.ID, Name: "Chicago O'Hare Airport", OpeningHours: alwaysOpen}
+	newYorkBranch := domain.Branch{ID: uuid.New(), CityID: newYork.ID, Name: "New York Midtown", OpeningHours: alwaysOpen}
+
+	carID := uuid.New()
+	startDate := time.Date(2030, time.July, 1, 15, 0, 0, 0, time.UTC)
+	endDate := time.Date(2030, time.July, 4, 15, 0, 0, 0, time.UTC)
+	notFound := errors.New(ErrReservationNotFound)
+
+	type args struct {
+		pickupBranchID *uuid.UUID
+		returnBranchID *uuid.UUID
+	}
+	type wants struct {
+		pickupBranchID *uuid.UUID
+		returnBranchID *uuid.UUID
+		oneWayFee      float64
+		err            error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "charges the one-way fee when car is returned in another city",
+			args: args{
+				returnBranchID: &newYorkBranch.ID,
+			},
+			wants: wants{
+				pickupBranchID: &chicagoBranch.ID,
+				returnBranchID: &newYorkBranch.ID,
+				oneWayFee:      150,
+				err:            nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).Return(domain.Reservation{}, notFound)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), newYorkBranch.ID).Return(newYorkBranch, nil)
+				d.oneWayFeesRepository.EXPECT().Get(gomock.Any(), reservationsCity.ID, newYork.ID).
+					Return(domain.OneWayFee{FromCityID: reservationsCity.ID, ToCityID: newYork.ID, Fee: 150, Currency: "USD"}, nil)
+				d.citiesRepository.EXPECT().Get(gomock.Any(), newYork.ID).Return(newYork, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), carID, uuid.Nil, endDate).Return(domain.Reservation{}, notFound)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "does not charge a fee when car is returned at another branch of the same city",
+			args: args{
+				returnBranchID: &otherChicagoBranch.ID,
+			},
+			wants: wants{
+				pickupBranchID: &chicagoBranch.ID,
+				returnBranchID: &otherChicagoBranch.ID,
+				oneWayFee:      0,
+				err:            nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).Return(domain.Reservation{}, notFound)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), otherChicagoBranch.ID).Return(otherChicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), carID, uuid.Nil, endDate).Return(domain.Reservation{}, notFound)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "picks the car up where its previous reservation returns it",
+			args: args{},
+			wants: wants{
+				pickupBranchID: &newYorkBranch.ID,
+				returnBranchID: &newYorkBranch.ID,
+				oneWayFee:      0,
+				err:            nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).
+					Return(domain.Reservation{ID: uuid.New(), CarID: carID, PickupBranchID: &chicagoBranch.ID, ReturnBranchID: &newYorkBranch.ID}, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), newYorkBranch.ID).Return(newYorkBranch, nil)
+				d.citiesRepository.EXPECT().Get(gomock.Any(), newYork.ID).Return(newYork, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), carID, uuid.Nil, endDate).Return(domain.Reservation{}, notFound)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), carID, startDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns error when car will not be at the requested pickup branch",
+			args: args{
+				pickupBranchID: &chicagoBranch.ID,
+			},
+			wants: wants{
+				err: errors.New(ErrCarNotAtPickupBranch),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).
+					Return(domain.Reservation{ID: uuid.New(), CarID: carID, PickupBranchID: &chicagoBranch.ID, ReturnBranchID: &newYorkBranch.ID}, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), newYorkBranch.ID).Return(newYorkBranch, nil)
+			},
+		},
+		{
+			name: "returns error when route between the cities has no one-way fee",
+			args: args{
+				returnBranchID: &newYorkBranch.ID,
+			},
+			wants: wants{
+				err: errors.New(ErrOneWayRouteNotAvailable),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).Return(domain.Reservation{}, notFound)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), newYorkBranch.ID).Return(newYorkBranch, nil)
+				d.oneWayFeesRepository.EXPECT().Get(gomock.Any(), reservationsCity.ID, newYork.ID).Return(domain.OneWayFee{}, errors.New(ErrOneWayFeeNotFound))
+			},
+		},
+		{
+			name: "returns error when next reservation picks the car up at another branch",
+			args: args{
+				returnBranchID: &newYorkBranch.ID,
+			},
+			wants: wants{
+				err: errors.New(ErrReturnBranchConflict),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(chicagoBranch, nil)
+				d.reservationsRepository.EXPECT().GetPreviousByCarID(gomock.Any(), carID, uuid.Nil, gomock.Any(), startDate).Return(domain.Reservation{}, notFound)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), newYorkBranch.ID).Return(newYorkBranch, nil)
+				d.oneWayFeesRepository.EXPECT().Get(gomock.Any(), reservationsCity.ID, newYork.ID).
+					Return(domain.OneWayFee{FromCityID: reservationsCity.ID, ToCityID: newYork.ID, Fee: 150, Currency: "USD"}, nil)
+				d.citiesRepository.EXPECT().Get(gomock.Any(), newYork.ID).Return(newYork, nil)
+				d.reservationsRepository.EXPECT().GetNextByCarID(gomock.Any(), carID, uuid.Nil, endDate).
+					Return(domain.Reservation{ID: uuid.New(), CarID: carID, PickupBranchID: &chicagoBranch.ID, ReturnBranchID: &chicagoBranch.ID}, nil)
+			},
+		},
+		{
+			name: "returns error when branches are chosen for a car without a branch",
+			args: args{
+				returnBranchID: &newYorkBranch.ID,
+			},
+			wants: wants{
+				err: errors.New(ErrCarWithoutBranch),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+			reservation := domain.Reservation{
+				UserID:         uuid.New(),
+				CarID:          carID,
+				StartDate:      startDate,
+				EndDate:        endDate,
+				PickupBranchID: test.args.pickupBranchID,
+				ReturnBranchID: test.args.returnBranchID,
+			}
+			d.usersRepository.EXPECT().Get(gomock.Any(), reservation.UserID).Return(reservationsUser, nil)
+			d.carsRepository.EXPECT().Get(gomock.Any(), carID).Return(reservationsCar, nil)
+			d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), carID).Return(reservationsCity, nil)
+			test.setMocks(d)
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			booked, err := reservationsService.Book(context.TODO(), reservation)
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.pickupBranchID, booked.PickupBranchID)
+			assert.Equal(t, test.wants.returnBranchID, booked.ReturnBranchID)
+			assert.Equal(t, test.wants.oneWayFee, booked.OneWayFee.Major())
+		})
+	}
+}
+
+func TestReservationsDriverEligibility(t *testing.T) {
+	initConstantsFromServices(t)
+
+	startDate := time.Now().AddDate(0, 0, 1)
+	endDate := startDate.AddDate(0, 0, 7)
+	licensedUser := func(ageYears int, licenseYears int, expiryDate time.Time) domain.User {
+		user := reservationsUser
+		dateOfBirth := startDate.AddDate(-ageYears, 0, 0)
+		user.DateOfBirth = &dateOfBirth
+		user.DriverLicense = &domain.DriverLicense{
+			Number:     "F1918M",
+			Country:    "US",
+			IssueDate:  startDate.AddDate(-licenseYears, 0, 0),
+			ExpiryDate: expiryDate,
+		}
+
+		return user
+	}
+	unlicensedUser := reservationsUser
+	unlicensedUser.DriverLicense = nil
+
+	type args struct {
+		user    domain.User
+		carType string
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns error when user has not registered their driver license",
+			args: args{
+				user:    unlicensedUser,
+				carType: "Sedan",
+			},
+			wants: wants{
+				err: errors.New(ErrDriverDataMissing),
+			},
+		},
+		{
+			name: "returns error when driver is younger than the minimum age of the car type",
+			args: args{
+				user:    licensedUser(29, 10, endDate.AddDate(1, 0, 0)),
+				carType: "Limousine",
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (%d years)", ErrDriverTooYoung, 30),
+			},
+		},
+		{
+			name: "returns error when driver has held their license for less than the car type requires",
+			args: args{
+				user:    licensedUser(40, 4, endDate.AddDate(1, 0, 0)),
+				carType: "Sports Car",
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (%d years)", ErrDriverLicenseTooRecent, 5),
+			},
+		},
+		{
+			name: "returns error when driver license expires before the reservation ends",
+			args: args{
+				user:    licensedUser(40, 20, endDate.AddDate(0, 0, -1)),
+				carType: "Sedan",
+			},
+			wants: wants{
+				err: errors.New(ErrDriverLicenseExpires),
+			},
+		},
+		{
+			name: "returns nil error when driver meets the requirements of the car type",
+			args: args{
+				user:    licensedUser(30, 5, endDate),
+				carType: "Limousine",
+			},
+			wants: wants{
+				err: nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reservation := domain.Reservation{
+				StartDate: startDate,
+				EndDate:   endDate,
+			}
+
+			err := checkDriverEligibility(test.args.user, test.args.carType, reservation)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestReservationsAdditionalDrivers(t *testing.T) {
+	initConstantsFromServices(t)
+
+	driver := reservationsUser
+	driver.ID = uuid.New()
+	inactiveDriver := driver
+	inactiveDriver.ID = uuid.New()
+	inactiveDriver.Status = "Inactive"
+	unlicensedDriver := driver
+	unlicensedDriver.ID = uuid.New()
+	unlicensedDriver.DriverLicense = nil
+
+	type args struct {
+		additionalDriverIDs []uuid.UUID
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "returns error when there are more additional drivers than allowed",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()},
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (%d drivers)", ErrTooManyAdditionalDrivers, 3),
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns error when the renter is an additional driver",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{reservationsUser.ID},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidAdditionalDrivers),
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns error when an additional driver is repeated",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{driver.ID, driver.ID},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidAdditionalDrivers),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), driver.ID).Return(driver, nil)
+			},
+		},
+		{
+			name: "returns error when an additional driver is not registered",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{driver.ID},
+			},
+			wants: wants{
+				err: errors.New(ErrUserNotFound),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), driver.ID).Return(domain.User{}, errors.New(ErrUserNotFound))
+			},
+		},
+		{
+			name: "returns error when an additional driver is inactive",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{driver.ID, inactiveDriver.ID},
+			},
+			wants: wants{
+				err: errors.New(ErrAdditionalDriverInactive),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), driver.ID).Return(driver, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), inactiveDriver.ID).Return(inactiveDriver, nil)
+			},
+		},
+		{
+			name: "returns error when an additional driver is not eligible for the car type",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{unlicensedDriver.ID},
+			},
+			wants: wants{
+				err: errors.New(ErrDriverDataMissing),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), unlicensedDriver.ID).Return(unlicensedDriver, nil)
+			},
+		},
+		{
+			name: "returns nil error when every additional driver can drive the car",
+			args: args{
+				additionalDriverIDs: []uuid.UUID{driver.ID},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.usersRepository.EXPECT().Get(gomock.Any(), driver.ID).Return(driver, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+			reservation := domain.Reservation{
+				UserID:              reservationsUser.ID,
+				CarID:               uuid.New(),
+				StartDate:           time.Now().AddDate(0, 0, 1),
+				EndDate:             time.Now().AddDate(0, 0, 8),
+				AdditionalDriverIDs: test.args.additionalDriverIDs,
+			}
+			d.usersRepository.EXPECT().Get(gomock.Any(), reservation.UserID).Return(reservationsUser, nil)
+			d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(reservationsCar, nil)
+			test.setMocks(d)
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			_, err := reservationsService.checkDrivers(context.TODO(), reservation)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestReservationsQuote(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	car := reservationsCar
+	car.HourlyRentCost = 10.25
+	airportBranchID := uuid.New()
+	usdToJPY := domain.ExchangeRate{From: "USD", To: "JPY", Rate: 149.83, UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)}
+	eurToUSD := domain.ExchangeRate{From: "EUR", To: "USD", Rate: 1.0852, UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)}
+
+	type args struct {
+		startDate           time.Time
+		endDate             time.Time
+		additionalDriverIDs []uuid.UUID
+		taxRules            []domain.TaxRule
+		displayCurrency     string
+		// currency the hourly rent of the car is set in, when it is not the one of the city
+		carCurrency string
+	}
+	type wants struct {
+		quote domain.Quote
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "charges started hours of rent",
+			args: args{
+				startDate: time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:   time.Date(2030, time.July, 1, 18, 30, 0, 0, chicago),
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(102.5),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(0),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(10),
+					Subtotal:             usd(112.5),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(112.5),
+					Currency:             "USD",
+				},
+			},
+		},
+		{
+			name: "charges the taxes of the city on the parts of the price they apply to",
+			args: args{
+				startDate: time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:   time.Date(2030, time.July, 1, 18, 30, 0, 0, chicago),
+				taxRules: []domain.TaxRule{
+					{Name: "Sales tax", Rate: 0.1025, AppliesTo: []string{"Rental", "Protection"}, Rounding: "Half Up"},
+					{Name: "Tourism levy", Rate: 0.09, AppliesTo: []string{"Rental"}, Rounding: "Half Even"},
+					// the car is not picked up at the airport
+					{Name: "Airport surcharge", BranchID: &airportBranchID, Rate: 0.11, AppliesTo: []string{"Rental"}, Rounding: "Up"},
+				},
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(102.5),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(0),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(10),
+					Subtotal:             usd(112.5),
+					Taxes: []domain.ReservationTax{
+						{Name: "Sales tax", Rate: 0.1025, Amount: usd(11.53)},
+						{Name: "Tourism levy", Rate: 0.09, Amount: usd(9.22)},
+					},
+					TaxTotal: usd(20.75),
+					Total:    usd(133.25),
+					Currency: "USD",
+				},
+			},
+		},
+		{
+			name: "charges each additional driver by started days",
+			args: args{
+				startDate:           time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:             time.Date(2030, time.July, 2, 10, 0, 0, 0, chicago),
+				additionalDriverIDs: []uuid.UUID{uuid.New(), uuid.New()},
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(256.25),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(50),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(20),
+					Subtotal:             usd(326.25),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(326.25),
+					Currency:             "USD",
+				},
+			},
+		},
+		{
+			name: "measures the period with the wall clock of the city",
+			args: args{
+				// daylight saving time starts during the reservation, which still lasts two days
+				startDate:           time.Date(2030, time.March, 9, 12, 0, 0, 0, chicago),
+				endDate:             time.Date(2030, time.March, 11, 12, 0, 0, 0, chicago),
+				additionalDriverIDs: []uuid.UUID{uuid.New()},
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(492),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(25),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(20),
+					Subtotal:             usd(537),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(537),
+					Currency:             "USD",
+				},
+			},
+		},
+		{
+			name: "displays the quote in a currency without minor units",
+			args: args{
+				startDate:       time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:         time.Date(2030, time.July, 1, 18, 30, 0, 0, chicago),
+				displayCurrency: "JPY",
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(102.5),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(0),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(10),
+					Subtotal:             usd(112.5),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(112.5),
+					Currency:             "USD",
+					Display: &domain.DisplayQuote{
+						Rate:                 usdToJPY,
+						RentalCost:           domain.Money{Amount: 15358, Currency: "JPY"},
+						OneWayFee:            domain.Money{Amount: 0, Currency: "JPY"},
+						AdditionalDriversFee: domain.Money{Amount: 0, Currency: "JPY"},
+						AddOnsCost:           domain.Money{Amount: 0, Currency: "JPY"},
+						ProtectionCost:       domain.Money{Amount: 1498, Currency: "JPY"},
+						Subtotal:             domain.Money{Amount: 16856, Currency: "JPY"},
+						TaxTotal:             domain.Money{Amount: 0, Currency: "JPY"},
+						Total:                domain.Money{Amount: 16856, Currency: "JPY"},
+					},
+				},
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "JPY").Return(usdToJPY, nil)
+			},
+		},
+		{
+			name: "displays the quote with the inverse of the opposite rate when the pair has none",
+			args: args{
+				startDate:       time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:         time.Date(2030, time.July, 1, 18, 30, 0, 0, chicago),
+				displayCurrency: "EUR",
+			},
+			wants: wants{
+				quote: domain.Quote{
+					RentalCost:           usd(102.5),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(0),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(10),
+					Subtotal:             usd(112.5),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(112.5),
+					Currency:             "USD",
+					Display: &domain.DisplayQuote{
+						Rate:                 domain.ExchangeRate{From: "USD", To: "EUR", Rate: 1 / 1.0852, UpdatedAt: eurToUSD.UpdatedAt},
+						RentalCost:           domain.Money{Amount: 9445, Currency: "EUR"},
+						OneWayFee:            domain.Money{Amount: 0, Currency: "EUR"},
+						AdditionalDriversFee: domain.Money{Amount: 0, Currency: "EUR"},
+						AddOnsCost:           domain.Money{Amount: 0, Currency: "EUR"},
+						ProtectionCost:       domain.Money{Amount: 921, Currency: "EUR"},
+						Subtotal:             domain.Money{Amount: 10366, Currency: "EUR"},
+						TaxTotal:             domain.Money{Amount: 0, Currency: "EUR"},
+						Total:                domain.Money{Amount: 10366, Currency: "EUR"},
+					},
+				},
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "USD", "EUR").Return(domain.ExchangeRate{}, errors.New(ErrExchangeRateNotFound))
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "EUR", "USD").Return(eurToUSD, nil)
+			},
+		},
+		{
+			name: "converts the hourly rent of a car set in another currency to the one of the city",
+			args: args{
+				startDate:   time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+				endDate:     time.Date(2030, time.July, 1, 18, 30, 0, 0, chicago),
+				carCurrency: "EUR",
+			},
+			wants: wants{
+				quote: domain.Quote{
+					// 10.25 EUR are 11.12 USD per hour
+					RentalCost:           usd(111.2),
+					OneWayFee:            usd(0),
+					AdditionalDriversFee: usd(0),
+					AddOnsCost:           usd(0),
+					ProtectionCost:       usd(10),
+					Subtotal:             usd(121.2),
+					Taxes:                []domain.ReservationTax{},
+					TaxTotal:             usd(0),
+					Total:                usd(121.2),
+					Currency:             "USD",
+				},
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.exchangeRatesRepository.EXPECT().Get(gomock.Any(), "EUR", "USD").Return(eurToUSD, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+
+			reservation := domain.Reservation{
+				UserID:              reservationsUser.ID,
+				CarID:               uuid.New(),
+				Status:              "Reserved",
+				PaymentStatus:       "Pending",
+				StartDate:           test.args.startDate,
+				EndDate:             test.args.endDate,
+				AdditionalDriverIDs: test.args.additionalDriverIDs,
+			}
+			d.usersRepository.EXPECT().Get(gomock.Any(), reservation.UserID).Return(reservationsUser, nil)
+			for _, driverID := range test.args.additionalDriverIDs {
+				driver := reservationsUser
+				driver.ID = driverID
+				d.usersRepository.EXPECT().Get(gomock.Any(), driverID).Return(driver, nil)
+			}
+			car := car
+			if test.args.carCurrency != "" {
+				car.Currency = test.args.carCurrency
+			}
+			d.carsRepository.EXPECT().Get(gomock.Any(), reservation.CarID).Return(car, nil)
+			d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(reservationsCity, nil)
+			d.branchesRepository.EXPECT().GetByCarID(gomock.Any(), reservation.CarID).Return(domain.Branch{}, errors.New(ErrBranchNotFound))
+			d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(reservationsProtectionPlan, nil)
+			d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), reservationsCity.ID).Return(test.args.taxRules, nil)
+			if test.setMocks != nil {
+				test.setMocks(d)
+			}
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			quote, err := reservationsService.Quote(context.TODO(), reservation, test.args.displayCurrency)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wants.quote, quote)
+		})
+	}
+}
+
+func TestReservationsAddOns(t *testing.T) {
+	initConstantsFromServices(t)
+
+	pickupBranchID := uuid.New()
+	childSeat := domain.AddOn{ID: uuid.New(), BranchID: pickupBranchID, Name: "Child seat", Stock: 3, Price: 12.5, PricingUnit: "Per Day", Currency: "USD"}
+	gps := domain.AddOn{ID: uuid.New(), BranchID: uuid.New(), Name: "GPS", Stock: 5, Price: 20, PricingUnit: "Per Rental", Currency: "USD"}
+	startDate := time.Now().AddDate(0, 0, 1)
+	endDate := startDate.AddDate(0, 0, 3)
+
+	type args struct {
+		addOns []domain.ReservationAddOn
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "returns error when an add-on is repeated",
+			args: args{
+				addOns: []domain.ReservationAddOn{{AddOnID: childSeat.ID, Quantity: 1}, {AddOnID: childSeat.ID, Quantity: 1}},
+			},
+			wants: wants{
+				err: errors.New(ErrRepeatedAddOn),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.addOnsRepository.EXPECT().Get(gomock.Any(), childSeat.ID).Return(childSeat, nil)
+				d.addOnsRepository.EXPECT().GetBookings(gomock.Any(), childSeat.ID, gomock.Any(), startDate, endDate).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns error when an add-on was not found",
+			args: args{
+				addOns: []domain.ReservationAddOn{{AddOnID: childSeat.ID, Quantity: 1}},
+			},
+			wants: wants{
+				err: errors.New(ErrAddOnNotFound),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.addOnsRepository.EXPECT().Get(gomock.Any(), childSeat.ID).Return(domain.AddOn{}, errors.New(ErrAddOnNotFound))
+			},
+		},
+		{
+			name: "returns error when an add-on is offered at another branch",
+			args: args{
+				addOns: []domain.ReservationAddOn{{AddOnID: gps.ID, Quantity: 1}},
+			},
+			wants: wants{
+				err: errors.New(ErrAddOnNotAtPickupBranch),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.addOnsRepository.EXPECT().Get(gomock.Any(), gps.ID).Return(gps, nil)
+			},
+		},
+		{
+			name: "returns error when the units booked at the same time exceed the stock",
+			args: args{
+				addOns: []domain.ReservationAddOn{{AddOnID: childSeat.ID, Quantity: 2}},
+			},
+			wants: wants{
+				err: fmt.Errorf("%s (%s)", ErrAddOnOutOfStock, childSeat.Name),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.addOnsRepository.EXPECT().Get(gomock.Any(), childSeat.ID).Return(childSeat, nil)
+				d.addOnsRepository.EXPECT().GetBookings(gomock.Any(), childSeat.ID, gomock.Any(), startDate, endDate).Return([]domain.AddOnBooking{
+					{Quantity: 1, StartDate: startDate.AddDate(0, 0, -1), EndDate: startDate.AddDate(0, 0, 2)},
+					{Quantity: 1, StartDate: startDate.AddDate(0, 0, 1), EndDate: endDate},
+				}, nil)
+			},
+		},
+		{
+			name: "returns nil error when there are units left for the whole time frame",
+			args: args{
+				addOns: []domain.ReservationAddOn{{AddOnID: childSeat.ID, Quantity: 2}},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.addOnsRepository.EXPECT().Get(gomock.Any(), childSeat.ID).Return(childSeat, nil)
+				d.addOnsRepository.EXPECT().GetBookings(gomock.Any(), childSeat.ID, gomock.Any(), startDate, endDate).Return([]domain.AddOnBooking{
+					{Quantity: 1, StartDate: startDate.AddDate(0, 0, -1), EndDate: startDate.AddDate(0, 0, 1)},
+					{Quantity: 1, StartDate: startDate.AddDate(0, 0, 1), EndDate: endDate},
+				}, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			test.setMocks(d)
+
+			reservation := domain.Reservation{
+				ID:             uuid.New(),
+				StartDate:      startDate,
+				EndDate:        endDate,
+				PickupBranchID: &pickupBranchID,
+				AddOns:         test.args.addOns,
+			}
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			_, err := reservationsService.checkAddOns(context.TODO(), reservation)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestReservationsAddOnsPricing(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	childSeat := domain.AddOn{ID: uuid.New(), Name: "Child seat", Price: 12.5, PricingUnit: "Per Day", Currency: "USD"}
+	gps := domain.AddOn{ID: uuid.New(), Name: "GPS", Price: 19.99, PricingUnit: "Per Rental", Currency: "USD"}
+
+	selected := []domain.ReservationAddOn{{AddOnID: childSeat.ID, Quantity: 2}, {AddOnID: gps.ID, Quantity: 1}}
+	reservation := domain.Reservation{
+		// two days and one hour, so per day add-ons are charged for three days
+		StartDate: time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+		EndDate:   time.Date(2030, time.July, 3, 10, 0, 0, 0, chicago),
+		AddOns:    selected,
+		Currency:  "USD",
+	}
+
+	prices := unitPrices{hourlyRent: usd(reservationsCar.HourlyRentCost), driverDailyFee: usd(0), addOns: []domain.Money{usd(childSeat.Price), usd(gps.Price)}}
+	priced := pricedReservation(reservation, prices, []domain.AddOn{childSeat, gps}, domain.ReservationProtection{}, chicago)
+
+	assert.Equal(t, []domain.ReservationAddOn{
+		{AddOnID: childSeat.ID, Quantity: 2, Cost: usd(75)},
+		{AddOnID: gps.ID, Quantity: 1, Cost: usd(19.99)},
+	}, priced.AddOns)
+	assert.Equal(t, usd(94.99), priced.AddOnsCost)
+	assert.Equal(t, domain.Money{}, selected[0].Cost)
+}
+
+func TestReservationsProtectionPlan(t *testing.T) {
+	initConstantsFromServices(t)
+
+	standard := domain.ProtectionPlan{ID: uuid.New(), Level: "Standard", CarType: reservationsCar.Type, DailyPrice: 15, Deductible: 500, Currency: "USD"}
+
+	type args struct {
+		level string
+	}
+	type wants struct {
+		plan domain.ProtectionPlan
+		err  error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "uses the default protection level when none is chosen",
+			args: args{
+				level: "",
+			},
+			wants: wants{
+				plan: domain.ProtectionPlan{Level: "Basic", CarType: reservationsCar.Type},
+				err:  nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Basic", reservationsCar.Type).Return(domain.ProtectionPlan{Level: "Basic", CarType: reservationsCar.Type}, nil)
+			},
+		},
+		{
+			name: "returns the plan of the chosen level for the car type",
+			args: args{
+				level: "Standard",
+			},
+			wants: wants{
+				plan: standard,
+				err:  nil,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Standard", reservationsCar.Type).Return(standard, nil)
+			},
+		},
+		{
+			name: "returns error when the level is not offered for the car type",
+			args: args{
+				level: "Full",
+			},
+			wants: wants{
+				plan: domain.ProtectionPlan{},
+				err:  errors.New(ErrProtectionPlanNotOffered),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.protectionPlansRepository.EXPECT().GetByLevelAndCarType(gomock.Any(), "Full", reservationsCar.Type).Return(domain.ProtectionPlan{}, errors.New(ErrProtectionPlanNotOffered))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			test.setMocks(d)
+
+			reservation := domain.Reservation{
+				ID:         uuid.New(),
+				Protection: domain.ReservationProtection{Level: test.args.level},
+			}
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			plan, err := reservationsService.protectionPlan(context.TODO(), reservation, reservationsCar)
+
+			assert.Equal(t, test.wants.plan, plan)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestReservationsProtectionPricing(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	protection := domain.ReservationProtection{Level: "Standard", DailyPrice: usd(15.35), Deductible: usd(500)}
+	reservation := domain.Reservation{
+		// two days and one hour, so the protection is charged for three days
+		StartDate: time.Date(2030, time.July, 1, 9, 0, 0, 0, chicago),
+		EndDate:   time.Date(2030, time.July, 3, 10, 0, 0, 0, chicago),
+		Currency:  "USD",
+	}
+
+	priced := pricedReservation(reservation, unitPrices{hourlyRent: usd(reservationsCar.HourlyRentCost), driverDailyFee: usd(0)}, nil, protection, chicago)
+
+	assert.Equal(t, protection, priced.Protection)
+	assert.Equal(t, usd(46.05), priced.ProtectionCost)
+	assert.Equal(t, priced.RentalCost.Add(usd(46.05)), priced.Quote().Total)
+}
+
+func TestReservationsExtend(t *testing.T) {
+	initConstantsFromServices(t)
+
+	chicago, err := time.LoadLocation(reservationsCity.TimeZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	car := reservationsCar
+	car.ID = uuid.New()
+	car.HourlyRentCost = 10
+	// two days, charged 480 for the car and 20 for the protection
+	reservation := domain.Reservation{
+		ID:             uuid.New(),
+		UserID:         reservationsUser.ID,
+		CarID:          car.ID,
+		Status:         "Reserved",
+		PaymentStatus:  "Pending",
+		StartDate:      time.Date(2030, time.July, 1, 10, 0, 0, 0, chicago),
+		EndDate:        time.Date(2030, time.July, 3, 10, 0, 0, 0, chicago),
+		RentalCost:     usd(480),
+		Protection:     domain.ReservationProtection{Level: "Basic", DailyPrice: usd(10), Deductible: usd(1500)},
+		ProtectionCost: usd(20),
+		Currency:       "USD",
+	}
+	// 74 hours, charged 740 for the car and 40 for the protection
+	endDate := time.Date(2030, time.July, 4, 12, 0, 0, 0, chicago)
+	next := domain.Reservation{ID: uuid.New(), CarID: car.ID, Status: "Reserved", StartDate: time.Date(2030, time.July, 3, 20, 0, 0, 0, chicago), EndDate: time.Date(2030, time.July, 6, 10, 0, 0, 0, chicago)}
+	later := domain.Reservation{ID: uuid.New(), CarID: car.ID, Status: "Reserved", StartDate: time.Date(2030, time.July, 4, 8, 0, 0, 0, chicago), EndDate: time.Date(2030, time.July, 5, 10, 0, 0, 0, chicago)}
+	overlapping := domain.Reservation{ID: uuid.New(), CarID: car.ID, Status: "Reserved", StartDate: time.Date(2030, time.July, 2, 10, 0, 0, 0, chicago), EndDate: time.Date(2030, time.July, 5, 10, 0, 0, 0, chicago)}
+	canceled := overlapping
+	canceled.ID = uuid.New()
+	canceled.Status = "Canceled"
+	maintenance := domain.Maintenance{ID: uuid.New(), CarID: car.ID, StartDate: time.Date(2030, time.July, 4, 0, 0, 0, 0, chicago), EndDate: time.Date(2030, time.July, 4, 18, 0, 0, 0, chicago)}
+	transfer := domain.Transfer{ID: uuid.New(), CarID: car.ID, DepartureDate: time.Date(2030, time.July, 3, 16, 0, 0, 0, chicago)}
+	completed := reservation
+	completed.Status = "Completed"
+	authorized := reservation
+	authorized.PaymentStatus = "Authorized"
+	// booked with a 10% sales tax on the car, when it was rented at 10 an hour
+	withTaxes := reservation
+	withTaxes.Taxes = []domain.ReservationTax{{Name: "Sales tax", Rate: 0.1, Amount: usd(48)}}
+	withTaxes.TaxTotal = usd(48)
+	repricedCar := car
+	repricedCar.HourlyRentCost = 12
+	// 47 hours and a half, charged 48 started hours and two started days
+	partial := reservation
+	partial.EndDate = time.Date(2030, time.July, 3, 9, 30, 0, 0, chicago)
+	salesTax := domain.TaxRule{Name: "Sales tax", Rate: 0.1, AppliesTo: []string{"Rental"}, Rounding: "Half Up"}
+	expiringLicense := *reservationsUser.DriverLicense
+	expiringLicense.ExpiryDate = time.Date(2030, time.July, 4, 0, 0, 0, 0, time.UTC)
+	userWithExpiringLicense := reservationsUser
+	userWithExpiringLicense.DriverLicense = &expiringLicense
+
+	type args struct {
+		endDate time.Time
+	}
+	type wants struct {
+		applied        bool
+		cost           float64
+		maximumEndDate time.Time
+		conflictingID  uuid.UUID
+		err            error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "applies the extension and charges the extra time when the car is free during it",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				applied: true,
+				cost:    280,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				// the reservation itself is in the extra time frame
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Reservation{reservation}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.carsRepository.EXPECT().Get(gomock.Any(), car.ID).Return(car, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), reservationsUser.ID).Return(reservationsUser, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), reservationsCity.ID).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Extend(gomock.Any(), gomock.Any(), reservation.EndDate).
+					DoAndReturn(func(ctx context.Context, extended domain.Reservation, previousEndDate time.Time) error {
+						assert.True(t, endDate.Equal(extended.EndDate))
+						assert.Equal(t, usd(740), extended.RentalCost)
+						assert.Equal(t, usd(40), extended.ProtectionCost)
+						assert.Equal(t, reservation.Protection, extended.Protection)
+						return nil
+					})
+			},
+		},
+		{
+			name: "charges what the extra time adds at the current price of the car and adds it to the prices of the reservation",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				applied: true,
+				// 26 more hours at 12 for the car, 2 more days of protection and the tax on the car
+				cost: 363.2,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(withTaxes, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.carsRepository.EXPECT().Get(gomock.Any(), car.ID).Return(repricedCar, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), reservationsUser.ID).Return(reservationsUser, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), reservationsCity.ID).Return([]domain.TaxRule{salesTax}, nil)
+				d.reservationsRepository.EXPECT().Extend(gomock.Any(), gomock.Any(), reservation.EndDate).
+					DoAndReturn(func(ctx context.Context, extended domain.Reservation, previousEndDate time.Time) error {
+						assert.Equal(t, usd(792), extended.RentalCost)
+						assert.Equal(t, usd(40), extended.ProtectionCost)
+						assert.Equal(t, []domain.ReservationTax{{Name: "Sales tax", Rate: 0.1, Amount: usd(79.2)}}, extended.Taxes)
+						assert.Equal(t, usd(79.2), extended.TaxTotal)
+						return nil
+					})
+			},
+		},
+		{
+			name: "does not charge again the hour and the day already started when the extension stays within them",
+			args: args{
+				endDate: reservation.EndDate,
+			},
+			wants: wants{
+				applied: true,
+				cost:    0,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(partial, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, partial.EndDate, reservation.EndDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, partial.EndDate, reservation.EndDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.carsRepository.EXPECT().Get(gomock.Any(), car.ID).Return(car, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), reservationsUser.ID).Return(reservationsUser, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), reservationsCity.ID).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Extend(gomock.Any(), gomock.Any(), partial.EndDate).
+					DoAndReturn(func(ctx context.Context, extended domain.Reservation, previousEndDate time.Time) error {
+						assert.True(t, reservation.EndDate.Equal(extended.EndDate))
+						assert.Equal(t, usd(480), extended.RentalCost)
+						assert.Equal(t, usd(20), extended.ProtectionCost)
+						return nil
+					})
+			},
+		},
+		{
+			name: "returns the earliest conflicting reservation and the latest possible end when the car is reserved during the extra time",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				maximumEndDate: next.StartDate,
+				conflictingID:  next.ID,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Reservation{later, reservation, next}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "returns the start of the maintenance as the latest possible end when the car is in maintenance during the extra time",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				maximumEndDate: maintenance.StartDate,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Maintenance{maintenance}, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "returns the departure of the transfer as the latest possible end when the car leaves during the extra time",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				maximumEndDate: transfer.DepartureDate,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Maintenance{maintenance}, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(transfer, nil)
+			},
+		},
+		{
+			name: "returns the current end as the latest possible end when a conflicting reservation starts before it",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				maximumEndDate: reservation.EndDate,
+				conflictingID:  overlapping.ID,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Reservation{next, overlapping}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "does not take into account canceled reservations during the extra time",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				maximumEndDate: next.StartDate,
+				conflictingID:  next.ID,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return([]domain.Reservation{next, canceled}, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+		{
+			name: "returns error when the license of the renter expires before the new end",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				err: errors.New(ErrDriverLicenseExpires),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.carsRepository.EXPECT().Get(gomock.Any(), car.ID).Return(car, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), reservationsUser.ID).Return(userWithExpiringLicense, nil)
+			},
+		},
+		{
+			name: "returns error when the car was taken after availability was checked",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				err: errors.New(ErrCarNotAvailable),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), car.ID).Return(reservationsCity, nil)
+				d.reservationsRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.maintenancesRepository.EXPECT().GetByCarIDAndTimeFrame(gomock.Any(), car.ID, reservation.EndDate, endDate).Return(nil, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), car.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.carsRepository.EXPECT().Get(gomock.Any(), car.ID).Return(car, nil)
+				d.usersRepository.EXPECT().Get(gomock.Any(), reservationsUser.ID).Return(reservationsUser, nil)
+				d.taxRulesRepository.EXPECT().ListByCityID(gomock.Any(), reservationsCity.ID).Return(nil, nil)
+				d.reservationsRepository.EXPECT().Extend(gomock.Any(), gomock.Any(), reservation.EndDate).Return(errors.New(ErrCarNotAvailable))
+			},
+		},
+		{
+			name: "returns error when the new end is not after the current one",
+			args: args{
+				endDate: reservation.EndDate,
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidExtensionEndDate),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(reservation, nil)
+			},
+		},
+		{
+			name: "returns error when the payment of the reservation was already authorized",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				err: errors.New(ErrReservationAlreadyPaid),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(authorized, nil)
+			},
+		},
+		{
+			name: "returns error when the reservation is no longer reserved",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				err: errors.New(ErrReservationNotExtendable),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(completed, nil)
+			},
+		},
+		{
+			name: "returns error when the reservation was not found",
+			args: args{
+				endDate: endDate,
+			},
+			wants: wants{
+				err: errors.New(ErrReservationNotFound),
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsRepository.EXPECT().Get(gomock.Any(), reservation.ID).Return(domain.Reservation{}, errors.New(ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			maintenancesRepo := mocks.NewMockMaintenancesRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			oneWayFeesRepo := mocks.NewMockOneWayFeesRepo(mockCtlr)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			carsRepo := mocks.NewMockCarsRepo(mockCtlr)
+			addOnsRepo := mocks.NewMockAddOnsRepo(mockCtlr)
+			protectionPlansRepo := mocks.NewMockProtectionPlansRepo(mockCtlr)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			exchangeRatesRepo := mocks.NewMockExchangeRatesRepo(mockCtlr)
+			d := NewReservationsDependencies(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			test.setMocks(d)
+
+			reservationsService := NewReservations(reservationsRepo, maintenancesRepo, citiesRepo, branchesRepo, oneWayFeesRepo, transfersRepo, usersRepo, carsRepo, addOnsRepo, protectionPlansRepo, taxRulesRepo, exchangeRatesRepo)
+			extension, err := reservationsService.Extend(context.TODO(), reservation.ID, test.args.endDate)
+
+			assert.Equal(t, test.wants.err, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.wants.applied, extension.Applied)
+			assert.Equal(t, usd(test.wants.cost), extension.Cost)
+			if test.wants.applied {
+				assert.Nil(t, extension.MaximumEndDate)
+				if assert.NotNil(t, extension.Reservation) {
+					assert.True(t, test.args.endDate.Equal(extension.Reservation.EndDate))
+				}
+			} else {
+				assert.Nil(t, extension.Reservation)
+				if assert.NotNil(t, extension.MaximumEndDate) {
+					assert.True(t, test.wants.maximumEndDate.Equal(*extension.MaximumEndDate))
+				}
+			}
+			if test.wants.conflictingID == uuid.Nil {
+				assert.Nil(t, extension.ConflictingReservation)
+			} else if assert.NotNil(t, extension.ConflictingReservation) {
+				assert.Equal(t, test.wants.conflictingID, extension.ConflictingReservation.ID)
+			}
+		})
+	}
+}
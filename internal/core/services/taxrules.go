@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTaxRuleNotFound      = "tax rule not found"
+	ErrTaxRuleExists        = "city already has a tax rule with that name"
+	ErrTaxRuleBranchNotCity = "branch of the tax rule is not in its city"
+)
+
+type TaxRules struct {
+	taxRulesRepository ports.TaxRulesRepo
+	branchesRepository ports.BranchesRepo
+}
+
+func NewTaxRules(trr ports.TaxRulesRepo, br ports.BranchesRepo) TaxRules {
+	return TaxRules{
+		taxRulesRepository: trr,
+		branchesRepository: br,
+	}
+}
+
+// Registers a tax rule. Reservations already booked keep the taxes they were booked with.
+func (trs TaxRules) Register(ctx context.Context, rule domain.TaxRule) (domain.TaxRule, error) {
+	if err := trs.checkBranch(ctx, rule); err != nil {
+		return domain.TaxRule{}, err
+	}
+
+	rule.ID = uuid.New()
+	if err := trs.taxRulesRepository.Insert(ctx, rule); err != nil {
+		return domain.TaxRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (trs TaxRules) Get(ctx context.Context, ID uuid.UUID) (domain.TaxRule, error) {
+	return trs.taxRulesRepository.Get(ctx, ID)
+}
+
+// Updates a tax rule. Reservations already booked keep the taxes they were booked with.
+func (trs TaxRules) FullUpdate(ctx context.Context, rule domain.TaxRule) error {
+	if err := trs.checkBranch(ctx, rule); err != nil {
+		return err
+	}
+
+	return trs.taxRulesRepository.FullUpdate(ctx, rule)
+}
+
+func (trs TaxRules) Delete(ctx context.Context, ID uuid.UUID) error {
+	return trs.taxRulesRepository.Delete(ctx, ID)
+}
+
+func (trs TaxRules) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error) {
+	return trs.taxRulesRepository.ListByCityID(ctx, cityID)
+}
+
+// Checks the branch the rule is limited to, if any, is in the city of the rule
+func (trs TaxRules) checkBranch(ctx context.Context, rule domain.TaxRule) error {
+	if rule.BranchID == nil {
+		return nil
+	}
+
+	branch, err := trs.branchesRepository.Get(ctx, *rule.BranchID)
+	if err != nil {
+		return err
+	}
+	if branch.CityID != rule.CityID {
+		return errors.New(ErrTaxRuleBranchNotCity)
+	}
+
+	return nil
+}
+
+// Sets the taxes of the reservation from the rules of the city of the car.
+// Each rule is charged on the parts of the price it applies to, and rounded
+// to the minor unit of the currency as its jurisdiction does. Rules limited to
+// a branch other than the pickup branch are left out.
+func taxedReservation(reservation domain.Reservation, rules []domain.TaxRule) domain.Reservation {
+	bases := constants.Values().TAX_BASES
+	baseAmounts := map[string]domain.Money{
+		bases.RENTAL:     reservation.RentalCost,
+		bases.ADD_ONS:    reservation.AddOnsCost,
+		bases.PROTECTION: reservation.ProtectionCost,
+		bases.FEES:       reservation.OneWayFee.Add(reservation.AdditionalDriversFee),
+	}
+
+	reservation.Taxes = []domain.ReservationTax{}
+	reservation.TaxTotal = domain.Money{Currency: reservation.Currency}
+	for _, rule := range rules {
+		if rule.BranchID != nil && (reservation.PickupBranchID == nil || *rule.BranchID != *reservation.PickupBranchID) {
+			continue
+		}
+
+		base := domain.Money{Currency: reservation.Currency}
+		for _, appliesTo := range rule.AppliesTo {
+			base = base.Add(baseAmounts[appliesTo])
+		}
+		amount := domain.Money{
+			Amount:   roundTax(float64(base.Amount)*rule.Rate, rule.Rounding),
+			Currency: reservation.Currency,
+		}
+
+		reservation.Taxes = append(reservation.Taxes, domain.ReservationTax{
+			Name:   rule.Name,
+			Rate:   rule.Rate,
+			Amount: amount,
+		})
+		reservation.TaxTotal = reservation.TaxTotal.Add(amount)
+	}
+
+	return reservation
+}
+
+// Rounds an amount of minor units to a whole number of them with the given rounding
+func roundTax(minorUnits float64, rounding string) int64 {
+	roundings := constants.Values().TAX_ROUNDINGS
+
+	// products such as 10000 * 0.11 are not exact, so they are first brought
+	// back to the minor units they stand for
+	minorUnits = math.Round(minorUnits*1e6) / 1e6
+	switch rounding {
+	case roundings.HALF_EVEN:
+		return int64(math.RoundToEven(minorUnits))
+	case roundings.UP:
+		return int64(math.Ceil(minorUnits))
+	case roundings.DOWN:
+		return int64(math.Floor(minorUnits))
+	default:
+		return int64(math.Round(minorUnits))
+	}
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type taxRulesDependencies struct {
+	taxRulesRepository *mocks.MockTaxRulesRepo
+	branchesRepository *mocks.MockBranchesRepo
+}
+
+func NewTaxRulesDependencies(taxRulesRepo *mocks.MockTaxRulesRepo, branchesRepo *mocks.MockBranchesRepo) *taxRulesDependencies {
+	return &taxRulesDependencies{
+		taxRulesRepository: taxRulesRepo,
+		branchesRepository: branchesRepo,
+	}
+}
+
+func TestTaxRulesRegister(t *testing.T) {
+	cityID := uuid.New()
+	airportID := uuid.New()
+	rule := domain.TaxRule{
+		CityID:       cityID,
+		Name:         "Sales tax",
+		Jurisdiction: "State of Illinois",
+		Rate:         0.1025,
+		AppliesTo:    []string{"Rental", "Add-ons", "Protection", "Fees"},
+		Rounding:     "Half Up",
+	}
+	airportRule := rule
+	airportRule.Name = "Airport surcharge"
+	airportRule.BranchID = &airportID
+
+	type args struct {
+		rule domain.TaxRule
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*taxRulesDependencies)
+	}{
+		{
+			name: "returns nil error when the tax rule was registered",
+			args: args{
+				rule: rule,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns nil error when the tax rule is limited to a branch of its city",
+			args: args{
+				rule: airportRule,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.branchesRepository.EXPECT().Get(gomock.Any(), airportID).Return(domain.Branch{ID: airportID, CityID: cityID}, nil)
+				d.taxRulesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the branch of the tax rule is in another city",
+			args: args{
+				rule: airportRule,
+			},
+			wants: wants{
+				err: errors.New(ErrTaxRuleBranchNotCity),
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.branchesRepository.EXPECT().Get(gomock.Any(), airportID).Return(domain.Branch{ID: airportID, CityID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns an error when the city already has a tax rule with the same name",
+			args: args{
+				rule: rule,
+			},
+			wants: wants{
+				err: errors.New(ErrTaxRuleExists),
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New(ErrTaxRuleExists))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			taxRulesRepo := mocks.NewMockTaxRulesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			d := NewTaxRulesDependencies(taxRulesRepo, branchesRepo)
+			test.setMocks(d)
+
+			taxRulesService := NewTaxRules(taxRulesRepo, branchesRepo)
+			dtr, err := taxRulesService.Register(context.TODO(), test.args.rule)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.NotEqual(t, uuid.Nil, dtr.ID)
+				assert.Equal(t, test.args.rule.Name, dtr.Name)
+			}
+		})
+	}
+}
+
+func TestTaxedReservation(t *testing.T) {
+	initConstantsFromServices(t)
+
+	airportID := uuid.New()
+	reservation := domain.Reservation{
+		RentalCost:           usd(100),
+		OneWayFee:            usd(15),
+		AdditionalDriversFee: usd(10),
+		AddOnsCost:           usd(20.5),
+		ProtectionCost:       usd(30),
+		Currency:             "USD",
+	}
+	atAirport := reservation
+	atAirport.PickupBranchID = &airportID
+	inYen := domain.Reservation{RentalCost: domain.NewMoney(1005, "JPY"), Currency: "JPY"}
+
+	type args struct {
+		reservation domain.Reservation
+		rules       []domain.TaxRule
+	}
+	type wants struct {
+		taxes    []domain.ReservationTax
+		taxTotal domain.Money
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "charges each rule on the parts of the price it applies to",
+			args: args{
+				reservation: reservation,
+				rules: []domain.TaxRule{
+					{Name: "Sales tax", Rate: 0.1, AppliesTo: []string{"Rental", "Add-ons", "Protection", "Fees"}, Rounding: "Half Up"},
+					{Name: "Tourism levy", Rate: 0.05, AppliesTo: []string{"Rental"}, Rounding: "Half Up"},
+					{Name: "Fee tax", Rate: 0.02, AppliesTo: []string{"Fees"}, Rounding: "Half Up"},
+				},
+			},
+			wants: wants{
+				taxes: []domain.ReservationTax{
+					{Name: "Sales tax", Rate: 0.1, Amount: usd(17.55)},
+					{Name: "Tourism levy", Rate: 0.05, Amount: usd(5)},
+					{Name: "Fee tax", Rate: 0.02, Amount: usd(0.5)},
+				},
+				taxTotal: usd(23.05),
+			},
+		},
+		{
+			name: "rounds each tax to cents as its jurisdiction does",
+			args: args{
+				// 30 in protection taxed at 0.0875 are 262.5 cents, and 20.5 in add-ons
+				// taxed at 0.11 are 225.5 cents
+				reservation: reservation,
+				rules: []domain.TaxRule{
+					{Name: "Half up", Rate: 0.0875, AppliesTo: []string{"Protection"}, Rounding: "Half Up"},
+					{Name: "Half even", Rate: 0.0875, AppliesTo: []string{"Protection"}, Rounding: "Half Even"},
+					{Name: "Up", Rate: 0.11, AppliesTo: []string{"Add-ons"}, Rounding: "Up"},
+					{Name: "Down", Rate: 0.11, AppliesTo: []string{"Add-ons"}, Rounding: "Down"},
+				},
+			},
+			wants: wants{
+				taxes: []domain.ReservationTax{
+					{Name: "Half up", Rate: 0.0875, Amount: usd(2.63)},
+					{Name: "Half even", Rate: 0.0875, Amount: usd(2.62)},
+					{Name: "Up", Rate: 0.11, Amount: usd(2.26)},
+					{Name: "Down", Rate: 0.11, Amount: usd(2.25)},
+				},
+				taxTotal: usd(9.76),
+			},
+		},
+		{
+			name: "rounds each tax to the minor unit of the currency",
+			args: args{
+				// 1005 yen taxed at 0.1 are 100.5 yen, and yen have no minor unit
+				reservation: inYen,
+				rules: []domain.TaxRule{
+					{Name: "Half up", Rate: 0.1, AppliesTo: []string{"Rental"}, Rounding: "Half Up"},
+					{Name: "Down", Rate: 0.1, AppliesTo: []string{"Rental"}, Rounding: "Down"},
+				},
+			},
+			wants: wants{
+				taxes: []domain.ReservationTax{
+					{Name: "Half up", Rate: 0.1, Amount: domain.NewMoney(101, "JPY")},
+					{Name: "Down", Rate: 0.1, Amount: domain.NewMoney(100, "JPY")},
+				},
+				taxTotal: domain.NewMoney(201, "JPY"),
+			},
+		},
+		{
+			name: "charges the rules of a branch only on rentals picked up there",
+			args: args{
+				reservation: atAirport,
+				rules: []domain.TaxRule{
+					{Name: "Airport surcharge", BranchID: &airportID, Rate: 0.11, AppliesTo: []string{"Rental"}, Rounding: "Up"},
+					{Name: "Other airport surcharge", BranchID: &uuid.UUID{}, Rate: 0.11, AppliesTo: []string{"Rental"}, Rounding: "Up"},
+				},
+			},
+			wants: wants{
+				taxes:    []domain.ReservationTax{{Name: "Airport surcharge", Rate: 0.11, Amount: usd(11)}},
+				taxTotal: usd(11),
+			},
+		},
+		{
+			name: "charges no taxes when the city has no rules",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				taxes:    []domain.ReservationTax{},
+				taxTotal: domain.Money{Currency: "USD"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taxed := taxedReservation(test.args.reservation, test.args.rules)
+
+			assert.Equal(t, test.wants.taxes, taxed.Taxes)
+			assert.Equal(t, test.wants.taxTotal, taxed.TaxTotal)
+			assert.Equal(t, test.args.reservation.Quote().Subtotal.Add(test.wants.taxTotal), taxed.Quote().Total)
+		})
+	}
+}
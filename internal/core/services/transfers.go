@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferNotFound             = "transfer was not found"
+	ErrInvalidTransferTimeFrame     = "transfer time frame is invalid"
+	ErrTransferSameCity             = "car is already in the destination city"
+	ErrTransferBranchNotInCity      = "destination branch does not belong to the destination city"
+	ErrTransferAlreadyScheduled     = "car already has a pending transfer"
+	ErrTransferOverlapsReservations = "car has reservations ending after the departure of the transfer"
+	ErrTransferCompleted            = "transfer was already completed"
+	ErrTransferNotDeparted          = "transfer can not be completed before its departure"
+)
+
+type Transfers struct {
+	transfersRepository    ports.TransfersRepo
+	reservationsRepository ports.ReservationsRepo
+	citiesRepository       ports.CitiesRepo
+	branchesRepository     ports.BranchesRepo
+}
+
+func NewTransfers(tr ports.TransfersRepo, rr ports.ReservationsRepo, cr ports.CitiesRepo, br ports.BranchesRepo) Transfers {
+	return Transfers{
+		transfersRepository:    tr,
+		reservationsRepository: rr,
+		citiesRepository:       cr,
+		branchesRepository:     br,
+	}
+}
+
+// Schedules the transfer of a car from its current city. The car can not
+// have reservations ending after the departure, as they would be picked up
+// in the city it is leaving, and only one transfer can be pending at a time.
+func (ts Transfers) Schedule(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	if isValid := utils.IsValidTimeFrame(transfer.DepartureDate, transfer.ArrivalDate); !isValid {
+		return domain.Transfer{}, errors.New(ErrInvalidTransferTimeFrame)
+	}
+
+	if transfer.DepartureDate.Before(time.Now()) {
+		return domain.Transfer{}, errors.New(ErrInvalidTransferTimeFrame)
+	}
+
+	city, err := ts.citiesRepository.GetByCarID(ctx, transfer.CarID)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+	if city.ID == transfer.ToCityID {
+		return domain.Transfer{}, errors.New(ErrTransferSameCity)
+	}
+	transfer.FromCityID = city.ID
+
+	if transfer.ToBranchID != nil {
+		branch, err := ts.branchesRepository.Get(ctx, *transfer.ToBranchID)
+		if err != nil {
+			return domain.Transfer{}, err
+		}
+		if branch.CityID != transfer.ToCityID {
+			return domain.Transfer{}, errors.New(ErrTransferBranchNotInCity)
+		}
+	}
+
+	_, err = ts.transfersRepository.GetPendingByCarID(ctx, transfer.CarID)
+	if err == nil {
+		return domain.Transfer{}, errors.New(ErrTransferAlreadyScheduled)
+	}
+	if err.Error() != ErrTransferNotFound {
+		return domain.Transfer{}, err
+	}
+
+	reservations, err := ts.reservationsRepository.GetByCarID(ctx, transfer.CarID)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	canceled := constants.Values().RESERVATION_STATUSES.CANCELED
+	for _, reservation := range reservations {
+		if reservation.Status != canceled && reservation.EndDate.After(transfer.DepartureDate) {
+			return domain.Transfer{}, errors.New(ErrTransferOverlapsReservations)
+		}
+	}
+
+	transfer.ID = uuid.New()
+	transfer.CompletedAt = nil
+	if err := ts.transfersRepository.Insert(ctx, transfer); err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (ts Transfers) Get(ctx context.Context, id uuid.UUID) (domain.Transfer, error) {
+	transfer, err := ts.transfersRepository.Get(ctx, id)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (ts Transfers) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error) {
+	return ts.transfersRepository.GetByCarID(ctx, carID)
+}
+
+// Completes a transfer once the car has departed, moving the car to the
+// destination city and branch.
+func (ts Transfers) Complete(ctx context.Context, id uuid.UUID) (domain.Transfer, error) {
+	transfer, err := ts.transfersRepository.Get(ctx, id)
+	if err != nil {
+		return domain.Transfer{}, err
+	}
+
+	if transfer.CompletedAt != nil {
+		return domain.Transfer{}, errors.New(ErrTransferCompleted)
+	}
+
+	completedAt := time.Now()
+	if completedAt.Before(transfer.DepartureDate) {
+		return domain.Transfer{}, errors.New(ErrTransferNotDeparted)
+	}
+
+	transfer.CompletedAt = &completedAt
+	if err := ts.transfersRepository.Complete(ctx, transfer); err != nil {
+		return domain.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// Cancels a pending transfer. Completed transfers can not be canceled.
+func (ts Transfers) Cancel(ctx context.Context, id uuid.UUID) error {
+	transfer, err := ts.transfersRepository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if transfer.CompletedAt != nil {
+		return errors.New(ErrTransferCompleted)
+	}
+
+	return ts.transfersRepository.Delete(ctx, id)
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type transfersDependencies struct {
+	transfersRepository    *mocks.MockTransfersRepo
+	reservationsRepository *mocks.MockReservationsRepo
+	citiesRepository       *mocks.MockCitiesRepo
+	branchesRepository     *mocks.MockBranchesRepo
+}
+
+func NewTransfersDependencies(transfersRepo *mocks.MockTransfersRepo, reservationsRepo *mocks.MockReservationsRepo, citiesRepo *mocks.MockCitiesRepo, branchesRepo *mocks.MockBranchesRepo) *transfersDependencies {
+	return &transfersDependencies{
+		transfersRepository:    transfersRepo,
+		reservationsRepository: reservationsRepo,
+		citiesRepository:       citiesRepo,
+		branchesRepository:     branchesRepo,
+	}
+}
+
+func TestTransfersSchedule(t *testing.T) {
+	initConstantsFromServices(t)
+	now := time.Now()
+	fromCity := domain.City{ID: uuid.New(), Name: "Chicago", TimeZone: "America/Chicago"}
+	toCityID := uuid.New()
+	toBranchID := uuid.New()
+	transfer := domain.Transfer{
+		CarID:         uuid.New(),
+		ToCityID:      toCityID,
+		ToBranchID:    &toBranchID,
+		DepartureDate: now.Add(48 * time.Hour),
+		ArrivalDate:   now.Add(72 * time.Hour),
+	}
+	// ends before the departure
+	pastReservation := domain.Reservation{
+		ID:        uuid.New(),
+		CarID:     transfer.CarID,
+		Status:    "Completed",
+		StartDate: now.Add(-72 * time.Hour),
+		EndDate:   now.Add(-48 * time.Hour),
+	}
+	// picked up in the city the car is leaving
+	laterReservation := domain.Reservation{
+		ID:        uuid.New(),
+		CarID:     transfer.CarID,
+		Status:    "Reserved",
+		StartDate: now.Add(96 * time.Hour),
+		EndDate:   now.Add(120 * time.Hour),
+	}
+	canceledReservation := laterReservation
+	canceledReservation.ID = uuid.New()
+	canceledReservation.Status = constants.Values().RESERVATION_STATUSES.CANCELED
+
+	type args struct {
+		transfer domain.Transfer
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*transfersDependencies)
+	}{
+		{
+			name: "returns nil error when the car has no reservations after the departure",
+			args: args{
+				transfer: transfer,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return(fromCity, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), toBranchID).Return(domain.Branch{ID: toBranchID, CityID: toCityID}, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), transfer.CarID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.reservationsRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return([]domain.Reservation{pastReservation, canceledReservation}, nil)
+				d.transfersRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, dt domain.Transfer) error {
+					assert.Equal(t, fromCity.ID, dt.FromCityID)
+					assert.NotEqual(t, uuid.Nil, dt.ID)
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "returns an error when the car has reservations ending after the departure",
+			args: args{
+				transfer: transfer,
+			},
+			wants: wants{
+				err: errors.New(ErrTransferOverlapsReservations),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return(fromCity, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), toBranchID).Return(domain.Branch{ID: toBranchID, CityID: toCityID}, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), transfer.CarID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+				d.reservationsRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return([]domain.Reservation{pastReservation, laterReservation}, nil)
+			},
+		},
+		{
+			name: "returns an error when the car already has a pending transfer",
+			args: args{
+				transfer: transfer,
+			},
+			wants: wants{
+				err: errors.New(ErrTransferAlreadyScheduled),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return(fromCity, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), toBranchID).Return(domain.Branch{ID: toBranchID, CityID: toCityID}, nil)
+				d.transfersRepository.EXPECT().GetPendingByCarID(gomock.Any(), transfer.CarID).Return(domain.Transfer{ID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns an error when the destination branch is in another city",
+			args: args{
+				transfer: transfer,
+			},
+			wants: wants{
+				err: errors.New(ErrTransferBranchNotInCity),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return(fromCity, nil)
+				d.branchesRepository.EXPECT().Get(gomock.Any(), toBranchID).Return(domain.Branch{ID: toBranchID, CityID: fromCity.ID}, nil)
+			},
+		},
+		{
+			name: "returns an error when the car is already in the destination city",
+			args: args{
+				transfer: transfer,
+			},
+			wants: wants{
+				err: errors.New(ErrTransferSameCity),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.citiesRepository.EXPECT().GetByCarID(gomock.Any(), transfer.CarID).Return(domain.City{ID: toCityID}, nil)
+			},
+		},
+		{
+			name: "returns an error when the arrival is not after the departure",
+			args: args{
+				transfer: domain.Transfer{
+					CarID:         transfer.CarID,
+					ToCityID:      toCityID,
+					DepartureDate: transfer.ArrivalDate,
+					ArrivalDate:   transfer.DepartureDate,
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidTransferTimeFrame),
+			},
+			setMocks: func(d *transfersDependencies) {},
+		},
+		{
+			name: "returns an error when the departure is in the past",
+			args: args{
+				transfer: domain.Transfer{
+					CarID:         transfer.CarID,
+					ToCityID:      toCityID,
+					DepartureDate: now.Add(-1 * time.Hour),
+					ArrivalDate:   transfer.ArrivalDate,
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidTransferTimeFrame),
+			},
+			setMocks: func(d *transfersDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			d := NewTransfersDependencies(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			test.setMocks(d)
+
+			transfersService := NewTransfers(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			scheduled, err := transfersService.Schedule(context.TODO(), test.args.transfer)
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, fromCity.ID, scheduled.FromCityID)
+				assert.Nil(t, scheduled.CompletedAt)
+			}
+		})
+	}
+}
+
+func TestTransfersComplete(t *testing.T) {
+	now := time.Now()
+	transfer := domain.Transfer{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		FromCityID:    uuid.New(),
+		ToCityID:      uuid.New(),
+		DepartureDate: now.Add(-24 * time.Hour),
+		ArrivalDate:   now.Add(-1 * time.Hour),
+	}
+	completedTransfer := transfer
+	completedTransfer.CompletedAt = &now
+	upcomingTransfer := transfer
+	upcomingTransfer.DepartureDate = now.Add(24 * time.Hour)
+	upcomingTransfer.ArrivalDate = now.Add(48 * time.Hour)
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*transfersDependencies)
+	}{
+		{
+			name: "completes the transfer and relocates the car",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(transfer, nil)
+				d.transfersRepository.EXPECT().Complete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, dt domain.Transfer) error {
+					assert.NotNil(t, dt.CompletedAt)
+					assert.Equal(t, transfer.ToCityID, dt.ToCityID)
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "returns an error when the transfer was already completed",
+			wants: wants{
+				err: errors.New(ErrTransferCompleted),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(completedTransfer, nil)
+			},
+		},
+		{
+			name: "returns an error when the car has not departed yet",
+			wants: wants{
+				err: errors.New(ErrTransferNotDeparted),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(upcomingTransfer, nil)
+			},
+		},
+		{
+			name: "returns an error when the transfer was not found",
+			wants: wants{
+				err: errors.New(ErrTransferNotFound),
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(domain.Transfer{}, errors.New(ErrTransferNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			d := NewTransfersDependencies(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			test.setMocks(d)
+
+			transfersService := NewTransfers(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			_, err := transfersService.Complete(context.TODO(), transfer.ID)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestTransfersCancel(t *testing.T) {
+	now := time.Now()
+	transfer := domain.Transfer{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		DepartureDate: now.Add(24 * time.Hour),
+		ArrivalDate:   now.Add(48 * time.Hour),
+	}
+	completedTransfer := transfer
+	completedTransfer.CompletedAt = &now
+
+	tests := []struct {
+		name     string
+		err      error
+		setMocks func(*transfersDependencies)
+	}{
+		{
+			name: "deletes a pending transfer",
+			err:  nil,
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(transfer, nil)
+				d.transfersRepository.EXPECT().Delete(gomock.Any(), transfer.ID).Return(nil)
+			},
+		},
+		{
+			name: "returns an error when the transfer was already completed",
+			err:  errors.New(ErrTransferCompleted),
+			setMocks: func(d *transfersDependencies) {
+				d.transfersRepository.EXPECT().Get(gomock.Any(), transfer.ID).Return(completedTransfer, nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			transfersRepo := mocks.NewMockTransfersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			branchesRepo := mocks.NewMockBranchesRepo(mockCtlr)
+			d := NewTransfersDependencies(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			test.setMocks(d)
+
+			transfersService := NewTransfers(transfersRepo, reservationsRepo, citiesRepo, branchesRepo)
+			err := transfersService.Cancel(context.TODO(), transfer.ID)
+
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
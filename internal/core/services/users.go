@@ -2,34 +2,62 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
 	"github.com/google/uuid"
 )
 
 var (
 	ErrUserNotFound           = "user not found"
 	ErrEmailAlreadyRegistered = "email already registered"
+	ErrEmailAlreadyVerified   = "email was already verified"
+	ErrUserEmailNotVerified   = "user email is not verified"
+	ErrInvalidToken           = "token is invalid, expired or was already used"
+	ErrUserInactive           = "user is inactive"
 )
 
 type Users struct {
-	usersRepository ports.UsersRepo
+	usersRepository        ports.UsersRepo
+	reservationsRepository ports.ReservationsRepo
+	userTokensRepository   ports.UserTokensRepo
+	mailer                 ports.Mailer
+	tokensSecret           []byte
 }
 
-func NewUsers(ur ports.UsersRepo) Users {
+func NewUsers(ur ports.UsersRepo, rr ports.ReservationsRepo, utr ports.UserTokensRepo, m ports.Mailer, tokensSecret string) Users {
 	return Users{
-		usersRepository: ur,
+		usersRepository:        ur,
+		reservationsRepository: rr,
+		userTokensRepository:   utr,
+		mailer:                 m,
+		tokensSecret:           []byte(tokensSecret),
 	}
 }
 
+// Registers a user with an unverified email and sends them the token to
+// verify it. The user is removed when the token can not be sent, so the
+// registration can be retried with the same email.
 func (us Users) Register(ctx context.Context, user domain.User) (domain.User, error) {
 	user.ID = uuid.New()
+	user.EmailVerifiedAt = nil
 
 	if err := us.usersRepository.Insert(ctx, user); err != nil {
 		return domain.User{}, err
 	}
 
+	if err := us.sendEmailVerification(ctx, user); err != nil {
+		// the token issued for the user goes along with it
+		us.usersRepository.Delete(ctx, user.ID)
+
+		return domain.User{}, err
+	}
+
 	return user, nil
 }
 
@@ -42,10 +70,163 @@ func (us Users) Get(ctx context.Context, ID uuid.UUID) (domain.User, error) {
 	return du, nil
 }
 
-func (us Users) FullUpdate(ctx context.Context, user domain.User) error {
-	return us.usersRepository.FullUpdate(ctx, user)
+// Updates the user. Inactive users can not book, so deactivating a user
+// returns their upcoming reservations to be handled.
+func (us Users) FullUpdate(ctx context.Context, user domain.User) ([]domain.Reservation, error) {
+	if err := us.usersRepository.FullUpdate(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if user.Status != constants.Values().USER_STATUSES.INACTIVE {
+		return nil, nil
+	}
+
+	reservations, err := us.reservationsRepository.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return upcomingReservations(reservations, time.Now()), nil
 }
 
 func (us Users) Delete(ctx context.Context, id uuid.UUID) error {
 	return us.usersRepository.Delete(ctx, id)
 }
+
+// Lists the users matching the filters. fromUserID is the last user seen in
+// the previous page.
+func (us Users) List(ctx context.Context, filters domain.UserFilters, fromUserID string) ([]domain.User, error) {
+	values := constants.Values()
+	if fromUserID == "" {
+		fromUserID = values.NULL_UUID
+	}
+
+	users, err := us.usersRepository.List(ctx, filters, fromUserID, values.USERS_PER_PAGE)
+	if err != nil {
+		return []domain.User{}, err
+	}
+
+	return users, nil
+}
+
+// Sends a new email verification token to a user whose email is not verified yet
+func (us Users) SendEmailVerification(ctx context.Context, id uuid.UUID) error {
+	user, err := us.usersRepository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return errors.New(ErrEmailAlreadyVerified)
+	}
+
+	return us.sendEmailVerification(ctx, user)
+}
+
+// Redeems an email verification token, marking the email of its user as verified
+func (us Users) VerifyEmail(ctx context.Context, token string) error {
+	userToken, err := us.redeemToken(ctx, token, constants.Values().USER_TOKEN_PURPOSES.EMAIL_VERIFICATION)
+	if err != nil {
+		return err
+	}
+
+	return us.userTokensRepository.VerifyEmail(ctx, userToken)
+}
+
+// Sends a password reset token to the user with the given email. Unknown
+// emails are ignored so the response does not tell which emails are registered.
+func (us Users) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := us.usersRepository.GetByEmail(ctx, email)
+	if err != nil {
+		if err.Error() == ErrUserNotFound {
+			return nil
+		}
+		return err
+	}
+
+	validFor := time.Duration(constants.Values().PASSWORD_RESET_TOKEN_MINUTES) * time.Minute
+	token, err := us.issueToken(ctx, user.ID, constants.Values().USER_TOKEN_PURPOSES.PASSWORD_RESET, validFor)
+	if err != nil {
+		return err
+	}
+
+	return us.mailer.Send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse this token to set a new password. It expires in %d minutes.\n\n%s\n\nIf you did not ask for it, you can ignore this email.\n",
+			user.FirstName, constants.Values().PASSWORD_RESET_TOKEN_MINUTES, token),
+	})
+}
+
+// Redeems a password reset token, setting the new password of its user
+func (us Users) ResetPassword(ctx context.Context, token string, password string) error {
+	userToken, err := us.redeemToken(ctx, token, constants.Values().USER_TOKEN_PURPOSES.PASSWORD_RESET)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return us.userTokensRepository.ResetPassword(ctx, userToken, passwordHash)
+}
+
+func (us Users) sendEmailVerification(ctx context.Context, user domain.User) error {
+	validFor := time.Duration(constants.Values().EMAIL_VERIFICATION_TOKEN_HOURS) * time.Hour
+	token, err := us.issueToken(ctx, user.ID, constants.Values().USER_TOKEN_PURPOSES.EMAIL_VERIFICATION, validFor)
+	if err != nil {
+		return err
+	}
+
+	return us.mailer.Send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nUse this token to verify your email. It expires in %d hours.\n\n%s\n",
+			user.FirstName, constants.Values().EMAIL_VERIFICATION_TOKEN_HOURS, token),
+	})
+}
+
+// Stores a new token for the user and returns it signed, ready to be sent
+func (us Users) issueToken(ctx context.Context, userID uuid.UUID, purpose string, validFor time.Duration) (string, error) {
+	userToken := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		ExpiresAt: time.Now().Add(validFor),
+	}
+	if err := us.userTokensRepository.Insert(ctx, userToken); err != nil {
+		return "", err
+	}
+
+	return utils.SignToken(us.tokensSecret, userToken.ID.String()), nil
+}
+
+// Checks the signature, purpose, expiry and use of a token. The returned token
+// is marked as used now, repositories must only redeem it if it is still unused.
+func (us Users) redeemToken(ctx context.Context, token string, purpose string) (domain.UserToken, error) {
+	payload, ok := utils.VerifyToken(us.tokensSecret, token)
+	if !ok {
+		return domain.UserToken{}, errors.New(ErrInvalidToken)
+	}
+
+	tokenID, err := uuid.Parse(payload)
+	if err != nil {
+		return domain.UserToken{}, errors.New(ErrInvalidToken)
+	}
+
+	userToken, err := us.userTokensRepository.Get(ctx, tokenID)
+	if err != nil {
+		return domain.UserToken{}, err
+	}
+
+	usedAt := time.Now()
+	if userToken.Purpose != purpose || userToken.UsedAt != nil || !usedAt.Before(userToken.ExpiresAt) {
+		return domain.UserToken{}, errors.New(ErrInvalidToken)
+	}
+
+	userToken.UsedAt = &usedAt
+
+	return userToken, nil
+}
@@ -3,10 +3,13 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,16 +18,24 @@ import (
 type usersDependencies struct {
 	usersRepository        *mocks.MockUsersRepo
 	reservationsRepository *mocks.MockReservationsRepo
+	userTokensRepository   *mocks.MockUserTokensRepo
+	mailer                 *mocks.MockMailer
 }
 
-func NewUsersDependencies(usersRepo *mocks.MockUsersRepo, reservationsRepo *mocks.MockReservationsRepo) *usersDependencies {
+func NewUsersDependencies(usersRepo *mocks.MockUsersRepo, reservationsRepo *mocks.MockReservationsRepo, userTokensRepo *mocks.MockUserTokensRepo, mailer *mocks.MockMailer) *usersDependencies {
 	return &usersDependencies{
 		usersRepository:        usersRepo,
 		reservationsRepository: reservationsRepo,
+		userTokensRepository:   userTokensRepo,
+		mailer:                 mailer,
 	}
 }
 
+const usersTokensSecret = "tokens secret"
+
 func TestUsersRegister(t *testing.T) {
+	initConstantsFromServices(t)
+
 	type args struct {
 		ctx  context.Context
 		user domain.User
@@ -56,6 +67,39 @@ func TestUsersRegister(t *testing.T) {
 			},
 			setMocks: func(d *usersDependencies) {
 				d.usersRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.userTokensRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, token domain.UserToken) error {
+						assert.Equal(t, "Email Verification", token.Purpose)
+						assert.WithinDuration(t, time.Now().Add(48*time.Hour), token.ExpiresAt, time.Minute)
+						return nil
+					})
+				d.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, email domain.Email) error {
+						assert.Equal(t, "richard.feynman@caltech.edu.us", email.To)
+						return nil
+					})
+			},
+		},
+		{
+			name: "returns an error and removes the user when the verification email can not be sent",
+			args: args{
+				ctx: context.TODO(),
+				user: domain.User{
+					FirstName: "Richard",
+					LastName:  "Feynman",
+					Email:     "richard.feynman@caltech.edu.us",
+					Type:      "Customer",
+					Status:    "Active",
+				},
+			},
+			wants: wants{
+				withError: true,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.userTokensRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+				d.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+				d.usersRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -85,10 +129,12 @@ func TestUsersRegister(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewUsersDependencies(usersRepo, reservationsRepo)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
 			test.setMocks(d)
 
-			usersService := NewUsers(usersRepo)
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
 			_, err := usersService.Register(test.args.ctx, test.args.user)
 
 			assert.Equal(t, test.wants.withError, err != nil)
@@ -154,10 +200,12 @@ func TestUsersGet(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewUsersDependencies(usersRepo, reservationsRepo)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
 			test.setMocks(d)
 
-			usersService := NewUsers(usersRepo)
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
 			user, err := usersService.Get(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.user, user)
@@ -167,6 +215,8 @@ func TestUsersGet(t *testing.T) {
 }
 
 func TestUsersFullUpdate(t *testing.T) {
+	initConstantsFromServices(t)
+
 	user := domain.User{
 		ID:        uuid.New(),
 		FirstName: "Richard",
@@ -175,13 +225,26 @@ func TestUsersFullUpdate(t *testing.T) {
 		Type:      "Customer",
 		Status:    "Active",
 	}
+	inactiveUser := user
+	inactiveUser.Status = "Inactive"
+	upcomingReservation := domain.Reservation{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Status:    "Reserved",
+		StartDate: time.Now().Add(-time.Hour),
+		EndDate:   time.Now().AddDate(0, 0, 2),
+	}
+	completedReservation := upcomingReservation
+	completedReservation.ID = uuid.New()
+	completedReservation.Status = "Completed"
 
 	type args struct {
 		ctx  context.Context
 		user domain.User
 	}
 	type wants struct {
-		err error
+		affectedReservations []domain.Reservation
+		err                  error
 	}
 	tests := []struct {
 		name     string
@@ -196,12 +259,43 @@ func TestUsersFullUpdate(t *testing.T) {
 				user: user,
 			},
 			wants: wants{
-				err: nil,
+				affectedReservations: nil,
+				err:                  nil,
 			},
 			setMocks: func(d *usersDependencies) {
 				d.usersRepository.EXPECT().FullUpdate(gomock.Any(), user).Return(nil)
 			},
 		},
+		{
+			name: "returns the upcoming reservations when user is deactivated",
+			args: args{
+				ctx:  context.TODO(),
+				user: inactiveUser,
+			},
+			wants: wants{
+				affectedReservations: []domain.Reservation{upcomingReservation},
+				err:                  nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().FullUpdate(gomock.Any(), inactiveUser).Return(nil)
+				d.reservationsRepository.EXPECT().GetByUserID(gomock.Any(), user.ID).Return([]domain.Reservation{upcomingReservation, completedReservation}, nil)
+			},
+		},
+		{
+			name: "returns an error when reservations of the deactivated user can not be retrieved",
+			args: args{
+				ctx:  context.TODO(),
+				user: inactiveUser,
+			},
+			wants: wants{
+				affectedReservations: nil,
+				err:                  errors.New("connection refused"),
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().FullUpdate(gomock.Any(), inactiveUser).Return(nil)
+				d.reservationsRepository.EXPECT().GetByUserID(gomock.Any(), user.ID).Return(nil, errors.New("connection refused"))
+			},
+		},
 		{
 			name: "returns an error when user update fails",
 			args: args{
@@ -209,7 +303,8 @@ func TestUsersFullUpdate(t *testing.T) {
 				user: user,
 			},
 			wants: wants{
-				err: errors.New("failure while updating user"),
+				affectedReservations: nil,
+				err:                  errors.New("failure while updating user"),
 			},
 			setMocks: func(d *usersDependencies) {
 				d.usersRepository.EXPECT().FullUpdate(gomock.Any(), user).Return(errors.New("failure while updating user"))
@@ -222,12 +317,15 @@ func TestUsersFullUpdate(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewUsersDependencies(usersRepo, reservationsRepo)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
 			test.setMocks(d)
 
-			usersService := NewUsers(usersRepo)
-			err := usersService.FullUpdate(test.args.ctx, test.args.user)
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+			affectedReservations, err := usersService.FullUpdate(test.args.ctx, test.args.user)
 
+			assert.Equal(t, test.wants.affectedReservations, affectedReservations)
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
@@ -280,13 +378,392 @@ func TestUsersDelete(t *testing.T) {
 			mockCtlr := gomock.NewController(t)
 			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
 			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
-			d := NewUsersDependencies(usersRepo, reservationsRepo)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
 			test.setMocks(d)
 
-			usersService := NewUsers(usersRepo)
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
 			err := usersService.Delete(test.args.ctx, test.args.ID)
 
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
 }
+
+func TestUsersList(t *testing.T) {
+	initConstantsFromServices(t)
+
+	users := []domain.User{
+		{ID: uuid.New(), FirstName: "Isaac", LastName: "Newton", Email: "isaac.newton@cam.ac.uk", Type: "Customer", Status: "Active"},
+		{ID: uuid.New(), FirstName: "Ada", LastName: "Lovelace", Email: "ada@lovelace.org", Type: "Admin", Status: "Active"},
+	}
+	filters := domain.UserFilters{Status: "Active", Search: "isa"}
+
+	type args struct {
+		filters    domain.UserFilters
+		fromUserID string
+	}
+	type wants struct {
+		users []domain.User
+		err   error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name: "lists from the first user when no user id is given",
+			args: args{
+				filters:    filters,
+				fromUserID: "",
+			},
+			wants: wants{
+				users: users,
+				err:   nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().List(gomock.Any(), filters, "00000000-0000-0000-0000-000000000000", uint16(20)).Return(users, nil)
+			},
+		},
+		{
+			name: "lists from the given user id",
+			args: args{
+				filters:    domain.UserFilters{},
+				fromUserID: users[0].ID.String(),
+			},
+			wants: wants{
+				users: users[1:],
+				err:   nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().List(gomock.Any(), domain.UserFilters{}, users[0].ID.String(), uint16(20)).Return(users[1:], nil)
+			},
+		},
+		{
+			name: "returns an error when the repository fails",
+			args: args{
+				filters:    filters,
+				fromUserID: "",
+			},
+			wants: wants{
+				users: []domain.User{},
+				err:   errors.New("failure listing users"),
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().List(gomock.Any(), filters, gomock.Any(), uint16(20)).Return(nil, errors.New("failure listing users"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
+			test.setMocks(d)
+
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+			foundUsers, err := usersService.List(context.TODO(), test.args.filters, test.args.fromUserID)
+
+			assert.Equal(t, test.wants.users, foundUsers)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestUsersVerifyEmail(t *testing.T) {
+	initConstantsFromServices(t)
+
+	userToken := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   "Email Verification",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	token := utils.SignToken([]byte(usersTokensSecret), userToken.ID.String())
+	usedAt := time.Now().Add(-time.Minute)
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		token    string
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name:  "verifies the email of the user of the token",
+			token: token,
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.userTokensRepository.EXPECT().Get(gomock.Any(), userToken.ID).Return(userToken, nil)
+				d.userTokensRepository.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, redeemed domain.UserToken) error {
+						assert.Equal(t, userToken.UserID, redeemed.UserID)
+						assert.NotNil(t, redeemed.UsedAt)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "returns an error when the token was signed with another secret",
+			token: utils.SignToken([]byte("another secret"), userToken.ID.String()),
+			wants: wants{
+				err: errors.New(ErrInvalidToken),
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name:  "returns an error when the token was issued for a password reset",
+			token: token,
+			wants: wants{
+				err: errors.New(ErrInvalidToken),
+			},
+			setMocks: func(d *usersDependencies) {
+				passwordResetToken := userToken
+				passwordResetToken.Purpose = "Password Reset"
+				d.userTokensRepository.EXPECT().Get(gomock.Any(), userToken.ID).Return(passwordResetToken, nil)
+			},
+		},
+		{
+			name:  "returns an error when the token has expired",
+			token: token,
+			wants: wants{
+				err: errors.New(ErrInvalidToken),
+			},
+			setMocks: func(d *usersDependencies) {
+				expiredToken := userToken
+				expiredToken.ExpiresAt = time.Now().Add(-time.Second)
+				d.userTokensRepository.EXPECT().Get(gomock.Any(), userToken.ID).Return(expiredToken, nil)
+			},
+		},
+		{
+			name:  "returns an error when the token was already used",
+			token: token,
+			wants: wants{
+				err: errors.New(ErrInvalidToken),
+			},
+			setMocks: func(d *usersDependencies) {
+				usedToken := userToken
+				usedToken.UsedAt = &usedAt
+				d.userTokensRepository.EXPECT().Get(gomock.Any(), userToken.ID).Return(usedToken, nil)
+			},
+		},
+		{
+			name:  "returns an error when the token is redeemed concurrently",
+			token: token,
+			wants: wants{
+				err: errors.New(ErrInvalidToken),
+			},
+			setMocks: func(d *usersDependencies) {
+				d.userTokensRepository.EXPECT().Get(gomock.Any(), userToken.ID).Return(userToken, nil)
+				d.userTokensRepository.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Return(errors.New(ErrInvalidToken))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
+			test.setMocks(d)
+
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+			err := usersService.VerifyEmail(context.TODO(), test.token)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestUsersSendEmailVerification(t *testing.T) {
+	initConstantsFromServices(t)
+
+	verifiedAt := time.Now()
+	user := domain.User{ID: uuid.New(), FirstName: "Richard", Email: "richard.feynman@caltech.edu.us"}
+
+	t.Run("returns an error when the email was already verified", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+		mailer := mocks.NewMockMailer(mockCtlr)
+
+		verifiedUser := user
+		verifiedUser.EmailVerifiedAt = &verifiedAt
+		usersRepo.EXPECT().Get(gomock.Any(), user.ID).Return(verifiedUser, nil)
+
+		usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+		err := usersService.SendEmailVerification(context.TODO(), user.ID)
+
+		assert.Equal(t, errors.New(ErrEmailAlreadyVerified), err)
+	})
+
+	t.Run("sends a token that can be verified with the secret", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+		mailer := mocks.NewMockMailer(mockCtlr)
+
+		var tokenID uuid.UUID
+		usersRepo.EXPECT().Get(gomock.Any(), user.ID).Return(user, nil)
+		userTokensRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, token domain.UserToken) error {
+				tokenID = token.ID
+				return nil
+			})
+		mailer.EXPECT().Send(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, email domain.Email) error {
+				assert.Contains(t, email.Body, utils.SignToken([]byte(usersTokensSecret), tokenID.String()))
+				return nil
+			})
+
+		usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+		err := usersService.SendEmailVerification(context.TODO(), user.ID)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestUsersRequestPasswordReset(t *testing.T) {
+	initConstantsFromServices(t)
+
+	user := domain.User{ID: uuid.New(), FirstName: "Richard", Email: "richard.feynman@caltech.edu.us"}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		email    string
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name:  "sends a password reset token to the user",
+			email: user.Email,
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+				d.userTokensRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, token domain.UserToken) error {
+						assert.Equal(t, user.ID, token.UserID)
+						assert.Equal(t, "Password Reset", token.Purpose)
+						assert.WithinDuration(t, time.Now().Add(60*time.Minute), token.ExpiresAt, time.Minute)
+						return nil
+					})
+				d.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, email domain.Email) error {
+						assert.Equal(t, user.Email, email.To)
+						assert.True(t, strings.Contains(email.Subject, "password"))
+						return nil
+					})
+			},
+		},
+		{
+			name:  "does not tell that the email is not registered",
+			email: "unknown@caltech.edu.us",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().GetByEmail(gomock.Any(), "unknown@caltech.edu.us").Return(domain.User{}, errors.New(ErrUserNotFound))
+			},
+		},
+		{
+			name:  "returns an error when the users repository fails",
+			email: user.Email,
+			wants: wants{
+				err: errors.New("connection refused"),
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(domain.User{}, errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+			reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+			userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+			mailer := mocks.NewMockMailer(mockCtlr)
+			d := NewUsersDependencies(usersRepo, reservationsRepo, userTokensRepo, mailer)
+			test.setMocks(d)
+
+			usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+			err := usersService.RequestPasswordReset(context.TODO(), test.email)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestUsersResetPassword(t *testing.T) {
+	initConstantsFromServices(t)
+
+	userToken := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   "Password Reset",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	token := utils.SignToken([]byte(usersTokensSecret), userToken.ID.String())
+
+	t.Run("stores the hash of the new password", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+		mailer := mocks.NewMockMailer(mockCtlr)
+
+		userTokensRepo.EXPECT().Get(gomock.Any(), userToken.ID).Return(userToken, nil)
+		userTokensRepo.EXPECT().ResetPassword(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, redeemed domain.UserToken, passwordHash string) error {
+				assert.Equal(t, userToken.UserID, redeemed.UserID)
+				assert.NotNil(t, redeemed.UsedAt)
+				assert.True(t, strings.HasPrefix(passwordHash, "pbkdf2-sha256$"))
+				assert.NotContains(t, passwordHash, "correct horse battery staple")
+				return nil
+			})
+
+		usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+		err := usersService.ResetPassword(context.TODO(), token, "correct horse battery staple")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("returns an error when the token was issued to verify an email", func(t *testing.T) {
+		mockCtlr := gomock.NewController(t)
+		usersRepo := mocks.NewMockUsersRepo(mockCtlr)
+		reservationsRepo := mocks.NewMockReservationsRepo(mockCtlr)
+		userTokensRepo := mocks.NewMockUserTokensRepo(mockCtlr)
+		mailer := mocks.NewMockMailer(mockCtlr)
+
+		emailVerificationToken := userToken
+		emailVerificationToken.Purpose = "Email Verification"
+		userTokensRepo.EXPECT().Get(gomock.Any(), userToken.ID).Return(emailVerificationToken, nil)
+
+		usersService := NewUsers(usersRepo, reservationsRepo, userTokensRepo, mailer, usersTokensSecret)
+		err := usersService.ResetPassword(context.TODO(), token, "correct horse battery staple")
+
+		assert.Equal(t, errors.New(ErrInvalidToken), err)
+	})
+}
@@ -0,0 +1,76 @@
+package inbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+// Memory keeps the sent emails in memory instead of delivering them. It is
+// meant for tests.
+type Memory struct {
+	mu     sync.Mutex
+	emails []domain.Email
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Send(ctx context.Context, email domain.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.emails = append(m.emails, email)
+
+	return nil
+}
+
+// Gets the emails sent so far, oldest first
+func (m *Memory) Emails() []domain.Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]domain.Email(nil), m.emails...)
+}
+
+// File writes each sent email as a JSON file inside a directory instead of
+// delivering it, so emails can be read during local development.
+type File struct {
+	dir string
+}
+
+func NewFile(dir string) (*File, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &File{
+		dir: dir,
+	}, nil
+}
+
+// Writes the email to a file named after the time it was sent, so files
+// are listed in the order emails were sent.
+func (f *File) Send(ctx context.Context, email domain.Email) error {
+	content, err := json.MarshalIndent(email, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.json", time.Now().UTC().Format("20060102T150405.000000000"), uuid.New())
+
+	return os.WriteFile(filepath.Join(f.dir, name), content, 0o644)
+}
@@ -0,0 +1,137 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	netsmtp "net/smtp"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidHeader = "email headers can not contain line breaks"
+)
+
+// Mailer sends emails through an SMTP server. STARTTLS is used whenever the
+// server supports it, and it is required to authenticate.
+type Mailer struct {
+	address  string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// Creates a mailer for the server at address (host:port). Emails are sent
+// without authentication when username is empty.
+func NewMailer(address string, username string, password string, from string) (*Mailer, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mailer{
+		address:  address,
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}, nil
+}
+
+func (m *Mailer) Send(ctx context.Context, email domain.Email) (err error) {
+	message, err := buildMessage(m.from, email, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.address)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := netsmtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+
+	if m.username != "" {
+		if err := client.Auth(netsmtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(m.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(email.To); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// Builds a plain text RFC 5322 message. The subject is encoded so it can hold
+// non ASCII characters.
+func buildMessage(from string, email domain.Email, date time.Time) ([]byte, error) {
+	for _, header := range []string{from, email.To, email.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, errors.New(ErrInvalidHeader)
+		}
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", email.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Message-ID: <%s@%s>\r\n", uuid.New(), messageIDDomain(from))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	message.WriteString("\r\n")
+	// SMTP requires CRLF line endings in the body too
+	message.WriteString(strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return message.Bytes(), nil
+}
+
+func messageIDDomain(from string) string {
+	if _, domain, found := strings.Cut(from, "@"); found {
+		return strings.TrimSuffix(domain, ">")
+	}
+
+	return "localhost"
+}
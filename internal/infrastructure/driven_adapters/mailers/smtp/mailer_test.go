@@ -0,0 +1,36 @@
+package smtp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns the message with crlf line endings", func(t *testing.T) {
+		msg, err := buildMessage("Car Rent <no-reply@car-rent.com>", domain.Email{
+			To:      "richard.feynman@caltech.edu.us",
+			Subject: "Verify your email",
+			Body:    "Hello\nWorld",
+		}, date)
+
+		assert.Nil(t, err)
+		assert.Contains(t, string(msg), "To: richard.feynman@caltech.edu.us\r\n")
+		assert.Contains(t, string(msg), "@car-rent.com>\r\n")
+		assert.True(t, strings.HasSuffix(string(msg), "\r\n\r\nHello\r\nWorld"))
+	})
+
+	t.Run("returns error when a header contains line breaks", func(t *testing.T) {
+		_, err := buildMessage("no-reply@car-rent.com", domain.Email{
+			To:      "richard.feynman@caltech.edu.us\r\nBcc: everyone@car-rent.com",
+			Subject: "Verify your email",
+		}, date)
+
+		assert.EqualError(t, err, ErrInvalidHeader)
+	})
+}
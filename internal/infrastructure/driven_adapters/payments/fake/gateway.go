@@ -0,0 +1,187 @@
+package fake
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+)
+
+// Payment methods with a known outcome. Any other payment method is authorized.
+const (
+	DeclinedPaymentMethod = "tok_declined"
+	PendingPaymentMethod  = "tok_pending"
+)
+
+// Types of the events sent to the webhook
+const (
+	EventAuthorized = "payment.authorized"
+	EventFailed     = "payment.failed"
+	EventCaptured   = "payment.captured"
+	EventVoided     = "payment.voided"
+	EventRefunded   = "payment.refunded"
+)
+
+type event struct {
+	Type      string  `json:"type"`
+	Reference string  `json:"reference"`
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+}
+
+type authorization struct {
+	amount   domain.Money
+	captured domain.Money
+	refunded domain.Money
+	pending  bool
+	voided   bool
+}
+
+// Gateway is an in-process payment gateway with deterministic outcomes, meant
+// for development and tests. References are random, so they do not collide
+// with the ones stored before a restart, and the events sent to the webhook
+// are signed with an HMAC-SHA256 of their payload.
+type Gateway struct {
+	secret         []byte
+	mu             sync.Mutex
+	authorizations map[string]*authorization
+}
+
+func NewGateway(secret string) *Gateway {
+	return &Gateway{
+		secret:         []byte(secret),
+		authorizations: map[string]*authorization{},
+	}
+}
+
+// Authorizes the amount unless the payment method is DeclinedPaymentMethod.
+// Authorizations of PendingPaymentMethod stay pending until the event
+// confirming them is sent.
+func (g *Gateway) Authorize(ctx context.Context, amount domain.Money, paymentMethod string) (domain.PaymentAuthorization, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	reference := "fake_" + uuid.NewString()
+
+	switch paymentMethod {
+	case DeclinedPaymentMethod:
+		return domain.PaymentAuthorization{Reference: reference, Status: statuses.FAILED}, nil
+	case PendingPaymentMethod:
+		g.authorizations[reference] = &authorization{amount: amount, pending: true}
+		return domain.PaymentAuthorization{Reference: reference, Status: statuses.PENDING}, nil
+	default:
+		g.authorizations[reference] = &authorization{amount: amount}
+		return domain.PaymentAuthorization{Reference: reference, Status: statuses.AUTHORIZED}, nil
+	}
+}
+
+// Captures up to the authorized amount, in the currency it was authorized in
+func (g *Gateway) Capture(ctx context.Context, reference string, amount domain.Money) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[reference]
+	if !ok || auth.pending || auth.voided || !auth.captured.IsZero() ||
+		amount.Currency != auth.amount.Currency || amount.GreaterThan(auth.amount) {
+		return errors.New(services.ErrPaymentGatewayRejected)
+	}
+	auth.captured = amount
+
+	return nil
+}
+
+func (g *Gateway) Void(ctx context.Context, reference string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[reference]
+	if !ok || auth.voided || !auth.captured.IsZero() {
+		return errors.New(services.ErrPaymentGatewayRejected)
+	}
+	auth.voided = true
+
+	return nil
+}
+
+func (g *Gateway) Refund(ctx context.Context, reference string, amount domain.Money) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[reference]
+	if !ok || amount.Currency != auth.amount.Currency || amount.GreaterThan(auth.captured.Sub(auth.refunded)) {
+		return errors.New(services.ErrPaymentGatewayRejected)
+	}
+	auth.refunded = auth.refunded.Add(amount)
+
+	return nil
+}
+
+func (g *Gateway) ParseEvent(payload []byte, signature string) (domain.PaymentEvent, error) {
+	statuses := constants.Values().PAYMENT_STATUSES
+
+	if !hmac.Equal([]byte(g.sign(payload)), []byte(signature)) {
+		return domain.PaymentEvent{}, errors.New(services.ErrInvalidPaymentSignature)
+	}
+
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return domain.PaymentEvent{}, errors.New(services.ErrUnsupportedPaymentEvent)
+	}
+
+	status, ok := map[string]string{
+		EventAuthorized: statuses.AUTHORIZED,
+		EventFailed:     statuses.FAILED,
+		EventCaptured:   statuses.PAID,
+		EventVoided:     statuses.CANCELED,
+		EventRefunded:   statuses.REFUNDED,
+	}[e.Type]
+	if !ok {
+		return domain.PaymentEvent{}, errors.New(services.ErrUnsupportedPaymentEvent)
+	}
+
+	return domain.PaymentEvent{
+		Reference: e.Reference,
+		Status:    status,
+		Amount:    domain.NewMoney(e.Amount, e.Currency),
+	}, nil
+}
+
+// Builds the signed event the provider would send to the webhook after the
+// payment changed. Events confirming or declining a pending authorization
+// settle it in the gateway as well.
+func (g *Gateway) Event(eventType string, reference string, amount domain.Money) (payload []byte, signature string, err error) {
+	g.mu.Lock()
+	if auth, ok := g.authorizations[reference]; ok && auth.pending {
+		switch eventType {
+		case EventAuthorized:
+			auth.pending = false
+		case EventFailed:
+			delete(g.authorizations, reference)
+		}
+	}
+	g.mu.Unlock()
+
+	payload, err = json.Marshal(event{Type: eventType, Reference: reference, Amount: amount.Major(), Currency: amount.Currency})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return payload, g.sign(payload), nil
+}
+
+func (g *Gateway) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
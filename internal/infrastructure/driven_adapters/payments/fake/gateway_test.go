@@ -0,0 +1,98 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+var pathToRoot = "./../../../../.."
+
+func initConstantsFromFake(t *testing.T) {
+	if err := constants.InitValuesFrom(pathToRoot); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func usd(amount float64) domain.Money {
+	return domain.NewMoney(amount, "USD")
+}
+
+func TestGatewayAuthorize(t *testing.T) {
+	initConstantsFromFake(t)
+
+	t.Run("returns the outcome set by the payment method", func(t *testing.T) {
+		gateway := NewGateway("secret")
+
+		authorized, err := gateway.Authorize(context.TODO(), usd(100), "tok_visa")
+		assert.Nil(t, err)
+		assert.Regexp(t, "^fake_[0-9a-f-]{36}$", authorized.Reference)
+		assert.Equal(t, "Authorized", authorized.Status)
+
+		declined, err := gateway.Authorize(context.TODO(), usd(100), DeclinedPaymentMethod)
+		assert.Nil(t, err)
+		assert.NotEqual(t, authorized.Reference, declined.Reference)
+		assert.Equal(t, "Failed", declined.Status)
+
+		pending, err := gateway.Authorize(context.TODO(), usd(100), PendingPaymentMethod)
+		assert.Nil(t, err)
+		assert.Equal(t, "Pending", pending.Status)
+	})
+
+	t.Run("captures pending authorizations only after they are confirmed", func(t *testing.T) {
+		gateway := NewGateway("secret")
+		pending, _ := gateway.Authorize(context.TODO(), usd(100), PendingPaymentMethod)
+
+		assert.EqualError(t, gateway.Capture(context.TODO(), pending.Reference, usd(100)), services.ErrPaymentGatewayRejected)
+
+		_, _, err := gateway.Event(EventAuthorized, pending.Reference, usd(100))
+		assert.Nil(t, err)
+		assert.Nil(t, gateway.Capture(context.TODO(), pending.Reference, usd(100)))
+	})
+
+	t.Run("refunds up to the captured amount", func(t *testing.T) {
+		gateway := NewGateway("secret")
+		authorized, _ := gateway.Authorize(context.TODO(), usd(100), "tok_visa")
+
+		assert.EqualError(t, gateway.Refund(context.TODO(), authorized.Reference, usd(10)), services.ErrPaymentGatewayRejected)
+		assert.Nil(t, gateway.Capture(context.TODO(), authorized.Reference, usd(100)))
+		assert.Nil(t, gateway.Refund(context.TODO(), authorized.Reference, usd(60)))
+		assert.EqualError(t, gateway.Refund(context.TODO(), authorized.Reference, usd(50)), services.ErrPaymentGatewayRejected)
+		assert.EqualError(t, gateway.Void(context.TODO(), authorized.Reference), services.ErrPaymentGatewayRejected)
+	})
+}
+
+func TestGatewayParseEvent(t *testing.T) {
+	initConstantsFromFake(t)
+	gateway := NewGateway("secret")
+
+	t.Run("returns the event when it is signed with the secret", func(t *testing.T) {
+		payload, signature, err := gateway.Event(EventCaptured, "fake_000001", usd(100))
+		assert.Nil(t, err)
+
+		event, err := gateway.ParseEvent(payload, signature)
+		assert.Nil(t, err)
+		assert.Equal(t, "fake_000001", event.Reference)
+		assert.Equal(t, "Paid", event.Status)
+		assert.Equal(t, usd(100), event.Amount)
+	})
+
+	t.Run("returns error when the signature does not match", func(t *testing.T) {
+		payload, _, _ := gateway.Event(EventCaptured, "fake_000001", usd(100))
+		_, signature, _ := NewGateway("other secret").Event(EventCaptured, "fake_000001", usd(100))
+
+		_, err := gateway.ParseEvent(payload, signature)
+		assert.EqualError(t, err, services.ErrInvalidPaymentSignature)
+	})
+
+	t.Run("returns error when the event type is unknown", func(t *testing.T) {
+		payload, signature, _ := gateway.Event("payment.disputed", "fake_000001", usd(100))
+
+		_, err := gateway.ParseEvent(payload, signature)
+		assert.EqualError(t, err, services.ErrUnsupportedPaymentEvent)
+	})
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type AddOn struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	BranchID    uuid.UUID `json:"branch_id"`
+	Name        string    `json:"name"`
+	Stock       int       `json:"stock"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	PricingUnit string    `json:"pricing_unit"`
+}
+
+func (ao AddOn) ToDomain() domain.AddOn {
+	return domain.AddOn{
+		ID:          ao.ID,
+		BranchID:    ao.BranchID,
+		Name:        ao.Name,
+		Stock:       ao.Stock,
+		Price:       ao.Price,
+		Currency:    ao.Currency,
+		PricingUnit: ao.PricingUnit,
+	}
+}
+
+func LoadAddOnFromDomain(dao domain.AddOn) AddOn {
+	return AddOn{
+		ID:          dao.ID,
+		BranchID:    dao.BranchID,
+		Name:        dao.Name,
+		Stock:       dao.Stock,
+		Price:       dao.Price,
+		Currency:    dao.Currency,
+		PricingUnit: dao.PricingUnit,
+	}
+}
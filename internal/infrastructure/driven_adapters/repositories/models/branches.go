@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Branch struct {
+	ID        uuid.UUID `json:"id"`
+	CityID    uuid.UUID `json:"city_id"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+}
+
+func (b Branch) ToDomain(openingHours []domain.OpeningHours, closures []domain.BranchClosure) domain.Branch {
+	return domain.Branch{
+		ID:           b.ID,
+		CityID:       b.CityID,
+		Name:         b.Name,
+		Address:      b.Address,
+		Latitude:     b.Latitude,
+		Longitude:    b.Longitude,
+		OpeningHours: openingHours,
+		Closures:     closures,
+	}
+}
+
+func LoadBranchFromDomain(db domain.Branch) Branch {
+	return Branch{
+		ID:        db.ID,
+		CityID:    db.CityID,
+		Name:      db.Name,
+		Address:   db.Address,
+		Latitude:  db.Latitude,
+		Longitude: db.Longitude,
+	}
+}
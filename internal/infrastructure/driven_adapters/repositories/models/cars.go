@@ -3,37 +3,83 @@ package models
 import (
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Car struct {
-	ID             uuid.UUID `json:"id"`
-	Type           string    `json:"type"`
-	Seats          int16     `json:"seats"`
-	HourlyRentCost float64   `json:"hourly_rent_cost"`
-	CityID         uuid.UUID `json:"city_id"`
-	Status         string    `json:"status"`
+	ID             uuid.UUID      `json:"id"`
+	Type           string         `json:"type"`
+	Seats          int16          `json:"seats"`
+	HourlyRentCost float64        `json:"hourly_rent_cost"`
+	Currency       string         `json:"currency"`
+	CityID         uuid.UUID      `json:"city_id"`
+	Status         string         `json:"status"`
+	Make           string         `json:"make"`
+	Model          string         `json:"model"`
+	Year           int16          `json:"year"`
+	LicensePlate   string         `json:"license_plate"`
+	VIN            string         `json:"vin"`
+	Transmission   string         `json:"transmission"`
+	FuelType       string         `json:"fuel_type"`
+	Color          string         `json:"color"`
+	Features       pq.StringArray `json:"features"`
+	BranchID       uuid.NullUUID  `json:"branch_id"`
 }
 
 type CarType string
 
 func (c *Car) ToDomain(cityName string) domain.Car {
-	return domain.Car{
+	car := domain.Car{
 		ID:             c.ID,
 		Type:           c.Type,
 		Seats:          c.Seats,
 		HourlyRentCost: c.HourlyRentCost,
+		Currency:       c.Currency,
 		CityName:       cityName,
 		Status:         c.Status,
+		Make:           c.Make,
+		Model:          c.Model,
+		Year:           c.Year,
+		LicensePlate:   c.LicensePlate,
+		VIN:            c.VIN,
+		Transmission:   c.Transmission,
+		FuelType:       c.FuelType,
+		Color:          c.Color,
+		Features:       []string(c.Features),
 	}
+	if c.BranchID.Valid {
+		branchID := c.BranchID.UUID
+		car.BranchID = &branchID
+	}
+
+	return car
 }
 
 func LoadCarFromDomain(dc domain.Car) Car {
-	return Car{
+	// features column does not accept NULL values
+	features := pq.StringArray{}
+	features = append(features, dc.Features...)
+
+	car := Car{
 		ID:             dc.ID,
 		Type:           dc.Type,
 		Seats:          dc.Seats,
 		HourlyRentCost: dc.HourlyRentCost,
+		Currency:       dc.Currency,
 		Status:         dc.Status,
+		Make:           dc.Make,
+		Model:          dc.Model,
+		Year:           dc.Year,
+		LicensePlate:   dc.LicensePlate,
+		VIN:            dc.VIN,
+		Transmission:   dc.Transmission,
+		FuelType:       dc.FuelType,
+		Color:          dc.Color,
+		Features:       features,
+	}
+	if dc.BranchID != nil {
+		car.BranchID = uuid.NullUUID{UUID: *dc.BranchID, Valid: true}
 	}
 
+	return car
 }
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type City struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	TimeZone string    `json:"time_zone"`
+	Country  string    `json:"country"`
+	Currency string    `json:"currency"`
+}
+
+func (c City) ToDomain() domain.City {
+	return domain.City{
+		ID:       c.ID,
+		Name:     c.Name,
+		TimeZone: c.TimeZone,
+		Country:  c.Country,
+		Currency: c.Currency,
+	}
+}
+
+func LoadCityFromDomain(dc domain.City) City {
+	return City{
+		ID:       dc.ID,
+		Name:     dc.Name,
+		TimeZone: dc.TimeZone,
+		Country:  dc.Country,
+		Currency: dc.Currency,
+	}
+}
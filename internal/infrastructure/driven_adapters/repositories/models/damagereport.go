@@ -0,0 +1,98 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type DamageReport struct {
+	ID            uuid.UUID     `json:"id,omitempty"`
+	CarID         uuid.UUID     `json:"car_id"`
+	ReservationID uuid.NullUUID `json:"reservation_id"`
+	ReporterID    uuid.UUID     `json:"reporter_id"`
+	Description   string        `json:"description"`
+	Status        string        `json:"status"`
+	Resolution    string        `json:"resolution"`
+	CreatedAt     time.Time     `json:"created_at"`
+	ResolvedAt    sql.NullTime  `json:"resolved_at"`
+}
+
+type DamagePhoto struct {
+	ID           uuid.UUID `json:"id,omitempty"`
+	ReportID     uuid.UUID `json:"report_id"`
+	ContentType  string    `json:"content_type"`
+	Size         int32     `json:"size"`
+	Key          string    `json:"key"`
+	ThumbnailKey string    `json:"thumbnail_key"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (dr DamageReport) ToDomain(photos []domain.DamagePhoto) domain.DamageReport {
+	report := domain.DamageReport{
+		ID:          dr.ID,
+		CarID:       dr.CarID,
+		ReporterID:  dr.ReporterID,
+		Description: dr.Description,
+		Status:      dr.Status,
+		Resolution:  dr.Resolution,
+		CreatedAt:   dr.CreatedAt,
+		Photos:      photos,
+	}
+	if dr.ReservationID.Valid {
+		reservationID := dr.ReservationID.UUID
+		report.ReservationID = &reservationID
+	}
+	if dr.ResolvedAt.Valid {
+		resolvedAt := dr.ResolvedAt.Time
+		report.ResolvedAt = &resolvedAt
+	}
+
+	return report
+}
+
+func LoadDamageReportFromDomain(ddr domain.DamageReport) DamageReport {
+	report := DamageReport{
+		ID:          ddr.ID,
+		CarID:       ddr.CarID,
+		ReporterID:  ddr.ReporterID,
+		Description: ddr.Description,
+		Status:      ddr.Status,
+		Resolution:  ddr.Resolution,
+		CreatedAt:   ddr.CreatedAt,
+	}
+	if ddr.ReservationID != nil {
+		report.ReservationID = uuid.NullUUID{UUID: *ddr.ReservationID, Valid: true}
+	}
+	if ddr.ResolvedAt != nil {
+		report.ResolvedAt = sql.NullTime{Time: *ddr.ResolvedAt, Valid: true}
+	}
+
+	return report
+}
+
+func (dp DamagePhoto) ToDomain() domain.DamagePhoto {
+	return domain.DamagePhoto{
+		ID:           dp.ID,
+		ReportID:     dp.ReportID,
+		ContentType:  dp.ContentType,
+		Size:         dp.Size,
+		Key:          dp.Key,
+		ThumbnailKey: dp.ThumbnailKey,
+		CreatedAt:    dp.CreatedAt,
+	}
+}
+
+func LoadDamagePhotoFromDomain(ddp domain.DamagePhoto) DamagePhoto {
+	return DamagePhoto{
+		ID:           ddp.ID,
+		ReportID:     ddp.ReportID,
+		ContentType:  ddp.ContentType,
+		Size:         ddp.Size,
+		Key:          ddp.Key,
+		ThumbnailKey: ddp.ThumbnailKey,
+		CreatedAt:    ddp.CreatedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Deposit struct {
+	ID             uuid.UUID    `json:"id,omitempty"`
+	ReservationID  uuid.UUID    `json:"reservation_id"`
+	Reference      string       `json:"reference"`
+	Amount         float64      `json:"amount"`
+	Currency       string       `json:"currency"`
+	CapturedAmount float64      `json:"captured_amount"`
+	CaptureReason  string       `json:"capture_reason"`
+	Status         string       `json:"status"`
+	HeldAt         time.Time    `json:"held_at"`
+	SettledAt      sql.NullTime `json:"settled_at"`
+}
+
+func (d Deposit) ToDomain() domain.Deposit {
+	deposit := domain.Deposit{
+		ID:             d.ID,
+		ReservationID:  d.ReservationID,
+		Reference:      d.Reference,
+		Amount:         domain.NewMoney(d.Amount, d.Currency),
+		CapturedAmount: domain.NewMoney(d.CapturedAmount, d.Currency),
+		CaptureReason:  d.CaptureReason,
+		Status:         d.Status,
+		HeldAt:         d.HeldAt,
+	}
+	if d.SettledAt.Valid {
+		settledAt := d.SettledAt.Time
+		deposit.SettledAt = &settledAt
+	}
+
+	return deposit
+}
+
+func LoadDepositFromDomain(dd domain.Deposit) Deposit {
+	deposit := Deposit{
+		ID:             dd.ID,
+		ReservationID:  dd.ReservationID,
+		Reference:      dd.Reference,
+		Amount:         dd.Amount.Major(),
+		Currency:       dd.Amount.Currency,
+		CapturedAmount: dd.CapturedAmount.Major(),
+		CaptureReason:  dd.CaptureReason,
+		Status:         dd.Status,
+		HeldAt:         dd.HeldAt,
+	}
+	if dd.SettledAt != nil {
+		deposit.SettledAt = sql.NullTime{Time: *dd.SettledAt, Valid: true}
+	}
+
+	return deposit
+}
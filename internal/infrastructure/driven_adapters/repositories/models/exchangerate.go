@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+)
+
+type ExchangeRate struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (er ExchangeRate) ToDomain() domain.ExchangeRate {
+	return domain.ExchangeRate{
+		From:      er.From,
+		To:        er.To,
+		Rate:      er.Rate,
+		UpdatedAt: er.UpdatedAt,
+	}
+}
+
+func LoadExchangeRateFromDomain(der domain.ExchangeRate) ExchangeRate {
+	return ExchangeRate{
+		From:      der.From,
+		To:        der.To,
+		Rate:      der.Rate,
+		UpdatedAt: der.UpdatedAt,
+	}
+}
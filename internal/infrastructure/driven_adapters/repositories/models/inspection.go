@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Inspection struct {
+	ID            uuid.UUID      `json:"id,omitempty"`
+	ReservationID uuid.UUID      `json:"reservation_id"`
+	Type          string         `json:"type"`
+	Mileage       int32          `json:"mileage"`
+	FuelLevel     int16          `json:"fuel_level"`
+	Damages       pq.StringArray `json:"damages"`
+	Notes         string         `json:"notes"`
+	InspectedAt   time.Time      `json:"inspected_at"`
+}
+
+type CarMileage struct {
+	CarID              uuid.UUID `json:"car_id"`
+	Mileage            int32     `json:"mileage"`
+	LastServiceMileage int32     `json:"last_service_mileage"`
+}
+
+func (i Inspection) ToDomain() domain.Inspection {
+	damages := []string(i.Damages)
+	if damages == nil {
+		damages = []string{}
+	}
+
+	return domain.Inspection{
+		ID:            i.ID,
+		ReservationID: i.ReservationID,
+		Type:          i.Type,
+		Mileage:       i.Mileage,
+		FuelLevel:     i.FuelLevel,
+		Damages:       damages,
+		Notes:         i.Notes,
+		InspectedAt:   i.InspectedAt,
+	}
+}
+
+func LoadInspectionFromDomain(di domain.Inspection) Inspection {
+	damages := pq.StringArray(di.Damages)
+	if damages == nil {
+		damages = pq.StringArray{}
+	}
+
+	return Inspection{
+		ID:            di.ID,
+		ReservationID: di.ReservationID,
+		Type:          di.Type,
+		Mileage:       di.Mileage,
+		FuelLevel:     di.FuelLevel,
+		Damages:       damages,
+		Notes:         di.Notes,
+		InspectedAt:   di.InspectedAt,
+	}
+}
+
+func (cm CarMileage) ToDomain() domain.CarMileage {
+	return domain.CarMileage{
+		CarID:              cm.CarID,
+		Mileage:            cm.Mileage,
+		LastServiceMileage: cm.LastServiceMileage,
+	}
+}
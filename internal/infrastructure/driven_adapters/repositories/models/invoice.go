@@ -0,0 +1,141 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Invoice struct {
+	ID            uuid.UUID `json:"id,omitempty"`
+	Year          int       `json:"year"`
+	Sequence      int       `json:"sequence"`
+	ReservationID uuid.UUID `json:"reservation_id"`
+	BillingName   string    `json:"billing_name"`
+	BillingEmail  string    `json:"billing_email"`
+	CompanyName   string    `json:"company_name"`
+	TaxID         string    `json:"tax_id"`
+	Address       string    `json:"address"`
+	City          string    `json:"city"`
+	PostalCode    string    `json:"postal_code"`
+	Country       string    `json:"country"`
+	Subtotal      float64   `json:"subtotal"`
+	TaxTotal      float64   `json:"tax_total"`
+	Total         float64   `json:"total"`
+	Currency      string    `json:"currency"`
+	IssuedAt      time.Time `json:"issued_at"`
+}
+
+type InvoiceLine struct {
+	InvoiceID   uuid.UUID `json:"invoice_id"`
+	Position    int       `json:"position"`
+	Description string    `json:"description"`
+	Amount      float64   `json:"amount"`
+}
+
+type InvoiceTax struct {
+	InvoiceID uuid.UUID `json:"invoice_id"`
+	Position  int       `json:"position"`
+	Name      string    `json:"name"`
+	Rate      float64   `json:"rate"`
+	Amount    float64   `json:"amount"`
+}
+
+type BillingProfile struct {
+	UserID      uuid.UUID `json:"user_id"`
+	CompanyName string    `json:"company_name"`
+	TaxID       string    `json:"tax_id"`
+	Address     string    `json:"address"`
+	City        string    `json:"city"`
+	PostalCode  string    `json:"postal_code"`
+	Country     string    `json:"country"`
+}
+
+func (i Invoice) ToDomain(lines []domain.InvoiceLine, taxes []domain.InvoiceTax) domain.Invoice {
+	return domain.Invoice{
+		ID:            i.ID,
+		Number:        domain.InvoiceNumber(i.Year, i.Sequence),
+		Year:          i.Year,
+		Sequence:      i.Sequence,
+		ReservationID: i.ReservationID,
+		Billing: domain.BillingInfo{
+			Name:        i.BillingName,
+			Email:       i.BillingEmail,
+			CompanyName: i.CompanyName,
+			TaxID:       i.TaxID,
+			Address:     i.Address,
+			City:        i.City,
+			PostalCode:  i.PostalCode,
+			Country:     i.Country,
+		},
+		Lines:    lines,
+		Taxes:    taxes,
+		Subtotal: domain.NewMoney(i.Subtotal, i.Currency),
+		TaxTotal: domain.NewMoney(i.TaxTotal, i.Currency),
+		Total:    domain.NewMoney(i.Total, i.Currency),
+		IssuedAt: i.IssuedAt,
+	}
+}
+
+func LoadInvoiceFromDomain(di domain.Invoice) Invoice {
+	return Invoice{
+		ID:            di.ID,
+		Year:          di.Year,
+		Sequence:      di.Sequence,
+		ReservationID: di.ReservationID,
+		BillingName:   di.Billing.Name,
+		BillingEmail:  di.Billing.Email,
+		CompanyName:   di.Billing.CompanyName,
+		TaxID:         di.Billing.TaxID,
+		Address:       di.Billing.Address,
+		City:          di.Billing.City,
+		PostalCode:    di.Billing.PostalCode,
+		Country:       di.Billing.Country,
+		Subtotal:      di.Subtotal.Major(),
+		TaxTotal:      di.TaxTotal.Major(),
+		Total:         di.Total.Major(),
+		Currency:      di.Total.Currency,
+		IssuedAt:      di.IssuedAt,
+	}
+}
+
+// Lines and taxes are in the currency of their invoice
+func (il InvoiceLine) ToDomain(currency string) domain.InvoiceLine {
+	return domain.InvoiceLine{
+		Description: il.Description,
+		Amount:      domain.NewMoney(il.Amount, currency),
+	}
+}
+
+func (it InvoiceTax) ToDomain(currency string) domain.InvoiceTax {
+	return domain.InvoiceTax{
+		Name:   it.Name,
+		Rate:   it.Rate,
+		Amount: domain.NewMoney(it.Amount, currency),
+	}
+}
+
+func (bp BillingProfile) ToDomain() domain.BillingProfile {
+	return domain.BillingProfile{
+		UserID:      bp.UserID,
+		CompanyName: bp.CompanyName,
+		TaxID:       bp.TaxID,
+		Address:     bp.Address,
+		City:        bp.City,
+		PostalCode:  bp.PostalCode,
+		Country:     bp.Country,
+	}
+}
+
+func LoadBillingProfileFromDomain(dbp domain.BillingProfile) BillingProfile {
+	return BillingProfile{
+		UserID:      dbp.UserID,
+		CompanyName: dbp.CompanyName,
+		TaxID:       dbp.TaxID,
+		Address:     dbp.Address,
+		City:        dbp.City,
+		PostalCode:  dbp.PostalCode,
+		Country:     dbp.Country,
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type LateReturn struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	DueAt         time.Time `json:"due_at"`
+	ReturnedAt    time.Time `json:"returned_at"`
+	MinutesLate   int       `json:"minutes_late"`
+	HoursCharged  int       `json:"hours_charged"`
+	HourlyFee     float64   `json:"hourly_fee"`
+	Fee           float64   `json:"fee"`
+	Currency      string    `json:"currency"`
+}
+
+type OverdueNotice struct {
+	ReservationID     uuid.UUID `json:"reservation_id"`
+	NextReservationID uuid.UUID `json:"next_reservation_id"`
+	NotifiedAt        time.Time `json:"notified_at"`
+}
+
+func (lr LateReturn) ToDomain() domain.LateReturn {
+	return domain.LateReturn{
+		ReservationID: lr.ReservationID,
+		DueAt:         lr.DueAt,
+		ReturnedAt:    lr.ReturnedAt,
+		MinutesLate:   lr.MinutesLate,
+		HoursCharged:  lr.HoursCharged,
+		HourlyFee:     domain.NewMoney(lr.HourlyFee, lr.Currency),
+		Fee:           domain.NewMoney(lr.Fee, lr.Currency),
+	}
+}
+
+func LoadLateReturnFromDomain(dlr domain.LateReturn) LateReturn {
+	return LateReturn{
+		ReservationID: dlr.ReservationID,
+		DueAt:         dlr.DueAt,
+		ReturnedAt:    dlr.ReturnedAt,
+		MinutesLate:   dlr.MinutesLate,
+		HoursCharged:  dlr.HoursCharged,
+		HourlyFee:     dlr.HourlyFee.Major(),
+		Fee:           dlr.Fee.Major(),
+		Currency:      dlr.Fee.Currency,
+	}
+}
+
+func (on OverdueNotice) ToDomain() domain.OverdueNotice {
+	return domain.OverdueNotice(on)
+}
+
+func LoadOverdueNoticeFromDomain(don domain.OverdueNotice) OverdueNotice {
+	return OverdueNotice(don)
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type LedgerEntry struct {
+	ID            uuid.UUID `json:"id,omitempty"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Account       string    `json:"account"`
+	Movement      string    `json:"movement"`
+	Amount        float64   `json:"amount"`
+	Currency      string    `json:"currency"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (le LedgerEntry) ToDomain() domain.LedgerEntry {
+	return domain.LedgerEntry{
+		ID:            le.ID,
+		TransactionID: le.TransactionID,
+		ReservationID: le.ReservationID,
+		Account:       le.Account,
+		Movement:      le.Movement,
+		Amount:        domain.NewMoney(le.Amount, le.Currency),
+		CreatedAt:     le.CreatedAt,
+	}
+}
+
+func LoadLedgerEntryFromDomain(dle domain.LedgerEntry) LedgerEntry {
+	return LedgerEntry{
+		ID:            dle.ID,
+		TransactionID: dle.TransactionID,
+		ReservationID: dle.ReservationID,
+		Account:       dle.Account,
+		Movement:      dle.Movement,
+		Amount:        dle.Amount.Major(),
+		Currency:      dle.Amount.Currency,
+		CreatedAt:     dle.CreatedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Maintenance struct {
+	ID        uuid.UUID `json:"id,omitempty"`
+	CarID     uuid.UUID `json:"car_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+}
+
+func (m Maintenance) ToDomain() domain.Maintenance {
+	return domain.Maintenance{
+		ID:        m.ID,
+		CarID:     m.CarID,
+		StartDate: m.StartDate,
+		EndDate:   m.EndDate,
+		Reason:    m.Reason,
+	}
+}
+
+func LoadMaintenanceFromDomain(dm domain.Maintenance) Maintenance {
+	return Maintenance{
+		ID:        dm.ID,
+		CarID:     dm.CarID,
+		StartDate: dm.StartDate,
+		EndDate:   dm.EndDate,
+		Reason:    dm.Reason,
+	}
+}
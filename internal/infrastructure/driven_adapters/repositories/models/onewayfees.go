@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type OneWayFee struct {
+	FromCityID uuid.UUID `json:"from_city_id"`
+	ToCityID   uuid.UUID `json:"to_city_id"`
+	Fee        float64   `json:"fee"`
+	Currency   string    `json:"currency"`
+}
+
+func (owf OneWayFee) ToDomain() domain.OneWayFee {
+	return domain.OneWayFee{
+		FromCityID: owf.FromCityID,
+		ToCityID:   owf.ToCityID,
+		Fee:        owf.Fee,
+		Currency:   owf.Currency,
+	}
+}
+
+func LoadOneWayFeeFromDomain(dowf domain.OneWayFee) OneWayFee {
+	return OneWayFee{
+		FromCityID: dowf.FromCityID,
+		ToCityID:   dowf.ToCityID,
+		Fee:        dowf.Fee,
+		Currency:   dowf.Currency,
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Payment struct {
+	ID             uuid.UUID `json:"id,omitempty"`
+	ReservationID  uuid.UUID `json:"reservation_id"`
+	Reference      string    `json:"reference"`
+	Amount         float64   `json:"amount"`
+	Currency       string    `json:"currency"`
+	CapturedAmount float64   `json:"captured_amount"`
+	RefundedAmount float64   `json:"refunded_amount"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	TaxAmount      float64   `json:"tax_amount"`
+}
+
+func (p Payment) ToDomain() domain.Payment {
+	return domain.Payment{
+		ID:             p.ID,
+		ReservationID:  p.ReservationID,
+		Reference:      p.Reference,
+		Amount:         domain.NewMoney(p.Amount, p.Currency),
+		CapturedAmount: domain.NewMoney(p.CapturedAmount, p.Currency),
+		RefundedAmount: domain.NewMoney(p.RefundedAmount, p.Currency),
+		Status:         p.Status,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		TaxAmount:      domain.NewMoney(p.TaxAmount, p.Currency),
+	}
+}
+
+func LoadPaymentFromDomain(dp domain.Payment) Payment {
+	return Payment{
+		ID:             dp.ID,
+		ReservationID:  dp.ReservationID,
+		Reference:      dp.Reference,
+		Amount:         dp.Amount.Major(),
+		Currency:       dp.Amount.Currency,
+		CapturedAmount: dp.CapturedAmount.Major(),
+		RefundedAmount: dp.RefundedAmount.Major(),
+		Status:         dp.Status,
+		CreatedAt:      dp.CreatedAt,
+		UpdatedAt:      dp.UpdatedAt,
+		TaxAmount:      dp.TaxAmount.Major(),
+	}
+}
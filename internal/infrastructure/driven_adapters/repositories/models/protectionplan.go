@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type ProtectionPlan struct {
+	ID         uuid.UUID `json:"id,omitempty"`
+	Level      string    `json:"level"`
+	CarType    string    `json:"car_type"`
+	DailyPrice float64   `json:"daily_price"`
+	Deductible float64   `json:"deductible"`
+	Currency   string    `json:"currency"`
+}
+
+func (pp ProtectionPlan) ToDomain() domain.ProtectionPlan {
+	return domain.ProtectionPlan{
+		ID:         pp.ID,
+		Level:      pp.Level,
+		CarType:    pp.CarType,
+		DailyPrice: pp.DailyPrice,
+		Deductible: pp.Deductible,
+		Currency:   pp.Currency,
+	}
+}
+
+func LoadProtectionPlanFromDomain(dpp domain.ProtectionPlan) ProtectionPlan {
+	return ProtectionPlan{
+		ID:         dpp.ID,
+		Level:      dpp.Level,
+		CarType:    dpp.CarType,
+		DailyPrice: dpp.DailyPrice,
+		Deductible: dpp.Deductible,
+		Currency:   dpp.Currency,
+	}
+}
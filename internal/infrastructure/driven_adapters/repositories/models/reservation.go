@@ -8,36 +8,98 @@ import (
 )
 
 type Reservation struct {
-	ID            uuid.UUID `json:"id,omitempty"`
-	UserID        uuid.UUID `json:"user_id"`
-	CarID         uuid.UUID `json:"car_id"`
-	Status        string    `json:"status"`
-	PaymentStatus string    `json:"payment_status"`
-	StartDate     time.Time `json:"start_date"`
-	EndDate       time.Time `json:"end_date"`
+	ID                   uuid.UUID     `json:"id,omitempty"`
+	UserID               uuid.UUID     `json:"user_id"`
+	CarID                uuid.UUID     `json:"car_id"`
+	Status               string        `json:"status"`
+	PaymentStatus        string        `json:"payment_status"`
+	StartDate            time.Time     `json:"start_date"`
+	EndDate              time.Time     `json:"end_date"`
+	PickupBranchID       uuid.NullUUID `json:"pickup_branch_id"`
+	ReturnBranchID       uuid.NullUUID `json:"return_branch_id"`
+	OneWayFee            float64       `json:"one_way_fee"`
+	AdditionalDriverIDs  []uuid.UUID   `json:"additional_driver_ids"`
+	RentalCost           float64       `json:"rental_cost"`
+	AdditionalDriversFee float64       `json:"additional_drivers_fee"`
+	AddOnsCost           float64       `json:"add_ons_cost"`
+	ProtectionLevel      string        `json:"protection_level"`
+	ProtectionDailyPrice float64       `json:"protection_daily_price"`
+	ProtectionDeductible float64       `json:"protection_deductible"`
+	ProtectionCost       float64       `json:"protection_cost"`
+	DepositStatus        string        `json:"deposit_status"`
+	TaxTotal             float64       `json:"tax_total"`
+	Currency             string        `json:"currency"`
 }
 
 func (r Reservation) ToDomain() domain.Reservation {
-	return domain.Reservation{
-		ID:            r.ID,
-		UserID:        r.UserID,
-		CarID:         r.CarID,
-		Status:        r.Status,
-		PaymentStatus: r.PaymentStatus,
-		StartDate:     r.StartDate,
-		EndDate:       r.EndDate,
+	reservation := domain.Reservation{
+		ID:                   r.ID,
+		UserID:               r.UserID,
+		CarID:                r.CarID,
+		Status:               r.Status,
+		PaymentStatus:        r.PaymentStatus,
+		StartDate:            r.StartDate,
+		EndDate:              r.EndDate,
+		Currency:             r.Currency,
+		OneWayFee:            domain.NewMoney(r.OneWayFee, r.Currency),
+		RentalCost:           domain.NewMoney(r.RentalCost, r.Currency),
+		AdditionalDriversFee: domain.NewMoney(r.AdditionalDriversFee, r.Currency),
+		AddOnsCost:           domain.NewMoney(r.AddOnsCost, r.Currency),
+		Protection: domain.ReservationProtection{
+			Level:      r.ProtectionLevel,
+			DailyPrice: domain.NewMoney(r.ProtectionDailyPrice, r.Currency),
+			Deductible: domain.NewMoney(r.ProtectionDeductible, r.Currency),
+		},
+		ProtectionCost: domain.NewMoney(r.ProtectionCost, r.Currency),
+		TaxTotal:       domain.NewMoney(r.TaxTotal, r.Currency),
+		DepositStatus:  r.DepositStatus,
 	}
+	if len(r.AdditionalDriverIDs) > 0 {
+		reservation.AdditionalDriverIDs = r.AdditionalDriverIDs
+	}
+	if r.PickupBranchID.Valid {
+		pickupBranchID := r.PickupBranchID.UUID
+		reservation.PickupBranchID = &pickupBranchID
+	}
+	if r.ReturnBranchID.Valid {
+		returnBranchID := r.ReturnBranchID.UUID
+		reservation.ReturnBranchID = &returnBranchID
+	}
+
+	return reservation
 }
 
 func LoadReservationFromDomain(dr domain.Reservation) Reservation {
-	return Reservation{
-		ID:            dr.ID,
-		UserID:        dr.UserID,
-		CarID:         dr.CarID,
-		Status:        dr.Status,
-		PaymentStatus: dr.PaymentStatus,
-		StartDate:     dr.StartDate,
-		EndDate:       dr.EndDate,
+	reservation := Reservation{
+		ID:                   dr.ID,
+		UserID:               dr.UserID,
+		CarID:                dr.CarID,
+		Status:               dr.Status,
+		PaymentStatus:        dr.PaymentStatus,
+		StartDate:            dr.StartDate,
+		EndDate:              dr.EndDate,
+		OneWayFee:            dr.OneWayFee.Major(),
+		AdditionalDriverIDs:  dr.AdditionalDriverIDs,
+		RentalCost:           dr.RentalCost.Major(),
+		AdditionalDriversFee: dr.AdditionalDriversFee.Major(),
+		AddOnsCost:           dr.AddOnsCost.Major(),
+		ProtectionLevel:      dr.Protection.Level,
+		ProtectionDailyPrice: dr.Protection.DailyPrice.Major(),
+		ProtectionDeductible: dr.Protection.Deductible.Major(),
+		ProtectionCost:       dr.ProtectionCost.Major(),
+		DepositStatus:        dr.DepositStatus,
+		TaxTotal:             dr.TaxTotal.Major(),
+		Currency:             dr.Currency,
+	}
+	if reservation.AdditionalDriverIDs == nil {
+		reservation.AdditionalDriverIDs = []uuid.UUID{}
+	}
+	if dr.PickupBranchID != nil {
+		reservation.PickupBranchID = uuid.NullUUID{UUID: *dr.PickupBranchID, Valid: true}
+	}
+	if dr.ReturnBranchID != nil {
+		reservation.ReturnBranchID = uuid.NullUUID{UUID: *dr.ReturnBranchID, Valid: true}
 	}
 
+	return reservation
 }
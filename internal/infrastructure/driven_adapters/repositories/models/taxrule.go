@@ -0,0 +1,52 @@
+package models
+
+import (
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type TaxRule struct {
+	ID           uuid.UUID     `json:"id,omitempty"`
+	CityID       uuid.UUID     `json:"city_id"`
+	BranchID     uuid.NullUUID `json:"branch_id"`
+	Name         string        `json:"name"`
+	Jurisdiction string        `json:"jurisdiction"`
+	Rate         float64       `json:"rate"`
+	AppliesTo    []string      `json:"applies_to"`
+	Rounding     string        `json:"rounding"`
+}
+
+func (tr TaxRule) ToDomain() domain.TaxRule {
+	rule := domain.TaxRule{
+		ID:           tr.ID,
+		CityID:       tr.CityID,
+		Name:         tr.Name,
+		Jurisdiction: tr.Jurisdiction,
+		Rate:         tr.Rate,
+		AppliesTo:    tr.AppliesTo,
+		Rounding:     tr.Rounding,
+	}
+	if tr.BranchID.Valid {
+		branchID := tr.BranchID.UUID
+		rule.BranchID = &branchID
+	}
+
+	return rule
+}
+
+func LoadTaxRuleFromDomain(dtr domain.TaxRule) TaxRule {
+	rule := TaxRule{
+		ID:           dtr.ID,
+		CityID:       dtr.CityID,
+		Name:         dtr.Name,
+		Jurisdiction: dtr.Jurisdiction,
+		Rate:         dtr.Rate,
+		AppliesTo:    dtr.AppliesTo,
+		Rounding:     dtr.Rounding,
+	}
+	if dtr.BranchID != nil {
+		rule.BranchID = uuid.NullUUID{UUID: *dtr.BranchID, Valid: true}
+	}
+
+	return rule
+}
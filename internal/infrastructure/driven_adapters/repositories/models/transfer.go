@@ -0,0 +1,60 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type Transfer struct {
+	ID            uuid.UUID     `json:"id,omitempty"`
+	CarID         uuid.UUID     `json:"car_id"`
+	FromCityID    uuid.UUID     `json:"from_city_id"`
+	ToCityID      uuid.UUID     `json:"to_city_id"`
+	ToBranchID    uuid.NullUUID `json:"to_branch_id"`
+	DepartureDate time.Time     `json:"departure_date"`
+	ArrivalDate   time.Time     `json:"arrival_date"`
+	CompletedAt   sql.NullTime  `json:"completed_at"`
+}
+
+func (t Transfer) ToDomain() domain.Transfer {
+	transfer := domain.Transfer{
+		ID:            t.ID,
+		CarID:         t.CarID,
+		FromCityID:    t.FromCityID,
+		ToCityID:      t.ToCityID,
+		DepartureDate: t.DepartureDate,
+		ArrivalDate:   t.ArrivalDate,
+	}
+	if t.ToBranchID.Valid {
+		toBranchID := t.ToBranchID.UUID
+		transfer.ToBranchID = &toBranchID
+	}
+	if t.CompletedAt.Valid {
+		completedAt := t.CompletedAt.Time
+		transfer.CompletedAt = &completedAt
+	}
+
+	return transfer
+}
+
+func LoadTransferFromDomain(dt domain.Transfer) Transfer {
+	transfer := Transfer{
+		ID:            dt.ID,
+		CarID:         dt.CarID,
+		FromCityID:    dt.FromCityID,
+		ToCityID:      dt.ToCityID,
+		DepartureDate: dt.DepartureDate,
+		ArrivalDate:   dt.ArrivalDate,
+	}
+	if dt.ToBranchID != nil {
+		transfer.ToBranchID = uuid.NullUUID{UUID: *dt.ToBranchID, Valid: true}
+	}
+	if dt.CompletedAt != nil {
+		transfer.CompletedAt = sql.NullTime{Time: *dt.CompletedAt, Valid: true}
+	}
+
+	return transfer
+}
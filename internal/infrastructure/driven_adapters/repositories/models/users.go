@@ -1,21 +1,29 @@
 package models
 
 import (
+	"database/sql"
+
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/google/uuid"
 )
 
 type User struct {
-	ID        uuid.UUID `json:"id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Email     string    `json:"email"`
-	Type      string    `json:"type"`
-	Status    string    `json:"status"`
+	ID                      uuid.UUID      `json:"id"`
+	FirstName               string         `json:"first_name"`
+	LastName                string         `json:"last_name"`
+	Email                   string         `json:"email"`
+	Type                    string         `json:"type"`
+	Status                  string         `json:"status"`
+	EmailVerifiedAt         sql.NullTime   `json:"email_verified_at"`
+	DateOfBirth             sql.NullTime   `json:"date_of_birth"`
+	DriverLicenseNumber     sql.NullString `json:"driver_license_number"`
+	DriverLicenseCountry    sql.NullString `json:"driver_license_country"`
+	DriverLicenseIssueDate  sql.NullTime   `json:"driver_license_issue_date"`
+	DriverLicenseExpiryDate sql.NullTime   `json:"driver_license_expiry_date"`
 }
 
 func (u *User) ToDomain() domain.User {
-	return domain.User{
+	user := domain.User{
 		ID:        u.ID,
 		FirstName: u.FirstName,
 		LastName:  u.LastName,
@@ -23,10 +31,28 @@ func (u *User) ToDomain() domain.User {
 		Type:      u.Type,
 		Status:    u.Status,
 	}
+	if u.EmailVerifiedAt.Valid {
+		emailVerifiedAt := u.EmailVerifiedAt.Time
+		user.EmailVerifiedAt = &emailVerifiedAt
+	}
+	if u.DateOfBirth.Valid {
+		dateOfBirth := u.DateOfBirth.Time
+		user.DateOfBirth = &dateOfBirth
+	}
+	if u.DriverLicenseNumber.Valid {
+		user.DriverLicense = &domain.DriverLicense{
+			Number:     u.DriverLicenseNumber.String,
+			Country:    u.DriverLicenseCountry.String,
+			IssueDate:  u.DriverLicenseIssueDate.Time,
+			ExpiryDate: u.DriverLicenseExpiryDate.Time,
+		}
+	}
+
+	return user
 }
 
 func LoadUserFromDomain(du domain.User) User {
-	return User{
+	user := User{
 		ID:        du.ID,
 		FirstName: du.FirstName,
 		LastName:  du.LastName,
@@ -34,4 +60,18 @@ func LoadUserFromDomain(du domain.User) User {
 		Type:      du.Type,
 		Status:    du.Status,
 	}
+	if du.EmailVerifiedAt != nil {
+		user.EmailVerifiedAt = sql.NullTime{Time: *du.EmailVerifiedAt, Valid: true}
+	}
+	if du.DateOfBirth != nil {
+		user.DateOfBirth = sql.NullTime{Time: *du.DateOfBirth, Valid: true}
+	}
+	if du.DriverLicense != nil {
+		user.DriverLicenseNumber = sql.NullString{String: du.DriverLicense.Number, Valid: true}
+		user.DriverLicenseCountry = sql.NullString{String: du.DriverLicense.Country, Valid: true}
+		user.DriverLicenseIssueDate = sql.NullTime{Time: du.DriverLicense.IssueDate, Valid: true}
+		user.DriverLicenseExpiryDate = sql.NullTime{Time: du.DriverLicense.ExpiryDate, Valid: true}
+	}
+
+	return user
 }
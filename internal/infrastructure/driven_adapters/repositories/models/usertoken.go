@@ -0,0 +1,46 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type UserToken struct {
+	ID        uuid.UUID    `json:"id"`
+	UserID    uuid.UUID    `json:"user_id"`
+	Purpose   string       `json:"purpose"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+}
+
+func (ut UserToken) ToDomain() domain.UserToken {
+	token := domain.UserToken{
+		ID:        ut.ID,
+		UserID:    ut.UserID,
+		Purpose:   ut.Purpose,
+		ExpiresAt: ut.ExpiresAt,
+	}
+	if ut.UsedAt.Valid {
+		usedAt := ut.UsedAt.Time
+		token.UsedAt = &usedAt
+	}
+
+	return token
+}
+
+func LoadUserTokenFromDomain(dut domain.UserToken) UserToken {
+	token := UserToken{
+		ID:        dut.ID,
+		UserID:    dut.UserID,
+		Purpose:   dut.Purpose,
+		ExpiresAt: dut.ExpiresAt,
+	}
+	if dut.UsedAt != nil {
+		token.UsedAt = sql.NullTime{Time: *dut.UsedAt, Valid: true}
+	}
+
+	return token
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type AddOnsRepo struct {
+	ports.Database
+}
+
+func NewAddOnsRepository(db ports.Database) *AddOnsRepo {
+	return &AddOnsRepo{
+		Database: db,
+	}
+}
+
+func (aor *AddOnsRepo) Insert(ctx context.Context, dao domain.AddOn) error {
+	addOn := models.LoadAddOnFromDomain(dao)
+
+	_, err := aor.GetDBHandle().ExecContext(ctx, "INSERT INTO add_ons (id, branch_id, name, stock, price, pricing_unit, currency) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		addOn.ID, addOn.BranchID, addOn.Name, addOn.Stock, addOn.Price, addOn.PricingUnit, addOn.Currency)
+
+	return mapAddOnViolation(err)
+}
+
+func (aor *AddOnsRepo) Get(ctx context.Context, ID uuid.UUID) (domain.AddOn, error) {
+	addOn, err := scanAddOn(aor.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM add_ons WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.AddOn{}, errors.New(services.ErrAddOnNotFound)
+		}
+		return domain.AddOn{}, err
+	}
+
+	return addOn.ToDomain(), nil
+}
+
+func (aor *AddOnsRepo) FullUpdate(ctx context.Context, dao domain.AddOn) error {
+	addOn := models.LoadAddOnFromDomain(dao)
+
+	result, err := aor.GetDBHandle().ExecContext(ctx, "UPDATE add_ons SET branch_id=$1, name=$2, stock=$3, price=$4, pricing_unit=$5, currency=$6 WHERE id=$7",
+		addOn.BranchID, addOn.Name, addOn.Stock, addOn.Price, addOn.PricingUnit, addOn.Currency, addOn.ID)
+	if err != nil {
+		return mapAddOnViolation(err)
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrAddOnNotFound)
+	}
+
+	return nil
+}
+
+func (aor *AddOnsRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := aor.GetDBHandle().ExecContext(ctx, "DELETE FROM add_ons WHERE id=$1", id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrAddOnHasReservations)
+		}
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrAddOnNotFound)
+	}
+
+	return nil
+}
+
+// Lists the add-ons of a branch ordered by name
+func (aor *AddOnsRepo) ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error) {
+	var addOns []domain.AddOn
+
+	rows, err := aor.GetDBHandle().QueryContext(ctx, "SELECT * FROM add_ons WHERE branch_id = $1 ORDER BY name ASC", branchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		addOn, err := scanAddOn(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		addOns = append(addOns, addOn.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return addOns, nil
+}
+
+// Gets the units of the add-on taken by the reservations, other than
+// excludedReservationID, that overlap the given time frame
+func (aor *AddOnsRepo) GetBookings(ctx context.Context, addOnID uuid.UUID, excludedReservationID uuid.UUID, startDate time.Time, endDate time.Time) ([]domain.AddOnBooking, error) {
+	return getAddOnBookings(ctx, aor.GetDBHandle(), addOnID, excludedReservationID, startDate, endDate)
+}
+
+// Runs queries on a database handle or within a transaction
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Gets the units of the add-on taken by the reservations other than
+// excludedReservationID and not canceled that overlap the time frame
+func getAddOnBookings(ctx context.Context, q queryer, addOnID uuid.UUID, excludedReservationID uuid.UUID, startDate time.Time, endDate time.Time) ([]domain.AddOnBooking, error) {
+	var bookings []domain.AddOnBooking
+
+	query := "SELECT reservations.id, reservation_add_ons.quantity, reservations.start_date, reservations.end_date FROM reservation_add_ons JOIN reservations ON reservations.id = reservation_add_ons.reservation_id " +
+		"WHERE reservation_add_ons.add_on_id=$1 AND reservations.id<>$2 AND reservations.status<>$3 AND reservations.start_date < $5 AND reservations.end_date > $4"
+	rows, err := q.QueryContext(ctx, query, addOnID, excludedReservationID, constants.Values().RESERVATION_STATUSES.CANCELED, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var booking domain.AddOnBooking
+		if err := rows.Scan(&booking.ReservationID, &booking.Quantity, &booking.StartDate, &booking.EndDate); err != nil {
+			return nil, err
+		}
+
+		bookings = append(bookings, booking)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+// Scans a row of the add_ons table following the order of its columns
+func scanAddOn(row scanner) (addOn models.AddOn, err error) {
+	err = row.Scan(&addOn.ID, &addOn.BranchID, &addOn.Name, &addOn.Stock, &addOn.Price, &addOn.PricingUnit, &addOn.Currency)
+
+	return addOn, err
+}
+
+func mapAddOnViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23503":
+			return errors.New(services.ErrBranchNotFound)
+		case "23505":
+			return errors.New(services.ErrAddOnNameInUse)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,298 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type addOnsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewAddOnsDependencies(db *mocks.MockDatabase) *addOnsDependencies {
+	return &addOnsDependencies{
+		db: db,
+	}
+}
+
+func TestAddOnsInsert(t *testing.T) {
+	dao := domain.AddOn{
+		ID:          uuid.New(),
+		BranchID:    uuid.New(),
+		Name:        "Child seat",
+		Stock:       4,
+		Price:       12.5,
+		Currency:    "USD",
+		PricingUnit: "Per Day",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*addOnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the add-on was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO add_ons").
+					WithArgs(dao.ID, dao.BranchID, dao.Name, dao.Stock, dao.Price, dao.PricingUnit, dao.Currency).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the branch was not found",
+			wants: wants{
+				err: errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO add_ons").
+					WithArgs(dao.ID, dao.BranchID, dao.Name, dao.Stock, dao.Price, dao.PricingUnit, dao.Currency).
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "add_ons" violates foreign key constraint "add_ons_branch_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the branch already has an add-on with the same name",
+			wants: wants{
+				err: errors.New(services.ErrAddOnNameInUse),
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO add_ons").
+					WithArgs(dao.ID, dao.BranchID, dao.Name, dao.Stock, dao.Price, dao.PricingUnit, dao.Currency).
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "add_ons_branch_id_name_key"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewAddOnsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			addOnsRepo := NewAddOnsRepository(db)
+			err := addOnsRepo.Insert(context.TODO(), dao)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestAddOnsDelete(t *testing.T) {
+	id := uuid.New()
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*addOnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns error when the add-on is booked in reservations",
+			wants: wants{
+				err: errors.New(services.ErrAddOnHasReservations),
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM add_ons").
+					WithArgs(id).
+					WillReturnError(&pq.Error{Code: "23503", Message: `update or delete on table "add_ons" violates foreign key constraint "reservation_add_ons_add_on_id_fkey" on table "reservation_add_ons"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the add-on was not found",
+			wants: wants{
+				err: errors.New(services.ErrAddOnNotFound),
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM add_ons").
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns nil error when the add-on was deleted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM add_ons").
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewAddOnsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			addOnsRepo := NewAddOnsRepository(db)
+			err := addOnsRepo.Delete(context.TODO(), id)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestAddOnsGetBookings(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	addOnID := uuid.New()
+	excludedID := uuid.New()
+	startDate := time.Date(2030, time.July, 1, 9, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, 3)
+	booking := domain.AddOnBooking{
+		ReservationID: uuid.New(),
+		Quantity:      2,
+		StartDate:     startDate.AddDate(0, 0, -1),
+		EndDate:       startDate.AddDate(0, 0, 1),
+	}
+
+	type wants struct {
+		bookings []domain.AddOnBooking
+		err      error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*addOnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns error when query fails",
+			wants: wants{
+				bookings: nil,
+				err:      errors.New("query error"),
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`FROM reservation_add_ons JOIN reservations`).
+					WithArgs(addOnID, excludedID, "Canceled", startDate, endDate).
+					WillReturnError(errors.New("query error"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns the bookings not canceled overlapping the time frame",
+			wants: wants{
+				bookings: []domain.AddOnBooking{booking},
+				err:      nil,
+			},
+			setMocks: func(d *addOnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "quantity", "start_date", "end_date"}).
+					AddRow(booking.ReservationID.String(), booking.Quantity, booking.StartDate, booking.EndDate)
+				mock.ExpectQuery(`FROM reservation_add_ons JOIN reservations .* reservations.id<>\$2 AND reservations.status<>\$3 AND reservations.start_date < \$5 AND reservations.end_date > \$4`).
+					WithArgs(addOnID, excludedID, "Canceled", startDate, endDate).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewAddOnsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			addOnsRepo := NewAddOnsRepository(db)
+			bookings, err := addOnsRepo.GetBookings(context.TODO(), addOnID, excludedID, startDate, endDate)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.bookings, bookings)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type BillingProfilesRepo struct {
+	ports.Database
+}
+
+func NewBillingProfilesRepository(db ports.Database) *BillingProfilesRepo {
+	return &BillingProfilesRepo{
+		Database: db,
+	}
+}
+
+func (bpr *BillingProfilesRepo) Get(ctx context.Context, userID uuid.UUID) (dbp domain.BillingProfile, err error) {
+	var profile models.BillingProfile
+	err = bpr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM billing_profiles WHERE user_id = $1", userID).
+		Scan(&profile.UserID, &profile.CompanyName, &profile.TaxID, &profile.Address, &profile.City, &profile.PostalCode, &profile.Country)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.BillingProfile{}, errors.New(services.ErrBillingProfileNotFound)
+		}
+		return domain.BillingProfile{}, err
+	}
+
+	return profile.ToDomain(), nil
+}
+
+// Inserts the billing profile of the user or replaces the one they had
+func (bpr *BillingProfilesRepo) Upsert(ctx context.Context, dbp domain.BillingProfile) (err error) {
+	profile := models.LoadBillingProfileFromDomain(dbp)
+
+	_, err = bpr.GetDBHandle().ExecContext(ctx, "INSERT INTO billing_profiles (user_id, company_name, tax_id, address, city, postal_code, country) VALUES ($1, $2, $3, $4, $5, $6, $7) "+
+		"ON CONFLICT (user_id) DO UPDATE SET company_name = EXCLUDED.company_name, tax_id = EXCLUDED.tax_id, address = EXCLUDED.address, city = EXCLUDED.city, postal_code = EXCLUDED.postal_code, country = EXCLUDED.country",
+		profile.UserID, profile.CompanyName, profile.TaxID, profile.Address, profile.City, profile.PostalCode, profile.Country)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrUserNotFound)
+	}
+
+	return err
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type billingProfilesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewBillingProfilesDependencies(db *mocks.MockDatabase) *billingProfilesDependencies {
+	return &billingProfilesDependencies{
+		db: db,
+	}
+}
+
+func TestBillingProfilesUpsert(t *testing.T) {
+	dbp := domain.BillingProfile{
+		UserID:      uuid.New(),
+		CompanyName: "Acme Logistics",
+		TaxID:       "900123456-7",
+		Address:     "Calle 93 #11-28",
+		City:        "Bogota",
+		PostalCode:  "110221",
+		Country:     "CO",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*billingProfilesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when billing profile was stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *billingProfilesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO billing_profiles .* ON CONFLICT \\(user_id\\) DO UPDATE").
+					WithArgs(dbp.UserID, dbp.CompanyName, dbp.TaxID, dbp.Address, dbp.City, dbp.PostalCode, dbp.Country).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when user was not found",
+			wants: wants{
+				err: errors.New(services.ErrUserNotFound),
+			},
+			setMocks: func(d *billingProfilesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO billing_profiles").
+					WillReturnError(&pq.Error{Code: "23503"})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewBillingProfilesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			billingProfilesRepo := NewBillingProfilesRepository(db)
+			err := billingProfilesRepo.Upsert(context.TODO(), dbp)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type BranchesRepo struct {
+	ports.Database
+}
+
+func NewBranchesRepository(db ports.Database) *BranchesRepo {
+	return &BranchesRepo{
+		Database: db,
+	}
+}
+
+// Inserts a branch along with its opening hours and closures
+func (br *BranchesRepo) Insert(ctx context.Context, db domain.Branch) (err error) {
+	branch := models.LoadBranchFromDomain(db)
+
+	tx, err := br.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO branches (id, city_id, name, address, latitude, longitude) VALUES ($1, $2, $3, $4, $5, $6)",
+		branch.ID, branch.CityID, branch.Name, branch.Address, branch.Latitude, branch.Longitude)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrCityNotFound)
+		}
+		return err
+	}
+
+	if err = insertBranchSchedule(ctx, tx, db); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Gets a branch along with its opening hours and closures
+func (br *BranchesRepo) Get(ctx context.Context, ID uuid.UUID) (db domain.Branch, err error) {
+	branch, err := scanBranch(br.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM branches WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Branch{}, errors.New(services.ErrBranchNotFound)
+		}
+		return domain.Branch{}, err
+	}
+
+	branches, err := br.withSchedules(ctx, []models.Branch{branch})
+	if err != nil {
+		return domain.Branch{}, err
+	}
+
+	return branches[0], nil
+}
+
+// Updates a branch row and replaces its opening hours and closures. If branch was not found returns an error.
+func (br *BranchesRepo) FullUpdate(ctx context.Context, db domain.Branch) error {
+	branch := models.LoadBranchFromDomain(db)
+
+	tx, err := br.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE branches SET city_id=$1, name=$2, address=$3, latitude=$4, longitude=$5 WHERE id=$6",
+		branch.CityID, branch.Name, branch.Address, branch.Latitude, branch.Longitude, branch.ID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			// cars of the branch would end up in a different city
+			if strings.Contains(pqErr.Message, "cars_branch_city_fkey") {
+				return errors.New(services.ErrBranchHasCars)
+			}
+			return errors.New(services.ErrCityNotFound)
+		}
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrBranchNotFound)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM branch_opening_hours WHERE branch_id=$1", branch.ID); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM branch_closures WHERE branch_id=$1", branch.ID); err != nil {
+		return err
+	}
+
+	if err = insertBranchSchedule(ctx, tx, db); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (br *BranchesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := br.GetDBHandle().ExecContext(ctx, "DELETE FROM branches WHERE id=$1", id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			if strings.Contains(pqErr.Message, "reservation") {
+				return errors.New(services.ErrBranchHasReservations)
+			}
+			return errors.New(services.ErrBranchHasCars)
+		}
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrBranchNotFound)
+	}
+
+	return nil
+}
+
+// Lists the branches of a city ordered by name
+func (br *BranchesRepo) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error) {
+	rows, err := br.GetDBHandle().QueryContext(ctx, "SELECT * FROM branches WHERE city_id = $1 ORDER BY name ASC", cityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []models.Branch
+	defer rows.Close()
+	for rows.Next() {
+		branch, err := scanBranch(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, branch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return br.withSchedules(ctx, branches)
+}
+
+// Gets the branch a car is assigned to. Cars without a branch return a not found error.
+func (br *BranchesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (db domain.Branch, err error) {
+	branch, err := scanBranch(br.GetDBHandle().QueryRowContext(ctx, "SELECT branches.* FROM branches JOIN cars ON cars.branch_id = branches.id WHERE cars.id = $1", carID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Branch{}, errors.New(services.ErrBranchNotFound)
+		}
+		return domain.Branch{}, err
+	}
+
+	branches, err := br.withSchedules(ctx, []models.Branch{branch})
+	if err != nil {
+		return domain.Branch{}, err
+	}
+
+	return branches[0], nil
+}
+
+// Loads the opening hours and closures of the given branches
+func (br *BranchesRepo) withSchedules(ctx context.Context, branches []models.Branch) ([]domain.Branch, error) {
+	domainBranches := make([]domain.Branch, 0, len(branches))
+	if len(branches) == 0 {
+		return domainBranches, nil
+	}
+
+	ids := make(pq.StringArray, 0, len(branches))
+	for _, branch := range branches {
+		ids = append(ids, branch.ID.String())
+	}
+
+	openingHours := make(map[uuid.UUID][]domain.OpeningHours, len(branches))
+	rows, err := br.GetDBHandle().QueryContext(ctx, "SELECT branch_id, weekday, to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI') FROM branch_opening_hours WHERE branch_id = ANY($1::uuid[]) ORDER BY weekday ASC", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var branchID uuid.UUID
+		var hours domain.OpeningHours
+		if err := rows.Scan(&branchID, &hours.Weekday, &hours.OpensAt, &hours.ClosesAt); err != nil {
+			return nil, err
+		}
+
+		openingHours[branchID] = append(openingHours[branchID], hours)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	closures := make(map[uuid.UUID][]domain.BranchClosure, len(branches))
+	closureRows, err := br.GetDBHandle().QueryContext(ctx, "SELECT branch_id, to_char(date, 'YYYY-MM-DD'), reason FROM branch_closures WHERE branch_id = ANY($1::uuid[]) ORDER BY date ASC", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer closureRows.Close()
+	for closureRows.Next() {
+		var branchID uuid.UUID
+		var closure domain.BranchClosure
+		if err := closureRows.Scan(&branchID, &closure.Date, &closure.Reason); err != nil {
+			return nil, err
+		}
+
+		closures[branchID] = append(closures[branchID], closure)
+	}
+	if err := closureRows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, branch := range branches {
+		domainBranches = append(domainBranches, branch.ToDomain(openingHours[branch.ID], closures[branch.ID]))
+	}
+
+	return domainBranches, nil
+}
+
+// Inserts the opening hours and closures of a branch within a transaction
+func insertBranchSchedule(ctx context.Context, tx *sql.Tx, db domain.Branch) error {
+	for _, hours := range db.OpeningHours {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO branch_opening_hours (branch_id, weekday, opens_at, closes_at) VALUES ($1, $2, $3, $4)",
+			db.ID, hours.Weekday, hours.OpensAt, hours.ClosesAt); err != nil {
+			return err
+		}
+	}
+
+	for _, closure := range db.Closures {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO branch_closures (branch_id, date, reason) VALUES ($1, $2, $3)",
+			db.ID, closure.Date, closure.Reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Scans a row of the branches table following the order of its columns
+func scanBranch(row scanner) (branch models.Branch, err error) {
+	err = row.Scan(&branch.ID, &branch.CityID, &branch.Name, &branch.Address, &branch.Latitude, &branch.Longitude)
+
+	return branch, err
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var branchesColumns = []string{"id", "city_id", "name", "address", "latitude", "longitude"}
+
+type branchesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewBranchesDependencies(db *mocks.MockDatabase) *branchesDependencies {
+	return &branchesDependencies{
+		db: db,
+	}
+}
+
+func TestBranchesInsert(t *testing.T) {
+	db := domain.Branch{
+		ID:        uuid.New(),
+		CityID:    uuid.New(),
+		Name:      "Chicago Downtown",
+		Address:   "55 W Randolph St, Chicago, IL 60601",
+		Latitude:  41.8837,
+		Longitude: -87.6289,
+		OpeningHours: []domain.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "07:00", ClosesAt: "21:00"},
+		},
+		Closures: []domain.BranchClosure{
+			{Date: "2030-12-25", Reason: "Christmas Day"},
+		},
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*branchesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when branch and its schedule were inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *branchesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO branches").
+					WithArgs(db.ID, db.CityID, db.Name, db.Address, db.Latitude, db.Longitude).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO branch_opening_hours").
+					WithArgs(db.ID, int64(time.Monday), "07:00", "21:00").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO branch_closures").
+					WithArgs(db.ID, "2030-12-25", "Christmas Day").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns city not found error when the city does not exist",
+			wants: wants{
+				err: errors.New(services.ErrCityNotFound),
+			},
+			setMocks: func(d *branchesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO branches").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "branches" violates foreign key constraint "branches_city_id_fkey"`})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewBranchesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			branchesRepo := NewBranchesRepository(mockDB)
+			err := branchesRepo.Insert(context.TODO(), db)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestBranchesGetByCarID(t *testing.T) {
+	carID := uuid.New()
+	branch := domain.Branch{
+		ID:        uuid.New(),
+		CityID:    uuid.New(),
+		Name:      "Chicago Downtown",
+		Address:   "55 W Randolph St, Chicago, IL 60601",
+		Latitude:  41.8837,
+		Longitude: -87.6289,
+		OpeningHours: []domain.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "07:00", ClosesAt: "21:00"},
+			{Weekday: time.Tuesday, OpensAt: "07:00", ClosesAt: "21:00"},
+		},
+		Closures: []domain.BranchClosure{
+			{Date: "2030-12-25", Reason: "Christmas Day"},
+		},
+	}
+
+	type wants struct {
+		branch domain.Branch
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*branchesDependencies) *sql.DB
+	}{
+		{
+			name: "returns branch not found error when the car has no branch",
+			wants: wants{
+				branch: domain.Branch{},
+				err:    errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *branchesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`SELECT branches.\* FROM branches JOIN cars`).
+					WithArgs(carID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns the branch of the car with its opening hours and closures",
+			wants: wants{
+				branch: branch,
+				err:    nil,
+			},
+			setMocks: func(d *branchesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`SELECT branches.\* FROM branches JOIN cars`).
+					WithArgs(carID).
+					WillReturnRows(sqlmock.NewRows(branchesColumns).
+						AddRow(branch.ID.String(), branch.CityID.String(), branch.Name, branch.Address, branch.Latitude, branch.Longitude))
+				mock.ExpectQuery("FROM branch_opening_hours").
+					WillReturnRows(sqlmock.NewRows([]string{"branch_id", "weekday", "opens_at", "closes_at"}).
+						AddRow(branch.ID.String(), 1, "07:00", "21:00").
+						AddRow(branch.ID.String(), 2, "07:00", "21:00"))
+				mock.ExpectQuery("FROM branch_closures").
+					WillReturnRows(sqlmock.NewRows([]string{"branch_id", "date", "reason"}).
+						AddRow(branch.ID.String(), "2030-12-25", "Christmas Day"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewBranchesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			branchesRepo := NewBranchesRepository(mockDB)
+			branch, err := branchesRepo.GetByCarID(context.TODO(), carID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.branch, branch)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type CarMileagesRepo struct {
+	ports.Database
+}
+
+func NewCarMileagesRepository(db ports.Database) *CarMileagesRepo {
+	return &CarMileagesRepo{
+		Database: db,
+	}
+}
+
+// Gets the mileage of a car. Cars without inspections have zero mileage.
+func (cmr *CarMileagesRepo) Get(ctx context.Context, carID uuid.UUID) (dcm domain.CarMileage, err error) {
+	var carMileage models.CarMileage
+
+	query := "SELECT cars.id, COALESCE(car_mileages.mileage, 0), COALESCE(car_mileages.last_service_mileage, 0) FROM cars LEFT JOIN car_mileages ON car_mileages.car_id = cars.id WHERE cars.id = $1"
+	if err := cmr.GetDBHandle().QueryRowContext(ctx, query, carID).
+		Scan(&carMileage.CarID, &carMileage.Mileage, &carMileage.LastServiceMileage); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CarMileage{}, errors.New(services.ErrCarNotFound)
+		}
+		return domain.CarMileage{}, err
+	}
+
+	return carMileage.ToDomain(), nil
+}
+
+// Records that the car was serviced at its current mileage
+func (cmr *CarMileagesRepo) RegisterService(ctx context.Context, carID uuid.UUID) error {
+	_, err := cmr.GetDBHandle().ExecContext(ctx, "INSERT INTO car_mileages (car_id) VALUES ($1) ON CONFLICT (car_id) DO UPDATE SET last_service_mileage = car_mileages.mileage", carID)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrCarNotFound)
+	}
+
+	return err
+}
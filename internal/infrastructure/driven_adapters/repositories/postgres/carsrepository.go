@@ -4,12 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
 	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type CarsRepo struct {
@@ -32,16 +37,15 @@ func (cr *CarsRepo) Insert(ctx context.Context, dc domain.Car) (err error) {
 		return err
 	}
 
-	_, err = cr.GetDBHandle().ExecContext(ctx, "INSERT INTO cars (id, type, seats, hourly_rent_cost, city_id, status) VALUES ($1, $2, $3, $4, $5, $6)",
-		car.ID, car.Type, car.Seats, car.HourlyRentCost, car.CityID, car.Status)
+	_, err = cr.GetDBHandle().ExecContext(ctx, "INSERT INTO cars (id, type, seats, hourly_rent_cost, city_id, status, make, model, year, license_plate, vin, transmission, fuel_type, color, features, branch_id, currency) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)",
+		car.ID, car.Type, car.Seats, car.HourlyRentCost, car.CityID, car.Status, car.Make, car.Model, car.Year, car.LicensePlate, car.VIN, car.Transmission, car.FuelType, car.Color, car.Features, car.BranchID, car.Currency)
 
-	return err
+	return mapCarConstraintViolation(err)
 }
 
 func (cr *CarsRepo) Get(ctx context.Context, ID uuid.UUID) (dc domain.Car, err error) {
-	var car models.Car
-	if err := cr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM cars WHERE ID = $1", ID).
-		Scan(&car.ID, &car.Type, &car.Seats, &car.HourlyRentCost, &car.CityID, &car.Status); err != nil {
+	car, err := scanCar(cr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM cars WHERE ID = $1", ID))
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Car{}, errors.New(services.ErrCarNotFound)
 		}
@@ -64,10 +68,10 @@ func (cr *CarsRepo) FullUpdate(ctx context.Context, dc domain.Car) (err error) {
 		return err
 	}
 
-	result, err := cr.GetDBHandle().ExecContext(ctx, "UPDATE cars SET type=$1, seats=$2, hourly_rent_cost=$3, city_id=$4, status=$5 WHERE id=$6",
-		car.Type, car.Seats, car.HourlyRentCost, car.CityID, car.Status, car.ID)
+	result, err := cr.GetDBHandle().ExecContext(ctx, "UPDATE cars SET type=$1, seats=$2, hourly_rent_cost=$3, city_id=$4, status=$5, make=$6, model=$7, year=$8, license_plate=$9, vin=$10, transmission=$11, fuel_type=$12, color=$13, features=$14, branch_id=$15, currency=$16 WHERE id=$17",
+		car.Type, car.Seats, car.HourlyRentCost, car.CityID, car.Status, car.Make, car.Model, car.Year, car.LicensePlate, car.VIN, car.Transmission, car.FuelType, car.Color, car.Features, car.BranchID, car.Currency, car.ID)
 	if err != nil {
-		return err
+		return mapCarConstraintViolation(err)
 	}
 
 	numUpdatedRows, err := result.RowsAffected()
@@ -100,10 +104,10 @@ func (cr *CarsRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-// List cars by city name.
+// List cars by city name matching the given filters.
 // from_car_id is the last document retrieved in the last page.
 // limit is the number of documents per page.
-func (cr *CarsRepo) List(ctx context.Context, cityName string, from_car_id string, limit uint16) ([]domain.Car, error) {
+func (cr *CarsRepo) List(ctx context.Context, cityName string, filters domain.CarFilters, from_car_id string, limit uint16) ([]domain.Car, error) {
 	var cars []domain.Car
 
 	cityID, err := cr.citiesRepository.GetIdByName(ctx, cityName)
@@ -111,14 +115,18 @@ func (cr *CarsRepo) List(ctx context.Context, cityName string, from_car_id strin
 		return nil, err
 	}
 
-	rows, err := cr.GetDBHandle().QueryContext(ctx, "SELECT * FROM cars WHERE city_id=$1 AND id > $2 ORDER BY id ASC LIMIT $3", cityID, from_car_id, limit)
+	conditions, args := carFiltersConditions(filters, 3)
+	query := fmt.Sprintf("SELECT * FROM cars WHERE city_id=$1 AND id > $2%s ORDER BY id ASC LIMIT $%d", conditions, len(args)+3)
+	args = append([]interface{}{cityID, from_car_id}, append(args, limit)...)
+
+	rows, err := cr.GetDBHandle().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		car := models.Car{}
-		if err := rows.Scan(&car.ID, &car.Type, &car.Seats, &car.HourlyRentCost, &car.CityID, &car.Status); err != nil {
+		car, err := scanCar(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -131,3 +139,130 @@ func (cr *CarsRepo) List(ctx context.Context, cityName string, from_car_id strin
 
 	return cars, nil
 }
+
+// Lists the cars with the given status that are free of reservations and
+// maintenances between startDate and endDate, and will be at a branch inside
+// the bounds when startDate comes. A car will be at the return branch of its
+// last reservation ending before startDate, or else at its own branch.
+// Canceled reservations neither hold the car nor move it.
+func (cr *CarsRepo) ListAvailableInArea(ctx context.Context, bounds domain.GeoBounds, status string, startDate time.Time, endDate time.Time) ([]domain.NearbyCar, error) {
+	var nearbyCars []domain.NearbyCar
+
+	query := `SELECT cars.*, cities.name, branches.* FROM cars
+		JOIN cities ON cities.id = cars.city_id
+		JOIN branches ON branches.id = COALESCE((SELECT reservations.return_branch_id FROM reservations
+			WHERE reservations.car_id = cars.id AND reservations.status <> $8 AND reservations.end_date > now() AND reservations.end_date <= $1
+			ORDER BY reservations.end_date DESC LIMIT 1), cars.branch_id)
+		WHERE cars.status = $3
+		AND branches.latitude BETWEEN $4 AND $5 AND branches.longitude BETWEEN $6 AND $7
+		AND NOT EXISTS (SELECT 1 FROM reservations WHERE reservations.car_id = cars.id AND reservations.status <> $8 AND reservations.start_date < $2 AND reservations.end_date > $1)
+		AND NOT EXISTS (SELECT 1 FROM maintenances WHERE maintenances.car_id = cars.id AND maintenances.start_date < $2 AND maintenances.end_date > $1)
+		AND NOT EXISTS (SELECT 1 FROM transfers WHERE transfers.car_id = cars.id AND transfers.completed_at IS NULL AND transfers.departure_date < $2)`
+	rows, err := cr.GetDBHandle().QueryContext(ctx, query, startDate, endDate, status,
+		bounds.MinLatitude, bounds.MaxLatitude, bounds.MinLongitude, bounds.MaxLongitude, constants.Values().RESERVATION_STATUSES.CANCELED)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var car models.Car
+		var cityName string
+		var branch models.Branch
+		fields := append(carFields(&car), &cityName, &branch.ID, &branch.CityID, &branch.Name, &branch.Address, &branch.Latitude, &branch.Longitude)
+		if err := rows.Scan(fields...); err != nil {
+			return nil, err
+		}
+
+		nearbyCars = append(nearbyCars, domain.NearbyCar{
+			Car:          car.ToDomain(cityName),
+			PickupBranch: branch.ToDomain(nil, nil),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return nearbyCars, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Scans a row of the cars table following the order of its columns
+func scanCar(row scanner) (car models.Car, err error) {
+	err = row.Scan(carFields(&car)...)
+
+	return car, err
+}
+
+// Gets the destinations to scan the columns of the cars table in order
+func carFields(car *models.Car) []interface{} {
+	return []interface{}{&car.ID, &car.Type, &car.Seats, &car.HourlyRentCost, &car.CityID, &car.Status,
+		&car.Make, &car.Model, &car.Year, &car.LicensePlate, &car.VIN, &car.Transmission, &car.FuelType, &car.Color, &car.Features, &car.BranchID, &car.Currency}
+}
+
+// Builds the SQL conditions for the non empty filters. Placeholders are
+// numbered starting from firstPlaceholder.
+func carFiltersConditions(filters domain.CarFilters, firstPlaceholder int) (string, []interface{}) {
+	var conditions strings.Builder
+	var args []interface{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions.WriteString(" AND ")
+		conditions.WriteString(strings.ReplaceAll(condition, "$?", fmt.Sprintf("$%d", firstPlaceholder+len(args)-1)))
+	}
+
+	if filters.Type != "" {
+		addCondition("type=$?", filters.Type)
+	}
+	if filters.Make != "" {
+		addCondition("lower(make)=lower($?)", filters.Make)
+	}
+	if filters.Model != "" {
+		addCondition("lower(model)=lower($?)", filters.Model)
+	}
+	if filters.Year != 0 {
+		addCondition("year=$?", filters.Year)
+	}
+	if filters.Transmission != "" {
+		addCondition("transmission=$?", filters.Transmission)
+	}
+	if filters.FuelType != "" {
+		addCondition("fuel_type=$?", filters.FuelType)
+	}
+	if filters.Color != "" {
+		addCondition("lower(color)=lower($?)", filters.Color)
+	}
+	if len(filters.Features) > 0 {
+		addCondition("features @> $?", pq.StringArray(filters.Features))
+	}
+	if filters.Search != "" {
+		addCondition("(lower(make) LIKE $? OR lower(model) LIKE $? OR lower(license_plate) LIKE $?)",
+			escapeLike(strings.ToLower(filters.Search))+"%")
+	}
+
+	return conditions.String(), args
+}
+
+// Escapes the wildcard characters of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// Maps unique and branch constraint violations in cars table to service errors
+func mapCarConstraintViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if strings.Contains(pqErr.Message, "unique_license_plate") {
+			return errors.New(services.ErrLicensePlateAlreadyRegistered)
+		} else if strings.Contains(pqErr.Message, "unique_vin") {
+			return errors.New(services.ErrVINAlreadyRegistered)
+		}
+	} else if ok && pqErr.Code == "23503" && strings.Contains(pqErr.Message, "cars_branch_city_fkey") {
+		return errors.New(services.ErrBranchNotInCarCity)
+	}
+
+	return err
+}
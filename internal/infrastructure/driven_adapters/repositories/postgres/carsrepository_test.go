@@ -5,18 +5,23 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 )
 
 var pathToRoot = "./../../../../.."
 
+var carsColumns = []string{"id", "type", "seats", "hourly_rent_cost", "city_id", "status", "make", "model", "year", "license_plate", "vin", "transmission", "fuel_type", "color", "features", "branch_id", "currency"}
+
 type carsDependencies struct {
 	db         *mocks.MockDatabase
 	citiesRepo *mocks.MockCitiesRepo
@@ -43,9 +48,22 @@ func TestCarsInsert(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
+	branchID := uuid.New()
+	dcWithBranch := dc
+	dcWithBranch.BranchID = &branchID
 
 	type args struct {
 		ctx context.Context
@@ -79,7 +97,7 @@ func TestCarsInsert(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 1)
 				mock.ExpectExec("INSERT INTO cars").
-					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status).
+					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -105,7 +123,7 @@ func TestCarsInsert(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("INSERT INTO cars").
-					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status).
+					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency).
 					WillReturnError(errors.New("there was some error"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -113,6 +131,84 @@ func TestCarsInsert(t *testing.T) {
 				return dbHandle
 			},
 		},
+		{
+			name: "returns license plate already registered error when plate is taken by another car",
+			args: args{
+				ctx: context.TODO(),
+				car: dc,
+			},
+			wants: wants{
+				err: errors.New(services.ErrLicensePlateAlreadyRegistered),
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				city_id := uuid.New()
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), dc.CityName).Return(city_id, nil)
+
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO cars").
+					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency).
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "unique_license_plate"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns vin already registered error when vin is taken by another car",
+			args: args{
+				ctx: context.TODO(),
+				car: dc,
+			},
+			wants: wants{
+				err: errors.New(services.ErrVINAlreadyRegistered),
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				city_id := uuid.New()
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), dc.CityName).Return(city_id, nil)
+
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO cars").
+					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency).
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "unique_vin"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns branch not in car city error when the branch belongs to another city",
+			args: args{
+				ctx: context.TODO(),
+				car: dcWithBranch,
+			},
+			wants: wants{
+				err: errors.New(services.ErrBranchNotInCarCity),
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				city_id := uuid.New()
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), dc.CityName).Return(city_id, nil)
+
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO cars").
+					WithArgs(dc.ID, dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), branchID, dc.Currency).
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "cars" violates foreign key constraint "cars_branch_city_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
 		{
 			name: "returns error when query to cities repo fails",
 			args: args{
@@ -157,8 +253,18 @@ func TestCarsGet(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -200,8 +306,8 @@ func TestCarsGet(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "type", "seats", "hourly_rent_cost", "city_id", "status"}).
-					AddRow(carIdByte, dc.Type, dc.Seats, dc.HourlyRentCost, cityIdByte, dc.Status)
+				rows := sqlmock.NewRows(carsColumns).
+					AddRow(carIdByte, dc.Type, dc.Seats, dc.HourlyRentCost, cityIdByte, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, "{A/C,GPS}", nil, dc.Currency)
 				mock.ExpectQuery(`SELECT \* FROM cars WHERE ID = \$1`).
 					WillReturnRows(rows)
 
@@ -235,8 +341,8 @@ func TestCarsGet(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "type", "seats", "hourly_rent_cost", "city_id", "status"}).
-					AddRow(carIdByte, dc.Type, dc.Seats, dc.HourlyRentCost, cityIdByte, dc.Status)
+				rows := sqlmock.NewRows(carsColumns).
+					AddRow(carIdByte, dc.Type, dc.Seats, dc.HourlyRentCost, cityIdByte, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, "{A/C,GPS}", nil, dc.Currency)
 				mock.ExpectQuery(`SELECT \* FROM cars WHERE ID = \$1`).
 					WillReturnRows(rows)
 
@@ -321,8 +427,18 @@ func TestCarsFullUpdate(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -371,7 +487,7 @@ func TestCarsFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("UPDATE cars SET").
-					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.ID).
+					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency, dc.ID).
 					WillReturnError(errors.New("exec error"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -398,7 +514,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewErrorResult(errors.New("rows affected error"))
 				mock.ExpectExec("UPDATE cars SET").
-					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.ID).
+					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency, dc.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -425,7 +541,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 0)
 				mock.ExpectExec("UPDATE cars SET").
-					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.ID).
+					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency, dc.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -452,7 +568,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 1)
 				mock.ExpectExec("UPDATE cars SET").
-					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.ID).
+					WithArgs(dc.Type, dc.Seats, dc.HourlyRentCost, city_id, dc.Status, dc.Make, dc.Model, dc.Year, dc.LicensePlate, dc.VIN, dc.Transmission, dc.FuelType, dc.Color, pq.StringArray(dc.Features), nil, dc.Currency, dc.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -622,14 +738,25 @@ func TestCarsList(t *testing.T) {
 			Type:           "Sedan",
 			Seats:          4,
 			HourlyRentCost: 21.1,
+			Currency:       "USD",
 			CityName:       "Los Angeles",
 			Status:         "Available",
+			Make:           "Toyota",
+			Model:          "Corolla",
+			Year:           2021,
+			LicensePlate:   "ABC1234",
+			VIN:            "1HGCM82633A004352",
+			Transmission:   "Automatic",
+			FuelType:       "Gasoline",
+			Color:          "White",
+			Features:       []string{"A/C", "GPS"},
 		},
 	}
 
 	type args struct {
 		ctx         context.Context
 		cityName    string
+		filters     domain.CarFilters
 		from_car_id string
 		limit       uint16
 	}
@@ -698,7 +825,7 @@ func TestCarsList(t *testing.T) {
 			},
 			wants: wants{
 				cars: nil,
-				err:  errors.New("sql: expected 1 destination arguments in Scan, not 6"),
+				err:  errors.New("sql: expected 1 destination arguments in Scan, not 17"),
 			},
 			setMocks: func(d *carsDependencies) *sql.DB {
 				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), "Los Angeles").Return(uuid.New(), nil)
@@ -748,8 +875,8 @@ func TestCarsList(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "type", "seats", "hourly_rent_cost", "city_id", "status"}).
-					AddRow(carIdByte, dcs[0].Type, dcs[0].Seats, dcs[0].HourlyRentCost, cityIdByte, dcs[0].Status).
+				rows := sqlmock.NewRows(carsColumns).
+					AddRow(carIdByte, dcs[0].Type, dcs[0].Seats, dcs[0].HourlyRentCost, cityIdByte, dcs[0].Status, dcs[0].Make, dcs[0].Model, dcs[0].Year, dcs[0].LicensePlate, dcs[0].VIN, dcs[0].Transmission, dcs[0].FuelType, dcs[0].Color, "{A/C,GPS}", nil, dcs[0].Currency).
 					RowError(0, errors.New("rows.Err error"))
 				mock.ExpectQuery(`^SELECT \* FROM cars WHERE city_id=\$1 AND id > \$2 ORDER BY id ASC LIMIT \$3$`).
 					WillReturnRows(rows)
@@ -786,13 +913,167 @@ func TestCarsList(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "type", "seats", "hourly_rent_cost", "city_id", "status"}).
-					AddRow(carIdByte, dcs[0].Type, dcs[0].Seats, dcs[0].HourlyRentCost, cityIdByte, dcs[0].Status)
+				rows := sqlmock.NewRows(carsColumns).
+					AddRow(carIdByte, dcs[0].Type, dcs[0].Seats, dcs[0].HourlyRentCost, cityIdByte, dcs[0].Status, dcs[0].Make, dcs[0].Model, dcs[0].Year, dcs[0].LicensePlate, dcs[0].VIN, dcs[0].Transmission, dcs[0].FuelType, dcs[0].Color, "{A/C,GPS}", nil, dcs[0].Currency)
 				mock.ExpectQuery(`^SELECT \* FROM cars WHERE city_id=\$1 AND id > \$2 ORDER BY id ASC LIMIT \$3$`).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
+				return dbHandle
+			},
+		},
+		{
+			name: "returns cars matching the given filters",
+			args: args{
+				ctx:         context.TODO(),
+				cityName:    "Los Angeles",
+				filters:     domain.CarFilters{Make: "Toyota", Features: []string{"GPS"}, Search: "Cor"},
+				from_car_id: "",
+				limit:       20,
+			},
+			wants: wants{
+				cars: dcs,
+				err:  nil,
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				cityID := uuid.New()
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), "Los Angeles").Return(cityID, nil)
+
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				carIdByte, err := dcs[0].ID.MarshalBinary()
+				if err != nil {
+					t.Fatal(err)
+				}
+				cityIdByte, err := cityID.MarshalBinary()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(carsColumns).
+					AddRow(carIdByte, dcs[0].Type, dcs[0].Seats, dcs[0].HourlyRentCost, cityIdByte, dcs[0].Status, dcs[0].Make, dcs[0].Model, dcs[0].Year, dcs[0].LicensePlate, dcs[0].VIN, dcs[0].Transmission, dcs[0].FuelType, dcs[0].Color, "{A/C,GPS}", nil, dcs[0].Currency)
+				mock.ExpectQuery(`^SELECT \* FROM cars WHERE city_id=\$1 AND id > \$2 AND lower\(make\)=lower\(\$3\) AND features @> \$4 AND \(lower\(make\) LIKE \$5 OR lower\(model\) LIKE \$5 OR lower\(license_plate\) LIKE \$5\) ORDER BY id ASC LIMIT \$6$`).
+					WithArgs(cityID, "", "Toyota", pq.StringArray{"GPS"}, "cor%", 20).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewCarsDependencies(db, citiesRepo)
+			dbHandle := test.setMocks(d)
+
+			carsRepo := NewCarsRepository(db, citiesRepo)
+			cars, err := carsRepo.List(test.args.ctx, test.args.cityName, test.args.filters, test.args.from_car_id, test.args.limit)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.cars, cars)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestCarsListAvailableInArea(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	startDate := time.Date(2030, time.June, 1, 10, 0, 0, 0, time.UTC)
+	endDate := time.Date(2030, time.June, 2, 10, 0, 0, 0, time.UTC)
+	bounds := domain.GeoBounds{MinLatitude: 41.79, MaxLatitude: 41.97, MinLongitude: -87.75, MaxLongitude: -87.50}
+	cityID := uuid.New()
+	nearbyCars := []domain.NearbyCar{
+		{
+			Car: domain.Car{
+				ID:             uuid.New(),
+				Type:           "Sedan",
+				Seats:          4,
+				HourlyRentCost: 21.1,
+				Currency:       "USD",
+				CityName:       "Chicago",
+				Status:         "Available",
+				Make:           "Toyota",
+				Model:          "Corolla",
+				Year:           2021,
+				LicensePlate:   "ABC1234",
+				VIN:            "1HGCM82633A004352",
+				Transmission:   "Automatic",
+				FuelType:       "Gasoline",
+				Color:          "White",
+				Features:       []string{"A/C", "GPS"},
+			},
+			PickupBranch: domain.Branch{
+				ID:        uuid.New(),
+				CityID:    cityID,
+				Name:      "Downtown",
+				Address:   "100 N State St",
+				Latitude:  41.8837,
+				Longitude: -87.6289,
+			},
+		},
+	}
+	query := `^SELECT cars\.\*, cities\.name, branches\.\* FROM cars JOIN cities ON cities\.id = cars\.city_id JOIN branches ON branches\.id = COALESCE\(.+, cars\.branch_id\) ` +
+		`WHERE cars\.status = \$3 AND branches\.latitude BETWEEN \$4 AND \$5 AND branches\.longitude BETWEEN \$6 AND \$7 AND NOT EXISTS \(.+\) AND NOT EXISTS \(.+\)$`
+
+	type wants struct {
+		cars []domain.NearbyCar
+		err  error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*carsDependencies) *sql.DB
+	}{
+		{
+			name: "returns error when query context fails",
+			wants: wants{
+				cars: nil,
+				err:  errors.New("query context error"),
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("query context error"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns the cars with the branch where they can be picked up",
+			wants: wants{
+				cars: nearbyCars,
+				err:  nil,
+			},
+			setMocks: func(d *carsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				car, branch := nearbyCars[0].Car, nearbyCars[0].PickupBranch
+				rows := sqlmock.NewRows(append(carsColumns, "name", "id", "city_id", "name", "address", "latitude", "longitude")).
+					AddRow(car.ID.String(), car.Type, car.Seats, car.HourlyRentCost, cityID.String(), car.Status, car.Make, car.Model, car.Year, car.LicensePlate, car.VIN, car.Transmission, car.FuelType, car.Color, "{A/C,GPS}", nil, car.Currency,
+						car.CityName, branch.ID.String(), cityID.String(), branch.Name, branch.Address, branch.Latitude, branch.Longitude)
+				mock.ExpectQuery(query).
+					WithArgs(startDate, endDate, "Available", bounds.MinLatitude, bounds.MaxLatitude, bounds.MinLongitude, bounds.MaxLongitude, "Canceled").
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
 				return dbHandle
 			},
 		},
@@ -807,7 +1088,7 @@ func TestCarsList(t *testing.T) {
 			dbHandle := test.setMocks(d)
 
 			carsRepo := NewCarsRepository(db, citiesRepo)
-			cars, err := carsRepo.List(test.args.ctx, test.args.cityName, test.args.from_car_id, test.args.limit)
+			cars, err := carsRepo.ListAvailableInArea(context.TODO(), bounds, "Available", startDate, endDate)
 
 			if dbHandle != nil {
 				dbHandle.Close()
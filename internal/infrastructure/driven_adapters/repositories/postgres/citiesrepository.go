@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
 	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type CitiesRepo struct {
@@ -20,6 +24,118 @@ func NewCitiesRepository(db ports.Database) *CitiesRepo {
 	}
 }
 
+func (cr *CitiesRepo) Insert(ctx context.Context, dc domain.City) (err error) {
+	city := models.LoadCityFromDomain(dc)
+
+	_, err = cr.GetDBHandle().ExecContext(ctx, "INSERT INTO cities (id, name, time_zone, country, currency) VALUES ($1, $2, $3, $4, $5)",
+		city.ID, city.Name, city.TimeZone, city.Country, city.Currency)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if strings.Contains(pqErr.Message, "unique_city_name") {
+			return errors.New(services.ErrCityNameAlreadyRegistered)
+		}
+	}
+
+	return err
+}
+
+func (cr *CitiesRepo) Get(ctx context.Context, ID uuid.UUID) (dc domain.City, err error) {
+	city, err := scanCity(cr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM cities WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.City{}, errors.New(services.ErrCityNotFound)
+		}
+		return domain.City{}, err
+	}
+
+	return city.ToDomain(), nil
+}
+
+// Updates city row. If city was not found returns an error. The currency of
+// cities with cars can not change, since their reservations are kept in it.
+func (cr *CitiesRepo) FullUpdate(ctx context.Context, dc domain.City) error {
+	city := models.LoadCityFromDomain(dc)
+
+	result, err := cr.GetDBHandle().ExecContext(ctx, "UPDATE cities SET name=$1, time_zone=$2, country=$3, currency=$4 WHERE id=$5 AND (currency=$4 OR NOT EXISTS (SELECT 1 FROM cars WHERE city_id=$5))",
+		city.Name, city.TimeZone, city.Country, city.Currency, city.ID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			if strings.Contains(pqErr.Message, "unique_city_name") {
+				return errors.New(services.ErrCityNameAlreadyRegistered)
+			}
+		}
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		var exists bool
+		if err := cr.GetDBHandle().QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM cities WHERE id=$1)", city.ID).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return errors.New(services.ErrCityCurrencyInUse)
+		}
+
+		return errors.New(services.ErrCityNotFound)
+	}
+
+	return nil
+}
+
+// Deletes a city. Cities with cars can not be deleted.
+func (cr *CitiesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := cr.GetDBHandle().ExecContext(ctx, "DELETE FROM cities WHERE id=$1", id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			if strings.Contains(pqErr.Message, "branches_city_id_fkey") {
+				return errors.New(services.ErrCityHasBranches)
+			}
+			return errors.New(services.ErrCityHasCars)
+		}
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrCityNotFound)
+	}
+
+	return nil
+}
+
+func (cr *CitiesRepo) List(ctx context.Context, fromCityID string, limit uint16) ([]domain.City, error) {
+	var cities []domain.City
+
+	rows, err := cr.GetDBHandle().QueryContext(ctx, "SELECT * FROM cities WHERE id > $1 ORDER BY id ASC LIMIT $2", fromCityID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		city, err := scanCity(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		cities = append(cities, city.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return cities, nil
+}
+
 func (cr *CitiesRepo) GetIdByName(ctx context.Context, name string) (ID uuid.UUID, err error) {
 	if err := cr.GetDBHandle().QueryRowContext(ctx, "SELECT id FROM cities WHERE name = $1", name).
 		Scan(&ID); err != nil {
@@ -44,26 +160,56 @@ func (cr *CitiesRepo) GetNameByID(ctx context.Context, ID uuid.UUID) (name strin
 	return name, nil
 }
 
-func (cr *CitiesRepo) ListNames(ctx context.Context) ([]string, error) {
-	var cityNames []string
+// Gets the city where a car is located
+func (cr *CitiesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (dc domain.City, err error) {
+	city, err := scanCity(cr.GetDBHandle().QueryRowContext(ctx, "SELECT cities.* FROM cities JOIN cars ON cars.city_id = cities.id WHERE cars.id = $1", carID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.City{}, errors.New(services.ErrCarNotFound)
+		}
+		return domain.City{}, err
+	}
 
-	rows, err := cr.GetDBHandle().QueryContext(ctx, "SELECT name FROM cities ORDER BY name LIMIT 100")
+	return city.ToDomain(), nil
+}
+
+// Gets the time zone of the city of each car. Cars that were not found are left out.
+func (cr *CitiesRepo) GetTimeZonesByCarIDs(ctx context.Context, carIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	timeZones := make(map[uuid.UUID]string, len(carIDs))
+	if len(carIDs) == 0 {
+		return timeZones, nil
+	}
+
+	ids := make(pq.StringArray, 0, len(carIDs))
+	for _, carID := range carIDs {
+		ids = append(ids, carID.String())
+	}
+
+	rows, err := cr.GetDBHandle().QueryContext(ctx, "SELECT cars.id, cities.time_zone FROM cars JOIN cities ON cities.id = cars.city_id WHERE cars.id = ANY($1::uuid[])", ids)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		var cityName string
-		if err := rows.Scan(&cityName); err != nil {
+		var carID uuid.UUID
+		var timeZone string
+		if err := rows.Scan(&carID, &timeZone); err != nil {
 			return nil, err
 		}
 
-		cityNames = append(cityNames, cityName)
+		timeZones[carID] = timeZone
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return cityNames, nil
+	return timeZones, nil
+}
+
+// Scans a row of the cities table following the order of its columns
+func scanCity(row scanner) (city models.City, err error) {
+	err = row.Scan(&city.ID, &city.Name, &city.TimeZone, &city.Country, &city.Currency)
+
+	return city, err
 }
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -249,21 +251,24 @@ func TestCitiesGetNameByID(t *testing.T) {
 	}
 }
 
-func TestListNames(t *testing.T) {
+func TestCitiesList(t *testing.T) {
 	initConstantsFromRepository(t)
 
-	ns := []string{
-		"Chicago",
-		"New York",
-		"Los Angeles",
+	cities := []domain.City{
+		{ID: uuid.New(), Name: "Chicago", TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+		{ID: uuid.New(), Name: "New York", TimeZone: "America/New_York", Country: "US", Currency: "USD"},
 	}
+	query := `SELECT \* FROM cities WHERE id > \$1 ORDER BY id ASC LIMIT \$2`
+	columns := []string{"id", "name", "time_zone", "country", "currency"}
 
 	type args struct {
-		ctx context.Context
+		ctx        context.Context
+		fromCityID string
+		limit      uint16
 	}
 	type wants struct {
-		names []string
-		err   error
+		cities []domain.City
+		err    error
 	}
 	tests := []struct {
 		name     string
@@ -274,18 +279,21 @@ func TestListNames(t *testing.T) {
 		{
 			name: "returns error when query context fails",
 			args: args{
-				ctx: context.TODO(),
+				ctx:        context.TODO(),
+				fromCityID: "00000000-0000-0000-0000-000000000000",
+				limit:      20,
 			},
 			wants: wants{
-				names: nil,
-				err:   errors.New("query context error"),
+				cities: nil,
+				err:    errors.New("query context error"),
 			},
 			setMocks: func(d *citiesDependencies) *sql.DB {
 				dbHandle, mock, err := sqlmock.New()
 				if err != nil {
 					t.Fatal(err)
 				}
-				mock.ExpectQuery("SELECT name FROM cities ORDER BY name LIMIT 100").
+				mock.ExpectQuery(query).
+					WithArgs("00000000-0000-0000-0000-000000000000", 20).
 					WillReturnError(errors.New("query context error"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -294,22 +302,26 @@ func TestListNames(t *testing.T) {
 			},
 		},
 		{
-			name: "returns error when rows are not as expected",
+			name: "returns error when rows.Err fails",
 			args: args{
-				ctx: context.TODO(),
+				ctx:        context.TODO(),
+				fromCityID: "00000000-0000-0000-0000-000000000000",
+				limit:      20,
 			},
 			wants: wants{
-				names: nil,
-				err:   errors.New("sql: expected 2 destination arguments in Scan, not 1"),
+				cities: nil,
+				err:    errors.New("rows.Err error"),
 			},
 			setMocks: func(d *citiesDependencies) *sql.DB {
 				dbHandle, mock, err := sqlmock.New()
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"noname", "noname"}).
-					AddRow("some name", "some name")
-				mock.ExpectQuery("SELECT name FROM cities ORDER BY name LIMIT 100").
+				rows := sqlmock.NewRows(columns).
+					AddRow(cities[0].ID.String(), cities[0].Name, cities[0].TimeZone, cities[0].Country, cities[0].Currency).
+					RowError(0, errors.New("rows.Err error"))
+				mock.ExpectQuery(query).
+					WithArgs("00000000-0000-0000-0000-000000000000", 20).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -318,23 +330,26 @@ func TestListNames(t *testing.T) {
 			},
 		},
 		{
-			name: "returns error when rows.Err fails",
+			name: "returns cities page when there were no errors",
 			args: args{
-				ctx: context.TODO(),
+				ctx:        context.TODO(),
+				fromCityID: "00000000-0000-0000-0000-000000000000",
+				limit:      20,
 			},
 			wants: wants{
-				names: nil,
-				err:   errors.New("rows.Err error"),
+				cities: cities,
+				err:    nil,
 			},
 			setMocks: func(d *citiesDependencies) *sql.DB {
 				dbHandle, mock, err := sqlmock.New()
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"name"}).
-					AddRow("city name").
-					RowError(0, errors.New("rows.Err error"))
-				mock.ExpectQuery("SELECT name FROM cities ORDER BY name LIMIT 100").
+				rows := sqlmock.NewRows(columns).
+					AddRow(cities[0].ID.String(), cities[0].Name, cities[0].TimeZone, cities[0].Country, cities[0].Currency).
+					AddRow(cities[1].ID.String(), cities[1].Name, cities[1].TimeZone, cities[1].Country, cities[1].Currency)
+				mock.ExpectQuery(query).
+					WithArgs("00000000-0000-0000-0000-000000000000", 20).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -342,25 +357,106 @@ func TestListNames(t *testing.T) {
 				return dbHandle
 			},
 		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewCitiesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			citiesRepo := NewCitiesRepository(db)
+			cities, err := citiesRepo.List(test.args.ctx, test.args.fromCityID, test.args.limit)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.cities, cities)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestCitiesGetTimeZonesByCarIDs(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	carA, carB := uuid.New(), uuid.New()
+	query := `SELECT cars.id, cities.time_zone FROM cars JOIN cities ON cities.id = cars.city_id WHERE cars.id = ANY\(\$1::uuid\[\]\)`
+
+	type args struct {
+		ctx    context.Context
+		carIDs []uuid.UUID
+	}
+	type wants struct {
+		timeZones map[uuid.UUID]string
+		err       error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*citiesDependencies) *sql.DB
+	}{
 		{
-			name: "returns cities name when there were no errors",
+			name: "returns an empty map without querying when there are no cars",
 			args: args{
-				ctx: context.TODO(),
+				ctx:    context.TODO(),
+				carIDs: nil,
+			},
+			wants: wants{
+				timeZones: map[uuid.UUID]string{},
+				err:       nil,
+			},
+			setMocks: func(d *citiesDependencies) *sql.DB {
+				return nil
+			},
+		},
+		{
+			name: "returns error when query context fails",
+			args: args{
+				ctx:    context.TODO(),
+				carIDs: []uuid.UUID{carA},
 			},
 			wants: wants{
-				names: ns,
-				err:   nil,
+				timeZones: nil,
+				err:       errors.New("query context error"),
 			},
 			setMocks: func(d *citiesDependencies) *sql.DB {
 				dbHandle, mock, err := sqlmock.New()
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"name"}).
-					AddRow(ns[0]).
-					AddRow(ns[1]).
-					AddRow(ns[2])
-				mock.ExpectQuery("SELECT name FROM cities ORDER BY name LIMIT 100").
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("query context error"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns the time zone of each car when there were no errors",
+			args: args{
+				ctx:    context.TODO(),
+				carIDs: []uuid.UUID{carA, carB},
+			},
+			wants: wants{
+				timeZones: map[uuid.UUID]string{
+					carA: "America/Chicago",
+					carB: "America/New_York",
+				},
+				err: nil,
+			},
+			setMocks: func(d *citiesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "time_zone"}).
+					AddRow(carA.String(), "America/Chicago").
+					AddRow(carB.String(), "America/New_York")
+				mock.ExpectQuery(query).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -378,12 +474,114 @@ func TestListNames(t *testing.T) {
 			dbHandle := test.setMocks(d)
 
 			citiesRepo := NewCitiesRepository(db)
-			names, err := citiesRepo.ListNames(test.args.ctx)
+			timeZones, err := citiesRepo.GetTimeZonesByCarIDs(test.args.ctx, test.args.carIDs)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.timeZones, timeZones)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestCitiesFullUpdate(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	dc := domain.City{
+		ID:       uuid.New(),
+		Name:     "Chicago",
+		TimeZone: "America/Chicago",
+		Country:  "US",
+		Currency: "EUR",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*citiesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the city was updated",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *citiesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec(`UPDATE cities SET name=\$1, time_zone=\$2, country=\$3, currency=\$4 WHERE id=\$5 AND \(currency=\$4 OR NOT EXISTS \(SELECT 1 FROM cars WHERE city_id=\$5\)\)`).
+					WithArgs(dc.Name, dc.TimeZone, dc.Country, dc.Currency, dc.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns currency in use error when the city has cars and its currency changes",
+			wants: wants{
+				err: errors.New(services.ErrCityCurrencyInUse),
+			},
+			setMocks: func(d *citiesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec(`UPDATE cities SET name=\$1, time_zone=\$2, country=\$3, currency=\$4 WHERE id=\$5`).
+					WithArgs(dc.Name, dc.TimeZone, dc.Country, dc.Currency, dc.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM cities WHERE id=\$1\)`).
+					WithArgs(dc.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(2)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns city not found error when the city does not exist",
+			wants: wants{
+				err: errors.New(services.ErrCityNotFound),
+			},
+			setMocks: func(d *citiesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec(`UPDATE cities SET name=\$1, time_zone=\$2, country=\$3, currency=\$4 WHERE id=\$5`).
+					WithArgs(dc.Name, dc.TimeZone, dc.Country, dc.Currency, dc.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM cities WHERE id=\$1\)`).
+					WithArgs(dc.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(2)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewCitiesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			citiesRepo := NewCitiesRepository(db)
+			err := citiesRepo.FullUpdate(context.TODO(), dc)
 
 			if dbHandle != nil {
 				dbHandle.Close()
 			}
-			assert.Equal(t, test.wants.names, names)
 			assert.Equal(t, test.wants.err, err)
 		})
 	}
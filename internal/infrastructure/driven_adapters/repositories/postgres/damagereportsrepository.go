@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type DamageReportsRepo struct {
+	ports.Database
+}
+
+func NewDamageReportsRepository(db ports.Database) *DamageReportsRepo {
+	return &DamageReportsRepo{
+		Database: db,
+	}
+}
+
+func (drr *DamageReportsRepo) Insert(ctx context.Context, ddr domain.DamageReport) (err error) {
+	report := models.LoadDamageReportFromDomain(ddr)
+
+	_, err = drr.GetDBHandle().ExecContext(ctx, "INSERT INTO damage_reports (id, car_id, reservation_id, reporter_id, description, status, resolution, created_at, resolved_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+		report.ID, report.CarID, report.ReservationID, report.ReporterID, report.Description, report.Status, report.Resolution, report.CreatedAt, report.ResolvedAt)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		if strings.Contains(pqErr.Message, "reporter_id") {
+			return errors.New(services.ErrUserNotFound)
+		} else if strings.Contains(pqErr.Message, "reservation_id") {
+			return errors.New(services.ErrReservationNotFound)
+		} else if strings.Contains(pqErr.Message, "car_id") {
+			return errors.New(services.ErrCarNotFound)
+		}
+	}
+
+	return err
+}
+
+// Gets a damage report along with its photos
+func (drr *DamageReportsRepo) Get(ctx context.Context, ID uuid.UUID) (ddr domain.DamageReport, err error) {
+	report, err := scanDamageReport(drr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM damage_reports WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.DamageReport{}, errors.New(services.ErrDamageReportNotFound)
+		}
+		return domain.DamageReport{}, err
+	}
+
+	photos, err := drr.getPhotos(ctx, []uuid.UUID{report.ID})
+	if err != nil {
+		return domain.DamageReport{}, err
+	}
+
+	return report.ToDomain(photos[report.ID]), nil
+}
+
+// Updates the status and resolution of a damage report. If report was not found returns an error.
+func (drr *DamageReportsRepo) UpdateStatus(ctx context.Context, ddr domain.DamageReport) error {
+	report := models.LoadDamageReportFromDomain(ddr)
+
+	result, err := drr.GetDBHandle().ExecContext(ctx, "UPDATE damage_reports SET status=$1, resolution=$2, resolved_at=$3 WHERE id=$4",
+		report.Status, report.Resolution, report.ResolvedAt, report.ID)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrDamageReportNotFound)
+	}
+
+	return nil
+}
+
+// Lists the damage reports of a car, newest first. An empty status lists all of them.
+func (drr *DamageReportsRepo) ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error) {
+	rows, err := drr.GetDBHandle().QueryContext(ctx, "SELECT * FROM damage_reports WHERE car_id=$1 AND ($2 = '' OR status::text = $2) ORDER BY created_at DESC", carID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []models.DamageReport
+	defer rows.Close()
+	for rows.Next() {
+		report, err := scanDamageReport(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	reportIDs := make([]uuid.UUID, 0, len(reports))
+	for _, report := range reports {
+		reportIDs = append(reportIDs, report.ID)
+	}
+	photos, err := drr.getPhotos(ctx, reportIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	damageReports := make([]domain.DamageReport, 0, len(reports))
+	for _, report := range reports {
+		damageReports = append(damageReports, report.ToDomain(photos[report.ID]))
+	}
+
+	return damageReports, nil
+}
+
+func (drr *DamageReportsRepo) InsertPhoto(ctx context.Context, ddp domain.DamagePhoto) (err error) {
+	photo := models.LoadDamagePhotoFromDomain(ddp)
+
+	_, err = drr.GetDBHandle().ExecContext(ctx, "INSERT INTO damage_photos (id, report_id, content_type, size, storage_key, thumbnail_key, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		photo.ID, photo.ReportID, photo.ContentType, photo.Size, photo.Key, photo.ThumbnailKey, photo.CreatedAt)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrDamageReportNotFound)
+	}
+
+	return err
+}
+
+func (drr *DamageReportsRepo) GetPhoto(ctx context.Context, reportID uuid.UUID, photoID uuid.UUID) (ddp domain.DamagePhoto, err error) {
+	photo, err := scanDamagePhoto(drr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM damage_photos WHERE id = $1 AND report_id = $2", photoID, reportID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.DamagePhoto{}, errors.New(services.ErrDamagePhotoNotFound)
+		}
+		return domain.DamagePhoto{}, err
+	}
+
+	return photo.ToDomain(), nil
+}
+
+// Gets the photos of the given reports grouped by report id
+func (drr *DamageReportsRepo) getPhotos(ctx context.Context, reportIDs []uuid.UUID) (map[uuid.UUID][]domain.DamagePhoto, error) {
+	photos := make(map[uuid.UUID][]domain.DamagePhoto, len(reportIDs))
+	if len(reportIDs) == 0 {
+		return photos, nil
+	}
+
+	ids := make(pq.StringArray, 0, len(reportIDs))
+	for _, reportID := range reportIDs {
+		ids = append(ids, reportID.String())
+	}
+
+	rows, err := drr.GetDBHandle().QueryContext(ctx, "SELECT * FROM damage_photos WHERE report_id = ANY($1::uuid[]) ORDER BY created_at ASC", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		photo, err := scanDamagePhoto(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		photos[photo.ReportID] = append(photos[photo.ReportID], photo.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return photos, nil
+}
+
+// Scans a row of the damage_reports table following the order of its columns
+func scanDamageReport(row scanner) (report models.DamageReport, err error) {
+	err = row.Scan(&report.ID, &report.CarID, &report.ReservationID, &report.ReporterID, &report.Description,
+		&report.Status, &report.Resolution, &report.CreatedAt, &report.ResolvedAt)
+
+	return report, err
+}
+
+// Scans a row of the damage_photos table following the order of its columns
+func scanDamagePhoto(row scanner) (photo models.DamagePhoto, err error) {
+	err = row.Scan(&photo.ID, &photo.ReportID, &photo.ContentType, &photo.Size, &photo.Key, &photo.ThumbnailKey, &photo.CreatedAt)
+
+	return photo, err
+}
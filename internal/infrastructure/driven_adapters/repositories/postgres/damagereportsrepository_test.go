@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var (
+	damageReportsColumns = []string{"id", "car_id", "reservation_id", "reporter_id", "description", "status", "resolution", "created_at", "resolved_at"}
+	damagePhotosColumns  = []string{"id", "report_id", "content_type", "size", "storage_key", "thumbnail_key", "created_at"}
+)
+
+type damageReportsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewDamageReportsDependencies(db *mocks.MockDatabase) *damageReportsDependencies {
+	return &damageReportsDependencies{
+		db: db,
+	}
+}
+
+func TestDamageReportsInsert(t *testing.T) {
+	ddr := domain.DamageReport{
+		ID:          uuid.New(),
+		CarID:       uuid.New(),
+		ReporterID:  uuid.New(),
+		Description: "Scratch on rear bumper",
+		Status:      "Open",
+		CreatedAt:   time.Now(),
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*damageReportsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when damage report was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *damageReportsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO damage_reports").
+					WithArgs(ddr.ID, ddr.CarID, uuid.NullUUID{}, ddr.ReporterID, ddr.Description, ddr.Status, ddr.Resolution, ddr.CreatedAt, sql.NullTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when reporter was not found",
+			wants: wants{
+				err: errors.New(services.ErrUserNotFound),
+			},
+			setMocks: func(d *damageReportsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO damage_reports").
+					WillReturnError(&pq.Error{Code: "23503", Message: "violates foreign key constraint \"damage_reports_reporter_id_fkey\""})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when car was not found",
+			wants: wants{
+				err: errors.New(services.ErrCarNotFound),
+			},
+			setMocks: func(d *damageReportsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO damage_reports").
+					WillReturnError(&pq.Error{Code: "23503", Message: "violates foreign key constraint \"damage_reports_car_id_fkey\""})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewDamageReportsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			damageReportsRepo := NewDamageReportsRepository(db)
+			err := damageReportsRepo.Insert(context.TODO(), ddr)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestDamageReportsGet(t *testing.T) {
+	createdAt := time.Now()
+	reservationID := uuid.New()
+	report := domain.DamageReport{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		ReservationID: &reservationID,
+		ReporterID:    uuid.New(),
+		Description:   "Scratch on rear bumper",
+		Status:        "Open",
+		CreatedAt:     createdAt,
+	}
+	photo := domain.DamagePhoto{
+		ID:           uuid.New(),
+		ReportID:     report.ID,
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Key:          "damage-reports/photo.jpeg",
+		ThumbnailKey: "damage-reports/photo-thumbnail.jpg",
+		CreatedAt:    createdAt,
+	}
+	reportWithPhotos := report
+	reportWithPhotos.Photos = []domain.DamagePhoto{photo}
+
+	type wants struct {
+		report domain.DamageReport
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*damageReportsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the damage report along with its photos",
+			wants: wants{
+				report: reportWithPhotos,
+				err:    nil,
+			},
+			setMocks: func(d *damageReportsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				reportRows := sqlmock.NewRows(damageReportsColumns).
+					AddRow(report.ID.String(), report.CarID.String(), reservationID.String(), report.ReporterID.String(), report.Description, report.Status, "", createdAt, nil)
+				mock.ExpectQuery("SELECT \\* FROM damage_reports").
+					WithArgs(report.ID).
+					WillReturnRows(reportRows)
+				photoRows := sqlmock.NewRows(damagePhotosColumns).
+					AddRow(photo.ID.String(), report.ID.String(), photo.ContentType, photo.Size, photo.Key, photo.ThumbnailKey, createdAt)
+				mock.ExpectQuery("SELECT \\* FROM damage_photos").
+					WillReturnRows(photoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(2)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when damage report was not found",
+			wants: wants{
+				report: domain.DamageReport{},
+				err:    errors.New(services.ErrDamageReportNotFound),
+			},
+			setMocks: func(d *damageReportsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM damage_reports").
+					WithArgs(report.ID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewDamageReportsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			damageReportsRepo := NewDamageReportsRepository(db)
+			ddr, err := damageReportsRepo.Get(context.TODO(), report.ID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.report, ddr)
+		})
+	}
+}
+
+func TestDamageReportsListByCarID(t *testing.T) {
+	carID := uuid.New()
+	createdAt := time.Now()
+	resolvedAt := createdAt.Add(time.Hour)
+	report := domain.DamageReport{
+		ID:          uuid.New(),
+		CarID:       carID,
+		ReporterID:  uuid.New(),
+		Description: "Broken side mirror",
+		Status:      "Resolved",
+		Resolution:  "Side mirror replaced",
+		CreatedAt:   createdAt,
+		ResolvedAt:  &resolvedAt,
+	}
+
+	mockCtlr := gomock.NewController(t)
+	db := mocks.NewMockDatabase(mockCtlr)
+	dbHandle, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbHandle.Close()
+
+	reportRows := sqlmock.NewRows(damageReportsColumns).
+		AddRow(report.ID.String(), carID.String(), nil, report.ReporterID.String(), report.Description, report.Status, report.Resolution, createdAt, resolvedAt)
+	mock.ExpectQuery("SELECT \\* FROM damage_reports WHERE car_id").
+		WithArgs(carID, "Resolved").
+		WillReturnRows(reportRows)
+	mock.ExpectQuery("SELECT \\* FROM damage_photos").
+		WillReturnRows(sqlmock.NewRows(damagePhotosColumns))
+	db.EXPECT().GetDBHandle().Return(dbHandle).Times(2)
+
+	damageReportsRepo := NewDamageReportsRepository(db)
+	reports, err := damageReportsRepo.ListByCarID(context.TODO(), carID, "Resolved")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.DamageReport{report}, reports)
+}
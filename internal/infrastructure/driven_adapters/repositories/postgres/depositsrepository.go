@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+)
+
+type DepositsRepo struct {
+	ports.Database
+}
+
+func NewDepositsRepository(db ports.Database) *DepositsRepo {
+	return &DepositsRepo{
+		Database: db,
+	}
+}
+
+func (dr *DepositsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (dd domain.Deposit, err error) {
+	deposit, err := scanDeposit(dr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM deposits WHERE reservation_id = $1", reservationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Deposit{}, errors.New(services.ErrDepositNotFound)
+		}
+		return domain.Deposit{}, err
+	}
+
+	return deposit.ToDomain(), nil
+}
+
+// Updates the capture and status of the deposit, and the deposit status of its
+// reservation. The deposit is only updated while it still has previousStatus,
+// so a deposit can not be both released and captured.
+func (dr *DepositsRepo) Update(ctx context.Context, dd domain.Deposit, previousStatus string, entries []domain.LedgerEntry) (err error) {
+	deposit := models.LoadDepositFromDomain(dd)
+
+	tx, err := dr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE deposits SET captured_amount=$1, capture_reason=$2, status=$3, settled_at=$4 WHERE id=$5 AND status=$6",
+		deposit.CapturedAmount, deposit.CaptureReason, deposit.Status, deposit.SettledAt, deposit.ID, previousStatus)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrDepositStatusChanged)
+	}
+
+	if _, err = tx.ExecContext(ctx, "UPDATE reservations SET deposit_status=$1 WHERE id=$2", deposit.Status, deposit.ReservationID); err != nil {
+		return err
+	}
+
+	if err = insertLedgerEntries(ctx, tx, entries); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Inserts the deposit held for a reservation and sets its status as the
+// deposit status of the reservation
+func insertDeposit(ctx context.Context, tx *sql.Tx, dd domain.Deposit) error {
+	deposit := models.LoadDepositFromDomain(dd)
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO deposits (id, reservation_id, reference, amount, currency, captured_amount, capture_reason, status, held_at, settled_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+		deposit.ID, deposit.ReservationID, deposit.Reference, deposit.Amount, deposit.Currency, deposit.CapturedAmount, deposit.CaptureReason, deposit.Status, deposit.HeldAt, deposit.SettledAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE reservations SET deposit_status=$1 WHERE id=$2", deposit.Status, deposit.ReservationID)
+
+	return err
+}
+
+// Scans a row of the deposits table following the order of its columns
+func scanDeposit(row scanner) (deposit models.Deposit, err error) {
+	err = row.Scan(&deposit.ID, &deposit.ReservationID, &deposit.Reference, &deposit.Amount, &deposit.Currency,
+		&deposit.CapturedAmount, &deposit.CaptureReason, &deposit.Status, &deposit.HeldAt, &deposit.SettledAt)
+
+	return deposit, err
+}
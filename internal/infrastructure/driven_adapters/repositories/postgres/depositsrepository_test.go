@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type depositsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewDepositsDependencies(db *mocks.MockDatabase) *depositsDependencies {
+	return &depositsDependencies{
+		db: db,
+	}
+}
+
+func TestDepositsGetByReservationID(t *testing.T) {
+	now := time.Now()
+	settledAt := now.Add(48 * time.Hour)
+	dd := domain.Deposit{
+		ID:             uuid.New(),
+		ReservationID:  uuid.New(),
+		Reference:      "fake_000001",
+		Amount:         domain.NewMoney(1500, "USD"),
+		CapturedAmount: domain.NewMoney(320, "USD"),
+		CaptureReason:  "Scratch on rear bumper",
+		Status:         "Captured",
+		HeldAt:         now,
+		SettledAt:      &settledAt,
+	}
+	columns := []string{"id", "reservation_id", "reference", "amount", "currency", "captured_amount", "capture_reason", "status", "held_at", "settled_at"}
+
+	type wants struct {
+		deposit domain.Deposit
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*depositsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the deposit of the reservation",
+			wants: wants{
+				deposit: dd,
+				err:     nil,
+			},
+			setMocks: func(d *depositsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM deposits WHERE reservation_id = \\$1").
+					WithArgs(dd.ReservationID).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(dd.ID.String(), dd.ReservationID.String(), dd.Reference, dd.Amount.Major(), dd.Amount.Currency, dd.CapturedAmount.Major(), dd.CaptureReason, dd.Status, dd.HeldAt, *dd.SettledAt))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when reservation has no deposit",
+			wants: wants{
+				deposit: domain.Deposit{},
+				err:     errors.New(services.ErrDepositNotFound),
+			},
+			setMocks: func(d *depositsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM deposits").
+					WithArgs(dd.ReservationID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewDepositsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			depositsRepo := NewDepositsRepository(db)
+			deposit, err := depositsRepo.GetByReservationID(context.TODO(), dd.ReservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.deposit, deposit)
+		})
+	}
+}
+
+func TestDepositsUpdate(t *testing.T) {
+	settledAt := time.Now()
+	dd := domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000002",
+		Amount:        domain.NewMoney(1000, "USD"),
+		Status:        "Released",
+		HeldAt:        settledAt.Add(-48 * time.Hour),
+		SettledAt:     &settledAt,
+	}
+	transactionID := uuid.New()
+	entries := []domain.LedgerEntry{
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: dd.ReservationID, Account: "Deposits", Movement: "Deposit Release", Amount: domain.NewMoney(1000, "USD"), CreatedAt: settledAt},
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: dd.ReservationID, Account: "Customer", Movement: "Deposit Release", Amount: domain.NewMoney(-1000, "USD"), CreatedAt: settledAt},
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*depositsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when deposit, reservation deposit status and ledger entries were stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *depositsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE deposits SET").
+					WithArgs(dd.CapturedAmount.Major(), dd.CaptureReason, dd.Status, sql.NullTime{Time: settledAt, Valid: true}, dd.ID, "Held").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE reservations SET deposit_status").
+					WithArgs(dd.Status, dd.ReservationID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				for _, entry := range entries {
+					mock.ExpectExec("INSERT INTO ledger_entries").
+						WithArgs(entry.ID, entry.TransactionID, entry.ReservationID, entry.Account, entry.Movement, entry.Amount.Major(), entry.Amount.Currency, entry.CreatedAt).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				}
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when deposit status changed",
+			wants: wants{
+				err: errors.New(services.ErrDepositStatusChanged),
+			},
+			setMocks: func(d *depositsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE deposits SET").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewDepositsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			depositsRepo := NewDepositsRepository(db)
+			err := depositsRepo.Update(context.TODO(), dd, "Held", entries)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+)
+
+type ExchangeRatesRepo struct {
+	ports.Database
+}
+
+func NewExchangeRatesRepository(db ports.Database) *ExchangeRatesRepo {
+	return &ExchangeRatesRepo{
+		Database: db,
+	}
+}
+
+// Inserts the rate of the currency pair or replaces it when it already exists
+func (xrr *ExchangeRatesRepo) Upsert(ctx context.Context, der domain.ExchangeRate) (err error) {
+	rate := models.LoadExchangeRateFromDomain(der)
+
+	_, err = xrr.GetDBHandle().ExecContext(ctx, "INSERT INTO exchange_rates (from_currency, to_currency, rate, updated_at) VALUES ($1, $2, $3, $4) ON CONFLICT (from_currency, to_currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at",
+		rate.From, rate.To, rate.Rate, rate.UpdatedAt)
+
+	return err
+}
+
+func (xrr *ExchangeRatesRepo) Get(ctx context.Context, from string, to string) (domain.ExchangeRate, error) {
+	rate, err := scanExchangeRate(xrr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM exchange_rates WHERE from_currency = $1 AND to_currency = $2", from, to))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ExchangeRate{}, errors.New(services.ErrExchangeRateNotFound)
+		}
+		return domain.ExchangeRate{}, err
+	}
+
+	return rate.ToDomain(), nil
+}
+
+// Lists the exchange rates ordered by currency pair
+func (xrr *ExchangeRatesRepo) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	var rates []domain.ExchangeRate
+
+	rows, err := xrr.GetDBHandle().QueryContext(ctx, "SELECT * FROM exchange_rates ORDER BY from_currency ASC, to_currency ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (xrr *ExchangeRatesRepo) Delete(ctx context.Context, from string, to string) error {
+	result, err := xrr.GetDBHandle().ExecContext(ctx, "DELETE FROM exchange_rates WHERE from_currency = $1 AND to_currency = $2", from, to)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrExchangeRateNotFound)
+	}
+
+	return nil
+}
+
+func scanExchangeRate(row scanner) (rate models.ExchangeRate, err error) {
+	err = row.Scan(&rate.From, &rate.To, &rate.Rate, &rate.UpdatedAt)
+
+	return rate, err
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type exchangeRatesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewExchangeRatesDependencies(db *mocks.MockDatabase) *exchangeRatesDependencies {
+	return &exchangeRatesDependencies{
+		db: db,
+	}
+}
+
+func TestExchangeRatesGet(t *testing.T) {
+	updatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	type wants struct {
+		rate domain.ExchangeRate
+		err  error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*exchangeRatesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the rate of the currency pair",
+			wants: wants{
+				rate: domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: updatedAt},
+				err:  nil,
+			},
+			setMocks: func(d *exchangeRatesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM exchange_rates").
+					WithArgs("USD", "EUR").
+					WillReturnRows(sqlmock.NewRows([]string{"from_currency", "to_currency", "rate", "updated_at"}).
+						AddRow("USD", "EUR", 0.9215, updatedAt))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the pair has no rate",
+			wants: wants{
+				rate: domain.ExchangeRate{},
+				err:  errors.New(services.ErrExchangeRateNotFound),
+			},
+			setMocks: func(d *exchangeRatesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM exchange_rates").
+					WithArgs("USD", "EUR").
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewExchangeRatesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			exchangeRatesRepo := NewExchangeRatesRepository(mockDB)
+			rate, err := exchangeRatesRepo.Get(context.TODO(), "USD", "EUR")
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.rate, rate)
+		})
+	}
+}
+
+func TestExchangeRatesDelete(t *testing.T) {
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*exchangeRatesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the rate was deleted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *exchangeRatesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM exchange_rates").
+					WithArgs("USD", "EUR").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the pair has no rate",
+			wants: wants{
+				err: errors.New(services.ErrExchangeRateNotFound),
+			},
+			setMocks: func(d *exchangeRatesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM exchange_rates").
+					WithArgs("USD", "EUR").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewExchangeRatesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			exchangeRatesRepo := NewExchangeRatesRepository(mockDB)
+			err := exchangeRatesRepo.Delete(context.TODO(), "USD", "EUR")
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
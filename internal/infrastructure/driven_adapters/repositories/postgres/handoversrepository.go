@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type HandoversRepo struct {
+	ports.Database
+}
+
+func NewHandoversRepository(db ports.Database) *HandoversRepo {
+	return &HandoversRepo{
+		Database: db,
+	}
+}
+
+// Begins a transaction recording a handover
+func (hr *HandoversRepo) Begin(ctx context.Context) (ports.HandoverTx, error) {
+	tx, err := hr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HandoverTx{tx: tx}, nil
+}
+
+type HandoverTx struct {
+	tx *sql.Tx
+}
+
+func (ht *HandoverTx) InsertInspection(ctx context.Context, di domain.Inspection) error {
+	inspection := models.LoadInspectionFromDomain(di)
+
+	_, err := ht.tx.ExecContext(ctx, "INSERT INTO inspections (id, reservation_id, type, mileage, fuel_level, damages, notes, inspected_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		inspection.ID, inspection.ReservationID, inspection.Type, inspection.Mileage, inspection.FuelLevel, inspection.Damages, inspection.Notes, inspection.InspectedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503":
+				return errors.New(services.ErrReservationNotFound)
+			case "23505":
+				return errors.New(services.ErrInspectionAlreadyRecorded)
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Moves the odometer of the car forward, it never goes back
+func (ht *HandoverTx) UpdateCarMileage(ctx context.Context, carID uuid.UUID, mileage int32) error {
+	_, err := ht.tx.ExecContext(ctx, "INSERT INTO car_mileages (car_id, mileage) VALUES ($1, $2) ON CONFLICT (car_id) DO UPDATE SET mileage = GREATEST(car_mileages.mileage, EXCLUDED.mileage)",
+		carID, mileage)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrCarNotFound)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (ht *HandoverTx) RelocateCar(ctx context.Context, carID uuid.UUID, branchID uuid.UUID) error {
+	result, err := ht.tx.ExecContext(ctx, "UPDATE cars SET branch_id = branches.id, city_id = branches.city_id FROM branches WHERE cars.id = $1 AND branches.id = $2",
+		carID, branchID)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrBranchNotFound)
+	}
+
+	return nil
+}
+
+func (ht *HandoverTx) UpdateReservationStatus(ctx context.Context, reservationID uuid.UUID, status string) error {
+	_, err := ht.tx.ExecContext(ctx, "UPDATE reservations SET status=$1 WHERE id=$2", status, reservationID)
+
+	return err
+}
+
+func (ht *HandoverTx) InsertLateReturn(ctx context.Context, dlr domain.LateReturn) error {
+	return insertLateReturn(ctx, ht.tx, dlr)
+}
+
+func (ht *HandoverTx) InsertDeposit(ctx context.Context, dd domain.Deposit) error {
+	return insertDeposit(ctx, ht.tx, dd)
+}
+
+func (ht *HandoverTx) InsertLedgerEntries(ctx context.Context, entries []domain.LedgerEntry) error {
+	return insertLedgerEntries(ctx, ht.tx, entries)
+}
+
+func (ht *HandoverTx) Commit() error {
+	return ht.tx.Commit()
+}
+
+func (ht *HandoverTx) Rollback() error {
+	if err := ht.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return err
+	}
+
+	return nil
+}
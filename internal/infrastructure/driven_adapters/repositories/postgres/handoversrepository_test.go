@@ -0,0 +1,291 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type handoversDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewHandoversDependencies(db *mocks.MockDatabase) *handoversDependencies {
+	return &handoversDependencies{
+		db: db,
+	}
+}
+
+func TestHandoversRecord(t *testing.T) {
+	carID := uuid.New()
+	di := domain.Inspection{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Type:          "Pickup",
+		Mileage:       10500,
+		FuelLevel:     100,
+		Damages:       []string{"Scratch on rear bumper"},
+		Notes:         "",
+		InspectedAt:   time.Now(),
+	}
+	damages := pq.StringArray(di.Damages)
+	returnBranchID := uuid.New()
+	deposit := domain.Deposit{
+		ID:            uuid.New(),
+		ReservationID: di.ReservationID,
+		Reference:     "pay_123",
+		Amount:        domain.NewMoney(1500, "USD"),
+		Status:        "Held",
+		HeldAt:        time.Now(),
+	}
+	lateReturn := domain.LateReturn{
+		ReservationID: di.ReservationID,
+		DueAt:         time.Now().Add(-3 * time.Hour),
+		ReturnedAt:    time.Now(),
+		MinutesLate:   180,
+		HoursCharged:  3,
+		HourlyFee:     domain.NewMoney(40, "USD"),
+		Fee:           domain.NewMoney(120, "USD"),
+	}
+	transactionID := uuid.New()
+	entries := []domain.LedgerEntry{
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: di.ReservationID, Account: "Customer", Movement: "Deposit Hold", Amount: domain.NewMoney(1500, "USD"), CreatedAt: deposit.HeldAt},
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: di.ReservationID, Account: "Deposits", Movement: "Deposit Hold", Amount: domain.NewMoney(-1500, "USD"), CreatedAt: deposit.HeldAt},
+	}
+
+	type args struct {
+		record func(ctx context.Context, tx ports.HandoverTx) error
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*handoversDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when inspection and car mileage were stored",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					if err := tx.InsertInspection(ctx, di); err != nil {
+						return err
+					}
+					return tx.UpdateCarMileage(ctx, carID, di.Mileage)
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO inspections").
+					WithArgs(di.ID, di.ReservationID, di.Type, di.Mileage, di.FuelLevel, damages, di.Notes, di.InspectedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO car_mileages").
+					WithArgs(carID, di.Mileage).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns nil error when car was moved to the return branch and the reservation was completed with a late return",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					if err := tx.RelocateCar(ctx, carID, returnBranchID); err != nil {
+						return err
+					}
+					if err := tx.UpdateReservationStatus(ctx, di.ReservationID, "Completed"); err != nil {
+						return err
+					}
+					return tx.InsertLateReturn(ctx, lateReturn)
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE cars SET branch_id").
+					WithArgs(carID, returnBranchID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE reservations SET status").
+					WithArgs("Completed", di.ReservationID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO late_returns").
+					WithArgs(lateReturn.ReservationID, lateReturn.DueAt, lateReturn.ReturnedAt, lateReturn.MinutesLate, lateReturn.HoursCharged, lateReturn.HourlyFee.Major(), lateReturn.Fee.Major(), lateReturn.Fee.Currency).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns nil error when the deposit held at pickup and its ledger entries were stored",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					if err := tx.InsertDeposit(ctx, deposit); err != nil {
+						return err
+					}
+					return tx.InsertLedgerEntries(ctx, entries)
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO deposits").
+					WithArgs(deposit.ID, deposit.ReservationID, deposit.Reference, deposit.Amount.Major(), deposit.Amount.Currency, deposit.CapturedAmount.Major(), deposit.CaptureReason, deposit.Status, deposit.HeldAt, sql.NullTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE reservations SET deposit_status").
+					WithArgs(deposit.Status, deposit.ReservationID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO ledger_entries").
+					WithArgs(entries[0].ID, transactionID, di.ReservationID, "Customer", "Deposit Hold", 1500.0, "USD", deposit.HeldAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO ledger_entries").
+					WithArgs(entries[1].ID, transactionID, di.ReservationID, "Deposits", "Deposit Hold", -1500.0, "USD", deposit.HeldAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when return branch was not found",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					return tx.RelocateCar(ctx, carID, returnBranchID)
+				},
+			},
+			wants: wants{
+				err: errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE cars SET branch_id").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when inspection type was already recorded for the reservation",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					return tx.InsertInspection(ctx, di)
+				},
+			},
+			wants: wants{
+				err: errors.New(services.ErrInspectionAlreadyRecorded),
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO inspections").
+					WillReturnError(&pq.Error{Code: "23505", Message: "unique_reservation_inspection_type"})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when car was not found",
+			args: args{
+				record: func(ctx context.Context, tx ports.HandoverTx) error {
+					return tx.UpdateCarMileage(ctx, carID, di.Mileage)
+				},
+			},
+			wants: wants{
+				err: errors.New(services.ErrCarNotFound),
+			},
+			setMocks: func(d *handoversDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO car_mileages").
+					WillReturnError(&pq.Error{Code: "23503", Message: ".* car_id .*"})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewHandoversDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			handoversRepo := NewHandoversRepository(db)
+			tx, err := handoversRepo.Begin(context.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = test.args.record(context.TODO(), tx)
+			if err == nil {
+				err = tx.Commit()
+			}
+			// rolling back a committed unit of work does nothing
+			rollbackErr := tx.Rollback()
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Nil(t, rollbackErr)
+		})
+	}
+}
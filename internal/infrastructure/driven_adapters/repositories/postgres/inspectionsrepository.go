@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+)
+
+type InspectionsRepo struct {
+	ports.Database
+}
+
+func NewInspectionsRepository(db ports.Database) *InspectionsRepo {
+	return &InspectionsRepo{
+		Database: db,
+	}
+}
+
+func (ir *InspectionsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (di []domain.Inspection, err error) {
+	var inspections []domain.Inspection
+
+	rows, err := ir.GetDBHandle().QueryContext(ctx, "SELECT * FROM inspections WHERE reservation_id=$1 ORDER BY inspected_at ASC", reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		inspection := models.Inspection{}
+		if err := rows.Scan(&inspection.ID, &inspection.ReservationID, &inspection.Type, &inspection.Mileage, &inspection.FuelLevel,
+			&inspection.Damages, &inspection.Notes, &inspection.InspectedAt); err != nil {
+			return nil, err
+		}
+
+		inspections = append(inspections, inspection.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return inspections, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type inspectionsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewInspectionsDependencies(db *mocks.MockDatabase) *inspectionsDependencies {
+	return &inspectionsDependencies{
+		db: db,
+	}
+}
+
+func TestCarMileagesGet(t *testing.T) {
+	carID := uuid.New()
+
+	type wants struct {
+		carMileage domain.CarMileage
+		err        error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*inspectionsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the mileage of the car",
+			wants: wants{
+				carMileage: domain.CarMileage{CarID: carID, Mileage: 15000, LastServiceMileage: 10000},
+				err:        nil,
+			},
+			setMocks: func(d *inspectionsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "mileage", "last_service_mileage"}).
+					AddRow(carID.String(), 15000, 10000)
+				mock.ExpectQuery("SELECT cars.id, COALESCE").
+					WithArgs(carID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when car was not found",
+			wants: wants{
+				carMileage: domain.CarMileage{},
+				err:        errors.New(services.ErrCarNotFound),
+			},
+			setMocks: func(d *inspectionsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT cars.id, COALESCE").
+					WithArgs(carID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewInspectionsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			carMileagesRepo := NewCarMileagesRepository(db)
+			carMileage, err := carMileagesRepo.Get(context.TODO(), carID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.carMileage, carMileage)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type InvoicesRepo struct {
+	ports.Database
+}
+
+func NewInvoicesRepository(db ports.Database) *InvoicesRepo {
+	return &InvoicesRepo{
+		Database: db,
+	}
+}
+
+// Takes the next number of the year of the invoice and inserts it along with
+// its lines and taxes in a single transaction. The sequence row stays locked
+// until the transaction ends, so concurrent invoices of a year are numbered
+// one after the other and a failed insert leaves no gap.
+func (ir *InvoicesRepo) Insert(ctx context.Context, di domain.Invoice) (domain.Invoice, error) {
+	tx, err := ir.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, "INSERT INTO invoice_sequences (year, last_number) VALUES ($1, 1) ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequences.last_number + 1 RETURNING last_number",
+		di.Year).Scan(&di.Sequence)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	di.Number = domain.InvoiceNumber(di.Year, di.Sequence)
+
+	invoice := models.LoadInvoiceFromDomain(di)
+	_, err = tx.ExecContext(ctx, "INSERT INTO invoices (id, year, sequence, reservation_id, billing_name, billing_email, company_name, tax_id, address, city, postal_code, country, subtotal, tax_total, total, currency, issued_at) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)",
+		invoice.ID, invoice.Year, invoice.Sequence, invoice.ReservationID, invoice.BillingName, invoice.BillingEmail, invoice.CompanyName, invoice.TaxID,
+		invoice.Address, invoice.City, invoice.PostalCode, invoice.Country, invoice.Subtotal, invoice.TaxTotal, invoice.Total, invoice.Currency, invoice.IssuedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503":
+				return domain.Invoice{}, errors.New(services.ErrReservationNotFound)
+			case "23505":
+				return domain.Invoice{}, errors.New(services.ErrInvoiceAlreadyIssued)
+			}
+		}
+
+		return domain.Invoice{}, err
+	}
+
+	for i, line := range di.Lines {
+		_, err = tx.ExecContext(ctx, "INSERT INTO invoice_lines (invoice_id, position, description, amount) VALUES ($1, $2, $3, $4)",
+			invoice.ID, i+1, line.Description, line.Amount.Major())
+		if err != nil {
+			return domain.Invoice{}, err
+		}
+	}
+
+	for i, tax := range di.Taxes {
+		_, err = tx.ExecContext(ctx, "INSERT INTO invoice_taxes (invoice_id, position, name, rate, amount) VALUES ($1, $2, $3, $4, $5)",
+			invoice.ID, i+1, tax.Name, tax.Rate, tax.Amount.Major())
+		if err != nil {
+			return domain.Invoice{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return di, nil
+}
+
+func (ir *InvoicesRepo) Get(ctx context.Context, ID uuid.UUID) (di domain.Invoice, err error) {
+	return ir.get(ctx, "SELECT * FROM invoices WHERE id = $1", ID)
+}
+
+func (ir *InvoicesRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (di domain.Invoice, err error) {
+	return ir.get(ctx, "SELECT * FROM invoices WHERE reservation_id = $1", reservationID)
+}
+
+// Gets the invoice found by the query along with its lines and taxes
+func (ir *InvoicesRepo) get(ctx context.Context, query string, arg interface{}) (domain.Invoice, error) {
+	invoice, err := scanInvoice(ir.GetDBHandle().QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Invoice{}, errors.New(services.ErrInvoiceNotFound)
+		}
+		return domain.Invoice{}, err
+	}
+
+	lines := []domain.InvoiceLine{}
+	rows, err := ir.GetDBHandle().QueryContext(ctx, "SELECT * FROM invoice_lines WHERE invoice_id = $1 ORDER BY position ASC", invoice.ID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var line models.InvoiceLine
+		if err := rows.Scan(&line.InvoiceID, &line.Position, &line.Description, &line.Amount); err != nil {
+			return domain.Invoice{}, err
+		}
+
+		lines = append(lines, line.ToDomain(invoice.Currency))
+	}
+	if err = rows.Err(); err != nil {
+		return domain.Invoice{}, err
+	}
+
+	taxes := []domain.InvoiceTax{}
+	rows, err = ir.GetDBHandle().QueryContext(ctx, "SELECT * FROM invoice_taxes WHERE invoice_id = $1 ORDER BY position ASC", invoice.ID)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tax models.InvoiceTax
+		if err := rows.Scan(&tax.InvoiceID, &tax.Position, &tax.Name, &tax.Rate, &tax.Amount); err != nil {
+			return domain.Invoice{}, err
+		}
+
+		taxes = append(taxes, tax.ToDomain(invoice.Currency))
+	}
+	if err = rows.Err(); err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return invoice.ToDomain(lines, taxes), nil
+}
+
+// Scans a row of the invoices table following the order of its columns
+func scanInvoice(row scanner) (invoice models.Invoice, err error) {
+	err = row.Scan(&invoice.ID, &invoice.Year, &invoice.Sequence, &invoice.ReservationID, &invoice.BillingName, &invoice.BillingEmail,
+		&invoice.CompanyName, &invoice.TaxID, &invoice.Address, &invoice.City, &invoice.PostalCode, &invoice.Country,
+		&invoice.Subtotal, &invoice.TaxTotal, &invoice.Total, &invoice.Currency, &invoice.IssuedAt)
+
+	return invoice, err
+}
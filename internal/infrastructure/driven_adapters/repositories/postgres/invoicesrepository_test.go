@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type invoicesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewInvoicesDependencies(db *mocks.MockDatabase) *invoicesDependencies {
+	return &invoicesDependencies{
+		db: db,
+	}
+}
+
+func TestInvoicesInsert(t *testing.T) {
+	issuedAt := time.Date(2027, 5, 22, 19, 0, 0, 0, time.UTC)
+	di := domain.Invoice{
+		ID:            uuid.New(),
+		Year:          2027,
+		ReservationID: uuid.New(),
+		Billing: domain.BillingInfo{
+			Name:        "Ana Torres",
+			Email:       "ana.torres@acme.com",
+			CompanyName: "Acme Logistics",
+			TaxID:       "900123456-7",
+			Address:     "Calle 93 #11-28",
+			City:        "Bogota",
+			PostalCode:  "110221",
+			Country:     "CO",
+		},
+		Lines: []domain.InvoiceLine{
+			{Description: "Car rental", Amount: domain.NewMoney(450, "USD")},
+			{Description: "Add-ons", Amount: domain.NewMoney(50, "USD")},
+		},
+		Taxes:    []domain.InvoiceTax{},
+		Subtotal: domain.NewMoney(500, "USD"),
+		Total:    domain.NewMoney(500, "USD"),
+		IssuedAt: issuedAt,
+	}
+
+	type wants struct {
+		number string
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*invoicesDependencies) *sql.DB
+	}{
+		{
+			name: "numbers the invoice with the next sequence of its year and stores its lines",
+			wants: wants{
+				number: "INV-2027-000042",
+				err:    nil,
+			},
+			setMocks: func(d *invoicesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO invoice_sequences").
+					WithArgs(2027).
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(42))
+				mock.ExpectExec("INSERT INTO invoices").
+					WithArgs(di.ID, 2027, 42, di.ReservationID, "Ana Torres", "ana.torres@acme.com", "Acme Logistics", "900123456-7",
+						"Calle 93 #11-28", "Bogota", "110221", "CO", 500.0, 0.0, 500.0, "USD", issuedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO invoice_lines").
+					WithArgs(di.ID, 1, "Car rental", 450.0).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO invoice_lines").
+					WithArgs(di.ID, 2, "Add-ons", 50.0).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back the sequence when the reservation was already invoiced",
+			wants: wants{
+				err: errors.New(services.ErrInvoiceAlreadyIssued),
+			},
+			setMocks: func(d *invoicesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO invoice_sequences").
+					WithArgs(2027).
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(43))
+				mock.ExpectExec("INSERT INTO invoices").
+					WillReturnError(&pq.Error{Code: "23505"})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewInvoicesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			invoicesRepo := NewInvoicesRepository(db)
+			invoice, err := invoicesRepo.Insert(context.TODO(), di)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.number, invoice.Number)
+		})
+	}
+}
+
+func TestInvoicesGetByReservationID(t *testing.T) {
+	issuedAt := time.Date(2027, 5, 22, 19, 0, 0, 0, time.UTC)
+	invoiceID := uuid.New()
+	reservationID := uuid.New()
+	invoiceColumns := []string{"id", "year", "sequence", "reservation_id", "billing_name", "billing_email", "company_name", "tax_id",
+		"address", "city", "postal_code", "country", "subtotal", "tax_total", "total", "currency", "issued_at"}
+
+	type wants struct {
+		invoice domain.Invoice
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*invoicesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the invoice of the reservation with its lines",
+			wants: wants{
+				invoice: domain.Invoice{
+					ID:            invoiceID,
+					Number:        "INV-2027-000007",
+					Year:          2027,
+					Sequence:      7,
+					ReservationID: reservationID,
+					Billing:       domain.BillingInfo{Name: "Ana Torres", Email: "ana@example.com"},
+					Lines:         []domain.InvoiceLine{{Description: "Car rental", Amount: domain.NewMoney(450, "USD")}},
+					Taxes:         []domain.InvoiceTax{},
+					Subtotal:      domain.NewMoney(450, "USD"),
+					TaxTotal:      domain.NewMoney(0, "USD"),
+					Total:         domain.NewMoney(450, "USD"),
+					IssuedAt:      issuedAt,
+				},
+				err: nil,
+			},
+			setMocks: func(d *invoicesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM invoices WHERE reservation_id = \\$1").
+					WithArgs(reservationID).
+					WillReturnRows(sqlmock.NewRows(invoiceColumns).
+						AddRow(invoiceID.String(), 2027, 7, reservationID.String(), "Ana Torres", "ana@example.com", "", "", "", "", "", "", 450, 0, 450, "USD", issuedAt))
+				mock.ExpectQuery("SELECT \\* FROM invoice_lines WHERE invoice_id = \\$1 ORDER BY position").
+					WithArgs(invoiceID).
+					WillReturnRows(sqlmock.NewRows([]string{"invoice_id", "position", "description", "amount"}).
+						AddRow(invoiceID.String(), 1, "Car rental", 450))
+				mock.ExpectQuery("SELECT \\* FROM invoice_taxes WHERE invoice_id = \\$1 ORDER BY position").
+					WithArgs(invoiceID).
+					WillReturnRows(sqlmock.NewRows([]string{"invoice_id", "position", "name", "rate", "amount"}))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the reservation has no invoice",
+			wants: wants{
+				invoice: domain.Invoice{},
+				err:     errors.New(services.ErrInvoiceNotFound),
+			},
+			setMocks: func(d *invoicesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM invoices").
+					WithArgs(reservationID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewInvoicesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			invoicesRepo := NewInvoicesRepository(db)
+			invoice, err := invoicesRepo.GetByReservationID(context.TODO(), reservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.invoice, invoice)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type LateReturnsRepo struct {
+	ports.Database
+}
+
+func NewLateReturnsRepository(db ports.Database) *LateReturnsRepo {
+	return &LateReturnsRepo{
+		Database: db,
+	}
+}
+
+func (lrr *LateReturnsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.LateReturn, error) {
+	lateReturn := models.LateReturn{}
+	err := lrr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM late_returns WHERE reservation_id = $1", reservationID).
+		Scan(&lateReturn.ReservationID, &lateReturn.DueAt, &lateReturn.ReturnedAt, &lateReturn.MinutesLate,
+			&lateReturn.HoursCharged, &lateReturn.HourlyFee, &lateReturn.Fee, &lateReturn.Currency)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.LateReturn{}, errors.New(services.ErrLateReturnNotFound)
+		}
+		return domain.LateReturn{}, err
+	}
+
+	return lateReturn.ToDomain(), nil
+}
+
+// Gets the notice sent for an overdue rental
+func (lrr *LateReturnsRepo) GetNotice(ctx context.Context, reservationID uuid.UUID) (domain.OverdueNotice, error) {
+	notice := models.OverdueNotice{}
+	err := lrr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM overdue_notices WHERE reservation_id = $1", reservationID).
+		Scan(&notice.ReservationID, &notice.NextReservationID, &notice.NotifiedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.OverdueNotice{}, errors.New(services.ErrOverdueNoticeNotFound)
+		}
+		return domain.OverdueNotice{}, err
+	}
+
+	return notice.ToDomain(), nil
+}
+
+// Records the notice sent for an overdue rental. Rentals already notified
+// return an error.
+func (lrr *LateReturnsRepo) InsertNotice(ctx context.Context, don domain.OverdueNotice) error {
+	notice := models.LoadOverdueNoticeFromDomain(don)
+
+	_, err := lrr.GetDBHandle().ExecContext(ctx, "INSERT INTO overdue_notices (reservation_id, next_reservation_id, notified_at) VALUES ($1, $2, $3)",
+		notice.ReservationID, notice.NextReservationID, notice.NotifiedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503":
+				return errors.New(services.ErrReservationNotFound)
+			case "23505":
+				return errors.New(services.ErrOverdueAlreadyNotified)
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func insertLateReturn(ctx context.Context, tx *sql.Tx, dlr domain.LateReturn) error {
+	lateReturn := models.LoadLateReturnFromDomain(dlr)
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO late_returns (reservation_id, due_at, returned_at, minutes_late, hours_charged, hourly_fee, fee, currency) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		lateReturn.ReservationID, lateReturn.DueAt, lateReturn.ReturnedAt, lateReturn.MinutesLate, lateReturn.HoursCharged, lateReturn.HourlyFee, lateReturn.Fee, lateReturn.Currency)
+
+	return err
+}
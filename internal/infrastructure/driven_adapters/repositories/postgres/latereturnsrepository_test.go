@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type lateReturnsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewLateReturnsDependencies(db *mocks.MockDatabase) *lateReturnsDependencies {
+	return &lateReturnsDependencies{
+		db: db,
+	}
+}
+
+func TestLateReturnsGetByReservationID(t *testing.T) {
+	dueAt := time.Date(2027, time.May, 22, 23, 0, 0, 0, time.UTC)
+	lateReturn := domain.LateReturn{
+		ReservationID: uuid.New(),
+		DueAt:         dueAt,
+		ReturnedAt:    dueAt.Add(130 * time.Minute),
+		MinutesLate:   130,
+		HoursCharged:  3,
+		HourlyFee:     domain.NewMoney(40, "USD"),
+		Fee:           domain.NewMoney(120, "USD"),
+	}
+
+	type wants struct {
+		lateReturn domain.LateReturn
+		err        error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the late return of the reservation",
+			wants: wants{
+				lateReturn: lateReturn,
+				err:        nil,
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM late_returns").
+					WithArgs(lateReturn.ReservationID).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "due_at", "returned_at", "minutes_late", "hours_charged", "hourly_fee", "fee", "currency"}).
+						AddRow(lateReturn.ReservationID.String(), lateReturn.DueAt, lateReturn.ReturnedAt, lateReturn.MinutesLate, lateReturn.HoursCharged, lateReturn.HourlyFee.Major(), lateReturn.Fee.Major(), lateReturn.Fee.Currency))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the car was not returned late",
+			wants: wants{
+				lateReturn: domain.LateReturn{},
+				err:        errors.New(services.ErrLateReturnNotFound),
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM late_returns").
+					WithArgs(lateReturn.ReservationID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewLateReturnsDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			lateReturnsRepo := NewLateReturnsRepository(mockDB)
+			got, err := lateReturnsRepo.GetByReservationID(context.TODO(), lateReturn.ReservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.lateReturn, got)
+		})
+	}
+}
+
+func TestLateReturnsGetNotice(t *testing.T) {
+	notice := domain.OverdueNotice{
+		ReservationID:     uuid.New(),
+		NextReservationID: uuid.New(),
+		NotifiedAt:        time.Date(2027, time.May, 23, 0, 15, 0, 0, time.UTC),
+	}
+
+	type wants struct {
+		notice domain.OverdueNotice
+		err    error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the notice sent for the overdue rental",
+			wants: wants{
+				notice: notice,
+				err:    nil,
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM overdue_notices").
+					WithArgs(notice.ReservationID).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "next_reservation_id", "notified_at"}).
+						AddRow(notice.ReservationID.String(), notice.NextReservationID.String(), notice.NotifiedAt))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the overdue rental was not notified",
+			wants: wants{
+				notice: domain.OverdueNotice{},
+				err:    errors.New(services.ErrOverdueNoticeNotFound),
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM overdue_notices").
+					WithArgs(notice.ReservationID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewLateReturnsDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			lateReturnsRepo := NewLateReturnsRepository(mockDB)
+			got, err := lateReturnsRepo.GetNotice(context.TODO(), notice.ReservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.notice, got)
+		})
+	}
+}
+
+func TestLateReturnsInsertNotice(t *testing.T) {
+	notice := domain.OverdueNotice{
+		ReservationID:     uuid.New(),
+		NextReservationID: uuid.New(),
+		NotifiedAt:        time.Date(2027, time.May, 23, 0, 15, 0, 0, time.UTC),
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the notice was stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO overdue_notices").
+					WithArgs(notice.ReservationID, notice.NextReservationID, notice.NotifiedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the overdue rental was already notified",
+			wants: wants{
+				err: errors.New(services.ErrOverdueAlreadyNotified),
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO overdue_notices").
+					WillReturnError(&pq.Error{Code: "23505"})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when a reservation was not found",
+			wants: wants{
+				err: errors.New(services.ErrReservationNotFound),
+			},
+			setMocks: func(d *lateReturnsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO overdue_notices").
+					WillReturnError(&pq.Error{Code: "23503"})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewLateReturnsDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			lateReturnsRepo := NewLateReturnsRepository(mockDB)
+			err := lateReturnsRepo.InsertNotice(context.TODO(), notice)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+)
+
+type LedgerRepo struct {
+	ports.Database
+}
+
+func NewLedgerRepository(db ports.Database) *LedgerRepo {
+	return &LedgerRepo{
+		Database: db,
+	}
+}
+
+func (lr *LedgerRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) ([]domain.LedgerEntry, error) {
+	var entries []domain.LedgerEntry
+
+	rows, err := lr.GetDBHandle().QueryContext(ctx, "SELECT * FROM ledger_entries WHERE reservation_id=$1 ORDER BY created_at ASC", reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		entry, err := scanLedgerEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Appends the entries to the ledger within the transaction of the money
+// movement they record. The database checks the entries of every ledger
+// transaction sum to zero when tx is committed.
+func insertLedgerEntries(ctx context.Context, tx *sql.Tx, entries []domain.LedgerEntry) error {
+	for _, dle := range entries {
+		entry := models.LoadLedgerEntryFromDomain(dle)
+
+		_, err := tx.ExecContext(ctx, "INSERT INTO ledger_entries (id, transaction_id, reservation_id, account, movement, amount, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+			entry.ID, entry.TransactionID, entry.ReservationID, entry.Account, entry.Movement, entry.Amount, entry.Currency, entry.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Scans a row of the ledger_entries table following the order of its columns
+func scanLedgerEntry(row scanner) (entry models.LedgerEntry, err error) {
+	err = row.Scan(&entry.ID, &entry.TransactionID, &entry.ReservationID, &entry.Account, &entry.Movement,
+		&entry.Amount, &entry.Currency, &entry.CreatedAt)
+
+	return entry, err
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type ledgerDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewLedgerDependencies(db *mocks.MockDatabase) *ledgerDependencies {
+	return &ledgerDependencies{
+		db: db,
+	}
+}
+
+func TestLedgerGetByReservationID(t *testing.T) {
+	now := time.Now()
+	reservationID := uuid.New()
+	transactionID := uuid.New()
+	entries := []domain.LedgerEntry{
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: reservationID, Account: "Customer", Movement: "Charge", Amount: domain.NewMoney(500, "USD"), CreatedAt: now},
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: reservationID, Account: "Revenue", Movement: "Charge", Amount: domain.NewMoney(-500, "USD"), CreatedAt: now},
+	}
+	columns := []string{"id", "transaction_id", "reservation_id", "account", "movement", "amount", "currency", "created_at"}
+
+	type wants struct {
+		entries []domain.LedgerEntry
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*ledgerDependencies) *sql.DB
+	}{
+		{
+			name: "returns the ledger entries of the reservation",
+			wants: wants{
+				entries: entries,
+				err:     nil,
+			},
+			setMocks: func(d *ledgerDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(columns)
+				for _, entry := range entries {
+					rows.AddRow(entry.ID.String(), entry.TransactionID.String(), entry.ReservationID.String(), entry.Account, entry.Movement, entry.Amount.Major(), entry.Amount.Currency, entry.CreatedAt)
+				}
+				mock.ExpectQuery("SELECT \\* FROM ledger_entries WHERE reservation_id=\\$1 ORDER BY created_at ASC").
+					WithArgs(reservationID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when ledger entries could not be read",
+			wants: wants{
+				entries: nil,
+				err:     errors.New("connection refused"),
+			},
+			setMocks: func(d *ledgerDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM ledger_entries").
+					WithArgs(reservationID).
+					WillReturnError(errors.New("connection refused"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewLedgerDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			ledgerRepo := NewLedgerRepository(db)
+			entries, err := ledgerRepo.GetByReservationID(context.TODO(), reservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.entries, entries)
+		})
+	}
+}
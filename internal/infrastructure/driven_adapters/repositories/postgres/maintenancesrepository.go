@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type MaintenancesRepo struct {
+	ports.Database
+	citiesRepository ports.CitiesRepo
+}
+
+func NewMaintenancesRepository(db ports.Database, cr ports.CitiesRepo) *MaintenancesRepo {
+	return &MaintenancesRepo{
+		Database:         db,
+		citiesRepository: cr,
+	}
+}
+
+func (mr *MaintenancesRepo) Insert(ctx context.Context, dm domain.Maintenance) (err error) {
+	maintenance := models.LoadMaintenanceFromDomain(dm)
+
+	_, err = mr.GetDBHandle().ExecContext(ctx, "INSERT INTO maintenances (id, car_id, start_date, end_date, reason) VALUES ($1, $2, $3, $4, $5)",
+		maintenance.ID, maintenance.CarID, maintenance.StartDate, maintenance.EndDate, maintenance.Reason)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrCarNotFound)
+	}
+
+	return err
+}
+
+func (mr *MaintenancesRepo) Get(ctx context.Context, ID uuid.UUID) (dm domain.Maintenance, err error) {
+	maintenance, err := scanMaintenance(mr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM maintenances WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Maintenance{}, errors.New(services.ErrMaintenanceNotFound)
+		}
+		return domain.Maintenance{}, err
+	}
+
+	return maintenance.ToDomain(), nil
+}
+
+// Updates maintenance row. If maintenance was not found returns an error.
+func (mr *MaintenancesRepo) FullUpdate(ctx context.Context, dm domain.Maintenance) (err error) {
+	maintenance := models.LoadMaintenanceFromDomain(dm)
+
+	result, err := mr.GetDBHandle().ExecContext(ctx, "UPDATE maintenances SET car_id=$1, start_date=$2, end_date=$3, reason=$4 WHERE id=$5",
+		maintenance.CarID, maintenance.StartDate, maintenance.EndDate, maintenance.Reason, maintenance.ID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrCarNotFound)
+		}
+
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrMaintenanceNotFound)
+	}
+
+	return nil
+}
+
+func (mr *MaintenancesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := mr.GetDBHandle().ExecContext(ctx, "DELETE FROM maintenances WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrMaintenanceNotFound)
+	}
+
+	return nil
+}
+
+// Lists the maintenances of the cars in a city that overlap the given time frame
+func (mr *MaintenancesRepo) List(ctx context.Context, cityName string, fromMaintenanceID string, startDate time.Time, endDate time.Time, limit uint16) ([]domain.Maintenance, error) {
+	cityID, err := mr.citiesRepository.GetIdByName(ctx, cityName)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT maintenances.* FROM maintenances JOIN cars ON cars.id = maintenances.car_id WHERE cars.city_id=$1 AND maintenances.start_date < $3 AND maintenances.end_date > $2 AND maintenances.id > $4 ORDER BY maintenances.id ASC LIMIT $5"
+	rows, err := mr.GetDBHandle().QueryContext(ctx, query, cityID, startDate, endDate, fromMaintenanceID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMaintenances(rows)
+}
+
+func (mr *MaintenancesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (dm []domain.Maintenance, err error) {
+	rows, err := mr.GetDBHandle().QueryContext(ctx, "SELECT * FROM maintenances WHERE car_id=$1 ORDER BY start_date ASC", carID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMaintenances(rows)
+}
+
+// Gets the maintenances of a car that overlap the given time frame
+func (mr *MaintenancesRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate time.Time, endDate time.Time) (dm []domain.Maintenance, err error) {
+	query := "SELECT * FROM maintenances WHERE car_id=$1 AND start_date < $3 AND end_date > $2"
+	rows, err := mr.GetDBHandle().QueryContext(ctx, query, carID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMaintenances(rows)
+}
+
+// Scans a row of the maintenances table following the order of its columns
+func scanMaintenance(row scanner) (maintenance models.Maintenance, err error) {
+	err = row.Scan(&maintenance.ID, &maintenance.CarID, &maintenance.StartDate, &maintenance.EndDate, &maintenance.Reason)
+
+	return maintenance, err
+}
+
+// Scans all the rows and closes them
+func scanMaintenances(rows *sql.Rows) ([]domain.Maintenance, error) {
+	var maintenances []domain.Maintenance
+
+	defer rows.Close()
+	for rows.Next() {
+		maintenance, err := scanMaintenance(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		maintenances = append(maintenances, maintenance.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return maintenances, nil
+}
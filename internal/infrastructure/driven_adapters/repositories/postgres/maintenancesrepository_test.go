@@ -0,0 +1,390 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var maintenancesColumns = []string{"id", "car_id", "start_date", "end_date", "reason"}
+
+type maintenancesDependencies struct {
+	db         *mocks.MockDatabase
+	citiesRepo *mocks.MockCitiesRepo
+}
+
+func NewMaintenancesDependencies(db *mocks.MockDatabase, citiesRepo *mocks.MockCitiesRepo) *maintenancesDependencies {
+	return &maintenancesDependencies{
+		db:         db,
+		citiesRepo: citiesRepo,
+	}
+}
+
+func TestMaintenancesInsert(t *testing.T) {
+	dm := domain.Maintenance{
+		ID:        uuid.New(),
+		CarID:     uuid.New(),
+		StartDate: time.Now(),
+		EndDate:   time.Now().AddDate(0, 0, 2),
+		Reason:    "Brakes replacement",
+	}
+
+	type args struct {
+		ctx         context.Context
+		maintenance domain.Maintenance
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when maintenance was inserted",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: dm,
+			},
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO maintenances").
+					WithArgs(dm.ID, dm.CarID, dm.StartDate, dm.EndDate, dm.Reason).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when car was not found",
+			args: args{
+				ctx:         context.TODO(),
+				maintenance: dm,
+			},
+			wants: wants{
+				err: errors.New(services.ErrCarNotFound),
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO maintenances").
+					WithArgs(dm.ID, dm.CarID, dm.StartDate, dm.EndDate, dm.Reason).
+					WillReturnError(&pq.Error{Code: "23503", Message: ".* car_id .*"})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewMaintenancesDependencies(db, citiesRepo)
+			dbHandle := test.setMocks(d)
+
+			maintenancesRepo := NewMaintenancesRepository(db, citiesRepo)
+			err := maintenancesRepo.Insert(test.args.ctx, test.args.maintenance)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestMaintenancesGet(t *testing.T) {
+	dm := domain.Maintenance{
+		ID:        uuid.New(),
+		CarID:     uuid.New(),
+		StartDate: time.Now(),
+		EndDate:   time.Now().AddDate(0, 0, 2),
+		Reason:    "Brakes replacement",
+	}
+
+	type args struct {
+		ctx context.Context
+		ID  uuid.UUID
+	}
+	type wants struct {
+		maintenance domain.Maintenance
+		err         error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the maintenance when it was found",
+			args: args{
+				ctx: context.TODO(),
+				ID:  dm.ID,
+			},
+			wants: wants{
+				maintenance: dm,
+				err:         nil,
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(maintenancesColumns).
+					AddRow(dm.ID.String(), dm.CarID.String(), dm.StartDate, dm.EndDate, dm.Reason)
+				mock.ExpectQuery(`^SELECT \* FROM maintenances WHERE id = \$1$`).
+					WithArgs(dm.ID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when maintenance was not found",
+			args: args{
+				ctx: context.TODO(),
+				ID:  dm.ID,
+			},
+			wants: wants{
+				maintenance: domain.Maintenance{},
+				err:         errors.New(services.ErrMaintenanceNotFound),
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM maintenances WHERE id = \$1$`).
+					WithArgs(dm.ID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewMaintenancesDependencies(db, citiesRepo)
+			dbHandle := test.setMocks(d)
+
+			maintenancesRepo := NewMaintenancesRepository(db, citiesRepo)
+			maintenance, err := maintenancesRepo.Get(test.args.ctx, test.args.ID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.maintenance, maintenance)
+		})
+	}
+}
+
+func TestMaintenancesDelete(t *testing.T) {
+	ID := uuid.New()
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*maintenancesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when maintenance was deleted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec(`^DELETE FROM maintenances WHERE id=\$1$`).
+					WithArgs(ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when maintenance was not found",
+			wants: wants{
+				err: errors.New(services.ErrMaintenanceNotFound),
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec(`^DELETE FROM maintenances WHERE id=\$1$`).
+					WithArgs(ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewMaintenancesDependencies(db, citiesRepo)
+			dbHandle := test.setMocks(d)
+
+			maintenancesRepo := NewMaintenancesRepository(db, citiesRepo)
+			err := maintenancesRepo.Delete(context.TODO(), ID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestMaintenancesList(t *testing.T) {
+	cityID := uuid.New()
+	startDate := time.Now()
+	endDate := startDate.AddDate(0, 0, 7)
+	fromMaintenanceID := "00000000-0000-0000-0000-000000000000"
+	dm := domain.Maintenance{
+		ID:        uuid.New(),
+		CarID:     uuid.New(),
+		StartDate: startDate.AddDate(0, 0, 1),
+		EndDate:   startDate.AddDate(0, 0, 2),
+		Reason:    "Brakes replacement",
+	}
+
+	type wants struct {
+		maintenances []domain.Maintenance
+		err          error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*maintenancesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the maintenances of the city",
+			wants: wants{
+				maintenances: []domain.Maintenance{dm},
+				err:          nil,
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(maintenancesColumns).
+					AddRow(dm.ID.String(), dm.CarID.String(), dm.StartDate, dm.EndDate, dm.Reason)
+				mock.ExpectQuery(`^SELECT maintenances.\* FROM maintenances JOIN cars ON cars.id = maintenances.car_id WHERE cars.city_id=\$1 AND maintenances.start_date < \$3 AND maintenances.end_date > \$2 AND maintenances.id > \$4 ORDER BY maintenances.id ASC LIMIT \$5$`).
+					WithArgs(cityID, startDate, endDate, fromMaintenanceID, 20).
+					WillReturnRows(rows)
+
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), "Seattle").Return(cityID, nil)
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when city was not found",
+			wants: wants{
+				maintenances: nil,
+				err:          errors.New(services.ErrInvalidCityName),
+			},
+			setMocks: func(d *maintenancesDependencies) *sql.DB {
+				d.citiesRepo.EXPECT().GetIdByName(gomock.Any(), "Seattle").Return(uuid.UUID{}, errors.New(services.ErrInvalidCityName))
+
+				return nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesRepo := mocks.NewMockCitiesRepo(mockCtlr)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewMaintenancesDependencies(db, citiesRepo)
+			dbHandle := test.setMocks(d)
+
+			maintenancesRepo := NewMaintenancesRepository(db, citiesRepo)
+			maintenances, err := maintenancesRepo.List(context.TODO(), "Seattle", fromMaintenanceID, startDate, endDate, 20)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.maintenances, maintenances)
+		})
+	}
+}
+
+func TestMaintenancesGetByCarIDAndTimeFrame(t *testing.T) {
+	carID := uuid.New()
+	startDate := time.Now()
+	endDate := startDate.Add(9 * time.Hour)
+
+	dbHandle, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbHandle.Close()
+	mock.ExpectQuery(`^SELECT \* FROM maintenances WHERE car_id=\$1 AND start_date < \$3 AND end_date > \$2$`).
+		WithArgs(carID, startDate, endDate).
+		WillReturnError(errors.New("query context error"))
+
+	mockCtlr := gomock.NewController(t)
+	db := mocks.NewMockDatabase(mockCtlr)
+	db.EXPECT().GetDBHandle().Return(dbHandle)
+
+	maintenancesRepo := NewMaintenancesRepository(db, mocks.NewMockCitiesRepo(mockCtlr))
+	maintenances, err := maintenancesRepo.GetByCarIDAndTimeFrame(context.TODO(), carID, startDate, endDate)
+
+	assert.Nil(t, maintenances)
+	assert.Equal(t, errors.New("query context error"), err)
+}
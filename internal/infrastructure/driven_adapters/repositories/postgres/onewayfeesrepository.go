@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type OneWayFeesRepo struct {
+	ports.Database
+}
+
+func NewOneWayFeesRepository(db ports.Database) *OneWayFeesRepo {
+	return &OneWayFeesRepo{
+		Database: db,
+	}
+}
+
+// Inserts the fee of the city pair or replaces it when it already exists
+func (owfr *OneWayFeesRepo) Upsert(ctx context.Context, dowf domain.OneWayFee) (err error) {
+	oneWayFee := models.LoadOneWayFeeFromDomain(dowf)
+
+	_, err = owfr.GetDBHandle().ExecContext(ctx, "INSERT INTO one_way_fees (from_city_id, to_city_id, fee, currency) VALUES ($1, $2, $3, $4) ON CONFLICT (from_city_id, to_city_id) DO UPDATE SET fee = EXCLUDED.fee, currency = EXCLUDED.currency",
+		oneWayFee.FromCityID, oneWayFee.ToCityID, oneWayFee.Fee, oneWayFee.Currency)
+
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrCityNotFound)
+	}
+
+	return err
+}
+
+func (owfr *OneWayFeesRepo) Get(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) (dowf domain.OneWayFee, err error) {
+	oneWayFee, err := scanOneWayFee(owfr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM one_way_fees WHERE from_city_id = $1 AND to_city_id = $2", fromCityID, toCityID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.OneWayFee{}, errors.New(services.ErrOneWayFeeNotFound)
+		}
+		return domain.OneWayFee{}, err
+	}
+
+	return oneWayFee.ToDomain(), nil
+}
+
+// Lists the fees of the routes starting at the given city
+func (owfr *OneWayFeesRepo) ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error) {
+	var oneWayFees []domain.OneWayFee
+
+	rows, err := owfr.GetDBHandle().QueryContext(ctx, "SELECT * FROM one_way_fees WHERE from_city_id = $1 ORDER BY to_city_id ASC", fromCityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		oneWayFee, err := scanOneWayFee(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		oneWayFees = append(oneWayFees, oneWayFee.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return oneWayFees, nil
+}
+
+func (owfr *OneWayFeesRepo) Delete(ctx context.Context, fromCityID uuid.UUID, toCityID uuid.UUID) error {
+	result, err := owfr.GetDBHandle().ExecContext(ctx, "DELETE FROM one_way_fees WHERE from_city_id = $1 AND to_city_id = $2", fromCityID, toCityID)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrOneWayFeeNotFound)
+	}
+
+	return nil
+}
+
+func scanOneWayFee(row scanner) (oneWayFee models.OneWayFee, err error) {
+	err = row.Scan(&oneWayFee.FromCityID, &oneWayFee.ToCityID, &oneWayFee.Fee, &oneWayFee.Currency)
+
+	return oneWayFee, err
+}
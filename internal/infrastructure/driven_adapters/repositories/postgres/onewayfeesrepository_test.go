@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type oneWayFeesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewOneWayFeesDependencies(db *mocks.MockDatabase) *oneWayFeesDependencies {
+	return &oneWayFeesDependencies{
+		db: db,
+	}
+}
+
+func TestOneWayFeesUpsert(t *testing.T) {
+	dowf := domain.OneWayFee{
+		FromCityID: uuid.New(),
+		ToCityID:   uuid.New(),
+		Fee:        150,
+		Currency:   "USD",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*oneWayFeesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the fee was stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *oneWayFeesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO one_way_fees .* ON CONFLICT").
+					WithArgs(dowf.FromCityID, dowf.ToCityID, dowf.Fee, dowf.Currency).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns city not found error when a city does not exist",
+			wants: wants{
+				err: errors.New(services.ErrCityNotFound),
+			},
+			setMocks: func(d *oneWayFeesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO one_way_fees").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "one_way_fees" violates foreign key constraint "one_way_fees_to_city_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewOneWayFeesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			oneWayFeesRepo := NewOneWayFeesRepository(mockDB)
+			err := oneWayFeesRepo.Upsert(context.TODO(), dowf)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestOneWayFeesGet(t *testing.T) {
+	fromCityID := uuid.New()
+	toCityID := uuid.New()
+
+	type wants struct {
+		oneWayFee domain.OneWayFee
+		err       error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*oneWayFeesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the fee of the route",
+			wants: wants{
+				oneWayFee: domain.OneWayFee{FromCityID: fromCityID, ToCityID: toCityID, Fee: 150, Currency: "USD"},
+				err:       nil,
+			},
+			setMocks: func(d *oneWayFeesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM one_way_fees").
+					WithArgs(fromCityID, toCityID).
+					WillReturnRows(sqlmock.NewRows([]string{"from_city_id", "to_city_id", "fee", "currency"}).
+						AddRow(fromCityID.String(), toCityID.String(), 150.0, "USD"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the route has no fee",
+			wants: wants{
+				oneWayFee: domain.OneWayFee{},
+				err:       errors.New(services.ErrOneWayFeeNotFound),
+			},
+			setMocks: func(d *oneWayFeesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM one_way_fees").
+					WithArgs(fromCityID, toCityID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			mockDB := mocks.NewMockDatabase(mockCtlr)
+			d := NewOneWayFeesDependencies(mockDB)
+			dbHandle := test.setMocks(d)
+
+			oneWayFeesRepo := NewOneWayFeesRepository(mockDB)
+			oneWayFee, err := oneWayFeesRepo.Get(context.TODO(), fromCityID, toCityID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.oneWayFee, oneWayFee)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type PaymentsRepo struct {
+	ports.Database
+}
+
+func NewPaymentsRepository(db ports.Database) *PaymentsRepo {
+	return &PaymentsRepo{
+		Database: db,
+	}
+}
+
+// Inserts the payment and sets its status as the payment status of the reservation
+func (pr *PaymentsRepo) Insert(ctx context.Context, dp domain.Payment) (err error) {
+	payment := models.LoadPaymentFromDomain(dp)
+
+	tx, err := pr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO payments (id, reservation_id, reference, amount, currency, captured_amount, refunded_amount, status, created_at, updated_at, tax_amount) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+		payment.ID, payment.ReservationID, payment.Reference, payment.Amount, payment.Currency, payment.CapturedAmount, payment.RefundedAmount, payment.Status, payment.CreatedAt, payment.UpdatedAt, payment.TaxAmount)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			if pqErr.Code == "23503" {
+				return errors.New(services.ErrReservationNotFound)
+			}
+			if pqErr.Code == "23505" && pqErr.Constraint == "payments_in_progress_idx" {
+				return errors.New(services.ErrPaymentInProgress)
+			}
+		}
+
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "UPDATE reservations SET payment_status=$1 WHERE id=$2", payment.Status, payment.ReservationID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (pr *PaymentsRepo) GetLatestByReservationID(ctx context.Context, reservationID uuid.UUID) (dp domain.Payment, err error) {
+	payment, err := scanPayment(pr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM payments WHERE reservation_id = $1 ORDER BY created_at DESC LIMIT 1", reservationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Payment{}, errors.New(services.ErrPaymentNotFound)
+		}
+		return domain.Payment{}, err
+	}
+
+	return payment.ToDomain(), nil
+}
+
+func (pr *PaymentsRepo) GetByReference(ctx context.Context, reference string) (dp domain.Payment, err error) {
+	payment, err := scanPayment(pr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM payments WHERE reference = $1", reference))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Payment{}, errors.New(services.ErrPaymentNotFound)
+		}
+		return domain.Payment{}, err
+	}
+
+	return payment.ToDomain(), nil
+}
+
+// Updates the amounts and status of the payment, and the payment status of its
+// reservation. The payment is only updated while it still has the status and
+// amounts of previous, so changes made at the same time, through the webhook
+// or another refund, are not overwritten.
+func (pr *PaymentsRepo) Update(ctx context.Context, dp domain.Payment, previous domain.Payment, entries []domain.LedgerEntry) (err error) {
+	payment := models.LoadPaymentFromDomain(dp)
+	previousPayment := models.LoadPaymentFromDomain(previous)
+
+	tx, err := pr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE payments SET captured_amount=$1, refunded_amount=$2, status=$3, updated_at=$4 WHERE id=$5 AND status=$6 AND captured_amount=$7 AND refunded_amount=$8",
+		payment.CapturedAmount, payment.RefundedAmount, payment.Status, payment.UpdatedAt, payment.ID, previousPayment.Status, previousPayment.CapturedAmount, previousPayment.RefundedAmount)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrPaymentStatusChanged)
+	}
+
+	if _, err = tx.ExecContext(ctx, "UPDATE reservations SET payment_status=$1 WHERE id=$2", payment.Status, payment.ReservationID); err != nil {
+		return err
+	}
+
+	if err = insertLedgerEntries(ctx, tx, entries); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Scans a row of the payments table following the order of its columns
+func scanPayment(row scanner) (payment models.Payment, err error) {
+	err = row.Scan(&payment.ID, &payment.ReservationID, &payment.Reference, &payment.Amount, &payment.Currency,
+		&payment.CapturedAmount, &payment.RefundedAmount, &payment.Status, &payment.CreatedAt, &payment.UpdatedAt, &payment.TaxAmount)
+
+	return payment, err
+}
@@ -0,0 +1,317 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type paymentsDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewPaymentsDependencies(db *mocks.MockDatabase) *paymentsDependencies {
+	return &paymentsDependencies{
+		db: db,
+	}
+}
+
+func TestPaymentsInsert(t *testing.T) {
+	now := time.Now()
+	dp := domain.Payment{
+		ID:            uuid.New(),
+		ReservationID: uuid.New(),
+		Reference:     "fake_000001",
+		Amount:        domain.NewMoney(500, "USD"),
+		TaxAmount:     domain.NewMoney(40.45, "USD"),
+		Status:        "Authorized",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when payment and reservation payment status were stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO payments").
+					WithArgs(dp.ID, dp.ReservationID, dp.Reference, dp.Amount.Major(), dp.Amount.Currency, dp.CapturedAmount.Major(), dp.RefundedAmount.Major(), dp.Status, dp.CreatedAt, dp.UpdatedAt, dp.TaxAmount.Major()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE reservations SET payment_status").
+					WithArgs(dp.Status, dp.ReservationID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when reservation already has a payment in progress",
+			wants: wants{
+				err: errors.New(services.ErrPaymentInProgress),
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO payments").
+					WillReturnError(&pq.Error{Code: "23505", Constraint: "payments_in_progress_idx"})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when reservation was not found",
+			wants: wants{
+				err: errors.New(services.ErrReservationNotFound),
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO payments").
+					WillReturnError(&pq.Error{Code: "23503", Message: ".* reservation_id .*"})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewPaymentsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			paymentsRepo := NewPaymentsRepository(db)
+			err := paymentsRepo.Insert(context.TODO(), dp)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestPaymentsGetLatestByReservationID(t *testing.T) {
+	now := time.Now()
+	dp := domain.Payment{
+		ID:             uuid.New(),
+		ReservationID:  uuid.New(),
+		Reference:      "fake_000002",
+		Amount:         domain.NewMoney(500, "USD"),
+		TaxAmount:      domain.NewMoney(40.45, "USD"),
+		CapturedAmount: domain.NewMoney(500, "USD"),
+		RefundedAmount: domain.NewMoney(0, "USD"),
+		Status:         "Paid",
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	columns := []string{"id", "reservation_id", "reference", "amount", "currency", "captured_amount", "refunded_amount", "status", "created_at", "updated_at", "tax_amount"}
+
+	type wants struct {
+		payment domain.Payment
+		err     error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the latest payment of the reservation",
+			wants: wants{
+				payment: dp,
+				err:     nil,
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM payments WHERE reservation_id = \\$1 ORDER BY created_at DESC LIMIT 1").
+					WithArgs(dp.ReservationID).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(dp.ID.String(), dp.ReservationID.String(), dp.Reference, dp.Amount.Major(), dp.Amount.Currency, dp.CapturedAmount.Major(), dp.RefundedAmount.Major(), dp.Status, dp.CreatedAt, dp.UpdatedAt, dp.TaxAmount.Major()))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when reservation has no payments",
+			wants: wants{
+				payment: domain.Payment{},
+				err:     errors.New(services.ErrPaymentNotFound),
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT \\* FROM payments").
+					WithArgs(dp.ReservationID).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewPaymentsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			paymentsRepo := NewPaymentsRepository(db)
+			payment, err := paymentsRepo.GetLatestByReservationID(context.TODO(), dp.ReservationID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.payment, payment)
+		})
+	}
+}
+
+func TestPaymentsUpdate(t *testing.T) {
+	dp := domain.Payment{
+		ID:             uuid.New(),
+		ReservationID:  uuid.New(),
+		Reference:      "fake_000003",
+		Amount:         domain.NewMoney(500, "USD"),
+		CapturedAmount: domain.NewMoney(500, "USD"),
+		Status:         "Paid",
+		UpdatedAt:      time.Now(),
+	}
+	previous := dp
+	previous.Status = "Capturing"
+	previous.CapturedAmount = domain.Money{Currency: "USD"}
+	transactionID := uuid.New()
+	entries := []domain.LedgerEntry{
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: dp.ReservationID, Account: "Customer", Movement: "Charge", Amount: domain.NewMoney(500, "USD"), CreatedAt: dp.UpdatedAt},
+		{ID: uuid.New(), TransactionID: transactionID, ReservationID: dp.ReservationID, Account: "Revenue", Movement: "Charge", Amount: domain.NewMoney(-500, "USD"), CreatedAt: dp.UpdatedAt},
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when payment, reservation payment status and ledger entries were stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE payments SET").
+					WithArgs(dp.CapturedAmount.Major(), dp.RefundedAmount.Major(), dp.Status, dp.UpdatedAt, dp.ID, previous.Status, previous.CapturedAmount.Major(), previous.RefundedAmount.Major()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE reservations SET payment_status").
+					WithArgs(dp.Status, dp.ReservationID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				for _, entry := range entries {
+					mock.ExpectExec("INSERT INTO ledger_entries").
+						WithArgs(entry.ID, entry.TransactionID, entry.ReservationID, entry.Account, entry.Movement, entry.Amount.Major(), entry.Amount.Currency, entry.CreatedAt).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				}
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when payment status or amounts changed",
+			wants: wants{
+				err: errors.New(services.ErrPaymentStatusChanged),
+			},
+			setMocks: func(d *paymentsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE payments SET").
+					WithArgs(dp.CapturedAmount.Major(), dp.RefundedAmount.Major(), dp.Status, dp.UpdatedAt, dp.ID, previous.Status, previous.CapturedAmount.Major(), previous.RefundedAmount.Major()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewPaymentsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			paymentsRepo := NewPaymentsRepository(db)
+			err := paymentsRepo.Update(context.TODO(), dp, previous, entries)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
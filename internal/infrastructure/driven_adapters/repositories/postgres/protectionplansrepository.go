@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ProtectionPlansRepo struct {
+	ports.Database
+}
+
+func NewProtectionPlansRepository(db ports.Database) *ProtectionPlansRepo {
+	return &ProtectionPlansRepo{
+		Database: db,
+	}
+}
+
+func (ppr *ProtectionPlansRepo) Insert(ctx context.Context, dpp domain.ProtectionPlan) error {
+	plan := models.LoadProtectionPlanFromDomain(dpp)
+
+	_, err := ppr.GetDBHandle().ExecContext(ctx, "INSERT INTO protection_plans (id, level, car_type, daily_price, deductible, currency) VALUES ($1, $2, $3, $4, $5, $6)",
+		plan.ID, plan.Level, plan.CarType, plan.DailyPrice, plan.Deductible, plan.Currency)
+
+	return mapProtectionPlanViolation(err)
+}
+
+func (ppr *ProtectionPlansRepo) Get(ctx context.Context, ID uuid.UUID) (domain.ProtectionPlan, error) {
+	plan, err := scanProtectionPlan(ppr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM protection_plans WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ProtectionPlan{}, errors.New(services.ErrProtectionPlanNotFound)
+		}
+		return domain.ProtectionPlan{}, err
+	}
+
+	return plan.ToDomain(), nil
+}
+
+// Gets the plan of a protection level for a car type
+func (ppr *ProtectionPlansRepo) GetByLevelAndCarType(ctx context.Context, level string, carType string) (domain.ProtectionPlan, error) {
+	plan, err := scanProtectionPlan(ppr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM protection_plans WHERE level = $1 AND car_type = $2", level, carType))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ProtectionPlan{}, errors.New(services.ErrProtectionPlanNotOffered)
+		}
+		return domain.ProtectionPlan{}, err
+	}
+
+	return plan.ToDomain(), nil
+}
+
+func (ppr *ProtectionPlansRepo) FullUpdate(ctx context.Context, dpp domain.ProtectionPlan) error {
+	plan := models.LoadProtectionPlanFromDomain(dpp)
+
+	result, err := ppr.GetDBHandle().ExecContext(ctx, "UPDATE protection_plans SET level=$1, car_type=$2, daily_price=$3, deductible=$4, currency=$5 WHERE id=$6",
+		plan.Level, plan.CarType, plan.DailyPrice, plan.Deductible, plan.Currency, plan.ID)
+	if err != nil {
+		return mapProtectionPlanViolation(err)
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrProtectionPlanNotFound)
+	}
+
+	return nil
+}
+
+// Deletes a plan. Reservations booked with it keep a copy of its terms.
+func (ppr *ProtectionPlansRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := ppr.GetDBHandle().ExecContext(ctx, "DELETE FROM protection_plans WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrProtectionPlanNotFound)
+	}
+
+	return nil
+}
+
+// Lists the plans ordered by car type and protection level. Only the plans of
+// the given car type are listed when it is not empty.
+func (ppr *ProtectionPlansRepo) List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error) {
+	var plans []domain.ProtectionPlan
+
+	query := "SELECT * FROM protection_plans"
+	args := []interface{}{}
+	if carType != "" {
+		query += " WHERE car_type = $1"
+		args = append(args, carType)
+	}
+	query += " ORDER BY car_type ASC, level ASC"
+
+	rows, err := ppr.GetDBHandle().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		plan, err := scanProtectionPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, plan.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// Scans a row of the protection_plans table following the order of its columns
+func scanProtectionPlan(row scanner) (plan models.ProtectionPlan, err error) {
+	err = row.Scan(&plan.ID, &plan.Level, &plan.CarType, &plan.DailyPrice, &plan.Deductible, &plan.Currency)
+
+	return plan, err
+}
+
+func mapProtectionPlanViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return errors.New(services.ErrProtectionPlanExists)
+	}
+
+	return err
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type protectionPlansDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewProtectionPlansDependencies(db *mocks.MockDatabase) *protectionPlansDependencies {
+	return &protectionPlansDependencies{
+		db: db,
+	}
+}
+
+func TestProtectionPlansInsert(t *testing.T) {
+	dpp := domain.ProtectionPlan{
+		ID:         uuid.New(),
+		Level:      "Standard",
+		CarType:    "Sedan",
+		DailyPrice: 15,
+		Deductible: 500,
+		Currency:   "USD",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*protectionPlansDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the protection plan was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *protectionPlansDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO protection_plans").
+					WithArgs(dpp.ID, dpp.Level, dpp.CarType, dpp.DailyPrice, dpp.Deductible, dpp.Currency).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the car type already has a plan of the same level",
+			wants: wants{
+				err: errors.New(services.ErrProtectionPlanExists),
+			},
+			setMocks: func(d *protectionPlansDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO protection_plans").
+					WithArgs(dpp.ID, dpp.Level, dpp.CarType, dpp.DailyPrice, dpp.Deductible, dpp.Currency).
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "protection_plans_car_type_level_key"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewProtectionPlansDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			protectionPlansRepo := NewProtectionPlansRepository(db)
+			err := protectionPlansRepo.Insert(context.TODO(), dpp)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestProtectionPlansGetByLevelAndCarType(t *testing.T) {
+	dpp := domain.ProtectionPlan{
+		ID:         uuid.New(),
+		Level:      "Full",
+		CarType:    "Luxury",
+		DailyPrice: 29.5,
+		Deductible: 0,
+		Currency:   "EUR",
+	}
+
+	type wants struct {
+		plan domain.ProtectionPlan
+		err  error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*protectionPlansDependencies) *sql.DB
+	}{
+		{
+			name: "returns the plan of the level for the car type",
+			wants: wants{
+				plan: dpp,
+				err:  nil,
+			},
+			setMocks: func(d *protectionPlansDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "level", "car_type", "daily_price", "deductible", "currency"}).
+					AddRow(dpp.ID.String(), dpp.Level, dpp.CarType, dpp.DailyPrice, dpp.Deductible, dpp.Currency)
+				mock.ExpectQuery("SELECT (.+) FROM protection_plans WHERE level = (.+) AND car_type = (.+)").
+					WithArgs(dpp.Level, dpp.CarType).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the level is not offered for the car type",
+			wants: wants{
+				plan: domain.ProtectionPlan{},
+				err:  errors.New(services.ErrProtectionPlanNotOffered),
+			},
+			setMocks: func(d *protectionPlansDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery("SELECT (.+) FROM protection_plans WHERE level = (.+) AND car_type = (.+)").
+					WithArgs(dpp.Level, dpp.CarType).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewProtectionPlansDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			protectionPlansRepo := NewProtectionPlansRepository(db)
+			plan, err := protectionPlansRepo.GetByLevelAndCarType(context.TODO(), dpp.Level, dpp.CarType)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.plan, plan)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
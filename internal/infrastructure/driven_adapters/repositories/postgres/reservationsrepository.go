@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
 	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
@@ -25,53 +28,85 @@ func NewReservationsRepository(db ports.Database) *ReservationsRepo {
 	}
 }
 
-func (rr ReservationsRepo) Insert(ctx context.Context, dc domain.Reservation) (err error) {
-	reservation := models.LoadReservationFromDomain(dc)
+// Inserts a reservation along with its add-ons and taxes. It is rejected when
+// another reservation of the car that was not canceled overlaps it.
+func (rr ReservationsRepo) Insert(ctx context.Context, dr domain.Reservation) (err error) {
+	reservation := models.LoadReservationFromDomain(dr)
 
-	_, err = rr.GetDBHandle().ExecContext(ctx, "INSERT INTO reservations (id, user_id, car_id, status, payment_status, start_date, end_date) VALUES ($1, $2, $3, $4, $5, $6, $7)",
-		reservation.ID, reservation.UserID, reservation.CarID, reservation.Status, reservation.PaymentStatus, reservation.StartDate, reservation.EndDate)
+	tx, err := rr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
-		if strings.Contains(pqErr.Message, "user_id") {
-			return errors.New(services.ErrUserNotFound)
-		} else if strings.Contains(pqErr.Message, "car_id") {
-			return errors.New(services.ErrCarNotFound)
-		}
+	if err = lockCarPeriod(ctx, tx, dr, dr.StartDate, dr.EndDate); err != nil {
+		return err
 	}
 
-	return err
+	_, err = tx.ExecContext(ctx, "INSERT INTO reservations (id, user_id, car_id, status, payment_status, start_date, end_date, pickup_branch_id, return_branch_id, one_way_fee, additional_driver_ids, rental_cost, additional_drivers_fee, add_ons_cost, protection_level, protection_daily_price, protection_deductible, protection_cost, deposit_status, tax_total, currency) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)",
+		reservation.ID, reservation.UserID, reservation.CarID, reservation.Status, reservation.PaymentStatus, reservation.StartDate, reservation.EndDate,
+		reservation.PickupBranchID, reservation.ReturnBranchID, reservation.OneWayFee, pq.Array(reservation.AdditionalDriverIDs), reservation.RentalCost, reservation.AdditionalDriversFee,
+		reservation.AddOnsCost, reservation.ProtectionLevel, reservation.ProtectionDailyPrice, reservation.ProtectionDeductible, reservation.ProtectionCost, reservation.DepositStatus, reservation.TaxTotal, reservation.Currency)
+	if err != nil {
+		return mapReservationForeignKeyViolation(err)
+	}
+
+	if err = lockAddOnsStock(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	if err = insertReservationAddOns(ctx, tx, dr); err != nil {
+		return mapReservationForeignKeyViolation(err)
+	}
+
+	if err = insertReservationTaxes(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
+// Gets a reservation along with its add-ons and taxes
 func (rr ReservationsRepo) Get(ctx context.Context, ID uuid.UUID) (dc domain.Reservation, err error) {
-	var reservation models.Reservation
-	if err := rr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM reservations WHERE ID = $1", ID).
-		Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus, &reservation.StartDate, &reservation.EndDate); err != nil {
+	reservation, err := scanReservation(rr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM reservations WHERE ID = $1", ID))
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Reservation{}, errors.New(services.ErrReservationNotFound)
 		}
 		return domain.Reservation{}, err
 	}
 
-	return reservation.ToDomain(), nil
+	reservations, err := rr.withDetails(ctx, []domain.Reservation{reservation.ToDomain()})
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+
+	return reservations[0], nil
 }
 
+// Updates a reservation row and replaces its add-ons and taxes. The payment status is left as it is, as only payments change it. If reservation was not found
+// or another reservation of the car that was not canceled overlaps it returns an error.
 func (rr ReservationsRepo) FullUpdate(ctx context.Context, dr domain.Reservation) (err error) {
 	reservation := models.LoadReservationFromDomain(dr)
 
-	result, err := rr.GetDBHandle().ExecContext(ctx, "UPDATE reservations SET user_id=$1, car_id=$2, status=$3, payment_status=$4, start_date=$5, end_date=$6 WHERE id=$7",
-		reservation.UserID, reservation.CarID, reservation.Status, reservation.PaymentStatus, reservation.StartDate, reservation.EndDate, reservation.ID)
+	tx, err := rr.GetDBHandle().BeginTx(ctx, nil)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
-			if strings.Contains(pqErr.Message, "user_id") {
-				return errors.New(services.ErrUserNotFound)
-			} else if strings.Contains(pqErr.Message, "car_id") {
-				return errors.New(services.ErrCarNotFound)
-			}
-		}
+		return err
+	}
+	defer tx.Rollback()
 
+	if err = lockCarPeriod(ctx, tx, dr, dr.StartDate, dr.EndDate); err != nil {
 		return err
 	}
 
+	result, err := tx.ExecContext(ctx, "UPDATE reservations SET user_id=$1, car_id=$2, status=$3, start_date=$4, end_date=$5, pickup_branch_id=$6, return_branch_id=$7, one_way_fee=$8, additional_driver_ids=$9, rental_cost=$10, additional_drivers_fee=$11, add_ons_cost=$12, protection_level=$13, protection_daily_price=$14, protection_deductible=$15, protection_cost=$16, tax_total=$17, currency=$18 WHERE id=$19",
+		reservation.UserID, reservation.CarID, reservation.Status, reservation.StartDate, reservation.EndDate,
+		reservation.PickupBranchID, reservation.ReturnBranchID, reservation.OneWayFee, pq.Array(reservation.AdditionalDriverIDs), reservation.RentalCost, reservation.AdditionalDriversFee,
+		reservation.AddOnsCost, reservation.ProtectionLevel, reservation.ProtectionDailyPrice, reservation.ProtectionDeductible, reservation.ProtectionCost, reservation.TaxTotal, reservation.Currency, reservation.ID)
+	if err != nil {
+		return mapReservationForeignKeyViolation(err)
+	}
+
 	numUpdatedRows, err := result.RowsAffected()
 	if err != nil {
 		return err
@@ -81,12 +116,37 @@ func (rr ReservationsRepo) FullUpdate(ctx context.Context, dr domain.Reservation
 		return errors.New(services.ErrReservationNotFound)
 	}
 
-	return nil
+	if _, err = tx.ExecContext(ctx, "DELETE FROM reservation_add_ons WHERE reservation_id=$1", reservation.ID); err != nil {
+		return err
+	}
+
+	if err = lockAddOnsStock(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	if err = insertReservationAddOns(ctx, tx, dr); err != nil {
+		return mapReservationForeignKeyViolation(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM reservation_taxes WHERE reservation_id=$1", reservation.ID); err != nil {
+		return err
+	}
+
+	if err = insertReservationTaxes(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (rr ReservationsRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	result, err := rr.GetDBHandle().ExecContext(ctx, "DELETE FROM reservations WHERE id=$1", id)
 	if err != nil {
+		// issued invoices keep their reservation
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrReservationInvoiced)
+		}
+
 		return err
 	}
 
@@ -112,8 +172,8 @@ func (rr ReservationsRepo) List(ctx context.Context, fromReservationID string, s
 	}
 	defer rows.Close()
 	for rows.Next() {
-		reservation := models.Reservation{}
-		if err := rows.Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus, &reservation.StartDate, &reservation.EndDate); err != nil {
+		reservation, err := scanReservation(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -124,20 +184,21 @@ func (rr ReservationsRepo) List(ctx context.Context, fromReservationID string, s
 		return nil, err
 	}
 
-	return reservations, nil
+	return rr.withDetails(ctx, reservations)
 }
 
+// Gets the reservations where the user is either the renter or an additional driver
 func (rr ReservationsRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (dr []domain.Reservation, err error) {
 	var reservations []domain.Reservation
 
-	rows, err := rr.GetDBHandle().QueryContext(ctx, "SELECT * FROM reservations WHERE user_id=$1", userID)
+	rows, err := rr.GetDBHandle().QueryContext(ctx, "SELECT * FROM reservations WHERE user_id=$1 OR $1=ANY(additional_driver_ids)", userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		reservation := models.Reservation{}
-		if err := rows.Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus, &reservation.StartDate, &reservation.EndDate); err != nil {
+		reservation, err := scanReservation(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -148,7 +209,7 @@ func (rr ReservationsRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (d
 		return nil, err
 	}
 
-	return reservations, nil
+	return rr.withDetails(ctx, reservations)
 }
 
 func (rr ReservationsRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (dr []domain.Reservation, err error) {
@@ -160,8 +221,8 @@ func (rr ReservationsRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (dr
 	}
 	defer rows.Close()
 	for rows.Next() {
-		reservation := models.Reservation{}
-		if err := rows.Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus, &reservation.StartDate, &reservation.EndDate); err != nil {
+		reservation, err := scanReservation(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -172,21 +233,21 @@ func (rr ReservationsRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (dr
 		return nil, err
 	}
 
-	return reservations, nil
+	return rr.withDetails(ctx, reservations)
 }
 
 func (rr ReservationsRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate time.Time, endDate time.Time) (dr []domain.Reservation, err error) {
 	var reservations []domain.Reservation
 
-	query := "SELECT * FROM reservations WHERE car_id=$1 AND start_date BETWEEN $2 AND $3 OR end_date BETWEEN $2 AND $3"
+	query := "SELECT * FROM reservations WHERE car_id=$1 AND start_date < $3 AND end_date > $2"
 	rows, err := rr.GetDBHandle().QueryContext(ctx, query, carID, startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		reservation := models.Reservation{}
-		if err := rows.Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus, &reservation.StartDate, &reservation.EndDate); err != nil {
+		reservation, err := scanReservation(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -199,3 +260,325 @@ func (rr ReservationsRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uui
 
 	return reservations, nil
 }
+
+// Gets the reservation of the car, other than excludedID and not canceled, that ends last within the given period.
+// It tells where the car will be right before a reservation starting at the end of the period.
+func (rr ReservationsRepo) GetPreviousByCarID(ctx context.Context, carID uuid.UUID, excludedID uuid.UUID, from time.Time, to time.Time) (domain.Reservation, error) {
+	query := "SELECT * FROM reservations WHERE car_id=$1 AND id<>$2 AND status<>$3 AND end_date > $4 AND end_date <= $5 ORDER BY end_date DESC LIMIT 1"
+	reservation, err := scanReservation(rr.GetDBHandle().QueryRowContext(ctx, query, carID, excludedID, constants.Values().RESERVATION_STATUSES.CANCELED, from, to))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Reservation{}, errors.New(services.ErrReservationNotFound)
+		}
+		return domain.Reservation{}, err
+	}
+
+	return reservation.ToDomain(), nil
+}
+
+// Gets the first reservation of the car, other than excludedID and not canceled, starting at or after the given date
+func (rr ReservationsRepo) GetNextByCarID(ctx context.Context, carID uuid.UUID, excludedID uuid.UUID, from time.Time) (domain.Reservation, error) {
+	query := "SELECT * FROM reservations WHERE car_id=$1 AND id<>$2 AND status<>$3 AND start_date >= $4 ORDER BY start_date ASC LIMIT 1"
+	reservation, err := scanReservation(rr.GetDBHandle().QueryRowContext(ctx, query, carID, excludedID, constants.Values().RESERVATION_STATUSES.CANCELED, from))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Reservation{}, errors.New(services.ErrReservationNotFound)
+		}
+		return domain.Reservation{}, err
+	}
+
+	return reservation.ToDomain(), nil
+}
+
+// Lists the reservations with the given status ending before dueBefore whose
+// car is out, the ones overdue the longest first. As the return inspection
+// can only follow the pickup one, the car is out while there is a single one.
+func (rr ReservationsRepo) ListOverdue(ctx context.Context, status string, dueBefore time.Time) (dr []domain.Reservation, err error) {
+	var reservations []domain.Reservation
+
+	query := `SELECT * FROM reservations WHERE status = $1 AND end_date < $2
+		AND (SELECT COUNT(*) FROM inspections WHERE inspections.reservation_id = reservations.id) = 1
+		ORDER BY end_date ASC`
+	rows, err := rr.GetDBHandle().QueryContext(ctx, query, status, dueBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		reservation, err := scanReservation(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		reservations = append(reservations, reservation.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rr.withDetails(ctx, reservations)
+}
+
+// Moves the end of the reservation to its new end date along with its new
+// prices in a single transaction. The car row is locked so reservations of the
+// same car are written one at a time, and the extension is rejected when
+// another reservation of the car that was not canceled starts before the new
+// end date or the reservation no longer ends at previousEndDate.
+func (rr ReservationsRepo) Extend(ctx context.Context, dr domain.Reservation, previousEndDate time.Time) (err error) {
+	reservation := models.LoadReservationFromDomain(dr)
+
+	tx, err := rr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = lockCarPeriod(ctx, tx, dr, previousEndDate, dr.EndDate); err != nil {
+		return err
+	}
+
+	// a payment made meanwhile would not cover the extension
+	result, err := tx.ExecContext(ctx, "UPDATE reservations SET end_date=$1, rental_cost=$2, additional_drivers_fee=$3, add_ons_cost=$4, protection_cost=$5, tax_total=$6 WHERE id=$7 AND end_date=$8 AND payment_status=$9",
+		reservation.EndDate, reservation.RentalCost, reservation.AdditionalDriversFee, reservation.AddOnsCost, reservation.ProtectionCost, reservation.TaxTotal, reservation.ID, previousEndDate, reservation.PaymentStatus)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrReservationChanged)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM reservation_add_ons WHERE reservation_id=$1", reservation.ID); err != nil {
+		return err
+	}
+
+	if err = lockAddOnsStock(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	if err = insertReservationAddOns(ctx, tx, dr); err != nil {
+		return mapReservationForeignKeyViolation(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM reservation_taxes WHERE reservation_id=$1", reservation.ID); err != nil {
+		return err
+	}
+
+	if err = insertReservationTaxes(ctx, tx, dr); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Loads the add-ons and taxes of the given reservations
+func (rr ReservationsRepo) withDetails(ctx context.Context, reservations []domain.Reservation) ([]domain.Reservation, error) {
+	reservations, err := rr.withAddOns(ctx, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	return rr.withTaxes(ctx, reservations)
+}
+
+// Loads the add-ons of the given reservations
+func (rr ReservationsRepo) withAddOns(ctx context.Context, reservations []domain.Reservation) ([]domain.Reservation, error) {
+	if len(reservations) == 0 {
+		return reservations, nil
+	}
+
+	// costs are in the currency of their reservation
+	ids := make(pq.StringArray, 0, len(reservations))
+	currencies := make(map[uuid.UUID]string, len(reservations))
+	for _, reservation := range reservations {
+		ids = append(ids, reservation.ID.String())
+		currencies[reservation.ID] = reservation.Currency
+	}
+
+	addOns := make(map[uuid.UUID][]domain.ReservationAddOn, len(reservations))
+	rows, err := rr.GetDBHandle().QueryContext(ctx, "SELECT reservation_id, add_on_id, quantity, cost FROM reservation_add_ons WHERE reservation_id = ANY($1::uuid[]) ORDER BY add_on_id ASC", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var reservationID uuid.UUID
+		var addOn domain.ReservationAddOn
+		var cost float64
+		if err := rows.Scan(&reservationID, &addOn.AddOnID, &addOn.Quantity, &cost); err != nil {
+			return nil, err
+		}
+		addOn.Cost = domain.NewMoney(cost, currencies[reservationID])
+
+		addOns[reservationID] = append(addOns[reservationID], addOn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range reservations {
+		reservations[i].AddOns = addOns[reservations[i].ID]
+	}
+
+	return reservations, nil
+}
+
+// Locks the row of the car of the reservation, so reservations of the same car
+// are written one at a time, and checks no other reservation of the car that
+// was not canceled overlaps the period between from and to. Canceled reservations
+// hold no car, so they are not checked.
+func lockCarPeriod(ctx context.Context, tx *sql.Tx, dr domain.Reservation, from time.Time, to time.Time) error {
+	canceled := constants.Values().RESERVATION_STATUSES.CANCELED
+	if dr.Status == canceled {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT id FROM cars WHERE id=$1 FOR UPDATE", dr.CarID); err != nil {
+		return err
+	}
+
+	var taken bool
+	err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM reservations WHERE car_id=$1 AND id<>$2 AND status<>$3 AND start_date < $5 AND end_date > $4)",
+		dr.CarID, dr.ID, canceled, from, to).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New(services.ErrCarNotAvailable)
+	}
+
+	return nil
+}
+
+// Locks the rows of the add-ons of the reservation, so their units are booked
+// one reservation at a time, and checks the units booked by the reservations
+// of the other cars that were not canceled leave enough stock for it. Rows are
+// locked in the order of their ids so reservations sharing add-ons do not
+// wait for each other.
+func lockAddOnsStock(ctx context.Context, tx *sql.Tx, dr domain.Reservation) error {
+	if dr.Status == constants.Values().RESERVATION_STATUSES.CANCELED {
+		return nil
+	}
+
+	selected := append([]domain.ReservationAddOn(nil), dr.AddOns...)
+	sort.Slice(selected, func(i, j int) bool { return selected[i].AddOnID.String() < selected[j].AddOnID.String() })
+	for _, addOn := range selected {
+		var name string
+		var stock int
+		if err := tx.QueryRowContext(ctx, "SELECT name, stock FROM add_ons WHERE id=$1 FOR UPDATE", addOn.AddOnID).Scan(&name, &stock); err != nil {
+			if err == sql.ErrNoRows {
+				return errors.New(services.ErrAddOnNotFound)
+			}
+			return err
+		}
+
+		bookings, err := getAddOnBookings(ctx, tx, addOn.AddOnID, dr.ID, dr.StartDate, dr.EndDate)
+		if err != nil {
+			return err
+		}
+		if domain.PeakBookedUnits(bookings, dr.StartDate, dr.EndDate)+addOn.Quantity > stock {
+			return fmt.Errorf("%s (%s)", services.ErrAddOnOutOfStock, name)
+		}
+	}
+
+	return nil
+}
+
+// Inserts the add-ons of a reservation within a transaction
+func insertReservationAddOns(ctx context.Context, tx *sql.Tx, dr domain.Reservation) error {
+	for _, addOn := range dr.AddOns {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO reservation_add_ons (reservation_id, add_on_id, quantity, cost) VALUES ($1, $2, $3, $4)",
+			dr.ID, addOn.AddOnID, addOn.Quantity, addOn.Cost.Major()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Loads the taxes of the given reservations in the order they were charged
+func (rr ReservationsRepo) withTaxes(ctx context.Context, reservations []domain.Reservation) ([]domain.Reservation, error) {
+	if len(reservations) == 0 {
+		return reservations, nil
+	}
+
+	// amounts are in the currency of their reservation
+	ids := make(pq.StringArray, 0, len(reservations))
+	currencies := make(map[uuid.UUID]string, len(reservations))
+	for _, reservation := range reservations {
+		ids = append(ids, reservation.ID.String())
+		currencies[reservation.ID] = reservation.Currency
+	}
+
+	taxes := make(map[uuid.UUID][]domain.ReservationTax, len(reservations))
+	rows, err := rr.GetDBHandle().QueryContext(ctx, "SELECT reservation_id, name, rate, amount FROM reservation_taxes WHERE reservation_id = ANY($1::uuid[]) ORDER BY reservation_id ASC, position ASC", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var reservationID uuid.UUID
+		var tax domain.ReservationTax
+		var amount float64
+		if err := rows.Scan(&reservationID, &tax.Name, &tax.Rate, &amount); err != nil {
+			return nil, err
+		}
+		tax.Amount = domain.NewMoney(amount, currencies[reservationID])
+
+		taxes[reservationID] = append(taxes[reservationID], tax)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range reservations {
+		reservations[i].Taxes = taxes[reservations[i].ID]
+	}
+
+	return reservations, nil
+}
+
+// Inserts the taxes of a reservation within a transaction, numbered in the
+// order they were charged
+func insertReservationTaxes(ctx context.Context, tx *sql.Tx, dr domain.Reservation) error {
+	for i, tax := range dr.Taxes {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO reservation_taxes (reservation_id, position, name, rate, amount) VALUES ($1, $2, $3, $4, $5)",
+			dr.ID, i+1, tax.Name, tax.Rate, tax.Amount.Major()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Scans a row of the reservations table following the order of its columns
+func scanReservation(row scanner) (reservation models.Reservation, err error) {
+	err = row.Scan(&reservation.ID, &reservation.UserID, &reservation.CarID, &reservation.Status, &reservation.PaymentStatus,
+		&reservation.StartDate, &reservation.EndDate, &reservation.PickupBranchID, &reservation.ReturnBranchID, &reservation.OneWayFee,
+		pq.Array(&reservation.AdditionalDriverIDs), &reservation.RentalCost, &reservation.AdditionalDriversFee, &reservation.AddOnsCost,
+		&reservation.ProtectionLevel, &reservation.ProtectionDailyPrice, &reservation.ProtectionDeductible, &reservation.ProtectionCost, &reservation.DepositStatus, &reservation.TaxTotal, &reservation.Currency)
+
+	return reservation, err
+}
+
+func mapReservationForeignKeyViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		if strings.Contains(pqErr.Message, "add_on_id") {
+			return errors.New(services.ErrAddOnNotFound)
+		} else if strings.Contains(pqErr.Message, "user_id") {
+			return errors.New(services.ErrUserNotFound)
+		} else if strings.Contains(pqErr.Message, "car_id") {
+			return errors.New(services.ErrCarNotFound)
+		} else if strings.Contains(pqErr.Message, "branch_id") {
+			return errors.New(services.ErrBranchNotFound)
+		}
+	}
+
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -37,7 +38,13 @@ func TestReservationsInsert(t *testing.T) {
 		PaymentStatus: "Pending",
 		StartDate:     time.Now(),
 		EndDate:       time.Now().AddDate(0, 0, 7),
+		Taxes:         []domain.ReservationTax{{Name: "Sales tax", Rate: 0.08875, Amount: domain.NewMoney(44.38, "USD")}},
+		TaxTotal:      domain.NewMoney(44.38, "USD"),
+		Currency:      "USD",
 	}
+	withAddOn := dr
+	withAddOn.AddOns = []domain.ReservationAddOn{{AddOnID: uuid.New(), Quantity: 2, Cost: domain.NewMoney(30, "USD")}}
+	booking := domain.AddOnBooking{ReservationID: uuid.New(), Quantity: 3, StartDate: dr.StartDate, EndDate: dr.EndDate}
 
 	type args struct {
 		ctx         context.Context
@@ -66,9 +73,17 @@ func TestReservationsInsert(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("INSERT INTO reservations").
-					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate).
+					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency).
 					WillReturnError(&pq.Error{Code: "23503", Message: ".* user_id .*"})
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -89,9 +104,17 @@ func TestReservationsInsert(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("INSERT INTO reservations").
-					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate).
+					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency).
 					WillReturnError(&pq.Error{Code: "23503", Message: ".* car_id .*"})
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -112,9 +135,82 @@ func TestReservationsInsert(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("INSERT INTO reservations").
-					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate).
+					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency).
 					WillReturnError(errors.New("exec context"))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when another reservation of the car overlaps it",
+			args: args{
+				ctx:         context.TODO(),
+				reservation: dr,
+			},
+			wants: wants{
+				err: errors.New(services.ErrCarNotAvailable),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when the add-ons left are booked by other reservations",
+			args: args{
+				ctx:         context.TODO(),
+				reservation: withAddOn,
+			},
+			wants: wants{
+				err: errors.New(services.ErrAddOnOutOfStock + " (Child seat)"),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec("INSERT INTO reservations").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT name, stock FROM add_ons WHERE id=\$1 FOR UPDATE`).
+					WithArgs(withAddOn.AddOns[0].AddOnID).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "stock"}).AddRow("Child seat", 4))
+				mock.ExpectQuery(`FROM reservation_add_ons JOIN reservations`).
+					WithArgs(withAddOn.AddOns[0].AddOnID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "start_date", "end_date"}).
+						AddRow(booking.ReservationID.String(), booking.Quantity, booking.StartDate, booking.EndDate))
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -136,9 +232,20 @@ func TestReservationsInsert(t *testing.T) {
 					t.Fatal(err)
 				}
 				result := sqlmock.NewResult(0, 1)
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("INSERT INTO reservations").
-					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate).
+					WithArgs(dr.ID, dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency).
+					WillReturnResult(result)
+				mock.ExpectExec("INSERT INTO reservation_taxes").
+					WithArgs(dr.ID, 1, dr.Taxes[0].Name, dr.Taxes[0].Rate, dr.Taxes[0].Amount.Major()).
 					WillReturnResult(result)
+				mock.ExpectCommit()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -169,13 +276,23 @@ func TestReservationsGet(t *testing.T) {
 	initConstantsFromRepository(t)
 
 	dr := domain.Reservation{
-		ID:            uuid.New(),
-		UserID:        uuid.New(),
-		CarID:         uuid.New(),
-		Status:        "Reserved",
-		PaymentStatus: "Pending",
-		StartDate:     time.Now(),
-		EndDate:       time.Now().AddDate(0, 0, 7),
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		CarID:                uuid.New(),
+		Status:               "Reserved",
+		PaymentStatus:        "Pending",
+		StartDate:            time.Now(),
+		EndDate:              time.Now().AddDate(0, 0, 7),
+		OneWayFee:            domain.NewMoney(0, "USD"),
+		RentalCost:           domain.NewMoney(0, "USD"),
+		AdditionalDriversFee: domain.NewMoney(0, "USD"),
+		AddOns:               []domain.ReservationAddOn{{AddOnID: uuid.New(), Quantity: 2, Cost: domain.NewMoney(30, "USD")}},
+		AddOnsCost:           domain.NewMoney(30, "USD"),
+		Protection:           domain.ReservationProtection{DailyPrice: domain.NewMoney(0, "USD"), Deductible: domain.NewMoney(0, "USD")},
+		ProtectionCost:       domain.NewMoney(0, "USD"),
+		Taxes:                []domain.ReservationTax{{Name: "Sales tax", Rate: 0.08875, Amount: domain.NewMoney(2.66, "USD")}},
+		TaxTotal:             domain.NewMoney(2.66, "USD"),
+		Currency:             "USD",
 	}
 
 	type args struct {
@@ -265,12 +382,20 @@ func TestReservationsGet(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "reservation_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate)
+				rows := sqlmock.NewRows([]string{"id", "user_id", "reservation_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency)
 				mock.ExpectQuery(`SELECT \* FROM reservations WHERE ID = \$1`).
 					WillReturnRows(rows)
+				addOnRows := sqlmock.NewRows([]string{"reservation_id", "add_on_id", "quantity", "cost"}).
+					AddRow(dr.ID.String(), dr.AddOns[0].AddOnID.String(), dr.AddOns[0].Quantity, dr.AddOns[0].Cost.Major())
+				mock.ExpectQuery(`^SELECT reservation_id, add_on_id, quantity, cost FROM reservation_add_ons WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(addOnRows)
+				taxRows := sqlmock.NewRows([]string{"reservation_id", "name", "rate", "amount"}).
+					AddRow(dr.ID.String(), dr.Taxes[0].Name, dr.Taxes[0].Rate, dr.Taxes[0].Amount.Major())
+				mock.ExpectQuery(`^SELECT reservation_id, name, rate, amount FROM reservation_taxes WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(taxRows)
 
-				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
 
 				return dbHandle
 			},
@@ -322,6 +447,34 @@ func TestReservationsFullUpdate(t *testing.T) {
 		wants    wants
 		setMocks func(*reservationsDependencies) *sql.DB
 	}{
+		{
+			name: "returns error and rolls back when another reservation of the car overlaps it",
+			args: args{
+				ctx:         context.TODO(),
+				reservation: dr,
+			},
+			wants: wants{
+				err: errors.New(services.ErrCarNotAvailable),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
 		{
 			name: "returns error when user was not found",
 			args: args{
@@ -336,9 +489,17 @@ func TestReservationsFullUpdate(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnError(&pq.Error{Code: "23503", Message: ".* user_id .*"})
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -359,9 +520,17 @@ func TestReservationsFullUpdate(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnError(&pq.Error{Code: "23503", Message: ".* car_id .*"})
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -382,9 +551,17 @@ func TestReservationsFullUpdate(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnError(errors.New("exec context"))
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -406,9 +583,17 @@ func TestReservationsFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				result := sqlmock.NewErrorResult(errors.New("rows affected error"))
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnResult(result)
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -430,9 +615,17 @@ func TestReservationsFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				result := sqlmock.NewResult(0, 0)
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnResult(result)
+				mock.ExpectRollback()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -454,9 +647,23 @@ func TestReservationsFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				result := sqlmock.NewResult(0, 1)
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", dr.StartDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 				mock.ExpectExec("UPDATE reservations SET").
-					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, dr.ID).
+					WithArgs(dr.UserID, dr.CarID, dr.Status, dr.StartDate, dr.EndDate, nil, nil, dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.Currency, dr.ID).
 					WillReturnResult(result)
+				mock.ExpectExec("DELETE FROM reservation_add_ons").
+					WithArgs(dr.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM reservation_taxes").
+					WithArgs(dr.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
 
@@ -524,6 +731,29 @@ func TestReservationsDelete(t *testing.T) {
 				return dbHandle
 			},
 		},
+		{
+			name: "returns error when the reservation has an invoice",
+			args: args{
+				ctx: context.TODO(),
+				id:  id,
+			},
+			wants: wants{
+				err: errors.New(services.ErrReservationInvoiced),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("DELETE FROM reservations").
+					WithArgs(id).
+					WillReturnError(&pq.Error{Code: "23503"})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
 		{
 			name: "returns error when rows affected fails",
 			args: args{
@@ -683,7 +913,7 @@ func TestReservationsList(t *testing.T) {
 			},
 			wants: wants{
 				reservations: nil,
-				err:          errors.New("sql: expected 1 destination arguments in Scan, not 7"),
+				err:          errors.New("sql: expected 1 destination arguments in Scan, not 21"),
 			},
 			setMocks: func(d *reservationsDependencies) *sql.DB {
 
@@ -735,8 +965,8 @@ func TestReservationsList(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency).
 					RowError(0, errors.New("rows.Err error"))
 				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE start_date BETWEEN \$1 AND \$2 AND end_date BETWEEN \$1 AND \$2 AND id > \$3 ORDER BY id ASC LIMIT \$4`).
 					WillReturnRows(rows)
@@ -776,13 +1006,17 @@ func TestReservationsList(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate)
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency)
 				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE start_date BETWEEN \$1 AND \$2 AND end_date BETWEEN \$1 AND \$2 AND id > \$3 ORDER BY id ASC LIMIT \$4`).
 					WillReturnRows(rows)
+				mock.ExpectQuery(`^SELECT reservation_id, add_on_id, quantity, cost FROM reservation_add_ons WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "add_on_id", "quantity", "cost"}))
+				mock.ExpectQuery(`^SELECT reservation_id, name, rate, amount FROM reservation_taxes WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "name", "rate", "amount"}))
 
-				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
 
 				return dbHandle
 			},
@@ -853,7 +1087,7 @@ func TestReservationsGetByUserID(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1 OR \$1=ANY\(additional_driver_ids\)$`).
 					WillReturnError(errors.New("query context error"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -869,7 +1103,7 @@ func TestReservationsGetByUserID(t *testing.T) {
 			},
 			wants: wants{
 				reservations: nil,
-				err:          errors.New("sql: expected 1 destination arguments in Scan, not 7"),
+				err:          errors.New("sql: expected 1 destination arguments in Scan, not 21"),
 			},
 			setMocks: func(d *reservationsDependencies) *sql.DB {
 
@@ -883,7 +1117,7 @@ func TestReservationsGetByUserID(t *testing.T) {
 				}
 				rows := sqlmock.NewRows([]string{"id"}).
 					AddRow(reservationIdByte)
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1 OR \$1=ANY\(additional_driver_ids\)$`).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -919,10 +1153,10 @@ func TestReservationsGetByUserID(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency).
 					RowError(0, errors.New("rows.Err error"))
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1 OR \$1=ANY\(additional_driver_ids\)$`).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -958,12 +1192,16 @@ func TestReservationsGetByUserID(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate)
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1$`).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency)
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE user_id=\$1 OR \$1=ANY\(additional_driver_ids\)$`).
 					WillReturnRows(rows)
+				mock.ExpectQuery(`^SELECT reservation_id, add_on_id, quantity, cost FROM reservation_add_ons WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "add_on_id", "quantity", "cost"}))
+				mock.ExpectQuery(`^SELECT reservation_id, name, rate, amount FROM reservation_taxes WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "name", "rate", "amount"}))
 
-				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
 
 				return dbHandle
 			},
@@ -1050,7 +1288,7 @@ func TestReservationsGetByCarID(t *testing.T) {
 			},
 			wants: wants{
 				reservations: nil,
-				err:          errors.New("sql: expected 1 destination arguments in Scan, not 7"),
+				err:          errors.New("sql: expected 1 destination arguments in Scan, not 21"),
 			},
 			setMocks: func(d *reservationsDependencies) *sql.DB {
 
@@ -1100,8 +1338,8 @@ func TestReservationsGetByCarID(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency).
 					RowError(0, errors.New("rows.Err error"))
 				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1$`).
 					WillReturnRows(rows)
@@ -1139,12 +1377,16 @@ func TestReservationsGetByCarID(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate)
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency)
 				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1$`).
 					WillReturnRows(rows)
+				mock.ExpectQuery(`^SELECT reservation_id, add_on_id, quantity, cost FROM reservation_add_ons WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "add_on_id", "quantity", "cost"}))
+				mock.ExpectQuery(`^SELECT reservation_id, name, rate, amount FROM reservation_taxes WHERE reservation_id = ANY\(\$1::uuid\[\]\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"reservation_id", "name", "rate", "amount"}))
 
-				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+				d.db.EXPECT().GetDBHandle().Return(dbHandle).Times(3)
 
 				return dbHandle
 			},
@@ -1221,7 +1463,7 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date BETWEEN \$2 AND \$3 OR end_date BETWEEN \$2 AND \$3$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date < \$3 AND end_date > \$2$`).
 					WillReturnError(errors.New("query context error"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -1239,7 +1481,7 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 			},
 			wants: wants{
 				reservations: nil,
-				err:          errors.New("sql: expected 1 destination arguments in Scan, not 7"),
+				err:          errors.New("sql: expected 1 destination arguments in Scan, not 21"),
 			},
 			setMocks: func(d *reservationsDependencies) *sql.DB {
 
@@ -1253,7 +1495,7 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 				}
 				rows := sqlmock.NewRows([]string{"id"}).
 					AddRow(reservationIdByte)
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date BETWEEN \$2 AND \$3 OR end_date BETWEEN \$2 AND \$3$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date < \$3 AND end_date > \$2$`).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -1291,10 +1533,10 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency).
 					RowError(0, errors.New("rows.Err error"))
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date BETWEEN \$2 AND \$3 OR end_date BETWEEN \$2 AND \$3$`).
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date < \$3 AND end_date > \$2$`).
 					WillReturnRows(rows)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -1332,9 +1574,9 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date"}).
-					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate)
-				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date BETWEEN \$2 AND \$3 OR end_date BETWEEN \$2 AND \$3$`).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(reservationIdByte, userIdByte, carIdByte, drs[0].Status, drs[0].PaymentStatus, drs[0].StartDate, drs[0].EndDate, nil, nil, drs[0].OneWayFee.Major(), "{}", drs[0].RentalCost.Major(), drs[0].AdditionalDriversFee.Major(), drs[0].AddOnsCost.Major(), drs[0].Protection.Level, drs[0].Protection.DailyPrice.Major(), drs[0].Protection.Deductible.Major(), drs[0].ProtectionCost.Major(), drs[0].DepositStatus, drs[0].TaxTotal.Major(), drs[0].Currency)
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND start_date < \$3 AND end_date > \$2$`).
 					WithArgs(drs[0].CarID, start_date, end_date).
 					WillReturnRows(rows)
 
@@ -1363,3 +1605,389 @@ func TestReservationsGetByCarIDAndTimeFrame(t *testing.T) {
 		})
 	}
 }
+
+func TestReservationsGetPreviousByCarID(t *testing.T) {
+	initConstantsFromRepository(t)
+	pickupBranchID := uuid.New()
+	dr := domain.Reservation{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		CarID:          uuid.New(),
+		Status:         "Reserved",
+		PaymentStatus:  "Pending",
+		StartDate:      time.Now().Add(-24 * time.Hour),
+		EndDate:        time.Now().Add(12 * time.Hour),
+		PickupBranchID: &pickupBranchID,
+		ReturnBranchID: &pickupBranchID,
+	}
+	excludedID := uuid.New()
+	from := time.Now()
+	to := time.Now().Add(24 * time.Hour)
+
+	type wants struct {
+		reservation domain.Reservation
+		err         error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*reservationsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the last reservation ending within the period with its branches",
+			wants: wants{
+				reservation: dr,
+				err:         nil,
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(dr.ID.String(), dr.UserID.String(), dr.CarID.String(), dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, pickupBranchID.String(), pickupBranchID.String(), dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency)
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND id<>\$2 AND status<>\$3 AND end_date > \$4 AND end_date <= \$5 ORDER BY end_date DESC LIMIT 1$`).
+					WithArgs(dr.CarID, excludedID, "Canceled", from, to).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the only earlier reservation was canceled",
+			wants: wants{
+				reservation: domain.Reservation{},
+				err:         errors.New(services.ErrReservationNotFound),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1`).
+					WithArgs(dr.CarID, excludedID, "Canceled", from, to).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewReservationsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			reservationsRepo := NewReservationsRepository(db)
+			reservation, err := reservationsRepo.GetPreviousByCarID(context.TODO(), dr.CarID, excludedID, from, to)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.reservation, reservation)
+		})
+	}
+}
+
+func TestReservationsGetNextByCarID(t *testing.T) {
+	initConstantsFromRepository(t)
+	pickupBranchID := uuid.New()
+	dr := domain.Reservation{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		CarID:          uuid.New(),
+		Status:         "Reserved",
+		PaymentStatus:  "Pending",
+		StartDate:      time.Now().Add(48 * time.Hour),
+		EndDate:        time.Now().Add(96 * time.Hour),
+		PickupBranchID: &pickupBranchID,
+		ReturnBranchID: &pickupBranchID,
+	}
+	excludedID := uuid.New()
+	from := time.Now().Add(24 * time.Hour)
+
+	type wants struct {
+		reservation domain.Reservation
+		err         error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*reservationsDependencies) *sql.DB
+	}{
+		{
+			name: "returns the first reservation starting after the date with its branches",
+			wants: wants{
+				reservation: dr,
+				err:         nil,
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"}).
+					AddRow(dr.ID.String(), dr.UserID.String(), dr.CarID.String(), dr.Status, dr.PaymentStatus, dr.StartDate, dr.EndDate, pickupBranchID.String(), pickupBranchID.String(), dr.OneWayFee.Major(), "{}", dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.Protection.Level, dr.Protection.DailyPrice.Major(), dr.Protection.Deductible.Major(), dr.ProtectionCost.Major(), dr.DepositStatus, dr.TaxTotal.Major(), dr.Currency)
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1 AND id<>\$2 AND status<>\$3 AND start_date >= \$4 ORDER BY start_date ASC LIMIT 1$`).
+					WithArgs(dr.CarID, excludedID, "Canceled", from).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns not found error when the only later reservation was canceled",
+			wants: wants{
+				reservation: domain.Reservation{},
+				err:         errors.New(services.ErrReservationNotFound),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE car_id=\$1`).
+					WithArgs(dr.CarID, excludedID, "Canceled", from).
+					WillReturnError(sql.ErrNoRows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewReservationsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			reservationsRepo := NewReservationsRepository(db)
+			reservation, err := reservationsRepo.GetNextByCarID(context.TODO(), dr.CarID, excludedID, from)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.reservation, reservation)
+		})
+	}
+}
+
+func TestReservationsListOverdue(t *testing.T) {
+	dueBefore := time.Now().Add(-30 * time.Minute)
+
+	type wants struct {
+		reservations []domain.Reservation
+		err          error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*reservationsDependencies) *sql.DB
+	}{
+		{
+			name: "returns no reservations when every car was returned on time",
+			wants: wants{
+				reservations: nil,
+				err:          nil,
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows([]string{"id", "user_id", "car_id", "status", "payment_status", "start_date", "end_date", "pickup_branch_id", "return_branch_id", "one_way_fee", "additional_driver_ids", "rental_cost", "additional_drivers_fee", "add_ons_cost", "protection_level", "protection_daily_price", "protection_deductible", "protection_cost", "deposit_status", "tax_total", "currency"})
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE status = \$1 AND end_date < \$2`).
+					WithArgs("Reserved", dueBefore).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the query failed",
+			wants: wants{
+				reservations: nil,
+				err:          errors.New("connection refused"),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM reservations WHERE status = \$1 AND end_date < \$2`).
+					WithArgs("Reserved", dueBefore).
+					WillReturnError(errors.New("connection refused"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewReservationsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			reservationsRepo := NewReservationsRepository(db)
+			reservations, err := reservationsRepo.ListOverdue(context.TODO(), "Reserved", dueBefore)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.reservations, reservations)
+		})
+	}
+}
+
+func TestReservationsExtend(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	previousEndDate := time.Now().Add(24 * time.Hour)
+	dr := domain.Reservation{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		CarID:          uuid.New(),
+		Status:         "Reserved",
+		PaymentStatus:  "Pending",
+		StartDate:      time.Now(),
+		EndDate:        previousEndDate.Add(48 * time.Hour),
+		RentalCost:     domain.NewMoney(720, "USD"),
+		ProtectionCost: domain.NewMoney(30, "USD"),
+		Taxes:          []domain.ReservationTax{{Name: "Sales tax", Rate: 0.1, Amount: domain.NewMoney(75, "USD")}},
+		TaxTotal:       domain.NewMoney(75, "USD"),
+		Currency:       "USD",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*reservationsDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the new end date and prices were stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", previousEndDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(`UPDATE reservations SET end_date=\$1`).
+					WithArgs(dr.EndDate, dr.RentalCost.Major(), dr.AdditionalDriversFee.Major(), dr.AddOnsCost.Major(), dr.ProtectionCost.Major(), dr.TaxTotal.Major(), dr.ID, previousEndDate, dr.PaymentStatus).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM reservation_add_ons").
+					WithArgs(dr.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM reservation_taxes").
+					WithArgs(dr.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO reservation_taxes").
+					WithArgs(dr.ID, 1, "Sales tax", 0.1, 75.0).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when another reservation of the car starts before the new end",
+			wants: wants{
+				err: errors.New(services.ErrCarNotAvailable),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", previousEndDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when the reservation no longer ends at the previous end date or was paid meanwhile",
+			wants: wants{
+				err: errors.New(services.ErrReservationChanged),
+			},
+			setMocks: func(d *reservationsDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`SELECT id FROM cars WHERE id=\$1 FOR UPDATE`).
+					WithArgs(dr.CarID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT EXISTS`).
+					WithArgs(dr.CarID, dr.ID, "Canceled", previousEndDate, dr.EndDate).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(`UPDATE reservations SET end_date=\$1.* WHERE id=\$7 AND end_date=\$8 AND payment_status=\$9`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewReservationsDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			reservationsRepo := NewReservationsRepository(db)
+			err := reservationsRepo.Extend(context.TODO(), dr, previousEndDate)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
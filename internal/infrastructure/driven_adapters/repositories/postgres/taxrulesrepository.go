@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type TaxRulesRepo struct {
+	ports.Database
+}
+
+func NewTaxRulesRepository(db ports.Database) *TaxRulesRepo {
+	return &TaxRulesRepo{
+		Database: db,
+	}
+}
+
+func (trr *TaxRulesRepo) Insert(ctx context.Context, dtr domain.TaxRule) error {
+	rule := models.LoadTaxRuleFromDomain(dtr)
+
+	_, err := trr.GetDBHandle().ExecContext(ctx, "INSERT INTO tax_rules (id, city_id, branch_id, name, jurisdiction, rate, applies_to, rounding) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		rule.ID, rule.CityID, rule.BranchID, rule.Name, rule.Jurisdiction, rule.Rate, pq.Array(rule.AppliesTo), rule.Rounding)
+
+	return mapTaxRuleViolation(err)
+}
+
+func (trr *TaxRulesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.TaxRule, error) {
+	rule, err := scanTaxRule(trr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM tax_rules WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.TaxRule{}, errors.New(services.ErrTaxRuleNotFound)
+		}
+		return domain.TaxRule{}, err
+	}
+
+	return rule.ToDomain(), nil
+}
+
+func (trr *TaxRulesRepo) FullUpdate(ctx context.Context, dtr domain.TaxRule) error {
+	rule := models.LoadTaxRuleFromDomain(dtr)
+
+	result, err := trr.GetDBHandle().ExecContext(ctx, "UPDATE tax_rules SET city_id=$1, branch_id=$2, name=$3, jurisdiction=$4, rate=$5, applies_to=$6, rounding=$7 WHERE id=$8",
+		rule.CityID, rule.BranchID, rule.Name, rule.Jurisdiction, rule.Rate, pq.Array(rule.AppliesTo), rule.Rounding, rule.ID)
+	if err != nil {
+		return mapTaxRuleViolation(err)
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrTaxRuleNotFound)
+	}
+
+	return nil
+}
+
+// Deletes a tax rule. Reservations booked with it keep the taxes they were charged.
+func (trr *TaxRulesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := trr.GetDBHandle().ExecContext(ctx, "DELETE FROM tax_rules WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrTaxRuleNotFound)
+	}
+
+	return nil
+}
+
+// Lists the tax rules of a city ordered by name, which is the order they are
+// charged in
+func (trr *TaxRulesRepo) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error) {
+	var rules []domain.TaxRule
+
+	rows, err := trr.GetDBHandle().QueryContext(ctx, "SELECT * FROM tax_rules WHERE city_id = $1 ORDER BY name ASC", cityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rule, err := scanTaxRule(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Scans a row of the tax_rules table following the order of its columns
+func scanTaxRule(row scanner) (rule models.TaxRule, err error) {
+	err = row.Scan(&rule.ID, &rule.CityID, &rule.BranchID, &rule.Name, &rule.Jurisdiction, &rule.Rate, pq.Array(&rule.AppliesTo), &rule.Rounding)
+
+	return rule, err
+}
+
+func mapTaxRuleViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		if pqErr.Code == "23505" {
+			return errors.New(services.ErrTaxRuleExists)
+		} else if pqErr.Code == "23503" {
+			if strings.Contains(pqErr.Message, "branch_id") {
+				return errors.New(services.ErrBranchNotFound)
+			}
+			return errors.New(services.ErrCityNotFound)
+		}
+	}
+
+	return err
+}
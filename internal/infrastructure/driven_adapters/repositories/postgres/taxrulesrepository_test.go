@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type taxRulesDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewTaxRulesDependencies(db *mocks.MockDatabase) *taxRulesDependencies {
+	return &taxRulesDependencies{
+		db: db,
+	}
+}
+
+func TestTaxRulesInsert(t *testing.T) {
+	branchID := uuid.New()
+	dtr := domain.TaxRule{
+		ID:           uuid.New(),
+		CityID:       uuid.New(),
+		BranchID:     &branchID,
+		Name:         "Airport surcharge",
+		Jurisdiction: "Chicago Department of Aviation",
+		Rate:         0.11,
+		AppliesTo:    []string{"Rental"},
+		Rounding:     "Up",
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*taxRulesDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when the tax rule was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO tax_rules").
+					WithArgs(dtr.ID, dtr.CityID, branchID, dtr.Name, dtr.Jurisdiction, dtr.Rate, "{\"Rental\"}", dtr.Rounding).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the city already has a tax rule with the same name",
+			wants: wants{
+				err: errors.New(services.ErrTaxRuleExists),
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO tax_rules").
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "unique_city_tax_rule_name"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the branch was not found",
+			wants: wants{
+				err: errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO tax_rules").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "tax_rules" violates foreign key constraint "tax_rules_branch_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the city was not found",
+			wants: wants{
+				err: errors.New(services.ErrCityNotFound),
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO tax_rules").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "tax_rules" violates foreign key constraint "tax_rules_city_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewTaxRulesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			taxRulesRepo := NewTaxRulesRepository(db)
+			err := taxRulesRepo.Insert(context.TODO(), dtr)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestTaxRulesListByCityID(t *testing.T) {
+	cityID := uuid.New()
+	branchID := uuid.New()
+	dtrs := []domain.TaxRule{
+		{
+			ID:           uuid.New(),
+			CityID:       cityID,
+			BranchID:     &branchID,
+			Name:         "Airport surcharge",
+			Jurisdiction: "Chicago Department of Aviation",
+			Rate:         0.11,
+			AppliesTo:    []string{"Rental"},
+			Rounding:     "Up",
+		},
+		{
+			ID:           uuid.New(),
+			CityID:       cityID,
+			Name:         "Sales tax",
+			Jurisdiction: "Illinois",
+			Rate:         0.1025,
+			AppliesTo:    []string{"Rental", "Add-ons", "Protection", "Fees"},
+			Rounding:     "Half Up",
+		},
+	}
+	columns := []string{"id", "city_id", "branch_id", "name", "jurisdiction", "rate", "applies_to", "rounding"}
+
+	type wants struct {
+		rules []domain.TaxRule
+		err   error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*taxRulesDependencies) *sql.DB
+	}{
+		{
+			name: "returns the tax rules of the city",
+			wants: wants{
+				rules: dtrs,
+				err:   nil,
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(columns).
+					AddRow(dtrs[0].ID.String(), cityID.String(), branchID.String(), dtrs[0].Name, dtrs[0].Jurisdiction, dtrs[0].Rate, "{Rental}", dtrs[0].Rounding).
+					AddRow(dtrs[1].ID.String(), cityID.String(), nil, dtrs[1].Name, dtrs[1].Jurisdiction, dtrs[1].Rate, "{Rental,Add-ons,Protection,Fees}", dtrs[1].Rounding)
+				mock.ExpectQuery(`^SELECT \* FROM tax_rules WHERE city_id = \$1 ORDER BY name ASC`).
+					WithArgs(cityID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns no tax rules when the city has none",
+			wants: wants{
+				rules: nil,
+				err:   nil,
+			},
+			setMocks: func(d *taxRulesDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM tax_rules WHERE city_id = \$1 ORDER BY name ASC`).
+					WithArgs(cityID).
+					WillReturnRows(sqlmock.NewRows(columns))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewTaxRulesDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			taxRulesRepo := NewTaxRulesRepository(db)
+			rules, err := taxRulesRepo.ListByCityID(context.TODO(), cityID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.rules, rules)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type TransfersRepo struct {
+	ports.Database
+}
+
+func NewTransfersRepository(db ports.Database) *TransfersRepo {
+	return &TransfersRepo{
+		Database: db,
+	}
+}
+
+func (tr *TransfersRepo) Insert(ctx context.Context, dt domain.Transfer) (err error) {
+	transfer := models.LoadTransferFromDomain(dt)
+
+	_, err = tr.GetDBHandle().ExecContext(ctx, "INSERT INTO transfers (id, car_id, from_city_id, to_city_id, to_branch_id, departure_date, arrival_date, completed_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		transfer.ID, transfer.CarID, transfer.FromCityID, transfer.ToCityID, transfer.ToBranchID, transfer.DepartureDate, transfer.ArrivalDate, transfer.CompletedAt)
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23503":
+			if strings.Contains(pqErr.Message, "branch") {
+				return errors.New(services.ErrBranchNotFound)
+			}
+			if strings.Contains(pqErr.Message, "city") {
+				return errors.New(services.ErrCityNotFound)
+			}
+			return errors.New(services.ErrCarNotFound)
+		case "23505":
+			return errors.New(services.ErrTransferAlreadyScheduled)
+		}
+	}
+
+	return err
+}
+
+func (tr *TransfersRepo) Get(ctx context.Context, ID uuid.UUID) (dt domain.Transfer, err error) {
+	transfer, err := scanTransfer(tr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM transfers WHERE id = $1", ID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Transfer{}, errors.New(services.ErrTransferNotFound)
+		}
+		return domain.Transfer{}, err
+	}
+
+	return transfer.ToDomain(), nil
+}
+
+func (tr *TransfersRepo) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error) {
+	rows, err := tr.GetDBHandle().QueryContext(ctx, "SELECT * FROM transfers WHERE car_id=$1 ORDER BY departure_date ASC", carID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanTransfers(rows)
+}
+
+func (tr *TransfersRepo) GetPendingByCarID(ctx context.Context, carID uuid.UUID) (dt domain.Transfer, err error) {
+	transfer, err := scanTransfer(tr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM transfers WHERE car_id = $1 AND completed_at IS NULL", carID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Transfer{}, errors.New(services.ErrTransferNotFound)
+		}
+		return domain.Transfer{}, err
+	}
+
+	return transfer.ToDomain(), nil
+}
+
+func (tr *TransfersRepo) Complete(ctx context.Context, dt domain.Transfer) (err error) {
+	transfer := models.LoadTransferFromDomain(dt)
+
+	tx, err := tr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE transfers SET completed_at=$1 WHERE id=$2 AND completed_at IS NULL", transfer.CompletedAt, transfer.ID)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrTransferCompleted)
+	}
+
+	result, err = tx.ExecContext(ctx, "UPDATE cars SET city_id=$1, branch_id=$2 WHERE id=$3", transfer.ToCityID, transfer.ToBranchID, transfer.CarID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrBranchNotFound)
+		}
+
+		return err
+	}
+
+	if numUpdatedRows, err = result.RowsAffected(); err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrCarNotFound)
+	}
+
+	return tx.Commit()
+}
+
+// Deletes a pending transfer. Completed transfers are kept as history.
+func (tr *TransfersRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := tr.GetDBHandle().ExecContext(ctx, "DELETE FROM transfers WHERE id=$1 AND completed_at IS NULL", id)
+	if err != nil {
+		return err
+	}
+
+	numDeletedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if numDeletedRows == 0 {
+		return errors.New(services.ErrTransferNotFound)
+	}
+
+	return nil
+}
+
+// Scans a row of the transfers table following the order of its columns
+func scanTransfer(row scanner) (transfer models.Transfer, err error) {
+	err = row.Scan(&transfer.ID, &transfer.CarID, &transfer.FromCityID, &transfer.ToCityID, &transfer.ToBranchID,
+		&transfer.DepartureDate, &transfer.ArrivalDate, &transfer.CompletedAt)
+
+	return transfer, err
+}
+
+// Scans all the rows and closes them
+func scanTransfers(rows *sql.Rows) ([]domain.Transfer, error) {
+	var transfers []domain.Transfer
+
+	defer rows.Close()
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
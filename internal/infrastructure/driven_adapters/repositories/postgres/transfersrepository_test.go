@@ -0,0 +1,332 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var transfersColumns = []string{"id", "car_id", "from_city_id", "to_city_id", "to_branch_id", "departure_date", "arrival_date", "completed_at"}
+
+type transfersDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewTransfersDependencies(db *mocks.MockDatabase) *transfersDependencies {
+	return &transfersDependencies{
+		db: db,
+	}
+}
+
+func TestTransfersInsert(t *testing.T) {
+	toBranchID := uuid.New()
+	dt := domain.Transfer{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		FromCityID:    uuid.New(),
+		ToCityID:      uuid.New(),
+		ToBranchID:    &toBranchID,
+		DepartureDate: time.Now().Add(24 * time.Hour),
+		ArrivalDate:   time.Now().Add(48 * time.Hour),
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*transfersDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when transfer was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO transfers").
+					WithArgs(dt.ID, dt.CarID, dt.FromCityID, dt.ToCityID, toBranchID.String(), dt.DepartureDate, dt.ArrivalDate, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when destination branch does not belong to the destination city",
+			wants: wants{
+				err: errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO transfers").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "transfers" violates foreign key constraint "transfers_to_branch_city_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when car was not found",
+			wants: wants{
+				err: errors.New(services.ErrCarNotFound),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO transfers").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "transfers" violates foreign key constraint "transfers_car_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when car already has a pending transfer",
+			wants: wants{
+				err: errors.New(services.ErrTransferAlreadyScheduled),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO transfers").
+					WillReturnError(&pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "transfers_pending_car_id_idx"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewTransfersDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			transfersRepo := NewTransfersRepository(db)
+			err := transfersRepo.Insert(context.TODO(), dt)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestTransfersGetPendingByCarID(t *testing.T) {
+	dt := domain.Transfer{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		FromCityID:    uuid.New(),
+		ToCityID:      uuid.New(),
+		DepartureDate: time.Date(2030, time.June, 1, 8, 0, 0, 0, time.UTC),
+		ArrivalDate:   time.Date(2030, time.June, 2, 18, 0, 0, 0, time.UTC),
+	}
+
+	type wants struct {
+		transfer domain.Transfer
+		err      error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*transfersDependencies) *sql.DB
+	}{
+		{
+			name: "returns the pending transfer of the car",
+			wants: wants{
+				transfer: dt,
+				err:      nil,
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(transfersColumns).
+					AddRow(dt.ID.String(), dt.CarID.String(), dt.FromCityID.String(), dt.ToCityID.String(), nil, dt.DepartureDate, dt.ArrivalDate, nil)
+				mock.ExpectQuery(`^SELECT \* FROM transfers WHERE car_id = \$1 AND completed_at IS NULL$`).
+					WithArgs(dt.CarID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when the car has no pending transfer",
+			wants: wants{
+				transfer: domain.Transfer{},
+				err:      errors.New(services.ErrTransferNotFound),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM transfers WHERE car_id = \$1 AND completed_at IS NULL$`).
+					WillReturnRows(sqlmock.NewRows(transfersColumns))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewTransfersDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			transfersRepo := NewTransfersRepository(db)
+			transfer, err := transfersRepo.GetPendingByCarID(context.TODO(), dt.CarID)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.transfer, transfer)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestTransfersComplete(t *testing.T) {
+	completedAt := time.Now()
+	toBranchID := uuid.New()
+	dt := domain.Transfer{
+		ID:            uuid.New(),
+		CarID:         uuid.New(),
+		FromCityID:    uuid.New(),
+		ToCityID:      uuid.New(),
+		ToBranchID:    &toBranchID,
+		DepartureDate: completedAt.Add(-48 * time.Hour),
+		ArrivalDate:   completedAt.Add(-24 * time.Hour),
+		CompletedAt:   &completedAt,
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*transfersDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when transfer was completed and car relocated",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE transfers SET completed_at").
+					WithArgs(completedAt, dt.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE cars SET city_id").
+					WithArgs(dt.ToCityID, toBranchID.String(), dt.CarID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when transfer was already completed",
+			wants: wants{
+				err: errors.New(services.ErrTransferCompleted),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE transfers SET completed_at").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when destination branch was removed",
+			wants: wants{
+				err: errors.New(services.ErrBranchNotFound),
+			},
+			setMocks: func(d *transfersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE transfers SET completed_at").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE cars SET city_id").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "cars" violates foreign key constraint "cars_branch_city_fkey"`})
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewTransfersDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			transfersRepo := NewTransfersRepository(db)
+			err := transfersRepo.Complete(context.TODO(), dt)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
@@ -26,8 +27,9 @@ func NewUsersRepository(db ports.Database) *UsersRepo {
 
 func (ur *UsersRepo) Insert(ctx context.Context, du domain.User) (err error) {
 	user := models.LoadUserFromDomain(du)
-	_, err = ur.GetDBHandle().ExecContext(ctx, "INSERT INTO users (id, first_name, last_name, email, type, status) VALUES ($1, $2, $3, $4, $5, $6)",
-		user.ID, user.FirstName, user.LastName, user.Email, user.Type, user.Status)
+	_, err = ur.GetDBHandle().ExecContext(ctx, "INSERT INTO users (id, first_name, last_name, email, type, status, email_verified_at, date_of_birth, driver_license_number, driver_license_country, driver_license_issue_date, driver_license_expiry_date) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+		user.ID, user.FirstName, user.LastName, user.Email, user.Type, user.Status, user.EmailVerifiedAt,
+		user.DateOfBirth, user.DriverLicenseNumber, user.DriverLicenseCountry, user.DriverLicenseIssueDate, user.DriverLicenseExpiryDate)
 
 	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
 		if strings.Contains(pqErr.Message, "unique_email") {
@@ -39,9 +41,8 @@ func (ur *UsersRepo) Insert(ctx context.Context, du domain.User) (err error) {
 }
 
 func (ur *UsersRepo) Get(ctx context.Context, ID uuid.UUID) (dc domain.User, err error) {
-	var user models.User
-	if err := ur.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM users WHERE ID = $1", ID).
-		Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.Type, &user.Status); err != nil {
+	user, err := scanUser(ur.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM users WHERE ID = $1", ID))
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return domain.User{}, errors.New(services.ErrUserNotFound)
 		}
@@ -51,11 +52,13 @@ func (ur *UsersRepo) Get(ctx context.Context, ID uuid.UUID) (dc domain.User, err
 	return user.ToDomain(), nil
 }
 
+// Updates the user. Changing the email clears its verification.
 func (ur *UsersRepo) FullUpdate(ctx context.Context, dc domain.User) error {
 	user := models.LoadUserFromDomain(dc)
 
-	result, err := ur.GetDBHandle().ExecContext(ctx, "UPDATE users SET first_name=$1, last_name=$2, email=$3, type=$4, status=$5 WHERE id=$6",
-		user.FirstName, user.LastName, user.Email, user.Type, user.Status, user.ID)
+	result, err := ur.GetDBHandle().ExecContext(ctx, "UPDATE users SET first_name=$1, last_name=$2, email=$3, type=$4, status=$5, email_verified_at=CASE WHEN email=$3 THEN email_verified_at END, date_of_birth=$6, driver_license_number=$7, driver_license_country=$8, driver_license_issue_date=$9, driver_license_expiry_date=$10 WHERE id=$11",
+		user.FirstName, user.LastName, user.Email, user.Type, user.Status,
+		user.DateOfBirth, user.DriverLicenseNumber, user.DriverLicenseCountry, user.DriverLicenseIssueDate, user.DriverLicenseExpiryDate, user.ID)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
 			if strings.Contains(pqErr.Message, "unique_email") {
@@ -94,3 +97,81 @@ func (ur *UsersRepo) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return err
 }
+
+func (ur *UsersRepo) GetByEmail(ctx context.Context, email string) (du domain.User, err error) {
+	user, err := scanUser(ur.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM users WHERE email = $1", email))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.User{}, errors.New(services.ErrUserNotFound)
+		}
+		return domain.User{}, err
+	}
+
+	return user.ToDomain(), nil
+}
+
+// List users matching the given filters.
+// fromUserID is the last user retrieved in the last page.
+// limit is the number of users per page.
+func (ur *UsersRepo) List(ctx context.Context, filters domain.UserFilters, fromUserID string, limit uint16) ([]domain.User, error) {
+	var users []domain.User
+
+	conditions, args := userFiltersConditions(filters, 2)
+	query := fmt.Sprintf("SELECT * FROM users WHERE id > $1%s ORDER BY id ASC LIMIT $%d", conditions, len(args)+2)
+	args = append([]interface{}{fromUserID}, append(args, limit)...)
+
+	rows, err := ur.GetDBHandle().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Builds the SQL conditions for the non empty filters. Placeholders are
+// numbered starting from firstPlaceholder. The search is a prefix match over
+// lowercased columns so it can use the lower() indexes of the users table.
+func userFiltersConditions(filters domain.UserFilters, firstPlaceholder int) (string, []interface{}) {
+	var conditions strings.Builder
+	var args []interface{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions.WriteString(" AND ")
+		conditions.WriteString(strings.ReplaceAll(condition, "$?", fmt.Sprintf("$%d", firstPlaceholder+len(args)-1)))
+	}
+
+	if filters.Type != "" {
+		addCondition("type=$?", filters.Type)
+	}
+	if filters.Status != "" {
+		addCondition("status=$?", filters.Status)
+	}
+	if filters.Search != "" {
+		addCondition("(lower(email) LIKE $? OR lower(first_name) LIKE $? OR lower(last_name) LIKE $? OR lower(first_name || ' ' || last_name) LIKE $?)",
+			escapeLike(strings.ToLower(filters.Search))+"%")
+	}
+
+	return conditions.String(), args
+}
+
+// Scans a row of the users table following the order of its columns
+func scanUser(row scanner) (user models.User, err error) {
+	err = row.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.Type, &user.Status, &user.EmailVerifiedAt,
+		&user.DateOfBirth, &user.DriverLicenseNumber, &user.DriverLicenseCountry, &user.DriverLicenseIssueDate, &user.DriverLicenseExpiryDate)
+
+	return user, err
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
@@ -15,6 +16,8 @@ import (
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 )
 
+var usersColumns = []string{"id", "first_name", "last_name", "email", "type", "status", "email_verified_at", "date_of_birth", "driver_license_number", "driver_license_country", "driver_license_issue_date", "driver_license_expiry_date"}
+
 type usersDependencies struct {
 	db *mocks.MockDatabase
 }
@@ -65,7 +68,7 @@ func TestUsersInsert(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status).
+					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil).
 					WillReturnError(&pq.Error{Code: "23505", Message: ".* unique_email .*"})
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -88,7 +91,7 @@ func TestUsersInsert(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status).
+					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil).
 					WillReturnError(errors.New("exec context"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -112,7 +115,7 @@ func TestUsersInsert(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 1)
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status).
+					WithArgs(du.ID, du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -151,6 +154,15 @@ func TestUsersGet(t *testing.T) {
 		Type:      "Customer",
 		Status:    "Active",
 	}
+	dateOfBirth := time.Date(1990, time.May, 11, 0, 0, 0, 0, time.UTC)
+	licensedUser := du
+	licensedUser.DateOfBirth = &dateOfBirth
+	licensedUser.DriverLicense = &domain.DriverLicense{
+		Number:     "F1918M",
+		Country:    "US",
+		IssueDate:  time.Date(2008, time.June, 1, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2030, time.June, 1, 0, 0, 0, 0, time.UTC),
+	}
 
 	type args struct {
 		ctx context.Context
@@ -233,8 +245,36 @@ func TestUsersGet(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
-				rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "type", "status"}).
-					AddRow(userIdByte, du.FirstName, du.LastName, du.Email, du.Type, du.Status)
+				rows := sqlmock.NewRows(usersColumns).
+					AddRow(userIdByte, du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil)
+				mock.ExpectQuery(`SELECT \* FROM users WHERE ID = \$1`).
+					WithArgs(du.ID).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns user with their driver license",
+			args: args{
+				ctx: context.TODO(),
+				id:  du.ID,
+			},
+			wants: wants{
+				user: licensedUser,
+				err:  nil,
+			},
+			setMocks: func(d *usersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(usersColumns).
+					AddRow(du.ID.String(), du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil,
+						*licensedUser.DateOfBirth, licensedUser.DriverLicense.Number, licensedUser.DriverLicense.Country,
+						licensedUser.DriverLicense.IssueDate, licensedUser.DriverLicense.ExpiryDate)
 				mock.ExpectQuery(`SELECT \* FROM users WHERE ID = \$1`).
 					WithArgs(du.ID).
 					WillReturnRows(rows)
@@ -305,7 +345,7 @@ func TestUsersFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("UPDATE users SET").
-					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, du.ID).
+					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, du.ID).
 					WillReturnError(&pq.Error{Code: "23505", Message: ".* unique_email .*"})
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -328,7 +368,7 @@ func TestUsersFullUpdate(t *testing.T) {
 					t.Fatal(err)
 				}
 				mock.ExpectExec("UPDATE users SET").
-					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, du.ID).
+					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, du.ID).
 					WillReturnError(errors.New("exec context"))
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -352,7 +392,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewErrorResult(errors.New("rows affected error"))
 				mock.ExpectExec("UPDATE users SET").
-					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, du.ID).
+					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, du.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -376,7 +416,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 0)
 				mock.ExpectExec("UPDATE users SET").
-					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, du.ID).
+					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, du.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -400,7 +440,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				}
 				result := sqlmock.NewResult(0, 1)
 				mock.ExpectExec("UPDATE users SET").
-					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, du.ID).
+					WithArgs(du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, du.ID).
 					WillReturnResult(result)
 
 				d.db.EXPECT().GetDBHandle().Return(dbHandle)
@@ -560,3 +600,126 @@ func TestUsersDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersList(t *testing.T) {
+	initConstantsFromRepository(t)
+
+	du := domain.User{
+		ID:        uuid.New(),
+		FirstName: "Richard",
+		LastName:  "Feynman",
+		Email:     "richard.feynman@caltech.edu.us",
+		Type:      "Customer",
+		Status:    "Active",
+	}
+	nullUUID := "00000000-0000-0000-0000-000000000000"
+
+	type args struct {
+		filters    domain.UserFilters
+		fromUserID string
+	}
+	type wants struct {
+		users []domain.User
+		err   error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*usersDependencies) *sql.DB
+	}{
+		{
+			name: "returns users when no filters are given",
+			args: args{
+				filters:    domain.UserFilters{},
+				fromUserID: nullUUID,
+			},
+			wants: wants{
+				users: []domain.User{du},
+				err:   nil,
+			},
+			setMocks: func(d *usersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(usersColumns).
+					AddRow(du.ID.String(), du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil)
+				mock.ExpectQuery(`^SELECT \* FROM users WHERE id > \$1 ORDER BY id ASC LIMIT \$2$`).
+					WithArgs(nullUUID, 20).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "filters by type, status and a lowercased escaped prefix",
+			args: args{
+				filters:    domain.UserFilters{Type: "Customer", Status: "Active", Search: "Rich_"},
+				fromUserID: nullUUID,
+			},
+			wants: wants{
+				users: []domain.User{du},
+				err:   nil,
+			},
+			setMocks: func(d *usersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := sqlmock.NewRows(usersColumns).
+					AddRow(du.ID.String(), du.FirstName, du.LastName, du.Email, du.Type, du.Status, nil, nil, nil, nil, nil, nil)
+				mock.ExpectQuery(`^SELECT \* FROM users WHERE id > \$1 AND type=\$2 AND status=\$3 AND \(lower\(email\) LIKE \$4 OR lower\(first_name\) LIKE \$4 OR lower\(last_name\) LIKE \$4 OR lower\(first_name \|\| ' ' \|\| last_name\) LIKE \$4\) ORDER BY id ASC LIMIT \$5$`).
+					WithArgs(nullUUID, "Customer", "Active", `rich\_%`, 20).
+					WillReturnRows(rows)
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when query context fails",
+			args: args{
+				filters:    domain.UserFilters{},
+				fromUserID: nullUUID,
+			},
+			wants: wants{
+				users: nil,
+				err:   errors.New("query context error"),
+			},
+			setMocks: func(d *usersDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectQuery(`^SELECT \* FROM users WHERE id > \$1 ORDER BY id ASC LIMIT \$2$`).
+					WillReturnError(errors.New("query context error"))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewUsersDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			usersRepo := NewUsersRepository(db)
+			users, err := usersRepo.List(context.TODO(), test.args.filters, test.args.fromUserID, 20)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.users, users)
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driven_adapters/repositories/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type UserTokensRepo struct {
+	ports.Database
+}
+
+func NewUserTokensRepository(db ports.Database) *UserTokensRepo {
+	return &UserTokensRepo{
+		Database: db,
+	}
+}
+
+func (utr *UserTokensRepo) Insert(ctx context.Context, dut domain.UserToken) (err error) {
+	token := models.LoadUserTokenFromDomain(dut)
+
+	_, err = utr.GetDBHandle().ExecContext(ctx, "INSERT INTO user_tokens (id, user_id, purpose, expires_at, used_at) VALUES ($1, $2, $3, $4, $5)",
+		token.ID, token.UserID, token.Purpose, token.ExpiresAt, token.UsedAt)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+		return errors.New(services.ErrUserNotFound)
+	}
+
+	return err
+}
+
+func (utr *UserTokensRepo) Get(ctx context.Context, ID uuid.UUID) (dut domain.UserToken, err error) {
+	var token models.UserToken
+	if err := utr.GetDBHandle().QueryRowContext(ctx, "SELECT * FROM user_tokens WHERE id = $1", ID).
+		Scan(&token.ID, &token.UserID, &token.Purpose, &token.ExpiresAt, &token.UsedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.UserToken{}, errors.New(services.ErrInvalidToken)
+		}
+		return domain.UserToken{}, err
+	}
+
+	return token.ToDomain(), nil
+}
+
+// Redeems an email verification token and marks the email of its user as
+// verified at the time the token was used.
+func (utr *UserTokensRepo) VerifyEmail(ctx context.Context, dut domain.UserToken) (err error) {
+	token := models.LoadUserTokenFromDomain(dut)
+
+	tx, err := utr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := useToken(ctx, tx, token); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "UPDATE users SET email_verified_at=$1 WHERE id=$2", token.UsedAt, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrUserNotFound)
+	}
+
+	return tx.Commit()
+}
+
+// Redeems a password reset token and sets the password hash of its user
+func (utr *UserTokensRepo) ResetPassword(ctx context.Context, dut domain.UserToken, passwordHash string) (err error) {
+	token := models.LoadUserTokenFromDomain(dut)
+
+	tx, err := utr.GetDBHandle().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := useToken(ctx, tx, token); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO user_credentials (user_id, password_hash) VALUES ($1, $2) ON CONFLICT (user_id) DO UPDATE SET password_hash=EXCLUDED.password_hash",
+		token.UserID, passwordHash)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return errors.New(services.ErrUserNotFound)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Marks the token as used. Fails if it was already used or has expired, so
+// concurrent redemptions of the same token can not both succeed.
+func useToken(ctx context.Context, tx *sql.Tx, token models.UserToken) error {
+	result, err := tx.ExecContext(ctx, "UPDATE user_tokens SET used_at=$1 WHERE id=$2 AND used_at IS NULL AND expires_at > $1", token.UsedAt, token.ID)
+	if err != nil {
+		return err
+	}
+
+	numUpdatedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numUpdatedRows == 0 {
+		return errors.New(services.ErrInvalidToken)
+	}
+
+	return nil
+}
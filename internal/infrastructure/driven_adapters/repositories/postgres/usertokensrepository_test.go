@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+type userTokensDependencies struct {
+	db *mocks.MockDatabase
+}
+
+func NewUserTokensDependencies(db *mocks.MockDatabase) *userTokensDependencies {
+	return &userTokensDependencies{
+		db: db,
+	}
+}
+
+func TestUserTokensInsert(t *testing.T) {
+	dut := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   "Email Verification",
+		ExpiresAt: time.Now().Add(48 * time.Hour),
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*userTokensDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when token was inserted",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO user_tokens").
+					WithArgs(dut.ID, dut.UserID, dut.Purpose, dut.ExpiresAt, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error when user was not found",
+			wants: wants{
+				err: errors.New(services.ErrUserNotFound),
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectExec("INSERT INTO user_tokens").
+					WillReturnError(&pq.Error{Code: "23503", Message: `insert or update on table "user_tokens" violates foreign key constraint "user_tokens_user_id_fkey"`})
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewUserTokensDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			userTokensRepo := NewUserTokensRepository(db)
+			err := userTokensRepo.Insert(context.TODO(), dut)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestUserTokensVerifyEmail(t *testing.T) {
+	usedAt := time.Now()
+	dut := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   "Email Verification",
+		ExpiresAt: usedAt.Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*userTokensDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when token was used and email verified",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE user_tokens SET used_at=\$1 WHERE id=\$2 AND used_at IS NULL AND expires_at > \$1`).
+					WithArgs(usedAt, dut.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE users SET email_verified_at").
+					WithArgs(usedAt, dut.UserID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when token was already used",
+			wants: wants{
+				err: errors.New(services.ErrInvalidToken),
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE user_tokens SET used_at").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when user was removed",
+			wants: wants{
+				err: errors.New(services.ErrUserNotFound),
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE user_tokens SET used_at").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE users SET email_verified_at").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewUserTokensDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			userTokensRepo := NewUserTokensRepository(db)
+			err := userTokensRepo.VerifyEmail(context.TODO(), dut)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestUserTokensResetPassword(t *testing.T) {
+	usedAt := time.Now()
+	dut := domain.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   "Password Reset",
+		ExpiresAt: usedAt.Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+	passwordHash := "pbkdf2-sha256$210000$c2FsdA$a2V5"
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*userTokensDependencies) *sql.DB
+	}{
+		{
+			name: "returns nil error when token was used and password stored",
+			wants: wants{
+				err: nil,
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE user_tokens SET used_at").
+					WithArgs(usedAt, dut.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO user_credentials \(user_id, password_hash\) VALUES \(\$1, \$2\) ON CONFLICT \(user_id\) DO UPDATE`).
+					WithArgs(dut.UserID, passwordHash).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+		{
+			name: "returns error and rolls back when token has expired",
+			wants: wants{
+				err: errors.New(services.ErrInvalidToken),
+			},
+			setMocks: func(d *userTokensDependencies) *sql.DB {
+				dbHandle, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatal(err)
+				}
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE user_tokens SET used_at").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				d.db.EXPECT().GetDBHandle().Return(dbHandle)
+
+				return dbHandle
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			db := mocks.NewMockDatabase(mockCtlr)
+			d := NewUserTokensDependencies(db)
+			dbHandle := test.setMocks(d)
+
+			userTokensRepo := NewUserTokensRepository(db)
+			err := userTokensRepo.ResetPassword(context.TODO(), dut, passwordHash)
+
+			if dbHandle != nil {
+				dbHandle.Close()
+			}
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
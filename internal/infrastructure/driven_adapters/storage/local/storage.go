@@ -0,0 +1,110 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrInvalidKey     = "invalid storage key"
+	ErrObjectNotFound = "stored object not found"
+)
+
+// Storage keeps objects as files inside a root directory of the local file system
+type Storage struct {
+	root string
+}
+
+func NewStorage(root string) (*Storage, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Storage{
+		root: root,
+	}, nil
+}
+
+// Saves the content under the key. The file is written to a temporary
+// location first, so readers never see partially written objects.
+func (s *Storage) Save(ctx context.Context, key string, content io.Reader) (err error) {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(file.Name())
+		}
+	}()
+
+	if _, err = io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (s *Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New(ErrObjectNotFound)
+	}
+
+	return file, err
+}
+
+func (s *Storage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Gets the path of the file for a key, rejecting keys that point outside the root
+func (s *Storage) path(key string) (string, error) {
+	if key == "" || filepath.IsAbs(key) || strings.Contains(key, "\\") {
+		return "", errors.New(ErrInvalidKey)
+	}
+
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.root+string(filepath.Separator)) {
+		return "", errors.New(ErrInvalidKey)
+	}
+
+	return path, nil
+}
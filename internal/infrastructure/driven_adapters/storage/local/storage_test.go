@@ -0,0 +1,51 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	ctx := context.TODO()
+	storage, err := NewStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("saves, opens and deletes an object", func(t *testing.T) {
+		key := "damage-reports/report/photo.jpg"
+		if err := storage.Save(ctx, key, bytes.NewBufferString("content")); err != nil {
+			t.Fatal(err)
+		}
+
+		file, err := storage.Open(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(file)
+		file.Close()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "content", string(content))
+
+		assert.Nil(t, storage.Delete(ctx, key))
+		_, err = storage.Open(ctx, key)
+		assert.Equal(t, errors.New(ErrObjectNotFound), err)
+	})
+
+	t.Run("returns nil error when deleting an object that does not exist", func(t *testing.T) {
+		assert.Nil(t, storage.Delete(ctx, "missing.jpg"))
+	})
+
+	t.Run("rejects keys outside of the root directory", func(t *testing.T) {
+		for _, key := range []string{"", "../outside.jpg", "damage-reports/../../outside.jpg", "/etc/passwd"} {
+			err := storage.Save(ctx, key, bytes.NewBufferString("content"))
+			assert.Equal(t, errors.New(ErrInvalidKey), err, key)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyAddOnBranch     = "add-on branch id cannot be empty"
+	ErrEmptyAddOnName       = "add-on name cannot be empty"
+	ErrAddOnNameTooLong     = fmt.Sprintf("add-on name cannot be longer than %d characters", maximumAddOnNameLength)
+	ErrInvalidAddOnStock    = "add-on stock cannot be negative"
+	ErrInvalidAddOnPrice    = "add-on price cannot be negative"
+	ErrInvalidAddOnPricing  = "invalid add-on pricing unit"
+	ErrInvalidAddOnQuantity = "add-on quantity must be positive"
+)
+
+const (
+	// Maximum length of the name of an add-on
+	maximumAddOnNameLength = 100
+)
+
+type AddOns struct {
+	AddOns []AddOn `json:"add_ons"`
+}
+
+type AddOn struct {
+	ID          uuid.UUID `json:"id"`
+	BranchID    uuid.UUID `json:"branch_id"`
+	Name        string    `json:"name"`
+	Stock       int       `json:"stock"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	PricingUnit string    `json:"pricing_unit"`
+}
+
+// Add-on selected for a reservation. Its cost is not taken from the request,
+// it is set when the reservation is booked.
+type ReservationAddOn struct {
+	AddOnID  uuid.UUID `json:"add_on_id"`
+	Quantity int       `json:"quantity"`
+	Cost     float64   `json:"cost"`
+}
+
+func (ao AddOn) ToDomain() domain.AddOn {
+	return domain.AddOn{
+		ID:          ao.ID,
+		BranchID:    ao.BranchID,
+		Name:        ao.Name,
+		Stock:       ao.Stock,
+		Price:       ao.Price,
+		Currency:    ao.Currency,
+		PricingUnit: ao.PricingUnit,
+	}
+}
+
+func (ao *AddOn) FromDomain(dao domain.AddOn) {
+	ao.ID = dao.ID
+	ao.BranchID = dao.BranchID
+	ao.Name = dao.Name
+	ao.Stock = dao.Stock
+	ao.Price = dao.Price
+	ao.Currency = dao.Currency
+	ao.PricingUnit = dao.PricingUnit
+}
+
+func AddOnFromBody(body io.Reader) (AddOn, error) {
+	var addOn AddOn
+	err := json.NewDecoder(body).Decode(&addOn)
+	if err != nil {
+		return AddOn{}, err
+	}
+
+	if addOn.BranchID == uuid.Nil {
+		return AddOn{}, errors.New(ErrEmptyAddOnBranch)
+	}
+
+	if addOn.Name = strings.TrimSpace(addOn.Name); addOn.Name == "" {
+		return AddOn{}, errors.New(ErrEmptyAddOnName)
+	}
+	if len([]rune(addOn.Name)) > maximumAddOnNameLength {
+		return AddOn{}, errors.New(ErrAddOnNameTooLong)
+	}
+
+	if addOn.Stock < 0 {
+		return AddOn{}, errors.New(ErrInvalidAddOnStock)
+	}
+	if addOn.Price < 0 {
+		return AddOn{}, errors.New(ErrInvalidAddOnPrice)
+	}
+
+	addOn.Currency = strings.ToUpper(strings.TrimSpace(addOn.Currency))
+	if !IsValidCurrency(addOn.Currency) {
+		return AddOn{}, errors.New(ErrInvalidCurrency)
+	}
+
+	if !utils.IsInSlice(constants.Values().ADD_ON_PRICING_UNITS.Values(), addOn.PricingUnit) {
+		return AddOn{}, errors.New(ErrInvalidAddOnPricing)
+	}
+
+	return addOn, nil
+}
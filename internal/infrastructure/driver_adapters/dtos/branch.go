@@ -0,0 +1,215 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyBranchName      = "branch name cannot be empty"
+	ErrBranchNameTooLong    = fmt.Sprintf("branch name cannot be longer than %d characters", maximumBranchNameLength)
+	ErrEmptyBranchAddress   = "branch address cannot be empty"
+	ErrBranchAddressTooLong = fmt.Sprintf("branch address cannot be longer than %d characters", maximumBranchAddressLength)
+	ErrEmptyBranchCity      = "branch city id cannot be empty"
+	ErrInvalidLatitude      = "latitude must be between -90 and 90"
+	ErrInvalidLongitude     = "longitude must be between -180 and 180"
+	ErrInvalidWeekday       = "weekday must be between 0 (Sunday) and 6 (Saturday)"
+	ErrRepeatedWeekday      = "opening hours cannot be repeated for a weekday"
+	ErrInvalidOpeningTime   = "opening hours must be in HH:MM format"
+	ErrInvalidOpeningHours  = "closing time must be after opening time"
+	ErrInvalidClosureDate   = "closure date must be in YYYY-MM-DD format"
+	ErrRepeatedClosureDate  = "closure dates cannot be repeated"
+	ErrClosureReasonTooLong = fmt.Sprintf("closure reason cannot be longer than %d characters", maximumClosureReasonLength)
+	clockTimePattern        = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+)
+
+const (
+	// Maximum lengths of the texts stored for a branch
+	maximumBranchNameLength    = 100
+	maximumBranchAddressLength = 255
+	maximumClosureReasonLength = 255
+)
+
+type Branches struct {
+	Branches []Branch `json:"branches"`
+}
+
+type Branch struct {
+	ID           uuid.UUID       `json:"id"`
+	CityID       uuid.UUID       `json:"city_id"`
+	Name         string          `json:"name"`
+	Address      string          `json:"address"`
+	Latitude     float64         `json:"latitude"`
+	Longitude    float64         `json:"longitude"`
+	OpeningHours []OpeningHours  `json:"opening_hours"`
+	Closures     []BranchClosure `json:"closures"`
+}
+
+type OpeningHours struct {
+	Weekday  time.Weekday `json:"weekday"`
+	OpensAt  string       `json:"opens_at"`
+	ClosesAt string       `json:"closes_at"`
+}
+
+type BranchClosure struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+func (b Branch) ToDomain() domain.Branch {
+	openingHours := make([]domain.OpeningHours, 0, len(b.OpeningHours))
+	for _, hours := range b.OpeningHours {
+		openingHours = append(openingHours, domain.OpeningHours{
+			Weekday:  hours.Weekday,
+			OpensAt:  hours.OpensAt,
+			ClosesAt: hours.ClosesAt,
+		})
+	}
+
+	closures := make([]domain.BranchClosure, 0, len(b.Closures))
+	for _, closure := range b.Closures {
+		closures = append(closures, domain.BranchClosure{
+			Date:   closure.Date,
+			Reason: closure.Reason,
+		})
+	}
+
+	return domain.Branch{
+		ID:           b.ID,
+		CityID:       b.CityID,
+		Name:         b.Name,
+		Address:      b.Address,
+		Latitude:     b.Latitude,
+		Longitude:    b.Longitude,
+		OpeningHours: openingHours,
+		Closures:     closures,
+	}
+}
+
+func (b *Branch) FromDomain(db domain.Branch) {
+	b.ID = db.ID
+	b.CityID = db.CityID
+	b.Name = db.Name
+	b.Address = db.Address
+	b.Latitude = db.Latitude
+	b.Longitude = db.Longitude
+
+	b.OpeningHours = make([]OpeningHours, 0, len(db.OpeningHours))
+	for _, hours := range db.OpeningHours {
+		b.OpeningHours = append(b.OpeningHours, OpeningHours{
+			Weekday:  hours.Weekday,
+			OpensAt:  hours.OpensAt,
+			ClosesAt: hours.ClosesAt,
+		})
+	}
+
+	b.Closures = make([]BranchClosure, 0, len(db.Closures))
+	for _, closure := range db.Closures {
+		b.Closures = append(b.Closures, BranchClosure{
+			Date:   closure.Date,
+			Reason: closure.Reason,
+		})
+	}
+}
+
+// Opening hours and closures are required to be consistent: one entry per weekday,
+// closing after opening, and one closure per date.
+func BranchFromBody(body io.Reader) (Branch, error) {
+	var branch Branch
+	err := json.NewDecoder(body).Decode(&branch)
+	if err != nil {
+		return Branch{}, err
+	}
+
+	if branch.CityID == uuid.Nil {
+		return Branch{}, errors.New(ErrEmptyBranchCity)
+	}
+
+	if branch.Name = strings.TrimSpace(branch.Name); branch.Name == "" {
+		return Branch{}, errors.New(ErrEmptyBranchName)
+	}
+	if len([]rune(branch.Name)) > maximumBranchNameLength {
+		return Branch{}, errors.New(ErrBranchNameTooLong)
+	}
+
+	if branch.Address = strings.TrimSpace(branch.Address); branch.Address == "" {
+		return Branch{}, errors.New(ErrEmptyBranchAddress)
+	}
+	if len([]rune(branch.Address)) > maximumBranchAddressLength {
+		return Branch{}, errors.New(ErrBranchAddressTooLong)
+	}
+
+	if branch.Latitude < -90 || branch.Latitude > 90 {
+		return Branch{}, errors.New(ErrInvalidLatitude)
+	}
+	if branch.Longitude < -180 || branch.Longitude > 180 {
+		return Branch{}, errors.New(ErrInvalidLongitude)
+	}
+
+	if branch.OpeningHours == nil {
+		branch.OpeningHours = []OpeningHours{}
+	}
+	if err := validateOpeningHours(branch.OpeningHours); err != nil {
+		return Branch{}, err
+	}
+
+	if branch.Closures == nil {
+		branch.Closures = []BranchClosure{}
+	}
+	if err := validateClosures(branch.Closures); err != nil {
+		return Branch{}, err
+	}
+
+	return branch, nil
+}
+
+func validateOpeningHours(openingHours []OpeningHours) error {
+	seen := make(map[time.Weekday]bool, len(openingHours))
+	for _, hours := range openingHours {
+		if hours.Weekday < time.Sunday || hours.Weekday > time.Saturday {
+			return errors.New(ErrInvalidWeekday)
+		}
+		if seen[hours.Weekday] {
+			return errors.New(ErrRepeatedWeekday)
+		}
+		seen[hours.Weekday] = true
+
+		if !clockTimePattern.MatchString(hours.OpensAt) || !clockTimePattern.MatchString(hours.ClosesAt) {
+			return errors.New(ErrInvalidOpeningTime)
+		}
+		// zero padded clock times are ordered as strings
+		if hours.ClosesAt <= hours.OpensAt {
+			return errors.New(ErrInvalidOpeningHours)
+		}
+	}
+
+	return nil
+}
+
+func validateClosures(closures []BranchClosure) error {
+	seen := make(map[string]bool, len(closures))
+	for i, closure := range closures {
+		if _, err := time.Parse("2006-01-02", closure.Date); err != nil {
+			return errors.New(ErrInvalidClosureDate)
+		}
+		if seen[closure.Date] {
+			return errors.New(ErrRepeatedClosureDate)
+		}
+		seen[closure.Date] = true
+
+		closures[i].Reason = strings.TrimSpace(closure.Reason)
+		if len([]rune(closures[i].Reason)) > maximumClosureReasonLength {
+			return errors.New(ErrClosureReasonTooLong)
+		}
+	}
+
+	return nil
+}
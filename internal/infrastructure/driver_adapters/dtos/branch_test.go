@@ -0,0 +1,122 @@
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchFromBody(t *testing.T) {
+	validBranch := func() Branch {
+		return Branch{
+			CityID:    uuid.New(),
+			Name:      "Chicago Downtown",
+			Address:   "55 W Randolph St, Chicago, IL 60601",
+			Latitude:  41.8837,
+			Longitude: -87.6289,
+			OpeningHours: []OpeningHours{
+				{Weekday: time.Monday, OpensAt: "07:00", ClosesAt: "21:00"},
+				{Weekday: time.Saturday, OpensAt: "08:00", ClosesAt: "18:00"},
+			},
+			Closures: []BranchClosure{
+				{Date: "2030-12-25", Reason: "Christmas Day"},
+			},
+		}
+	}
+
+	type args struct {
+		modify func(*Branch)
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name:  "returns nil error when body structure is as expected",
+			args:  args{modify: func(b *Branch) {}},
+			wants: wants{err: nil},
+		},
+		{
+			name:  "returns an error when city id is missing",
+			args:  args{modify: func(b *Branch) { b.CityID = uuid.Nil }},
+			wants: wants{err: errors.New(ErrEmptyBranchCity)},
+		},
+		{
+			name:  "returns an error when name is blank",
+			args:  args{modify: func(b *Branch) { b.Name = "  " }},
+			wants: wants{err: errors.New(ErrEmptyBranchName)},
+		},
+		{
+			name:  "returns an error when address is blank",
+			args:  args{modify: func(b *Branch) { b.Address = "" }},
+			wants: wants{err: errors.New(ErrEmptyBranchAddress)},
+		},
+		{
+			name:  "returns an error when latitude is out of range",
+			args:  args{modify: func(b *Branch) { b.Latitude = 91 }},
+			wants: wants{err: errors.New(ErrInvalidLatitude)},
+		},
+		{
+			name:  "returns an error when longitude is out of range",
+			args:  args{modify: func(b *Branch) { b.Longitude = -180.5 }},
+			wants: wants{err: errors.New(ErrInvalidLongitude)},
+		},
+		{
+			name:  "returns an error when weekday is out of range",
+			args:  args{modify: func(b *Branch) { b.OpeningHours[0].Weekday = 7 }},
+			wants: wants{err: errors.New(ErrInvalidWeekday)},
+		},
+		{
+			name:  "returns an error when a weekday is repeated",
+			args:  args{modify: func(b *Branch) { b.OpeningHours[1].Weekday = time.Monday }},
+			wants: wants{err: errors.New(ErrRepeatedWeekday)},
+		},
+		{
+			name:  "returns an error when opening time is not in HH:MM format",
+			args:  args{modify: func(b *Branch) { b.OpeningHours[0].OpensAt = "7:00" }},
+			wants: wants{err: errors.New(ErrInvalidOpeningTime)},
+		},
+		{
+			name:  "returns an error when closing time is not after opening time",
+			args:  args{modify: func(b *Branch) { b.OpeningHours[0].ClosesAt = "07:00" }},
+			wants: wants{err: errors.New(ErrInvalidOpeningHours)},
+		},
+		{
+			name:  "returns an error when closure date is not valid",
+			args:  args{modify: func(b *Branch) { b.Closures[0].Date = "2030-02-30" }},
+			wants: wants{err: errors.New(ErrInvalidClosureDate)},
+		},
+		{
+			name: "returns an error when a closure date is repeated",
+			args: args{modify: func(b *Branch) {
+				b.Closures = append(b.Closures, BranchClosure{Date: "2030-12-25"})
+			}},
+			wants: wants{err: errors.New(ErrRepeatedClosureDate)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			branch := validBranch()
+			test.args.modify(&branch)
+
+			branchJSON, err := json.Marshal(branch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = BranchFromBody(bytes.NewBuffer(branchJSON))
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
@@ -3,7 +3,13 @@ package dtos
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
@@ -17,19 +23,83 @@ var (
 	ErrEmptyCity             = "city name cannot be empty"
 	ErrInvalidCarType        = "invalid car type"
 	ErrInvalidCarStatus      = "invalid car status"
+	ErrEmptyMake             = "make cannot be empty"
+	ErrEmptyModel            = "model cannot be empty"
+	ErrInvalidYear           = "invalid model year"
+	ErrInvalidLicensePlate   = "invalid license plate"
+	ErrInvalidVIN            = "invalid vin"
+	ErrInvalidTransmission   = "invalid transmission"
+	ErrInvalidFuelType       = "invalid fuel type"
+	ErrEmptyColor            = "color cannot be empty"
+	ErrInvalidCarFeature     = "invalid car feature"
+	ErrRepeatedCarFeature    = "car features cannot be repeated"
+	ErrInvalidSearchRadiusKm = "radius_km must be a number"
+	CarDeactivationWarning   = "the unavailable car has upcoming reservations"
 )
 
+// Oldest model year accepted for a car
+const minimumModelYear = 1900
+
 type ListCarsResponse struct {
 	Cars []Car `json:"cars"`
 }
 
+// Car along with the upcoming reservations affected by it being unavailable
+type CarWithAffectedReservations struct {
+	Car
+	AffectedReservations []Reservation `json:"affected_reservations"`
+	Warning              string        `json:"warning,omitempty"`
+}
+
+type ListNearbyCarsResponse struct {
+	Cars []NearbyCar `json:"cars"`
+}
+
+type NearbyCar struct {
+	Car
+	PickupBranch PickupBranch `json:"pickup_branch"`
+	DistanceKm   float64      `json:"distance_km"`
+}
+
+type PickupBranch struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+}
+
+func (nc *NearbyCar) FromDomain(dnc domain.NearbyCar) {
+	nc.Car.FromDomain(dnc.Car)
+	nc.PickupBranch = PickupBranch{
+		ID:        dnc.PickupBranch.ID,
+		Name:      dnc.PickupBranch.Name,
+		Address:   dnc.PickupBranch.Address,
+		Latitude:  dnc.PickupBranch.Latitude,
+		Longitude: dnc.PickupBranch.Longitude,
+	}
+	// distances are shown with meter precision
+	nc.DistanceKm = math.Round(dnc.DistanceKm*1000) / 1000
+}
+
 type Car struct {
-	ID             uuid.UUID `json:"id,omitempty"`
-	Type           string    `json:"type"`
-	Seats          int16     `json:"seats"`
-	HourlyRentCost float64   `json:"hourly_rent_cost"`
-	CityName       string    `json:"city_name"`
-	Status         string    `json:"status"`
+	ID             uuid.UUID  `json:"id,omitempty"`
+	Type           string     `json:"type"`
+	Seats          int16      `json:"seats"`
+	HourlyRentCost float64    `json:"hourly_rent_cost"`
+	Currency       string     `json:"currency"`
+	CityName       string     `json:"city_name"`
+	Status         string     `json:"status"`
+	Make           string     `json:"make"`
+	Model          string     `json:"model"`
+	Year           int16      `json:"year"`
+	LicensePlate   string     `json:"license_plate"`
+	VIN            string     `json:"vin"`
+	Transmission   string     `json:"transmission"`
+	FuelType       string     `json:"fuel_type"`
+	Color          string     `json:"color"`
+	Features       []string   `json:"features"`
+	BranchID       *uuid.UUID `json:"branch_id,omitempty"`
 }
 
 func (c Car) ToDomain() domain.Car {
@@ -38,8 +108,19 @@ func (c Car) ToDomain() domain.Car {
 		Type:           c.Type,
 		Seats:          c.Seats,
 		HourlyRentCost: c.HourlyRentCost,
+		Currency:       c.Currency,
 		CityName:       c.CityName,
 		Status:         c.Status,
+		Make:           c.Make,
+		Model:          c.Model,
+		Year:           c.Year,
+		LicensePlate:   c.LicensePlate,
+		VIN:            c.VIN,
+		Transmission:   c.Transmission,
+		FuelType:       c.FuelType,
+		Color:          c.Color,
+		Features:       c.Features,
+		BranchID:       c.BranchID,
 	}
 }
 
@@ -48,8 +129,19 @@ func (c *Car) FromDomain(dc domain.Car) {
 	c.Type = dc.Type
 	c.Seats = dc.Seats
 	c.HourlyRentCost = dc.HourlyRentCost
+	c.Currency = dc.Currency
 	c.CityName = dc.CityName
 	c.Status = dc.Status
+	c.Make = dc.Make
+	c.Model = dc.Model
+	c.Year = dc.Year
+	c.LicensePlate = dc.LicensePlate
+	c.VIN = dc.VIN
+	c.Transmission = dc.Transmission
+	c.FuelType = dc.FuelType
+	c.Color = dc.Color
+	c.Features = dc.Features
+	c.BranchID = dc.BranchID
 }
 
 func CarFromBody(body io.Reader) (Car, error) {
@@ -67,11 +159,16 @@ func CarFromBody(body io.Reader) (Car, error) {
 		return Car{}, errors.New(ErrInvalidHourlyRentCost)
 	}
 
+	car.Currency = strings.ToUpper(strings.TrimSpace(car.Currency))
+	if !IsValidCurrency(car.Currency) {
+		return Car{}, errors.New(ErrInvalidCurrency)
+	}
+
 	if car.CityName == "" {
 		return Car{}, errors.New(ErrEmptyCity)
 	}
 
-	if !isValidCarType(car.Type) {
+	if !IsValidCarType(car.Type) {
 		return Car{}, errors.New(ErrInvalidCarType)
 	}
 
@@ -79,17 +176,172 @@ func CarFromBody(body io.Reader) (Car, error) {
 		return Car{}, errors.New(ErrInvalidCarStatus)
 	}
 
+	if car.Make = strings.TrimSpace(car.Make); car.Make == "" {
+		return Car{}, errors.New(ErrEmptyMake)
+	}
+
+	if car.Model = strings.TrimSpace(car.Model); car.Model == "" {
+		return Car{}, errors.New(ErrEmptyModel)
+	}
+
+	if car.Year < minimumModelYear || int(car.Year) > time.Now().Year()+1 {
+		return Car{}, errors.New(ErrInvalidYear)
+	}
+
+	car.LicensePlate = strings.ToUpper(strings.TrimSpace(car.LicensePlate))
+	if !utils.IsValidLicensePlate(car.LicensePlate) {
+		return Car{}, errors.New(ErrInvalidLicensePlate)
+	}
+
+	car.VIN = strings.ToUpper(strings.TrimSpace(car.VIN))
+	if !utils.IsValidVIN(car.VIN) {
+		return Car{}, errors.New(ErrInvalidVIN)
+	}
+
+	if !isValidTransmission(car.Transmission) {
+		return Car{}, errors.New(ErrInvalidTransmission)
+	}
+
+	if !isValidFuelType(car.FuelType) {
+		return Car{}, errors.New(ErrInvalidFuelType)
+	}
+
+	if car.Color = strings.TrimSpace(car.Color); car.Color == "" {
+		return Car{}, errors.New(ErrEmptyColor)
+	}
+
+	if car.Features == nil {
+		car.Features = []string{}
+	}
+	if err := validateCarFeatures(car.Features); err != nil {
+		return Car{}, err
+	}
+
 	return car, nil
 }
 
-func isValidCarType(carType string) bool {
-	carTypes := constants.Values.CAR_TYPES.Values()
+// Builds the response for an updated car, warning about the affected reservations if any
+func NewCarWithAffectedReservations(dc domain.Car, affectedReservations []domain.Reservation) CarWithAffectedReservations {
+	var response CarWithAffectedReservations
+
+	response.FromDomain(dc)
+	response.AffectedReservations = reservationsFromDomain(affectedReservations)
+	if len(response.AffectedReservations) > 0 {
+		response.Warning = CarDeactivationWarning
+	}
+
+	return response
+}
+
+func IsValidCarType(carType string) bool {
+	carTypes := constants.Values().CAR_TYPES.Values()
 
 	return utils.IsInSlice(carTypes, carType)
 }
 
 func isValidCarStatus(carStatus string) bool {
-	carStatuses := constants.Values.CAR_STATUSES.Values()
+	carStatuses := constants.Values().CAR_STATUSES.Values()
 
 	return utils.IsInSlice(carStatuses, carStatus)
 }
+
+func isValidTransmission(transmission string) bool {
+	transmissions := constants.Values().TRANSMISSIONS.Values()
+
+	return utils.IsInSlice(transmissions, transmission)
+}
+
+func isValidFuelType(fuelType string) bool {
+	fuelTypes := constants.Values().FUEL_TYPES.Values()
+
+	return utils.IsInSlice(fuelTypes, fuelType)
+}
+
+func validateCarFeatures(features []string) error {
+	carFeatures := constants.Values().CAR_FEATURES.Values()
+
+	for i, feature := range features {
+		if !utils.IsInSlice(carFeatures, feature) {
+			return errors.New(ErrInvalidCarFeature)
+		}
+		if utils.IsInSlice(features[:i], feature) {
+			return errors.New(ErrRepeatedCarFeature)
+		}
+	}
+
+	return nil
+}
+
+// Gets the cars search filters from query params. Features are received comma separated.
+func CarFiltersFromQuery(query url.Values) (domain.CarFilters, error) {
+	filters := domain.CarFilters{
+		Type:         query.Get("type"),
+		Make:         strings.TrimSpace(query.Get("make")),
+		Model:        strings.TrimSpace(query.Get("model")),
+		Transmission: query.Get("transmission"),
+		FuelType:     query.Get("fuel_type"),
+		Color:        strings.TrimSpace(query.Get("color")),
+		Search:       strings.TrimSpace(query.Get("search")),
+	}
+
+	if filters.Type != "" && !IsValidCarType(filters.Type) {
+		return domain.CarFilters{}, errors.New(ErrInvalidCarType)
+	}
+
+	if year := query.Get("year"); year != "" {
+		parsedYear, err := strconv.ParseInt(year, 10, 16)
+		if err != nil {
+			return domain.CarFilters{}, errors.New(ErrInvalidYear)
+		}
+		filters.Year = int16(parsedYear)
+	}
+
+	if filters.Transmission != "" && !isValidTransmission(filters.Transmission) {
+		return domain.CarFilters{}, errors.New(ErrInvalidTransmission)
+	}
+
+	if filters.FuelType != "" && !isValidFuelType(filters.FuelType) {
+		return domain.CarFilters{}, errors.New(ErrInvalidFuelType)
+	}
+
+	if features := query.Get("features"); features != "" {
+		for _, feature := range strings.Split(features, ",") {
+			filters.Features = append(filters.Features, strings.TrimSpace(feature))
+		}
+		if err := validateCarFeatures(filters.Features); err != nil {
+			return domain.CarFilters{}, err
+		}
+	}
+
+	return filters, nil
+}
+
+// Gets the nearby cars search from query params. Location, radius and time window are required.
+func NearbyCarsSearchFromQuery(query url.Values) (domain.NearbyCarsSearch, error) {
+	var search domain.NearbyCarsSearch
+	var err error
+
+	search.Latitude, err = strconv.ParseFloat(query.Get("lat"), 64)
+	if err != nil || search.Latitude < -90 || search.Latitude > 90 {
+		return domain.NearbyCarsSearch{}, errors.New(ErrInvalidLatitude)
+	}
+
+	search.Longitude, err = strconv.ParseFloat(query.Get("lon"), 64)
+	if err != nil || search.Longitude < -180 || search.Longitude > 180 {
+		return domain.NearbyCarsSearch{}, errors.New(ErrInvalidLongitude)
+	}
+
+	if search.RadiusKm, err = strconv.ParseFloat(query.Get("radius_km"), 64); err != nil {
+		return domain.NearbyCarsSearch{}, errors.New(ErrInvalidSearchRadiusKm)
+	}
+
+	datetimeLayout := constants.Values().DATETIME_LAYOUT
+	if search.StartDate, err = time.Parse(datetimeLayout, query.Get("start_date")); err != nil {
+		return domain.NearbyCarsSearch{}, fmt.Errorf("start_date: %s", err.Error())
+	}
+	if search.EndDate, err = time.Parse(datetimeLayout, query.Get("end_date")); err != nil {
+		return domain.NearbyCarsSearch{}, fmt.Errorf("end_date: %s", err.Error())
+	}
+
+	return search, nil
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,8 +41,18 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Seda",
 					Seats:          4,
 					HourlyRentCost: 21.1,
+					Currency:       "USD",
 					CityName:       "Los Angeles",
 					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
@@ -54,8 +66,18 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Sedan",
 					Seats:          4,
 					HourlyRentCost: 21.1,
+					Currency:       "USD",
 					CityName:       "Los Angeles",
 					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
@@ -69,8 +91,18 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Sedan",
 					Seats:          -4,
 					HourlyRentCost: 21.1,
+					Currency:       "USD",
 					CityName:       "Los Angeles",
 					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
@@ -84,14 +116,49 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Sedan",
 					Seats:          4,
 					HourlyRentCost: -21.1,
+					Currency:       "USD",
 					CityName:       "Los Angeles",
 					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
 				err: errors.New(ErrInvalidHourlyRentCost),
 			},
 		},
+		{
+			name: "returns invalid currency error when the hourly rent cost has an unknown currency",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "DOLLARS",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidCurrency),
+			},
+		},
 		{
 			name: "returns invalid empty city error when it is not provided",
 			args: args{
@@ -99,8 +166,18 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Sedan",
 					Seats:          4,
 					HourlyRentCost: 21.1,
+					Currency:       "USD",
 					CityName:       "",
 					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
@@ -114,14 +191,174 @@ func TestCarFromBody(t *testing.T) {
 					Type:           "Sedan",
 					Seats:          4,
 					HourlyRentCost: 21.1,
+					Currency:       "USD",
 					CityName:       "Los Angeles",
 					Status:         "available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
 				},
 			},
 			wants: wants{
 				err: errors.New(ErrInvalidCarStatus),
 			},
 		},
+		{
+			name: "returns invalid year error when model year is in the far future",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2999,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidYear),
+			},
+		},
+		{
+			name: "returns invalid license plate error when it has not allowed characters",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC#1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidLicensePlate),
+			},
+		},
+		{
+			name: "returns invalid vin error when it does not have 17 characters",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A0043",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "GPS"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidVIN),
+			},
+		},
+		{
+			name: "returns nil error when license plate and vin are in lower case",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   " abc-1234 ",
+					VIN:            "1hgcm82633a004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       nil,
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+		},
+		{
+			name: "returns invalid car feature error when a feature is not one of the expected values",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"A/C", "Jetpack"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidCarFeature),
+			},
+		},
+		{
+			name: "returns repeated car feature error when a feature is given twice",
+			args: args{
+				car: Car{
+					Type:           "Sedan",
+					Seats:          4,
+					HourlyRentCost: 21.1,
+					Currency:       "USD",
+					CityName:       "Los Angeles",
+					Status:         "Available",
+					Make:           "Toyota",
+					Model:          "Corolla",
+					Year:           2021,
+					LicensePlate:   "ABC1234",
+					VIN:            "1HGCM82633A004352",
+					Transmission:   "Automatic",
+					FuelType:       "Gasoline",
+					Color:          "White",
+					Features:       []string{"GPS", "GPS"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrRepeatedCarFeature),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -138,3 +375,20 @@ func TestCarFromBody(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCarWithAffectedReservations(t *testing.T) {
+	car := domain.Car{
+		ID:     uuid.New(),
+		Type:   "Sedan",
+		Status: "Unavailable",
+	}
+
+	response := NewCarWithAffectedReservations(car, nil)
+	assert.Equal(t, "", response.Warning)
+	assert.Equal(t, []Reservation{}, response.AffectedReservations)
+
+	response = NewCarWithAffectedReservations(car, []domain.Reservation{{ID: uuid.New(), CarID: car.ID}})
+	assert.Equal(t, CarDeactivationWarning, response.Warning)
+	assert.Len(t, response.AffectedReservations, 1)
+	assert.Equal(t, car.ID, response.ID)
+}
@@ -1,5 +1,96 @@
 package dtos
 
-type ListCitiesNameResponse struct {
-	CitiesName []string `json:"cities_name"`
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyCityName    = "city name cannot be empty"
+	ErrCityNameTooLong  = fmt.Sprintf("city name cannot be longer than %d characters", maximumCityNameLength)
+	ErrEmptyTimeZone    = "time zone cannot be empty"
+	ErrInvalidCountry   = "country must be an ISO 3166-1 alpha-2 code"
+	ErrInvalidCurrency  = "currency must be an ISO 4217 code"
+	countryCodePattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Maximum length of the name stored for a city
+const maximumCityNameLength = 100
+
+type Cities struct {
+	Cities []City `json:"cities"`
+}
+
+type City struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	TimeZone string    `json:"time_zone"`
+	Country  string    `json:"country"`
+	Currency string    `json:"currency"`
+}
+
+func (c City) ToDomain() domain.City {
+	return domain.City{
+		ID:       c.ID,
+		Name:     c.Name,
+		TimeZone: c.TimeZone,
+		Country:  c.Country,
+		Currency: c.Currency,
+	}
+}
+
+func (c *City) FromDomain(dc domain.City) {
+	c.ID = dc.ID
+	c.Name = dc.Name
+	c.TimeZone = dc.TimeZone
+	c.Country = dc.Country
+	c.Currency = dc.Currency
+}
+
+// Country and currency codes are accepted in any case and stored uppercase
+func CityFromBody(body io.Reader) (City, error) {
+	var city City
+	err := json.NewDecoder(body).Decode(&city)
+	if err != nil {
+		return City{}, err
+	}
+
+	city.Name = strings.TrimSpace(city.Name)
+	if city.Name == "" {
+		return City{}, errors.New(ErrEmptyCityName)
+	}
+
+	if len([]rune(city.Name)) > maximumCityNameLength {
+		return City{}, errors.New(ErrCityNameTooLong)
+	}
+
+	city.TimeZone = strings.TrimSpace(city.TimeZone)
+	if city.TimeZone == "" {
+		return City{}, errors.New(ErrEmptyTimeZone)
+	}
+
+	city.Country = strings.ToUpper(strings.TrimSpace(city.Country))
+	if !countryCodePattern.MatchString(city.Country) {
+		return City{}, errors.New(ErrInvalidCountry)
+	}
+
+	city.Currency = strings.ToUpper(strings.TrimSpace(city.Currency))
+	if !IsValidCurrency(city.Currency) {
+		return City{}, errors.New(ErrInvalidCurrency)
+	}
+
+	return city, nil
+}
+
+// Checks whether a code, in uppercase, is an ISO 4217 currency code
+func IsValidCurrency(code string) bool {
+	return currencyCodePattern.MatchString(code)
 }
@@ -0,0 +1,96 @@
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCityFromBody(t *testing.T) {
+	type args struct {
+		city City
+	}
+	type wants struct {
+		city City
+		err  error
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns the normalized city when body structure is as expected",
+			args: args{
+				city: City{Name: " Chicago ", TimeZone: "America/Chicago", Country: "us", Currency: "usd"},
+			},
+			wants: wants{
+				city: City{Name: "Chicago", TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+				err:  nil,
+			},
+		},
+		{
+			name: "returns an error when name is blank",
+			args: args{
+				city: City{Name: "  ", TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+			},
+			wants: wants{
+				err: errors.New(ErrEmptyCityName),
+			},
+		},
+		{
+			name: "returns an error when name is too long",
+			args: args{
+				city: City{Name: strings.Repeat("a", maximumCityNameLength+1), TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+			},
+			wants: wants{
+				err: errors.New(ErrCityNameTooLong),
+			},
+		},
+		{
+			name: "returns an error when time zone is empty",
+			args: args{
+				city: City{Name: "Chicago", Country: "US", Currency: "USD"},
+			},
+			wants: wants{
+				err: errors.New(ErrEmptyTimeZone),
+			},
+		},
+		{
+			name: "returns an error when country is not an alpha-2 code",
+			args: args{
+				city: City{Name: "Chicago", TimeZone: "America/Chicago", Country: "USA", Currency: "USD"},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidCountry),
+			},
+		},
+		{
+			name: "returns an error when currency is not an ISO 4217 code",
+			args: args{
+				city: City{Name: "Chicago", TimeZone: "America/Chicago", Country: "US", Currency: "US$"},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidCurrency),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cityJSON, err := json.Marshal(test.args.city)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			city, err := CityFromBody(bytes.NewBuffer(cityJSON))
+
+			assert.Equal(t, test.wants.err, err)
+			assert.Equal(t, test.wants.city, city)
+		})
+	}
+}
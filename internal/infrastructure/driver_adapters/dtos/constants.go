@@ -0,0 +1,19 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+)
+
+type Constants struct {
+	Version  uint64                   `json:"version"`
+	LoadedAt time.Time                `json:"loaded_at"`
+	Values   constants.ConstantValues `json:"values"`
+}
+
+func (c *Constants) FromSnapshot(snapshot constants.Snapshot) {
+	c.Version = snapshot.Version
+	c.LoadedAt = snapshot.LoadedAt
+	c.Values = snapshot.Values
+}
@@ -0,0 +1,191 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyDamageDescription   = "damage description cannot be empty"
+	ErrInvalidDamageReportField = "damage report field %s could not be converted to uuid"
+	ErrInvalidDamageStatus      = "invalid damage report status"
+	ErrMissingPhotos            = "at least one photo must be uploaded"
+)
+
+// Name of the multipart field holding the uploaded photos
+const PhotosFormField = "photos"
+
+type DamageReports struct {
+	DamageReports []DamageReport `json:"damage_reports"`
+}
+
+type DamageReport struct {
+	ID            uuid.UUID     `json:"id,omitempty"`
+	CarID         uuid.UUID     `json:"car_id"`
+	ReservationID *uuid.UUID    `json:"reservation_id"`
+	ReporterID    uuid.UUID     `json:"reporter_id"`
+	Description   string        `json:"description"`
+	Status        string        `json:"status"`
+	Resolution    string        `json:"resolution"`
+	CreatedAt     time.Time     `json:"created_at"`
+	ResolvedAt    *time.Time    `json:"resolved_at"`
+	Photos        []DamagePhoto `json:"photos"`
+}
+
+type DamagePhoto struct {
+	ID           uuid.UUID `json:"id"`
+	ContentType  string    `json:"content_type"`
+	Size         int32     `json:"size"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type DamageReportStatus struct {
+	Status     string `json:"status"`
+	Resolution string `json:"resolution"`
+}
+
+func (dr DamageReport) ToDomain() domain.DamageReport {
+	return domain.DamageReport{
+		ID:            dr.ID,
+		CarID:         dr.CarID,
+		ReservationID: dr.ReservationID,
+		ReporterID:    dr.ReporterID,
+		Description:   dr.Description,
+		Status:        dr.Status,
+		Resolution:    dr.Resolution,
+		CreatedAt:     dr.CreatedAt,
+		ResolvedAt:    dr.ResolvedAt,
+	}
+}
+
+func (dr *DamageReport) FromDomain(ddr domain.DamageReport) {
+	dr.ID = ddr.ID
+	dr.CarID = ddr.CarID
+	dr.ReservationID = ddr.ReservationID
+	dr.ReporterID = ddr.ReporterID
+	dr.Description = ddr.Description
+	dr.Status = ddr.Status
+	dr.Resolution = ddr.Resolution
+	dr.CreatedAt = ddr.CreatedAt
+	dr.ResolvedAt = ddr.ResolvedAt
+
+	dr.Photos = make([]DamagePhoto, 0, len(ddr.Photos))
+	for _, ddp := range ddr.Photos {
+		photo := DamagePhoto{}
+		photo.FromDomain(ddp)
+		dr.Photos = append(dr.Photos, photo)
+	}
+}
+
+// Photos are exposed through their download urls instead of their storage keys
+func (dp *DamagePhoto) FromDomain(ddp domain.DamagePhoto) {
+	dp.ID = ddp.ID
+	dp.ContentType = ddp.ContentType
+	dp.Size = ddp.Size
+	dp.URL = fmt.Sprintf("/api/v1/damage-reports/%s/photos/%s", ddp.ReportID, ddp.ID)
+	dp.ThumbnailURL = dp.URL + "/thumbnail"
+	dp.CreatedAt = ddp.CreatedAt
+}
+
+// Builds a damage report from the fields of a multipart form
+func DamageReportFromForm(form *multipart.Form) (DamageReport, error) {
+	var report DamageReport
+	var err error
+
+	if report.CarID, err = uuidFromForm(form, "car_id"); err != nil {
+		return DamageReport{}, err
+	}
+	if report.ReporterID, err = uuidFromForm(form, "reporter_id"); err != nil {
+		return DamageReport{}, err
+	}
+	if formValue(form, "reservation_id") != "" {
+		reservationID, err := uuidFromForm(form, "reservation_id")
+		if err != nil {
+			return DamageReport{}, err
+		}
+		report.ReservationID = &reservationID
+	}
+
+	report.Description = strings.TrimSpace(formValue(form, "description"))
+	if report.Description == "" {
+		return DamageReport{}, errors.New(ErrEmptyDamageDescription)
+	}
+
+	return report, nil
+}
+
+// Reads the photos uploaded in a multipart form
+func PhotoUploadsFromForm(form *multipart.Form) ([]domain.PhotoUpload, error) {
+	fileHeaders := form.File[PhotosFormField]
+	if len(fileHeaders) == 0 {
+		return nil, errors.New(ErrMissingPhotos)
+	}
+
+	photos := make([]domain.PhotoUpload, 0, len(fileHeaders))
+	for _, fileHeader := range fileHeaders {
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		photos = append(photos, domain.PhotoUpload{Filename: fileHeader.Filename, Content: content})
+	}
+
+	return photos, nil
+}
+
+func DamageReportStatusFromBody(body io.Reader) (DamageReportStatus, error) {
+	var status DamageReportStatus
+	err := json.NewDecoder(body).Decode(&status)
+	if err != nil {
+		return DamageReportStatus{}, err
+	}
+
+	if !IsValidDamageReportStatus(status.Status) {
+		return DamageReportStatus{}, errors.New(ErrInvalidDamageStatus)
+	}
+	status.Resolution = strings.TrimSpace(status.Resolution)
+
+	return status, nil
+}
+
+func IsValidDamageReportStatus(status string) bool {
+	damageReportStatuses := constants.Values().DAMAGE_REPORT_STATUSES.Values()
+
+	return utils.IsInSlice(damageReportStatuses, status)
+}
+
+func formValue(form *multipart.Form, field string) string {
+	if values := form.Value[field]; len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+
+	return ""
+}
+
+func uuidFromForm(form *multipart.Form, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(formValue(form, field))
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf(ErrInvalidDamageReportField, field)
+	}
+
+	return id, nil
+}
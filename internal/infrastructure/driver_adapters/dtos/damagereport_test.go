@@ -0,0 +1,107 @@
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDamageReportFromForm(t *testing.T) {
+	carID := uuid.New()
+	reporterID := uuid.New()
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		form  map[string][]string
+		wants wants
+	}{
+		{
+			name: "returns nil error when form fields are as expected",
+			form: map[string][]string{
+				"car_id":      {carID.String()},
+				"reporter_id": {reporterID.String()},
+				"description": {"Scratch on rear bumper"},
+			},
+			wants: wants{
+				err: nil,
+			},
+		},
+		{
+			name: "returns an error when reservation id is invalid",
+			form: map[string][]string{
+				"car_id":         {carID.String()},
+				"reporter_id":    {reporterID.String()},
+				"reservation_id": {"invalid-id"},
+				"description":    {"Scratch on rear bumper"},
+			},
+			wants: wants{
+				err: fmt.Errorf(ErrInvalidDamageReportField, "reservation_id"),
+			},
+		},
+		{
+			name: "returns an error when description is blank",
+			form: map[string][]string{
+				"car_id":      {carID.String()},
+				"reporter_id": {reporterID.String()},
+				"description": {"   "},
+			},
+			wants: wants{
+				err: errors.New(ErrEmptyDamageDescription),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := DamageReportFromForm(&multipart.Form{Value: test.form})
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestDamageReportStatusFromBody(t *testing.T) {
+	initConstantsFromDtos(t)
+
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		status DamageReportStatus
+		wants  wants
+	}{
+		{
+			name:   "returns nil error when status is valid",
+			status: DamageReportStatus{Status: "In Repair"},
+			wants: wants{
+				err: nil,
+			},
+		},
+		{
+			name:   "returns an error when status is invalid",
+			status: DamageReportStatus{Status: "Lost"},
+			wants: wants{
+				err: errors.New(ErrInvalidDamageStatus),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, _ := json.Marshal(test.status)
+			_, err := DamageReportStatusFromBody(bytes.NewBuffer(body))
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
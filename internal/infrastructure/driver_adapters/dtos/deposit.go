@@ -0,0 +1,69 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDepositCaptureAmount = "captured amount must be positive"
+	ErrDepositCaptureReasonMissing = "a reason is required to capture the deposit"
+)
+
+type Deposit struct {
+	ID             uuid.UUID  `json:"id"`
+	ReservationID  uuid.UUID  `json:"reservation_id"`
+	Reference      string     `json:"reference"`
+	Amount         float64    `json:"amount"`
+	Currency       string     `json:"currency"`
+	CapturedAmount float64    `json:"captured_amount"`
+	CaptureReason  string     `json:"capture_reason,omitempty"`
+	Status         string     `json:"status"`
+	HeldAt         time.Time  `json:"held_at"`
+	SettledAt      *time.Time `json:"settled_at,omitempty"`
+}
+
+// Part of the held deposit to capture and what it covers, such as damages or
+// late fees
+type DepositCapture struct {
+	Amount float64 `json:"amount"`
+	Reason string  `json:"reason"`
+}
+
+func (d *Deposit) FromDomain(dd domain.Deposit) {
+	d.ID = dd.ID
+	d.ReservationID = dd.ReservationID
+	d.Reference = dd.Reference
+	d.Amount = dd.Amount.Major()
+	d.Currency = dd.Amount.Currency
+	d.CapturedAmount = dd.CapturedAmount.Major()
+	d.CaptureReason = dd.CaptureReason
+	d.Status = dd.Status
+	d.HeldAt = dd.HeldAt
+	d.SettledAt = dd.SettledAt
+}
+
+func DepositCaptureFromBody(body io.Reader) (DepositCapture, error) {
+	var capture DepositCapture
+	err := json.NewDecoder(body).Decode(&capture)
+	if err != nil {
+		return DepositCapture{}, err
+	}
+
+	if capture.Amount <= 0 {
+		return DepositCapture{}, errors.New(ErrInvalidDepositCaptureAmount)
+	}
+
+	capture.Reason = strings.TrimSpace(capture.Reason)
+	if capture.Reason == "" {
+		return DepositCapture{}, errors.New(ErrDepositCaptureReasonMissing)
+	}
+
+	return capture, nil
+}
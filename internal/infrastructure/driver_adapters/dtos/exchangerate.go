@@ -0,0 +1,95 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+)
+
+var (
+	ErrInvalidExchangeRate = "exchange rate must be greater than 0"
+)
+
+type ExchangeRates struct {
+	ExchangeRates []ExchangeRate `json:"exchange_rates"`
+}
+
+type ExchangeRate struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Money in major units, such as dollars, with its currency
+type Money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type DisplayQuote struct {
+	Rate                 ExchangeRate `json:"rate"`
+	RentalCost           Money        `json:"rental_cost"`
+	OneWayFee            Money        `json:"one_way_fee"`
+	AdditionalDriversFee Money        `json:"additional_drivers_fee"`
+	AddOnsCost           Money        `json:"add_ons_cost"`
+	ProtectionCost       Money        `json:"protection_cost"`
+	Subtotal             Money        `json:"subtotal"`
+	TaxTotal             Money        `json:"tax_total"`
+	Total                Money        `json:"total"`
+}
+
+func (er ExchangeRate) ToDomain() domain.ExchangeRate {
+	return domain.ExchangeRate(er)
+}
+
+func (er *ExchangeRate) FromDomain(der domain.ExchangeRate) {
+	*er = ExchangeRate(der)
+}
+
+func MoneyFromDomain(dm domain.Money) Money {
+	return Money{
+		Amount:   dm.Major(),
+		Currency: dm.Currency,
+	}
+}
+
+// Converts the quote shown in the display currency, nil when there is none
+func displayQuoteFromDomain(ddq *domain.DisplayQuote) *DisplayQuote {
+	if ddq == nil {
+		return nil
+	}
+
+	var rate ExchangeRate
+	rate.FromDomain(ddq.Rate)
+
+	return &DisplayQuote{
+		Rate:                 rate,
+		RentalCost:           MoneyFromDomain(ddq.RentalCost),
+		OneWayFee:            MoneyFromDomain(ddq.OneWayFee),
+		AdditionalDriversFee: MoneyFromDomain(ddq.AdditionalDriversFee),
+		AddOnsCost:           MoneyFromDomain(ddq.AddOnsCost),
+		ProtectionCost:       MoneyFromDomain(ddq.ProtectionCost),
+		Subtotal:             MoneyFromDomain(ddq.Subtotal),
+		TaxTotal:             MoneyFromDomain(ddq.TaxTotal),
+		Total:                MoneyFromDomain(ddq.Total),
+	}
+}
+
+// Decodes the rate of a currency pair. The currencies are taken from the path.
+func ExchangeRateFromBody(body io.Reader) (ExchangeRate, error) {
+	var rate ExchangeRate
+	err := json.NewDecoder(body).Decode(&rate)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	if rate.Rate <= 0 {
+		return ExchangeRate{}, errors.New(ErrInvalidExchangeRate)
+	}
+
+	return rate, nil
+}
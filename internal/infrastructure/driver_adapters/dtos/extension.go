@@ -0,0 +1,68 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrExtensionEndDateMissing = "end date of the extension is required"
+)
+
+// New end date a reservation is asked to be extended to
+type ExtensionRequest struct {
+	EndDate time.Time `json:"end_date"`
+}
+
+type Extension struct {
+	ReservationID          uuid.UUID    `json:"reservation_id"`
+	PreviousEndDate        time.Time    `json:"previous_end_date"`
+	EndDate                time.Time    `json:"end_date"`
+	Applied                bool         `json:"applied"`
+	Cost                   float64      `json:"cost"`
+	Currency               string       `json:"currency"`
+	Reservation            *Reservation `json:"reservation,omitempty"`
+	ConflictingReservation *Reservation `json:"conflicting_reservation,omitempty"`
+	MaximumEndDate         *time.Time   `json:"maximum_end_date,omitempty"`
+}
+
+func (e *Extension) FromDomain(de domain.Extension) {
+	e.ReservationID = de.ReservationID
+	e.PreviousEndDate = de.PreviousEndDate
+	e.EndDate = de.EndDate
+	e.Applied = de.Applied
+	e.Cost = de.Cost.Major()
+	e.Currency = de.Cost.Currency
+	e.MaximumEndDate = de.MaximumEndDate
+
+	e.Reservation = nil
+	if de.Reservation != nil {
+		e.Reservation = &Reservation{}
+		e.Reservation.FromDomain(*de.Reservation)
+	}
+
+	e.ConflictingReservation = nil
+	if de.ConflictingReservation != nil {
+		e.ConflictingReservation = &Reservation{}
+		e.ConflictingReservation.FromDomain(*de.ConflictingReservation)
+	}
+}
+
+func ExtensionRequestFromBody(body io.Reader) (ExtensionRequest, error) {
+	var request ExtensionRequest
+	err := json.NewDecoder(body).Decode(&request)
+	if err != nil {
+		return ExtensionRequest{}, err
+	}
+
+	if request.EndDate.IsZero() {
+		return ExtensionRequest{}, errors.New(ErrExtensionEndDateMissing)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,154 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidInspectionType = "invalid inspection type"
+	ErrInvalidMileage        = "mileage cannot be negative"
+	ErrInvalidFuelLevel      = "fuel level must be a percentage between 0 and 100"
+	ErrEmptyDamage           = "damage observations cannot be empty"
+)
+
+type Inspection struct {
+	ID            uuid.UUID `json:"id,omitempty"`
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Type          string    `json:"type"`
+	Mileage       int32     `json:"mileage"`
+	FuelLevel     int16     `json:"fuel_level"`
+	Damages       []string  `json:"damages"`
+	Notes         string    `json:"notes"`
+	InspectedAt   time.Time `json:"inspected_at"`
+	// Payment method the security deposit is held on at pickup and the late
+	// fee is charged on at return. It is only read from requests.
+	PaymentMethod string `json:"payment_method,omitempty"`
+}
+
+type Handover struct {
+	ReservationID  uuid.UUID   `json:"reservation_id"`
+	Pickup         *Inspection `json:"pickup"`
+	Return         *Inspection `json:"return"`
+	DistanceDriven int32       `json:"distance_driven"`
+	CarMileage     CarMileage  `json:"car_mileage"`
+	Deposit        *Deposit    `json:"deposit"`
+	LateReturn     *LateReturn `json:"late_return"`
+	Warnings       []string    `json:"warnings,omitempty"`
+}
+
+type CarMileage struct {
+	CarID              uuid.UUID `json:"car_id"`
+	Mileage            int32     `json:"mileage"`
+	LastServiceMileage int32     `json:"last_service_mileage"`
+	MaintenanceDue     bool      `json:"maintenance_due"`
+}
+
+func (i Inspection) ToDomain() domain.Inspection {
+	return domain.Inspection{
+		ID:            i.ID,
+		ReservationID: i.ReservationID,
+		Type:          i.Type,
+		Mileage:       i.Mileage,
+		FuelLevel:     i.FuelLevel,
+		Damages:       i.Damages,
+		Notes:         i.Notes,
+		InspectedAt:   i.InspectedAt,
+	}
+}
+
+func (i *Inspection) FromDomain(di domain.Inspection) {
+	i.ID = di.ID
+	i.ReservationID = di.ReservationID
+	i.Type = di.Type
+	i.Mileage = di.Mileage
+	i.FuelLevel = di.FuelLevel
+	i.Damages = di.Damages
+	i.Notes = di.Notes
+	i.InspectedAt = di.InspectedAt
+}
+
+func (h *Handover) FromDomain(dh domain.Handover) {
+	h.ReservationID = dh.ReservationID
+	h.DistanceDriven = dh.DistanceDriven
+	h.CarMileage.FromDomain(dh.CarMileage)
+
+	h.Pickup, h.Return = nil, nil
+	if dh.Pickup != nil {
+		h.Pickup = &Inspection{}
+		h.Pickup.FromDomain(*dh.Pickup)
+	}
+	if dh.Return != nil {
+		h.Return = &Inspection{}
+		h.Return.FromDomain(*dh.Return)
+	}
+
+	h.Deposit = nil
+	if dh.Deposit != nil {
+		h.Deposit = &Deposit{}
+		h.Deposit.FromDomain(*dh.Deposit)
+	}
+
+	h.LateReturn = nil
+	if dh.LateReturn != nil {
+		h.LateReturn = &LateReturn{}
+		h.LateReturn.FromDomain(*dh.LateReturn)
+	}
+
+	h.Warnings = dh.Warnings
+}
+
+func (cm *CarMileage) FromDomain(dcm domain.CarMileage) {
+	cm.CarID = dcm.CarID
+	cm.Mileage = dcm.Mileage
+	cm.LastServiceMileage = dcm.LastServiceMileage
+	cm.MaintenanceDue = dcm.MaintenanceDue
+}
+
+func InspectionFromBody(body io.Reader) (Inspection, error) {
+	var inspection Inspection
+	err := json.NewDecoder(body).Decode(&inspection)
+	if err != nil {
+		return Inspection{}, err
+	}
+
+	if !isValidInspectionType(inspection.Type) {
+		return Inspection{}, errors.New(ErrInvalidInspectionType)
+	}
+
+	if inspection.Mileage < 0 {
+		return Inspection{}, errors.New(ErrInvalidMileage)
+	}
+
+	if inspection.FuelLevel < 0 || inspection.FuelLevel > 100 {
+		return Inspection{}, errors.New(ErrInvalidFuelLevel)
+	}
+
+	damages := make([]string, 0, len(inspection.Damages))
+	for _, damage := range inspection.Damages {
+		damage = strings.TrimSpace(damage)
+		if damage == "" {
+			return Inspection{}, errors.New(ErrEmptyDamage)
+		}
+		damages = append(damages, damage)
+	}
+	inspection.Damages = damages
+	inspection.Notes = strings.TrimSpace(inspection.Notes)
+	inspection.PaymentMethod = strings.TrimSpace(inspection.PaymentMethod)
+
+	return inspection, nil
+}
+
+func isValidInspectionType(inspectionType string) bool {
+	inspectionTypes := constants.Values().INSPECTION_TYPES.Values()
+
+	return utils.IsInSlice(inspectionTypes, inspectionType)
+}
@@ -0,0 +1,81 @@
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspectionFromBody(t *testing.T) {
+	initConstantsFromDtos(t)
+
+	inspection := Inspection{
+		Type:        "Pickup",
+		Mileage:     10500,
+		FuelLevel:   100,
+		Damages:     []string{" Scratch on rear bumper "},
+		InspectedAt: time.Now(),
+	}
+
+	type args struct {
+		modify func(i *Inspection)
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name:  "returns nil error when body structure is as expected",
+			args:  args{modify: func(i *Inspection) {}},
+			wants: wants{err: nil},
+		},
+		{
+			name:  "returns an error when inspection type is invalid",
+			args:  args{modify: func(i *Inspection) { i.Type = "pickup" }},
+			wants: wants{err: errors.New(ErrInvalidInspectionType)},
+		},
+		{
+			name:  "returns an error when mileage is negative",
+			args:  args{modify: func(i *Inspection) { i.Mileage = -1 }},
+			wants: wants{err: errors.New(ErrInvalidMileage)},
+		},
+		{
+			name:  "returns an error when fuel level is above 100",
+			args:  args{modify: func(i *Inspection) { i.FuelLevel = 101 }},
+			wants: wants{err: errors.New(ErrInvalidFuelLevel)},
+		},
+		{
+			name:  "returns an error when a damage observation is empty",
+			args:  args{modify: func(i *Inspection) { i.Damages = []string{"Dent", " "} }},
+			wants: wants{err: errors.New(ErrEmptyDamage)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := inspection
+			body.Damages = append([]string{}, inspection.Damages...)
+			test.args.modify(&body)
+
+			inspectionJSON, err := json.Marshal(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parsed, err := InspectionFromBody(bytes.NewBuffer(inspectionJSON))
+
+			assert.Equal(t, test.wants.err, err)
+			if err == nil {
+				assert.Equal(t, []string{"Scratch on rear bumper"}, parsed.Damages)
+			}
+		})
+	}
+}
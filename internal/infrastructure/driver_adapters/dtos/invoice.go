@@ -0,0 +1,127 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBillingCompanyNameMissing = "company name cannot be empty"
+	ErrBillingTaxIDMissing       = "tax id cannot be empty"
+	ErrBillingAddressMissing     = "billing address, city and postal code cannot be empty"
+)
+
+type Invoice struct {
+	ID            uuid.UUID     `json:"id"`
+	Number        string        `json:"number"`
+	ReservationID uuid.UUID     `json:"reservation_id"`
+	Billing       BillingInfo   `json:"billing"`
+	Lines         []InvoiceLine `json:"lines"`
+	Taxes         []InvoiceTax  `json:"taxes"`
+	Subtotal      float64       `json:"subtotal"`
+	TaxTotal      float64       `json:"tax_total"`
+	Total         float64       `json:"total"`
+	Currency      string        `json:"currency"`
+	IssuedAt      time.Time     `json:"issued_at"`
+}
+
+type BillingInfo struct {
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	CompanyName string `json:"company_name,omitempty"`
+	TaxID       string `json:"tax_id,omitempty"`
+	Address     string `json:"address,omitempty"`
+	City        string `json:"city,omitempty"`
+	PostalCode  string `json:"postal_code,omitempty"`
+	Country     string `json:"country,omitempty"`
+}
+
+type InvoiceLine struct {
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+type InvoiceTax struct {
+	Name   string  `json:"name"`
+	Rate   float64 `json:"rate"`
+	Amount float64 `json:"amount"`
+}
+
+type BillingProfile struct {
+	UserID      uuid.UUID `json:"user_id"`
+	CompanyName string    `json:"company_name"`
+	TaxID       string    `json:"tax_id"`
+	Address     string    `json:"address"`
+	City        string    `json:"city"`
+	PostalCode  string    `json:"postal_code"`
+	Country     string    `json:"country"`
+}
+
+func (i *Invoice) FromDomain(di domain.Invoice) {
+	i.ID = di.ID
+	i.Number = di.Number
+	i.ReservationID = di.ReservationID
+	i.Billing = BillingInfo(di.Billing)
+	i.Subtotal = di.Subtotal.Major()
+	i.TaxTotal = di.TaxTotal.Major()
+	i.Total = di.Total.Major()
+	i.Currency = di.Total.Currency
+	i.IssuedAt = di.IssuedAt
+
+	i.Lines = make([]InvoiceLine, len(di.Lines))
+	for j, line := range di.Lines {
+		i.Lines[j] = InvoiceLine{Description: line.Description, Amount: line.Amount.Major()}
+	}
+
+	i.Taxes = make([]InvoiceTax, len(di.Taxes))
+	for j, tax := range di.Taxes {
+		i.Taxes[j] = InvoiceTax{Name: tax.Name, Rate: tax.Rate, Amount: tax.Amount.Major()}
+	}
+}
+
+func (bp BillingProfile) ToDomain() domain.BillingProfile {
+	return domain.BillingProfile(bp)
+}
+
+func (bp *BillingProfile) FromDomain(dbp domain.BillingProfile) {
+	*bp = BillingProfile(dbp)
+}
+
+// The country code is accepted in any case and stored uppercase
+func BillingProfileFromBody(body io.Reader) (BillingProfile, error) {
+	var profile BillingProfile
+	err := json.NewDecoder(body).Decode(&profile)
+	if err != nil {
+		return BillingProfile{}, err
+	}
+
+	profile.CompanyName = strings.TrimSpace(profile.CompanyName)
+	if profile.CompanyName == "" {
+		return BillingProfile{}, errors.New(ErrBillingCompanyNameMissing)
+	}
+
+	profile.TaxID = strings.TrimSpace(profile.TaxID)
+	if profile.TaxID == "" {
+		return BillingProfile{}, errors.New(ErrBillingTaxIDMissing)
+	}
+
+	profile.Address = strings.TrimSpace(profile.Address)
+	profile.City = strings.TrimSpace(profile.City)
+	profile.PostalCode = strings.TrimSpace(profile.PostalCode)
+	if profile.Address == "" || profile.City == "" || profile.PostalCode == "" {
+		return BillingProfile{}, errors.New(ErrBillingAddressMissing)
+	}
+
+	profile.Country = strings.ToUpper(strings.TrimSpace(profile.Country))
+	if !countryCodePattern.MatchString(profile.Country) {
+		return BillingProfile{}, errors.New(ErrInvalidCountry)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,76 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type LateReturn struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	DueAt         time.Time `json:"due_at"`
+	ReturnedAt    time.Time `json:"returned_at"`
+	MinutesLate   int       `json:"minutes_late"`
+	HoursCharged  int       `json:"hours_charged"`
+	HourlyFee     float64   `json:"hourly_fee"`
+	Fee           float64   `json:"fee"`
+	Currency      string    `json:"currency"`
+}
+
+type OverdueRentals struct {
+	OverdueRentals []OverdueRental `json:"overdue_rentals"`
+}
+
+type OverdueRental struct {
+	Reservation     Reservation    `json:"reservation"`
+	Lateness        LateReturn     `json:"lateness"`
+	NextReservation *Reservation   `json:"next_reservation,omitempty"`
+	Notice          *OverdueNotice `json:"notice,omitempty"`
+}
+
+type OverdueNotice struct {
+	NextReservationID uuid.UUID `json:"next_reservation_id"`
+	NotifiedAt        time.Time `json:"notified_at"`
+}
+
+func (lr *LateReturn) FromDomain(dlr domain.LateReturn) {
+	lr.ReservationID = dlr.ReservationID
+	lr.DueAt = dlr.DueAt
+	lr.ReturnedAt = dlr.ReturnedAt
+	lr.MinutesLate = dlr.MinutesLate
+	lr.HoursCharged = dlr.HoursCharged
+	lr.HourlyFee = dlr.HourlyFee.Major()
+	lr.Fee = dlr.Fee.Major()
+	lr.Currency = dlr.Fee.Currency
+}
+
+func (o *OverdueRental) FromDomain(dor domain.OverdueRental) {
+	o.Reservation.FromDomain(dor.Reservation)
+	o.Lateness.FromDomain(dor.Lateness)
+
+	o.NextReservation = nil
+	if dor.NextReservation != nil {
+		o.NextReservation = &Reservation{}
+		o.NextReservation.FromDomain(*dor.NextReservation)
+	}
+
+	o.Notice = nil
+	if dor.Notice != nil {
+		o.Notice = &OverdueNotice{
+			NextReservationID: dor.Notice.NextReservationID,
+			NotifiedAt:        dor.Notice.NotifiedAt,
+		}
+	}
+}
+
+func OverdueRentalsFromDomain(dors []domain.OverdueRental) OverdueRentals {
+	overdueRentals := OverdueRentals{OverdueRentals: make([]OverdueRental, 0, len(dors))}
+	for _, dor := range dors {
+		overdueRental := OverdueRental{}
+		overdueRental.FromDomain(dor)
+		overdueRentals.OverdueRentals = append(overdueRentals.OverdueRentals, overdueRental)
+	}
+
+	return overdueRentals
+}
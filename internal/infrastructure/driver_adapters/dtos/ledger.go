@@ -0,0 +1,53 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type LedgerEntry struct {
+	ID            uuid.UUID `json:"id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	Account       string    `json:"account"`
+	Movement      string    `json:"movement"`
+	Amount        float64   `json:"amount"`
+	Currency      string    `json:"currency"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Balance of the ledger accounts of a reservation. Debits are positive and
+// credits negative, so total is zero while the ledger is consistent.
+type LedgerBalance struct {
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	Currency      string             `json:"currency,omitempty"`
+	Accounts      map[string]float64 `json:"accounts"`
+	Total         float64            `json:"total"`
+	Entries       []LedgerEntry      `json:"entries"`
+}
+
+func (le *LedgerEntry) FromDomain(dle domain.LedgerEntry) {
+	le.ID = dle.ID
+	le.TransactionID = dle.TransactionID
+	le.Account = dle.Account
+	le.Movement = dle.Movement
+	le.Amount = dle.Amount.Major()
+	le.Currency = dle.Amount.Currency
+	le.CreatedAt = dle.CreatedAt
+}
+
+func (lb *LedgerBalance) FromDomain(dlb domain.LedgerBalance) {
+	lb.ReservationID = dlb.ReservationID
+	lb.Currency = dlb.Total.Currency
+	lb.Total = dlb.Total.Major()
+	lb.Accounts = make(map[string]float64, len(dlb.Accounts))
+	for account, balance := range dlb.Accounts {
+		lb.Accounts[account] = balance.Major()
+	}
+
+	lb.Entries = make([]LedgerEntry, len(dlb.Entries))
+	for i, dle := range dlb.Entries {
+		lb.Entries[i].FromDomain(dle)
+	}
+}
@@ -0,0 +1,91 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyMaintenanceReason   = "maintenance reason cannot be empty"
+	ErrMaintenanceReasonTooLong = fmt.Sprintf("maintenance reason cannot be longer than %d characters", maximumMaintenanceReasonLength)
+	MaintenanceConflictsWarning = "the maintenance collides with existing reservations"
+)
+
+// Maximum length of the reason stored for a maintenance
+const maximumMaintenanceReasonLength = 255
+
+type Maintenances struct {
+	Maintenances []Maintenance `json:"maintenances"`
+}
+
+type Maintenance struct {
+	ID        uuid.UUID `json:"id,omitempty"`
+	CarID     uuid.UUID `json:"car_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+}
+
+// Maintenance along with the reservations colliding with its window
+type MaintenanceWithConflicts struct {
+	Maintenance
+	ConflictingReservations []Reservation `json:"conflicting_reservations"`
+	Warning                 string        `json:"warning,omitempty"`
+}
+
+func (m Maintenance) ToDomain() domain.Maintenance {
+	return domain.Maintenance{
+		ID:        m.ID,
+		CarID:     m.CarID,
+		StartDate: m.StartDate,
+		EndDate:   m.EndDate,
+		Reason:    m.Reason,
+	}
+}
+
+func (m *Maintenance) FromDomain(dm domain.Maintenance) {
+	m.ID = dm.ID
+	m.CarID = dm.CarID
+	m.StartDate = dm.StartDate
+	m.EndDate = dm.EndDate
+	m.Reason = dm.Reason
+}
+
+func MaintenanceFromBody(body io.Reader) (Maintenance, error) {
+	var maintenance Maintenance
+	err := json.NewDecoder(body).Decode(&maintenance)
+	if err != nil {
+		return Maintenance{}, err
+	}
+
+	maintenance.Reason = strings.TrimSpace(maintenance.Reason)
+	if maintenance.Reason == "" {
+		return Maintenance{}, errors.New(ErrEmptyMaintenanceReason)
+	}
+
+	if len([]rune(maintenance.Reason)) > maximumMaintenanceReasonLength {
+		return Maintenance{}, errors.New(ErrMaintenanceReasonTooLong)
+	}
+
+	return maintenance, nil
+}
+
+// Builds the response for a maintenance, warning about the colliding reservations if any
+func NewMaintenanceWithConflicts(dm domain.Maintenance, conflictingReservations []domain.Reservation) MaintenanceWithConflicts {
+	var response MaintenanceWithConflicts
+
+	response.FromDomain(dm)
+	response.ConflictingReservations = reservationsFromDomain(conflictingReservations)
+	if len(response.ConflictingReservations) > 0 {
+		response.Warning = MaintenanceConflictsWarning
+	}
+
+	return response
+}
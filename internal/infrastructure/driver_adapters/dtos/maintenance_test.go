@@ -0,0 +1,104 @@
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceFromBody(t *testing.T) {
+	type args struct {
+		maintenance Maintenance
+	}
+	type wants struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns nil error when body structure is as expected",
+			args: args{
+				maintenance: Maintenance{
+					CarID:     uuid.New(),
+					StartDate: time.Now(),
+					EndDate:   time.Now().AddDate(0, 0, 2),
+					Reason:    "Brakes replacement",
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+		},
+		{
+			name: "returns an error when reason is blank",
+			args: args{
+				maintenance: Maintenance{
+					CarID:     uuid.New(),
+					StartDate: time.Now(),
+					EndDate:   time.Now().AddDate(0, 0, 2),
+					Reason:    "   ",
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrEmptyMaintenanceReason),
+			},
+		},
+		{
+			name: "returns an error when reason is too long",
+			args: args{
+				maintenance: Maintenance{
+					CarID:     uuid.New(),
+					StartDate: time.Now(),
+					EndDate:   time.Now().AddDate(0, 0, 2),
+					Reason:    strings.Repeat("a", maximumMaintenanceReasonLength+1),
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrMaintenanceReasonTooLong),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			maintenanceJSON, err := json.Marshal(test.args.maintenance)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			maintenanceReader := bytes.NewBuffer(maintenanceJSON)
+			_, err = MaintenanceFromBody(maintenanceReader)
+
+			assert.Equal(t, test.wants.err, err)
+		})
+	}
+}
+
+func TestNewMaintenanceWithConflicts(t *testing.T) {
+	maintenance := domain.Maintenance{
+		ID:        uuid.New(),
+		CarID:     uuid.New(),
+		StartDate: time.Now(),
+		EndDate:   time.Now().AddDate(0, 0, 2),
+		Reason:    "Brakes replacement",
+	}
+
+	response := NewMaintenanceWithConflicts(maintenance, nil)
+	assert.Equal(t, "", response.Warning)
+	assert.Equal(t, []Reservation{}, response.ConflictingReservations)
+
+	response = NewMaintenanceWithConflicts(maintenance, []domain.Reservation{{ID: uuid.New(), CarID: maintenance.CarID}})
+	assert.Equal(t, MaintenanceConflictsWarning, response.Warning)
+	assert.Len(t, response.ConflictingReservations, 1)
+	assert.Equal(t, maintenance.ID, response.ID)
+}
@@ -0,0 +1,62 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNegativeOneWayFee = "one-way fee cannot be negative"
+)
+
+type OneWayFees struct {
+	OneWayFees []OneWayFee `json:"one_way_fees"`
+}
+
+type OneWayFee struct {
+	FromCityID uuid.UUID `json:"from_city_id"`
+	ToCityID   uuid.UUID `json:"to_city_id"`
+	Fee        float64   `json:"fee"`
+	Currency   string    `json:"currency"`
+}
+
+func (owf OneWayFee) ToDomain() domain.OneWayFee {
+	return domain.OneWayFee{
+		FromCityID: owf.FromCityID,
+		ToCityID:   owf.ToCityID,
+		Fee:        owf.Fee,
+		Currency:   owf.Currency,
+	}
+}
+
+func (owf *OneWayFee) FromDomain(dowf domain.OneWayFee) {
+	owf.FromCityID = dowf.FromCityID
+	owf.ToCityID = dowf.ToCityID
+	owf.Fee = dowf.Fee
+	owf.Currency = dowf.Currency
+}
+
+// Decodes the fee of a route. The cities of the route are taken from the path.
+func OneWayFeeFromBody(body io.Reader) (OneWayFee, error) {
+	var oneWayFee OneWayFee
+	err := json.NewDecoder(body).Decode(&oneWayFee)
+	if err != nil {
+		return OneWayFee{}, err
+	}
+
+	if oneWayFee.Fee < 0 {
+		return OneWayFee{}, errors.New(ErrNegativeOneWayFee)
+	}
+
+	oneWayFee.Currency = strings.ToUpper(strings.TrimSpace(oneWayFee.Currency))
+	if !IsValidCurrency(oneWayFee.Currency) {
+		return OneWayFee{}, errors.New(ErrInvalidCurrency)
+	}
+
+	return oneWayFee, nil
+}
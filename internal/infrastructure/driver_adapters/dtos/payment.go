@@ -0,0 +1,84 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPaymentMethodRequired = "payment method is required"
+	ErrInvalidRefundAmount   = "refund amount cannot be negative"
+)
+
+type Payment struct {
+	ID             uuid.UUID `json:"id"`
+	ReservationID  uuid.UUID `json:"reservation_id"`
+	Reference      string    `json:"reference"`
+	Amount         float64   `json:"amount"`
+	TaxAmount      float64   `json:"tax_amount"`
+	Currency       string    `json:"currency"`
+	CapturedAmount float64   `json:"captured_amount"`
+	RefundedAmount float64   `json:"refunded_amount"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Payment method to authorize the reservation payment on, as given by the
+// payment provider
+type PaymentAuthorization struct {
+	PaymentMethod string `json:"payment_method"`
+}
+
+// Amount to give back of a captured payment. Everything left is refunded
+// when it is zero or the body is empty.
+type Refund struct {
+	Amount float64 `json:"amount"`
+}
+
+func (p *Payment) FromDomain(dp domain.Payment) {
+	p.ID = dp.ID
+	p.ReservationID = dp.ReservationID
+	p.Reference = dp.Reference
+	p.Amount = dp.Amount.Major()
+	p.TaxAmount = dp.TaxAmount.Major()
+	p.Currency = dp.Amount.Currency
+	p.CapturedAmount = dp.CapturedAmount.Major()
+	p.RefundedAmount = dp.RefundedAmount.Major()
+	p.Status = dp.Status
+	p.CreatedAt = dp.CreatedAt
+	p.UpdatedAt = dp.UpdatedAt
+}
+
+func PaymentAuthorizationFromBody(body io.Reader) (PaymentAuthorization, error) {
+	var authorization PaymentAuthorization
+	err := json.NewDecoder(body).Decode(&authorization)
+	if err != nil {
+		return PaymentAuthorization{}, err
+	}
+
+	if authorization.PaymentMethod == "" {
+		return PaymentAuthorization{}, errors.New(ErrPaymentMethodRequired)
+	}
+
+	return authorization, nil
+}
+
+func RefundFromBody(body io.Reader) (Refund, error) {
+	var refund Refund
+	err := json.NewDecoder(body).Decode(&refund)
+	if err != nil && err != io.EOF {
+		return Refund{}, err
+	}
+
+	if refund.Amount < 0 {
+		return Refund{}, errors.New(ErrInvalidRefundAmount)
+	}
+
+	return refund, nil
+}
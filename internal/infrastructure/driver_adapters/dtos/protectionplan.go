@@ -0,0 +1,96 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidProtectionLevel      = "invalid protection level"
+	ErrInvalidProtectionDailyPrice = "protection plan daily price cannot be negative"
+	ErrInvalidProtectionDeductible = "protection plan deductible cannot be negative"
+)
+
+type ProtectionPlans struct {
+	ProtectionPlans []ProtectionPlan `json:"protection_plans"`
+}
+
+type ProtectionPlan struct {
+	ID         uuid.UUID `json:"id"`
+	Level      string    `json:"level"`
+	CarType    string    `json:"car_type"`
+	DailyPrice float64   `json:"daily_price"`
+	Deductible float64   `json:"deductible"`
+	Currency   string    `json:"currency"`
+}
+
+// Protection plan chosen for a reservation. Only the level is taken from the
+// request, the terms of the plan are set when the reservation is booked.
+type ReservationProtection struct {
+	Level      string  `json:"level"`
+	DailyPrice float64 `json:"daily_price"`
+	Deductible float64 `json:"deductible"`
+}
+
+func (pp ProtectionPlan) ToDomain() domain.ProtectionPlan {
+	return domain.ProtectionPlan{
+		ID:         pp.ID,
+		Level:      pp.Level,
+		CarType:    pp.CarType,
+		DailyPrice: pp.DailyPrice,
+		Deductible: pp.Deductible,
+		Currency:   pp.Currency,
+	}
+}
+
+func (pp *ProtectionPlan) FromDomain(dpp domain.ProtectionPlan) {
+	pp.ID = dpp.ID
+	pp.Level = dpp.Level
+	pp.CarType = dpp.CarType
+	pp.DailyPrice = dpp.DailyPrice
+	pp.Deductible = dpp.Deductible
+	pp.Currency = dpp.Currency
+}
+
+func ProtectionPlanFromBody(body io.Reader) (ProtectionPlan, error) {
+	var plan ProtectionPlan
+	err := json.NewDecoder(body).Decode(&plan)
+	if err != nil {
+		return ProtectionPlan{}, err
+	}
+
+	if !isValidProtectionLevel(plan.Level) {
+		return ProtectionPlan{}, errors.New(ErrInvalidProtectionLevel)
+	}
+
+	if !IsValidCarType(plan.CarType) {
+		return ProtectionPlan{}, errors.New(ErrInvalidCarType)
+	}
+
+	if plan.DailyPrice < 0 {
+		return ProtectionPlan{}, errors.New(ErrInvalidProtectionDailyPrice)
+	}
+	if plan.Deductible < 0 {
+		return ProtectionPlan{}, errors.New(ErrInvalidProtectionDeductible)
+	}
+
+	plan.Currency = strings.ToUpper(strings.TrimSpace(plan.Currency))
+	if !IsValidCurrency(plan.Currency) {
+		return ProtectionPlan{}, errors.New(ErrInvalidCurrency)
+	}
+
+	return plan, nil
+}
+
+func isValidProtectionLevel(level string) bool {
+	protectionLevels := constants.Values().PROTECTION_LEVELS.Values()
+
+	return utils.IsInSlice(protectionLevels, level)
+}
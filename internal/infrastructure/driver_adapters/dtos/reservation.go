@@ -14,7 +14,6 @@ import (
 
 var (
 	ErrInvalidReservationStatus = "invalid reservation status"
-	ErrInvalidPaymentStatus     = "invalid payment status"
 )
 
 type Reservations struct {
@@ -22,24 +21,63 @@ type Reservations struct {
 }
 
 type Reservation struct {
-	ID            uuid.UUID `json:"id,omitempty"`
-	UserID        uuid.UUID `json:"user_id"`
-	CarID         uuid.UUID `json:"car_id"`
-	Status        string    `json:"status"`
-	PaymentStatus string    `json:"payment_status"`
-	StartDate     time.Time `json:"start_date"`
-	EndDate       time.Time `json:"end_date"`
+	ID                  uuid.UUID             `json:"id,omitempty"`
+	UserID              uuid.UUID             `json:"user_id"`
+	CarID               uuid.UUID             `json:"car_id"`
+	Status              string                `json:"status"`
+	PaymentStatus       string                `json:"payment_status"`
+	StartDate           time.Time             `json:"start_date"`
+	EndDate             time.Time             `json:"end_date"`
+	TimeZone            string                `json:"time_zone,omitempty"`
+	PickupBranchID      *uuid.UUID            `json:"pickup_branch_id,omitempty"`
+	ReturnBranchID      *uuid.UUID            `json:"return_branch_id,omitempty"`
+	OneWayFee           float64               `json:"one_way_fee"`
+	Currency            string                `json:"currency,omitempty"`
+	AdditionalDriverIDs []uuid.UUID           `json:"additional_driver_ids"`
+	AddOns              []ReservationAddOn    `json:"add_ons"`
+	Protection          ReservationProtection `json:"protection"`
+	DepositStatus       string                `json:"deposit_status"`
+	Quote               *Quote                `json:"quote,omitempty"`
 }
 
+type Quote struct {
+	RentalCost           float64          `json:"rental_cost"`
+	OneWayFee            float64          `json:"one_way_fee"`
+	AdditionalDriversFee float64          `json:"additional_drivers_fee"`
+	AddOnsCost           float64          `json:"add_ons_cost"`
+	ProtectionCost       float64          `json:"protection_cost"`
+	Subtotal             float64          `json:"subtotal"`
+	Taxes                []ReservationTax `json:"taxes"`
+	TaxTotal             float64          `json:"tax_total"`
+	Total                float64          `json:"total"`
+	Currency             string           `json:"currency,omitempty"`
+	Display              *DisplayQuote    `json:"display,omitempty"`
+}
+
+// Prices, taxes and protection terms are not taken from the request, they are set when
+// the reservation is booked. Neither are the payment and deposit statuses, which
+// only payments and deposit holds change.
 func (r Reservation) ToDomain() domain.Reservation {
+	var addOns []domain.ReservationAddOn
+	for _, addOn := range r.AddOns {
+		addOns = append(addOns, domain.ReservationAddOn{
+			AddOnID:  addOn.AddOnID,
+			Quantity: addOn.Quantity,
+		})
+	}
+
 	return domain.Reservation{
-		ID:            r.ID,
-		UserID:        r.UserID,
-		CarID:         r.CarID,
-		Status:        r.Status,
-		PaymentStatus: r.PaymentStatus,
-		StartDate:     r.StartDate,
-		EndDate:       r.EndDate,
+		ID:                  r.ID,
+		UserID:              r.UserID,
+		CarID:               r.CarID,
+		Status:              r.Status,
+		StartDate:           r.StartDate,
+		EndDate:             r.EndDate,
+		PickupBranchID:      r.PickupBranchID,
+		ReturnBranchID:      r.ReturnBranchID,
+		AdditionalDriverIDs: r.AdditionalDriverIDs,
+		AddOns:              addOns,
+		Protection:          domain.ReservationProtection{Level: r.Protection.Level},
 	}
 }
 
@@ -49,8 +87,62 @@ func (r *Reservation) FromDomain(dr domain.Reservation) {
 	r.CarID = dr.CarID
 	r.Status = dr.Status
 	r.PaymentStatus = dr.PaymentStatus
+	r.DepositStatus = dr.DepositStatus
 	r.StartDate = dr.StartDate
 	r.EndDate = dr.EndDate
+	r.TimeZone = dr.TimeZone
+	r.PickupBranchID = dr.PickupBranchID
+	r.ReturnBranchID = dr.ReturnBranchID
+	r.OneWayFee = dr.OneWayFee.Major()
+	r.Currency = dr.Currency
+	r.AdditionalDriverIDs = dr.AdditionalDriverIDs
+	if r.AdditionalDriverIDs == nil {
+		r.AdditionalDriverIDs = []uuid.UUID{}
+	}
+	r.AddOns = make([]ReservationAddOn, 0, len(dr.AddOns))
+	for _, addOn := range dr.AddOns {
+		r.AddOns = append(r.AddOns, ReservationAddOn{
+			AddOnID:  addOn.AddOnID,
+			Quantity: addOn.Quantity,
+			Cost:     addOn.Cost.Major(),
+		})
+	}
+	r.Protection = ReservationProtection{
+		Level:      dr.Protection.Level,
+		DailyPrice: dr.Protection.DailyPrice.Major(),
+		Deductible: dr.Protection.Deductible.Major(),
+	}
+	quote := QuoteFromDomain(dr.Quote())
+	r.Quote = &quote
+}
+
+func QuoteFromDomain(dq domain.Quote) Quote {
+	return Quote{
+		RentalCost:           dq.RentalCost.Major(),
+		OneWayFee:            dq.OneWayFee.Major(),
+		AdditionalDriversFee: dq.AdditionalDriversFee.Major(),
+		AddOnsCost:           dq.AddOnsCost.Major(),
+		ProtectionCost:       dq.ProtectionCost.Major(),
+		Subtotal:             dq.Subtotal.Major(),
+		Taxes:                reservationTaxesFromDomain(dq.Taxes),
+		TaxTotal:             dq.TaxTotal.Major(),
+		Total:                dq.Total.Major(),
+		Currency:             dq.Currency,
+		Display:              displayQuoteFromDomain(dq.Display),
+	}
+}
+
+// Converts domain reservations, an empty list is returned when there are none
+func reservationsFromDomain(drs []domain.Reservation) []Reservation {
+	reservations := make([]Reservation, 0, len(drs))
+	for _, dr := range drs {
+		reservation := Reservation{}
+		reservation.FromDomain(dr)
+
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations
 }
 
 func ReservationFromBody(body io.Reader) (Reservation, error) {
@@ -64,21 +156,22 @@ func ReservationFromBody(body io.Reader) (Reservation, error) {
 		return Reservation{}, errors.New(ErrInvalidReservationStatus)
 	}
 
-	if !isValidPaymentStatus(reservation.PaymentStatus) {
-		return Reservation{}, errors.New(ErrInvalidPaymentStatus)
+	// the default protection level is used when none is chosen
+	if reservation.Protection.Level != "" && !isValidProtectionLevel(reservation.Protection.Level) {
+		return Reservation{}, errors.New(ErrInvalidProtectionLevel)
+	}
+
+	for _, addOn := range reservation.AddOns {
+		if addOn.Quantity <= 0 {
+			return Reservation{}, errors.New(ErrInvalidAddOnQuantity)
+		}
 	}
 
 	return reservation, nil
 }
 
 func isValidReservationStatus(status string) bool {
-	reservationStatuses := constants.Values.RESERVATION_STATUSES.Values()
+	reservationStatuses := constants.Values().RESERVATION_STATUSES.Values()
 
 	return utils.IsInSlice(reservationStatuses, status)
 }
-
-func isValidPaymentStatus(status string) bool {
-	paymentStatuses := constants.Values.PAYMENT_STATUSES.Values()
-
-	return utils.IsInSlice(paymentStatuses, status)
-}
@@ -58,7 +58,7 @@ func TestReservationFromBody(t *testing.T) {
 			},
 		},
 		{
-			name: "returns invalid payment status when received one is not one of the expected values",
+			name: "ignores the payment status as it is set by the payment flow",
 			args: args{
 				reservation: Reservation{
 					UserID:        uuid.New(),
@@ -70,7 +70,41 @@ func TestReservationFromBody(t *testing.T) {
 				},
 			},
 			wants: wants{
-				err: errors.New(ErrInvalidPaymentStatus),
+				err: nil,
+			},
+		},
+		{
+			name: "returns invalid add-on quantity when an add-on is selected without units",
+			args: args{
+				reservation: Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now(),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+					AddOns:        []ReservationAddOn{{AddOnID: uuid.New(), Quantity: 0}},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidAddOnQuantity),
+			},
+		},
+		{
+			name: "returns invalid protection level when the level does not exist",
+			args: args{
+				reservation: Reservation{
+					UserID:        uuid.New(),
+					CarID:         uuid.New(),
+					Status:        "Reserved",
+					PaymentStatus: "Pending",
+					StartDate:     time.Now(),
+					EndDate:       time.Now().AddDate(0, 0, 7),
+					Protection:    ReservationProtection{Level: "Premium"},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidProtectionLevel),
 			},
 		},
 	}
@@ -89,3 +123,14 @@ func TestReservationFromBody(t *testing.T) {
 		})
 	}
 }
+
+func TestReservationToDomainIgnoresPaymentStatus(t *testing.T) {
+	reservation := Reservation{
+		UserID:        uuid.New(),
+		CarID:         uuid.New(),
+		Status:        "Reserved",
+		PaymentStatus: "Paid",
+	}
+
+	assert.Equal(t, "", reservation.ToDomain().PaymentStatus)
+}
@@ -0,0 +1,107 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTaxRuleNameMissing         = "tax rule name cannot be empty"
+	ErrTaxRuleJurisdictionMissing = "tax rule jurisdiction cannot be empty"
+	ErrInvalidTaxRate             = "tax rate must be greater than 0 and lower than 1"
+	ErrInvalidTaxBases            = "tax rule must apply to distinct parts of the price among Rental, Add-ons, Protection and Fees"
+	ErrInvalidTaxRounding         = "invalid tax rounding"
+)
+
+type TaxRules struct {
+	TaxRules []TaxRule `json:"tax_rules"`
+}
+
+type TaxRule struct {
+	ID           uuid.UUID  `json:"id"`
+	CityID       uuid.UUID  `json:"city_id"`
+	BranchID     *uuid.UUID `json:"branch_id"`
+	Name         string     `json:"name"`
+	Jurisdiction string     `json:"jurisdiction"`
+	Rate         float64    `json:"rate"`
+	AppliesTo    []string   `json:"applies_to"`
+	Rounding     string     `json:"rounding"`
+}
+
+type ReservationTax struct {
+	Name   string  `json:"name"`
+	Rate   float64 `json:"rate"`
+	Amount float64 `json:"amount"`
+}
+
+func (tr TaxRule) ToDomain() domain.TaxRule {
+	return domain.TaxRule(tr)
+}
+
+func (tr *TaxRule) FromDomain(dtr domain.TaxRule) {
+	*tr = TaxRule(dtr)
+}
+
+// Converts the taxes of a reservation, an empty list is returned when there are none
+func reservationTaxesFromDomain(drts []domain.ReservationTax) []ReservationTax {
+	taxes := make([]ReservationTax, 0, len(drts))
+	for _, drt := range drts {
+		taxes = append(taxes, ReservationTax{Name: drt.Name, Rate: drt.Rate, Amount: drt.Amount.Major()})
+	}
+
+	return taxes
+}
+
+func TaxRuleFromBody(body io.Reader) (TaxRule, error) {
+	var rule TaxRule
+	err := json.NewDecoder(body).Decode(&rule)
+	if err != nil {
+		return TaxRule{}, err
+	}
+
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return TaxRule{}, errors.New(ErrTaxRuleNameMissing)
+	}
+
+	rule.Jurisdiction = strings.TrimSpace(rule.Jurisdiction)
+	if rule.Jurisdiction == "" {
+		return TaxRule{}, errors.New(ErrTaxRuleJurisdictionMissing)
+	}
+
+	if rule.Rate <= 0 || rule.Rate >= 1 {
+		return TaxRule{}, errors.New(ErrInvalidTaxRate)
+	}
+
+	if !areValidTaxBases(rule.AppliesTo) {
+		return TaxRule{}, errors.New(ErrInvalidTaxBases)
+	}
+
+	if !utils.IsInSlice(constants.Values().TAX_ROUNDINGS.Values(), rule.Rounding) {
+		return TaxRule{}, errors.New(ErrInvalidTaxRounding)
+	}
+
+	return rule, nil
+}
+
+func areValidTaxBases(bases []string) bool {
+	if len(bases) == 0 {
+		return false
+	}
+
+	taxBases := constants.Values().TAX_BASES.Values()
+	for i, base := range bases {
+		if !utils.IsInSlice(taxBases, base) || utils.IsInSlice(bases[:i], base) {
+			return false
+		}
+	}
+
+	return true
+}
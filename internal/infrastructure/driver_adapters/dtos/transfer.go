@@ -0,0 +1,65 @@
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+var ErrEmptyTransferCity = "destination city id cannot be empty"
+
+type Transfers struct {
+	Transfers []Transfer `json:"transfers"`
+}
+
+type Transfer struct {
+	ID            uuid.UUID  `json:"id,omitempty"`
+	CarID         uuid.UUID  `json:"car_id"`
+	FromCityID    uuid.UUID  `json:"from_city_id"`
+	ToCityID      uuid.UUID  `json:"to_city_id"`
+	ToBranchID    *uuid.UUID `json:"to_branch_id,omitempty"`
+	DepartureDate time.Time  `json:"departure_date"`
+	ArrivalDate   time.Time  `json:"arrival_date"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
+
+// The origin city and the completion date are set by the service
+func (t Transfer) ToDomain() domain.Transfer {
+	return domain.Transfer{
+		ID:            t.ID,
+		CarID:         t.CarID,
+		ToCityID:      t.ToCityID,
+		ToBranchID:    t.ToBranchID,
+		DepartureDate: t.DepartureDate,
+		ArrivalDate:   t.ArrivalDate,
+	}
+}
+
+func (t *Transfer) FromDomain(dt domain.Transfer) {
+	t.ID = dt.ID
+	t.CarID = dt.CarID
+	t.FromCityID = dt.FromCityID
+	t.ToCityID = dt.ToCityID
+	t.ToBranchID = dt.ToBranchID
+	t.DepartureDate = dt.DepartureDate
+	t.ArrivalDate = dt.ArrivalDate
+	t.CompletedAt = dt.CompletedAt
+}
+
+func TransferFromBody(body io.Reader) (Transfer, error) {
+	var transfer Transfer
+	err := json.NewDecoder(body).Decode(&transfer)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	if transfer.ToCityID == uuid.Nil {
+		return Transfer{}, errors.New(ErrEmptyTransferCity)
+	}
+
+	return transfer, nil
+}
@@ -3,7 +3,12 @@ package dtos
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
@@ -12,11 +17,18 @@ import (
 )
 
 var (
-	ErrEmptyFirstName    = "first name cannot be empty"
-	ErrEmptyLastName     = "last name cannot be empty"
-	ErrInvalidEmail      = "invalid email"
-	ErrInvalidUserType   = "invalid user type"
-	ErrInvalidUserStatus = "invalid user status"
+	ErrEmptyFirstName              = "first name cannot be empty"
+	ErrEmptyLastName               = "last name cannot be empty"
+	ErrInvalidEmail                = "invalid email"
+	ErrInvalidUserType             = "invalid user type"
+	ErrInvalidUserStatus           = "invalid user status"
+	ErrEmptyToken                  = "token cannot be empty"
+	ErrShortPassword               = "password is shorter than minimum allowed"
+	ErrInvalidDateOfBirth          = "date of birth is invalid"
+	ErrEmptyDriverLicenseNumber    = "driver license number cannot be empty"
+	ErrInvalidDriverLicenseCountry = "driver license country must be an ISO 3166-1 alpha-2 code"
+	ErrInvalidDriverLicenseDates   = "driver license must be issued before it expires and not in the future"
+	UserDeactivationWarning        = "the inactive user has upcoming reservations"
 )
 
 type ListUsersResponse struct {
@@ -30,17 +42,59 @@ type User struct {
 	Email     string    `json:"email"`
 	Type      string    `json:"type"`
 	Status    string    `json:"status"`
+	// Set by the email verification flow, it is ignored in requests
+	EmailVerifiedAt *time.Time     `json:"email_verified_at,omitempty"`
+	DateOfBirth     *time.Time     `json:"date_of_birth,omitempty"`
+	DriverLicense   *DriverLicense `json:"driver_license,omitempty"`
+}
+
+// User along with the upcoming reservations affected by their deactivation
+type UserWithAffectedReservations struct {
+	User
+	AffectedReservations []Reservation `json:"affected_reservations"`
+	Warning              string        `json:"warning,omitempty"`
+}
+
+type DriverLicense struct {
+	Number     string    `json:"number"`
+	Country    string    `json:"country"`
+	IssueDate  time.Time `json:"issue_date"`
+	ExpiryDate time.Time `json:"expiry_date"`
+}
+
+type EmailVerification struct {
+	Token string `json:"token"`
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type PasswordReset struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
 }
 
 func (u User) ToDomain() domain.User {
-	return domain.User{
-		ID:        u.ID,
-		FirstName: u.FirstName,
-		LastName:  u.LastName,
-		Email:     u.Email,
-		Type:      u.Type,
-		Status:    u.Status,
+	user := domain.User{
+		ID:          u.ID,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		Email:       u.Email,
+		Type:        u.Type,
+		Status:      u.Status,
+		DateOfBirth: u.DateOfBirth,
+	}
+	if u.DriverLicense != nil {
+		user.DriverLicense = &domain.DriverLicense{
+			Number:     u.DriverLicense.Number,
+			Country:    u.DriverLicense.Country,
+			IssueDate:  u.DriverLicense.IssueDate,
+			ExpiryDate: u.DriverLicense.ExpiryDate,
+		}
 	}
+
+	return user
 }
 
 func (u *User) FromDomain(du domain.User) {
@@ -50,6 +104,17 @@ func (u *User) FromDomain(du domain.User) {
 	u.Email = du.Email
 	u.Type = du.Type
 	u.Status = du.Status
+	u.EmailVerifiedAt = du.EmailVerifiedAt
+	u.DateOfBirth = du.DateOfBirth
+	u.DriverLicense = nil
+	if du.DriverLicense != nil {
+		u.DriverLicense = &DriverLicense{
+			Number:     du.DriverLicense.Number,
+			Country:    du.DriverLicense.Country,
+			IssueDate:  du.DriverLicense.IssueDate,
+			ExpiryDate: du.DriverLicense.ExpiryDate,
+		}
+	}
 }
 
 func UserFromBody(body io.Reader) (User, error) {
@@ -79,21 +144,141 @@ func UserFromBody(body io.Reader) (User, error) {
 		return User{}, errors.New(ErrInvalidUserStatus)
 	}
 
+	if user.DateOfBirth != nil && user.DateOfBirth.After(time.Now()) {
+		return User{}, errors.New(ErrInvalidDateOfBirth)
+	}
+
+	if user.DriverLicense != nil {
+		if err := user.DriverLicense.validate(); err != nil {
+			return User{}, err
+		}
+	}
+
+	user.EmailVerifiedAt = nil
+
 	return user, nil
 }
 
+func (dl *DriverLicense) validate() error {
+	dl.Number = strings.TrimSpace(dl.Number)
+	if dl.Number == "" {
+		return errors.New(ErrEmptyDriverLicenseNumber)
+	}
+
+	if !isValidCountryCode(dl.Country) {
+		return errors.New(ErrInvalidDriverLicenseCountry)
+	}
+
+	if dl.IssueDate.IsZero() || !dl.IssueDate.Before(dl.ExpiryDate) || dl.IssueDate.After(time.Now()) {
+		return errors.New(ErrInvalidDriverLicenseDates)
+	}
+
+	return nil
+}
+
+func EmailVerificationFromBody(body io.Reader) (EmailVerification, error) {
+	var emailVerification EmailVerification
+	if err := json.NewDecoder(body).Decode(&emailVerification); err != nil {
+		return EmailVerification{}, err
+	}
+
+	if emailVerification.Token == "" {
+		return EmailVerification{}, errors.New(ErrEmptyToken)
+	}
+
+	return emailVerification, nil
+}
+
+func PasswordResetRequestFromBody(body io.Reader) (PasswordResetRequest, error) {
+	var passwordResetRequest PasswordResetRequest
+	if err := json.NewDecoder(body).Decode(&passwordResetRequest); err != nil {
+		return PasswordResetRequest{}, err
+	}
+
+	if !isValidEmail(passwordResetRequest.Email) {
+		return PasswordResetRequest{}, errors.New(ErrInvalidEmail)
+	}
+
+	return passwordResetRequest, nil
+}
+
+func PasswordResetFromBody(body io.Reader) (PasswordReset, error) {
+	var passwordReset PasswordReset
+	if err := json.NewDecoder(body).Decode(&passwordReset); err != nil {
+		return PasswordReset{}, err
+	}
+
+	if passwordReset.Token == "" {
+		return PasswordReset{}, errors.New(ErrEmptyToken)
+	}
+
+	minimumPasswordLength := constants.Values().MINIMUM_PASSWORD_LENGTH
+	if utf8.RuneCountInString(passwordReset.Password) < int(minimumPasswordLength) {
+		return PasswordReset{}, fmt.Errorf("%s (%d characters)", ErrShortPassword, minimumPasswordLength)
+	}
+
+	return passwordReset, nil
+}
+
+// Builds the response for an updated user, warning about the affected reservations if any
+func NewUserWithAffectedReservations(du domain.User, affectedReservations []domain.Reservation) UserWithAffectedReservations {
+	var response UserWithAffectedReservations
+
+	response.FromDomain(du)
+	response.AffectedReservations = reservationsFromDomain(affectedReservations)
+	if len(response.AffectedReservations) > 0 {
+		response.Warning = UserDeactivationWarning
+	}
+
+	return response
+}
+
+// Gets the users search filters from query params
+func UserFiltersFromQuery(query url.Values) (domain.UserFilters, error) {
+	filters := domain.UserFilters{
+		Type:   query.Get("type"),
+		Status: query.Get("status"),
+		Search: strings.TrimSpace(query.Get("search")),
+	}
+
+	if filters.Type != "" && !isValidUserType(filters.Type) {
+		return domain.UserFilters{}, errors.New(ErrInvalidUserType)
+	}
+
+	if filters.Status != "" && !isValidUserStatus(filters.Status) {
+		return domain.UserFilters{}, errors.New(ErrInvalidUserStatus)
+	}
+
+	return filters, nil
+}
+
 func isValidEmail(email string) bool {
 	return utils.IsValidEmail(email)
 }
 
+// Country codes are two upper case letters
+func isValidCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+
+	for _, letter := range code {
+		if letter < 'A' || letter > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
 func isValidUserType(userType string) bool {
-	userTypes := constants.Values.USER_TYPES.Values()
+	userTypes := constants.Values().USER_TYPES.Values()
 
 	return utils.IsInSlice(userTypes, userType)
 }
 
 func isValidUserStatus(userStatus string) bool {
-	userStatuses := constants.Values.USER_STATUSES.Values()
+	userStatuses := constants.Values().USER_STATUSES.Values()
 
 	return utils.IsInSlice(userStatuses, userStatus)
 }
@@ -4,13 +4,25 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUserFromBody(t *testing.T) {
 	initConstantsFromDtos(t)
+	dateOfBirth := time.Date(1990, time.May, 11, 0, 0, 0, 0, time.UTC)
+	tomorrow := time.Now().Add(24 * time.Hour)
+	driverLicense := DriverLicense{
+		Number:     "F1918M",
+		Country:    "US",
+		IssueDate:  time.Date(2008, time.June, 1, 0, 0, 0, 0, time.UTC),
+		ExpiryDate: time.Date(2030, time.June, 1, 0, 0, 0, 0, time.UTC),
+	}
 
 	type args struct {
 		user User
@@ -113,6 +125,87 @@ func TestUserFromBody(t *testing.T) {
 				err: errors.New(ErrInvalidUserStatus),
 			},
 		},
+		{
+			name: "returns user structure when driver license is valid",
+			args: args{
+				user: User{
+					FirstName:     "Richard",
+					LastName:      "Feynman",
+					Email:         "richard.feynman@caltech.edu.us",
+					Type:          "Customer",
+					Status:        "Active",
+					DateOfBirth:   &dateOfBirth,
+					DriverLicense: &driverLicense,
+				},
+			},
+			wants: wants{
+				err: nil,
+			},
+		},
+		{
+			name: "returns invalid date of birth when it is in the future",
+			args: args{
+				user: User{
+					FirstName:   "Richard",
+					LastName:    "Feynman",
+					Email:       "richard.feynman@caltech.edu.us",
+					Type:        "Customer",
+					Status:      "Active",
+					DateOfBirth: &tomorrow,
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidDateOfBirth),
+			},
+		},
+		{
+			name: "returns empty driver license number when it is blank",
+			args: args{
+				user: User{
+					FirstName:     "Richard",
+					LastName:      "Feynman",
+					Email:         "richard.feynman@caltech.edu.us",
+					Type:          "Customer",
+					Status:        "Active",
+					DriverLicense: &DriverLicense{Number: "  ", Country: "US", IssueDate: driverLicense.IssueDate, ExpiryDate: driverLicense.ExpiryDate},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrEmptyDriverLicenseNumber),
+			},
+		},
+		{
+			name: "returns invalid driver license country when it is not an alpha-2 code",
+			args: args{
+				user: User{
+					FirstName:     "Richard",
+					LastName:      "Feynman",
+					Email:         "richard.feynman@caltech.edu.us",
+					Type:          "Customer",
+					Status:        "Active",
+					DriverLicense: &DriverLicense{Number: "F1918M", Country: "USA", IssueDate: driverLicense.IssueDate, ExpiryDate: driverLicense.ExpiryDate},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidDriverLicenseCountry),
+			},
+		},
+		{
+			name: "returns invalid driver license dates when it expires before being issued",
+			args: args{
+				user: User{
+					FirstName:     "Richard",
+					LastName:      "Feynman",
+					Email:         "richard.feynman@caltech.edu.us",
+					Type:          "Customer",
+					Status:        "Active",
+					DriverLicense: &DriverLicense{Number: "F1918M", Country: "US", IssueDate: driverLicense.ExpiryDate, ExpiryDate: driverLicense.IssueDate},
+				},
+			},
+			wants: wants{
+				err: errors.New(ErrInvalidDriverLicenseDates),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -129,3 +222,62 @@ func TestUserFromBody(t *testing.T) {
 		})
 	}
 }
+
+func TestPasswordResetFromBody(t *testing.T) {
+	initConstantsFromDtos(t)
+
+	tests := []struct {
+		name          string
+		passwordReset PasswordReset
+		err           error
+	}{
+		{
+			name:          "returns nil error when token and password are given",
+			passwordReset: PasswordReset{Token: "token", Password: "correct horse"},
+			err:           nil,
+		},
+		{
+			name:          "returns empty token when it is not given",
+			passwordReset: PasswordReset{Password: "correct horse"},
+			err:           errors.New(ErrEmptyToken),
+		},
+		{
+			name:          "returns short password when it has less characters than the minimum",
+			passwordReset: PasswordReset{Token: "token", Password: "señor"},
+			err:           fmt.Errorf("%s (8 characters)", ErrShortPassword),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, err := json.Marshal(test.passwordReset)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = PasswordResetFromBody(bytes.NewBuffer(body))
+
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestNewUserWithAffectedReservations(t *testing.T) {
+	user := domain.User{
+		ID:        uuid.New(),
+		FirstName: "Richard",
+		LastName:  "Feynman",
+		Email:     "richard.feynman@caltech.edu.us",
+		Type:      "Customer",
+		Status:    "Inactive",
+	}
+
+	response := NewUserWithAffectedReservations(user, nil)
+	assert.Equal(t, "", response.Warning)
+	assert.Equal(t, []Reservation{}, response.AffectedReservations)
+
+	response = NewUserWithAffectedReservations(user, []domain.Reservation{{ID: uuid.New(), UserID: user.ID}})
+	assert.Equal(t, UserDeactivationWarning, response.Warning)
+	assert.Len(t, response.AffectedReservations, 1)
+	assert.Equal(t, user.ID, response.ID)
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type AddOns struct {
+	AddOnsService ports.AddOnsService
+}
+
+func NewAddOns(aos ports.AddOnsService) AddOns {
+	return AddOns{
+		AddOnsService: aos,
+	}
+}
+
+// @Summary Register a new add-on
+// @Description Register an add-on (child seat, GPS, snow chains...) offered at a branch with the units in stock.
+// @Description The price is charged per day of rental or once per rental depending on the pricing unit.
+// @ID register-add-on
+// @Accept json
+// @Produce json
+// @Param add_on body docs.AddOnRequest true "Add-on information"
+// @Success 201 {object} docs.AddOnResponse "Created add-on"
+// @Failure 400 {object} docs.ErrorAddOnNameInUse "Bad Request"
+// @Failure 404 {object} docs.ErrorBranchNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags AddOns
+// @Router /add-ons [post]
+func (aoh AddOns) Register(w http.ResponseWriter, r *http.Request) {
+	addOn, err := dtos.AddOnFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dao, err := aoh.AddOnsService.Register(r.Context(), addOn.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrAddOnNameInUse {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	addOn.FromDomain(dao)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, addOn)
+}
+
+// @Summary Get an add-on
+// @Description Get an add-on by UUID
+// @ID get-add-on
+// @Produce json
+// @Param id path string true "Add-on UUID" format(uuid)
+// @Success 200 {object} docs.AddOnResponse "Obtained add-on"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorAddOnNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags AddOns
+// @Router /add-ons/{id} [get]
+func (aoh AddOns) Get(w http.ResponseWriter, r *http.Request) {
+	var addOn dtos.AddOn
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dao, err := aoh.AddOnsService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrAddOnNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	addOn.FromDomain(dao)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, addOn)
+}
+
+// @Summary Update an add-on
+// @Description Update an add-on by UUID
+// @ID update-add-on
+// @Accept json
+// @Produce json
+// @Param id path string true "Add-on UUID" format(uuid)
+// @Param add_on body docs.AddOnRequest true "Add-on information"
+// @Success 200 {object} docs.AddOnResponse "Updated add-on"
+// @Failure 400 {object} docs.ErrorAddOnNameInUse "Bad Request"
+// @Failure 404 {object} docs.ErrorAddOnNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags AddOns
+// @Router /add-ons/{id} [put]
+func (aoh AddOns) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	addOn, err := dtos.AddOnFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	addOn.ID = ID
+
+	if err = aoh.AddOnsService.FullUpdate(r.Context(), addOn.ToDomain()); err != nil {
+		if err.Error() == services.ErrAddOnNotFound || err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrAddOnNameInUse {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, addOn)
+}
+
+// @Summary Delete an add-on
+// @Description Delete an add-on by UUID. Add-ons booked in reservations can not be deleted.
+// @ID delete-add-on
+// @Produce json
+// @Param id path string true "Add-on UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorAddOnHasReservations "Bad Request"
+// @Failure 404 {object} docs.ErrorAddOnNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags AddOns
+// @Router /add-ons/{id} [delete]
+func (aoh AddOns) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = aoh.AddOnsService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrAddOnNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrAddOnHasReservations {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary List the add-ons of a branch
+// @Description List the add-ons offered at a branch ordered by name
+// @ID list-branch-add-ons
+// @Produce json
+// @Param id path string true "Branch UUID" format(uuid)
+// @Success 200 {object} docs.ListAddOnsResponse "Add-ons of the branch"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags AddOns
+// @Router /branches/{id}/add-ons [get]
+func (aoh AddOns) ListByBranchID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	branchID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	daos, err := aoh.AddOnsService.ListByBranchID(r.Context(), branchID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	addOns := dtos.AddOns{AddOns: make([]dtos.AddOn, 0, len(daos))}
+	for _, dao := range daos {
+		addOn := dtos.AddOn{}
+		addOn.FromDomain(dao)
+		addOns.AddOns = append(addOns.AddOns, addOn)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, addOns)
+}
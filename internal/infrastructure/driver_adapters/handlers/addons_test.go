@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type addOnsDependencies struct {
+	addOnsService *mocks.MockAddOnsService
+}
+
+func NewAddOnsDependencies(addOnsSrv *mocks.MockAddOnsService) *addOnsDependencies {
+	return &addOnsDependencies{
+		addOnsService: addOnsSrv,
+	}
+}
+
+func TestAddOnsRegister(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	addOn := dtos.AddOn{
+		BranchID:    uuid.New(),
+		Name:        "Child seat",
+		Stock:       4,
+		Price:       12.5,
+		Currency:    "USD",
+		PricingUnit: "Per Day",
+	}
+	invalidPricingUnit := addOn
+	invalidPricingUnit.PricingUnit = "Per Week"
+
+	type args struct {
+		body dtos.AddOn
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*addOnsDependencies)
+	}{
+		{
+			name: "returns status code 201 when the add-on was registered",
+			args: args{
+				body: addOn,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Register(gomock.Any(), addOn.ToDomain()).Return(domain.AddOn{ID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the pricing unit is not valid",
+			args: args{
+				body: invalidPricingUnit,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *addOnsDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the branch already has an add-on with the same name",
+			args: args{
+				body: addOn,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Register(gomock.Any(), addOn.ToDomain()).Return(domain.AddOn{}, errors.New(services.ErrAddOnNameInUse))
+			},
+		},
+		{
+			name: "returns 404 status code when the branch was not found",
+			args: args{
+				body: addOn,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Register(gomock.Any(), addOn.ToDomain()).Return(domain.AddOn{}, errors.New(services.ErrBranchNotFound))
+			},
+		},
+		{
+			name: "returns 500 status code when add-ons service fails to register the add-on",
+			args: args{
+				body: addOn,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Register(gomock.Any(), addOn.ToDomain()).Return(domain.AddOn{}, errors.New("error registering add-on"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			addOnsSrv := mocks.NewMockAddOnsService(mockCtlr)
+			d := NewAddOnsDependencies(addOnsSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.body)
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/add-ons", bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			addOnsHandler := NewAddOns(addOnsSrv)
+			addOnsHandler.Register(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestAddOnsDelete(t *testing.T) {
+	ID := uuid.New()
+
+	type args struct {
+		id string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*addOnsDependencies)
+	}{
+		{
+			name: "returns status code 204 when the add-on was deleted",
+			args: args{
+				id: ID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Delete(gomock.Any(), ID).Return(nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the add-on is booked in reservations",
+			args: args{
+				id: ID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Delete(gomock.Any(), ID).Return(errors.New(services.ErrAddOnHasReservations))
+			},
+		},
+		{
+			name: "returns 404 status code when the add-on was not found",
+			args: args{
+				id: ID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *addOnsDependencies) {
+				d.addOnsService.EXPECT().Delete(gomock.Any(), ID).Return(errors.New(services.ErrAddOnNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			addOnsSrv := mocks.NewMockAddOnsService(mockCtlr)
+			d := NewAddOnsDependencies(addOnsSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodDelete, "/api/v1/add-ons/"+test.args.id, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.id})
+
+			rr := httptest.NewRecorder()
+
+			addOnsHandler := NewAddOns(addOnsSrv)
+			addOnsHandler.Delete(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Branches struct {
+	BranchesService ports.BranchesService
+}
+
+func NewBranches(bs ports.BranchesService) Branches {
+	return Branches{
+		BranchesService: bs,
+	}
+}
+
+// @Summary Register a new branch
+// @Description Register a branch of a city with its opening hours and holiday closures.
+// @Description Opening hours are given in the city local time; weekdays without opening hours are closed.
+// @ID register-branch
+// @Accept json
+// @Produce json
+// @Param branch body docs.BranchRequest true "Branch information (weekday goes from 0 for Sunday to 6 for Saturday)"
+// @Success 201 {object} docs.BranchResponse "Created branch"
+// @Failure 400 {object} docs.ErrorInvalidOpeningHours "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Branches
+// @Router /branches [post]
+func (bh Branches) Register(w http.ResponseWriter, r *http.Request) {
+	branch, err := dtos.BranchFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	db, err := bh.BranchesService.Register(r.Context(), branch.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	branch.FromDomain(db)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, branch)
+}
+
+// @Summary Get a branch
+// @Description Get a branch by UUID along with its opening hours and closures
+// @ID get-branch
+// @Produce json
+// @Param id path string true "Branch UUID" format(uuid)
+// @Success 200 {object} docs.BranchResponse "Obtained branch"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorBranchNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Branches
+// @Router /branches/{id} [get]
+func (bh Branches) Get(w http.ResponseWriter, r *http.Request) {
+	var branch dtos.Branch
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	db, err := bh.BranchesService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	branch.FromDomain(db)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, branch)
+}
+
+// @Summary Update a branch
+// @Description Update a branch by UUID. Opening hours and closures are replaced by the given ones.
+// @ID update-branch
+// @Accept json
+// @Produce json
+// @Param id path string true "Branch UUID" format(uuid)
+// @Param branch body docs.BranchRequest true "Branch information (weekday goes from 0 for Sunday to 6 for Saturday)"
+// @Success 200 {object} docs.BranchResponse "Updated branch"
+// @Failure 400 {object} docs.ErrorBranchHasCars "Bad Request"
+// @Failure 404 {object} docs.ErrorBranchNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Branches
+// @Router /branches/{id} [put]
+func (bh Branches) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	branch, err := dtos.BranchFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	branch.ID = ID
+
+	if err = bh.BranchesService.FullUpdate(r.Context(), branch.ToDomain()); err != nil {
+		if err.Error() == services.ErrBranchNotFound || err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrBranchHasCars {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, branch)
+}
+
+// @Summary Delete a branch
+// @Description Delete a branch by UUID. Branches with cars or reservations can not be deleted.
+// @ID delete-branch
+// @Produce json
+// @Param id path string true "Branch UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorBranchHasCars "Bad Request"
+// @Failure 404 {object} docs.ErrorBranchNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Branches
+// @Router /branches/{id} [delete]
+func (bh Branches) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = bh.BranchesService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrBranchHasCars || err.Error() == services.ErrBranchHasReservations {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary List the branches of a city
+// @Description List the branches of a city ordered by name
+// @ID list-city-branches
+// @Produce json
+// @Param city_id path string true "City UUID" format(uuid)
+// @Success 200 {object} docs.ListBranchesResponse "Branches of the city"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Branches
+// @Router /cities/{city_id}/branches [get]
+func (bh Branches) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	cityID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dbs, err := bh.BranchesService.ListByCityID(r.Context(), cityID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	branches := dtos.Branches{Branches: make([]dtos.Branch, 0, len(dbs))}
+	for _, db := range dbs {
+		branch := dtos.Branch{}
+		branch.FromDomain(db)
+		branches.Branches = append(branches.Branches, branch)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, branches)
+}
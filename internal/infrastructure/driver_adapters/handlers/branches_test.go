@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type branchesDependencies struct {
+	branchesService *mocks.MockBranchesService
+}
+
+func NewBranchesDependencies(branchesSrv *mocks.MockBranchesService) *branchesDependencies {
+	return &branchesDependencies{
+		branchesService: branchesSrv,
+	}
+}
+
+func TestBranchesRegister(t *testing.T) {
+	branch := dtos.Branch{
+		CityID:    uuid.New(),
+		Name:      "Chicago Downtown",
+		Address:   "55 W Randolph St, Chicago, IL 60601",
+		Latitude:  41.8837,
+		Longitude: -87.6289,
+		OpeningHours: []dtos.OpeningHours{
+			{Weekday: time.Monday, OpensAt: "07:00", ClosesAt: "21:00"},
+		},
+		Closures: []dtos.BranchClosure{},
+	}
+
+	type args struct {
+		branch dtos.Branch
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*branchesDependencies)
+	}{
+		{
+			name: "returns status code 201 when body is appropriate",
+			args: args{
+				branch: branch,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Register(gomock.Any(), branch.ToDomain()).Return(domain.Branch{}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when opening hours are not valid",
+			args: args{
+				branch: dtos.Branch{
+					CityID:       branch.CityID,
+					Name:         branch.Name,
+					Address:      branch.Address,
+					OpeningHours: []dtos.OpeningHours{{Weekday: time.Monday, OpensAt: "21:00", ClosesAt: "07:00"}},
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *branchesDependencies) {},
+		},
+		{
+			name: "returns 404 status code when the city was not found",
+			args: args{
+				branch: branch,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Register(gomock.Any(), branch.ToDomain()).Return(domain.Branch{}, errors.New("city not found"))
+			},
+		},
+		{
+			name: "returns 500 status code when branches service fails to register branch",
+			args: args{
+				branch: branch,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Register(gomock.Any(), branch.ToDomain()).Return(domain.Branch{}, errors.New("error registering branch"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			branchesSrv := mocks.NewMockBranchesService(mockCtlr)
+			d := NewBranchesDependencies(branchesSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.branch)
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/branches", bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			branchesHandler := NewBranches(branchesSrv)
+			branchesHandler.Register(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestBranchesDelete(t *testing.T) {
+	branchID := uuid.New()
+
+	type args struct {
+		requestID string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*branchesDependencies)
+	}{
+		{
+			name: "returns status code 204 when branch was deleted successfully",
+			args: args{
+				requestID: branchID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Delete(gomock.Any(), branchID).Return(nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the branch still has cars",
+			args: args{
+				requestID: branchID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Delete(gomock.Any(), branchID).Return(errors.New("branch can not be moved or deleted while it has cars"))
+			},
+		},
+		{
+			name: "returns 404 status code when the branch was not found",
+			args: args{
+				requestID: branchID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *branchesDependencies) {
+				d.branchesService.EXPECT().Delete(gomock.Any(), branchID).Return(errors.New("branch not found"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			branchesSrv := mocks.NewMockBranchesService(mockCtlr)
+			d := NewBranchesDependencies(branchesSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodDelete, "/api/v1/branches/"+test.args.requestID, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Include request vars for gorilla mux to interpret path params
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.requestID})
+
+			rr := httptest.NewRecorder()
+
+			branchesHandler := NewBranches(branchesSrv)
+			branchesHandler.Delete(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
@@ -34,9 +35,9 @@ func NewCars(cs ports.CarsService) Cars {
 // @ID register-car
 // @Accept json
 // @Produce json
-// @Param car body docs.CarRequest true "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable)"
+// @Param car body docs.CarRequest true "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable; allowed transmissions: Manual, Automatic; allowed fuel types: Gasoline, Diesel, Hybrid, Electric; allowed features: A/C, GPS, Child Seat Ready, Bluetooth)"
 // @Success 201 {object} docs.CarResponse "Created car"
-// @Failure 400 {object} docs.ErrorInvalidCityName "Bad Request"
+// @Failure 400 {object} docs.ErrorLicensePlateAlreadyRegistered "Bad Request"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
 // @Tags Cars
 // @Router /cars [post]
@@ -49,7 +50,10 @@ func (ch Cars) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if newCar, err = ch.CarsService.Register(r.Context(), car.ToDomain()); err != nil {
-		if err.Error() == services.ErrInvalidCityName {
+		if err.Error() == services.ErrInvalidCityName ||
+			err.Error() == services.ErrLicensePlateAlreadyRegistered ||
+			err.Error() == services.ErrVINAlreadyRegistered ||
+			err.Error() == services.ErrBranchNotInCarCity {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
@@ -105,13 +109,13 @@ func (ch Cars) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Update a car
-// @Description Update a car by UUID
+// @Description Update a car by UUID. Marking a car as unavailable lists its upcoming reservations in the response, as unavailable cars can not be booked.
 // @ID update-car
 // @Accept json
 // @Produce json
 // @Param id path string true "Car UUID" format(uuid)
-// @Param car body docs.CarRequest true "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable)"
-// @Success 200 {object} docs.CarResponse "Updated car"
+// @Param car body docs.CarRequest true "Car information (allowed types: Sedan, Luxury, Sports Car, Limousine; allowed statuses: Available, Unavailable; allowed transmissions: Manual, Automatic; allowed fuel types: Gasoline, Diesel, Hybrid, Electric; allowed features: A/C, GPS, Child Seat Ready, Bluetooth)"
+// @Success 200 {object} docs.CarWithAffectedReservationsResponse "Updated car"
 // @Failure 400 {object} docs.ErrorInvalidCarStatus "Bad Request"
 // @Failure 404 {object} docs.ErrorCarNotFound "Not Found"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
@@ -135,10 +139,14 @@ func (ch Cars) FullUpdate(w http.ResponseWriter, r *http.Request) {
 	// Get the ID from path param
 	car.ID = ID
 
-	if err = ch.CarsService.FullUpdate(r.Context(), car.ToDomain()); err != nil {
+	affectedReservations, err := ch.CarsService.FullUpdate(r.Context(), car.ToDomain())
+	if err != nil {
 		if err.Error() == services.ErrCarNotFound {
 			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
-		} else if err.Error() == services.ErrInvalidCityName {
+		} else if err.Error() == services.ErrInvalidCityName ||
+			err.Error() == services.ErrLicensePlateAlreadyRegistered ||
+			err.Error() == services.ErrVINAlreadyRegistered ||
+			err.Error() == services.ErrBranchNotInCarCity {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		} else {
 			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
@@ -148,7 +156,7 @@ func (ch Cars) FullUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httphandler.WriteSuccessResponse(w, http.StatusOK, car)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.NewCarWithAffectedReservations(car.ToDomain(), affectedReservations))
 }
 
 // @Summary Delete a car
@@ -191,6 +199,15 @@ func (ch Cars) Delete(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param city query string true "City name"
 // @Param from_car_id query string false "Last seen car ID" format(uuid)
+// @Param type query string false "Car type"
+// @Param make query string false "Make (case insensitive)"
+// @Param model query string false "Model (case insensitive)"
+// @Param year query int false "Model year"
+// @Param transmission query string false "Transmission (Manual, Automatic)"
+// @Param fuel_type query string false "Fuel type (Gasoline, Diesel, Hybrid, Electric)"
+// @Param color query string false "Color (case insensitive)"
+// @Param features query string false "Comma separated features the car must have (A/C, GPS, Child Seat Ready, Bluetooth)"
+// @Param search query string false "Case insensitive prefix of the make, model or license plate"
 // @Success 200 {object} docs.ListCarsResponse "Obtained car"
 // @Failure 400 {object} docs.ErrorCityQueryParamEmpty "Bad Request"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
@@ -208,7 +225,13 @@ func (ch Cars) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cars, err := ch.CarsService.List(r.Context(), city, from_car_id)
+	filters, err := dtos.CarFiltersFromQuery(r.URL.Query())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cars, err := ch.CarsService.List(r.Context(), city, filters, from_car_id)
 	if err != nil && err.Error() != services.ErrInvalidCityName {
 		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
 		log.Println(err)
@@ -233,3 +256,49 @@ func getListCarsResponse(cars []domain.Car) (listCarsResponse dtos.ListCarsRespo
 
 	return listCarsResponse
 }
+
+// @Summary Search cars near a location
+// @Description Lists the cars available during the time window that can be picked up within
+// @Description radius_km of the location, nearest first. The distance is measured to the branch
+// @Description where each car will be when the window starts. At most 20 cars are returned.
+// @ID search-nearby-cars
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lon query number true "Longitude"
+// @Param radius_km query number true "Search radius in kilometers (maximum 50)"
+// @Param start_date query string true "Start date"
+// @Param end_date query string true "End date"
+// @Success 200 {object} docs.ListNearbyCarsResponse "Obtained cars"
+// @Failure 400 {object} docs.ErrorInvalidSearchRadius "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cars
+// @Router /cars/nearby [get]
+func (ch Cars) SearchNearby(w http.ResponseWriter, r *http.Request) {
+	search, err := dtos.NearbyCarsSearchFromQuery(r.URL.Query())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cars, err := ch.CarsService.SearchNearby(r.Context(), search)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), services.ErrInvalidSearchRadius) || err.Error() == services.ErrInvalidReservationTimeFrame {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	listNearbyCarsResponse := dtos.ListNearbyCarsResponse{Cars: make([]dtos.NearbyCar, 0, len(cars))}
+	for _, domainCar := range cars {
+		car := dtos.NearbyCar{}
+		car.FromDomain(domainCar)
+
+		listNearbyCarsResponse.Cars = append(listNearbyCarsResponse.Cars, car)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, listNearbyCarsResponse)
+}
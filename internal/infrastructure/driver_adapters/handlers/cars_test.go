@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
 	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
@@ -44,8 +47,18 @@ func TestCarsRegister(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -97,6 +110,18 @@ func TestCarsRegister(t *testing.T) {
 				d.carsService.EXPECT().Register(gomock.Any(), car.ToDomain()).Return(domain.Car{}, errors.New("city name is not valid"))
 			},
 		},
+		{
+			name: "returns status code 400 when license plate is already registered",
+			args: args{
+				car: car,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsService.EXPECT().Register(gomock.Any(), car.ToDomain()).Return(domain.Car{}, errors.New("license plate already registered"))
+			},
+		},
 		{
 			name: "returns 500 status code when car service fails to register car",
 			args: args{
@@ -142,8 +167,18 @@ func TestCarsGet(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -247,8 +282,18 @@ func TestCarsFullUpdate(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -274,7 +319,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				statusCode: http.StatusOK,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(nil)
+				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(nil, nil)
 			},
 		},
 		{
@@ -312,7 +357,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				statusCode: http.StatusNotFound,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(errors.New("car not found"))
+				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(nil, errors.New("car not found"))
 			},
 		},
 		{
@@ -325,7 +370,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				statusCode: http.StatusBadRequest,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(errors.New("city name is not valid"))
+				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(nil, errors.New("city name is not valid"))
 			},
 		},
 		{
@@ -338,7 +383,7 @@ func TestCarsFullUpdate(t *testing.T) {
 				statusCode: http.StatusInternalServerError,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(errors.New("error registering car"))
+				d.carsService.EXPECT().FullUpdate(gomock.Any(), car.ToDomain()).Return(nil, errors.New("error registering car"))
 			},
 		},
 	}
@@ -382,8 +427,18 @@ func TestCarsDelete(t *testing.T) {
 		Type:           "Sedan",
 		Seats:          4,
 		HourlyRentCost: 21.1,
+		Currency:       "USD",
 		CityName:       "Los Angeles",
 		Status:         "Available",
+		Make:           "Toyota",
+		Model:          "Corolla",
+		Year:           2021,
+		LicensePlate:   "ABC1234",
+		VIN:            "1HGCM82633A004352",
+		Transmission:   "Automatic",
+		FuelType:       "Gasoline",
+		Color:          "White",
+		Features:       []string{"A/C", "GPS"},
 	}
 
 	type args struct {
@@ -480,28 +535,51 @@ func TestCarsDelete(t *testing.T) {
 }
 
 func TestCarsList(t *testing.T) {
+	initConstantsFromHandlers(t)
+
 	foundCars := []domain.Car{
 		{
 			ID:             uuid.New(),
 			Type:           "Sedan",
 			Seats:          5,
 			HourlyRentCost: 90,
+			Currency:       "USD",
 			CityName:       "New York",
 			Status:         "Available",
+			Make:           "Toyota",
+			Model:          "Corolla",
+			Year:           2021,
+			LicensePlate:   "ABC1234",
+			VIN:            "1HGCM82633A004352",
+			Transmission:   "Automatic",
+			FuelType:       "Gasoline",
+			Color:          "White",
+			Features:       []string{"A/C", "GPS"},
 		},
 		{
 			ID:             uuid.New(),
 			Type:           "Sedan",
 			Seats:          5,
 			HourlyRentCost: 100,
+			Currency:       "USD",
 			CityName:       "New York",
 			Status:         "Available",
+			Make:           "Toyota",
+			Model:          "Corolla",
+			Year:           2021,
+			LicensePlate:   "ABC1234",
+			VIN:            "1HGCM82633A004352",
+			Transmission:   "Automatic",
+			FuelType:       "Gasoline",
+			Color:          "White",
+			Features:       []string{"A/C", "GPS"},
 		},
 	}
 
 	type args struct {
 		city        string
 		from_car_id string
+		filters     map[string]string
 	}
 	type wants struct {
 		statusCode int
@@ -522,7 +600,7 @@ func TestCarsList(t *testing.T) {
 				statusCode: http.StatusOK,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().List(gomock.Any(), "New York", "5ae5d956-5a8d-40dd-9aef-5340fda345e8").Return(foundCars, nil)
+				d.carsService.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "5ae5d956-5a8d-40dd-9aef-5340fda345e8").Return(foundCars, nil)
 			},
 		},
 		{
@@ -547,7 +625,7 @@ func TestCarsList(t *testing.T) {
 				statusCode: http.StatusOK,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().List(gomock.Any(), "New York", "").Return(foundCars, nil)
+				d.carsService.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "").Return(foundCars, nil)
 			},
 		},
 		{
@@ -562,6 +640,46 @@ func TestCarsList(t *testing.T) {
 			setMocks: func(d *carsDependencies) {
 			},
 		},
+		{
+			name: "returns status code 200 and calls service with filters when they are provided",
+			args: args{
+				city:        "New York",
+				from_car_id: "",
+				filters: map[string]string{
+					"make":         "Toyota",
+					"year":         "2020",
+					"transmission": "Automatic",
+					"features":     "A/C,GPS",
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *carsDependencies) {
+				filters := domain.CarFilters{
+					Make:         "Toyota",
+					Year:         2020,
+					Transmission: "Automatic",
+					Features:     []string{"A/C", "GPS"},
+				}
+				d.carsService.EXPECT().List(gomock.Any(), "New York", filters, "").Return(foundCars, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when a filter is not valid",
+			args: args{
+				city:        "New York",
+				from_car_id: "",
+				filters: map[string]string{
+					"fuel_type": "Coal",
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {
+			},
+		},
 		{
 			name: "returns 500 status code when there is a server error",
 			args: args{
@@ -572,7 +690,7 @@ func TestCarsList(t *testing.T) {
 				statusCode: http.StatusInternalServerError,
 			},
 			setMocks: func(d *carsDependencies) {
-				d.carsService.EXPECT().List(gomock.Any(), "New York", "5ae5d956-5a8d-40dd-9aef-5340fda345e8").Return([]domain.Car{}, errors.New("error getting cars list"))
+				d.carsService.EXPECT().List(gomock.Any(), "New York", domain.CarFilters{}, "5ae5d956-5a8d-40dd-9aef-5340fda345e8").Return([]domain.Car{}, errors.New("error getting cars list"))
 			},
 		},
 	}
@@ -588,6 +706,9 @@ func TestCarsList(t *testing.T) {
 			values := url.Values{}
 			values.Set("city", test.args.city)
 			values.Set("from_car_id", test.args.from_car_id)
+			for key, value := range test.args.filters {
+				values.Set(key, value)
+			}
 			urlObj, _ := url.Parse(baseURL + "cars/?" + values.Encode())
 			URL := urlObj.String()
 
@@ -623,3 +744,153 @@ func TestCarsList(t *testing.T) {
 		})
 	}
 }
+
+func TestCarsSearchNearby(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	nearbyCars := []domain.NearbyCar{
+		{
+			Car:          domain.Car{ID: uuid.New(), Type: "Sedan", CityName: "Chicago", Status: "Available"},
+			PickupBranch: domain.Branch{ID: uuid.New(), Name: "Downtown", Latitude: 41.8837, Longitude: -87.6289},
+			DistanceKm:   0.12345,
+		},
+	}
+	search := domain.NearbyCarsSearch{
+		Latitude:  41.8837,
+		Longitude: -87.6289,
+		RadiusKm:  10,
+		StartDate: time.Date(2030, time.June, 1, 10, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2030, time.June, 2, 10, 0, 0, 0, time.UTC),
+	}
+	validQuery := map[string]string{
+		"lat":        "41.8837",
+		"lon":        "-87.6289",
+		"radius_km":  "10",
+		"start_date": "2030-06-01T10:00:00Z",
+		"end_date":   "2030-06-02T10:00:00Z",
+	}
+	withQuery := func(key, value string) map[string]string {
+		query := map[string]string{}
+		for k, v := range validQuery {
+			query[k] = v
+		}
+		query[key] = value
+
+		return query
+	}
+
+	type args struct {
+		query map[string]string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*carsDependencies)
+	}{
+		{
+			name: "returns status code 200 when the search is valid and service works with no error",
+			args: args{
+				query: validQuery,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsService.EXPECT().SearchNearby(gomock.Any(), search).Return(nearbyCars, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when latitude is out of range",
+			args: args{
+				query: withQuery("lat", "91"),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when radius is missing",
+			args: args{
+				query: withQuery("radius_km", ""),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when end date is not valid",
+			args: args{
+				query: withQuery("end_date", "2030-06-02"),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when service rejects the radius",
+			args: args{
+				query: validQuery,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsService.EXPECT().SearchNearby(gomock.Any(), search).Return(nil, fmt.Errorf("%s (50 km)", services.ErrInvalidSearchRadius))
+			},
+		},
+		{
+			name: "returns status code 500 when there is a server error",
+			args: args{
+				query: validQuery,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *carsDependencies) {
+				d.carsService.EXPECT().SearchNearby(gomock.Any(), search).Return(nil, errors.New("error searching cars"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			carsSrv := mocks.NewMockCarsService(mockCtlr)
+			d := NewCarsDependencies(carsSrv)
+			test.setMocks(d)
+
+			values := url.Values{}
+			for key, value := range test.args.query {
+				values.Set(key, value)
+			}
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/cars/nearby?"+values.Encode(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			carsHandler := NewCars(carsSrv)
+			carsHandler.SearchNearby(rr, req)
+
+			if rr.Result().StatusCode == http.StatusOK {
+				body := dtos.ListNearbyCarsResponse{}
+				err = json.Unmarshal(rr.Body.Bytes(), &body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, nearbyCars[0].Car.ID, body.Cars[0].ID)
+				assert.Equal(t, nearbyCars[0].PickupBranch.ID, body.Cars[0].PickupBranch.ID)
+				assert.Equal(t, 0.123, body.Cars[0].DistanceKm)
+			}
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
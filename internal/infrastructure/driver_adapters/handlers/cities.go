@@ -5,8 +5,11 @@ import (
 	"net/http"
 
 	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
 	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 )
 
 type Cities struct {
@@ -19,16 +22,176 @@ func NewCities(cs ports.CitiesService) Cities {
 	}
 }
 
+// @Summary Register a new city
+// @Description Register a new city where cars can be rented. Reservation dates of its cars are shown in its time zone.
+// @ID register-city
+// @Accept json
+// @Produce json
+// @Param city body docs.CityRequest true "City information (time zone must be an IANA time zone, country an ISO 3166-1 alpha-2 code and currency an ISO 4217 code)"
+// @Success 201 {object} docs.CityResponse "Created city"
+// @Failure 400 {object} docs.ErrorInvalidTimeZone "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities [post]
+func (ch Cities) Register(w http.ResponseWriter, r *http.Request) {
+	city, err := dtos.CityFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dc, err := ch.CitiesService.Register(r.Context(), city.ToDomain())
+	if err != nil {
+		if isCityBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	city.FromDomain(dc)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, city)
+}
+
+// @Summary Get a city
+// @Description Get a city by UUID
+// @ID get-city
+// @Produce json
+// @Param id path string true "City UUID" format(uuid)
+// @Success 200 {object} docs.CityResponse "Obtained city"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{id} [get]
+func (ch Cities) Get(w http.ResponseWriter, r *http.Request) {
+	var city dtos.City
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dc, err := ch.CitiesService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	city.FromDomain(dc)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, city)
+}
+
+// @Summary Update a city
+// @Description Update a city by UUID. The currency of cities with cars can not change.
+// @ID update-city
+// @Accept json
+// @Produce json
+// @Param id path string true "City UUID" format(uuid)
+// @Param city body docs.CityRequest true "City information (time zone must be an IANA time zone, country an ISO 3166-1 alpha-2 code and currency an ISO 4217 code)"
+// @Success 200 {object} docs.CityResponse "Updated city"
+// @Failure 400 {object} docs.ErrorInvalidTimeZone "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{id} [put]
+func (ch Cities) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	city, err := dtos.CityFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	city.ID = ID
+
+	if err = ch.CitiesService.FullUpdate(r.Context(), city.ToDomain()); err != nil {
+		if err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if isCityBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, city)
+}
+
+// @Summary Delete a city
+// @Description Delete a city by UUID. Cities with cars or branches can not be deleted.
+// @ID delete-city
+// @Produce json
+// @Param id path string true "City UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorCityHasCars "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{id} [delete]
+func (ch Cities) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = ch.CitiesService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrCityHasCars || err.Error() == services.ErrCityHasBranches {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
 // @Summary List cities
-// @Description Lists the names of all the currently supported cities
+// @Description Lists the supported cities in pages of 20 elements. from_city_id parameter
+// @Description is taken as the last seen city in a previous page.
 // @ID list-cities
 // @Produce json
-// @Success 200 {object} docs.ListCitiesNameResponse "Cities name"
+// @Param from_city_id query string false "Last seen city ID" format(uuid)
+// @Success 200 {object} docs.ListCitiesResponse "Obtained cities"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
 // @Tags Cities
-// @Router /cities/names [get]
-func (ch Cities) ListNames(w http.ResponseWriter, r *http.Request) {
-	citiesName, err := ch.CitiesService.ListNames(r.Context())
+// @Router /cities [get]
+func (ch Cities) List(w http.ResponseWriter, r *http.Request) {
+	fromCityID := r.URL.Query().Get("from_city_id")
+	if _, err := uuid.Parse(fromCityID); err != nil && fromCityID != "" {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dcs, err := ch.CitiesService.List(r.Context(), fromCityID)
 	if err != nil {
 		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
 		log.Println(err)
@@ -36,9 +199,18 @@ func (ch Cities) ListNames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := dtos.ListCitiesNameResponse{
-		CitiesName: citiesName,
+	cities := dtos.Cities{Cities: make([]dtos.City, 0, len(dcs))}
+	for _, dc := range dcs {
+		city := dtos.City{}
+		city.FromDomain(dc)
+		cities.Cities = append(cities.Cities, city)
 	}
 
-	httphandler.WriteSuccessResponse(w, http.StatusOK, response)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, cities)
+}
+
+func isCityBadRequest(err error) bool {
+	return err.Error() == services.ErrInvalidTimeZone ||
+		err.Error() == services.ErrCityNameAlreadyRegistered ||
+		err.Error() == services.ErrCityCurrencyInUse
 }
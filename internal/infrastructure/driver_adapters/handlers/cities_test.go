@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -8,9 +9,12 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,33 +28,292 @@ func NewCitiesDependencies(citiesSrv *mocks.MockCitiesService) *citiesDependenci
 	}
 }
 
+func TestCitiesRegister(t *testing.T) {
+	city := dtos.City{
+		Name:     "Chicago",
+		TimeZone: "America/Chicago",
+		Country:  "US",
+		Currency: "USD",
+	}
+
+	type args struct {
+		city dtos.City
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*citiesDependencies)
+	}{
+		{
+			name: "returns status code 201 when body is appropriate",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Register(gomock.Any(), city.ToDomain()).Return(domain.City{}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when country in body is not valid",
+			args: args{
+				city: dtos.City{
+					Name:     "Chicago",
+					TimeZone: "America/Chicago",
+					Country:  "USA",
+					Currency: "USD",
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *citiesDependencies) {
+			},
+		},
+		{
+			name: "returns 400 status code when time zone is not valid",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Register(gomock.Any(), city.ToDomain()).Return(domain.City{}, errors.New("time zone is not a valid IANA time zone"))
+			},
+		},
+		{
+			name: "returns 400 status code when city name is already registered",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Register(gomock.Any(), city.ToDomain()).Return(domain.City{}, errors.New("city name already registered"))
+			},
+		},
+		{
+			name: "returns 500 status code when cities service fails to register city",
+			args: args{
+				city: city,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Register(gomock.Any(), city.ToDomain()).Return(domain.City{}, errors.New("error registering city"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesSrv := mocks.NewMockCitiesService(mockCtlr)
+			d := NewCitiesDependencies(citiesSrv)
+			test.setMocks(d)
+
+			baseURL := "/api/v1/"
+			body, _ := json.Marshal(test.args.city)
+			URL := baseURL + "cities"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			citiesHandler := NewCities(citiesSrv)
+			citiesHandler.Register(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
 func TestCitiesDelete(t *testing.T) {
-	CitiesName := []string{"CityName A", "CityName B", "CityName C"}
+	cityID := uuid.New()
+
+	type args struct {
+		requestID string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*citiesDependencies)
+	}{
+		{
+			name: "returns status code 204 when city was deleted successfully",
+			args: args{
+				requestID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Delete(gomock.Any(), cityID).Return(nil)
+			},
+		},
+		{
+			name: "returns 400 status code when path param id is not an uuid",
+			args: args{
+				requestID: "this-is-not-a-uuid",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *citiesDependencies) {
+			},
+		},
+		{
+			name: "returns 400 status code when the city still has cars",
+			args: args{
+				requestID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Delete(gomock.Any(), cityID).Return(errors.New("city can not be deleted while it has cars"))
+			},
+		},
+		{
+			name: "returns 404 status code when the city was not found",
+			args: args{
+				requestID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Delete(gomock.Any(), cityID).Return(errors.New("city not found"))
+			},
+		},
+		{
+			name: "returns 500 status code when there is a server error",
+			args: args{
+				requestID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().Delete(gomock.Any(), cityID).Return(errors.New("error deleting city"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			citiesSrv := mocks.NewMockCitiesService(mockCtlr)
+			d := NewCitiesDependencies(citiesSrv)
+			test.setMocks(d)
+
+			baseURL := "/api/v1/"
+			urlObj, _ := url.Parse(baseURL + "cities/" + test.args.requestID)
+			URL := urlObj.String()
+
+			req, err := http.NewRequest(http.MethodDelete, URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
 
+			// Include request vars for gorilla mux to interpret path params
+			vars := map[string]string{
+				"id": test.args.requestID,
+			}
+			req = mux.SetURLVars(req, vars)
+
+			rr := httptest.NewRecorder()
+
+			citiesHandler := NewCities(citiesSrv)
+			citiesHandler.Delete(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestCitiesList(t *testing.T) {
+	cities := []domain.City{
+		{ID: uuid.New(), Name: "Chicago", TimeZone: "America/Chicago", Country: "US", Currency: "USD"},
+		{ID: uuid.New(), Name: "New York", TimeZone: "America/New_York", Country: "US", Currency: "USD"},
+	}
+	fromCityID := uuid.New().String()
+
+	type args struct {
+		fromCityID string
+	}
 	type wants struct {
 		statusCode int
+		cities     []domain.City
 	}
 	tests := []struct {
 		name     string
+		args     args
 		wants    wants
 		setMocks func(*citiesDependencies)
 	}{
 		{
-			name: "returns status code 200 when cities name list was successfully retrieved",
+			name: "returns status code 200 and the first page when no cursor is given",
+			args: args{
+				fromCityID: "",
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				cities:     cities,
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().List(gomock.Any(), "").Return(cities, nil)
+			},
+		},
+		{
+			name: "returns status code 200 and the page after the given city",
+			args: args{
+				fromCityID: fromCityID,
+			},
 			wants: wants{
 				statusCode: http.StatusOK,
+				cities:     cities[1:],
+			},
+			setMocks: func(d *citiesDependencies) {
+				d.citiesService.EXPECT().List(gomock.Any(), fromCityID).Return(cities[1:], nil)
+			},
+		},
+		{
+			name: "returns 400 status code when from_city_id is not an uuid",
+			args: args{
+				fromCityID: "this-is-not-a-uuid",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
 			},
 			setMocks: func(d *citiesDependencies) {
-				d.citiesService.EXPECT().ListNames(gomock.Any()).Return(CitiesName, nil)
 			},
 		},
 		{
 			name: "returns 500 status code when there was a server error",
+			args: args{
+				fromCityID: "",
+			},
 			wants: wants{
 				statusCode: http.StatusInternalServerError,
 			},
 			setMocks: func(d *citiesDependencies) {
-				d.citiesService.EXPECT().ListNames(gomock.Any()).Return(nil, errors.New("error getting cities name list"))
+				d.citiesService.EXPECT().List(gomock.Any(), "").Return(nil, errors.New("error listing cities"))
 			},
 		},
 	}
@@ -63,10 +326,15 @@ func TestCitiesDelete(t *testing.T) {
 			test.setMocks(d)
 
 			baseURL := "/api/v1/"
-			urlObj, _ := url.Parse(baseURL + "cities/names")
+			urlObj, _ := url.Parse(baseURL + "cities")
+			query := urlObj.Query()
+			if test.args.fromCityID != "" {
+				query.Set("from_city_id", test.args.fromCityID)
+			}
+			urlObj.RawQuery = query.Encode()
 			URL := urlObj.String()
 
-			req, err := http.NewRequest(http.MethodDelete, URL, nil)
+			req, err := http.NewRequest(http.MethodGet, URL, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -74,21 +342,19 @@ func TestCitiesDelete(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			citiesHandler := NewCities(citiesSrv)
-			citiesHandler.ListNames(rr, req)
+			citiesHandler.List(rr, req)
 
-			if rr.Result().StatusCode == http.StatusOK {
-				body := dtos.ListCitiesNameResponse{}
-				err = json.Unmarshal(rr.Body.Bytes(), &body)
-				if err != nil {
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				body := dtos.Cities{}
+				if err = json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
 					t.Fatal(err)
 				}
-				if len(body.CitiesName) != 0 {
-					assert.Equal(t, CitiesName[0], body.CitiesName[0])
-					assert.Equal(t, len(CitiesName), len(body.CitiesName))
+				assert.Equal(t, len(test.wants.cities), len(body.Cities))
+				for i, city := range body.Cities {
+					assert.Equal(t, test.wants.cities[i], city.ToDomain())
 				}
 			}
-
-			assert.Equal(t, test.wants.statusCode, rr.Code)
 		})
 	}
 }
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+)
+
+type Constants struct {
+}
+
+func NewConstants() Constants {
+	return Constants{}
+}
+
+// @Summary Get active constants
+// @Description Get the business constants currently in use and their version.
+// @Description The version increases every time the constants file is reloaded successfully.
+// @ID get-constants
+// @Produce json
+// @Success 200 {object} docs.ConstantsResponse "Active constants"
+// @Tags Admin
+// @Router /admin/constants [get]
+func (ch Constants) Get(w http.ResponseWriter, r *http.Request) {
+	var response dtos.Constants
+	response.FromSnapshot(constants.Current())
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, response)
+}
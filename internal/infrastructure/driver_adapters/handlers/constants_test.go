@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantsGet(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/v1/admin/constants", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+
+	constantsHandler := NewConstants()
+	constantsHandler.Get(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	body := dtos.Constants{}
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, constants.Current().Version, body.Version)
+	assert.Equal(t, constants.Values().CARS_PER_PAGE, body.Values.CARS_PER_PAGE)
+	assert.Equal(t, constants.Values().CAR_TYPES.Values(), body.Values.CAR_TYPES.Values())
+}
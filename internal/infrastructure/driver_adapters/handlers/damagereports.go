@@ -0,0 +1,327 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+var (
+	ErrInvalidMultipartForm = "request body must be a multipart form within the size limit"
+	ErrTooManyPhotos        = fmt.Sprintf("no more than %d photos can be uploaded at once", maximumPhotosPerRequest)
+)
+
+const (
+	// Maximum number of photos accepted in a single request
+	maximumPhotosPerRequest = 10
+	// Bytes of a multipart form kept in memory, the rest goes to temporary files
+	multipartMemoryBytes = 1 << 20
+)
+
+type DamageReports struct {
+	DamageReportsService ports.DamageReportsService
+}
+
+func NewDamageReports(drs ports.DamageReportsService) DamageReports {
+	return DamageReports{
+		DamageReportsService: drs,
+	}
+}
+
+// @Summary File a damage report
+// @Description File a damage report for a car with at least one photo. Photos must be JPEG or PNG images within the maximum photo size.
+// @ID file-damage-report
+// @Accept mpfd
+// @Produce json
+// @Param car_id formData string true "Car id" format(uuid)
+// @Param reporter_id formData string true "Id of the user reporting the damage" format(uuid)
+// @Param reservation_id formData string false "Reservation during which the damage happened" format(uuid)
+// @Param description formData string true "Description of the damage"
+// @Param photos formData file true "Photos of the damage"
+// @Success 201 {object} docs.DamageReportResponse "Filed damage report"
+// @Failure 400 {object} docs.ErrorUnsupportedPhotoType "Bad Request"
+// @Failure 404 {object} docs.ErrorCarNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports [post]
+func (drh DamageReports) File(w http.ResponseWriter, r *http.Request) {
+	form, err := parsePhotosForm(w, r)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer form.RemoveAll()
+
+	report, err := dtos.DamageReportFromForm(form)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	photos, err := dtos.PhotoUploadsFromForm(form)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ddr, err := drh.DamageReportsService.File(r.Context(), report.ToDomain(), photos)
+	drh.writeDamageReport(w, http.StatusCreated, ddr, err)
+}
+
+// @Summary Get a damage report
+// @Description Get a damage report by UUID along with its photos
+// @ID get-damage-report
+// @Produce json
+// @Param id path string true "Damage report UUID" format(uuid)
+// @Success 200 {object} docs.DamageReportResponse "Obtained damage report"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorDamageReportNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports/{id} [get]
+func (drh DamageReports) Get(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	ddr, err := drh.DamageReportsService.Get(r.Context(), ID)
+	drh.writeDamageReport(w, http.StatusOK, ddr, err)
+}
+
+// @Summary Update the status of a damage report
+// @Description Move a damage report through its statuses. Resolving a report requires a resolution.
+// @ID update-damage-report-status
+// @Accept json
+// @Produce json
+// @Param id path string true "Damage report UUID" format(uuid)
+// @Param status body docs.DamageReportStatusRequest true "Status information (allowed statuses: Open, In Repair, Resolved)"
+// @Success 200 {object} docs.DamageReportResponse "Updated damage report"
+// @Failure 400 {object} docs.ErrorDamageResolutionRequired "Bad Request"
+// @Failure 404 {object} docs.ErrorDamageReportNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports/{id}/status [put]
+func (drh DamageReports) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	status, err := dtos.DamageReportStatusFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ddr, err := drh.DamageReportsService.UpdateStatus(r.Context(), ID, status.Status, status.Resolution)
+	drh.writeDamageReport(w, http.StatusOK, ddr, err)
+}
+
+// @Summary List the damage reports of a car
+// @Description List the damage reports of a car, newest first, optionally filtered by status
+// @ID list-car-damage-reports
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Param status query string false "Status of the reports (Open, In Repair, Resolved)"
+// @Success 200 {object} docs.DamageReportsResponse "Damage reports of the car"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /cars/{car_id}/damage-reports [get]
+func (drh DamageReports) ListByCarID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !dtos.IsValidDamageReportStatus(status) {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, dtos.ErrInvalidDamageStatus)
+		return
+	}
+
+	ddrs, err := drh.DamageReportsService.ListByCarID(r.Context(), carID, status)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	damageReports := dtos.DamageReports{DamageReports: make([]dtos.DamageReport, 0, len(ddrs))}
+	for _, ddr := range ddrs {
+		report := dtos.DamageReport{}
+		report.FromDomain(ddr)
+		damageReports.DamageReports = append(damageReports.DamageReports, report)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, damageReports)
+}
+
+// @Summary Add photos to a damage report
+// @Description Upload more photos of the damage. Photos must be JPEG or PNG images within the maximum photo size.
+// @ID add-damage-photos
+// @Accept mpfd
+// @Produce json
+// @Param id path string true "Damage report UUID" format(uuid)
+// @Param photos formData file true "Photos of the damage"
+// @Success 201 {object} docs.DamageReportResponse "Damage report with the added photos"
+// @Failure 400 {object} docs.ErrorPhotoTooLarge "Bad Request"
+// @Failure 404 {object} docs.ErrorDamageReportNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports/{id}/photos [post]
+func (drh DamageReports) AddPhotos(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	form, err := parsePhotosForm(w, r)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer form.RemoveAll()
+
+	photos, err := dtos.PhotoUploadsFromForm(form)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ddr, err := drh.DamageReportsService.AddPhotos(r.Context(), ID, photos)
+	drh.writeDamageReport(w, http.StatusCreated, ddr, err)
+}
+
+// @Summary Download a damage photo
+// @Description Download the original photo of a damage report
+// @ID get-damage-photo
+// @Produce jpeg,png
+// @Param id path string true "Damage report UUID" format(uuid)
+// @Param photo_id path string true "Photo UUID" format(uuid)
+// @Success 200 {file} file "Photo content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorDamagePhotoNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports/{id}/photos/{photo_id} [get]
+func (drh DamageReports) GetPhoto(w http.ResponseWriter, r *http.Request) {
+	drh.writePhoto(w, r, false)
+}
+
+// @Summary Download the thumbnail of a damage photo
+// @Description Download the JPEG thumbnail generated for a photo of a damage report
+// @ID get-damage-photo-thumbnail
+// @Produce jpeg
+// @Param id path string true "Damage report UUID" format(uuid)
+// @Param photo_id path string true "Photo UUID" format(uuid)
+// @Success 200 {file} file "Thumbnail content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorDamagePhotoNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Damage Reports
+// @Router /damage-reports/{id}/photos/{photo_id}/thumbnail [get]
+func (drh DamageReports) GetThumbnail(w http.ResponseWriter, r *http.Request) {
+	drh.writePhoto(w, r, true)
+}
+
+func (drh DamageReports) writePhoto(w http.ResponseWriter, r *http.Request, thumbnail bool) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+	photoID, err := uuid.Parse(params["photo_id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	content, contentType, err := drh.DamageReportsService.OpenPhoto(r.Context(), ID, photoID, thumbnail)
+	if err != nil {
+		if err.Error() == services.ErrDamagePhotoNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		log.Println(err)
+	}
+}
+
+func (drh DamageReports) writeDamageReport(w http.ResponseWriter, statusCode int, ddr domain.DamageReport, err error) {
+	var report dtos.DamageReport
+
+	if err != nil {
+		if err.Error() == services.ErrDamageReportNotFound ||
+			err.Error() == services.ErrCarNotFound ||
+			err.Error() == services.ErrUserNotFound ||
+			err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrDamageReportReservationMismatch ||
+			err.Error() == services.ErrUnsupportedPhotoType ||
+			err.Error() == services.ErrPhotoTooLarge ||
+			err.Error() == services.ErrPhotoDimensionsTooLarge ||
+			err.Error() == services.ErrInvalidPhoto ||
+			err.Error() == services.ErrDamageResolutionRequired {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	report.FromDomain(ddr)
+	httphandler.WriteSuccessResponse(w, statusCode, report)
+}
+
+// Parses a multipart form carrying photos, limiting the size of the body so
+// oversized uploads are rejected before being read completely
+func parsePhotosForm(w http.ResponseWriter, r *http.Request) (*multipart.Form, error) {
+	maximumBodyBytes := int64(constants.Values().MAXIMUM_PHOTO_BYTES)*maximumPhotosPerRequest + multipartMemoryBytes
+	r.Body = http.MaxBytesReader(w, r.Body, maximumBodyBytes)
+
+	if err := r.ParseMultipartForm(multipartMemoryBytes); err != nil {
+		return nil, errors.New(ErrInvalidMultipartForm)
+	}
+
+	if len(r.MultipartForm.File[dtos.PhotosFormField]) > maximumPhotosPerRequest {
+		r.MultipartForm.RemoveAll()
+		return nil, errors.New(ErrTooManyPhotos)
+	}
+
+	return r.MultipartForm, nil
+}
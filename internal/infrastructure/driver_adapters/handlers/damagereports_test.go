@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type damageReportsDependencies struct {
+	damageReportsService *mocks.MockDamageReportsService
+}
+
+func NewDamageReportsDependencies(damageReportsSrv *mocks.MockDamageReportsService) *damageReportsDependencies {
+	return &damageReportsDependencies{
+		damageReportsService: damageReportsSrv,
+	}
+}
+
+// Builds a multipart body with the given fields and one photo per entry of photos
+func newDamageReportForm(t *testing.T, fields map[string]string, photos [][]byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for field, value := range fields {
+		if err := writer.WriteField(field, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, photo := range photos {
+		part, err := writer.CreateFormFile("photos", "damage.jpg")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(photo)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return body, writer.FormDataContentType()
+}
+
+func TestDamageReportsFile(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	fields := map[string]string{
+		"car_id":      uuid.New().String(),
+		"reporter_id": uuid.New().String(),
+		"description": "Scratch on rear bumper",
+	}
+	photo := []byte("photo content")
+
+	type args struct {
+		fields map[string]string
+		photos [][]byte
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*damageReportsDependencies)
+	}{
+		{
+			name: "returns status code 201 when damage report was filed",
+			args: args{
+				fields: fields,
+				photos: [][]byte{photo},
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().File(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, ddr domain.DamageReport, photos []domain.PhotoUpload) (domain.DamageReport, error) {
+						assert.Equal(t, "Scratch on rear bumper", ddr.Description)
+						assert.Nil(t, ddr.ReservationID)
+						assert.Equal(t, photo, photos[0].Content)
+						return ddr, nil
+					})
+			},
+		},
+		{
+			name: "returns status code 400 when no photo was uploaded",
+			args: args{
+				fields: fields,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when car id is invalid",
+			args: args{
+				fields: map[string]string{"car_id": "invalid-id", "reporter_id": fields["reporter_id"], "description": "Dent"},
+				photos: [][]byte{photo},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when photo type is not supported",
+			args: args{
+				fields: fields,
+				photos: [][]byte{photo},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().File(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.DamageReport{}, errors.New(services.ErrUnsupportedPhotoType))
+			},
+		},
+		{
+			name: "returns status code 404 when car was not found",
+			args: args{
+				fields: fields,
+				photos: [][]byte{photo},
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().File(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.DamageReport{}, errors.New(services.ErrCarNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			damageReportsSrv := mocks.NewMockDamageReportsService(mockCtlr)
+			d := NewDamageReportsDependencies(damageReportsSrv)
+			test.setMocks(d)
+
+			body, contentType := newDamageReportForm(t, test.args.fields, test.args.photos)
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/damage-reports", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", contentType)
+
+			rr := httptest.NewRecorder()
+
+			damageReportsHandler := NewDamageReports(damageReportsSrv)
+			damageReportsHandler.File(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestDamageReportsGetThumbnail(t *testing.T) {
+	reportID := uuid.New()
+	photoID := uuid.New()
+
+	type wants struct {
+		statusCode  int
+		contentType string
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*damageReportsDependencies)
+	}{
+		{
+			name: "returns the thumbnail content when photo was found",
+			wants: wants{
+				statusCode:  http.StatusOK,
+				contentType: "image/jpeg",
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().OpenPhoto(gomock.Any(), reportID, photoID, true).
+					Return(io.NopCloser(bytes.NewReader([]byte("thumbnail"))), "image/jpeg", nil)
+			},
+		},
+		{
+			name: "returns status code 404 when photo was not found",
+			wants: wants{
+				statusCode:  http.StatusNotFound,
+				contentType: "application/json",
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().OpenPhoto(gomock.Any(), reportID, photoID, true).
+					Return(nil, "", errors.New(services.ErrDamagePhotoNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			damageReportsSrv := mocks.NewMockDamageReportsService(mockCtlr)
+			d := NewDamageReportsDependencies(damageReportsSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/damage-reports/" + reportID.String() + "/photos/" + photoID.String() + "/thumbnail"
+			req, err := http.NewRequest(http.MethodGet, URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": reportID.String(), "photo_id": photoID.String()})
+
+			rr := httptest.NewRecorder()
+
+			damageReportsHandler := NewDamageReports(damageReportsSrv)
+			damageReportsHandler.GetThumbnail(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			assert.Contains(t, rr.Header().Get("Content-Type"), test.wants.contentType)
+		})
+	}
+}
+
+func TestDamageReportsListByCarID(t *testing.T) {
+	initConstantsFromHandlers(t)
+	carID := uuid.New()
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		status   string
+		wants    wants
+		setMocks func(*damageReportsDependencies)
+	}{
+		{
+			name:   "returns status code 200 when status filter is valid",
+			status: "Open",
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *damageReportsDependencies) {
+				d.damageReportsService.EXPECT().ListByCarID(gomock.Any(), carID, "Open").Return([]domain.DamageReport{{ID: uuid.New(), CarID: carID}}, nil)
+			},
+		},
+		{
+			name:   "returns status code 400 when status filter is invalid",
+			status: "Lost",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *damageReportsDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			damageReportsSrv := mocks.NewMockDamageReportsService(mockCtlr)
+			d := NewDamageReportsDependencies(damageReportsSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/cars/"+carID.String()+"/damage-reports?status="+test.status, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": carID.String()})
+
+			rr := httptest.NewRecorder()
+
+			damageReportsHandler := NewDamageReports(damageReportsSrv)
+			damageReportsHandler.ListByCarID(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Deposits struct {
+	DepositsService ports.DepositsService
+}
+
+func NewDeposits(ds ports.DepositsService) Deposits {
+	return Deposits{
+		DepositsService: ds,
+	}
+}
+
+// @Summary Get the security deposit of a reservation
+// @Description Get the security deposit held at pickup, its status and what was captured of it
+// @ID get-deposit
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.DepositResponse "Deposit of the reservation"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorDepositNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Deposits
+// @Router /reservations/{id}/deposit [get]
+func (dh Deposits) Get(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dd, err := dh.DepositsService.Get(r.Context(), reservationID)
+	dh.writeDeposit(w, dd, err)
+}
+
+// @Summary Capture part of a security deposit
+// @Description Capture part of the held deposit to cover damages or late fees. The rest of the hold is released.
+// @ID capture-deposit
+// @Accept json
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Param capture body docs.DepositCaptureRequest true "Amount to capture and what it covers"
+// @Success 200 {object} docs.DepositResponse "Captured deposit"
+// @Failure 400 {object} docs.ErrorDepositNotHeld "Bad Request"
+// @Failure 404 {object} docs.ErrorDepositNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Deposits
+// @Router /reservations/{id}/deposit/capture [post]
+func (dh Deposits) Capture(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	capture, err := dtos.DepositCaptureFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dd, err := dh.DepositsService.Capture(r.Context(), reservationID, capture.Amount, capture.Reason)
+	dh.writeDeposit(w, dd, err)
+}
+
+// @Summary Release a security deposit
+// @Description Release the whole held deposit, for example once the damages observed at return were assessed
+// @ID release-deposit
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.DepositResponse "Released deposit"
+// @Failure 400 {object} docs.ErrorDepositNotHeld "Bad Request"
+// @Failure 404 {object} docs.ErrorDepositNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Deposits
+// @Router /reservations/{id}/deposit/release [post]
+func (dh Deposits) Release(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dd, err := dh.DepositsService.Release(r.Context(), reservationID)
+	dh.writeDeposit(w, dd, err)
+}
+
+// Writes the deposit, or the error its operation failed with
+func (dh Deposits) writeDeposit(w http.ResponseWriter, dd domain.Deposit, err error) {
+	if err != nil {
+		if err.Error() == services.ErrDepositNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrDepositNotHeld ||
+			err.Error() == services.ErrDepositStatusChanged ||
+			err.Error() == services.ErrPaymentGatewayRejected ||
+			strings.HasPrefix(err.Error(), services.ErrDepositCaptureExceedsHeld) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var deposit dtos.Deposit
+	deposit.FromDomain(dd)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, deposit)
+}
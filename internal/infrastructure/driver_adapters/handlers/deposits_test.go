@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type depositsDependencies struct {
+	depositsService *mocks.MockDepositsService
+}
+
+func NewDepositsDependencies(depositsSrv *mocks.MockDepositsService) *depositsDependencies {
+	return &depositsDependencies{
+		depositsService: depositsSrv,
+	}
+}
+
+func TestDepositsCapture(t *testing.T) {
+	reservationID := uuid.New()
+
+	type args struct {
+		body string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*depositsDependencies)
+	}{
+		{
+			name: "returns status code 200 when part of the deposit was captured",
+			args: args{
+				body: `{"amount": 320, "reason": " Scratch on rear bumper "}`,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsService.EXPECT().Capture(gomock.Any(), reservationID, 320.0, "Scratch on rear bumper").Return(domain.Deposit{Status: "Captured"}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when capture exceeds the deposit held",
+			args: args{
+				body: `{"amount": 2000, "reason": "Broken windshield"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsService.EXPECT().Capture(gomock.Any(), reservationID, 2000.0, "Broken windshield").Return(domain.Deposit{}, fmt.Errorf("%s (1500.00 held)", services.ErrDepositCaptureExceedsHeld))
+			},
+		},
+		{
+			name: "returns status code 400 when reason is missing",
+			args: args{
+				body: `{"amount": 100}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *depositsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when deposit is not held",
+			args: args{
+				body: `{"amount": 100, "reason": "Late fee"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsService.EXPECT().Capture(gomock.Any(), reservationID, 100.0, "Late fee").Return(domain.Deposit{}, errors.New(services.ErrDepositNotHeld))
+			},
+		},
+		{
+			name: "returns status code 404 when reservation has no deposit",
+			args: args{
+				body: `{"amount": 100, "reason": "Late fee"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *depositsDependencies) {
+				d.depositsService.EXPECT().Capture(gomock.Any(), reservationID, 100.0, "Late fee").Return(domain.Deposit{}, errors.New(services.ErrDepositNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			depositsSrv := mocks.NewMockDepositsService(mockCtlr)
+			d := NewDepositsDependencies(depositsSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + reservationID.String() + "/deposit/capture"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": reservationID.String()})
+
+			rr := httptest.NewRecorder()
+
+			depositsHandler := NewDeposits(depositsSrv)
+			depositsHandler.Capture(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/gorilla/mux"
+)
+
+type ExchangeRates struct {
+	ExchangeRatesService ports.ExchangeRatesService
+}
+
+func NewExchangeRates(xrs ports.ExchangeRatesService) ExchangeRates {
+	return ExchangeRates{
+		ExchangeRatesService: xrs,
+	}
+}
+
+// @Summary Set an exchange rate
+// @Description Set how many units of the target currency one unit of the source currency is worth.
+// @Description Rates are used to display quotes in other currencies, the inverse pair is used when there is no direct one.
+// @ID set-exchange-rate
+// @Accept json
+// @Produce json
+// @Param from path string true "ISO 4217 code of the source currency"
+// @Param to path string true "ISO 4217 code of the target currency"
+// @Param rate body docs.ExchangeRateRequest true "Exchange rate"
+// @Success 200 {object} docs.ExchangeRateResponse "Exchange rate of the pair"
+// @Failure 400 {object} docs.ErrorExchangeRateSameCurrency "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Admin
+// @Router /admin/exchange-rates/{from}/{to} [put]
+func (xrh ExchangeRates) Set(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	from := strings.ToUpper(params["from"])
+	to := strings.ToUpper(params["to"])
+	if !dtos.IsValidCurrency(from) || !dtos.IsValidCurrency(to) {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, dtos.ErrInvalidCurrency)
+		return
+	}
+
+	exchangeRate, err := dtos.ExchangeRateFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the currencies from path params
+	exchangeRate.From = from
+	exchangeRate.To = to
+
+	der, err := xrh.ExchangeRatesService.Set(r.Context(), exchangeRate.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrExchangeRateSameCurrency {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	exchangeRate.FromDomain(der)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, exchangeRate)
+}
+
+// @Summary List exchange rates
+// @Description List the exchange rates used to display quotes in other currencies
+// @ID list-exchange-rates
+// @Produce json
+// @Success 200 {object} docs.ListExchangeRatesResponse "Exchange rates"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Admin
+// @Router /admin/exchange-rates [get]
+func (xrh ExchangeRates) List(w http.ResponseWriter, r *http.Request) {
+	ders, err := xrh.ExchangeRatesService.List(r.Context())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	exchangeRates := dtos.ExchangeRates{ExchangeRates: make([]dtos.ExchangeRate, 0, len(ders))}
+	for _, der := range ders {
+		exchangeRate := dtos.ExchangeRate{}
+		exchangeRate.FromDomain(der)
+		exchangeRates.ExchangeRates = append(exchangeRates.ExchangeRates, exchangeRate)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, exchangeRates)
+}
+
+// @Summary Delete an exchange rate
+// @Description Delete the rate of a currency pair. Quotes cannot be displayed in the pair anymore unless its inverse is set.
+// @ID delete-exchange-rate
+// @Produce json
+// @Param from path string true "ISO 4217 code of the source currency"
+// @Param to path string true "ISO 4217 code of the target currency"
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorInvalidCurrency "Bad Request"
+// @Failure 404 {object} docs.ErrorExchangeRateNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Admin
+// @Router /admin/exchange-rates/{from}/{to} [delete]
+func (xrh ExchangeRates) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	from := strings.ToUpper(params["from"])
+	to := strings.ToUpper(params["to"])
+	if !dtos.IsValidCurrency(from) || !dtos.IsValidCurrency(to) {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, dtos.ErrInvalidCurrency)
+		return
+	}
+
+	if err := xrh.ExchangeRatesService.Delete(r.Context(), from, to); err != nil {
+		if err.Error() == services.ErrExchangeRateNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
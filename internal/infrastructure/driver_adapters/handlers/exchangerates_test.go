@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type exchangeRatesDependencies struct {
+	exchangeRatesService *mocks.MockExchangeRatesService
+}
+
+func NewExchangeRatesDependencies(exchangeRatesSrv *mocks.MockExchangeRatesService) *exchangeRatesDependencies {
+	return &exchangeRatesDependencies{
+		exchangeRatesService: exchangeRatesSrv,
+	}
+}
+
+func TestExchangeRatesSet(t *testing.T) {
+	type args struct {
+		from string
+		to   string
+		body string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*exchangeRatesDependencies)
+	}{
+		{
+			name: "returns status code 200 when the rate was set",
+			args: args{
+				from: "usd",
+				to:   "eur",
+				body: `{"rate": 0.9215}`,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				rate := domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215}
+				d.exchangeRatesService.EXPECT().Set(gomock.Any(), rate).Return(domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: time.Now()}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when a currency is not a currency code",
+			args: args{
+				from: "dollar",
+				to:   "EUR",
+				body: `{"rate": 0.9215}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the rate is not positive",
+			args: args{
+				from: "USD",
+				to:   "EUR",
+				body: `{"rate": 0}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when both currencies are the same",
+			args: args{
+				from: "USD",
+				to:   "USD",
+				body: `{"rate": 1}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesService.EXPECT().Set(gomock.Any(), gomock.Any()).Return(domain.ExchangeRate{}, errors.New(services.ErrExchangeRateSameCurrency))
+			},
+		},
+		{
+			name: "returns 500 status code when exchange rates service fails to set the rate",
+			args: args{
+				from: "USD",
+				to:   "EUR",
+				body: `{"rate": 0.9215}`,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesService.EXPECT().Set(gomock.Any(), gomock.Any()).Return(domain.ExchangeRate{}, errors.New("error setting exchange rate"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			exchangeRatesSrv := mocks.NewMockExchangeRatesService(mockCtlr)
+			d := NewExchangeRatesDependencies(exchangeRatesSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/admin/exchange-rates/" + test.args.from + "/" + test.args.to
+			req, err := http.NewRequest(http.MethodPut, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"from": test.args.from, "to": test.args.to})
+
+			rr := httptest.NewRecorder()
+
+			exchangeRatesHandler := NewExchangeRates(exchangeRatesSrv)
+			exchangeRatesHandler.Set(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestExchangeRatesDelete(t *testing.T) {
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*exchangeRatesDependencies)
+	}{
+		{
+			name: "returns status code 204 when the rate was deleted",
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesService.EXPECT().Delete(gomock.Any(), "USD", "EUR").Return(nil)
+			},
+		},
+		{
+			name: "returns 404 status code when the pair has no rate",
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *exchangeRatesDependencies) {
+				d.exchangeRatesService.EXPECT().Delete(gomock.Any(), "USD", "EUR").Return(errors.New(services.ErrExchangeRateNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			exchangeRatesSrv := mocks.NewMockExchangeRatesService(mockCtlr)
+			d := NewExchangeRatesDependencies(exchangeRatesSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodDelete, "/api/v1/admin/exchange-rates/usd/eur", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"from": "usd", "to": "eur"})
+
+			rr := httptest.NewRecorder()
+
+			exchangeRatesHandler := NewExchangeRates(exchangeRatesSrv)
+			exchangeRatesHandler.Delete(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
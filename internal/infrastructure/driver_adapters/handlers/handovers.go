@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Handovers struct {
+	HandoversService ports.HandoversService
+}
+
+func NewHandovers(hs ports.HandoversService) Handovers {
+	return Handovers{
+		HandoversService: hs,
+	}
+}
+
+// @Summary Record an inspection
+// @Description Record the pickup or return inspection of a reservation. The return mileage must not be lower than the pickup one.
+// @Description Pickups can be recorded from EARLY_PICKUP_MINUTES before the reservation starts, and neither inspection can be recorded once the reservation is canceled or completed.
+// @Description The inspection time, the current time when none is given, can not differ from the current time by more than INSPECTION_SKEW_MINUTES.
+// @Description At pickup the security deposit of the car type is held on the deposit payment method. It is released when the car is returned without damages that were not there at pickup.
+// @Description A return after the grace period past the end of the reservation is charged a late fee per started hour by car type, captured from the held deposit up to its amount.
+// @ID record-inspection
+// @Accept json
+// @Produce json
+// @Param reservation_id path string true "Reservation id" format(uuid)
+// @Param inspection body docs.InspectionRequest true "Inspection information (allowed types: Pickup, Return)"
+// @Success 201 {object} docs.HandoverResponse "Handover of the reservation"
+// @Failure 400 {object} docs.ErrorReturnMileageBelowPickup "Bad Request"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Handovers
+// @Router /reservations/{reservation_id}/inspections [post]
+func (hh Handovers) RecordInspection(w http.ResponseWriter, r *http.Request) {
+	var handover dtos.Handover
+
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	inspection, err := dtos.InspectionFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the reservation ID from path param
+	inspection.ReservationID = reservationID
+
+	dh, err := hh.HandoversService.RecordInspection(r.Context(), inspection.ToDomain(), inspection.PaymentMethod)
+	if err != nil {
+		if err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrInspectionAlreadyRecorded ||
+			err.Error() == services.ErrPickupInspectionMissing ||
+			err.Error() == services.ErrReturnMileageBelowPickup ||
+			err.Error() == services.ErrMileageBelowCarMileage ||
+			err.Error() == services.ErrReturnInspectionBeforePickup ||
+			err.Error() == services.ErrInspectionCanceledReservation ||
+			err.Error() == services.ErrInspectionCompletedReservation ||
+			err.Error() == services.ErrPickupTooEarly ||
+			err.Error() == services.ErrInspectionTimeOutOfRange ||
+			err.Error() == services.ErrDepositPaymentMethodRequired ||
+			err.Error() == services.ErrDepositDeclined ||
+			err.Error() == services.ErrPaymentGatewayRejected {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	// the handover was recorded, but what failed to settle has to be finished by hand
+	for _, warning := range dh.Warnings {
+		log.Println(warning)
+	}
+
+	handover.FromDomain(dh)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, handover)
+}
+
+// @Summary Get the handover of a reservation
+// @Description Get the pickup and return inspections of a reservation and the distance driven
+// @ID get-handover
+// @Produce json
+// @Param reservation_id path string true "Reservation id" format(uuid)
+// @Success 200 {object} docs.HandoverResponse "Handover of the reservation"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Handovers
+// @Router /reservations/{reservation_id}/handover [get]
+func (hh Handovers) Get(w http.ResponseWriter, r *http.Request) {
+	var handover dtos.Handover
+
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dh, err := hh.HandoversService.Get(r.Context(), reservationID)
+	if err != nil {
+		if err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	handover.FromDomain(dh)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, handover)
+}
+
+// @Summary Get the mileage of a car
+// @Description Get the cumulative mileage of a car and whether it is due for maintenance
+// @ID get-car-mileage
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Success 200 {object} docs.CarMileageResponse "Mileage of the car"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorCarNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Handovers
+// @Router /cars/{car_id}/mileage [get]
+func (hh Handovers) GetCarMileage(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dcm, err := hh.HandoversService.GetCarMileage(r.Context(), carID)
+	hh.writeCarMileage(w, dcm, err)
+}
+
+// @Summary Register the service of a car
+// @Description Register that a car was serviced at its current mileage, clearing its maintenance due flag
+// @ID register-car-service
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Success 200 {object} docs.CarMileageResponse "Mileage of the car"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorCarNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Handovers
+// @Router /cars/{car_id}/mileage/service [post]
+func (hh Handovers) RegisterCarService(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dcm, err := hh.HandoversService.RegisterCarService(r.Context(), carID)
+	hh.writeCarMileage(w, dcm, err)
+}
+
+func (hh Handovers) writeCarMileage(w http.ResponseWriter, dcm domain.CarMileage, err error) {
+	var carMileage dtos.CarMileage
+
+	if err != nil {
+		if err.Error() == services.ErrCarNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	carMileage.FromDomain(dcm)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, carMileage)
+}
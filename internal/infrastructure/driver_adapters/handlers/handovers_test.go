@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type handoversDependencies struct {
+	handoversService *mocks.MockHandoversService
+}
+
+func NewHandoversDependencies(handoversSrv *mocks.MockHandoversService) *handoversDependencies {
+	return &handoversDependencies{
+		handoversService: handoversSrv,
+	}
+}
+
+func TestHandoversRecordInspection(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	reservationID := uuid.New()
+	inspection := dtos.Inspection{
+		Type:        "Return",
+		Mileage:     10500,
+		FuelLevel:   50,
+		Damages:     []string{},
+		InspectedAt: time.Now(),
+	}
+
+	type args struct {
+		reservationID string
+		inspection    dtos.Inspection
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*handoversDependencies)
+	}{
+		{
+			name: "returns status code 201 when inspection was recorded",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    inspection,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "").Return(domain.Handover{ReservationID: reservationID}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when inspection type is invalid",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    dtos.Inspection{Type: "Other"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *handoversDependencies) {},
+		},
+		{
+			name: "returns status code 400 when return mileage is lower than pickup mileage",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    inspection,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "").Return(domain.Handover{}, errors.New(services.ErrReturnMileageBelowPickup))
+			},
+		},
+		{
+			name: "returns status code 400 when the inspection time is far from the current time",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    inspection,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "").Return(domain.Handover{}, errors.New(services.ErrInspectionTimeOutOfRange))
+			},
+		},
+		{
+			name: "returns status code 400 when the security deposit hold was declined",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    dtos.Inspection{Type: "Pickup", Mileage: 10500, FuelLevel: 100, PaymentMethod: " pm_card_declined "},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "pm_card_declined").Return(domain.Handover{}, errors.New(services.ErrDepositDeclined))
+			},
+		},
+		{
+			name: "returns status code 404 when reservation was not found",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    inspection,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "").Return(domain.Handover{}, errors.New(services.ErrReservationNotFound))
+			},
+		},
+		{
+			name: "returns status code 500 when handovers service fails",
+			args: args{
+				reservationID: reservationID.String(),
+				inspection:    inspection,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().RecordInspection(gomock.Any(), gomock.Any(), "").Return(domain.Handover{}, errors.New("error recording inspection"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			handoversSrv := mocks.NewMockHandoversService(mockCtlr)
+			d := NewHandoversDependencies(handoversSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.inspection)
+			URL := "/api/v1/reservations/" + test.args.reservationID + "/inspections"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.reservationID})
+
+			rr := httptest.NewRecorder()
+
+			handoversHandler := NewHandovers(handoversSrv)
+			handoversHandler.RecordInspection(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestHandoversGetCarMileage(t *testing.T) {
+	carID := uuid.New()
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		id       string
+		wants    wants
+		setMocks func(*handoversDependencies)
+	}{
+		{
+			name: "returns status code 200 when car was found",
+			id:   carID.String(),
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().GetCarMileage(gomock.Any(), carID).Return(domain.CarMileage{CarID: carID}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when car id is invalid",
+			id:   "invalid-id",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *handoversDependencies) {},
+		},
+		{
+			name: "returns status code 404 when car was not found",
+			id:   carID.String(),
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *handoversDependencies) {
+				d.handoversService.EXPECT().GetCarMileage(gomock.Any(), carID).Return(domain.CarMileage{}, errors.New(services.ErrCarNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			handoversSrv := mocks.NewMockHandoversService(mockCtlr)
+			d := NewHandoversDependencies(handoversSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/cars/"+test.id+"/mileage", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.id})
+
+			rr := httptest.NewRecorder()
+
+			handoversHandler := NewHandovers(handoversSrv)
+			handoversHandler.GetCarMileage(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Invoices struct {
+	InvoicesService ports.InvoicesService
+}
+
+func NewInvoices(is ports.InvoicesService) Invoices {
+	return Invoices{
+		InvoicesService: is,
+	}
+}
+
+// @Summary Issue the invoice of a reservation
+// @Description Issue the invoice of a completed reservation. Returned cars are invoiced on their own, so this is only needed for reservations completed by hand. Issuing it again returns the invoice issued first.
+// @ID issue-invoice
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 201 {object} docs.InvoiceResponse "Invoice of the reservation"
+// @Failure 400 {object} docs.ErrorReservationNotCompleted "Bad Request"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /reservations/{id}/invoice [post]
+func (ih Invoices) Issue(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	di, err := ih.InvoicesService.Issue(r.Context(), reservationID)
+	ih.writeInvoice(w, http.StatusCreated, di, err)
+}
+
+// @Summary Get an invoice
+// @Description Get an invoice by UUID
+// @ID get-invoice
+// @Produce json
+// @Param id path string true "Invoice UUID" format(uuid)
+// @Success 200 {object} docs.InvoiceResponse "Invoice"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorInvoiceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /invoices/{id} [get]
+func (ih Invoices) Get(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	di, err := ih.InvoicesService.Get(r.Context(), ID)
+	ih.writeInvoice(w, http.StatusOK, di, err)
+}
+
+// @Summary Get the invoice of a reservation
+// @Description Get the invoice issued for a reservation
+// @ID get-reservation-invoice
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.InvoiceResponse "Invoice of the reservation"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorInvoiceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /reservations/{id}/invoice [get]
+func (ih Invoices) GetByReservationID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	di, err := ih.InvoicesService.GetByReservationID(r.Context(), reservationID)
+	ih.writeInvoice(w, http.StatusOK, di, err)
+}
+
+// @Summary Download an invoice
+// @Description Download the document of an invoice as PDF, the default, or HTML
+// @ID download-invoice
+// @Produce application/pdf
+// @Produce html
+// @Param id path string true "Invoice UUID" format(uuid)
+// @Param format query string false "Document format" Enums(pdf, html)
+// @Success 200 {file} file "Invoice document"
+// @Failure 400 {object} docs.ErrorUnsupportedInvoiceFormat "Bad Request"
+// @Failure 404 {object} docs.ErrorInvoiceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /invoices/{id}/download [get]
+func (ih Invoices) Download(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "pdf"
+	}
+
+	content, contentType, err := ih.InvoicesService.Open(r.Context(), ID, format)
+	if err != nil {
+		if err.Error() == services.ErrInvoiceNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrUnsupportedInvoiceFormat {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"invoice-%s.%s\"", ID, format))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		log.Println(err)
+	}
+}
+
+// @Summary Get the billing profile of a user
+// @Description Get the company details put on the invoices of a user
+// @ID get-billing-profile
+// @Produce json
+// @Param id path string true "User UUID" format(uuid)
+// @Success 200 {object} docs.BillingProfileResponse "Billing profile"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorBillingProfileNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /users/{id}/billing-profile [get]
+func (ih Invoices) GetBillingProfile(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	userID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dbp, err := ih.InvoicesService.GetBillingProfile(r.Context(), userID)
+	ih.writeBillingProfile(w, dbp, err)
+}
+
+// @Summary Save the billing profile of a user
+// @Description Create or replace the company details put on the invoices of a user. Invoices already issued keep the details they were issued with.
+// @ID save-billing-profile
+// @Accept json
+// @Produce json
+// @Param id path string true "User UUID" format(uuid)
+// @Param profile body docs.BillingProfileRequest true "Company details"
+// @Success 200 {object} docs.BillingProfileResponse "Billing profile"
+// @Failure 400 {object} docs.ErrorInvalidCountry "Bad Request"
+// @Failure 404 {object} docs.ErrorUserNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Invoices
+// @Router /users/{id}/billing-profile [put]
+func (ih Invoices) SaveBillingProfile(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	userID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	profile, err := dtos.BillingProfileFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	profile.UserID = userID
+
+	dbp, err := ih.InvoicesService.SaveBillingProfile(r.Context(), profile.ToDomain())
+	ih.writeBillingProfile(w, dbp, err)
+}
+
+// Writes the invoice, or the error its operation failed with
+func (ih Invoices) writeInvoice(w http.ResponseWriter, statusCode int, di domain.Invoice, err error) {
+	if err != nil {
+		if err.Error() == services.ErrInvoiceNotFound ||
+			err.Error() == services.ErrReservationNotFound ||
+			err.Error() == services.ErrUserNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrReservationNotCompleted {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var invoice dtos.Invoice
+	invoice.FromDomain(di)
+	httphandler.WriteSuccessResponse(w, statusCode, invoice)
+}
+
+// Writes the billing profile, or the error its operation failed with
+func (ih Invoices) writeBillingProfile(w http.ResponseWriter, dbp domain.BillingProfile, err error) {
+	if err != nil {
+		if err.Error() == services.ErrBillingProfileNotFound ||
+			err.Error() == services.ErrUserNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var profile dtos.BillingProfile
+	profile.FromDomain(dbp)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, profile)
+}
@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type invoicesDependencies struct {
+	invoicesService *mocks.MockInvoicesService
+}
+
+func NewInvoicesDependencies(invoicesSrv *mocks.MockInvoicesService) *invoicesDependencies {
+	return &invoicesDependencies{
+		invoicesService: invoicesSrv,
+	}
+}
+
+func TestInvoicesIssue(t *testing.T) {
+	reservationID := uuid.New()
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*invoicesDependencies)
+	}{
+		{
+			name: "returns status code 201 when the invoice was issued",
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservationID).Return(domain.Invoice{Number: "INV-2027-000001"}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when the reservation is not completed",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservationID).Return(domain.Invoice{}, errors.New(services.ErrReservationNotCompleted))
+			},
+		},
+		{
+			name: "returns status code 404 when the reservation was not found",
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Issue(gomock.Any(), reservationID).Return(domain.Invoice{}, errors.New(services.ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			invoicesSrv := mocks.NewMockInvoicesService(mockCtlr)
+			d := NewInvoicesDependencies(invoicesSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + reservationID.String() + "/invoice"
+			req, err := http.NewRequest(http.MethodPost, URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": reservationID.String()})
+
+			rr := httptest.NewRecorder()
+
+			invoicesHandler := NewInvoices(invoicesSrv)
+			invoicesHandler.Issue(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestInvoicesDownload(t *testing.T) {
+	invoiceID := uuid.New()
+
+	type wants struct {
+		statusCode  int
+		contentType string
+	}
+	tests := []struct {
+		name     string
+		format   string
+		wants    wants
+		setMocks func(*invoicesDependencies)
+	}{
+		{
+			name:   "returns the pdf document when no format is given",
+			format: "",
+			wants: wants{
+				statusCode:  http.StatusOK,
+				contentType: "application/pdf",
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Open(gomock.Any(), invoiceID, "pdf").
+					Return(io.NopCloser(bytes.NewReader([]byte("%PDF-1.4"))), "application/pdf", nil)
+			},
+		},
+		{
+			name:   "returns the html document when asked for",
+			format: "html",
+			wants: wants{
+				statusCode:  http.StatusOK,
+				contentType: "text/html",
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Open(gomock.Any(), invoiceID, "html").
+					Return(io.NopCloser(bytes.NewReader([]byte("<html></html>"))), "text/html; charset=utf-8", nil)
+			},
+		},
+		{
+			name:   "returns status code 400 when the format is not supported",
+			format: "docx",
+			wants: wants{
+				statusCode:  http.StatusBadRequest,
+				contentType: "application/json",
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Open(gomock.Any(), invoiceID, "docx").
+					Return(nil, "", errors.New(services.ErrUnsupportedInvoiceFormat))
+			},
+		},
+		{
+			name:   "returns status code 404 when the invoice was not found",
+			format: "pdf",
+			wants: wants{
+				statusCode:  http.StatusNotFound,
+				contentType: "application/json",
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().Open(gomock.Any(), invoiceID, "pdf").
+					Return(nil, "", errors.New(services.ErrInvoiceNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			invoicesSrv := mocks.NewMockInvoicesService(mockCtlr)
+			d := NewInvoicesDependencies(invoicesSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/invoices/" + invoiceID.String() + "/download"
+			if test.format != "" {
+				URL += "?format=" + test.format
+			}
+			req, err := http.NewRequest(http.MethodGet, URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": invoiceID.String()})
+
+			rr := httptest.NewRecorder()
+
+			invoicesHandler := NewInvoices(invoicesSrv)
+			invoicesHandler.Download(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			assert.Contains(t, rr.Header().Get("Content-Type"), test.wants.contentType)
+		})
+	}
+}
+
+func TestInvoicesSaveBillingProfile(t *testing.T) {
+	userID := uuid.New()
+
+	type args struct {
+		body string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*invoicesDependencies)
+	}{
+		{
+			name: "returns status code 200 when the billing profile was saved",
+			args: args{
+				body: `{"company_name": "Acme Logistics", "tax_id": "900123456-7", "address": "Calle 93 #11-28", "city": "Bogota", "postal_code": "110221", "country": "co"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				profile := domain.BillingProfile{
+					UserID:      userID,
+					CompanyName: "Acme Logistics",
+					TaxID:       "900123456-7",
+					Address:     "Calle 93 #11-28",
+					City:        "Bogota",
+					PostalCode:  "110221",
+					Country:     "CO",
+				}
+				d.invoicesService.EXPECT().SaveBillingProfile(gomock.Any(), profile).Return(profile, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when the country is not a country code",
+			args: args{
+				body: `{"company_name": "Acme Logistics", "tax_id": "900123456-7", "address": "Calle 93 #11-28", "city": "Bogota", "postal_code": "110221", "country": "Colombia"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *invoicesDependencies) {},
+		},
+		{
+			name: "returns status code 404 when the user was not found",
+			args: args{
+				body: `{"company_name": "Acme Logistics", "tax_id": "900123456-7", "address": "Calle 93 #11-28", "city": "Bogota", "postal_code": "110221", "country": "CO"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *invoicesDependencies) {
+				d.invoicesService.EXPECT().SaveBillingProfile(gomock.Any(), gomock.Any()).Return(domain.BillingProfile{}, errors.New(services.ErrUserNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			invoicesSrv := mocks.NewMockInvoicesService(mockCtlr)
+			d := NewInvoicesDependencies(invoicesSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/users/" + userID.String() + "/billing-profile"
+			req, err := http.NewRequest(http.MethodPut, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": userID.String()})
+
+			rr := httptest.NewRecorder()
+
+			invoicesHandler := NewInvoices(invoicesSrv)
+			invoicesHandler.SaveBillingProfile(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
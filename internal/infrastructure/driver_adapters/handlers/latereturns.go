@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+)
+
+type LateReturns struct {
+	LateReturnsService ports.LateReturnsService
+}
+
+func NewLateReturns(lrs ports.LateReturnsService) LateReturns {
+	return LateReturns{
+		LateReturnsService: lrs,
+	}
+}
+
+// @Summary List overdue rentals
+// @Description List the rentals whose car was picked up and is still out past the grace period after their end, the ones overdue the longest first.
+// @Description Their lateness is measured as if the car were returned now, and the next reservation on the car is the one that may not get it on time.
+// @ID list-overdue-rentals
+// @Produce json
+// @Success 200 {object} docs.ListOverdueRentalsResponse "Overdue rentals"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Reservations
+// @Router /reservations/overdue [get]
+func (lrh LateReturns) ListOverdue(w http.ResponseWriter, r *http.Request) {
+	dors, err := lrh.LateReturnsService.ListOverdue(r.Context())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.OverdueRentalsFromDomain(dors))
+}
+
+// @Summary Notify overdue rentals
+// @Description Email the renter of the next reservation on the car of every overdue rental that the car was not returned on time.
+// @Description Each overdue rental is notified once, so it can be called periodically.
+// @ID notify-overdue-rentals
+// @Produce json
+// @Success 200 {object} docs.ListOverdueRentalsResponse "Overdue rentals with their notices"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Reservations
+// @Router /reservations/overdue/notify [post]
+func (lrh LateReturns) NotifyOverdue(w http.ResponseWriter, r *http.Request) {
+	dors, err := lrh.LateReturnsService.NotifyOverdue(r.Context())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.OverdueRentalsFromDomain(dors))
+}
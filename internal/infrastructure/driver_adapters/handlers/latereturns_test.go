@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type lateReturnsDependencies struct {
+	lateReturnsService *mocks.MockLateReturnsService
+}
+
+func NewLateReturnsDependencies(lateReturnsSrv *mocks.MockLateReturnsService) *lateReturnsDependencies {
+	return &lateReturnsDependencies{
+		lateReturnsService: lateReturnsSrv,
+	}
+}
+
+func TestLateReturnsListOverdue(t *testing.T) {
+	endDate := time.Now().UTC().Add(-2 * time.Hour)
+	rental := domain.OverdueRental{
+		Reservation: domain.Reservation{ID: uuid.New(), CarID: uuid.New(), Status: "Reserved", EndDate: endDate},
+		Lateness:    domain.LateReturn{DueAt: endDate, HoursCharged: 2, HourlyFee: domain.NewMoney(15, "USD"), Fee: domain.NewMoney(30, "USD")},
+	}
+
+	type wants struct {
+		statusCode     int
+		overdueRentals int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies)
+	}{
+		{
+			name: "returns status code 200 and the overdue rentals",
+			wants: wants{
+				statusCode:     http.StatusOK,
+				overdueRentals: 1,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.lateReturnsService.EXPECT().ListOverdue(gomock.Any()).Return([]domain.OverdueRental{rental}, nil)
+			},
+		},
+		{
+			name: "returns status code 200 and an empty list when no rental is overdue",
+			wants: wants{
+				statusCode:     http.StatusOK,
+				overdueRentals: 0,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.lateReturnsService.EXPECT().ListOverdue(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns status code 500 when overdue rentals could not be listed",
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.lateReturnsService.EXPECT().ListOverdue(gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			lateReturnsSrv := mocks.NewMockLateReturnsService(mockCtlr)
+			d := NewLateReturnsDependencies(lateReturnsSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/reservations/overdue", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			lateReturnsHandler := NewLateReturns(lateReturnsSrv)
+			lateReturnsHandler.ListOverdue(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var response dtos.OverdueRentals
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.NotNil(t, response.OverdueRentals)
+				assert.Len(t, response.OverdueRentals, test.wants.overdueRentals)
+			}
+		})
+	}
+}
+
+func TestLateReturnsNotifyOverdue(t *testing.T) {
+	endDate := time.Now().UTC().Add(-2 * time.Hour)
+	next := domain.Reservation{ID: uuid.New(), Status: "Reserved", StartDate: time.Now().UTC().Add(time.Hour)}
+	rental := domain.OverdueRental{
+		Reservation:     domain.Reservation{ID: uuid.New(), CarID: uuid.New(), Status: "Reserved", EndDate: endDate},
+		Lateness:        domain.LateReturn{DueAt: endDate, HoursCharged: 2, HourlyFee: domain.NewMoney(15, "USD"), Fee: domain.NewMoney(30, "USD")},
+		NextReservation: &next,
+		Notice:          &domain.OverdueNotice{NextReservationID: next.ID, NotifiedAt: time.Now().UTC()},
+	}
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*lateReturnsDependencies)
+	}{
+		{
+			name: "returns status code 200 and the overdue rentals with their notices",
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.lateReturnsService.EXPECT().NotifyOverdue(gomock.Any()).Return([]domain.OverdueRental{rental}, nil)
+			},
+		},
+		{
+			name: "returns status code 500 when overdue rentals could not be notified",
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *lateReturnsDependencies) {
+				d.lateReturnsService.EXPECT().NotifyOverdue(gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			lateReturnsSrv := mocks.NewMockLateReturnsService(mockCtlr)
+			d := NewLateReturnsDependencies(lateReturnsSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/reservations/overdue/notify", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			lateReturnsHandler := NewLateReturns(lateReturnsSrv)
+			lateReturnsHandler.NotifyOverdue(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var response dtos.OverdueRentals
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				if assert.Len(t, response.OverdueRentals, 1) && assert.NotNil(t, response.OverdueRentals[0].Notice) {
+					assert.Equal(t, next.ID, response.OverdueRentals[0].Notice.NextReservationID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Ledger struct {
+	LedgerService ports.LedgerService
+}
+
+func NewLedger(ls ports.LedgerService) Ledger {
+	return Ledger{
+		LedgerService: ls,
+	}
+}
+
+// @Summary Get the ledger balance of a reservation
+// @Description Get the balance of the Customer, Revenue, Deposits and Tax accounts for the money moved by a reservation, along with the ledger entries it was computed from.
+// @Description Debits are positive and credits negative, so the total is zero.
+// @ID get-reservation-balance
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.LedgerBalanceResponse "Balance of the reservation"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Ledger
+// @Router /reservations/{id}/balance [get]
+func (lh Ledger) GetBalance(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dlb, err := lh.LedgerService.GetBalance(r.Context(), reservationID)
+	if err != nil {
+		if err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var balance dtos.LedgerBalance
+	balance.FromDomain(dlb)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, balance)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type ledgerDependencies struct {
+	ledgerService *mocks.MockLedgerService
+}
+
+func NewLedgerDependencies(ledgerSrv *mocks.MockLedgerService) *ledgerDependencies {
+	return &ledgerDependencies{
+		ledgerService: ledgerSrv,
+	}
+}
+
+func TestLedgerGetBalance(t *testing.T) {
+	reservationID := uuid.New()
+	balance := domain.LedgerBalance{
+		ReservationID: reservationID,
+		Accounts:      map[string]domain.Money{"Customer": {}, "Revenue": {}, "Deposits": {}, "Tax": {}},
+	}
+
+	type args struct {
+		reservationID string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*ledgerDependencies)
+	}{
+		{
+			name: "returns status code 200 and empty entries when nothing was charged yet",
+			args: args{
+				reservationID: reservationID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *ledgerDependencies) {
+				d.ledgerService.EXPECT().GetBalance(gomock.Any(), reservationID).Return(balance, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when reservation id is invalid",
+			args: args{
+				reservationID: "invalid",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *ledgerDependencies) {},
+		},
+		{
+			name: "returns status code 404 when reservation was not found",
+			args: args{
+				reservationID: reservationID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *ledgerDependencies) {
+				d.ledgerService.EXPECT().GetBalance(gomock.Any(), reservationID).Return(domain.LedgerBalance{}, errors.New(services.ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			ledgerSrv := mocks.NewMockLedgerService(mockCtlr)
+			d := NewLedgerDependencies(ledgerSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + test.args.reservationID + "/balance"
+			req, err := http.NewRequest(http.MethodGet, URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.reservationID})
+
+			rr := httptest.NewRecorder()
+
+			ledgerHandler := NewLedger(ledgerSrv)
+			ledgerHandler.GetBalance(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var response dtos.LedgerBalance
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.NotNil(t, response.Entries)
+				assert.Equal(t, map[string]float64{"Customer": 0, "Revenue": 0, "Deposits": 0, "Tax": 0}, response.Accounts)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/internal/pkg/constants"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Maintenances struct {
+	MaintenancesService ports.MaintenancesService
+}
+
+func NewMaintenances(ms ports.MaintenancesService) Maintenances {
+	return Maintenances{
+		MaintenancesService: ms,
+	}
+}
+
+// @Summary Schedule a maintenance
+// @Description Schedule a maintenance window for a car. Reservations colliding with the window are listed in the response.
+// @ID schedule-maintenance
+// @Accept json
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Param maintenance body docs.MaintenanceRequest true "Maintenance information"
+// @Success 201 {object} docs.MaintenanceWithConflictsResponse "Scheduled maintenance"
+// @Failure 400 {object} docs.ErrorMaintenanceOverlap "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /cars/{car_id}/maintenances [post]
+func (mh Maintenances) Schedule(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	maintenance, err := dtos.MaintenanceFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the car ID from path param
+	maintenance.CarID = carID
+
+	dm, conflictingReservations, err := mh.MaintenancesService.Schedule(r.Context(), maintenance.ToDomain())
+	if err != nil {
+		if isMaintenanceBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, dtos.NewMaintenanceWithConflicts(dm, conflictingReservations))
+}
+
+// @Summary Get a maintenance
+// @Description Get a maintenance by UUID
+// @ID get-maintenance
+// @Produce json
+// @Param id path string true "Maintenance UUID" format(uuid)
+// @Success 200 {object} docs.MaintenanceResponse "Obtained maintenance"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorMaintenanceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /maintenances/{id} [get]
+func (mh Maintenances) Get(w http.ResponseWriter, r *http.Request) {
+	var maintenance dtos.Maintenance
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dm, err := mh.MaintenancesService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrMaintenanceNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	maintenance.FromDomain(dm)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, maintenance)
+}
+
+// @Summary Update a maintenance
+// @Description Update a maintenance by UUID. Reservations colliding with the new window are listed in the response.
+// @ID update-maintenance
+// @Accept json
+// @Produce json
+// @Param id path string true "Maintenance UUID" format(uuid)
+// @Param maintenance body docs.MaintenanceUpdateRequest true "Maintenance information"
+// @Success 200 {object} docs.MaintenanceWithConflictsResponse "Updated maintenance"
+// @Failure 400 {object} docs.ErrorInvalidMaintenanceTimeFrame "Bad Request"
+// @Failure 404 {object} docs.ErrorMaintenanceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /maintenances/{id} [put]
+func (mh Maintenances) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	maintenance, err := dtos.MaintenanceFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	maintenance.ID = ID
+
+	conflictingReservations, err := mh.MaintenancesService.FullUpdate(r.Context(), maintenance.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrMaintenanceNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if isMaintenanceBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.NewMaintenanceWithConflicts(maintenance.ToDomain(), conflictingReservations))
+}
+
+// @Summary Delete a maintenance
+// @Description Delete a maintenance by UUID
+// @ID delete-maintenance
+// @Produce json
+// @Param id path string true "Maintenance UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorMaintenanceNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /maintenances/{id} [delete]
+func (mh Maintenances) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = mh.MaintenancesService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrMaintenanceNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary Get upcoming maintenances in a city
+// @Description Get the maintenances of the cars in a city overlapping a time frame (next seven days by default)
+// @ID get-maintenances
+// @Produce json
+// @Param city query string true "City name"
+// @Param from_maintenance_id query string false "Last seen maintenance" format(uuid)
+// @Param start_date query string false "Start date"
+// @Param end_date query string false "End date"
+// @Success 200 {object} docs.Maintenances "Obtained maintenances"
+// @Failure 400 {object} docs.ErrorCityQueryParamEmpty "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /maintenances/ [get]
+func (mh Maintenances) List(w http.ResponseWriter, r *http.Request) {
+	var startDate, endDate time.Time
+	var err error
+
+	city := r.URL.Query().Get("city")
+	if city == "" {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrCityQueryParamEmpty)
+		return
+	}
+	datetimeLayout := constants.Values().DATETIME_LAYOUT
+	fromMaintenanceID := r.URL.Query().Get("from_maintenance_id")
+	if _, err := uuid.Parse(fromMaintenanceID); fromMaintenanceID != "" && err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("from_maintenance_id: %s", err.Error()))
+		return
+	}
+	if sDate := r.URL.Query().Get("start_date"); sDate != "" {
+		if startDate, err = time.Parse(datetimeLayout, sDate); err != nil {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("start_date: %s", err.Error()))
+			return
+		}
+	}
+	if eDate := r.URL.Query().Get("end_date"); eDate != "" {
+		if endDate, err = time.Parse(datetimeLayout, eDate); err != nil {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("end_date: %s", err.Error()))
+			return
+		}
+	}
+	if !startDate.IsZero() && !endDate.IsZero() {
+		if endDate.Before(startDate) || startDate.Equal(endDate) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrorInvalidTimeFrame)
+			return
+		}
+	}
+
+	dms, err := mh.MaintenancesService.List(r.Context(), city, fromMaintenanceID, startDate, endDate)
+	if err != nil && err.Error() != services.ErrInvalidCityName {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, getMaintenancesResponse(dms))
+}
+
+// @Summary Get maintenances by Car id
+// @Description Get maintenances by Car id
+// @ID get-maintenances-by-car
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Success 200 {object} docs.Maintenances "Obtained maintenances"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Maintenances
+// @Router /cars/{car_id}/maintenances [get]
+func (mh Maintenances) GetByCarID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dms, err := mh.MaintenancesService.GetByCarID(r.Context(), carID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, getMaintenancesResponse(dms))
+}
+
+// Checks whether a maintenance error is caused by the client request
+func isMaintenanceBadRequest(err error) bool {
+	return err.Error() == services.ErrCarNotFound ||
+		err.Error() == services.ErrInvalidMaintenanceTimeFrame ||
+		err.Error() == services.ErrMaintenanceOverlap
+}
+
+func getMaintenancesResponse(domainMaintenances []domain.Maintenance) (maintenances dtos.Maintenances) {
+	maintenances.Maintenances = make([]dtos.Maintenance, 0)
+	for _, domainMaintenance := range domainMaintenances {
+		maintenance := dtos.Maintenance{}
+		maintenance.FromDomain(domainMaintenance)
+
+		maintenances.Maintenances = append(maintenances.Maintenances, maintenance)
+	}
+
+	return maintenances
+}
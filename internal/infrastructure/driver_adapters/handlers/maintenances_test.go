@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type maintenancesDependencies struct {
+	maintenancesService *mocks.MockMaintenancesService
+}
+
+func NewMaintenancesDependencies(maintenancesSrv *mocks.MockMaintenancesService) *maintenancesDependencies {
+	return &maintenancesDependencies{
+		maintenancesService: maintenancesSrv,
+	}
+}
+
+func TestMaintenancesSchedule(t *testing.T) {
+	carID := uuid.New()
+	maintenance := dtos.Maintenance{
+		StartDate: time.Now().Add(24 * time.Hour),
+		EndDate:   time.Now().Add(48 * time.Hour),
+		Reason:    "Brakes replacement",
+	}
+
+	type args struct {
+		carID       string
+		maintenance dtos.Maintenance
+	}
+	type wants struct {
+		statusCode int
+		warning    string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name: "returns status code 201 when maintenance was scheduled",
+			args: args{
+				carID:       carID.String(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Schedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, dm domain.Maintenance) (domain.Maintenance, []domain.Reservation, error) {
+						assert.Equal(t, carID, dm.CarID)
+						return dm, nil, nil
+					})
+			},
+		},
+		{
+			name: "returns a warning when the maintenance collides with reservations",
+			args: args{
+				carID:       carID.String(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+				warning:    dtos.MaintenanceConflictsWarning,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Schedule(gomock.Any(), gomock.Any()).Return(domain.Maintenance{}, []domain.Reservation{{ID: uuid.New(), CarID: carID}}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when car id is invalid",
+			args: args{
+				carID:       "invalid-id",
+				maintenance: maintenance,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns status code 400 when reason is empty",
+			args: args{
+				carID:       carID.String(),
+				maintenance: dtos.Maintenance{StartDate: maintenance.StartDate, EndDate: maintenance.EndDate},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns status code 400 when maintenance overlaps another one",
+			args: args{
+				carID:       carID.String(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Schedule(gomock.Any(), gomock.Any()).Return(domain.Maintenance{}, nil, errors.New(services.ErrMaintenanceOverlap))
+			},
+		},
+		{
+			name: "returns status code 500 when maintenances service fails",
+			args: args{
+				carID:       carID.String(),
+				maintenance: maintenance,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Schedule(gomock.Any(), gomock.Any()).Return(domain.Maintenance{}, nil, errors.New("error scheduling maintenance"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesSrv := mocks.NewMockMaintenancesService(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.maintenance)
+			URL := "/api/v1/cars/" + test.args.carID + "/maintenances"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.carID})
+
+			rr := httptest.NewRecorder()
+
+			maintenancesHandler := NewMaintenances(maintenancesSrv)
+			maintenancesHandler.Schedule(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusCreated {
+				var response dtos.MaintenanceWithConflicts
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, test.wants.warning, response.Warning)
+			}
+		})
+	}
+}
+
+func TestMaintenancesGet(t *testing.T) {
+	ID := uuid.New()
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		id       string
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name: "returns status code 200 when maintenance was found",
+			id:   ID.String(),
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Get(gomock.Any(), ID).Return(domain.Maintenance{ID: ID}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when id is invalid",
+			id:   "invalid-id",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns status code 404 when maintenance was not found",
+			id:   ID.String(),
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().Get(gomock.Any(), ID).Return(domain.Maintenance{}, errors.New(services.ErrMaintenanceNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesSrv := mocks.NewMockMaintenancesService(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/maintenances/"+test.id, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.id})
+
+			rr := httptest.NewRecorder()
+
+			maintenancesHandler := NewMaintenances(maintenancesSrv)
+			maintenancesHandler.Get(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestMaintenancesList(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		query    url.Values
+		wants    wants
+		setMocks func(*maintenancesDependencies)
+	}{
+		{
+			name:  "returns status code 200 when city is given",
+			query: url.Values{"city": []string{"Seattle"}},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().List(gomock.Any(), "Seattle", "", time.Time{}, time.Time{}).Return([]domain.Maintenance{{ID: uuid.New()}}, nil)
+			},
+		},
+		{
+			name:  "returns status code 400 when city is not given",
+			query: url.Values{},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name: "returns status code 400 when time frame is invalid",
+			query: url.Values{
+				"city":       []string{"Seattle"},
+				"start_date": []string{"2030-01-02T00:00:00Z"},
+				"end_date":   []string{"2030-01-01T00:00:00Z"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *maintenancesDependencies) {},
+		},
+		{
+			name:  "returns status code 500 when maintenances service fails",
+			query: url.Values{"city": []string{"Seattle"}},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *maintenancesDependencies) {
+				d.maintenancesService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error listing maintenances"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			maintenancesSrv := mocks.NewMockMaintenancesService(mockCtlr)
+			d := NewMaintenancesDependencies(maintenancesSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/maintenances/?"+test.query.Encode(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			maintenancesHandler := NewMaintenances(maintenancesSrv)
+			maintenancesHandler.List(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
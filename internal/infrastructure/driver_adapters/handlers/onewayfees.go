@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type OneWayFees struct {
+	OneWayFeesService ports.OneWayFeesService
+}
+
+func NewOneWayFees(owfs ports.OneWayFeesService) OneWayFees {
+	return OneWayFees{
+		OneWayFeesService: owfs,
+	}
+}
+
+// @Summary Set a one-way fee
+// @Description Set the fee charged for returning in another city a car picked up in the given one.
+// @Description Routes without a fee do not allow one-way rentals.
+// @ID set-one-way-fee
+// @Accept json
+// @Produce json
+// @Param city_id path string true "Pickup city UUID" format(uuid)
+// @Param to_city_id path string true "Return city UUID" format(uuid)
+// @Param fee body docs.OneWayFeeRequest true "One-way fee"
+// @Success 200 {object} docs.OneWayFeeResponse "One-way fee of the route"
+// @Failure 400 {object} docs.ErrorOneWayFeeSameCity "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{city_id}/one-way-fees/{to_city_id} [put]
+func (owfh OneWayFees) Set(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	fromCityID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+	toCityID, err := uuid.Parse(params["to_city_id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	oneWayFee, err := dtos.OneWayFeeFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the cities from path params
+	oneWayFee.FromCityID = fromCityID
+	oneWayFee.ToCityID = toCityID
+
+	if err = owfh.OneWayFeesService.Set(r.Context(), oneWayFee.ToDomain()); err != nil {
+		if err.Error() == services.ErrOneWayFeeSameCity {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else if err.Error() == services.ErrCityNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, oneWayFee)
+}
+
+// @Summary List the one-way fees of a city
+// @Description List the routes starting at a city that allow one-way rentals, with their fees
+// @ID list-city-one-way-fees
+// @Produce json
+// @Param city_id path string true "Pickup city UUID" format(uuid)
+// @Success 200 {object} docs.ListOneWayFeesResponse "One-way fees of the city"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{city_id}/one-way-fees [get]
+func (owfh OneWayFees) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	fromCityID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dowfs, err := owfh.OneWayFeesService.ListByCityID(r.Context(), fromCityID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	oneWayFees := dtos.OneWayFees{OneWayFees: make([]dtos.OneWayFee, 0, len(dowfs))}
+	for _, dowf := range dowfs {
+		oneWayFee := dtos.OneWayFee{}
+		oneWayFee.FromDomain(dowf)
+		oneWayFees.OneWayFees = append(oneWayFees.OneWayFees, oneWayFee)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, oneWayFees)
+}
+
+// @Summary Delete a one-way fee
+// @Description Delete the fee of a route, which stops allowing one-way rentals. Existing reservations keep their fee.
+// @ID delete-one-way-fee
+// @Produce json
+// @Param city_id path string true "Pickup city UUID" format(uuid)
+// @Param to_city_id path string true "Return city UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorOneWayFeeNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{city_id}/one-way-fees/{to_city_id} [delete]
+func (owfh OneWayFees) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	fromCityID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+	toCityID, err := uuid.Parse(params["to_city_id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = owfh.OneWayFeesService.Delete(r.Context(), fromCityID, toCityID); err != nil {
+		if err.Error() == services.ErrOneWayFeeNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
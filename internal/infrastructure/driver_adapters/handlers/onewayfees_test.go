@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type oneWayFeesDependencies struct {
+	oneWayFeesService *mocks.MockOneWayFeesService
+}
+
+func NewOneWayFeesDependencies(oneWayFeesSrv *mocks.MockOneWayFeesService) *oneWayFeesDependencies {
+	return &oneWayFeesDependencies{
+		oneWayFeesService: oneWayFeesSrv,
+	}
+}
+
+func TestOneWayFeesSet(t *testing.T) {
+	fromCityID := uuid.New()
+	toCityID := uuid.New()
+	oneWayFee := domain.OneWayFee{FromCityID: fromCityID, ToCityID: toCityID, Fee: 150, Currency: "USD"}
+
+	type args struct {
+		toCityID string
+		body     dtos.OneWayFee
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*oneWayFeesDependencies)
+	}{
+		{
+			name: "returns status code 200 when the fee was set",
+			args: args{
+				toCityID: toCityID.String(),
+				body:     dtos.OneWayFee{Fee: 150, Currency: "USD"},
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {
+				d.oneWayFeesService.EXPECT().Set(gomock.Any(), oneWayFee).Return(nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the fee is negative",
+			args: args{
+				toCityID: toCityID.String(),
+				body:     dtos.OneWayFee{Fee: -1},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the currency of the fee is not valid",
+			args: args{
+				toCityID: toCityID.String(),
+				body:     dtos.OneWayFee{Fee: 150, Currency: "usd dollars"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the return city id is not valid",
+			args: args{
+				toCityID: "not-a-uuid",
+				body:     dtos.OneWayFee{Fee: 150, Currency: "USD"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {},
+		},
+		{
+			name: "returns 404 status code when a city was not found",
+			args: args{
+				toCityID: toCityID.String(),
+				body:     dtos.OneWayFee{Fee: 150, Currency: "USD"},
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {
+				d.oneWayFeesService.EXPECT().Set(gomock.Any(), oneWayFee).Return(errors.New("city not found"))
+			},
+		},
+		{
+			name: "returns 500 status code when one-way fees service fails to set the fee",
+			args: args{
+				toCityID: toCityID.String(),
+				body:     dtos.OneWayFee{Fee: 150, Currency: "USD"},
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *oneWayFeesDependencies) {
+				d.oneWayFeesService.EXPECT().Set(gomock.Any(), oneWayFee).Return(errors.New("error setting one-way fee"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			oneWayFeesSrv := mocks.NewMockOneWayFeesService(mockCtlr)
+			d := NewOneWayFeesDependencies(oneWayFeesSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.body)
+			req, err := http.NewRequest(http.MethodPut, "/api/v1/cities/"+fromCityID.String()+"/one-way-fees/"+test.args.toCityID, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": fromCityID.String(), "to_city_id": test.args.toCityID})
+
+			rr := httptest.NewRecorder()
+
+			oneWayFeesHandler := NewOneWayFees(oneWayFeesSrv)
+			oneWayFeesHandler.Set(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
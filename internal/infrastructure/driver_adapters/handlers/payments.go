@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// Header with the signature of the events sent to the webhook
+	paymentSignatureHeader = "X-Payment-Signature"
+	// Largest event accepted by the webhook
+	maxPaymentEventBytes = 64 << 10
+)
+
+type Payments struct {
+	PaymentsService ports.PaymentsService
+}
+
+func NewPayments(ps ports.PaymentsService) Payments {
+	return Payments{
+		PaymentsService: ps,
+	}
+}
+
+// @Summary Authorize the payment of a reservation
+// @Description Hold the total of the reservation quote on a payment method, in the currency of the city of the car.
+// @Description The payment is Authorized, Failed when the payment method is declined, or Pending until the provider confirms it through the webhook.
+// @Description A new payment can only be started when the previous one failed or was voided.
+// @ID authorize-payment
+// @Accept json
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Param authorization body docs.PaymentAuthorizationRequest true "Payment method"
+// @Success 201 {object} docs.PaymentResponse "Payment of the reservation"
+// @Failure 400 {object} docs.ErrorPaymentInProgress "Bad Request"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /reservations/{id}/payment/authorize [post]
+func (ph Payments) Authorize(w http.ResponseWriter, r *http.Request) {
+	var payment dtos.Payment
+
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	authorization, err := dtos.PaymentAuthorizationFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dp, err := ph.PaymentsService.Authorize(r.Context(), reservationID, authorization.PaymentMethod)
+	if err != nil {
+		if err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrReservationNotPayable ||
+			err.Error() == services.ErrPaymentInProgress ||
+			err.Error() == services.ErrPaymentGatewayRejected {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	payment.FromDomain(dp)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, payment)
+}
+
+// @Summary Capture the payment of a reservation
+// @Description Charge the authorized amount of the reservation payment
+// @ID capture-payment
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.PaymentResponse "Captured payment"
+// @Failure 400 {object} docs.ErrorInvalidPaymentTransition "Bad Request"
+// @Failure 404 {object} docs.ErrorPaymentNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /reservations/{id}/payment/capture [post]
+func (ph Payments) Capture(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dp, err := ph.PaymentsService.Capture(r.Context(), reservationID)
+	ph.writePayment(w, dp, err)
+}
+
+// @Summary Void the payment of a reservation
+// @Description Release the authorization of the reservation payment without charging it
+// @ID void-payment
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.PaymentResponse "Voided payment"
+// @Failure 400 {object} docs.ErrorInvalidPaymentTransition "Bad Request"
+// @Failure 404 {object} docs.ErrorPaymentNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /reservations/{id}/payment/void [post]
+func (ph Payments) Void(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dp, err := ph.PaymentsService.Void(r.Context(), reservationID)
+	ph.writePayment(w, dp, err)
+}
+
+// @Summary Refund the payment of a reservation
+// @Description Give back part of the captured amount of the reservation payment, or all that is left of it when no amount is given.
+// @Description The payment is Refunded once the whole captured amount was given back.
+// @ID refund-payment
+// @Accept json
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Param refund body docs.RefundRequest false "Amount to refund"
+// @Success 200 {object} docs.PaymentResponse "Refunded payment"
+// @Failure 400 {object} docs.ErrorRefundExceedsCaptured "Bad Request"
+// @Failure 404 {object} docs.ErrorPaymentNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /reservations/{id}/payment/refund [post]
+func (ph Payments) Refund(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	refund, err := dtos.RefundFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dp, err := ph.PaymentsService.Refund(r.Context(), reservationID, refund.Amount)
+	ph.writePayment(w, dp, err)
+}
+
+// @Summary Get the payment of a reservation
+// @Description Get the latest payment of a reservation
+// @ID get-payment
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Success 200 {object} docs.PaymentResponse "Payment of the reservation"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorPaymentNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /reservations/{id}/payment [get]
+func (ph Payments) Get(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	reservationID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dp, err := ph.PaymentsService.Get(r.Context(), reservationID)
+	ph.writePayment(w, dp, err)
+}
+
+// @Summary Receive payment events
+// @Description Receive the events the payment provider sends when a payment changes, such as the confirmation of a pending authorization.
+// @Description Events must be signed with the signature header of the provider. Events already applied are ignored, and captures of more than the authorized amount or in another currency are rejected.
+// @ID payment-webhook
+// @Accept json
+// @Param X-Payment-Signature header string true "Signature of the event"
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorInvalidPaymentSignature "Bad Request"
+// @Failure 404 {object} docs.ErrorPaymentNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Payments
+// @Router /payments/webhook [post]
+func (ph Payments) Webhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPaymentEventBytes))
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err = ph.PaymentsService.HandleEvent(r.Context(), payload, r.Header.Get(paymentSignatureHeader)); err != nil {
+		if err.Error() == services.ErrPaymentNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrInvalidPaymentSignature ||
+			err.Error() == services.ErrUnsupportedPaymentEvent ||
+			err.Error() == services.ErrCaptureExceedsAuthorized ||
+			err.Error() == services.ErrPaymentCurrencyMismatch ||
+			err.Error() == services.ErrPaymentStatusChanged ||
+			strings.HasPrefix(err.Error(), services.ErrInvalidPaymentTransition) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// Writes the payment changed by an operation, or the error it failed with
+func (ph Payments) writePayment(w http.ResponseWriter, dp domain.Payment, err error) {
+	if err != nil {
+		if err.Error() == services.ErrPaymentNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrPaymentStatusChanged ||
+			err.Error() == services.ErrPaymentGatewayRejected ||
+			strings.HasPrefix(err.Error(), services.ErrInvalidPaymentTransition) ||
+			strings.HasPrefix(err.Error(), services.ErrRefundExceedsCaptured) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var payment dtos.Payment
+	payment.FromDomain(dp)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, payment)
+}
@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type paymentsDependencies struct {
+	paymentsService *mocks.MockPaymentsService
+}
+
+func NewPaymentsDependencies(paymentsSrv *mocks.MockPaymentsService) *paymentsDependencies {
+	return &paymentsDependencies{
+		paymentsService: paymentsSrv,
+	}
+}
+
+func TestPaymentsAuthorize(t *testing.T) {
+	reservationID := uuid.New()
+	payment := domain.Payment{
+		ID:            uuid.New(),
+		ReservationID: reservationID,
+		Reference:     "fake_000001",
+		Amount:        domain.NewMoney(500, "USD"),
+		Status:        "Authorized",
+	}
+
+	type args struct {
+		reservationID string
+		body          string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "returns status code 201 when payment was authorized",
+			args: args{
+				reservationID: reservationID.String(),
+				body:          `{"payment_method": "tok_visa"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Authorize(gomock.Any(), reservationID, "tok_visa").Return(payment, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when payment method is missing",
+			args: args{
+				reservationID: reservationID.String(),
+				body:          `{}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when reservation already has a payment in progress",
+			args: args{
+				reservationID: reservationID.String(),
+				body:          `{"payment_method": "tok_visa"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Authorize(gomock.Any(), reservationID, "tok_visa").Return(domain.Payment{}, errors.New(services.ErrPaymentInProgress))
+			},
+		},
+		{
+			name: "returns status code 404 when reservation was not found",
+			args: args{
+				reservationID: reservationID.String(),
+				body:          `{"payment_method": "tok_visa"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Authorize(gomock.Any(), reservationID, "tok_visa").Return(domain.Payment{}, errors.New(services.ErrReservationNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			paymentsSrv := mocks.NewMockPaymentsService(mockCtlr)
+			d := NewPaymentsDependencies(paymentsSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + test.args.reservationID + "/payment/authorize"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.reservationID})
+
+			rr := httptest.NewRecorder()
+
+			paymentsHandler := NewPayments(paymentsSrv)
+			paymentsHandler.Authorize(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusCreated {
+				var response dtos.Payment
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, payment.Reference, response.Reference)
+				assert.Equal(t, payment.Status, response.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentsRefund(t *testing.T) {
+	reservationID := uuid.New()
+
+	type args struct {
+		body string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "returns status code 200 when everything left was refunded",
+			args: args{
+				body: "",
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Refund(gomock.Any(), reservationID, 0.0).Return(domain.Payment{Status: "Refunded"}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when refund exceeds the captured amount",
+			args: args{
+				body: `{"amount": 600}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Refund(gomock.Any(), reservationID, 600.0).Return(domain.Payment{}, fmt.Errorf("%s (500.00 left)", services.ErrRefundExceedsCaptured))
+			},
+		},
+		{
+			name: "returns status code 400 when refund amount is negative",
+			args: args{
+				body: `{"amount": -10}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {},
+		},
+		{
+			name: "returns status code 404 when reservation has no payments",
+			args: args{
+				body: `{"amount": 50}`,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().Refund(gomock.Any(), reservationID, 50.0).Return(domain.Payment{}, errors.New(services.ErrPaymentNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			paymentsSrv := mocks.NewMockPaymentsService(mockCtlr)
+			d := NewPaymentsDependencies(paymentsSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + reservationID.String() + "/payment/refund"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": reservationID.String()})
+
+			rr := httptest.NewRecorder()
+
+			paymentsHandler := NewPayments(paymentsSrv)
+			paymentsHandler.Refund(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestPaymentsWebhook(t *testing.T) {
+	payload := `{"type":"payment.captured","reference":"fake_000001","amount":500}`
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*paymentsDependencies)
+	}{
+		{
+			name: "returns status code 204 when event was applied",
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().HandleEvent(gomock.Any(), []byte(payload), "signature").Return(nil)
+			},
+		},
+		{
+			name: "returns status code 400 when signature is invalid",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().HandleEvent(gomock.Any(), []byte(payload), "signature").Return(errors.New(services.ErrInvalidPaymentSignature))
+			},
+		},
+		{
+			name: "returns status code 400 when payment can not move to the status of the event",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().HandleEvent(gomock.Any(), []byte(payload), "signature").Return(fmt.Errorf("%s (Canceled to Paid)", services.ErrInvalidPaymentTransition))
+			},
+		},
+		{
+			name: "returns status code 400 when the capture is in another currency",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().HandleEvent(gomock.Any(), []byte(payload), "signature").Return(errors.New(services.ErrPaymentCurrencyMismatch))
+			},
+		},
+		{
+			name: "returns status code 404 when payment was not found",
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *paymentsDependencies) {
+				d.paymentsService.EXPECT().HandleEvent(gomock.Any(), []byte(payload), "signature").Return(errors.New(services.ErrPaymentNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			paymentsSrv := mocks.NewMockPaymentsService(mockCtlr)
+			d := NewPaymentsDependencies(paymentsSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/payments/webhook", bytes.NewBufferString(payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("X-Payment-Signature", "signature")
+
+			rr := httptest.NewRecorder()
+
+			paymentsHandler := NewPayments(paymentsSrv)
+			paymentsHandler.Webhook(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type ProtectionPlans struct {
+	ProtectionPlansService ports.ProtectionPlansService
+}
+
+func NewProtectionPlans(pps ports.ProtectionPlansService) ProtectionPlans {
+	return ProtectionPlans{
+		ProtectionPlansService: pps,
+	}
+}
+
+// @Summary Register a new protection plan
+// @Description Register the daily price and deductible of a protection level (Basic, Standard, Full) for a car type.
+// @Description A car type can only have one plan per protection level.
+// @ID register-protection-plan
+// @Accept json
+// @Produce json
+// @Param protection_plan body docs.ProtectionPlanRequest true "Protection plan information"
+// @Success 201 {object} docs.ProtectionPlanResponse "Created protection plan"
+// @Failure 400 {object} docs.ErrorProtectionPlanExists "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags ProtectionPlans
+// @Router /protection-plans [post]
+func (pph ProtectionPlans) Register(w http.ResponseWriter, r *http.Request) {
+	plan, err := dtos.ProtectionPlanFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dpp, err := pph.ProtectionPlansService.Register(r.Context(), plan.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrProtectionPlanExists {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	plan.FromDomain(dpp)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, plan)
+}
+
+// @Summary Get a protection plan
+// @Description Get a protection plan by UUID
+// @ID get-protection-plan
+// @Produce json
+// @Param id path string true "Protection plan UUID" format(uuid)
+// @Success 200 {object} docs.ProtectionPlanResponse "Obtained protection plan"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorProtectionPlanNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags ProtectionPlans
+// @Router /protection-plans/{id} [get]
+func (pph ProtectionPlans) Get(w http.ResponseWriter, r *http.Request) {
+	var plan dtos.ProtectionPlan
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dpp, err := pph.ProtectionPlansService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrProtectionPlanNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	plan.FromDomain(dpp)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, plan)
+}
+
+// @Summary Update a protection plan
+// @Description Update a protection plan by UUID. Reservations already booked keep the terms they were booked with.
+// @ID update-protection-plan
+// @Accept json
+// @Produce json
+// @Param id path string true "Protection plan UUID" format(uuid)
+// @Param protection_plan body docs.ProtectionPlanRequest true "Protection plan information"
+// @Success 200 {object} docs.ProtectionPlanResponse "Updated protection plan"
+// @Failure 400 {object} docs.ErrorProtectionPlanExists "Bad Request"
+// @Failure 404 {object} docs.ErrorProtectionPlanNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags ProtectionPlans
+// @Router /protection-plans/{id} [put]
+func (pph ProtectionPlans) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	plan, err := dtos.ProtectionPlanFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	plan.ID = ID
+
+	if err = pph.ProtectionPlansService.FullUpdate(r.Context(), plan.ToDomain()); err != nil {
+		if err.Error() == services.ErrProtectionPlanNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrProtectionPlanExists {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, plan)
+}
+
+// @Summary Delete a protection plan
+// @Description Delete a protection plan by UUID. Reservations already booked keep the terms they were booked with.
+// @ID delete-protection-plan
+// @Produce json
+// @Param id path string true "Protection plan UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorProtectionPlanNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags ProtectionPlans
+// @Router /protection-plans/{id} [delete]
+func (pph ProtectionPlans) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = pph.ProtectionPlansService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrProtectionPlanNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary List protection plans
+// @Description List the protection plans ordered by car type and protection level
+// @ID list-protection-plans
+// @Produce json
+// @Param car_type query string false "Only list the plans of this car type"
+// @Success 200 {object} docs.ListProtectionPlansResponse "Protection plans"
+// @Failure 400 {object} docs.ErrorInvalidCarType "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags ProtectionPlans
+// @Router /protection-plans [get]
+func (pph ProtectionPlans) List(w http.ResponseWriter, r *http.Request) {
+	carType := r.URL.Query().Get("car_type")
+	if carType != "" && !dtos.IsValidCarType(carType) {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, dtos.ErrInvalidCarType)
+		return
+	}
+
+	dpps, err := pph.ProtectionPlansService.List(r.Context(), carType)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	plans := dtos.ProtectionPlans{ProtectionPlans: make([]dtos.ProtectionPlan, 0, len(dpps))}
+	for _, dpp := range dpps {
+		plan := dtos.ProtectionPlan{}
+		plan.FromDomain(dpp)
+		plans.ProtectionPlans = append(plans.ProtectionPlans, plan)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, plans)
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type protectionPlansDependencies struct {
+	protectionPlansService *mocks.MockProtectionPlansService
+}
+
+func NewProtectionPlansDependencies(protectionPlansSrv *mocks.MockProtectionPlansService) *protectionPlansDependencies {
+	return &protectionPlansDependencies{
+		protectionPlansService: protectionPlansSrv,
+	}
+}
+
+func TestProtectionPlansRegister(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	plan := dtos.ProtectionPlan{
+		Level:      "Standard",
+		CarType:    "Sedan",
+		DailyPrice: 15,
+		Deductible: 500,
+		Currency:   "USD",
+	}
+	invalidLevel := plan
+	invalidLevel.Level = "Premium"
+	negativeDeductible := plan
+	negativeDeductible.Deductible = -1
+
+	type args struct {
+		body dtos.ProtectionPlan
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*protectionPlansDependencies)
+	}{
+		{
+			name: "returns status code 201 when the protection plan was registered",
+			args: args{
+				body: plan,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansService.EXPECT().Register(gomock.Any(), plan.ToDomain()).Return(domain.ProtectionPlan{ID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the protection level is not valid",
+			args: args{
+				body: invalidLevel,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *protectionPlansDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the deductible is negative",
+			args: args{
+				body: negativeDeductible,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *protectionPlansDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the car type already has a plan of the same level",
+			args: args{
+				body: plan,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansService.EXPECT().Register(gomock.Any(), plan.ToDomain()).Return(domain.ProtectionPlan{}, errors.New(services.ErrProtectionPlanExists))
+			},
+		},
+		{
+			name: "returns 500 status code when protection plans service fails to register the plan",
+			args: args{
+				body: plan,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansService.EXPECT().Register(gomock.Any(), plan.ToDomain()).Return(domain.ProtectionPlan{}, errors.New("error registering protection plan"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			protectionPlansSrv := mocks.NewMockProtectionPlansService(mockCtlr)
+			d := NewProtectionPlansDependencies(protectionPlansSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.body)
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/protection-plans", bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			protectionPlansHandler := NewProtectionPlans(protectionPlansSrv)
+			protectionPlansHandler.Register(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestProtectionPlansList(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	type args struct {
+		url string
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*protectionPlansDependencies)
+	}{
+		{
+			name: "returns status code 200 with the plans of the car type",
+			args: args{
+				url: "/api/v1/protection-plans?car_type=Luxury",
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansService.EXPECT().List(gomock.Any(), "Luxury").Return([]domain.ProtectionPlan{{ID: uuid.New(), Level: "Basic", CarType: "Luxury"}}, nil)
+			},
+		},
+		{
+			name: "returns status code 200 with every plan when no car type is given",
+			args: args{
+				url: "/api/v1/protection-plans",
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *protectionPlansDependencies) {
+				d.protectionPlansService.EXPECT().List(gomock.Any(), "").Return(nil, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the car type is not valid",
+			args: args{
+				url: "/api/v1/protection-plans?car_type=Spaceship",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *protectionPlansDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			protectionPlansSrv := mocks.NewMockProtectionPlansService(mockCtlr)
+			d := NewProtectionPlansDependencies(protectionPlansSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, test.args.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			protectionPlansHandler := NewProtectionPlans(protectionPlansSrv)
+			protectionPlansHandler.List(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -32,13 +32,19 @@ func NewReservations(rs ports.ReservationsService) Reservations {
 }
 
 // @Summary Create a reservation
-// @Description Create a reservation with the provided information
+// @Description Create a reservation with the provided information. Pickup and return times must fall inside the opening hours of the car branch, in the city local time.
+// @Description The car is picked up where it will be when the reservation starts. It can be returned at a branch of another city when the route has a one-way fee, which is added to the reservation.
+// @Description Only active users with a verified email can book cars that are available. Drivers must meet the minimum age and license tenure of the car type when the reservation starts, and their license must not expire before it ends.
+// @Description Other active users can be listed as additional drivers when they meet the same requirements. Each of them adds a fee per started day to the quote of the reservation.
+// @Description Add-ons offered at the pickup branch can be added while they have units left for the whole time frame of the reservation.
+// @Description A protection level offered for the car type can be chosen, otherwise the default level is used. Its daily price and deductible are kept with the reservation.
 // @ID create-reservation
 // @Accept json
 // @Produce json
-// @Param reservation body docs.ReservationRequest true "Reservation information (allowed statuses: Reserved, Canceled, Completed; allowed payment statuses: Paid, Pending, Canceled)"
+// @Param reservation body docs.ReservationRequest true "Reservation information (allowed statuses: Reserved, Canceled, Completed)"
 // @Success 201 {object} docs.ReservationResponse "Created reservation"
 // @Failure 400 {object} docs.ErrorMinimumReservationHours "Bad Request"
+// @Failure 403 {object} docs.ErrorUserEmailNotVerified "Forbidden"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
 // @Tags Reservations
 // @Router /reservations [post]
@@ -51,11 +57,27 @@ func (rh Reservations) Book(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if newReservation, err = rh.ReservationsService.Book(r.Context(), reservation.ToDomain()); err != nil {
+		if err.Error() == services.ErrUserInactive ||
+			err.Error() == services.ErrUserEmailNotVerified ||
+			isDriverEligibilityError(err) {
+			httphandler.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if err.Error() == services.ErrUserNotFound ||
+			isAdditionalDriversError(err) ||
+			isAddOnsError(err) ||
+			err.Error() == services.ErrProtectionPlanNotOffered ||
 			err.Error() == services.ErrCarNotFound ||
 			err.Error() == services.ErrInvalidReservationTimeFrame ||
 			err.Error() == services.ErrCarNotAvailable ||
-			strings.HasPrefix(err.Error(), services.ErrMinimumReservationHours) {
+			err.Error() == services.ErrCarUnavailable ||
+			err.Error() == services.ErrCarInMaintenance ||
+			err.Error() == services.ErrCarInTransfer ||
+			err.Error() == services.ErrPickupOutsideOpeningHours ||
+			err.Error() == services.ErrReturnOutsideOpeningHours ||
+			isReservationRouteError(err) ||
+			strings.HasPrefix(err.Error(), services.ErrMinimumReservationHours) ||
+			strings.HasPrefix(err.Error(), services.ErrExchangeRateNotFound) {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
@@ -69,6 +91,71 @@ func (rh Reservations) Book(w http.ResponseWriter, r *http.Request) {
 	httphandler.WriteSuccessResponse(w, http.StatusCreated, reservation)
 }
 
+// @Summary Quote a reservation
+// @Description Get the price breakdown a reservation would be booked with, after the same checks made to book it.
+// @Description The car is rented by started hours of the city local time, and each additional driver is charged by started days.
+// @Description Add-ons are charged once per rental or by started days, depending on their pricing unit.
+// @Description The protection plan is charged by started days.
+// @Description The quote is charged in the currency of the city, and can also be displayed in another currency.
+// @ID quote-reservation
+// @Accept json
+// @Produce json
+// @Param reservation body docs.ReservationRequest true "Reservation information (allowed statuses: Reserved, Canceled, Completed)"
+// @Param currency query string false "ISO 4217 code of the currency to display the quote in"
+// @Success 200 {object} docs.QuoteResponse "Price breakdown"
+// @Failure 400 {object} docs.ErrorTooManyAdditionalDrivers "Bad Request"
+// @Failure 403 {object} docs.ErrorUserEmailNotVerified "Forbidden"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Reservations
+// @Router /reservations/quote [post]
+func (rh Reservations) Quote(w http.ResponseWriter, r *http.Request) {
+	reservation, err := dtos.ReservationFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	displayCurrency := strings.ToUpper(r.URL.Query().Get("currency"))
+	if displayCurrency != "" && !dtos.IsValidCurrency(displayCurrency) {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, dtos.ErrInvalidCurrency)
+		return
+	}
+
+	quote, err := rh.ReservationsService.Quote(r.Context(), reservation.ToDomain(), displayCurrency)
+	if err != nil {
+		if err.Error() == services.ErrUserInactive ||
+			err.Error() == services.ErrUserEmailNotVerified ||
+			isDriverEligibilityError(err) {
+			httphandler.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if err.Error() == services.ErrUserNotFound ||
+			isAdditionalDriversError(err) ||
+			isAddOnsError(err) ||
+			err.Error() == services.ErrProtectionPlanNotOffered ||
+			err.Error() == services.ErrCarNotFound ||
+			err.Error() == services.ErrInvalidReservationTimeFrame ||
+			err.Error() == services.ErrCarNotAvailable ||
+			err.Error() == services.ErrCarUnavailable ||
+			err.Error() == services.ErrCarInMaintenance ||
+			err.Error() == services.ErrCarInTransfer ||
+			err.Error() == services.ErrPickupOutsideOpeningHours ||
+			err.Error() == services.ErrReturnOutsideOpeningHours ||
+			isReservationRouteError(err) ||
+			strings.HasPrefix(err.Error(), services.ErrMinimumReservationHours) ||
+			strings.HasPrefix(err.Error(), services.ErrExchangeRateNotFound) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.QuoteFromDomain(quote))
+}
+
 // @Summary Get a reservation
 // @Description Get a reservation by UUID
 // @ID get-reservation
@@ -114,9 +201,10 @@ func (rh Reservations) Get(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Reservation UUID" format(uuid)
-// @Param reservation body docs.ReservationRequest true "Reservation information (allowed statuses: Reserved, Canceled, Completed; allowed payment statuses: Paid, Pending, Canceled)"
+// @Param reservation body docs.ReservationRequest true "Reservation information (allowed statuses: Reserved, Canceled, Completed)"
 // @Success 200 {object} docs.ReservationResponse "Updated reservation"
 // @Failure 400 {object} docs.ErrorInvalidReservationTimeFrame "Bad Request"
+// @Failure 403 {object} docs.ErrorUserEmailNotVerified "Forbidden"
 // @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
 // @Tags Reservations
@@ -143,11 +231,26 @@ func (rh Reservations) FullUpdate(w http.ResponseWriter, r *http.Request) {
 		if err.Error() == services.ErrReservationNotFound {
 			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
+		} else if err.Error() == services.ErrUserInactive ||
+			err.Error() == services.ErrUserEmailNotVerified ||
+			isDriverEligibilityError(err) {
+			httphandler.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+			return
 		} else if err.Error() == services.ErrUserNotFound ||
+			isAdditionalDriversError(err) ||
+			isAddOnsError(err) ||
+			err.Error() == services.ErrProtectionPlanNotOffered ||
 			err.Error() == services.ErrCarNotFound ||
+			err.Error() == services.ErrCarUnavailable ||
 			err.Error() == services.ErrInvalidReservationTimeFrame ||
 			strings.HasPrefix(err.Error(), services.ErrMinimumReservationHours) ||
-			err.Error() == services.ErrCarNotAvailable {
+			err.Error() == services.ErrCarNotAvailable ||
+			err.Error() == services.ErrCarInMaintenance ||
+			err.Error() == services.ErrCarInTransfer ||
+			err.Error() == services.ErrPickupOutsideOpeningHours ||
+			err.Error() == services.ErrReturnOutsideOpeningHours ||
+			isReservationRouteError(err) ||
+			strings.HasPrefix(err.Error(), services.ErrExchangeRateNotFound) {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		} else {
@@ -167,7 +270,7 @@ func (rh Reservations) FullUpdate(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path string true "Reservation UUID" format(uuid)
 // @Success 204 "No Content"
-// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 400 {object} docs.ErrorReservationInvoiced "Bad Request"
 // @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
 // @Tags Reservations
@@ -184,6 +287,8 @@ func (rh Reservations) Delete(w http.ResponseWriter, r *http.Request) {
 	if err = rh.ReservationsService.Delete(r.Context(), ID); err != nil {
 		if err.Error() == services.ErrReservationNotFound {
 			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrReservationInvoiced {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		} else {
 			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
 			log.Println(err)
@@ -211,19 +316,20 @@ func (rh Reservations) List(w http.ResponseWriter, r *http.Request) {
 	var startDate, endDate time.Time
 	var err error
 
+	datetimeLayout := constants.Values().DATETIME_LAYOUT
 	fromReservationID := r.URL.Query().Get("from_reservation_id")
 	if _, err := uuid.Parse(fromReservationID); fromReservationID != "" && err != nil {
 		httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("from_reservation_id: %s", err.Error()))
 		return
 	}
 	if sDate := r.URL.Query().Get("start_date"); sDate != "" {
-		if startDate, err = time.Parse(constants.Values.DATETIME_LAYOUT, sDate); err != nil {
+		if startDate, err = time.Parse(datetimeLayout, sDate); err != nil {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("start_date: %s", err.Error()))
 			return
 		}
 	}
 	if eDate := r.URL.Query().Get("end_date"); eDate != "" {
-		if endDate, err = time.Parse(constants.Values.DATETIME_LAYOUT, eDate); err != nil {
+		if endDate, err = time.Parse(datetimeLayout, eDate); err != nil {
 			httphandler.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("end_date: %s", err.Error()))
 			return
 		}
@@ -280,7 +386,7 @@ func (rh Reservations) GetByCarID(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Get reservations by User id
-// @Description Get reservations by User id
+// @Description Get reservations by User id, including those where the user is an additional driver
 // @ID get-reservation-by-user
 // @Produce json
 // @Param user_id path string true "User id" format(uuid)
@@ -310,6 +416,75 @@ func (rh Reservations) GetByUserID(w http.ResponseWriter, r *http.Request) {
 	httphandler.WriteSuccessResponse(w, http.StatusOK, reservations)
 }
 
+// @Summary Extend a reservation
+// @Description Keep the car of a reservation until a later end date. Only the extra time is checked for availability, leaving the reservation itself out.
+// @Description The extension costs what pricing the reservation until the new end date adds to pricing it until its current end, at current prices and with the protection terms of the reservation. Its cost is added to the reservation along with the new end date in a single transaction.
+// @Description Reservations whose payment was already authorized or captured can not be extended.
+// @Description When the car is reserved, in maintenance or transferred away during the extra time, nothing is changed and the earliest reservation in the way and the latest end date it can be extended to are returned with status 409.
+// @ID extend-reservation
+// @Accept json
+// @Produce json
+// @Param id path string true "Reservation UUID" format(uuid)
+// @Param extension body docs.ExtensionRequest true "New end date of the reservation"
+// @Success 200 {object} docs.ExtensionResponse "Applied extension"
+// @Failure 400 {object} docs.ErrorInvalidExtensionEndDate "Bad Request"
+// @Failure 403 {object} docs.ErrorDriverLicenseExpires "Forbidden"
+// @Failure 404 {object} docs.ErrorReservationNotFound "Not Found"
+// @Failure 409 {object} docs.ExtensionConflictResponse "Car not available for the whole extension"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Reservations
+// @Router /reservations/{id}/extend [post]
+func (rh Reservations) Extend(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	ID, err := uuid.Parse(id)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	request, err := dtos.ExtensionRequestFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	de, err := rh.ReservationsService.Extend(r.Context(), ID, request.EndDate)
+	if err != nil {
+		if err.Error() == services.ErrReservationNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if isDriverEligibilityError(err) {
+			httphandler.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+		} else if err.Error() == services.ErrInvalidExtensionEndDate ||
+			err.Error() == services.ErrReservationNotExtendable ||
+			err.Error() == services.ErrReservationAlreadyPaid ||
+			err.Error() == services.ErrReturnOutsideOpeningHours ||
+			err.Error() == services.ErrBranchNotFound ||
+			isAddOnsError(err) ||
+			strings.HasPrefix(err.Error(), services.ErrExchangeRateNotFound) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else if err.Error() == services.ErrCarNotAvailable ||
+			err.Error() == services.ErrReservationChanged {
+			// the car was taken or the reservation changed after it was checked
+			httphandler.WriteErrorResponse(w, http.StatusConflict, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var extension dtos.Extension
+	extension.FromDomain(de)
+	if !extension.Applied {
+		httphandler.WriteSuccessResponse(w, http.StatusConflict, extension)
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, extension)
+}
+
 func getReservationsResponse(domainReservations []domain.Reservation) (reservations dtos.Reservations) {
 	reservations.Reservations = make([]dtos.Reservation, 0)
 	for _, domainReservation := range domainReservations {
@@ -321,3 +496,34 @@ func getReservationsResponse(domainReservations []domain.Reservation) (reservati
 
 	return reservations
 }
+
+// Tells whether the error comes from a driver not meeting the requirements of the car type
+func isDriverEligibilityError(err error) bool {
+	return err.Error() == services.ErrDriverDataMissing ||
+		err.Error() == services.ErrAdditionalDriverInactive ||
+		err.Error() == services.ErrDriverLicenseExpires ||
+		strings.HasPrefix(err.Error(), services.ErrDriverTooYoung) ||
+		strings.HasPrefix(err.Error(), services.ErrDriverLicenseTooRecent)
+}
+
+func isAdditionalDriversError(err error) bool {
+	return err.Error() == services.ErrInvalidAdditionalDrivers ||
+		strings.HasPrefix(err.Error(), services.ErrTooManyAdditionalDrivers)
+}
+
+// Tells whether the error comes from the add-ons selected for the reservation
+func isAddOnsError(err error) bool {
+	return err.Error() == services.ErrAddOnNotFound ||
+		err.Error() == services.ErrRepeatedAddOn ||
+		err.Error() == services.ErrAddOnNotAtPickupBranch ||
+		strings.HasPrefix(err.Error(), services.ErrAddOnOutOfStock)
+}
+
+// Tells whether the error comes from the pickup and return branches of the reservation
+func isReservationRouteError(err error) bool {
+	return err.Error() == services.ErrBranchNotFound ||
+		err.Error() == services.ErrCarNotAtPickupBranch ||
+		err.Error() == services.ErrReturnBranchConflict ||
+		err.Error() == services.ErrCarWithoutBranch ||
+		err.Error() == services.ErrOneWayRouteNotAvailable
+}
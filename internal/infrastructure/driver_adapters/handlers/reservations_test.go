@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
@@ -100,6 +102,42 @@ func TestReservationsBook(t *testing.T) {
 				d.reservationsService.EXPECT().Book(gomock.Any(), gomock.Any()).Return(domain.Reservation{}, errors.New("user not found"))
 			},
 		},
+		{
+			name: "returns 400 status code when there is no exchange rate to the currency of the city",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Book(gomock.Any(), gomock.Any()).Return(domain.Reservation{}, fmt.Errorf("%s (%s to %s)", services.ErrExchangeRateNotFound, "USD", "EUR"))
+			},
+		},
+		{
+			name: "returns 403 status code when the email of the user is not verified",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Book(gomock.Any(), gomock.Any()).Return(domain.Reservation{}, errors.New(services.ErrUserEmailNotVerified))
+			},
+		},
+		{
+			name: "returns 403 status code when the driver is too young for the car type",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Book(gomock.Any(), gomock.Any()).Return(domain.Reservation{}, fmt.Errorf("%s (%d years)", services.ErrDriverTooYoung, 25))
+			},
+		},
 		{
 			name: "returns 500 status code when reservation service fails to book the reservation",
 			args: args{
@@ -139,6 +177,193 @@ func TestReservationsBook(t *testing.T) {
 	}
 }
 
+func TestReservationsQuote(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	reservation := dtos.Reservation{
+		UserID:              uuid.New(),
+		CarID:               uuid.New(),
+		Status:              "Reserved",
+		PaymentStatus:       "Pending",
+		StartDate:           time.Date(2030, time.July, 1, 9, 0, 0, 0, time.UTC),
+		EndDate:             time.Date(2030, time.July, 8, 9, 0, 0, 0, time.UTC),
+		AdditionalDriverIDs: []uuid.UUID{uuid.New()},
+	}
+
+	type args struct {
+		reservation dtos.Reservation
+		currency    string
+	}
+	type wants struct {
+		statusCode int
+		quote      dtos.Quote
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "returns status code 200 and the price breakdown when body is appropriate",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				quote: dtos.Quote{
+					RentalCost:           1680,
+					AdditionalDriversFee: 87.5,
+					Subtotal:             1767.5,
+					Taxes:                []dtos.ReservationTax{{Name: "Sales tax", Rate: 0.08875, Amount: 156.87}},
+					TaxTotal:             156.87,
+					Total:                1924.37,
+					Currency:             "USD",
+				},
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Quote(gomock.Any(), reservation.ToDomain(), "").
+					Return(domain.Quote{
+						RentalCost:           domain.NewMoney(1680, "USD"),
+						AdditionalDriversFee: domain.NewMoney(87.5, "USD"),
+						Subtotal:             domain.NewMoney(1767.5, "USD"),
+						Taxes:                []domain.ReservationTax{{Name: "Sales tax", Rate: 0.08875, Amount: domain.NewMoney(156.87, "USD")}},
+						TaxTotal:             domain.NewMoney(156.87, "USD"),
+						Total:                domain.NewMoney(1924.37, "USD"),
+						Currency:             "USD",
+					}, nil)
+			},
+		},
+		{
+			name: "returns status code 200 and the price breakdown displayed in the currency asked for",
+			args: args{
+				reservation: reservation,
+				currency:    "eur",
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				quote: dtos.Quote{
+					RentalCost: 100,
+					Subtotal:   100,
+					Taxes:      []dtos.ReservationTax{},
+					Total:      100,
+					Currency:   "USD",
+					Display: &dtos.DisplayQuote{
+						Rate:                 dtos.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)},
+						RentalCost:           dtos.Money{Amount: 92.15, Currency: "EUR"},
+						OneWayFee:            dtos.Money{Amount: 0, Currency: "EUR"},
+						AdditionalDriversFee: dtos.Money{Amount: 0, Currency: "EUR"},
+						AddOnsCost:           dtos.Money{Amount: 0, Currency: "EUR"},
+						ProtectionCost:       dtos.Money{Amount: 0, Currency: "EUR"},
+						Subtotal:             dtos.Money{Amount: 92.15, Currency: "EUR"},
+						TaxTotal:             dtos.Money{Amount: 0, Currency: "EUR"},
+						Total:                dtos.Money{Amount: 92.15, Currency: "EUR"},
+					},
+				},
+			},
+			setMocks: func(d *reservationsDependencies) {
+				quote := domain.Quote{RentalCost: domain.NewMoney(100, "USD"), Subtotal: domain.NewMoney(100, "USD"), Taxes: []domain.ReservationTax{}, Total: domain.NewMoney(100, "USD"), Currency: "USD"}
+				display := quote.In(domain.ExchangeRate{From: "USD", To: "EUR", Rate: 0.9215, UpdatedAt: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)})
+				quote.Display = &display
+				d.reservationsService.EXPECT().Quote(gomock.Any(), reservation.ToDomain(), "EUR").Return(quote, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the display currency is not a currency code",
+			args: args{
+				reservation: reservation,
+				currency:    "euros",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns 400 status code when there is no exchange rate to the display currency",
+			args: args{
+				reservation: reservation,
+				currency:    "JPY",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Quote(gomock.Any(), gomock.Any(), "JPY").Return(domain.Quote{}, fmt.Errorf("%s (%s to %s)", services.ErrExchangeRateNotFound, "USD", "JPY"))
+			},
+		},
+		{
+			name: "returns 400 status code when there are more additional drivers than allowed",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Quote(gomock.Any(), gomock.Any(), "").Return(domain.Quote{}, fmt.Errorf("%s (%d drivers)", services.ErrTooManyAdditionalDrivers, 3))
+			},
+		},
+		{
+			name: "returns 403 status code when an additional driver is inactive",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Quote(gomock.Any(), gomock.Any(), "").Return(domain.Quote{}, errors.New(services.ErrAdditionalDriverInactive))
+			},
+		},
+		{
+			name: "returns 500 status code when reservation service fails to quote the reservation",
+			args: args{
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Quote(gomock.Any(), gomock.Any(), "").Return(domain.Quote{}, errors.New("error quoting reservation"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsSrv := mocks.NewMockReservationsService(mockCtlr)
+			d := NewReservationsDependencies(reservationsSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.reservation)
+			URL := "/api/v1/reservations/quote"
+			if test.args.currency != "" {
+				URL += "?currency=" + test.args.currency
+			}
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			reservationsHandler := NewReservations(reservationsSrv)
+			reservationsHandler.Quote(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if test.wants.statusCode == http.StatusOK {
+				var quote dtos.Quote
+				if err := json.NewDecoder(rr.Body).Decode(&quote); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, test.wants.quote, quote)
+			}
+		})
+	}
+}
+
 func TestReservationsGet(t *testing.T) {
 	reservation := dtos.Reservation{
 		UserID:        uuid.New(),
@@ -305,6 +530,19 @@ func TestReservationsFullUpdate(t *testing.T) {
 			setMocks: func(d *reservationsDependencies) {
 			},
 		},
+		{
+			name: "returns 400 status code when there is no exchange rate to the currency of the city",
+			args: args{
+				requestID:   reservation.ID.String(),
+				reservation: reservation,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().FullUpdate(gomock.Any(), gomock.Any()).Return(fmt.Errorf("%s (%s to %s)", services.ErrExchangeRateNotFound, "USD", "EUR"))
+			},
+		},
 		{
 			name: "returns 404 status code when reservation was not found by id",
 			args: args{
@@ -901,3 +1139,199 @@ func TestReservationsListByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestReservationsExtend(t *testing.T) {
+	reservationID := uuid.New()
+	endDate := time.Date(2030, time.July, 4, 17, 0, 0, 0, time.UTC)
+	previousEndDate := time.Date(2030, time.July, 3, 15, 0, 0, 0, time.UTC)
+	extended := domain.Reservation{ID: reservationID, Status: "Reserved", EndDate: endDate}
+	next := domain.Reservation{ID: uuid.New(), Status: "Reserved", StartDate: time.Date(2030, time.July, 4, 1, 0, 0, 0, time.UTC)}
+	applied := domain.Extension{ReservationID: reservationID, PreviousEndDate: previousEndDate, EndDate: endDate, Applied: true, Cost: domain.NewMoney(280, "USD"), Reservation: &extended}
+	conflict := domain.Extension{ReservationID: reservationID, PreviousEndDate: previousEndDate, EndDate: endDate, Cost: domain.NewMoney(0, "USD"), ConflictingReservation: &next, MaximumEndDate: &next.StartDate}
+
+	type args struct {
+		requestID string
+		body      string
+	}
+	type wants struct {
+		statusCode    int
+		conflictingID uuid.UUID
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*reservationsDependencies)
+	}{
+		{
+			name: "returns status code 200 and the extended reservation when the extension was applied",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(applied, nil)
+			},
+		},
+		{
+			name: "returns status code 409 and the conflicting reservation when the car is not available for the extension",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode:    http.StatusConflict,
+				conflictingID: next.ID,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(conflict, nil)
+			},
+		},
+		{
+			name: "returns status code 409 when the car was taken while the extension was applied",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusConflict,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, errors.New(services.ErrCarNotAvailable))
+			},
+		},
+		{
+			name: "returns status code 400 when there is no exchange rate to the currency of the reservation",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, fmt.Errorf("%s (%s to %s)", services.ErrExchangeRateNotFound, "USD", "EUR"))
+			},
+		},
+		{
+			name: "returns status code 400 when the payment of the reservation was already authorized or captured",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, errors.New(services.ErrReservationAlreadyPaid))
+			},
+		},
+		{
+			name: "returns status code 400 when the end date is missing",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+		{
+			name: "returns status code 400 when the new end date is not after the current one",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, errors.New(services.ErrInvalidExtensionEndDate))
+			},
+		},
+		{
+			name: "returns status code 403 when the license of a driver expires before the new end",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, errors.New(services.ErrDriverLicenseExpires))
+			},
+		},
+		{
+			name: "returns status code 404 when the reservation was not found",
+			args: args{
+				requestID: reservationID.String(),
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *reservationsDependencies) {
+				d.reservationsService.EXPECT().Extend(gomock.Any(), reservationID, endDate).Return(domain.Extension{}, errors.New(services.ErrReservationNotFound))
+			},
+		},
+		{
+			name: "returns status code 400 when reservation id is invalid",
+			args: args{
+				requestID: "invalid",
+				body:      `{"end_date": "2030-07-04T17:00:00Z"}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *reservationsDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			reservationsSrv := mocks.NewMockReservationsService(mockCtlr)
+			d := NewReservationsDependencies(reservationsSrv)
+			test.setMocks(d)
+
+			URL := "/api/v1/reservations/" + test.args.requestID + "/extend"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBufferString(test.args.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.requestID})
+
+			rr := httptest.NewRecorder()
+
+			reservationsHandler := NewReservations(reservationsSrv)
+			reservationsHandler.Extend(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var extension dtos.Extension
+				if err := json.NewDecoder(rr.Body).Decode(&extension); err != nil {
+					t.Fatal(err)
+				}
+				assert.True(t, extension.Applied)
+				assert.Equal(t, 280.0, extension.Cost)
+				assert.NotNil(t, extension.Reservation)
+			}
+			if test.wants.conflictingID != uuid.Nil {
+				var extension dtos.Extension
+				if err := json.NewDecoder(rr.Body).Decode(&extension); err != nil {
+					t.Fatal(err)
+				}
+				assert.False(t, extension.Applied)
+				if assert.NotNil(t, extension.ConflictingReservation) && assert.NotNil(t, extension.MaximumEndDate) {
+					assert.Equal(t, test.wants.conflictingID, extension.ConflictingReservation.ID)
+					assert.True(t, next.StartDate.Equal(*extension.MaximumEndDate))
+				}
+			}
+		})
+	}
+}
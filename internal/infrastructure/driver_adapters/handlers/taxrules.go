@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type TaxRules struct {
+	TaxRulesService ports.TaxRulesService
+}
+
+func NewTaxRules(trs ports.TaxRulesService) TaxRules {
+	return TaxRules{
+		TaxRulesService: trs,
+	}
+}
+
+// @Summary Register a new tax rule
+// @Description Register a tax charged on the rentals of a city, such as a sales tax, a tourism levy or an airport surcharge.
+// @Description The rule applies to the given parts of the price (Rental, Add-ons, Protection, Fees) and is rounded to cents as its jurisdiction does.
+// @Description Rules with a branch are only charged on rentals picked up at that branch.
+// @ID register-tax-rule
+// @Accept json
+// @Produce json
+// @Param tax_rule body docs.TaxRuleRequest true "Tax rule information"
+// @Success 201 {object} docs.TaxRuleResponse "Created tax rule"
+// @Failure 400 {object} docs.ErrorTaxRuleExists "Bad Request"
+// @Failure 404 {object} docs.ErrorCityNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags TaxRules
+// @Router /tax-rules [post]
+func (trh TaxRules) Register(w http.ResponseWriter, r *http.Request) {
+	rule, err := dtos.TaxRuleFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dtr, err := trh.TaxRulesService.Register(r.Context(), rule.ToDomain())
+	if err != nil {
+		if err.Error() == services.ErrCityNotFound || err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrTaxRuleExists || err.Error() == services.ErrTaxRuleBranchNotCity {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	rule.FromDomain(dtr)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, rule)
+}
+
+// @Summary Get a tax rule
+// @Description Get a tax rule by UUID
+// @ID get-tax-rule
+// @Produce json
+// @Param id path string true "Tax rule UUID" format(uuid)
+// @Success 200 {object} docs.TaxRuleResponse "Obtained tax rule"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorTaxRuleNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags TaxRules
+// @Router /tax-rules/{id} [get]
+func (trh TaxRules) Get(w http.ResponseWriter, r *http.Request) {
+	var rule dtos.TaxRule
+
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dtr, err := trh.TaxRulesService.Get(r.Context(), ID)
+	if err != nil {
+		if err.Error() == services.ErrTaxRuleNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	rule.FromDomain(dtr)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, rule)
+}
+
+// @Summary Update a tax rule
+// @Description Update a tax rule by UUID. Reservations already booked keep the taxes they were booked with.
+// @ID update-tax-rule
+// @Accept json
+// @Produce json
+// @Param id path string true "Tax rule UUID" format(uuid)
+// @Param tax_rule body docs.TaxRuleRequest true "Tax rule information"
+// @Success 200 {object} docs.TaxRuleResponse "Updated tax rule"
+// @Failure 400 {object} docs.ErrorTaxRuleExists "Bad Request"
+// @Failure 404 {object} docs.ErrorTaxRuleNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags TaxRules
+// @Router /tax-rules/{id} [put]
+func (trh TaxRules) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	rule, err := dtos.TaxRuleFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the ID from path param
+	rule.ID = ID
+
+	if err = trh.TaxRulesService.FullUpdate(r.Context(), rule.ToDomain()); err != nil {
+		if err.Error() == services.ErrTaxRuleNotFound || err.Error() == services.ErrCityNotFound || err.Error() == services.ErrBranchNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrTaxRuleExists || err.Error() == services.ErrTaxRuleBranchNotCity {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, rule)
+}
+
+// @Summary Delete a tax rule
+// @Description Delete a tax rule by UUID. Reservations already booked keep the taxes they were booked with.
+// @ID delete-tax-rule
+// @Produce json
+// @Param id path string true "Tax rule UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorTaxRuleNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags TaxRules
+// @Router /tax-rules/{id} [delete]
+func (trh TaxRules) Delete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = trh.TaxRulesService.Delete(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrTaxRuleNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary List the tax rules of a city
+// @Description List the tax rules of a city ordered by name
+// @ID list-city-tax-rules
+// @Produce json
+// @Param id path string true "City UUID" format(uuid)
+// @Success 200 {object} docs.ListTaxRulesResponse "Tax rules of the city"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Cities
+// @Router /cities/{id}/tax-rules [get]
+func (trh TaxRules) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	cityID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dtrs, err := trh.TaxRulesService.ListByCityID(r.Context(), cityID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	rules := dtos.TaxRules{TaxRules: make([]dtos.TaxRule, 0, len(dtrs))}
+	for _, dtr := range dtrs {
+		rule := dtos.TaxRule{}
+		rule.FromDomain(dtr)
+		rules.TaxRules = append(rules.TaxRules, rule)
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, rules)
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type taxRulesDependencies struct {
+	taxRulesService *mocks.MockTaxRulesService
+}
+
+func NewTaxRulesDependencies(taxRulesSrv *mocks.MockTaxRulesService) *taxRulesDependencies {
+	return &taxRulesDependencies{
+		taxRulesService: taxRulesSrv,
+	}
+}
+
+func TestTaxRulesRegister(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	rule := dtos.TaxRule{
+		CityID:       uuid.New(),
+		Name:         "Sales tax",
+		Jurisdiction: "Illinois",
+		Rate:         0.1025,
+		AppliesTo:    []string{"Rental", "Add-ons"},
+		Rounding:     "Half Up",
+	}
+	invalidRate := rule
+	invalidRate.Rate = 10.25
+	repeatedBase := rule
+	repeatedBase.AppliesTo = []string{"Rental", "Rental"}
+	invalidRounding := rule
+	invalidRounding.Rounding = "Bankers"
+
+	type args struct {
+		body dtos.TaxRule
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*taxRulesDependencies)
+	}{
+		{
+			name: "returns status code 201 when the tax rule was registered",
+			args: args{
+				body: rule,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesService.EXPECT().Register(gomock.Any(), rule.ToDomain()).Return(domain.TaxRule{ID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the rate is not a fraction",
+			args: args{
+				body: invalidRate,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *taxRulesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when a part of the price is repeated",
+			args: args{
+				body: repeatedBase,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *taxRulesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the rounding is not valid",
+			args: args{
+				body: invalidRounding,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *taxRulesDependencies) {},
+		},
+		{
+			name: "returns 400 status code when the city already has a tax rule with the same name",
+			args: args{
+				body: rule,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesService.EXPECT().Register(gomock.Any(), rule.ToDomain()).Return(domain.TaxRule{}, errors.New(services.ErrTaxRuleExists))
+			},
+		},
+		{
+			name: "returns 404 status code when the city was not found",
+			args: args{
+				body: rule,
+			},
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesService.EXPECT().Register(gomock.Any(), rule.ToDomain()).Return(domain.TaxRule{}, errors.New(services.ErrCityNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			taxRulesSrv := mocks.NewMockTaxRulesService(mockCtlr)
+			d := NewTaxRulesDependencies(taxRulesSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.body)
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/tax-rules", bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			taxRulesHandler := NewTaxRules(taxRulesSrv)
+			taxRulesHandler.Register(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestTaxRulesListByCityID(t *testing.T) {
+	cityID := uuid.New()
+
+	type args struct {
+		cityID string
+	}
+	type wants struct {
+		statusCode int
+		rules      dtos.TaxRules
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*taxRulesDependencies)
+	}{
+		{
+			name: "returns status code 200 with the tax rules of the city",
+			args: args{
+				cityID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				rules:      dtos.TaxRules{TaxRules: []dtos.TaxRule{{CityID: cityID, Name: "Sales tax"}}},
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesService.EXPECT().ListByCityID(gomock.Any(), cityID).Return([]domain.TaxRule{{CityID: cityID, Name: "Sales tax"}}, nil)
+			},
+		},
+		{
+			name: "returns status code 200 with an empty list when the city has no tax rules",
+			args: args{
+				cityID: cityID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				rules:      dtos.TaxRules{TaxRules: []dtos.TaxRule{}},
+			},
+			setMocks: func(d *taxRulesDependencies) {
+				d.taxRulesService.EXPECT().ListByCityID(gomock.Any(), cityID).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns 400 status code when the city id is not valid",
+			args: args{
+				cityID: "chicago",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *taxRulesDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			taxRulesSrv := mocks.NewMockTaxRulesService(mockCtlr)
+			d := NewTaxRulesDependencies(taxRulesSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/cities/"+test.args.cityID+"/tax-rules", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.cityID})
+
+			rr := httptest.NewRecorder()
+
+			taxRulesHandler := NewTaxRules(taxRulesSrv)
+			taxRulesHandler.ListByCityID(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var rules dtos.TaxRules
+				if err := json.NewDecoder(rr.Body).Decode(&rules); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, test.wants.rules, rules)
+			}
+		})
+	}
+}
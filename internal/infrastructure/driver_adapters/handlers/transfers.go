@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/ports"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	"github.com/Edigiraldo/car-rent/pkg/httphandler"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type Transfers struct {
+	TransfersService ports.TransfersService
+}
+
+func NewTransfers(ts ports.TransfersService) Transfers {
+	return Transfers{
+		TransfersService: ts,
+	}
+}
+
+// @Summary Schedule a transfer
+// @Description Schedule the transfer of a car from its current city to another one. The car can not
+// @Description be rented from the departure until the transfer is completed, and it can not have
+// @Description reservations ending after the departure.
+// @ID schedule-transfer
+// @Accept json
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Param transfer body docs.TransferRequest true "Transfer information"
+// @Success 201 {object} docs.TransferResponse "Scheduled transfer"
+// @Failure 400 {object} docs.ErrorTransferOverlapsReservations "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Transfers
+// @Router /cars/{car_id}/transfers [post]
+func (th Transfers) Schedule(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	transfer, err := dtos.TransferFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get the car ID from path param
+	transfer.CarID = carID
+
+	dt, err := th.TransfersService.Schedule(r.Context(), transfer.ToDomain())
+	if err != nil {
+		if isTransferBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	transfer.FromDomain(dt)
+	httphandler.WriteSuccessResponse(w, http.StatusCreated, transfer)
+}
+
+// @Summary Get a transfer
+// @Description Get a transfer by UUID
+// @ID get-transfer
+// @Produce json
+// @Param id path string true "Transfer UUID" format(uuid)
+// @Success 200 {object} docs.TransferResponse "Obtained transfer"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 404 {object} docs.ErrorTransferNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Transfers
+// @Router /transfers/{id} [get]
+func (th Transfers) Get(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dt, err := th.TransfersService.Get(r.Context(), ID)
+	th.writeTransfer(w, dt, err)
+}
+
+// @Summary Get transfers by Car id
+// @Description Get the transfers of a car ordered by departure
+// @ID get-transfers-by-car
+// @Produce json
+// @Param car_id path string true "Car id" format(uuid)
+// @Success 200 {object} docs.Transfers "Obtained transfers"
+// @Failure 400 {object} docs.ErrorinvalidUUID "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Transfers
+// @Router /cars/{car_id}/transfers [get]
+func (th Transfers) GetByCarID(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	carID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dts, err := th.TransfersService.GetByCarID(r.Context(), carID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, getTransfersResponse(dts))
+}
+
+// @Summary Complete a transfer
+// @Description Complete a transfer once the car has departed. The car is moved to the destination city and branch.
+// @ID complete-transfer
+// @Produce json
+// @Param id path string true "Transfer UUID" format(uuid)
+// @Success 200 {object} docs.TransferResponse "Completed transfer"
+// @Failure 400 {object} docs.ErrorTransferCompleted "Bad Request"
+// @Failure 404 {object} docs.ErrorTransferNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Transfers
+// @Router /transfers/{id}/complete [post]
+func (th Transfers) Complete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	dt, err := th.TransfersService.Complete(r.Context(), ID)
+	th.writeTransfer(w, dt, err)
+}
+
+// @Summary Cancel a transfer
+// @Description Cancel a pending transfer by UUID
+// @ID cancel-transfer
+// @Produce json
+// @Param id path string true "Transfer UUID" format(uuid)
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorTransferCompleted "Bad Request"
+// @Failure 404 {object} docs.ErrorTransferNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Transfers
+// @Router /transfers/{id} [delete]
+func (th Transfers) Cancel(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ID, err := uuid.Parse(params["id"])
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err = th.TransfersService.Cancel(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrTransferNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrTransferCompleted {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// Writes the transfer or the error obtained from the service
+func (th Transfers) writeTransfer(w http.ResponseWriter, dt domain.Transfer, err error) {
+	if err != nil {
+		if err.Error() == services.ErrTransferNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if isTransferBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	var transfer dtos.Transfer
+	transfer.FromDomain(dt)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, transfer)
+}
+
+// Checks whether a transfer error is caused by the client request
+func isTransferBadRequest(err error) bool {
+	switch err.Error() {
+	case services.ErrCarNotFound,
+		services.ErrCityNotFound,
+		services.ErrBranchNotFound,
+		services.ErrInvalidTransferTimeFrame,
+		services.ErrTransferSameCity,
+		services.ErrTransferBranchNotInCity,
+		services.ErrTransferAlreadyScheduled,
+		services.ErrTransferOverlapsReservations,
+		services.ErrTransferCompleted,
+		services.ErrTransferNotDeparted:
+		return true
+	}
+
+	return false
+}
+
+func getTransfersResponse(domainTransfers []domain.Transfer) (transfers dtos.Transfers) {
+	transfers.Transfers = make([]dtos.Transfer, 0, len(domainTransfers))
+	for _, domainTransfer := range domainTransfers {
+		transfer := dtos.Transfer{}
+		transfer.FromDomain(domainTransfer)
+
+		transfers.Transfers = append(transfers.Transfers, transfer)
+	}
+
+	return transfers
+}
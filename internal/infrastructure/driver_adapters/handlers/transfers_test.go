@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
+	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
+	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type transfersDependencies struct {
+	transfersService *mocks.MockTransfersService
+}
+
+func NewTransfersDependencies(transfersSrv *mocks.MockTransfersService) *transfersDependencies {
+	return &transfersDependencies{
+		transfersService: transfersSrv,
+	}
+}
+
+func TestTransfersSchedule(t *testing.T) {
+	carID := uuid.New()
+	fromCityID := uuid.New()
+	transfer := dtos.Transfer{
+		ToCityID:      uuid.New(),
+		DepartureDate: time.Now().Add(24 * time.Hour),
+		ArrivalDate:   time.Now().Add(48 * time.Hour),
+	}
+
+	type args struct {
+		carID    string
+		transfer dtos.Transfer
+	}
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*transfersDependencies)
+	}{
+		{
+			name: "returns status code 201 when transfer was scheduled",
+			args: args{
+				carID:    carID.String(),
+				transfer: transfer,
+			},
+			wants: wants{
+				statusCode: http.StatusCreated,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Schedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, dt domain.Transfer) (domain.Transfer, error) {
+						assert.Equal(t, carID, dt.CarID)
+						dt.ID = uuid.New()
+						dt.FromCityID = fromCityID
+						return dt, nil
+					})
+			},
+		},
+		{
+			name: "returns status code 400 when car id is invalid",
+			args: args{
+				carID:    "invalid-id",
+				transfer: transfer,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *transfersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when destination city is missing",
+			args: args{
+				carID:    carID.String(),
+				transfer: dtos.Transfer{DepartureDate: transfer.DepartureDate, ArrivalDate: transfer.ArrivalDate},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *transfersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when the car has reservations after the departure",
+			args: args{
+				carID:    carID.String(),
+				transfer: transfer,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Schedule(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New(services.ErrTransferOverlapsReservations))
+			},
+		},
+		{
+			name: "returns status code 500 when transfers service fails",
+			args: args{
+				carID:    carID.String(),
+				transfer: transfer,
+			},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Schedule(gomock.Any(), gomock.Any()).Return(domain.Transfer{}, errors.New("error scheduling transfer"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			transfersSrv := mocks.NewMockTransfersService(mockCtlr)
+			d := NewTransfersDependencies(transfersSrv)
+			test.setMocks(d)
+
+			body, _ := json.Marshal(test.args.transfer)
+			URL := "/api/v1/cars/" + test.args.carID + "/transfers"
+			req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": test.args.carID})
+
+			rr := httptest.NewRecorder()
+
+			transfersHandler := NewTransfers(transfersSrv)
+			transfersHandler.Schedule(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusCreated {
+				var response dtos.Transfer
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, carID, response.CarID)
+				assert.Equal(t, fromCityID, response.FromCityID)
+				assert.Nil(t, response.CompletedAt)
+			}
+		})
+	}
+}
+
+func TestTransfersComplete(t *testing.T) {
+	ID := uuid.New()
+	completedAt := time.Now()
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		wants    wants
+		setMocks func(*transfersDependencies)
+	}{
+		{
+			name: "returns status code 200 when transfer was completed",
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Complete(gomock.Any(), ID).Return(domain.Transfer{ID: ID, CompletedAt: &completedAt}, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when transfer was already completed",
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Complete(gomock.Any(), ID).Return(domain.Transfer{}, errors.New(services.ErrTransferCompleted))
+			},
+		},
+		{
+			name: "returns status code 404 when transfer was not found",
+			wants: wants{
+				statusCode: http.StatusNotFound,
+			},
+			setMocks: func(d *transfersDependencies) {
+				d.transfersService.EXPECT().Complete(gomock.Any(), ID).Return(domain.Transfer{}, errors.New(services.ErrTransferNotFound))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			transfersSrv := mocks.NewMockTransfersService(mockCtlr)
+			d := NewTransfersDependencies(transfersSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/transfers/"+ID.String()+"/complete", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": ID.String()})
+
+			rr := httptest.NewRecorder()
+
+			transfersHandler := NewTransfers(transfersSrv)
+			transfersHandler.Complete(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
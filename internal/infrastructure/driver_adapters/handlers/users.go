@@ -96,13 +96,13 @@ func (uh Users) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Update a user
-// @Description Update a user by UUID
+// @Description Update a user by UUID. Deactivating a user lists their upcoming reservations in the response, as inactive users can not book.
 // @ID update-user
 // @Accept json
 // @Produce json
 // @Param id path string true "User UUID" format(uuid)
 // @Param user body docs.UserRequest true "User information (allowed types: Customer, Admin; allowed statuses: Active, Inactive)"
-// @Success 200 {object} docs.UserResponse "Updated user"
+// @Success 200 {object} docs.UserWithAffectedReservationsResponse "Updated user"
 // @Failure 400 {object} docs.ErrorInvalidEmail "Bad Request"
 // @Failure 404 {object} docs.ErrorUserNotFound "Not Found"
 // @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
@@ -126,7 +126,8 @@ func (uh Users) FullUpdate(w http.ResponseWriter, r *http.Request) {
 	// Get the ID from path param
 	user.ID = ID
 
-	if err = uh.UsersService.FullUpdate(r.Context(), user.ToDomain()); err != nil {
+	affectedReservations, err := uh.UsersService.FullUpdate(r.Context(), user.ToDomain())
+	if err != nil {
 		if err.Error() == services.ErrUserNotFound {
 			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 		} else if err.Error() == services.ErrEmailAlreadyRegistered {
@@ -139,7 +140,7 @@ func (uh Users) FullUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httphandler.WriteSuccessResponse(w, http.StatusOK, user)
+	httphandler.WriteSuccessResponse(w, http.StatusOK, dtos.NewUserWithAffectedReservations(user.ToDomain(), affectedReservations))
 }
 
 // @Summary Delete a user
@@ -176,3 +177,193 @@ func (uh Users) Delete(w http.ResponseWriter, r *http.Request) {
 
 	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
 }
+
+// @Summary List users
+// @Description Lists users in pages of 20 elements. from_user_id parameter
+// @Description is taken as the last seen user in a previous page.
+// @ID list-users
+// @Produce json
+// @Param from_user_id query string false "Last seen user ID" format(uuid)
+// @Param type query string false "User type (Customer, Admin)"
+// @Param status query string false "User status (Active, Inactive)"
+// @Param search query string false "Case insensitive prefix of the email, first name, last name or full name"
+// @Success 200 {object} docs.ListUsersResponse "Obtained users"
+// @Failure 400 {object} docs.ErrorInvalidUserType "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Users
+// @Router /users [get]
+func (uh Users) List(w http.ResponseWriter, r *http.Request) {
+	fromUserID := r.URL.Query().Get("from_user_id")
+	if _, err := uuid.Parse(fromUserID); err != nil && fromUserID != "" {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters, err := dtos.UserFiltersFromQuery(r.URL.Query())
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	users, err := uh.UsersService.List(r.Context(), filters, fromUserID)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	listUsersResponse := getListUsersResponse(users)
+
+	httphandler.WriteSuccessResponse(w, http.StatusOK, listUsersResponse)
+}
+
+// Gets a list of users and builds the user response
+func getListUsersResponse(users []domain.User) (listUsersResponse dtos.ListUsersResponse) {
+	listUsersResponse.Users = make([]dtos.User, 0)
+	for _, domainUser := range users {
+		user := dtos.User{}
+		user.FromDomain(domainUser)
+
+		listUsersResponse.Users = append(listUsersResponse.Users, user)
+	}
+
+	return listUsersResponse
+}
+
+// @Summary Send an email verification
+// @Description Sends a new email verification token to a user whose email is not verified yet.
+// @Description Tokens expire after EMAIL_VERIFICATION_TOKEN_HOURS and can only be used once.
+// @ID send-email-verification
+// @Produce json
+// @Param id path string true "User UUID" format(uuid)
+// @Success 202 "Accepted"
+// @Failure 400 {object} docs.ErrorEmailAlreadyVerified "Bad Request"
+// @Failure 404 {object} docs.ErrorUserNotFound "Not Found"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Users
+// @Router /users/{id}/email-verification [post]
+func (uh Users) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	ID, err := uuid.Parse(id)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, ErrInvalidID)
+		return
+	}
+
+	if err := uh.UsersService.SendEmailVerification(r.Context(), ID); err != nil {
+		if err.Error() == services.ErrUserNotFound {
+			httphandler.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		} else if err.Error() == services.ErrEmailAlreadyVerified {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusAccepted, nil)
+}
+
+// @Summary Verify an email
+// @Description Verifies the email of a user with the token sent to it
+// @ID verify-email
+// @Accept json
+// @Produce json
+// @Param verification body docs.EmailVerificationRequest true "Email verification token"
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorInvalidToken "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Users
+// @Router /users/email-verification [post]
+func (uh Users) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	emailVerification, err := dtos.EmailVerificationFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := uh.UsersService.VerifyEmail(r.Context(), emailVerification.Token); err != nil {
+		if isTokenBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// @Summary Request a password reset
+// @Description Sends a password reset token to the user with the given email. The response is the same
+// @Description whether the email is registered or not. Tokens expire after PASSWORD_RESET_TOKEN_MINUTES.
+// @ID request-password-reset
+// @Accept json
+// @Produce json
+// @Param request body docs.PasswordResetRequest true "User email"
+// @Success 202 "Accepted"
+// @Failure 400 {object} docs.ErrorInvalidEmail "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Users
+// @Router /users/password-reset [post]
+func (uh Users) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	passwordResetRequest, err := dtos.PasswordResetRequestFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := uh.UsersService.RequestPasswordReset(r.Context(), passwordResetRequest.Email); err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+		log.Println(err)
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusAccepted, nil)
+}
+
+// @Summary Reset a password
+// @Description Sets a new password with the token sent by email
+// @ID reset-password
+// @Accept json
+// @Produce json
+// @Param reset body docs.PasswordResetConfirmationRequest true "Password reset token and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} docs.ErrorInvalidToken "Bad Request"
+// @Failure 500 {object} docs.ErrorInternalServer "Internal Server Error"
+// @Tags Users
+// @Router /users/password-reset/confirm [post]
+func (uh Users) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	passwordReset, err := dtos.PasswordResetFromBody(r.Body)
+	if err != nil {
+		httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := uh.UsersService.ResetPassword(r.Context(), passwordReset.Token, passwordReset.Password); err != nil {
+		if isTokenBadRequest(err) {
+			httphandler.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else {
+			httphandler.WriteErrorResponse(w, http.StatusInternalServerError, ErrInternalServerError)
+			log.Println(err)
+		}
+
+		return
+	}
+
+	httphandler.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// Whether the error is caused by a token that can not be redeemed.
+// Users removed after the token was sent are reported the same way.
+func isTokenBadRequest(err error) bool {
+	return err.Error() == services.ErrInvalidToken ||
+		err.Error() == services.ErrUserNotFound
+}
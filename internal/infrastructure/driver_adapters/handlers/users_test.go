@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/Edigiraldo/car-rent/internal/core/domain"
+	"github.com/Edigiraldo/car-rent/internal/core/services"
 	"github.com/Edigiraldo/car-rent/internal/infrastructure/driver_adapters/dtos"
 	mocks "github.com/Edigiraldo/car-rent/internal/pkg/mocks"
 	"github.com/golang/mock/gomock"
@@ -268,7 +269,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				statusCode: http.StatusOK,
 			},
 			setMocks: func(d *usersDependencies) {
-				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(nil)
+				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(nil, nil)
 			},
 		},
 		{
@@ -306,7 +307,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				statusCode: http.StatusNotFound,
 			},
 			setMocks: func(d *usersDependencies) {
-				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(errors.New("user not found"))
+				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(nil, errors.New("user not found"))
 			},
 		},
 		{
@@ -319,7 +320,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				statusCode: http.StatusBadRequest,
 			},
 			setMocks: func(d *usersDependencies) {
-				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(errors.New("email already registered"))
+				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(nil, errors.New("email already registered"))
 			},
 		},
 		{
@@ -332,7 +333,7 @@ func TestUsersFullUpdate(t *testing.T) {
 				statusCode: http.StatusInternalServerError,
 			},
 			setMocks: func(d *usersDependencies) {
-				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(errors.New("error registering user"))
+				d.usersService.EXPECT().FullUpdate(gomock.Any(), user.ToDomain()).Return(nil, errors.New("error registering user"))
 			},
 		},
 	}
@@ -465,3 +466,327 @@ func TestUsersDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersList(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	foundUsers := []domain.User{
+		{
+			ID:        uuid.New(),
+			FirstName: "Isaac",
+			LastName:  "Newton",
+			Email:     "isaac.newton@cam.ac.uk",
+			Type:      "Customer",
+			Status:    "Active",
+		},
+	}
+
+	type args struct {
+		fromUserID string
+		filters    map[string]string
+	}
+	type wants struct {
+		statusCode int
+		users      []domain.User
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name: "returns status code 200 with the users matching the filters",
+			args: args{
+				fromUserID: "",
+				filters:    map[string]string{"type": "Customer", "status": "Active", "search": " isaac "},
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				users:      foundUsers,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().List(gomock.Any(), domain.UserFilters{Type: "Customer", Status: "Active", Search: "isaac"}, "").Return(foundUsers, nil)
+			},
+		},
+		{
+			name: "returns status code 200 with an empty list when no users were found",
+			args: args{
+				fromUserID: foundUsers[0].ID.String(),
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				users:      []domain.User{},
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().List(gomock.Any(), domain.UserFilters{}, foundUsers[0].ID.String()).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns status code 400 when from_user_id is invalid",
+			args: args{
+				fromUserID: "invalid-id",
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when type is invalid",
+			args: args{
+				filters: map[string]string{"type": "Driver"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when status is invalid",
+			args: args{
+				filters: map[string]string{"status": "Blocked"},
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name: "returns status code 500 when users service fails",
+			args: args{},
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().List(gomock.Any(), domain.UserFilters{}, "").Return(nil, errors.New("error listing users"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersSrv := mocks.NewMockUsersService(mockCtlr)
+			d := NewUsersDependencies(usersSrv)
+			test.setMocks(d)
+
+			values := url.Values{}
+			if test.args.fromUserID != "" {
+				values.Set("from_user_id", test.args.fromUserID)
+			}
+			for key, value := range test.args.filters {
+				values.Set(key, value)
+			}
+			req, err := http.NewRequest(http.MethodGet, "/api/v1/users?"+values.Encode(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			usersHandler := NewUsers(usersSrv)
+			usersHandler.List(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+			if rr.Code == http.StatusOK {
+				var response dtos.ListUsersResponse
+				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, len(test.wants.users), len(response.Users))
+				for i, user := range response.Users {
+					assert.Equal(t, test.wants.users[i], user.ToDomain())
+				}
+			}
+		})
+	}
+}
+
+func TestUsersVerifyEmail(t *testing.T) {
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		body     string
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name: "returns status code 204 when email was verified",
+			body: `{"token": "valid-token"}`,
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().VerifyEmail(gomock.Any(), "valid-token").Return(nil)
+			},
+		},
+		{
+			name: "returns status code 400 when token is missing",
+			body: `{}`,
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when token is invalid",
+			body: `{"token": "used-token"}`,
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().VerifyEmail(gomock.Any(), "used-token").Return(errors.New(services.ErrInvalidToken))
+			},
+		},
+		{
+			name: "returns status code 500 when users service fails",
+			body: `{"token": "valid-token"}`,
+			wants: wants{
+				statusCode: http.StatusInternalServerError,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().VerifyEmail(gomock.Any(), "valid-token").Return(errors.New("connection refused"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersSrv := mocks.NewMockUsersService(mockCtlr)
+			d := NewUsersDependencies(usersSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/users/email-verification", bytes.NewBufferString(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			usersHandler := NewUsers(usersSrv)
+			usersHandler.VerifyEmail(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestUsersRequestPasswordReset(t *testing.T) {
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		body     string
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name: "returns status code 202 when the request was accepted",
+			body: `{"email": "richard.feynman@caltech.edu.us"}`,
+			wants: wants{
+				statusCode: http.StatusAccepted,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().RequestPasswordReset(gomock.Any(), "richard.feynman@caltech.edu.us").Return(nil)
+			},
+		},
+		{
+			name: "returns status code 400 when email is invalid",
+			body: `{"email": "richard.feynman"}`,
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersSrv := mocks.NewMockUsersService(mockCtlr)
+			d := NewUsersDependencies(usersSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/users/password-reset", bytes.NewBufferString(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			usersHandler := NewUsers(usersSrv)
+			usersHandler.RequestPasswordReset(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
+
+func TestUsersResetPassword(t *testing.T) {
+	initConstantsFromHandlers(t)
+
+	type wants struct {
+		statusCode int
+	}
+	tests := []struct {
+		name     string
+		body     string
+		wants    wants
+		setMocks func(*usersDependencies)
+	}{
+		{
+			name: "returns status code 204 when password was reset",
+			body: `{"token": "valid-token", "password": "correct horse battery staple"}`,
+			wants: wants{
+				statusCode: http.StatusNoContent,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().ResetPassword(gomock.Any(), "valid-token", "correct horse battery staple").Return(nil)
+			},
+		},
+		{
+			name: "returns status code 400 when password is too short",
+			body: `{"token": "valid-token", "password": "short"}`,
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {},
+		},
+		{
+			name: "returns status code 400 when token has expired",
+			body: `{"token": "expired-token", "password": "correct horse battery staple"}`,
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+			setMocks: func(d *usersDependencies) {
+				d.usersService.EXPECT().ResetPassword(gomock.Any(), "expired-token", gomock.Any()).Return(errors.New(services.ErrInvalidToken))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtlr := gomock.NewController(t)
+			usersSrv := mocks.NewMockUsersService(mockCtlr)
+			d := NewUsersDependencies(usersSrv)
+			test.setMocks(d)
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v1/users/password-reset/confirm", bytes.NewBufferString(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+
+			usersHandler := NewUsers(usersSrv)
+			usersHandler.ResetPassword(rr, req)
+
+			assert.Equal(t, test.wants.statusCode, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package constants
+
+type ADD_ON_PRICING_UNITS struct {
+	PER_DAY    string `mapstructure:"PER DAY" json:"PER DAY"`
+	PER_RENTAL string `mapstructure:"PER RENTAL" json:"PER RENTAL"`
+}
+
+// Get the values in add-on pricing units
+func (aopu ADD_ON_PRICING_UNITS) Values() []string {
+	return stringValues(aopu)
+}
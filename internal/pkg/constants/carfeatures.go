@@ -0,0 +1,13 @@
+package constants
+
+type CAR_FEATURES struct {
+	AIR_CONDITIONING string `mapstructure:"AIR CONDITIONING" json:"AIR CONDITIONING"`
+	GPS              string `mapstructure:"GPS" json:"GPS"`
+	CHILD_SEAT_READY string `mapstructure:"CHILD SEAT READY" json:"CHILD SEAT READY"`
+	BLUETOOTH        string `mapstructure:"BLUETOOTH" json:"BLUETOOTH"`
+}
+
+// Get the values in car features
+func (cf CAR_FEATURES) Values() []string {
+	return stringValues(cf)
+}
@@ -1,27 +1,15 @@
 package constants
 
-import "reflect"
-
-var carTypes []string
-
-// Initializes carTypes slice with values of CAR_TYPES struct
-func initializeCarTypesValues() {
-	valueOf := reflect.ValueOf(Values.CAR_TYPES)
-	for i := 0; i < valueOf.NumField(); i++ {
-		field := valueOf.Field(i)
-		carTypes = append(carTypes, field.String())
-	}
-
-}
-
+// Car types are declared as a type in the database, so they are fixed at
+// deploy time: adding one takes a migration and a new field here.
 type CAR_TYPES struct {
-	SEDAN      string `mapstructure:"SEDAN"`
-	LUXURY     string `mapstructure:"LUXURY"`
-	SPORTS_CAR string `mapstructure:"SPORTS CAR"`
-	LIMOUSINE  string `mapstructure:"LIMOUSINE"`
+	SEDAN      string `mapstructure:"SEDAN" json:"SEDAN"`
+	LUXURY     string `mapstructure:"LUXURY" json:"LUXURY"`
+	SPORTS_CAR string `mapstructure:"SPORTS CAR" json:"SPORTS CAR"`
+	LIMOUSINE  string `mapstructure:"LIMOUSINE" json:"LIMOUSINE"`
 }
 
 // Get the values in car types
-func (ct *CAR_TYPES) Values() []string {
-	return carTypes
+func (ct CAR_TYPES) Values() []string {
+	return stringValues(ct)
 }
@@ -1,47 +1,134 @@
 package constants
 
 import (
+	"errors"
+	"fmt"
+	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/Edigiraldo/car-rent/pkg/utils"
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 )
 
-var Values ConstantValues
+const (
+	constantsFile = "constants.json"
+	// time to wait for a burst of file system events to settle before reloading
+	reloadDelay = 100 * time.Millisecond
+)
+
+var (
+	active   atomic.Pointer[Snapshot]
+	reloadMu sync.Mutex
+	// ISO 4217 currency codes
+	currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+	// enumerations that are also declared as types in the database
+	databaseEnums = []string{
+		"CAR_TYPES", "CAR_STATUSES", "TRANSMISSIONS", "FUEL_TYPES", "USER_TYPES",
+		"USER_STATUSES", "RESERVATION_STATUSES", "PAYMENT_STATUSES", "INSPECTION_TYPES",
+		"DAMAGE_REPORT_STATUSES", "USER_TOKEN_PURPOSES", "ADD_ON_PRICING_UNITS",
+		"PROTECTION_LEVELS", "DEPOSIT_STATUSES", "LEDGER_ACCOUNTS", "LEDGER_MOVEMENTS",
+		"TAX_BASES", "TAX_ROUNDINGS",
+	}
+)
 
 type ConstantValues struct {
-	CARS_PER_PAGE             uint16               `mapstructure:"CARS_PER_PAGE"`
-	RESERVATIONS_PER_PAGE     uint16               `mapstructure:"RESERVATIONS_PER_PAGE"`
-	MINIMUM_RESERVATION_HOURS uint16               `mapstructure:"MINIMUM_RESERVATION_HOURS"`
-	NULL_UUID                 string               `mapstructure:"NULL_UUID"`
-	DATETIME_LAYOUT           string               `mapstructure:"DATETIME_LAYOUT"`
-	CAR_TYPES                 CAR_TYPES            `mapstructure:"CAR_TYPES"`
-	CAR_STATUSES              CAR_STATUSES         `mapstructure:"CAR_STATUSES"`
-	USER_TYPES                USER_TYPES           `mapstructure:"USER_TYPES"`
-	USER_STATUSES             USER_STATUSES        `mapstructure:"USER_STATUSES"`
-	RESERVATION_STATUSES      RESERVATION_STATUSES `mapstructure:"RESERVATION_STATUSES"`
-	PAYMENT_STATUSES          PAYMENT_STATUSES     `mapstructure:"PAYMENT_STATUSES"`
+	CARS_PER_PAGE                  uint16                 `mapstructure:"CARS_PER_PAGE" json:"CARS_PER_PAGE"`
+	RESERVATIONS_PER_PAGE          uint16                 `mapstructure:"RESERVATIONS_PER_PAGE" json:"RESERVATIONS_PER_PAGE"`
+	MAINTENANCES_PER_PAGE          uint16                 `mapstructure:"MAINTENANCES_PER_PAGE" json:"MAINTENANCES_PER_PAGE"`
+	CITIES_PER_PAGE                uint16                 `mapstructure:"CITIES_PER_PAGE" json:"CITIES_PER_PAGE"`
+	USERS_PER_PAGE                 uint16                 `mapstructure:"USERS_PER_PAGE" json:"USERS_PER_PAGE"`
+	MINIMUM_RESERVATION_HOURS      uint16                 `mapstructure:"MINIMUM_RESERVATION_HOURS" json:"MINIMUM_RESERVATION_HOURS"`
+	MAINTENANCE_INTERVAL_KM        uint32                 `mapstructure:"MAINTENANCE_INTERVAL_KM" json:"MAINTENANCE_INTERVAL_KM"`
+	MAXIMUM_PHOTO_BYTES            uint32                 `mapstructure:"MAXIMUM_PHOTO_BYTES" json:"MAXIMUM_PHOTO_BYTES"`
+	MAXIMUM_PHOTO_PIXELS           uint32                 `mapstructure:"MAXIMUM_PHOTO_PIXELS" json:"MAXIMUM_PHOTO_PIXELS"`
+	THUMBNAIL_SIZE                 uint16                 `mapstructure:"THUMBNAIL_SIZE" json:"THUMBNAIL_SIZE"`
+	MAXIMUM_SEARCH_RADIUS_KM       uint16                 `mapstructure:"MAXIMUM_SEARCH_RADIUS_KM" json:"MAXIMUM_SEARCH_RADIUS_KM"`
+	EMAIL_VERIFICATION_TOKEN_HOURS uint16                 `mapstructure:"EMAIL_VERIFICATION_TOKEN_HOURS" json:"EMAIL_VERIFICATION_TOKEN_HOURS"`
+	PASSWORD_RESET_TOKEN_MINUTES   uint16                 `mapstructure:"PASSWORD_RESET_TOKEN_MINUTES" json:"PASSWORD_RESET_TOKEN_MINUTES"`
+	MINIMUM_PASSWORD_LENGTH        uint16                 `mapstructure:"MINIMUM_PASSWORD_LENGTH" json:"MINIMUM_PASSWORD_LENGTH"`
+	MAXIMUM_ADDITIONAL_DRIVERS     uint16                 `mapstructure:"MAXIMUM_ADDITIONAL_DRIVERS" json:"MAXIMUM_ADDITIONAL_DRIVERS"`
+	ADDITIONAL_DRIVER_DAILY_FEE    float64                `mapstructure:"ADDITIONAL_DRIVER_DAILY_FEE" json:"ADDITIONAL_DRIVER_DAILY_FEE"`
+	FEES_CURRENCY                  string                 `mapstructure:"FEES_CURRENCY" json:"FEES_CURRENCY"`
+	DEFAULT_PROTECTION_LEVEL       string                 `mapstructure:"DEFAULT_PROTECTION_LEVEL" json:"DEFAULT_PROTECTION_LEVEL"`
+	LATE_RETURN_GRACE_MINUTES      uint16                 `mapstructure:"LATE_RETURN_GRACE_MINUTES" json:"LATE_RETURN_GRACE_MINUTES"`
+	EARLY_PICKUP_MINUTES           uint16                 `mapstructure:"EARLY_PICKUP_MINUTES" json:"EARLY_PICKUP_MINUTES"`
+	INSPECTION_SKEW_MINUTES        uint16                 `mapstructure:"INSPECTION_SKEW_MINUTES" json:"INSPECTION_SKEW_MINUTES"`
+	MINIMUM_DRIVER_AGE             uint16                 `mapstructure:"MINIMUM_DRIVER_AGE" json:"MINIMUM_DRIVER_AGE"`
+	NULL_UUID                      string                 `mapstructure:"NULL_UUID" json:"NULL_UUID"`
+	DATETIME_LAYOUT                string                 `mapstructure:"DATETIME_LAYOUT" json:"DATETIME_LAYOUT"`
+	CAR_TYPES                      CAR_TYPES              `mapstructure:"CAR_TYPES" json:"CAR_TYPES"`
+	CAR_STATUSES                   CAR_STATUSES           `mapstructure:"CAR_STATUSES" json:"CAR_STATUSES"`
+	TRANSMISSIONS                  TRANSMISSIONS          `mapstructure:"TRANSMISSIONS" json:"TRANSMISSIONS"`
+	FUEL_TYPES                     FUEL_TYPES             `mapstructure:"FUEL_TYPES" json:"FUEL_TYPES"`
+	CAR_FEATURES                   CAR_FEATURES           `mapstructure:"CAR_FEATURES" json:"CAR_FEATURES"`
+	USER_TYPES                     USER_TYPES             `mapstructure:"USER_TYPES" json:"USER_TYPES"`
+	USER_STATUSES                  USER_STATUSES          `mapstructure:"USER_STATUSES" json:"USER_STATUSES"`
+	RESERVATION_STATUSES           RESERVATION_STATUSES   `mapstructure:"RESERVATION_STATUSES" json:"RESERVATION_STATUSES"`
+	PAYMENT_STATUSES               PAYMENT_STATUSES       `mapstructure:"PAYMENT_STATUSES" json:"PAYMENT_STATUSES"`
+	INSPECTION_TYPES               INSPECTION_TYPES       `mapstructure:"INSPECTION_TYPES" json:"INSPECTION_TYPES"`
+	DAMAGE_REPORT_STATUSES         DAMAGE_REPORT_STATUSES `mapstructure:"DAMAGE_REPORT_STATUSES" json:"DAMAGE_REPORT_STATUSES"`
+	USER_TOKEN_PURPOSES            USER_TOKEN_PURPOSES    `mapstructure:"USER_TOKEN_PURPOSES" json:"USER_TOKEN_PURPOSES"`
+	ADD_ON_PRICING_UNITS           ADD_ON_PRICING_UNITS   `mapstructure:"ADD_ON_PRICING_UNITS" json:"ADD_ON_PRICING_UNITS"`
+	PROTECTION_LEVELS              PROTECTION_LEVELS      `mapstructure:"PROTECTION_LEVELS" json:"PROTECTION_LEVELS"`
+	DEPOSIT_STATUSES               DEPOSIT_STATUSES       `mapstructure:"DEPOSIT_STATUSES" json:"DEPOSIT_STATUSES"`
+	LEDGER_ACCOUNTS                LEDGER_ACCOUNTS        `mapstructure:"LEDGER_ACCOUNTS" json:"LEDGER_ACCOUNTS"`
+	LEDGER_MOVEMENTS               LEDGER_MOVEMENTS       `mapstructure:"LEDGER_MOVEMENTS" json:"LEDGER_MOVEMENTS"`
+	TAX_BASES                      TAX_BASES              `mapstructure:"TAX_BASES" json:"TAX_BASES"`
+	TAX_ROUNDINGS                  TAX_ROUNDINGS          `mapstructure:"TAX_ROUNDINGS" json:"TAX_ROUNDINGS"`
+	DRIVER_REQUIREMENTS            []DRIVER_REQUIREMENT   `mapstructure:"DRIVER_REQUIREMENTS" json:"DRIVER_REQUIREMENTS"`
+	SECURITY_DEPOSITS              []SECURITY_DEPOSIT     `mapstructure:"SECURITY_DEPOSITS" json:"SECURITY_DEPOSITS"`
+	LATE_FEES                      []LATE_FEE             `mapstructure:"LATE_FEES" json:"LATE_FEES"`
 }
 
-func InitValues() error {
-	viper.SetConfigName("constants")
-	viper.SetConfigType("json")
-	viper.AddConfigPath("./")
+// Snapshot is an immutable set of validated constant values.
+// Version is increased every time a new set of values is activated.
+type Snapshot struct {
+	Values   ConstantValues
+	Version  uint64
+	LoadedAt time.Time
+	file     string
+}
 
-	if err := viper.ReadInConfig(); err != nil {
-		return err
+// Values returns the currently active constant values
+func Values() ConstantValues {
+	return Current().Values
+}
+
+// Current returns the currently active snapshot of constant values
+func Current() Snapshot {
+	snapshot := active.Load()
+	if snapshot == nil {
+		return Snapshot{}
 	}
 
-	if err := viper.Unmarshal(&Values); err != nil {
+	return *snapshot
+}
+
+// Loads constants file located in the current working directory.
+// Once a file has been loaded, next calls load that same file again.
+func InitValues() error {
+	file := Current().file
+	if file == "" {
+		var err error
+		if file, err = filepath.Abs(constantsFile); err != nil {
+			return err
+		}
+	}
+
+	values, err := load(file)
+	if err != nil {
 		return err
 	}
 
-	// initializes lists of values of structs to have iterable objects
-	initializeCarTypesValues()
-	initializeCarStatusesValues()
-	initializeUserTypesValues()
-	initializeUserStatusesValues()
-	initializeReservationStatusesValues()
-	initializePaymentStatusesValues()
+	activate(values, file)
 
 	return nil
 }
@@ -58,3 +145,401 @@ func InitValuesFrom(PathToRoot string) error {
 
 	return nil
 }
+
+// Watch reloads the constants file every time it changes on disk.
+// Values that can not be read or do not pass validation are logged and
+// discarded, so the previously active ones keep being used.
+// The returned function stops watching the file. No reload happens once it
+// has returned.
+func Watch() (stop func() error, err error) {
+	file := Current().file
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// the whole directory is watched to also get the file replaced by editors
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	var (
+		timerMu sync.Mutex
+		timer   *time.Timer
+		stopped bool
+	)
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != file || !(event.Has(fsnotify.Write) || event.Has(fsnotify.Create)) {
+					continue
+				}
+
+				timerMu.Lock()
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(reloadDelay, func() {
+					timerMu.Lock()
+					defer timerMu.Unlock()
+					if !stopped {
+						Reload()
+					}
+				})
+				timerMu.Unlock()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Println("error while watching constants file: ", err)
+			}
+		}
+	}()
+
+	stop = func() error {
+		timerMu.Lock()
+		stopped = true
+		if timer != nil {
+			timer.Stop()
+		}
+		timerMu.Unlock()
+
+		return watcher.Close()
+	}
+
+	return stop, nil
+}
+
+// Reload reads again the constants file of the active snapshot and activates
+// its values if they are valid. Values of enumerations declared in the database
+// can not change without a migration, so reloads changing them are rejected.
+// Rejected values are logged and returned as error.
+func Reload() error {
+	current := Current()
+	file := current.file
+
+	values, err := load(file)
+	if err == nil {
+		err = values.checkDatabaseEnums(current.Values)
+	}
+	if err != nil {
+		log.Printf("constants reload rejected, keeping version %d: %s\n", current.Version, err)
+		return err
+	}
+
+	snapshot := activate(values, file)
+	log.Printf("constants reloaded, version %d is now active\n", snapshot.Version)
+
+	return nil
+}
+
+// Validates the set of values, returning the first problem found
+func (cv ConstantValues) Validate() error {
+	if cv.CARS_PER_PAGE == 0 {
+		return errors.New("CARS_PER_PAGE must be greater than 0")
+	}
+
+	if cv.RESERVATIONS_PER_PAGE == 0 {
+		return errors.New("RESERVATIONS_PER_PAGE must be greater than 0")
+	}
+
+	if cv.MAINTENANCES_PER_PAGE == 0 {
+		return errors.New("MAINTENANCES_PER_PAGE must be greater than 0")
+	}
+
+	if cv.CITIES_PER_PAGE == 0 {
+		return errors.New("CITIES_PER_PAGE must be greater than 0")
+	}
+
+	if cv.USERS_PER_PAGE == 0 {
+		return errors.New("USERS_PER_PAGE must be greater than 0")
+	}
+
+	if cv.MINIMUM_RESERVATION_HOURS == 0 {
+		return errors.New("MINIMUM_RESERVATION_HOURS must be greater than 0")
+	}
+
+	if cv.MAINTENANCE_INTERVAL_KM == 0 {
+		return errors.New("MAINTENANCE_INTERVAL_KM must be greater than 0")
+	}
+
+	if cv.MAXIMUM_PHOTO_BYTES == 0 {
+		return errors.New("MAXIMUM_PHOTO_BYTES must be greater than 0")
+	}
+
+	if cv.MAXIMUM_PHOTO_PIXELS == 0 {
+		return errors.New("MAXIMUM_PHOTO_PIXELS must be greater than 0")
+	}
+
+	if cv.THUMBNAIL_SIZE == 0 {
+		return errors.New("THUMBNAIL_SIZE must be greater than 0")
+	}
+
+	if cv.MAXIMUM_SEARCH_RADIUS_KM == 0 {
+		return errors.New("MAXIMUM_SEARCH_RADIUS_KM must be greater than 0")
+	}
+
+	if cv.EMAIL_VERIFICATION_TOKEN_HOURS == 0 {
+		return errors.New("EMAIL_VERIFICATION_TOKEN_HOURS must be greater than 0")
+	}
+
+	if cv.PASSWORD_RESET_TOKEN_MINUTES == 0 {
+		return errors.New("PASSWORD_RESET_TOKEN_MINUTES must be greater than 0")
+	}
+
+	if cv.MINIMUM_PASSWORD_LENGTH == 0 {
+		return errors.New("MINIMUM_PASSWORD_LENGTH must be greater than 0")
+	}
+
+	if cv.MAXIMUM_ADDITIONAL_DRIVERS == 0 {
+		return errors.New("MAXIMUM_ADDITIONAL_DRIVERS must be greater than 0")
+	}
+
+	if cv.ADDITIONAL_DRIVER_DAILY_FEE < 0 {
+		return errors.New("ADDITIONAL_DRIVER_DAILY_FEE cannot be negative")
+	}
+
+	if !currencyRegexp.MatchString(cv.FEES_CURRENCY) {
+		return errors.New("FEES_CURRENCY must be an ISO 4217 code")
+	}
+
+	if cv.MINIMUM_DRIVER_AGE == 0 {
+		return errors.New("MINIMUM_DRIVER_AGE must be greater than 0")
+	}
+
+	if _, err := uuid.Parse(cv.NULL_UUID); err != nil {
+		return fmt.Errorf("NULL_UUID: %s", err)
+	}
+
+	if cv.DATETIME_LAYOUT == "" {
+		return errors.New("DATETIME_LAYOUT cannot be empty")
+	}
+	if _, err := time.Parse(cv.DATETIME_LAYOUT, time.Now().Format(cv.DATETIME_LAYOUT)); err != nil {
+		return fmt.Errorf("DATETIME_LAYOUT: %s", err)
+	}
+
+	enums := cv.enums()
+	for name, values := range enums {
+		if err := validateEnum(values); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+	}
+
+	if !utils.IsInSlice(cv.PROTECTION_LEVELS.Values(), cv.DEFAULT_PROTECTION_LEVEL) {
+		return errors.New("DEFAULT_PROTECTION_LEVEL must be one of PROTECTION_LEVELS")
+	}
+
+	if err := cv.validateDriverRequirements(); err != nil {
+		return fmt.Errorf("DRIVER_REQUIREMENTS: %s", err)
+	}
+
+	if err := cv.validateSecurityDeposits(); err != nil {
+		return fmt.Errorf("SECURITY_DEPOSITS: %s", err)
+	}
+
+	if err := cv.validateLateFees(); err != nil {
+		return fmt.Errorf("LATE_FEES: %s", err)
+	}
+
+	return nil
+}
+
+// Driver requirements must refer to known car types, at most once each, and
+// can not ask for an age below the minimum driver age
+func (cv ConstantValues) validateDriverRequirements() error {
+	carTypes := make(map[string]bool)
+	for _, carType := range cv.CAR_TYPES.Values() {
+		carTypes[carType] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, requirement := range cv.DRIVER_REQUIREMENTS {
+		if !carTypes[requirement.CAR_TYPE] {
+			return fmt.Errorf("car type %q is unknown", requirement.CAR_TYPE)
+		}
+		if seen[requirement.CAR_TYPE] {
+			return fmt.Errorf("car type %q is repeated", requirement.CAR_TYPE)
+		}
+		seen[requirement.CAR_TYPE] = true
+
+		if requirement.MINIMUM_AGE < cv.MINIMUM_DRIVER_AGE {
+			return fmt.Errorf("minimum age of %q is lower than MINIMUM_DRIVER_AGE", requirement.CAR_TYPE)
+		}
+	}
+
+	return nil
+}
+
+// Security deposits must refer to known car types, at most once each, and
+// hold a positive amount
+func (cv ConstantValues) validateSecurityDeposits() error {
+	carTypes := make(map[string]bool)
+	for _, carType := range cv.CAR_TYPES.Values() {
+		carTypes[carType] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, deposit := range cv.SECURITY_DEPOSITS {
+		if !carTypes[deposit.CAR_TYPE] {
+			return fmt.Errorf("car type %q is unknown", deposit.CAR_TYPE)
+		}
+		if seen[deposit.CAR_TYPE] {
+			return fmt.Errorf("car type %q is repeated", deposit.CAR_TYPE)
+		}
+		seen[deposit.CAR_TYPE] = true
+
+		if deposit.AMOUNT <= 0 {
+			return fmt.Errorf("amount of %q must be positive", deposit.CAR_TYPE)
+		}
+	}
+
+	return nil
+}
+
+// Late fees must refer to known car types, at most once each, and charge a
+// positive amount per hour
+func (cv ConstantValues) validateLateFees() error {
+	carTypes := make(map[string]bool)
+	for _, carType := range cv.CAR_TYPES.Values() {
+		carTypes[carType] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, lateFee := range cv.LATE_FEES {
+		if !carTypes[lateFee.CAR_TYPE] {
+			return fmt.Errorf("car type %q is unknown", lateFee.CAR_TYPE)
+		}
+		if seen[lateFee.CAR_TYPE] {
+			return fmt.Errorf("car type %q is repeated", lateFee.CAR_TYPE)
+		}
+		seen[lateFee.CAR_TYPE] = true
+
+		if lateFee.HOURLY_FEE <= 0 {
+			return fmt.Errorf("hourly fee of %q must be positive", lateFee.CAR_TYPE)
+		}
+	}
+
+	return nil
+}
+
+// Gets the values of every enumeration by its name
+func (cv ConstantValues) enums() map[string][]string {
+	return map[string][]string{
+		"CAR_TYPES":              cv.CAR_TYPES.Values(),
+		"CAR_STATUSES":           cv.CAR_STATUSES.Values(),
+		"TRANSMISSIONS":          cv.TRANSMISSIONS.Values(),
+		"FUEL_TYPES":             cv.FUEL_TYPES.Values(),
+		"CAR_FEATURES":           cv.CAR_FEATURES.Values(),
+		"USER_TYPES":             cv.USER_TYPES.Values(),
+		"USER_STATUSES":          cv.USER_STATUSES.Values(),
+		"RESERVATION_STATUSES":   cv.RESERVATION_STATUSES.Values(),
+		"PAYMENT_STATUSES":       cv.PAYMENT_STATUSES.Values(),
+		"INSPECTION_TYPES":       cv.INSPECTION_TYPES.Values(),
+		"DAMAGE_REPORT_STATUSES": cv.DAMAGE_REPORT_STATUSES.Values(),
+		"USER_TOKEN_PURPOSES":    cv.USER_TOKEN_PURPOSES.Values(),
+		"ADD_ON_PRICING_UNITS":   cv.ADD_ON_PRICING_UNITS.Values(),
+		"PROTECTION_LEVELS":      cv.PROTECTION_LEVELS.Values(),
+		"DEPOSIT_STATUSES":       cv.DEPOSIT_STATUSES.Values(),
+		"LEDGER_ACCOUNTS":        cv.LEDGER_ACCOUNTS.Values(),
+		"LEDGER_MOVEMENTS":       cv.LEDGER_MOVEMENTS.Values(),
+		"TAX_BASES":              cv.TAX_BASES.Values(),
+		"TAX_ROUNDINGS":          cv.TAX_ROUNDINGS.Values(),
+	}
+}
+
+// Checks the enumerations declared as types in the database keep the values
+// of the previous set
+func (cv ConstantValues) checkDatabaseEnums(previous ConstantValues) error {
+	enums, previousEnums := cv.enums(), previous.enums()
+	for _, name := range databaseEnums {
+		if !reflect.DeepEqual(enums[name], previousEnums[name]) {
+			return fmt.Errorf("%s: values are declared in the database and can not change without a migration", name)
+		}
+	}
+
+	return nil
+}
+
+// Reads and validates the values in the given file
+func load(file string) (ConstantValues, error) {
+	var values ConstantValues
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	v.SetConfigType("json")
+
+	if err := v.ReadInConfig(); err != nil {
+		return ConstantValues{}, err
+	}
+
+	if err := v.Unmarshal(&values); err != nil {
+		return ConstantValues{}, err
+	}
+
+	if err := values.Validate(); err != nil {
+		return ConstantValues{}, err
+	}
+
+	return values, nil
+}
+
+// Atomically replaces the active snapshot by a new one with the given values
+func activate(values ConstantValues, file string) Snapshot {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	var version uint64 = 1
+	if previous := active.Load(); previous != nil {
+		version = previous.Version + 1
+	}
+
+	snapshot := &Snapshot{
+		Values:   values,
+		Version:  version,
+		LoadedAt: time.Now(),
+		file:     file,
+	}
+	active.Store(snapshot)
+
+	return *snapshot
+}
+
+func validateEnum(values []string) error {
+	if len(values) == 0 {
+		return errors.New("at least one value is required")
+	}
+
+	seen := make(map[string]bool)
+	for _, value := range values {
+		if value == "" {
+			return errors.New("values cannot be empty")
+		}
+		if seen[value] {
+			return fmt.Errorf("value %q is repeated", value)
+		}
+		seen[value] = true
+	}
+
+	return nil
+}
+
+// Gets the values of the string fields of a struct
+func stringValues(s interface{}) []string {
+	var values []string
+
+	valueOf := reflect.ValueOf(s)
+	for i := 0; i < valueOf.NumField(); i++ {
+		if field := valueOf.Field(i); field.Kind() == reflect.String {
+			values = append(values, field.String())
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,335 @@
+package constants
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pathToRoot = "./../../.."
+
+func readConstantsFile(t *testing.T) string {
+	content, err := os.ReadFile(filepath.Join(pathToRoot, constantsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(content)
+}
+
+// Writes the given content as a constants file in a temporary directory
+// and activates its values
+func activateTempConstants(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), constantsFile)
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	activate(values, file)
+
+	return file
+}
+
+func TestValidate(t *testing.T) {
+	validValues, err := load(filepath.Join(pathToRoot, constantsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type wants struct {
+		withError bool
+	}
+	tests := []struct {
+		name   string
+		modify func(cv *ConstantValues)
+		wants  wants
+	}{
+		{
+			name:   "returns nil error when values in constants file are used",
+			modify: func(cv *ConstantValues) {},
+			wants: wants{
+				withError: false,
+			},
+		},
+		{
+			name:   "returns an error when cars per page is zero",
+			modify: func(cv *ConstantValues) { cv.CARS_PER_PAGE = 0 },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name:   "returns an error when minimum reservation hours is zero",
+			modify: func(cv *ConstantValues) { cv.MINIMUM_RESERVATION_HOURS = 0 },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name:   "returns an error when null uuid is not an uuid",
+			modify: func(cv *ConstantValues) { cv.NULL_UUID = "not-an-uuid" },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name:   "returns an error when a car type is empty",
+			modify: func(cv *ConstantValues) { cv.CAR_TYPES.LUXURY = "" },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name:   "returns an error when a car type is repeated",
+			modify: func(cv *ConstantValues) { cv.CAR_TYPES.LIMOUSINE = cv.CAR_TYPES.SEDAN },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name:   "returns an error when the default protection level is not a protection level",
+			modify: func(cv *ConstantValues) { cv.DEFAULT_PROTECTION_LEVEL = "Premium" },
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when driver requirements refer to an unknown car type",
+			modify: func(cv *ConstantValues) {
+				cv.DRIVER_REQUIREMENTS = []DRIVER_REQUIREMENT{{CAR_TYPE: "Tractor", MINIMUM_AGE: 21}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when a car type has driver requirements twice",
+			modify: func(cv *ConstantValues) {
+				cv.DRIVER_REQUIREMENTS = []DRIVER_REQUIREMENT{{CAR_TYPE: "Sedan", MINIMUM_AGE: 21}, {CAR_TYPE: "Sedan", MINIMUM_AGE: 25}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when driver requirements ask for an age below the minimum driver age",
+			modify: func(cv *ConstantValues) {
+				cv.DRIVER_REQUIREMENTS = []DRIVER_REQUIREMENT{{CAR_TYPE: "Sedan", MINIMUM_AGE: cv.MINIMUM_DRIVER_AGE - 1}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when a security deposit refers to an unknown car type",
+			modify: func(cv *ConstantValues) {
+				cv.SECURITY_DEPOSITS = []SECURITY_DEPOSIT{{CAR_TYPE: "Tractor", AMOUNT: 500}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when a security deposit is not positive",
+			modify: func(cv *ConstantValues) {
+				cv.SECURITY_DEPOSITS = []SECURITY_DEPOSIT{{CAR_TYPE: "Luxury", AMOUNT: 0}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when a late fee is repeated for a car type",
+			modify: func(cv *ConstantValues) {
+				cv.LATE_FEES = []LATE_FEE{{CAR_TYPE: "Sedan", HOURLY_FEE: 15}, {CAR_TYPE: "Sedan", HOURLY_FEE: 20}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+		{
+			name: "returns an error when a late fee is not positive",
+			modify: func(cv *ConstantValues) {
+				cv.LATE_FEES = []LATE_FEE{{CAR_TYPE: "Sedan", HOURLY_FEE: -15}}
+			},
+			wants: wants{
+				withError: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values := validValues
+			test.modify(&values)
+
+			err := values.Validate()
+
+			assert.Equal(t, test.wants.withError, err != nil)
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	content := readConstantsFile(t)
+
+	t.Run("activates a new version when constants file is valid", func(t *testing.T) {
+		file := activateTempConstants(t, content)
+		previous := Current()
+
+		updated := strings.Replace(content, `"CARS_PER_PAGE": 20`, `"CARS_PER_PAGE": 50`, 1)
+		if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		err := Reload()
+
+		assert.Nil(t, err)
+		assert.Equal(t, previous.Version+1, Current().Version)
+		assert.Equal(t, uint16(50), Values().CARS_PER_PAGE)
+	})
+
+	t.Run("keeps active values when an enumeration declared in the database changes", func(t *testing.T) {
+		file := activateTempConstants(t, content)
+		previous := Current()
+
+		updated := strings.Replace(content, `"COMPLETED": "Completed"`, `"COMPLETED": "Finished"`, 1)
+		if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		err := Reload()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, previous, Current())
+	})
+
+	t.Run("keeps active values when constants file is not valid", func(t *testing.T) {
+		file := activateTempConstants(t, content)
+		previous := Current()
+
+		updated := strings.Replace(content, `"CARS_PER_PAGE": 20`, `"CARS_PER_PAGE": 0`, 1)
+		if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		err := Reload()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, previous, Current())
+	})
+
+	t.Run("keeps active values when constants file can not be parsed", func(t *testing.T) {
+		file := activateTempConstants(t, content)
+		previous := Current()
+
+		if err := os.WriteFile(file, []byte(content[:len(content)/2]), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		err := Reload()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, previous, Current())
+	})
+}
+
+func TestWatch(t *testing.T) {
+	content := readConstantsFile(t)
+	file := activateTempConstants(t, content)
+	previous := Current()
+
+	stop, err := Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	updated := strings.Replace(content, `"MINIMUM_RESERVATION_HOURS": 6`, `"MINIMUM_RESERVATION_HOURS": 12`, 1)
+	if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Eventually(t, func() bool {
+		return Current().Version > previous.Version
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, uint16(12), Values().MINIMUM_RESERVATION_HOURS)
+}
+
+func TestWatchStop(t *testing.T) {
+	content := readConstantsFile(t)
+	file := activateTempConstants(t, content)
+	previous := Current()
+
+	stop, err := Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated := strings.Replace(content, `"MINIMUM_RESERVATION_HOURS": 6`, `"MINIMUM_RESERVATION_HOURS": 12`, 1)
+	if err := os.WriteFile(file, []byte(updated), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(3 * reloadDelay)
+	assert.Equal(t, previous.Version, Current().Version)
+}
+
+func TestDriverRequirementFor(t *testing.T) {
+	values, err := load(filepath.Join(pathToRoot, constantsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values.DRIVER_REQUIREMENTS = []DRIVER_REQUIREMENT{{CAR_TYPE: "Limousine", MINIMUM_AGE: 30, MINIMUM_LICENSE_YEARS: 5}}
+
+	t.Run("returns the requirements of the car type", func(t *testing.T) {
+		assert.Equal(t, DRIVER_REQUIREMENT{CAR_TYPE: "Limousine", MINIMUM_AGE: 30, MINIMUM_LICENSE_YEARS: 5}, values.DriverRequirementFor("Limousine"))
+	})
+
+	t.Run("returns the minimum driver age when the car type has no requirements", func(t *testing.T) {
+		assert.Equal(t, DRIVER_REQUIREMENT{CAR_TYPE: "Sedan", MINIMUM_AGE: values.MINIMUM_DRIVER_AGE}, values.DriverRequirementFor("Sedan"))
+	})
+}
+
+func TestDepositFor(t *testing.T) {
+	values, err := load(filepath.Join(pathToRoot, constantsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values.SECURITY_DEPOSITS = []SECURITY_DEPOSIT{{CAR_TYPE: "Sports Car", AMOUNT: 1500}}
+
+	t.Run("returns the deposit of the car type", func(t *testing.T) {
+		assert.Equal(t, 1500.0, values.DepositFor("Sports Car"))
+	})
+
+	t.Run("returns zero when the car type has no deposit", func(t *testing.T) {
+		assert.Equal(t, 0.0, values.DepositFor("Sedan"))
+	})
+}
+
+func TestLateFeeFor(t *testing.T) {
+	values, err := load(filepath.Join(pathToRoot, constantsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values.LATE_FEES = []LATE_FEE{{CAR_TYPE: "Luxury", HOURLY_FEE: 40}}
+
+	t.Run("returns the hourly late fee of the car type", func(t *testing.T) {
+		assert.Equal(t, 40.0, values.LateFeeFor("Luxury"))
+	})
+
+	t.Run("returns zero when the car type has no late fee", func(t *testing.T) {
+		assert.Equal(t, 0.0, values.LateFeeFor("Sedan"))
+	})
+}
@@ -0,0 +1,12 @@
+package constants
+
+type DAMAGE_REPORT_STATUSES struct {
+	OPEN      string `mapstructure:"OPEN" json:"OPEN"`
+	IN_REPAIR string `mapstructure:"IN REPAIR" json:"IN REPAIR"`
+	RESOLVED  string `mapstructure:"RESOLVED" json:"RESOLVED"`
+}
+
+// Get the values in damage report statuses
+func (drs DAMAGE_REPORT_STATUSES) Values() []string {
+	return stringValues(drs)
+}
@@ -0,0 +1,16 @@
+package constants
+
+type DEPOSIT_STATUSES struct {
+	NOT_REQUIRED string `mapstructure:"NOT REQUIRED" json:"NOT REQUIRED"`
+	PENDING      string `mapstructure:"PENDING" json:"PENDING"`
+	HELD         string `mapstructure:"HELD" json:"HELD"`
+	CAPTURING    string `mapstructure:"CAPTURING" json:"CAPTURING"`
+	RELEASING    string `mapstructure:"RELEASING" json:"RELEASING"`
+	RELEASED     string `mapstructure:"RELEASED" json:"RELEASED"`
+	CAPTURED     string `mapstructure:"CAPTURED" json:"CAPTURED"`
+}
+
+// Get the values in deposit statuses
+func (ds DEPOSIT_STATUSES) Values() []string {
+	return stringValues(ds)
+}
@@ -0,0 +1,23 @@
+package constants
+
+// Requirements drivers must meet to book cars of a type
+type DRIVER_REQUIREMENT struct {
+	CAR_TYPE              string `mapstructure:"CAR_TYPE" json:"CAR_TYPE"`
+	MINIMUM_AGE           uint16 `mapstructure:"MINIMUM_AGE" json:"MINIMUM_AGE"`
+	MINIMUM_LICENSE_YEARS uint16 `mapstructure:"MINIMUM_LICENSE_YEARS" json:"MINIMUM_LICENSE_YEARS"`
+}
+
+// Gets the requirements for drivers of the given car type. Car types without
+// their own requirements only ask for the minimum driver age.
+func (cv ConstantValues) DriverRequirementFor(carType string) DRIVER_REQUIREMENT {
+	for _, requirement := range cv.DRIVER_REQUIREMENTS {
+		if requirement.CAR_TYPE == carType {
+			return requirement
+		}
+	}
+
+	return DRIVER_REQUIREMENT{
+		CAR_TYPE:    carType,
+		MINIMUM_AGE: cv.MINIMUM_DRIVER_AGE,
+	}
+}
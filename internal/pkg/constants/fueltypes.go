@@ -0,0 +1,13 @@
+package constants
+
+type FUEL_TYPES struct {
+	GASOLINE string `mapstructure:"GASOLINE" json:"GASOLINE"`
+	DIESEL   string `mapstructure:"DIESEL" json:"DIESEL"`
+	HYBRID   string `mapstructure:"HYBRID" json:"HYBRID"`
+	ELECTRIC string `mapstructure:"ELECTRIC" json:"ELECTRIC"`
+}
+
+// Get the values in fuel types
+func (ft FUEL_TYPES) Values() []string {
+	return stringValues(ft)
+}
@@ -0,0 +1,11 @@
+package constants
+
+type INSPECTION_TYPES struct {
+	PICKUP string `mapstructure:"PICKUP" json:"PICKUP"`
+	RETURN string `mapstructure:"RETURN" json:"RETURN"`
+}
+
+// Get the values in inspection types
+func (it INSPECTION_TYPES) Values() []string {
+	return stringValues(it)
+}
@@ -0,0 +1,20 @@
+package constants
+
+// Amount, in FEES_CURRENCY, charged per started hour for cars of a type
+// returned late
+type LATE_FEE struct {
+	CAR_TYPE   string  `mapstructure:"CAR_TYPE" json:"CAR_TYPE"`
+	HOURLY_FEE float64 `mapstructure:"HOURLY_FEE" json:"HOURLY_FEE"`
+}
+
+// Gets the hourly late fee of the given car type. Car types without a late
+// fee return zero.
+func (cv ConstantValues) LateFeeFor(carType string) float64 {
+	for _, lateFee := range cv.LATE_FEES {
+		if lateFee.CAR_TYPE == carType {
+			return lateFee.HOURLY_FEE
+		}
+	}
+
+	return 0
+}
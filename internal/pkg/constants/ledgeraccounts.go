@@ -0,0 +1,13 @@
+package constants
+
+type LEDGER_ACCOUNTS struct {
+	CUSTOMER string `mapstructure:"CUSTOMER" json:"CUSTOMER"`
+	REVENUE  string `mapstructure:"REVENUE" json:"REVENUE"`
+	DEPOSITS string `mapstructure:"DEPOSITS" json:"DEPOSITS"`
+	TAX      string `mapstructure:"TAX" json:"TAX"`
+}
+
+// Get the values in ledger accounts
+func (la LEDGER_ACCOUNTS) Values() []string {
+	return stringValues(la)
+}
@@ -0,0 +1,16 @@
+package constants
+
+type LEDGER_MOVEMENTS struct {
+	CHARGE          string `mapstructure:"CHARGE" json:"CHARGE"`
+	DISCOUNT        string `mapstructure:"DISCOUNT" json:"DISCOUNT"`
+	REFUND          string `mapstructure:"REFUND" json:"REFUND"`
+	DEPOSIT_HOLD    string `mapstructure:"DEPOSIT HOLD" json:"DEPOSIT HOLD"`
+	DEPOSIT_CAPTURE string `mapstructure:"DEPOSIT CAPTURE" json:"DEPOSIT CAPTURE"`
+	DEPOSIT_RELEASE string `mapstructure:"DEPOSIT RELEASE" json:"DEPOSIT RELEASE"`
+	LATE_FEE        string `mapstructure:"LATE FEE" json:"LATE FEE"`
+}
+
+// Get the values in ledger movements
+func (lm LEDGER_MOVEMENTS) Values() []string {
+	return stringValues(lm)
+}
@@ -1,25 +1,17 @@
 package constants
 
-import "reflect"
-
-var paymentStatuses []string
-
-// Initializes paymentTypes slice with values of PAYMENT_STATUSES struct
-func initializePaymentStatusesValues() {
-	valueOf := reflect.ValueOf(Values.PAYMENT_STATUSES)
-	for i := 0; i < valueOf.NumField(); i++ {
-		field := valueOf.Field(i)
-		paymentStatuses = append(paymentStatuses, field.String())
-	}
-}
-
 type PAYMENT_STATUSES struct {
-	PAID     string `mapstructure:"PAID"`
-	PENDING  string `mapstructure:"PENDING"`
-	CANCELED string `mapstructure:"CANCELED"`
+	PAID       string `mapstructure:"PAID" json:"PAID"`
+	PENDING    string `mapstructure:"PENDING" json:"PENDING"`
+	CANCELED   string `mapstructure:"CANCELED" json:"CANCELED"`
+	AUTHORIZED string `mapstructure:"AUTHORIZED" json:"AUTHORIZED"`
+	CAPTURING  string `mapstructure:"CAPTURING" json:"CAPTURING"`
+	REFUNDING  string `mapstructure:"REFUNDING" json:"REFUNDING"`
+	REFUNDED   string `mapstructure:"REFUNDED" json:"REFUNDED"`
+	FAILED     string `mapstructure:"FAILED" json:"FAILED"`
 }
 
 // Get the values in payment statuses
-func (cs *PAYMENT_STATUSES) Values() []string {
-	return paymentStatuses
+func (ps PAYMENT_STATUSES) Values() []string {
+	return stringValues(ps)
 }
@@ -0,0 +1,12 @@
+package constants
+
+type PROTECTION_LEVELS struct {
+	BASIC    string `mapstructure:"BASIC" json:"BASIC"`
+	STANDARD string `mapstructure:"STANDARD" json:"STANDARD"`
+	FULL     string `mapstructure:"FULL" json:"FULL"`
+}
+
+// Get the values in protection levels
+func (pl PROTECTION_LEVELS) Values() []string {
+	return stringValues(pl)
+}
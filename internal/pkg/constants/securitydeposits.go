@@ -0,0 +1,20 @@
+package constants
+
+// Amount, in FEES_CURRENCY, held on the renter payment method while cars of
+// a type are rented
+type SECURITY_DEPOSIT struct {
+	CAR_TYPE string  `mapstructure:"CAR_TYPE" json:"CAR_TYPE"`
+	AMOUNT   float64 `mapstructure:"AMOUNT" json:"AMOUNT"`
+}
+
+// Gets the security deposit of the given car type. Car types without a
+// deposit return zero.
+func (cv ConstantValues) DepositFor(carType string) float64 {
+	for _, deposit := range cv.SECURITY_DEPOSITS {
+		if deposit.CAR_TYPE == carType {
+			return deposit.AMOUNT
+		}
+	}
+
+	return 0
+}
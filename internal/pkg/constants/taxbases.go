@@ -0,0 +1,13 @@
+package constants
+
+type TAX_BASES struct {
+	RENTAL     string `mapstructure:"RENTAL" json:"RENTAL"`
+	ADD_ONS    string `mapstructure:"ADD ONS" json:"ADD ONS"`
+	PROTECTION string `mapstructure:"PROTECTION" json:"PROTECTION"`
+	FEES       string `mapstructure:"FEES" json:"FEES"`
+}
+
+// Get the values in tax bases
+func (tb TAX_BASES) Values() []string {
+	return stringValues(tb)
+}
@@ -0,0 +1,13 @@
+package constants
+
+type TAX_ROUNDINGS struct {
+	HALF_UP   string `mapstructure:"HALF UP" json:"HALF UP"`
+	HALF_EVEN string `mapstructure:"HALF EVEN" json:"HALF EVEN"`
+	UP        string `mapstructure:"UP" json:"UP"`
+	DOWN      string `mapstructure:"DOWN" json:"DOWN"`
+}
+
+// Get the values in tax roundings
+func (tr TAX_ROUNDINGS) Values() []string {
+	return stringValues(tr)
+}
@@ -0,0 +1,11 @@
+package constants
+
+type TRANSMISSIONS struct {
+	MANUAL    string `mapstructure:"MANUAL" json:"MANUAL"`
+	AUTOMATIC string `mapstructure:"AUTOMATIC" json:"AUTOMATIC"`
+}
+
+// Get the values in transmissions
+func (t TRANSMISSIONS) Values() []string {
+	return stringValues(t)
+}
@@ -0,0 +1,11 @@
+package constants
+
+type USER_TOKEN_PURPOSES struct {
+	EMAIL_VERIFICATION string `mapstructure:"EMAIL VERIFICATION" json:"EMAIL VERIFICATION"`
+	PASSWORD_RESET     string `mapstructure:"PASSWORD RESET" json:"PASSWORD RESET"`
+}
+
+// Get the values in user token purposes
+func (utp USER_TOKEN_PURPOSES) Values() []string {
+	return stringValues(utp)
+}
@@ -129,6 +129,18 @@ func (mr *MockCarsControllerMockRecorder) Register(w, r interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCarsController)(nil).Register), w, r)
 }
 
+// SearchNearby mocks base method.
+func (m *MockCarsController) SearchNearby(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SearchNearby", w, r)
+}
+
+// SearchNearby indicates an expected call of SearchNearby.
+func (mr *MockCarsControllerMockRecorder) SearchNearby(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNearby", reflect.TypeOf((*MockCarsController)(nil).SearchNearby), w, r)
+}
+
 // MockUsersController is a mock of UsersController interface.
 type MockUsersController struct {
 	ctrl     *gomock.Controller
@@ -188,6 +200,54 @@ func (mr *MockUsersControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersController)(nil).Get), w, r)
 }
 
+// List mocks base method.
+func (m *MockUsersController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockUsersControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsersController)(nil).List), w, r)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockUsersController) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RequestPasswordReset", w, r)
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUsersControllerMockRecorder) RequestPasswordReset(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUsersController)(nil).RequestPasswordReset), w, r)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUsersController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetPassword", w, r)
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUsersControllerMockRecorder) ResetPassword(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUsersController)(nil).ResetPassword), w, r)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockUsersController) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendEmailVerification", w, r)
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockUsersControllerMockRecorder) SendEmailVerification(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockUsersController)(nil).SendEmailVerification), w, r)
+}
+
 // SignUp mocks base method.
 func (m *MockUsersController) SignUp(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -200,6 +260,18 @@ func (mr *MockUsersControllerMockRecorder) SignUp(w, r interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockUsersController)(nil).SignUp), w, r)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUsersController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VerifyEmail", w, r)
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUsersControllerMockRecorder) VerifyEmail(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUsersController)(nil).VerifyEmail), w, r)
+}
+
 // MockCitiesController is a mock of CitiesController interface.
 type MockCitiesController struct {
 	ctrl     *gomock.Controller
@@ -223,121 +295,1365 @@ func (m *MockCitiesController) EXPECT() *MockCitiesControllerMockRecorder {
 	return m.recorder
 }
 
-// ListNames mocks base method.
-func (m *MockCitiesController) ListNames(w http.ResponseWriter, r *http.Request) {
+// Delete mocks base method.
+func (m *MockCitiesController) Delete(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ListNames", w, r)
+	m.ctrl.Call(m, "Delete", w, r)
 }
 
-// ListNames indicates an expected call of ListNames.
-func (mr *MockCitiesControllerMockRecorder) ListNames(w, r interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockCitiesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNames", reflect.TypeOf((*MockCitiesController)(nil).ListNames), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCitiesController)(nil).Delete), w, r)
 }
 
-// MockReservationsController is a mock of ReservationsController interface.
-type MockReservationsController struct {
+// FullUpdate mocks base method.
+func (m *MockCitiesController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockCitiesControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockCitiesController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockCitiesController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCitiesControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCitiesController)(nil).Get), w, r)
+}
+
+// List mocks base method.
+func (m *MockCitiesController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockCitiesControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCitiesController)(nil).List), w, r)
+}
+
+// Register mocks base method.
+func (m *MockCitiesController) Register(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", w, r)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockCitiesControllerMockRecorder) Register(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCitiesController)(nil).Register), w, r)
+}
+
+// MockBranchesController is a mock of BranchesController interface.
+type MockBranchesController struct {
 	ctrl     *gomock.Controller
-	recorder *MockReservationsControllerMockRecorder
+	recorder *MockBranchesControllerMockRecorder
 }
 
-// MockReservationsControllerMockRecorder is the mock recorder for MockReservationsController.
-type MockReservationsControllerMockRecorder struct {
-	mock *MockReservationsController
+// MockBranchesControllerMockRecorder is the mock recorder for MockBranchesController.
+type MockBranchesControllerMockRecorder struct {
+	mock *MockBranchesController
 }
 
-// NewMockReservationsController creates a new mock instance.
-func NewMockReservationsController(ctrl *gomock.Controller) *MockReservationsController {
-	mock := &MockReservationsController{ctrl: ctrl}
-	mock.recorder = &MockReservationsControllerMockRecorder{mock}
+// NewMockBranchesController creates a new mock instance.
+func NewMockBranchesController(ctrl *gomock.Controller) *MockBranchesController {
+	mock := &MockBranchesController{ctrl: ctrl}
+	mock.recorder = &MockBranchesControllerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockReservationsController) EXPECT() *MockReservationsControllerMockRecorder {
+func (m *MockBranchesController) EXPECT() *MockBranchesControllerMockRecorder {
 	return m.recorder
 }
 
-// Book mocks base method.
-func (m *MockReservationsController) Book(w http.ResponseWriter, r *http.Request) {
+// Delete mocks base method.
+func (m *MockBranchesController) Delete(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Book", w, r)
+	m.ctrl.Call(m, "Delete", w, r)
 }
 
-// Book indicates an expected call of Book.
-func (mr *MockReservationsControllerMockRecorder) Book(w, r interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockBranchesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockReservationsController)(nil).Book), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBranchesController)(nil).Delete), w, r)
+}
+
+// FullUpdate mocks base method.
+func (m *MockBranchesController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockBranchesControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockBranchesController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockBranchesController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBranchesControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBranchesController)(nil).Get), w, r)
+}
+
+// ListByCityID mocks base method.
+func (m *MockBranchesController) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListByCityID", w, r)
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockBranchesControllerMockRecorder) ListByCityID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockBranchesController)(nil).ListByCityID), w, r)
+}
+
+// Register mocks base method.
+func (m *MockBranchesController) Register(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", w, r)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockBranchesControllerMockRecorder) Register(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockBranchesController)(nil).Register), w, r)
+}
+
+// MockAddOnsController is a mock of AddOnsController interface.
+type MockAddOnsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddOnsControllerMockRecorder
+}
+
+// MockAddOnsControllerMockRecorder is the mock recorder for MockAddOnsController.
+type MockAddOnsControllerMockRecorder struct {
+	mock *MockAddOnsController
+}
+
+// NewMockAddOnsController creates a new mock instance.
+func NewMockAddOnsController(ctrl *gomock.Controller) *MockAddOnsController {
+	mock := &MockAddOnsController{ctrl: ctrl}
+	mock.recorder = &MockAddOnsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddOnsController) EXPECT() *MockAddOnsControllerMockRecorder {
+	return m.recorder
 }
 
 // Delete mocks base method.
-func (m *MockReservationsController) Delete(w http.ResponseWriter, r *http.Request) {
+func (m *MockAddOnsController) Delete(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Delete", w, r)
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockReservationsControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+func (mr *MockAddOnsControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsController)(nil).Delete), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddOnsController)(nil).Delete), w, r)
 }
 
 // FullUpdate mocks base method.
-func (m *MockReservationsController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+func (m *MockAddOnsController) FullUpdate(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "FullUpdate", w, r)
 }
 
 // FullUpdate indicates an expected call of FullUpdate.
-func (mr *MockReservationsControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+func (mr *MockAddOnsControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsController)(nil).FullUpdate), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockAddOnsController)(nil).FullUpdate), w, r)
 }
 
 // Get mocks base method.
-func (m *MockReservationsController) Get(w http.ResponseWriter, r *http.Request) {
+func (m *MockAddOnsController) Get(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Get", w, r)
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockReservationsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+func (mr *MockAddOnsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsController)(nil).Get), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddOnsController)(nil).Get), w, r)
 }
 
-// GetByCarID mocks base method.
-func (m *MockReservationsController) GetByCarID(w http.ResponseWriter, r *http.Request) {
+// ListByBranchID mocks base method.
+func (m *MockAddOnsController) ListByBranchID(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "GetByCarID", w, r)
+	m.ctrl.Call(m, "ListByBranchID", w, r)
 }
 
-// GetByCarID indicates an expected call of GetByCarID.
-func (mr *MockReservationsControllerMockRecorder) GetByCarID(w, r interface{}) *gomock.Call {
+// ListByBranchID indicates an expected call of ListByBranchID.
+func (mr *MockAddOnsControllerMockRecorder) ListByBranchID(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsController)(nil).GetByCarID), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBranchID", reflect.TypeOf((*MockAddOnsController)(nil).ListByBranchID), w, r)
 }
 
-// GetByUserID mocks base method.
-func (m *MockReservationsController) GetByUserID(w http.ResponseWriter, r *http.Request) {
+// Register mocks base method.
+func (m *MockAddOnsController) Register(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "GetByUserID", w, r)
+	m.ctrl.Call(m, "Register", w, r)
 }
 
-// GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockReservationsControllerMockRecorder) GetByUserID(w, r interface{}) *gomock.Call {
+// Register indicates an expected call of Register.
+func (mr *MockAddOnsControllerMockRecorder) Register(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsController)(nil).GetByUserID), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAddOnsController)(nil).Register), w, r)
+}
+
+// MockProtectionPlansController is a mock of ProtectionPlansController interface.
+type MockProtectionPlansController struct {
+	ctrl     *gomock.Controller
+	recorder *MockProtectionPlansControllerMockRecorder
+}
+
+// MockProtectionPlansControllerMockRecorder is the mock recorder for MockProtectionPlansController.
+type MockProtectionPlansControllerMockRecorder struct {
+	mock *MockProtectionPlansController
+}
+
+// NewMockProtectionPlansController creates a new mock instance.
+func NewMockProtectionPlansController(ctrl *gomock.Controller) *MockProtectionPlansController {
+	mock := &MockProtectionPlansController{ctrl: ctrl}
+	mock.recorder = &MockProtectionPlansControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProtectionPlansController) EXPECT() *MockProtectionPlansControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockProtectionPlansController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProtectionPlansControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProtectionPlansController)(nil).Delete), w, r)
+}
+
+// FullUpdate mocks base method.
+func (m *MockProtectionPlansController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockProtectionPlansControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockProtectionPlansController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockProtectionPlansController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProtectionPlansControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProtectionPlansController)(nil).Get), w, r)
 }
 
 // List mocks base method.
-func (m *MockReservationsController) List(w http.ResponseWriter, r *http.Request) {
+func (m *MockProtectionPlansController) List(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "List", w, r)
 }
 
 // List indicates an expected call of List.
-func (mr *MockReservationsControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+func (mr *MockProtectionPlansControllerMockRecorder) List(w, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsController)(nil).List), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProtectionPlansController)(nil).List), w, r)
+}
+
+// Register mocks base method.
+func (m *MockProtectionPlansController) Register(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", w, r)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockProtectionPlansControllerMockRecorder) Register(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockProtectionPlansController)(nil).Register), w, r)
+}
+
+// MockTaxRulesController is a mock of TaxRulesController interface.
+type MockTaxRulesController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRulesControllerMockRecorder
+}
+
+// MockTaxRulesControllerMockRecorder is the mock recorder for MockTaxRulesController.
+type MockTaxRulesControllerMockRecorder struct {
+	mock *MockTaxRulesController
+}
+
+// NewMockTaxRulesController creates a new mock instance.
+func NewMockTaxRulesController(ctrl *gomock.Controller) *MockTaxRulesController {
+	mock := &MockTaxRulesController{ctrl: ctrl}
+	mock.recorder = &MockTaxRulesControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRulesController) EXPECT() *MockTaxRulesControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTaxRulesController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRulesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRulesController)(nil).Delete), w, r)
+}
+
+// FullUpdate mocks base method.
+func (m *MockTaxRulesController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockTaxRulesControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockTaxRulesController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockTaxRulesController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaxRulesControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaxRulesController)(nil).Get), w, r)
+}
+
+// ListByCityID mocks base method.
+func (m *MockTaxRulesController) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListByCityID", w, r)
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockTaxRulesControllerMockRecorder) ListByCityID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockTaxRulesController)(nil).ListByCityID), w, r)
+}
+
+// Register mocks base method.
+func (m *MockTaxRulesController) Register(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", w, r)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockTaxRulesControllerMockRecorder) Register(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockTaxRulesController)(nil).Register), w, r)
+}
+
+// MockExchangeRatesController is a mock of ExchangeRatesController interface.
+type MockExchangeRatesController struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesControllerMockRecorder
+}
+
+// MockExchangeRatesControllerMockRecorder is the mock recorder for MockExchangeRatesController.
+type MockExchangeRatesControllerMockRecorder struct {
+	mock *MockExchangeRatesController
+}
+
+// NewMockExchangeRatesController creates a new mock instance.
+func NewMockExchangeRatesController(ctrl *gomock.Controller) *MockExchangeRatesController {
+	mock := &MockExchangeRatesController{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRatesController) EXPECT() *MockExchangeRatesControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRatesController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRatesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRatesController)(nil).Delete), w, r)
+}
+
+// List mocks base method.
+func (m *MockExchangeRatesController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockExchangeRatesControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExchangeRatesController)(nil).List), w, r)
+}
+
+// Set mocks base method.
+func (m *MockExchangeRatesController) Set(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, r)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockExchangeRatesControllerMockRecorder) Set(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockExchangeRatesController)(nil).Set), w, r)
+}
+
+// MockOneWayFeesController is a mock of OneWayFeesController interface.
+type MockOneWayFeesController struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneWayFeesControllerMockRecorder
+}
+
+// MockOneWayFeesControllerMockRecorder is the mock recorder for MockOneWayFeesController.
+type MockOneWayFeesControllerMockRecorder struct {
+	mock *MockOneWayFeesController
+}
+
+// NewMockOneWayFeesController creates a new mock instance.
+func NewMockOneWayFeesController(ctrl *gomock.Controller) *MockOneWayFeesController {
+	mock := &MockOneWayFeesController{ctrl: ctrl}
+	mock.recorder = &MockOneWayFeesControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOneWayFeesController) EXPECT() *MockOneWayFeesControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockOneWayFeesController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOneWayFeesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOneWayFeesController)(nil).Delete), w, r)
+}
+
+// ListByCityID mocks base method.
+func (m *MockOneWayFeesController) ListByCityID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListByCityID", w, r)
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockOneWayFeesControllerMockRecorder) ListByCityID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockOneWayFeesController)(nil).ListByCityID), w, r)
+}
+
+// Set mocks base method.
+func (m *MockOneWayFeesController) Set(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, r)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockOneWayFeesControllerMockRecorder) Set(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockOneWayFeesController)(nil).Set), w, r)
+}
+
+// MockReservationsController is a mock of ReservationsController interface.
+type MockReservationsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsControllerMockRecorder
+}
+
+// MockReservationsControllerMockRecorder is the mock recorder for MockReservationsController.
+type MockReservationsControllerMockRecorder struct {
+	mock *MockReservationsController
+}
+
+// NewMockReservationsController creates a new mock instance.
+func NewMockReservationsController(ctrl *gomock.Controller) *MockReservationsController {
+	mock := &MockReservationsController{ctrl: ctrl}
+	mock.recorder = &MockReservationsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsController) EXPECT() *MockReservationsControllerMockRecorder {
+	return m.recorder
+}
+
+// Book mocks base method.
+func (m *MockReservationsController) Book(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Book", w, r)
+}
+
+// Book indicates an expected call of Book.
+func (mr *MockReservationsControllerMockRecorder) Book(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockReservationsController)(nil).Book), w, r)
+}
+
+// Delete mocks base method.
+func (m *MockReservationsController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReservationsControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsController)(nil).Delete), w, r)
+}
+
+// Extend mocks base method.
+func (m *MockReservationsController) Extend(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Extend", w, r)
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockReservationsControllerMockRecorder) Extend(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockReservationsController)(nil).Extend), w, r)
+}
+
+// FullUpdate mocks base method.
+func (m *MockReservationsController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockReservationsControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockReservationsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservationsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsController)(nil).Get), w, r)
+}
+
+// GetByCarID mocks base method.
+func (m *MockReservationsController) GetByCarID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetByCarID", w, r)
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockReservationsControllerMockRecorder) GetByCarID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsController)(nil).GetByCarID), w, r)
+}
+
+// GetByUserID mocks base method.
+func (m *MockReservationsController) GetByUserID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetByUserID", w, r)
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockReservationsControllerMockRecorder) GetByUserID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsController)(nil).GetByUserID), w, r)
+}
+
+// List mocks base method.
+func (m *MockReservationsController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservationsControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsController)(nil).List), w, r)
+}
+
+// Quote mocks base method.
+func (m *MockReservationsController) Quote(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Quote", w, r)
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockReservationsControllerMockRecorder) Quote(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockReservationsController)(nil).Quote), w, r)
+}
+
+// MockPaymentsController is a mock of PaymentsController interface.
+type MockPaymentsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentsControllerMockRecorder
+}
+
+// MockPaymentsControllerMockRecorder is the mock recorder for MockPaymentsController.
+type MockPaymentsControllerMockRecorder struct {
+	mock *MockPaymentsController
+}
+
+// NewMockPaymentsController creates a new mock instance.
+func NewMockPaymentsController(ctrl *gomock.Controller) *MockPaymentsController {
+	mock := &MockPaymentsController{ctrl: ctrl}
+	mock.recorder = &MockPaymentsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentsController) EXPECT() *MockPaymentsControllerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPaymentsController) Authorize(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Authorize", w, r)
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPaymentsControllerMockRecorder) Authorize(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPaymentsController)(nil).Authorize), w, r)
+}
+
+// Capture mocks base method.
+func (m *MockPaymentsController) Capture(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Capture", w, r)
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockPaymentsControllerMockRecorder) Capture(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockPaymentsController)(nil).Capture), w, r)
+}
+
+// Get mocks base method.
+func (m *MockPaymentsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPaymentsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPaymentsController)(nil).Get), w, r)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentsController) Refund(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Refund", w, r)
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentsControllerMockRecorder) Refund(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentsController)(nil).Refund), w, r)
+}
+
+// Void mocks base method.
+func (m *MockPaymentsController) Void(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Void", w, r)
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentsControllerMockRecorder) Void(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentsController)(nil).Void), w, r)
+}
+
+// Webhook mocks base method.
+func (m *MockPaymentsController) Webhook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Webhook", w, r)
+}
+
+// Webhook indicates an expected call of Webhook.
+func (mr *MockPaymentsControllerMockRecorder) Webhook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhook", reflect.TypeOf((*MockPaymentsController)(nil).Webhook), w, r)
+}
+
+// MockDepositsController is a mock of DepositsController interface.
+type MockDepositsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositsControllerMockRecorder
+}
+
+// MockDepositsControllerMockRecorder is the mock recorder for MockDepositsController.
+type MockDepositsControllerMockRecorder struct {
+	mock *MockDepositsController
+}
+
+// NewMockDepositsController creates a new mock instance.
+func NewMockDepositsController(ctrl *gomock.Controller) *MockDepositsController {
+	mock := &MockDepositsController{ctrl: ctrl}
+	mock.recorder = &MockDepositsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositsController) EXPECT() *MockDepositsControllerMockRecorder {
+	return m.recorder
+}
+
+// Capture mocks base method.
+func (m *MockDepositsController) Capture(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Capture", w, r)
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockDepositsControllerMockRecorder) Capture(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockDepositsController)(nil).Capture), w, r)
+}
+
+// Get mocks base method.
+func (m *MockDepositsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepositsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepositsController)(nil).Get), w, r)
+}
+
+// Release mocks base method.
+func (m *MockDepositsController) Release(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Release", w, r)
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockDepositsControllerMockRecorder) Release(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockDepositsController)(nil).Release), w, r)
+}
+
+// MockLedgerController is a mock of LedgerController interface.
+type MockLedgerController struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerControllerMockRecorder
+}
+
+// MockLedgerControllerMockRecorder is the mock recorder for MockLedgerController.
+type MockLedgerControllerMockRecorder struct {
+	mock *MockLedgerController
+}
+
+// NewMockLedgerController creates a new mock instance.
+func NewMockLedgerController(ctrl *gomock.Controller) *MockLedgerController {
+	mock := &MockLedgerController{ctrl: ctrl}
+	mock.recorder = &MockLedgerControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerController) EXPECT() *MockLedgerControllerMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockLedgerController) GetBalance(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetBalance", w, r)
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLedgerControllerMockRecorder) GetBalance(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLedgerController)(nil).GetBalance), w, r)
+}
+
+// MockInvoicesController is a mock of InvoicesController interface.
+type MockInvoicesController struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoicesControllerMockRecorder
+}
+
+// MockInvoicesControllerMockRecorder is the mock recorder for MockInvoicesController.
+type MockInvoicesControllerMockRecorder struct {
+	mock *MockInvoicesController
+}
+
+// NewMockInvoicesController creates a new mock instance.
+func NewMockInvoicesController(ctrl *gomock.Controller) *MockInvoicesController {
+	mock := &MockInvoicesController{ctrl: ctrl}
+	mock.recorder = &MockInvoicesControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoicesController) EXPECT() *MockInvoicesControllerMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockInvoicesController) Download(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Download", w, r)
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockInvoicesControllerMockRecorder) Download(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockInvoicesController)(nil).Download), w, r)
+}
+
+// Get mocks base method.
+func (m *MockInvoicesController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoicesControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoicesController)(nil).Get), w, r)
+}
+
+// GetBillingProfile mocks base method.
+func (m *MockInvoicesController) GetBillingProfile(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetBillingProfile", w, r)
+}
+
+// GetBillingProfile indicates an expected call of GetBillingProfile.
+func (mr *MockInvoicesControllerMockRecorder) GetBillingProfile(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingProfile", reflect.TypeOf((*MockInvoicesController)(nil).GetBillingProfile), w, r)
+}
+
+// GetByReservationID mocks base method.
+func (m *MockInvoicesController) GetByReservationID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetByReservationID", w, r)
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockInvoicesControllerMockRecorder) GetByReservationID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockInvoicesController)(nil).GetByReservationID), w, r)
+}
+
+// Issue mocks base method.
+func (m *MockInvoicesController) Issue(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Issue", w, r)
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockInvoicesControllerMockRecorder) Issue(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockInvoicesController)(nil).Issue), w, r)
+}
+
+// SaveBillingProfile mocks base method.
+func (m *MockInvoicesController) SaveBillingProfile(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SaveBillingProfile", w, r)
+}
+
+// SaveBillingProfile indicates an expected call of SaveBillingProfile.
+func (mr *MockInvoicesControllerMockRecorder) SaveBillingProfile(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBillingProfile", reflect.TypeOf((*MockInvoicesController)(nil).SaveBillingProfile), w, r)
+}
+
+// MockConstantsController is a mock of ConstantsController interface.
+type MockConstantsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockConstantsControllerMockRecorder
+}
+
+// MockConstantsControllerMockRecorder is the mock recorder for MockConstantsController.
+type MockConstantsControllerMockRecorder struct {
+	mock *MockConstantsController
+}
+
+// NewMockConstantsController creates a new mock instance.
+func NewMockConstantsController(ctrl *gomock.Controller) *MockConstantsController {
+	mock := &MockConstantsController{ctrl: ctrl}
+	mock.recorder = &MockConstantsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConstantsController) EXPECT() *MockConstantsControllerMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockConstantsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConstantsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConstantsController)(nil).Get), w, r)
+}
+
+// MockMaintenancesController is a mock of MaintenancesController interface.
+type MockMaintenancesController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenancesControllerMockRecorder
+}
+
+// MockMaintenancesControllerMockRecorder is the mock recorder for MockMaintenancesController.
+type MockMaintenancesControllerMockRecorder struct {
+	mock *MockMaintenancesController
+}
+
+// NewMockMaintenancesController creates a new mock instance.
+func NewMockMaintenancesController(ctrl *gomock.Controller) *MockMaintenancesController {
+	mock := &MockMaintenancesController{ctrl: ctrl}
+	mock.recorder = &MockMaintenancesControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenancesController) EXPECT() *MockMaintenancesControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMaintenancesController) Delete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", w, r)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMaintenancesControllerMockRecorder) Delete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMaintenancesController)(nil).Delete), w, r)
+}
+
+// FullUpdate mocks base method.
+func (m *MockMaintenancesController) FullUpdate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FullUpdate", w, r)
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockMaintenancesControllerMockRecorder) FullUpdate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockMaintenancesController)(nil).FullUpdate), w, r)
+}
+
+// Get mocks base method.
+func (m *MockMaintenancesController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMaintenancesControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMaintenancesController)(nil).Get), w, r)
+}
+
+// GetByCarID mocks base method.
+func (m *MockMaintenancesController) GetByCarID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetByCarID", w, r)
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockMaintenancesControllerMockRecorder) GetByCarID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockMaintenancesController)(nil).GetByCarID), w, r)
+}
+
+// List mocks base method.
+func (m *MockMaintenancesController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockMaintenancesControllerMockRecorder) List(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMaintenancesController)(nil).List), w, r)
+}
+
+// Schedule mocks base method.
+func (m *MockMaintenancesController) Schedule(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Schedule", w, r)
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockMaintenancesControllerMockRecorder) Schedule(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockMaintenancesController)(nil).Schedule), w, r)
+}
+
+// MockTransfersController is a mock of TransfersController interface.
+type MockTransfersController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransfersControllerMockRecorder
+}
+
+// MockTransfersControllerMockRecorder is the mock recorder for MockTransfersController.
+type MockTransfersControllerMockRecorder struct {
+	mock *MockTransfersController
+}
+
+// NewMockTransfersController creates a new mock instance.
+func NewMockTransfersController(ctrl *gomock.Controller) *MockTransfersController {
+	mock := &MockTransfersController{ctrl: ctrl}
+	mock.recorder = &MockTransfersControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfersController) EXPECT() *MockTransfersControllerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockTransfersController) Cancel(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Cancel", w, r)
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockTransfersControllerMockRecorder) Cancel(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransfersController)(nil).Cancel), w, r)
+}
+
+// Complete mocks base method.
+func (m *MockTransfersController) Complete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Complete", w, r)
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTransfersControllerMockRecorder) Complete(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTransfersController)(nil).Complete), w, r)
+}
+
+// Get mocks base method.
+func (m *MockTransfersController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransfersControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransfersController)(nil).Get), w, r)
+}
+
+// GetByCarID mocks base method.
+func (m *MockTransfersController) GetByCarID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetByCarID", w, r)
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockTransfersControllerMockRecorder) GetByCarID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockTransfersController)(nil).GetByCarID), w, r)
+}
+
+// Schedule mocks base method.
+func (m *MockTransfersController) Schedule(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Schedule", w, r)
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockTransfersControllerMockRecorder) Schedule(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTransfersController)(nil).Schedule), w, r)
+}
+
+// MockHandoversController is a mock of HandoversController interface.
+type MockHandoversController struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandoversControllerMockRecorder
+}
+
+// MockHandoversControllerMockRecorder is the mock recorder for MockHandoversController.
+type MockHandoversControllerMockRecorder struct {
+	mock *MockHandoversController
+}
+
+// NewMockHandoversController creates a new mock instance.
+func NewMockHandoversController(ctrl *gomock.Controller) *MockHandoversController {
+	mock := &MockHandoversController{ctrl: ctrl}
+	mock.recorder = &MockHandoversControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandoversController) EXPECT() *MockHandoversControllerMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockHandoversController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockHandoversControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockHandoversController)(nil).Get), w, r)
+}
+
+// GetCarMileage mocks base method.
+func (m *MockHandoversController) GetCarMileage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetCarMileage", w, r)
+}
+
+// GetCarMileage indicates an expected call of GetCarMileage.
+func (mr *MockHandoversControllerMockRecorder) GetCarMileage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarMileage", reflect.TypeOf((*MockHandoversController)(nil).GetCarMileage), w, r)
+}
+
+// RecordInspection mocks base method.
+func (m *MockHandoversController) RecordInspection(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordInspection", w, r)
+}
+
+// RecordInspection indicates an expected call of RecordInspection.
+func (mr *MockHandoversControllerMockRecorder) RecordInspection(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInspection", reflect.TypeOf((*MockHandoversController)(nil).RecordInspection), w, r)
+}
+
+// RegisterCarService mocks base method.
+func (m *MockHandoversController) RegisterCarService(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCarService", w, r)
+}
+
+// RegisterCarService indicates an expected call of RegisterCarService.
+func (mr *MockHandoversControllerMockRecorder) RegisterCarService(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCarService", reflect.TypeOf((*MockHandoversController)(nil).RegisterCarService), w, r)
+}
+
+// MockLateReturnsController is a mock of LateReturnsController interface.
+type MockLateReturnsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockLateReturnsControllerMockRecorder
+}
+
+// MockLateReturnsControllerMockRecorder is the mock recorder for MockLateReturnsController.
+type MockLateReturnsControllerMockRecorder struct {
+	mock *MockLateReturnsController
+}
+
+// NewMockLateReturnsController creates a new mock instance.
+func NewMockLateReturnsController(ctrl *gomock.Controller) *MockLateReturnsController {
+	mock := &MockLateReturnsController{ctrl: ctrl}
+	mock.recorder = &MockLateReturnsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLateReturnsController) EXPECT() *MockLateReturnsControllerMockRecorder {
+	return m.recorder
+}
+
+// ListOverdue mocks base method.
+func (m *MockLateReturnsController) ListOverdue(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListOverdue", w, r)
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockLateReturnsControllerMockRecorder) ListOverdue(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockLateReturnsController)(nil).ListOverdue), w, r)
+}
+
+// NotifyOverdue mocks base method.
+func (m *MockLateReturnsController) NotifyOverdue(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyOverdue", w, r)
+}
+
+// NotifyOverdue indicates an expected call of NotifyOverdue.
+func (mr *MockLateReturnsControllerMockRecorder) NotifyOverdue(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyOverdue", reflect.TypeOf((*MockLateReturnsController)(nil).NotifyOverdue), w, r)
+}
+
+// MockDamageReportsController is a mock of DamageReportsController interface.
+type MockDamageReportsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportsControllerMockRecorder
+}
+
+// MockDamageReportsControllerMockRecorder is the mock recorder for MockDamageReportsController.
+type MockDamageReportsControllerMockRecorder struct {
+	mock *MockDamageReportsController
+}
+
+// NewMockDamageReportsController creates a new mock instance.
+func NewMockDamageReportsController(ctrl *gomock.Controller) *MockDamageReportsController {
+	mock := &MockDamageReportsController{ctrl: ctrl}
+	mock.recorder = &MockDamageReportsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportsController) EXPECT() *MockDamageReportsControllerMockRecorder {
+	return m.recorder
+}
+
+// AddPhotos mocks base method.
+func (m *MockDamageReportsController) AddPhotos(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddPhotos", w, r)
+}
+
+// AddPhotos indicates an expected call of AddPhotos.
+func (mr *MockDamageReportsControllerMockRecorder) AddPhotos(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPhotos", reflect.TypeOf((*MockDamageReportsController)(nil).AddPhotos), w, r)
+}
+
+// File mocks base method.
+func (m *MockDamageReportsController) File(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "File", w, r)
+}
+
+// File indicates an expected call of File.
+func (mr *MockDamageReportsControllerMockRecorder) File(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "File", reflect.TypeOf((*MockDamageReportsController)(nil).File), w, r)
+}
+
+// Get mocks base method.
+func (m *MockDamageReportsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDamageReportsControllerMockRecorder) Get(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDamageReportsController)(nil).Get), w, r)
+}
+
+// GetPhoto mocks base method.
+func (m *MockDamageReportsController) GetPhoto(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPhoto", w, r)
+}
+
+// GetPhoto indicates an expected call of GetPhoto.
+func (mr *MockDamageReportsControllerMockRecorder) GetPhoto(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoto", reflect.TypeOf((*MockDamageReportsController)(nil).GetPhoto), w, r)
+}
+
+// GetThumbnail mocks base method.
+func (m *MockDamageReportsController) GetThumbnail(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetThumbnail", w, r)
+}
+
+// GetThumbnail indicates an expected call of GetThumbnail.
+func (mr *MockDamageReportsControllerMockRecorder) GetThumbnail(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThumbnail", reflect.TypeOf((*MockDamageReportsController)(nil).GetThumbnail), w, r)
+}
+
+// ListByCarID mocks base method.
+func (m *MockDamageReportsController) ListByCarID(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListByCarID", w, r)
+}
+
+// ListByCarID indicates an expected call of ListByCarID.
+func (mr *MockDamageReportsControllerMockRecorder) ListByCarID(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCarID", reflect.TypeOf((*MockDamageReportsController)(nil).ListByCarID), w, r)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockDamageReportsController) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateStatus", w, r)
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockDamageReportsControllerMockRecorder) UpdateStatus(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockDamageReportsController)(nil).UpdateStatus), w, r)
 }
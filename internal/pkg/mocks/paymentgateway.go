@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/paymentgateway.go
+
+// Package mock_ports is a generated GoMock package.
+package mock_ports
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Edigiraldo/car-rent/internal/core/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentGateway is a mock of PaymentGateway interface.
+type MockPaymentGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentGatewayMockRecorder
+}
+
+// MockPaymentGatewayMockRecorder is the mock recorder for MockPaymentGateway.
+type MockPaymentGatewayMockRecorder struct {
+	mock *MockPaymentGateway
+}
+
+// NewMockPaymentGateway creates a new mock instance.
+func NewMockPaymentGateway(ctrl *gomock.Controller) *MockPaymentGateway {
+	mock := &MockPaymentGateway{ctrl: ctrl}
+	mock.recorder = &MockPaymentGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentGateway) EXPECT() *MockPaymentGatewayMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPaymentGateway) Authorize(ctx context.Context, amount domain.Money, paymentMethod string) (domain.PaymentAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, amount, paymentMethod)
+	ret0, _ := ret[0].(domain.PaymentAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPaymentGatewayMockRecorder) Authorize(ctx, amount, paymentMethod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPaymentGateway)(nil).Authorize), ctx, amount, paymentMethod)
+}
+
+// Capture mocks base method.
+func (m *MockPaymentGateway) Capture(ctx context.Context, reference string, amount domain.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, reference, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockPaymentGatewayMockRecorder) Capture(ctx, reference, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockPaymentGateway)(nil).Capture), ctx, reference, amount)
+}
+
+// ParseEvent mocks base method.
+func (m *MockPaymentGateway) ParseEvent(payload []byte, signature string) (domain.PaymentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseEvent", payload, signature)
+	ret0, _ := ret[0].(domain.PaymentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseEvent indicates an expected call of ParseEvent.
+func (mr *MockPaymentGatewayMockRecorder) ParseEvent(payload, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseEvent", reflect.TypeOf((*MockPaymentGateway)(nil).ParseEvent), payload, signature)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentGateway) Refund(ctx context.Context, reference string, amount domain.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, reference, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentGatewayMockRecorder) Refund(ctx, reference, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentGateway)(nil).Refund), ctx, reference, amount)
+}
+
+// Void mocks base method.
+func (m *MockPaymentGateway) Void(ctx context.Context, reference string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, reference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentGatewayMockRecorder) Void(ctx, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentGateway)(nil).Void), ctx, reference)
+}
@@ -11,6 +11,7 @@ import (
 	time "time"
 
 	domain "github.com/Edigiraldo/car-rent/internal/core/domain"
+	ports "github.com/Edigiraldo/car-rent/internal/core/ports"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -147,18 +148,33 @@ func (mr *MockCarsRepoMockRecorder) Insert(ctx, dc interface{}) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockCarsRepo) List(ctx context.Context, cityName, from_car_id string, limit uint16) ([]domain.Car, error) {
+func (m *MockCarsRepo) List(ctx context.Context, cityName string, filters domain.CarFilters, from_car_id string, limit uint16) ([]domain.Car, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, cityName, from_car_id, limit)
+	ret := m.ctrl.Call(m, "List", ctx, cityName, filters, from_car_id, limit)
 	ret0, _ := ret[0].([]domain.Car)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockCarsRepoMockRecorder) List(ctx, cityName, from_car_id, limit interface{}) *gomock.Call {
+func (mr *MockCarsRepoMockRecorder) List(ctx, cityName, filters, from_car_id, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCarsRepo)(nil).List), ctx, cityName, from_car_id, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCarsRepo)(nil).List), ctx, cityName, filters, from_car_id, limit)
+}
+
+// ListAvailableInArea mocks base method.
+func (m *MockCarsRepo) ListAvailableInArea(ctx context.Context, bounds domain.GeoBounds, status string, startDate, endDate time.Time) ([]domain.NearbyCar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailableInArea", ctx, bounds, status, startDate, endDate)
+	ret0, _ := ret[0].([]domain.NearbyCar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailableInArea indicates an expected call of ListAvailableInArea.
+func (mr *MockCarsRepoMockRecorder) ListAvailableInArea(ctx, bounds, status, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailableInArea", reflect.TypeOf((*MockCarsRepo)(nil).ListAvailableInArea), ctx, bounds, status, startDate, endDate)
 }
 
 // MockUsersRepo is a mock of UsersRepo interface.
@@ -227,6 +243,21 @@ func (mr *MockUsersRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersRepo)(nil).Get), ctx, ID)
 }
 
+// GetByEmail mocks base method.
+func (m *MockUsersRepo) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUsersRepoMockRecorder) GetByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUsersRepo)(nil).GetByEmail), ctx, email)
+}
+
 // Insert mocks base method.
 func (m *MockUsersRepo) Insert(ctx context.Context, du domain.User) error {
 	m.ctrl.T.Helper()
@@ -241,6 +272,101 @@ func (mr *MockUsersRepoMockRecorder) Insert(ctx, du interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUsersRepo)(nil).Insert), ctx, du)
 }
 
+// List mocks base method.
+func (m *MockUsersRepo) List(ctx context.Context, filters domain.UserFilters, fromUserID string, limit uint16) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, fromUserID, limit)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUsersRepoMockRecorder) List(ctx, filters, fromUserID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsersRepo)(nil).List), ctx, filters, fromUserID, limit)
+}
+
+// MockUserTokensRepo is a mock of UserTokensRepo interface.
+type MockUserTokensRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTokensRepoMockRecorder
+}
+
+// MockUserTokensRepoMockRecorder is the mock recorder for MockUserTokensRepo.
+type MockUserTokensRepoMockRecorder struct {
+	mock *MockUserTokensRepo
+}
+
+// NewMockUserTokensRepo creates a new mock instance.
+func NewMockUserTokensRepo(ctrl *gomock.Controller) *MockUserTokensRepo {
+	mock := &MockUserTokensRepo{ctrl: ctrl}
+	mock.recorder = &MockUserTokensRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTokensRepo) EXPECT() *MockUserTokensRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUserTokensRepo) Get(ctx context.Context, ID uuid.UUID) (domain.UserToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.UserToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserTokensRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserTokensRepo)(nil).Get), ctx, ID)
+}
+
+// Insert mocks base method.
+func (m *MockUserTokensRepo) Insert(ctx context.Context, dut domain.UserToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockUserTokensRepoMockRecorder) Insert(ctx, dut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUserTokensRepo)(nil).Insert), ctx, dut)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserTokensRepo) ResetPassword(ctx context.Context, dut domain.UserToken, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, dut, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserTokensRepoMockRecorder) ResetPassword(ctx, dut, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserTokensRepo)(nil).ResetPassword), ctx, dut, passwordHash)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUserTokensRepo) VerifyEmail(ctx context.Context, dut domain.UserToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, dut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserTokensRepoMockRecorder) VerifyEmail(ctx, dut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserTokensRepo)(nil).VerifyEmail), ctx, dut)
+}
+
 // MockCitiesRepo is a mock of CitiesRepo interface.
 type MockCitiesRepo struct {
 	ctrl     *gomock.Controller
@@ -264,6 +390,64 @@ func (m *MockCitiesRepo) EXPECT() *MockCitiesRepoMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockCitiesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCitiesRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCitiesRepo)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockCitiesRepo) FullUpdate(ctx context.Context, dc domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockCitiesRepoMockRecorder) FullUpdate(ctx, dc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockCitiesRepo)(nil).FullUpdate), ctx, dc)
+}
+
+// Get mocks base method.
+func (m *MockCitiesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCitiesRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCitiesRepo)(nil).Get), ctx, ID)
+}
+
+// GetByCarID mocks base method.
+func (m *MockCitiesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].(domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockCitiesRepoMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockCitiesRepo)(nil).GetByCarID), ctx, carID)
+}
+
 // GetIdByName mocks base method.
 func (m *MockCitiesRepo) GetIdByName(ctx context.Context, name string) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -294,46 +478,75 @@ func (mr *MockCitiesRepoMockRecorder) GetNameByID(ctx, ID interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameByID", reflect.TypeOf((*MockCitiesRepo)(nil).GetNameByID), ctx, ID)
 }
 
-// ListNames mocks base method.
-func (m *MockCitiesRepo) ListNames(ctx context.Context) ([]string, error) {
+// GetTimeZonesByCarIDs mocks base method.
+func (m *MockCitiesRepo) GetTimeZonesByCarIDs(ctx context.Context, carIDs []uuid.UUID) (map[uuid.UUID]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNames", ctx)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetTimeZonesByCarIDs", ctx, carIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNames indicates an expected call of ListNames.
-func (mr *MockCitiesRepoMockRecorder) ListNames(ctx interface{}) *gomock.Call {
+// GetTimeZonesByCarIDs indicates an expected call of GetTimeZonesByCarIDs.
+func (mr *MockCitiesRepoMockRecorder) GetTimeZonesByCarIDs(ctx, carIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNames", reflect.TypeOf((*MockCitiesRepo)(nil).ListNames), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeZonesByCarIDs", reflect.TypeOf((*MockCitiesRepo)(nil).GetTimeZonesByCarIDs), ctx, carIDs)
 }
 
-// MockReservationsRepo is a mock of ReservationsRepo interface.
-type MockReservationsRepo struct {
+// Insert mocks base method.
+func (m *MockCitiesRepo) Insert(ctx context.Context, dc domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCitiesRepoMockRecorder) Insert(ctx, dc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCitiesRepo)(nil).Insert), ctx, dc)
+}
+
+// List mocks base method.
+func (m *MockCitiesRepo) List(ctx context.Context, fromCityID string, limit uint16) ([]domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, fromCityID, limit)
+	ret0, _ := ret[0].([]domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCitiesRepoMockRecorder) List(ctx, fromCityID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCitiesRepo)(nil).List), ctx, fromCityID, limit)
+}
+
+// MockBranchesRepo is a mock of BranchesRepo interface.
+type MockBranchesRepo struct {
 	ctrl     *gomock.Controller
-	recorder *MockReservationsRepoMockRecorder
+	recorder *MockBranchesRepoMockRecorder
 }
 
-// MockReservationsRepoMockRecorder is the mock recorder for MockReservationsRepo.
-type MockReservationsRepoMockRecorder struct {
-	mock *MockReservationsRepo
+// MockBranchesRepoMockRecorder is the mock recorder for MockBranchesRepo.
+type MockBranchesRepoMockRecorder struct {
+	mock *MockBranchesRepo
 }
 
-// NewMockReservationsRepo creates a new mock instance.
-func NewMockReservationsRepo(ctrl *gomock.Controller) *MockReservationsRepo {
-	mock := &MockReservationsRepo{ctrl: ctrl}
-	mock.recorder = &MockReservationsRepoMockRecorder{mock}
+// NewMockBranchesRepo creates a new mock instance.
+func NewMockBranchesRepo(ctrl *gomock.Controller) *MockBranchesRepo {
+	mock := &MockBranchesRepo{ctrl: ctrl}
+	mock.recorder = &MockBranchesRepoMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockReservationsRepo) EXPECT() *MockReservationsRepoMockRecorder {
+func (m *MockBranchesRepo) EXPECT() *MockBranchesRepoMockRecorder {
 	return m.recorder
 }
 
 // Delete mocks base method.
-func (m *MockReservationsRepo) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockBranchesRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
@@ -341,110 +554,1736 @@ func (m *MockReservationsRepo) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockReservationsRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockBranchesRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsRepo)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBranchesRepo)(nil).Delete), ctx, id)
 }
 
 // FullUpdate mocks base method.
-func (m *MockReservationsRepo) FullUpdate(ctx context.Context, dr domain.Reservation) error {
+func (m *MockBranchesRepo) FullUpdate(ctx context.Context, db domain.Branch) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullUpdate", ctx, dr)
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, db)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // FullUpdate indicates an expected call of FullUpdate.
-func (mr *MockReservationsRepoMockRecorder) FullUpdate(ctx, dr interface{}) *gomock.Call {
+func (mr *MockBranchesRepoMockRecorder) FullUpdate(ctx, db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsRepo)(nil).FullUpdate), ctx, dr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockBranchesRepo)(nil).FullUpdate), ctx, db)
 }
 
 // Get mocks base method.
-func (m *MockReservationsRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Reservation, error) {
+func (m *MockBranchesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Branch, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, ID)
-	ret0, _ := ret[0].(domain.Reservation)
+	ret0, _ := ret[0].(domain.Branch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockReservationsRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+func (mr *MockBranchesRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsRepo)(nil).Get), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBranchesRepo)(nil).Get), ctx, ID)
 }
 
 // GetByCarID mocks base method.
-func (m *MockReservationsRepo) GetByCarID(ctx context.Context, CarID uuid.UUID) ([]domain.Reservation, error) {
+func (m *MockBranchesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) (domain.Branch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByCarID", ctx, CarID)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].(domain.Branch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByCarID indicates an expected call of GetByCarID.
-func (mr *MockReservationsRepoMockRecorder) GetByCarID(ctx, CarID interface{}) *gomock.Call {
+func (mr *MockBranchesRepoMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsRepo)(nil).GetByCarID), ctx, CarID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockBranchesRepo)(nil).GetByCarID), ctx, carID)
 }
 
-// GetByCarIDAndTimeFrame mocks base method.
-func (m *MockReservationsRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate, endDate time.Time) ([]domain.Reservation, error) {
+// Insert mocks base method.
+func (m *MockBranchesRepo) Insert(ctx context.Context, db domain.Branch) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByCarIDAndTimeFrame", ctx, carID, startDate, endDate)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "Insert", ctx, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockBranchesRepoMockRecorder) Insert(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockBranchesRepo)(nil).Insert), ctx, db)
+}
+
+// ListByCityID mocks base method.
+func (m *MockBranchesRepo) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, cityID)
+	ret0, _ := ret[0].([]domain.Branch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByCarIDAndTimeFrame indicates an expected call of GetByCarIDAndTimeFrame.
-func (mr *MockReservationsRepoMockRecorder) GetByCarIDAndTimeFrame(ctx, carID, startDate, endDate interface{}) *gomock.Call {
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockBranchesRepoMockRecorder) ListByCityID(ctx, cityID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarIDAndTimeFrame", reflect.TypeOf((*MockReservationsRepo)(nil).GetByCarIDAndTimeFrame), ctx, carID, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockBranchesRepo)(nil).ListByCityID), ctx, cityID)
 }
 
-// GetByUserID mocks base method.
-func (m *MockReservationsRepo) GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+// MockAddOnsRepo is a mock of AddOnsRepo interface.
+type MockAddOnsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddOnsRepoMockRecorder
+}
+
+// MockAddOnsRepoMockRecorder is the mock recorder for MockAddOnsRepo.
+type MockAddOnsRepoMockRecorder struct {
+	mock *MockAddOnsRepo
+}
+
+// NewMockAddOnsRepo creates a new mock instance.
+func NewMockAddOnsRepo(ctrl *gomock.Controller) *MockAddOnsRepo {
+	mock := &MockAddOnsRepo{ctrl: ctrl}
+	mock.recorder = &MockAddOnsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddOnsRepo) EXPECT() *MockAddOnsRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAddOnsRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAddOnsRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddOnsRepo)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockAddOnsRepo) FullUpdate(ctx context.Context, dao domain.AddOn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dao)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockAddOnsRepoMockRecorder) FullUpdate(ctx, dao interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockAddOnsRepo)(nil).FullUpdate), ctx, dao)
+}
+
+// Get mocks base method.
+func (m *MockAddOnsRepo) Get(ctx context.Context, ID uuid.UUID) (domain.AddOn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.AddOn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockReservationsRepoMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockAddOnsRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsRepo)(nil).GetByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddOnsRepo)(nil).Get), ctx, ID)
+}
+
+// GetBookings mocks base method.
+func (m *MockAddOnsRepo) GetBookings(ctx context.Context, addOnID, excludedReservationID uuid.UUID, startDate, endDate time.Time) ([]domain.AddOnBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookings", ctx, addOnID, excludedReservationID, startDate, endDate)
+	ret0, _ := ret[0].([]domain.AddOnBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookings indicates an expected call of GetBookings.
+func (mr *MockAddOnsRepoMockRecorder) GetBookings(ctx, addOnID, excludedReservationID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookings", reflect.TypeOf((*MockAddOnsRepo)(nil).GetBookings), ctx, addOnID, excludedReservationID, startDate, endDate)
 }
 
 // Insert mocks base method.
-func (m *MockReservationsRepo) Insert(ctx context.Context, dr domain.Reservation) error {
+func (m *MockAddOnsRepo) Insert(ctx context.Context, dao domain.AddOn) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, dr)
+	ret := m.ctrl.Call(m, "Insert", ctx, dao)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockReservationsRepoMockRecorder) Insert(ctx, dr interface{}) *gomock.Call {
+func (mr *MockAddOnsRepoMockRecorder) Insert(ctx, dao interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockReservationsRepo)(nil).Insert), ctx, dr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAddOnsRepo)(nil).Insert), ctx, dao)
 }
 
-// List mocks base method.
-func (m *MockReservationsRepo) List(ctx context.Context, fromReservationID string, startDate, endDate time.Time, limit uint16) ([]domain.Reservation, error) {
+// ListByBranchID mocks base method.
+func (m *MockAddOnsRepo) ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, fromReservationID, startDate, endDate, limit)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "ListByBranchID", ctx, branchID)
+	ret0, _ := ret[0].([]domain.AddOn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockReservationsRepoMockRecorder) List(ctx, fromReservationID, startDate, endDate, limit interface{}) *gomock.Call {
+// ListByBranchID indicates an expected call of ListByBranchID.
+func (mr *MockAddOnsRepoMockRecorder) ListByBranchID(ctx, branchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsRepo)(nil).List), ctx, fromReservationID, startDate, endDate, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBranchID", reflect.TypeOf((*MockAddOnsRepo)(nil).ListByBranchID), ctx, branchID)
+}
+
+// MockProtectionPlansRepo is a mock of ProtectionPlansRepo interface.
+type MockProtectionPlansRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProtectionPlansRepoMockRecorder
+}
+
+// MockProtectionPlansRepoMockRecorder is the mock recorder for MockProtectionPlansRepo.
+type MockProtectionPlansRepoMockRecorder struct {
+	mock *MockProtectionPlansRepo
+}
+
+// NewMockProtectionPlansRepo creates a new mock instance.
+func NewMockProtectionPlansRepo(ctrl *gomock.Controller) *MockProtectionPlansRepo {
+	mock := &MockProtectionPlansRepo{ctrl: ctrl}
+	mock.recorder = &MockProtectionPlansRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProtectionPlansRepo) EXPECT() *MockProtectionPlansRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockProtectionPlansRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProtectionPlansRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProtectionPlansRepo)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockProtectionPlansRepo) FullUpdate(ctx context.Context, dpp domain.ProtectionPlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dpp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockProtectionPlansRepoMockRecorder) FullUpdate(ctx, dpp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockProtectionPlansRepo)(nil).FullUpdate), ctx, dpp)
+}
+
+// Get mocks base method.
+func (m *MockProtectionPlansRepo) Get(ctx context.Context, ID uuid.UUID) (domain.ProtectionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.ProtectionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProtectionPlansRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProtectionPlansRepo)(nil).Get), ctx, ID)
+}
+
+// GetByLevelAndCarType mocks base method.
+func (m *MockProtectionPlansRepo) GetByLevelAndCarType(ctx context.Context, level, carType string) (domain.ProtectionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLevelAndCarType", ctx, level, carType)
+	ret0, _ := ret[0].(domain.ProtectionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLevelAndCarType indicates an expected call of GetByLevelAndCarType.
+func (mr *MockProtectionPlansRepoMockRecorder) GetByLevelAndCarType(ctx, level, carType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLevelAndCarType", reflect.TypeOf((*MockProtectionPlansRepo)(nil).GetByLevelAndCarType), ctx, level, carType)
+}
+
+// Insert mocks base method.
+func (m *MockProtectionPlansRepo) Insert(ctx context.Context, dpp domain.ProtectionPlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dpp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockProtectionPlansRepoMockRecorder) Insert(ctx, dpp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockProtectionPlansRepo)(nil).Insert), ctx, dpp)
+}
+
+// List mocks base method.
+func (m *MockProtectionPlansRepo) List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, carType)
+	ret0, _ := ret[0].([]domain.ProtectionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProtectionPlansRepoMockRecorder) List(ctx, carType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProtectionPlansRepo)(nil).List), ctx, carType)
+}
+
+// MockTaxRulesRepo is a mock of TaxRulesRepo interface.
+type MockTaxRulesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRulesRepoMockRecorder
+}
+
+// MockTaxRulesRepoMockRecorder is the mock recorder for MockTaxRulesRepo.
+type MockTaxRulesRepoMockRecorder struct {
+	mock *MockTaxRulesRepo
+}
+
+// NewMockTaxRulesRepo creates a new mock instance.
+func NewMockTaxRulesRepo(ctrl *gomock.Controller) *MockTaxRulesRepo {
+	mock := &MockTaxRulesRepo{ctrl: ctrl}
+	mock.recorder = &MockTaxRulesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRulesRepo) EXPECT() *MockTaxRulesRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTaxRulesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRulesRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRulesRepo)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockTaxRulesRepo) FullUpdate(ctx context.Context, dtr domain.TaxRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dtr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockTaxRulesRepoMockRecorder) FullUpdate(ctx, dtr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockTaxRulesRepo)(nil).FullUpdate), ctx, dtr)
+}
+
+// Get mocks base method.
+func (m *MockTaxRulesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaxRulesRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaxRulesRepo)(nil).Get), ctx, ID)
+}
+
+// Insert mocks base method.
+func (m *MockTaxRulesRepo) Insert(ctx context.Context, dtr domain.TaxRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dtr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockTaxRulesRepoMockRecorder) Insert(ctx, dtr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTaxRulesRepo)(nil).Insert), ctx, dtr)
+}
+
+// ListByCityID mocks base method.
+func (m *MockTaxRulesRepo) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, cityID)
+	ret0, _ := ret[0].([]domain.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockTaxRulesRepoMockRecorder) ListByCityID(ctx, cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockTaxRulesRepo)(nil).ListByCityID), ctx, cityID)
+}
+
+// MockExchangeRatesRepo is a mock of ExchangeRatesRepo interface.
+type MockExchangeRatesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesRepoMockRecorder
+}
+
+// MockExchangeRatesRepoMockRecorder is the mock recorder for MockExchangeRatesRepo.
+type MockExchangeRatesRepoMockRecorder struct {
+	mock *MockExchangeRatesRepo
+}
+
+// NewMockExchangeRatesRepo creates a new mock instance.
+func NewMockExchangeRatesRepo(ctrl *gomock.Controller) *MockExchangeRatesRepo {
+	mock := &MockExchangeRatesRepo{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRatesRepo) EXPECT() *MockExchangeRatesRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRatesRepo) Delete(ctx context.Context, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRatesRepoMockRecorder) Delete(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRatesRepo)(nil).Delete), ctx, from, to)
+}
+
+// Get mocks base method.
+func (m *MockExchangeRatesRepo) Get(ctx context.Context, from, to string) (domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, from, to)
+	ret0, _ := ret[0].(domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExchangeRatesRepoMockRecorder) Get(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExchangeRatesRepo)(nil).Get), ctx, from, to)
+}
+
+// List mocks base method.
+func (m *MockExchangeRatesRepo) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockExchangeRatesRepoMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExchangeRatesRepo)(nil).List), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockExchangeRatesRepo) Upsert(ctx context.Context, der domain.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, der)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExchangeRatesRepoMockRecorder) Upsert(ctx, der interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExchangeRatesRepo)(nil).Upsert), ctx, der)
+}
+
+// MockReservationsRepo is a mock of ReservationsRepo interface.
+type MockReservationsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsRepoMockRecorder
+}
+
+// MockReservationsRepoMockRecorder is the mock recorder for MockReservationsRepo.
+type MockReservationsRepoMockRecorder struct {
+	mock *MockReservationsRepo
+}
+
+// NewMockReservationsRepo creates a new mock instance.
+func NewMockReservationsRepo(ctrl *gomock.Controller) *MockReservationsRepo {
+	mock := &MockReservationsRepo{ctrl: ctrl}
+	mock.recorder = &MockReservationsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsRepo) EXPECT() *MockReservationsRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockReservationsRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReservationsRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsRepo)(nil).Delete), ctx, id)
+}
+
+// Extend mocks base method.
+func (m *MockReservationsRepo) Extend(ctx context.Context, dr domain.Reservation, previousEndDate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, dr, previousEndDate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockReservationsRepoMockRecorder) Extend(ctx, dr, previousEndDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockReservationsRepo)(nil).Extend), ctx, dr, previousEndDate)
+}
+
+// FullUpdate mocks base method.
+func (m *MockReservationsRepo) FullUpdate(ctx context.Context, dr domain.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockReservationsRepoMockRecorder) FullUpdate(ctx, dr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsRepo)(nil).FullUpdate), ctx, dr)
+}
+
+// Get mocks base method.
+func (m *MockReservationsRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservationsRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsRepo)(nil).Get), ctx, ID)
+}
+
+// GetByCarID mocks base method.
+func (m *MockReservationsRepo) GetByCarID(ctx context.Context, CarID uuid.UUID) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, CarID)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockReservationsRepoMockRecorder) GetByCarID(ctx, CarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsRepo)(nil).GetByCarID), ctx, CarID)
+}
+
+// GetByCarIDAndTimeFrame mocks base method.
+func (m *MockReservationsRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate, endDate time.Time) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarIDAndTimeFrame", ctx, carID, startDate, endDate)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarIDAndTimeFrame indicates an expected call of GetByCarIDAndTimeFrame.
+func (mr *MockReservationsRepoMockRecorder) GetByCarIDAndTimeFrame(ctx, carID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarIDAndTimeFrame", reflect.TypeOf((*MockReservationsRepo)(nil).GetByCarIDAndTimeFrame), ctx, carID, startDate, endDate)
+}
+
+// GetByUserID mocks base method.
+func (m *MockReservationsRepo) GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockReservationsRepoMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsRepo)(nil).GetByUserID), ctx, userID)
+}
+
+// GetNextByCarID mocks base method.
+func (m *MockReservationsRepo) GetNextByCarID(ctx context.Context, carID, excludedID uuid.UUID, from time.Time) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextByCarID", ctx, carID, excludedID, from)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextByCarID indicates an expected call of GetNextByCarID.
+func (mr *MockReservationsRepoMockRecorder) GetNextByCarID(ctx, carID, excludedID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextByCarID", reflect.TypeOf((*MockReservationsRepo)(nil).GetNextByCarID), ctx, carID, excludedID, from)
+}
+
+// GetPreviousByCarID mocks base method.
+func (m *MockReservationsRepo) GetPreviousByCarID(ctx context.Context, carID, excludedID uuid.UUID, from, to time.Time) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousByCarID", ctx, carID, excludedID, from, to)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousByCarID indicates an expected call of GetPreviousByCarID.
+func (mr *MockReservationsRepoMockRecorder) GetPreviousByCarID(ctx, carID, excludedID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousByCarID", reflect.TypeOf((*MockReservationsRepo)(nil).GetPreviousByCarID), ctx, carID, excludedID, from, to)
+}
+
+// Insert mocks base method.
+func (m *MockReservationsRepo) Insert(ctx context.Context, dr domain.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockReservationsRepoMockRecorder) Insert(ctx, dr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockReservationsRepo)(nil).Insert), ctx, dr)
+}
+
+// List mocks base method.
+func (m *MockReservationsRepo) List(ctx context.Context, fromReservationID string, startDate, endDate time.Time, limit uint16) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, fromReservationID, startDate, endDate, limit)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservationsRepoMockRecorder) List(ctx, fromReservationID, startDate, endDate, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsRepo)(nil).List), ctx, fromReservationID, startDate, endDate, limit)
+}
+
+// ListOverdue mocks base method.
+func (m *MockReservationsRepo) ListOverdue(ctx context.Context, status string, dueBefore time.Time) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx, status, dueBefore)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockReservationsRepoMockRecorder) ListOverdue(ctx, status, dueBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockReservationsRepo)(nil).ListOverdue), ctx, status, dueBefore)
+}
+
+// MockPaymentsRepo is a mock of PaymentsRepo interface.
+type MockPaymentsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentsRepoMockRecorder
+}
+
+// MockPaymentsRepoMockRecorder is the mock recorder for MockPaymentsRepo.
+type MockPaymentsRepoMockRecorder struct {
+	mock *MockPaymentsRepo
+}
+
+// NewMockPaymentsRepo creates a new mock instance.
+func NewMockPaymentsRepo(ctrl *gomock.Controller) *MockPaymentsRepo {
+	mock := &MockPaymentsRepo{ctrl: ctrl}
+	mock.recorder = &MockPaymentsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentsRepo) EXPECT() *MockPaymentsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReference mocks base method.
+func (m *MockPaymentsRepo) GetByReference(ctx context.Context, reference string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReference", ctx, reference)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReference indicates an expected call of GetByReference.
+func (mr *MockPaymentsRepoMockRecorder) GetByReference(ctx, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReference", reflect.TypeOf((*MockPaymentsRepo)(nil).GetByReference), ctx, reference)
+}
+
+// GetLatestByReservationID mocks base method.
+func (m *MockPaymentsRepo) GetLatestByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestByReservationID indicates an expected call of GetLatestByReservationID.
+func (mr *MockPaymentsRepoMockRecorder) GetLatestByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestByReservationID", reflect.TypeOf((*MockPaymentsRepo)(nil).GetLatestByReservationID), ctx, reservationID)
+}
+
+// Insert mocks base method.
+func (m *MockPaymentsRepo) Insert(ctx context.Context, dp domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockPaymentsRepoMockRecorder) Insert(ctx, dp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPaymentsRepo)(nil).Insert), ctx, dp)
+}
+
+// Update mocks base method.
+func (m *MockPaymentsRepo) Update(ctx context.Context, dp, previous domain.Payment, entries []domain.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dp, previous, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPaymentsRepoMockRecorder) Update(ctx, dp, previous, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPaymentsRepo)(nil).Update), ctx, dp, previous, entries)
+}
+
+// MockDepositsRepo is a mock of DepositsRepo interface.
+type MockDepositsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositsRepoMockRecorder
+}
+
+// MockDepositsRepoMockRecorder is the mock recorder for MockDepositsRepo.
+type MockDepositsRepoMockRecorder struct {
+	mock *MockDepositsRepo
+}
+
+// NewMockDepositsRepo creates a new mock instance.
+func NewMockDepositsRepo(ctrl *gomock.Controller) *MockDepositsRepo {
+	mock := &MockDepositsRepo{ctrl: ctrl}
+	mock.recorder = &MockDepositsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositsRepo) EXPECT() *MockDepositsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReservationID mocks base method.
+func (m *MockDepositsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockDepositsRepoMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockDepositsRepo)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// Update mocks base method.
+func (m *MockDepositsRepo) Update(ctx context.Context, dd domain.Deposit, previousStatus string, entries []domain.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dd, previousStatus, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDepositsRepoMockRecorder) Update(ctx, dd, previousStatus, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDepositsRepo)(nil).Update), ctx, dd, previousStatus, entries)
+}
+
+// MockLedgerRepo is a mock of LedgerRepo interface.
+type MockLedgerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepoMockRecorder
+}
+
+// MockLedgerRepoMockRecorder is the mock recorder for MockLedgerRepo.
+type MockLedgerRepoMockRecorder struct {
+	mock *MockLedgerRepo
+}
+
+// NewMockLedgerRepo creates a new mock instance.
+func NewMockLedgerRepo(ctrl *gomock.Controller) *MockLedgerRepo {
+	mock := &MockLedgerRepo{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepo) EXPECT() *MockLedgerRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReservationID mocks base method.
+func (m *MockLedgerRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) ([]domain.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].([]domain.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockLedgerRepoMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockLedgerRepo)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// MockInvoicesRepo is a mock of InvoicesRepo interface.
+type MockInvoicesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoicesRepoMockRecorder
+}
+
+// MockInvoicesRepoMockRecorder is the mock recorder for MockInvoicesRepo.
+type MockInvoicesRepoMockRecorder struct {
+	mock *MockInvoicesRepo
+}
+
+// NewMockInvoicesRepo creates a new mock instance.
+func NewMockInvoicesRepo(ctrl *gomock.Controller) *MockInvoicesRepo {
+	mock := &MockInvoicesRepo{ctrl: ctrl}
+	mock.recorder = &MockInvoicesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoicesRepo) EXPECT() *MockInvoicesRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockInvoicesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoicesRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoicesRepo)(nil).Get), ctx, ID)
+}
+
+// GetByReservationID mocks base method.
+func (m *MockInvoicesRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockInvoicesRepoMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockInvoicesRepo)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// Insert mocks base method.
+func (m *MockInvoicesRepo) Insert(ctx context.Context, di domain.Invoice) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, di)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockInvoicesRepoMockRecorder) Insert(ctx, di interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockInvoicesRepo)(nil).Insert), ctx, di)
+}
+
+// MockBillingProfilesRepo is a mock of BillingProfilesRepo interface.
+type MockBillingProfilesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillingProfilesRepoMockRecorder
+}
+
+// MockBillingProfilesRepoMockRecorder is the mock recorder for MockBillingProfilesRepo.
+type MockBillingProfilesRepoMockRecorder struct {
+	mock *MockBillingProfilesRepo
+}
+
+// NewMockBillingProfilesRepo creates a new mock instance.
+func NewMockBillingProfilesRepo(ctrl *gomock.Controller) *MockBillingProfilesRepo {
+	mock := &MockBillingProfilesRepo{ctrl: ctrl}
+	mock.recorder = &MockBillingProfilesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBillingProfilesRepo) EXPECT() *MockBillingProfilesRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockBillingProfilesRepo) Get(ctx context.Context, userID uuid.UUID) (domain.BillingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(domain.BillingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBillingProfilesRepoMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBillingProfilesRepo)(nil).Get), ctx, userID)
+}
+
+// Upsert mocks base method.
+func (m *MockBillingProfilesRepo) Upsert(ctx context.Context, dbp domain.BillingProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, dbp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockBillingProfilesRepoMockRecorder) Upsert(ctx, dbp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockBillingProfilesRepo)(nil).Upsert), ctx, dbp)
+}
+
+// MockOneWayFeesRepo is a mock of OneWayFeesRepo interface.
+type MockOneWayFeesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneWayFeesRepoMockRecorder
+}
+
+// MockOneWayFeesRepoMockRecorder is the mock recorder for MockOneWayFeesRepo.
+type MockOneWayFeesRepoMockRecorder struct {
+	mock *MockOneWayFeesRepo
+}
+
+// NewMockOneWayFeesRepo creates a new mock instance.
+func NewMockOneWayFeesRepo(ctrl *gomock.Controller) *MockOneWayFeesRepo {
+	mock := &MockOneWayFeesRepo{ctrl: ctrl}
+	mock.recorder = &MockOneWayFeesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOneWayFeesRepo) EXPECT() *MockOneWayFeesRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockOneWayFeesRepo) Delete(ctx context.Context, fromCityID, toCityID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, fromCityID, toCityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOneWayFeesRepoMockRecorder) Delete(ctx, fromCityID, toCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOneWayFeesRepo)(nil).Delete), ctx, fromCityID, toCityID)
+}
+
+// Get mocks base method.
+func (m *MockOneWayFeesRepo) Get(ctx context.Context, fromCityID, toCityID uuid.UUID) (domain.OneWayFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, fromCityID, toCityID)
+	ret0, _ := ret[0].(domain.OneWayFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOneWayFeesRepoMockRecorder) Get(ctx, fromCityID, toCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOneWayFeesRepo)(nil).Get), ctx, fromCityID, toCityID)
+}
+
+// ListByCityID mocks base method.
+func (m *MockOneWayFeesRepo) ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, fromCityID)
+	ret0, _ := ret[0].([]domain.OneWayFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockOneWayFeesRepoMockRecorder) ListByCityID(ctx, fromCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockOneWayFeesRepo)(nil).ListByCityID), ctx, fromCityID)
+}
+
+// Upsert mocks base method.
+func (m *MockOneWayFeesRepo) Upsert(ctx context.Context, dowf domain.OneWayFee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, dowf)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockOneWayFeesRepoMockRecorder) Upsert(ctx, dowf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockOneWayFeesRepo)(nil).Upsert), ctx, dowf)
+}
+
+// MockMaintenancesRepo is a mock of MaintenancesRepo interface.
+type MockMaintenancesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenancesRepoMockRecorder
+}
+
+// MockMaintenancesRepoMockRecorder is the mock recorder for MockMaintenancesRepo.
+type MockMaintenancesRepoMockRecorder struct {
+	mock *MockMaintenancesRepo
+}
+
+// NewMockMaintenancesRepo creates a new mock instance.
+func NewMockMaintenancesRepo(ctrl *gomock.Controller) *MockMaintenancesRepo {
+	mock := &MockMaintenancesRepo{ctrl: ctrl}
+	mock.recorder = &MockMaintenancesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenancesRepo) EXPECT() *MockMaintenancesRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMaintenancesRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMaintenancesRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMaintenancesRepo)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockMaintenancesRepo) FullUpdate(ctx context.Context, dm domain.Maintenance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockMaintenancesRepoMockRecorder) FullUpdate(ctx, dm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockMaintenancesRepo)(nil).FullUpdate), ctx, dm)
+}
+
+// Get mocks base method.
+func (m *MockMaintenancesRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMaintenancesRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMaintenancesRepo)(nil).Get), ctx, ID)
+}
+
+// GetByCarID mocks base method.
+func (m *MockMaintenancesRepo) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].([]domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockMaintenancesRepoMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockMaintenancesRepo)(nil).GetByCarID), ctx, carID)
+}
+
+// GetByCarIDAndTimeFrame mocks base method.
+func (m *MockMaintenancesRepo) GetByCarIDAndTimeFrame(ctx context.Context, carID uuid.UUID, startDate, endDate time.Time) ([]domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarIDAndTimeFrame", ctx, carID, startDate, endDate)
+	ret0, _ := ret[0].([]domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarIDAndTimeFrame indicates an expected call of GetByCarIDAndTimeFrame.
+func (mr *MockMaintenancesRepoMockRecorder) GetByCarIDAndTimeFrame(ctx, carID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarIDAndTimeFrame", reflect.TypeOf((*MockMaintenancesRepo)(nil).GetByCarIDAndTimeFrame), ctx, carID, startDate, endDate)
+}
+
+// Insert mocks base method.
+func (m *MockMaintenancesRepo) Insert(ctx context.Context, dm domain.Maintenance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMaintenancesRepoMockRecorder) Insert(ctx, dm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMaintenancesRepo)(nil).Insert), ctx, dm)
+}
+
+// List mocks base method.
+func (m *MockMaintenancesRepo) List(ctx context.Context, cityName, fromMaintenanceID string, startDate, endDate time.Time, limit uint16) ([]domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, cityName, fromMaintenanceID, startDate, endDate, limit)
+	ret0, _ := ret[0].([]domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMaintenancesRepoMockRecorder) List(ctx, cityName, fromMaintenanceID, startDate, endDate, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMaintenancesRepo)(nil).List), ctx, cityName, fromMaintenanceID, startDate, endDate, limit)
+}
+
+// MockTransfersRepo is a mock of TransfersRepo interface.
+type MockTransfersRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransfersRepoMockRecorder
+}
+
+// MockTransfersRepoMockRecorder is the mock recorder for MockTransfersRepo.
+type MockTransfersRepoMockRecorder struct {
+	mock *MockTransfersRepo
+}
+
+// NewMockTransfersRepo creates a new mock instance.
+func NewMockTransfersRepo(ctrl *gomock.Controller) *MockTransfersRepo {
+	mock := &MockTransfersRepo{ctrl: ctrl}
+	mock.recorder = &MockTransfersRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfersRepo) EXPECT() *MockTransfersRepoMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockTransfersRepo) Complete(ctx context.Context, dt domain.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, dt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTransfersRepoMockRecorder) Complete(ctx, dt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTransfersRepo)(nil).Complete), ctx, dt)
+}
+
+// Delete mocks base method.
+func (m *MockTransfersRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTransfersRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransfersRepo)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockTransfersRepo) Get(ctx context.Context, ID uuid.UUID) (domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransfersRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransfersRepo)(nil).Get), ctx, ID)
+}
+
+// GetByCarID mocks base method.
+func (m *MockTransfersRepo) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].([]domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockTransfersRepoMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockTransfersRepo)(nil).GetByCarID), ctx, carID)
+}
+
+// GetPendingByCarID mocks base method.
+func (m *MockTransfersRepo) GetPendingByCarID(ctx context.Context, carID uuid.UUID) (domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByCarID", ctx, carID)
+	ret0, _ := ret[0].(domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByCarID indicates an expected call of GetPendingByCarID.
+func (mr *MockTransfersRepoMockRecorder) GetPendingByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByCarID", reflect.TypeOf((*MockTransfersRepo)(nil).GetPendingByCarID), ctx, carID)
+}
+
+// Insert mocks base method.
+func (m *MockTransfersRepo) Insert(ctx context.Context, dt domain.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockTransfersRepoMockRecorder) Insert(ctx, dt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransfersRepo)(nil).Insert), ctx, dt)
+}
+
+// MockInspectionsRepo is a mock of InspectionsRepo interface.
+type MockInspectionsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockInspectionsRepoMockRecorder
+}
+
+// MockInspectionsRepoMockRecorder is the mock recorder for MockInspectionsRepo.
+type MockInspectionsRepoMockRecorder struct {
+	mock *MockInspectionsRepo
+}
+
+// NewMockInspectionsRepo creates a new mock instance.
+func NewMockInspectionsRepo(ctrl *gomock.Controller) *MockInspectionsRepo {
+	mock := &MockInspectionsRepo{ctrl: ctrl}
+	mock.recorder = &MockInspectionsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInspectionsRepo) EXPECT() *MockInspectionsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReservationID mocks base method.
+func (m *MockInspectionsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) ([]domain.Inspection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].([]domain.Inspection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockInspectionsRepoMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockInspectionsRepo)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// MockHandoversRepo is a mock of HandoversRepo interface.
+type MockHandoversRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandoversRepoMockRecorder
+}
+
+// MockHandoversRepoMockRecorder is the mock recorder for MockHandoversRepo.
+type MockHandoversRepoMockRecorder struct {
+	mock *MockHandoversRepo
+}
+
+// NewMockHandoversRepo creates a new mock instance.
+func NewMockHandoversRepo(ctrl *gomock.Controller) *MockHandoversRepo {
+	mock := &MockHandoversRepo{ctrl: ctrl}
+	mock.recorder = &MockHandoversRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandoversRepo) EXPECT() *MockHandoversRepoMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockHandoversRepo) Begin(ctx context.Context) (ports.HandoverTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx)
+	ret0, _ := ret[0].(ports.HandoverTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockHandoversRepoMockRecorder) Begin(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockHandoversRepo)(nil).Begin), ctx)
+}
+
+// MockHandoverTx is a mock of HandoverTx interface.
+type MockHandoverTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandoverTxMockRecorder
+}
+
+// MockHandoverTxMockRecorder is the mock recorder for MockHandoverTx.
+type MockHandoverTxMockRecorder struct {
+	mock *MockHandoverTx
+}
+
+// NewMockHandoverTx creates a new mock instance.
+func NewMockHandoverTx(ctrl *gomock.Controller) *MockHandoverTx {
+	mock := &MockHandoverTx{ctrl: ctrl}
+	mock.recorder = &MockHandoverTxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandoverTx) EXPECT() *MockHandoverTxMockRecorder {
+	return m.recorder
+}
+
+// Commit mocks base method.
+func (m *MockHandoverTx) Commit() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockHandoverTxMockRecorder) Commit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockHandoverTx)(nil).Commit))
+}
+
+// InsertDeposit mocks base method.
+func (m *MockHandoverTx) InsertDeposit(ctx context.Context, dd domain.Deposit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDeposit", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDeposit indicates an expected call of InsertDeposit.
+func (mr *MockHandoverTxMockRecorder) InsertDeposit(ctx, dd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDeposit", reflect.TypeOf((*MockHandoverTx)(nil).InsertDeposit), ctx, dd)
+}
+
+// InsertInspection mocks base method.
+func (m *MockHandoverTx) InsertInspection(ctx context.Context, di domain.Inspection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertInspection", ctx, di)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertInspection indicates an expected call of InsertInspection.
+func (mr *MockHandoverTxMockRecorder) InsertInspection(ctx, di interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertInspection", reflect.TypeOf((*MockHandoverTx)(nil).InsertInspection), ctx, di)
+}
+
+// InsertLateReturn mocks base method.
+func (m *MockHandoverTx) InsertLateReturn(ctx context.Context, dlr domain.LateReturn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLateReturn", ctx, dlr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertLateReturn indicates an expected call of InsertLateReturn.
+func (mr *MockHandoverTxMockRecorder) InsertLateReturn(ctx, dlr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLateReturn", reflect.TypeOf((*MockHandoverTx)(nil).InsertLateReturn), ctx, dlr)
+}
+
+// InsertLedgerEntries mocks base method.
+func (m *MockHandoverTx) InsertLedgerEntries(ctx context.Context, entries []domain.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLedgerEntries", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertLedgerEntries indicates an expected call of InsertLedgerEntries.
+func (mr *MockHandoverTxMockRecorder) InsertLedgerEntries(ctx, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLedgerEntries", reflect.TypeOf((*MockHandoverTx)(nil).InsertLedgerEntries), ctx, entries)
+}
+
+// RelocateCar mocks base method.
+func (m *MockHandoverTx) RelocateCar(ctx context.Context, carID, branchID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelocateCar", ctx, carID, branchID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RelocateCar indicates an expected call of RelocateCar.
+func (mr *MockHandoverTxMockRecorder) RelocateCar(ctx, carID, branchID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelocateCar", reflect.TypeOf((*MockHandoverTx)(nil).RelocateCar), ctx, carID, branchID)
+}
+
+// Rollback mocks base method.
+func (m *MockHandoverTx) Rollback() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockHandoverTxMockRecorder) Rollback() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockHandoverTx)(nil).Rollback))
+}
+
+// UpdateCarMileage mocks base method.
+func (m *MockHandoverTx) UpdateCarMileage(ctx context.Context, carID uuid.UUID, mileage int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCarMileage", ctx, carID, mileage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCarMileage indicates an expected call of UpdateCarMileage.
+func (mr *MockHandoverTxMockRecorder) UpdateCarMileage(ctx, carID, mileage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCarMileage", reflect.TypeOf((*MockHandoverTx)(nil).UpdateCarMileage), ctx, carID, mileage)
+}
+
+// UpdateReservationStatus mocks base method.
+func (m *MockHandoverTx) UpdateReservationStatus(ctx context.Context, reservationID uuid.UUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservationStatus", ctx, reservationID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservationStatus indicates an expected call of UpdateReservationStatus.
+func (mr *MockHandoverTxMockRecorder) UpdateReservationStatus(ctx, reservationID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservationStatus", reflect.TypeOf((*MockHandoverTx)(nil).UpdateReservationStatus), ctx, reservationID, status)
+}
+
+// MockLateReturnsRepo is a mock of LateReturnsRepo interface.
+type MockLateReturnsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLateReturnsRepoMockRecorder
+}
+
+// MockLateReturnsRepoMockRecorder is the mock recorder for MockLateReturnsRepo.
+type MockLateReturnsRepoMockRecorder struct {
+	mock *MockLateReturnsRepo
+}
+
+// NewMockLateReturnsRepo creates a new mock instance.
+func NewMockLateReturnsRepo(ctrl *gomock.Controller) *MockLateReturnsRepo {
+	mock := &MockLateReturnsRepo{ctrl: ctrl}
+	mock.recorder = &MockLateReturnsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLateReturnsRepo) EXPECT() *MockLateReturnsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReservationID mocks base method.
+func (m *MockLateReturnsRepo) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.LateReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(domain.LateReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockLateReturnsRepoMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockLateReturnsRepo)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// GetNotice mocks base method.
+func (m *MockLateReturnsRepo) GetNotice(ctx context.Context, reservationID uuid.UUID) (domain.OverdueNotice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotice", ctx, reservationID)
+	ret0, _ := ret[0].(domain.OverdueNotice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotice indicates an expected call of GetNotice.
+func (mr *MockLateReturnsRepoMockRecorder) GetNotice(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotice", reflect.TypeOf((*MockLateReturnsRepo)(nil).GetNotice), ctx, reservationID)
+}
+
+// InsertNotice mocks base method.
+func (m *MockLateReturnsRepo) InsertNotice(ctx context.Context, don domain.OverdueNotice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNotice", ctx, don)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertNotice indicates an expected call of InsertNotice.
+func (mr *MockLateReturnsRepoMockRecorder) InsertNotice(ctx, don interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNotice", reflect.TypeOf((*MockLateReturnsRepo)(nil).InsertNotice), ctx, don)
+}
+
+// MockCarMileagesRepo is a mock of CarMileagesRepo interface.
+type MockCarMileagesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCarMileagesRepoMockRecorder
+}
+
+// MockCarMileagesRepoMockRecorder is the mock recorder for MockCarMileagesRepo.
+type MockCarMileagesRepoMockRecorder struct {
+	mock *MockCarMileagesRepo
+}
+
+// NewMockCarMileagesRepo creates a new mock instance.
+func NewMockCarMileagesRepo(ctrl *gomock.Controller) *MockCarMileagesRepo {
+	mock := &MockCarMileagesRepo{ctrl: ctrl}
+	mock.recorder = &MockCarMileagesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCarMileagesRepo) EXPECT() *MockCarMileagesRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCarMileagesRepo) Get(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, carID)
+	ret0, _ := ret[0].(domain.CarMileage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCarMileagesRepoMockRecorder) Get(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCarMileagesRepo)(nil).Get), ctx, carID)
+}
+
+// RegisterService mocks base method.
+func (m *MockCarMileagesRepo) RegisterService(ctx context.Context, carID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterService", ctx, carID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterService indicates an expected call of RegisterService.
+func (mr *MockCarMileagesRepoMockRecorder) RegisterService(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterService", reflect.TypeOf((*MockCarMileagesRepo)(nil).RegisterService), ctx, carID)
+}
+
+// MockDamageReportsRepo is a mock of DamageReportsRepo interface.
+type MockDamageReportsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportsRepoMockRecorder
+}
+
+// MockDamageReportsRepoMockRecorder is the mock recorder for MockDamageReportsRepo.
+type MockDamageReportsRepoMockRecorder struct {
+	mock *MockDamageReportsRepo
+}
+
+// NewMockDamageReportsRepo creates a new mock instance.
+func NewMockDamageReportsRepo(ctrl *gomock.Controller) *MockDamageReportsRepo {
+	mock := &MockDamageReportsRepo{ctrl: ctrl}
+	mock.recorder = &MockDamageReportsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportsRepo) EXPECT() *MockDamageReportsRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDamageReportsRepo) Get(ctx context.Context, ID uuid.UUID) (domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ID)
+	ret0, _ := ret[0].(domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDamageReportsRepoMockRecorder) Get(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDamageReportsRepo)(nil).Get), ctx, ID)
+}
+
+// GetPhoto mocks base method.
+func (m *MockDamageReportsRepo) GetPhoto(ctx context.Context, reportID, photoID uuid.UUID) (domain.DamagePhoto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhoto", ctx, reportID, photoID)
+	ret0, _ := ret[0].(domain.DamagePhoto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPhoto indicates an expected call of GetPhoto.
+func (mr *MockDamageReportsRepoMockRecorder) GetPhoto(ctx, reportID, photoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoto", reflect.TypeOf((*MockDamageReportsRepo)(nil).GetPhoto), ctx, reportID, photoID)
+}
+
+// Insert mocks base method.
+func (m *MockDamageReportsRepo) Insert(ctx context.Context, ddr domain.DamageReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, ddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockDamageReportsRepoMockRecorder) Insert(ctx, ddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDamageReportsRepo)(nil).Insert), ctx, ddr)
+}
+
+// InsertPhoto mocks base method.
+func (m *MockDamageReportsRepo) InsertPhoto(ctx context.Context, ddp domain.DamagePhoto) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPhoto", ctx, ddp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPhoto indicates an expected call of InsertPhoto.
+func (mr *MockDamageReportsRepoMockRecorder) InsertPhoto(ctx, ddp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPhoto", reflect.TypeOf((*MockDamageReportsRepo)(nil).InsertPhoto), ctx, ddp)
+}
+
+// ListByCarID mocks base method.
+func (m *MockDamageReportsRepo) ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCarID", ctx, carID, status)
+	ret0, _ := ret[0].([]domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCarID indicates an expected call of ListByCarID.
+func (mr *MockDamageReportsRepoMockRecorder) ListByCarID(ctx, carID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCarID", reflect.TypeOf((*MockDamageReportsRepo)(nil).ListByCarID), ctx, carID, status)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockDamageReportsRepo) UpdateStatus(ctx context.Context, ddr domain.DamageReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, ddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockDamageReportsRepoMockRecorder) UpdateStatus(ctx, ddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockDamageReportsRepo)(nil).UpdateStatus), ctx, ddr)
 }
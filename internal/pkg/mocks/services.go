@@ -6,6 +6,7 @@ package mock_ports
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -52,11 +53,12 @@ func (mr *MockCarsServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call
 }
 
 // FullUpdate mocks base method.
-func (m *MockCarsService) FullUpdate(ctx context.Context, dc domain.Car) error {
+func (m *MockCarsService) FullUpdate(ctx context.Context, dc domain.Car) ([]domain.Reservation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FullUpdate", ctx, dc)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // FullUpdate indicates an expected call of FullUpdate.
@@ -81,18 +83,18 @@ func (mr *MockCarsServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockCarsService) List(ctx context.Context, city, from_car_id string) ([]domain.Car, error) {
+func (m *MockCarsService) List(ctx context.Context, city string, filters domain.CarFilters, from_car_id string) ([]domain.Car, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, city, from_car_id)
+	ret := m.ctrl.Call(m, "List", ctx, city, filters, from_car_id)
 	ret0, _ := ret[0].([]domain.Car)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockCarsServiceMockRecorder) List(ctx, city, from_car_id interface{}) *gomock.Call {
+func (mr *MockCarsServiceMockRecorder) List(ctx, city, filters, from_car_id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCarsService)(nil).List), ctx, city, from_car_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCarsService)(nil).List), ctx, city, filters, from_car_id)
 }
 
 // Register mocks base method.
@@ -110,6 +112,21 @@ func (mr *MockCarsServiceMockRecorder) Register(ctx, car interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCarsService)(nil).Register), ctx, car)
 }
 
+// SearchNearby mocks base method.
+func (m *MockCarsService) SearchNearby(ctx context.Context, search domain.NearbyCarsSearch) ([]domain.NearbyCar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNearby", ctx, search)
+	ret0, _ := ret[0].([]domain.NearbyCar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchNearby indicates an expected call of SearchNearby.
+func (mr *MockCarsServiceMockRecorder) SearchNearby(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNearby", reflect.TypeOf((*MockCarsService)(nil).SearchNearby), ctx, search)
+}
+
 // MockUsersService is a mock of UsersService interface.
 type MockUsersService struct {
 	ctrl     *gomock.Controller
@@ -148,11 +165,12 @@ func (mr *MockUsersServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call
 }
 
 // FullUpdate mocks base method.
-func (m *MockUsersService) FullUpdate(ctx context.Context, du domain.User) error {
+func (m *MockUsersService) FullUpdate(ctx context.Context, du domain.User) ([]domain.Reservation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FullUpdate", ctx, du)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // FullUpdate indicates an expected call of FullUpdate.
@@ -176,6 +194,21 @@ func (mr *MockUsersServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersService)(nil).Get), ctx, id)
 }
 
+// List mocks base method.
+func (m *MockUsersService) List(ctx context.Context, filters domain.UserFilters, fromUserID string) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, fromUserID)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUsersServiceMockRecorder) List(ctx, filters, fromUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsersService)(nil).List), ctx, filters, fromUserID)
+}
+
 // Register mocks base method.
 func (m *MockUsersService) Register(ctx context.Context, car domain.User) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +224,62 @@ func (mr *MockUsersServiceMockRecorder) Register(ctx, car interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUsersService)(nil).Register), ctx, car)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockUsersService) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUsersServiceMockRecorder) RequestPasswordReset(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUsersService)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUsersService) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUsersServiceMockRecorder) ResetPassword(ctx, token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUsersService)(nil).ResetPassword), ctx, token, password)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockUsersService) SendEmailVerification(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockUsersServiceMockRecorder) SendEmailVerification(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockUsersService)(nil).SendEmailVerification), ctx, id)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUsersService) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUsersServiceMockRecorder) VerifyEmail(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUsersService)(nil).VerifyEmail), ctx, token)
+}
+
 // MockCitiesService is a mock of CitiesService interface.
 type MockCitiesService struct {
 	ctrl     *gomock.Controller
@@ -214,61 +303,200 @@ func (m *MockCitiesService) EXPECT() *MockCitiesServiceMockRecorder {
 	return m.recorder
 }
 
-// ListNames mocks base method.
-func (m *MockCitiesService) ListNames(ctx context.Context) ([]string, error) {
+// Delete mocks base method.
+func (m *MockCitiesService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCitiesServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCitiesService)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockCitiesService) FullUpdate(ctx context.Context, city domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockCitiesServiceMockRecorder) FullUpdate(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockCitiesService)(nil).FullUpdate), ctx, city)
+}
+
+// Get mocks base method.
+func (m *MockCitiesService) Get(ctx context.Context, id uuid.UUID) (domain.City, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNames", ctx)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.City)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNames indicates an expected call of ListNames.
-func (mr *MockCitiesServiceMockRecorder) ListNames(ctx interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockCitiesServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNames", reflect.TypeOf((*MockCitiesService)(nil).ListNames), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCitiesService)(nil).Get), ctx, id)
 }
 
-// MockReservationsService is a mock of ReservationsService interface.
-type MockReservationsService struct {
+// List mocks base method.
+func (m *MockCitiesService) List(ctx context.Context, fromCityID string) ([]domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, fromCityID)
+	ret0, _ := ret[0].([]domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCitiesServiceMockRecorder) List(ctx, fromCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCitiesService)(nil).List), ctx, fromCityID)
+}
+
+// Register mocks base method.
+func (m *MockCitiesService) Register(ctx context.Context, city domain.City) (domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, city)
+	ret0, _ := ret[0].(domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockCitiesServiceMockRecorder) Register(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCitiesService)(nil).Register), ctx, city)
+}
+
+// MockBranchesService is a mock of BranchesService interface.
+type MockBranchesService struct {
 	ctrl     *gomock.Controller
-	recorder *MockReservationsServiceMockRecorder
+	recorder *MockBranchesServiceMockRecorder
 }
 
-// MockReservationsServiceMockRecorder is the mock recorder for MockReservationsService.
-type MockReservationsServiceMockRecorder struct {
-	mock *MockReservationsService
+// MockBranchesServiceMockRecorder is the mock recorder for MockBranchesService.
+type MockBranchesServiceMockRecorder struct {
+	mock *MockBranchesService
 }
 
-// NewMockReservationsService creates a new mock instance.
-func NewMockReservationsService(ctrl *gomock.Controller) *MockReservationsService {
-	mock := &MockReservationsService{ctrl: ctrl}
-	mock.recorder = &MockReservationsServiceMockRecorder{mock}
+// NewMockBranchesService creates a new mock instance.
+func NewMockBranchesService(ctrl *gomock.Controller) *MockBranchesService {
+	mock := &MockBranchesService{ctrl: ctrl}
+	mock.recorder = &MockBranchesServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockReservationsService) EXPECT() *MockReservationsServiceMockRecorder {
+func (m *MockBranchesService) EXPECT() *MockBranchesServiceMockRecorder {
 	return m.recorder
 }
 
-// Book mocks base method.
-func (m *MockReservationsService) Book(ctx context.Context, reservation domain.Reservation) (domain.Reservation, error) {
+// Delete mocks base method.
+func (m *MockBranchesService) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Book", ctx, reservation)
-	ret0, _ := ret[0].(domain.Reservation)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBranchesServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBranchesService)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockBranchesService) FullUpdate(ctx context.Context, branch domain.Branch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, branch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockBranchesServiceMockRecorder) FullUpdate(ctx, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockBranchesService)(nil).FullUpdate), ctx, branch)
+}
+
+// Get mocks base method.
+func (m *MockBranchesService) Get(ctx context.Context, id uuid.UUID) (domain.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Branch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Book indicates an expected call of Book.
-func (mr *MockReservationsServiceMockRecorder) Book(ctx, reservation interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockBranchesServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockReservationsService)(nil).Book), ctx, reservation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBranchesService)(nil).Get), ctx, id)
+}
+
+// ListByCityID mocks base method.
+func (m *MockBranchesService) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, cityID)
+	ret0, _ := ret[0].([]domain.Branch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockBranchesServiceMockRecorder) ListByCityID(ctx, cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockBranchesService)(nil).ListByCityID), ctx, cityID)
+}
+
+// Register mocks base method.
+func (m *MockBranchesService) Register(ctx context.Context, branch domain.Branch) (domain.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, branch)
+	ret0, _ := ret[0].(domain.Branch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockBranchesServiceMockRecorder) Register(ctx, branch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockBranchesService)(nil).Register), ctx, branch)
+}
+
+// MockAddOnsService is a mock of AddOnsService interface.
+type MockAddOnsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddOnsServiceMockRecorder
+}
+
+// MockAddOnsServiceMockRecorder is the mock recorder for MockAddOnsService.
+type MockAddOnsServiceMockRecorder struct {
+	mock *MockAddOnsService
+}
+
+// NewMockAddOnsService creates a new mock instance.
+func NewMockAddOnsService(ctrl *gomock.Controller) *MockAddOnsService {
+	mock := &MockAddOnsService{ctrl: ctrl}
+	mock.recorder = &MockAddOnsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddOnsService) EXPECT() *MockAddOnsServiceMockRecorder {
+	return m.recorder
 }
 
 // Delete mocks base method.
-func (m *MockReservationsService) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockAddOnsService) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
@@ -276,81 +504,1339 @@ func (m *MockReservationsService) Delete(ctx context.Context, id uuid.UUID) erro
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockReservationsServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockAddOnsServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsService)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddOnsService)(nil).Delete), ctx, id)
 }
 
 // FullUpdate mocks base method.
-func (m *MockReservationsService) FullUpdate(ctx context.Context, dr domain.Reservation) error {
+func (m *MockAddOnsService) FullUpdate(ctx context.Context, addOn domain.AddOn) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullUpdate", ctx, dr)
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, addOn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // FullUpdate indicates an expected call of FullUpdate.
-func (mr *MockReservationsServiceMockRecorder) FullUpdate(ctx, dr interface{}) *gomock.Call {
+func (mr *MockAddOnsServiceMockRecorder) FullUpdate(ctx, addOn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsService)(nil).FullUpdate), ctx, dr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockAddOnsService)(nil).FullUpdate), ctx, addOn)
 }
 
 // Get mocks base method.
-func (m *MockReservationsService) Get(ctx context.Context, id uuid.UUID) (domain.Reservation, error) {
+func (m *MockAddOnsService) Get(ctx context.Context, id uuid.UUID) (domain.AddOn, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id)
-	ret0, _ := ret[0].(domain.Reservation)
+	ret0, _ := ret[0].(domain.AddOn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockReservationsServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+func (mr *MockAddOnsServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsService)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddOnsService)(nil).Get), ctx, id)
 }
 
-// GetByCarID mocks base method.
-func (m *MockReservationsService) GetByCarID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+// ListByBranchID mocks base method.
+func (m *MockAddOnsService) ListByBranchID(ctx context.Context, branchID uuid.UUID) ([]domain.AddOn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByCarID", ctx, userID)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "ListByBranchID", ctx, branchID)
+	ret0, _ := ret[0].([]domain.AddOn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByCarID indicates an expected call of GetByCarID.
-func (mr *MockReservationsServiceMockRecorder) GetByCarID(ctx, userID interface{}) *gomock.Call {
+// ListByBranchID indicates an expected call of ListByBranchID.
+func (mr *MockAddOnsServiceMockRecorder) ListByBranchID(ctx, branchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsService)(nil).GetByCarID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBranchID", reflect.TypeOf((*MockAddOnsService)(nil).ListByBranchID), ctx, branchID)
 }
 
-// GetByUserID mocks base method.
-func (m *MockReservationsService) GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+// Register mocks base method.
+func (m *MockAddOnsService) Register(ctx context.Context, addOn domain.AddOn) (domain.AddOn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "Register", ctx, addOn)
+	ret0, _ := ret[0].(domain.AddOn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockReservationsServiceMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+// Register indicates an expected call of Register.
+func (mr *MockAddOnsServiceMockRecorder) Register(ctx, addOn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsService)(nil).GetByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAddOnsService)(nil).Register), ctx, addOn)
+}
+
+// MockProtectionPlansService is a mock of ProtectionPlansService interface.
+type MockProtectionPlansService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProtectionPlansServiceMockRecorder
+}
+
+// MockProtectionPlansServiceMockRecorder is the mock recorder for MockProtectionPlansService.
+type MockProtectionPlansServiceMockRecorder struct {
+	mock *MockProtectionPlansService
+}
+
+// NewMockProtectionPlansService creates a new mock instance.
+func NewMockProtectionPlansService(ctrl *gomock.Controller) *MockProtectionPlansService {
+	mock := &MockProtectionPlansService{ctrl: ctrl}
+	mock.recorder = &MockProtectionPlansServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProtectionPlansService) EXPECT() *MockProtectionPlansServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockProtectionPlansService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProtectionPlansServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProtectionPlansService)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockProtectionPlansService) FullUpdate(ctx context.Context, plan domain.ProtectionPlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, plan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockProtectionPlansServiceMockRecorder) FullUpdate(ctx, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockProtectionPlansService)(nil).FullUpdate), ctx, plan)
+}
+
+// Get mocks base method.
+func (m *MockProtectionPlansService) Get(ctx context.Context, id uuid.UUID) (domain.ProtectionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.ProtectionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProtectionPlansServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProtectionPlansService)(nil).Get), ctx, id)
 }
 
 // List mocks base method.
-func (m *MockReservationsService) List(ctx context.Context, fromReservationID string, startDate, endDate time.Time) ([]domain.Reservation, error) {
+func (m *MockProtectionPlansService) List(ctx context.Context, carType string) ([]domain.ProtectionPlan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, fromReservationID, startDate, endDate)
-	ret0, _ := ret[0].([]domain.Reservation)
+	ret := m.ctrl.Call(m, "List", ctx, carType)
+	ret0, _ := ret[0].([]domain.ProtectionPlan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockReservationsServiceMockRecorder) List(ctx, fromReservationID, startDate, endDate interface{}) *gomock.Call {
+func (mr *MockProtectionPlansServiceMockRecorder) List(ctx, carType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsService)(nil).List), ctx, fromReservationID, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProtectionPlansService)(nil).List), ctx, carType)
+}
+
+// Register mocks base method.
+func (m *MockProtectionPlansService) Register(ctx context.Context, plan domain.ProtectionPlan) (domain.ProtectionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, plan)
+	ret0, _ := ret[0].(domain.ProtectionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockProtectionPlansServiceMockRecorder) Register(ctx, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockProtectionPlansService)(nil).Register), ctx, plan)
+}
+
+// MockTaxRulesService is a mock of TaxRulesService interface.
+type MockTaxRulesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRulesServiceMockRecorder
+}
+
+// MockTaxRulesServiceMockRecorder is the mock recorder for MockTaxRulesService.
+type MockTaxRulesServiceMockRecorder struct {
+	mock *MockTaxRulesService
+}
+
+// NewMockTaxRulesService creates a new mock instance.
+func NewMockTaxRulesService(ctrl *gomock.Controller) *MockTaxRulesService {
+	mock := &MockTaxRulesService{ctrl: ctrl}
+	mock.recorder = &MockTaxRulesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRulesService) EXPECT() *MockTaxRulesServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTaxRulesService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRulesServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRulesService)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockTaxRulesService) FullUpdate(ctx context.Context, rule domain.TaxRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockTaxRulesServiceMockRecorder) FullUpdate(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockTaxRulesService)(nil).FullUpdate), ctx, rule)
+}
+
+// Get mocks base method.
+func (m *MockTaxRulesService) Get(ctx context.Context, id uuid.UUID) (domain.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaxRulesServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaxRulesService)(nil).Get), ctx, id)
+}
+
+// ListByCityID mocks base method.
+func (m *MockTaxRulesService) ListByCityID(ctx context.Context, cityID uuid.UUID) ([]domain.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, cityID)
+	ret0, _ := ret[0].([]domain.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockTaxRulesServiceMockRecorder) ListByCityID(ctx, cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockTaxRulesService)(nil).ListByCityID), ctx, cityID)
+}
+
+// Register mocks base method.
+func (m *MockTaxRulesService) Register(ctx context.Context, rule domain.TaxRule) (domain.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, rule)
+	ret0, _ := ret[0].(domain.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockTaxRulesServiceMockRecorder) Register(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockTaxRulesService)(nil).Register), ctx, rule)
+}
+
+// MockExchangeRatesService is a mock of ExchangeRatesService interface.
+type MockExchangeRatesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesServiceMockRecorder
+}
+
+// MockExchangeRatesServiceMockRecorder is the mock recorder for MockExchangeRatesService.
+type MockExchangeRatesServiceMockRecorder struct {
+	mock *MockExchangeRatesService
+}
+
+// NewMockExchangeRatesService creates a new mock instance.
+func NewMockExchangeRatesService(ctrl *gomock.Controller) *MockExchangeRatesService {
+	mock := &MockExchangeRatesService{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRatesService) EXPECT() *MockExchangeRatesServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRatesService) Delete(ctx context.Context, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRatesServiceMockRecorder) Delete(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRatesService)(nil).Delete), ctx, from, to)
+}
+
+// List mocks base method.
+func (m *MockExchangeRatesService) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockExchangeRatesServiceMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExchangeRatesService)(nil).List), ctx)
+}
+
+// Set mocks base method.
+func (m *MockExchangeRatesService) Set(ctx context.Context, rate domain.ExchangeRate) (domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, rate)
+	ret0, _ := ret[0].(domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockExchangeRatesServiceMockRecorder) Set(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockExchangeRatesService)(nil).Set), ctx, rate)
+}
+
+// MockOneWayFeesService is a mock of OneWayFeesService interface.
+type MockOneWayFeesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneWayFeesServiceMockRecorder
+}
+
+// MockOneWayFeesServiceMockRecorder is the mock recorder for MockOneWayFeesService.
+type MockOneWayFeesServiceMockRecorder struct {
+	mock *MockOneWayFeesService
+}
+
+// NewMockOneWayFeesService creates a new mock instance.
+func NewMockOneWayFeesService(ctrl *gomock.Controller) *MockOneWayFeesService {
+	mock := &MockOneWayFeesService{ctrl: ctrl}
+	mock.recorder = &MockOneWayFeesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOneWayFeesService) EXPECT() *MockOneWayFeesServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockOneWayFeesService) Delete(ctx context.Context, fromCityID, toCityID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, fromCityID, toCityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOneWayFeesServiceMockRecorder) Delete(ctx, fromCityID, toCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOneWayFeesService)(nil).Delete), ctx, fromCityID, toCityID)
+}
+
+// ListByCityID mocks base method.
+func (m *MockOneWayFeesService) ListByCityID(ctx context.Context, fromCityID uuid.UUID) ([]domain.OneWayFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCityID", ctx, fromCityID)
+	ret0, _ := ret[0].([]domain.OneWayFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCityID indicates an expected call of ListByCityID.
+func (mr *MockOneWayFeesServiceMockRecorder) ListByCityID(ctx, fromCityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCityID", reflect.TypeOf((*MockOneWayFeesService)(nil).ListByCityID), ctx, fromCityID)
+}
+
+// Set mocks base method.
+func (m *MockOneWayFeesService) Set(ctx context.Context, oneWayFee domain.OneWayFee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, oneWayFee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockOneWayFeesServiceMockRecorder) Set(ctx, oneWayFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockOneWayFeesService)(nil).Set), ctx, oneWayFee)
+}
+
+// MockReservationsService is a mock of ReservationsService interface.
+type MockReservationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsServiceMockRecorder
+}
+
+// MockReservationsServiceMockRecorder is the mock recorder for MockReservationsService.
+type MockReservationsServiceMockRecorder struct {
+	mock *MockReservationsService
+}
+
+// NewMockReservationsService creates a new mock instance.
+func NewMockReservationsService(ctrl *gomock.Controller) *MockReservationsService {
+	mock := &MockReservationsService{ctrl: ctrl}
+	mock.recorder = &MockReservationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationsService) EXPECT() *MockReservationsServiceMockRecorder {
+	return m.recorder
+}
+
+// Book mocks base method.
+func (m *MockReservationsService) Book(ctx context.Context, reservation domain.Reservation) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Book", ctx, reservation)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Book indicates an expected call of Book.
+func (mr *MockReservationsServiceMockRecorder) Book(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockReservationsService)(nil).Book), ctx, reservation)
+}
+
+// Delete mocks base method.
+func (m *MockReservationsService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReservationsServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservationsService)(nil).Delete), ctx, id)
+}
+
+// Extend mocks base method.
+func (m *MockReservationsService) Extend(ctx context.Context, reservationID uuid.UUID, endDate time.Time) (domain.Extension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, reservationID, endDate)
+	ret0, _ := ret[0].(domain.Extension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockReservationsServiceMockRecorder) Extend(ctx, reservationID, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockReservationsService)(nil).Extend), ctx, reservationID, endDate)
+}
+
+// FullUpdate mocks base method.
+func (m *MockReservationsService) FullUpdate(ctx context.Context, dr domain.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockReservationsServiceMockRecorder) FullUpdate(ctx, dr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockReservationsService)(nil).FullUpdate), ctx, dr)
+}
+
+// Get mocks base method.
+func (m *MockReservationsService) Get(ctx context.Context, id uuid.UUID) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservationsServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservationsService)(nil).Get), ctx, id)
+}
+
+// GetByCarID mocks base method.
+func (m *MockReservationsService) GetByCarID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, userID)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockReservationsServiceMockRecorder) GetByCarID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockReservationsService)(nil).GetByCarID), ctx, userID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockReservationsService) GetByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockReservationsServiceMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReservationsService)(nil).GetByUserID), ctx, userID)
+}
+
+// List mocks base method.
+func (m *MockReservationsService) List(ctx context.Context, fromReservationID string, startDate, endDate time.Time) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, fromReservationID, startDate, endDate)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservationsServiceMockRecorder) List(ctx, fromReservationID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservationsService)(nil).List), ctx, fromReservationID, startDate, endDate)
+}
+
+// Quote mocks base method.
+func (m *MockReservationsService) Quote(ctx context.Context, reservation domain.Reservation, displayCurrency string) (domain.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, reservation, displayCurrency)
+	ret0, _ := ret[0].(domain.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockReservationsServiceMockRecorder) Quote(ctx, reservation, displayCurrency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockReservationsService)(nil).Quote), ctx, reservation, displayCurrency)
+}
+
+// MockPaymentsService is a mock of PaymentsService interface.
+type MockPaymentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentsServiceMockRecorder
+}
+
+// MockPaymentsServiceMockRecorder is the mock recorder for MockPaymentsService.
+type MockPaymentsServiceMockRecorder struct {
+	mock *MockPaymentsService
+}
+
+// NewMockPaymentsService creates a new mock instance.
+func NewMockPaymentsService(ctrl *gomock.Controller) *MockPaymentsService {
+	mock := &MockPaymentsService{ctrl: ctrl}
+	mock.recorder = &MockPaymentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentsService) EXPECT() *MockPaymentsServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPaymentsService) Authorize(ctx context.Context, reservationID uuid.UUID, paymentMethod string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, reservationID, paymentMethod)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPaymentsServiceMockRecorder) Authorize(ctx, reservationID, paymentMethod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPaymentsService)(nil).Authorize), ctx, reservationID, paymentMethod)
+}
+
+// Capture mocks base method.
+func (m *MockPaymentsService) Capture(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockPaymentsServiceMockRecorder) Capture(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockPaymentsService)(nil).Capture), ctx, reservationID)
+}
+
+// Get mocks base method.
+func (m *MockPaymentsService) Get(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPaymentsServiceMockRecorder) Get(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPaymentsService)(nil).Get), ctx, reservationID)
+}
+
+// HandleEvent mocks base method.
+func (m *MockPaymentsService) HandleEvent(ctx context.Context, payload []byte, signature string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEvent", ctx, payload, signature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleEvent indicates an expected call of HandleEvent.
+func (mr *MockPaymentsServiceMockRecorder) HandleEvent(ctx, payload, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockPaymentsService)(nil).HandleEvent), ctx, payload, signature)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentsService) Refund(ctx context.Context, reservationID uuid.UUID, amount float64) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, reservationID, amount)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentsServiceMockRecorder) Refund(ctx, reservationID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentsService)(nil).Refund), ctx, reservationID, amount)
+}
+
+// Void mocks base method.
+func (m *MockPaymentsService) Void(ctx context.Context, reservationID uuid.UUID) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentsServiceMockRecorder) Void(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentsService)(nil).Void), ctx, reservationID)
+}
+
+// MockDepositsService is a mock of DepositsService interface.
+type MockDepositsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositsServiceMockRecorder
+}
+
+// MockDepositsServiceMockRecorder is the mock recorder for MockDepositsService.
+type MockDepositsServiceMockRecorder struct {
+	mock *MockDepositsService
+}
+
+// NewMockDepositsService creates a new mock instance.
+func NewMockDepositsService(ctrl *gomock.Controller) *MockDepositsService {
+	mock := &MockDepositsService{ctrl: ctrl}
+	mock.recorder = &MockDepositsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositsService) EXPECT() *MockDepositsServiceMockRecorder {
+	return m.recorder
+}
+
+// Capture mocks base method.
+func (m *MockDepositsService) Capture(ctx context.Context, reservationID uuid.UUID, amount float64, reason string) (domain.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, reservationID, amount, reason)
+	ret0, _ := ret[0].(domain.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockDepositsServiceMockRecorder) Capture(ctx, reservationID, amount, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockDepositsService)(nil).Capture), ctx, reservationID, amount, reason)
+}
+
+// Get mocks base method.
+func (m *MockDepositsService) Get(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDepositsServiceMockRecorder) Get(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDepositsService)(nil).Get), ctx, reservationID)
+}
+
+// Release mocks base method.
+func (m *MockDepositsService) Release(ctx context.Context, reservationID uuid.UUID) (domain.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockDepositsServiceMockRecorder) Release(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockDepositsService)(nil).Release), ctx, reservationID)
+}
+
+// MockLedgerService is a mock of LedgerService interface.
+type MockLedgerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerServiceMockRecorder
+}
+
+// MockLedgerServiceMockRecorder is the mock recorder for MockLedgerService.
+type MockLedgerServiceMockRecorder struct {
+	mock *MockLedgerService
+}
+
+// NewMockLedgerService creates a new mock instance.
+func NewMockLedgerService(ctrl *gomock.Controller) *MockLedgerService {
+	mock := &MockLedgerService{ctrl: ctrl}
+	mock.recorder = &MockLedgerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockLedgerService) GetBalance(ctx context.Context, reservationID uuid.UUID) (domain.LedgerBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, reservationID)
+	ret0, _ := ret[0].(domain.LedgerBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLedgerServiceMockRecorder) GetBalance(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLedgerService)(nil).GetBalance), ctx, reservationID)
+}
+
+// MockInvoicesService is a mock of InvoicesService interface.
+type MockInvoicesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoicesServiceMockRecorder
+}
+
+// MockInvoicesServiceMockRecorder is the mock recorder for MockInvoicesService.
+type MockInvoicesServiceMockRecorder struct {
+	mock *MockInvoicesService
+}
+
+// NewMockInvoicesService creates a new mock instance.
+func NewMockInvoicesService(ctrl *gomock.Controller) *MockInvoicesService {
+	mock := &MockInvoicesService{ctrl: ctrl}
+	mock.recorder = &MockInvoicesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoicesService) EXPECT() *MockInvoicesServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockInvoicesService) Get(ctx context.Context, id uuid.UUID) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoicesServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoicesService)(nil).Get), ctx, id)
+}
+
+// GetBillingProfile mocks base method.
+func (m *MockInvoicesService) GetBillingProfile(ctx context.Context, userID uuid.UUID) (domain.BillingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillingProfile", ctx, userID)
+	ret0, _ := ret[0].(domain.BillingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillingProfile indicates an expected call of GetBillingProfile.
+func (mr *MockInvoicesServiceMockRecorder) GetBillingProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingProfile", reflect.TypeOf((*MockInvoicesService)(nil).GetBillingProfile), ctx, userID)
+}
+
+// GetByReservationID mocks base method.
+func (m *MockInvoicesService) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReservationID", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReservationID indicates an expected call of GetByReservationID.
+func (mr *MockInvoicesServiceMockRecorder) GetByReservationID(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReservationID", reflect.TypeOf((*MockInvoicesService)(nil).GetByReservationID), ctx, reservationID)
+}
+
+// Issue mocks base method.
+func (m *MockInvoicesService) Issue(ctx context.Context, reservationID uuid.UUID) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockInvoicesServiceMockRecorder) Issue(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockInvoicesService)(nil).Issue), ctx, reservationID)
+}
+
+// Open mocks base method.
+func (m *MockInvoicesService) Open(ctx context.Context, id uuid.UUID, format string) (io.ReadCloser, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, id, format)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockInvoicesServiceMockRecorder) Open(ctx, id, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockInvoicesService)(nil).Open), ctx, id, format)
+}
+
+// SaveBillingProfile mocks base method.
+func (m *MockInvoicesService) SaveBillingProfile(ctx context.Context, profile domain.BillingProfile) (domain.BillingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBillingProfile", ctx, profile)
+	ret0, _ := ret[0].(domain.BillingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveBillingProfile indicates an expected call of SaveBillingProfile.
+func (mr *MockInvoicesServiceMockRecorder) SaveBillingProfile(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBillingProfile", reflect.TypeOf((*MockInvoicesService)(nil).SaveBillingProfile), ctx, profile)
+}
+
+// MockMaintenancesService is a mock of MaintenancesService interface.
+type MockMaintenancesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenancesServiceMockRecorder
+}
+
+// MockMaintenancesServiceMockRecorder is the mock recorder for MockMaintenancesService.
+type MockMaintenancesServiceMockRecorder struct {
+	mock *MockMaintenancesService
+}
+
+// NewMockMaintenancesService creates a new mock instance.
+func NewMockMaintenancesService(ctrl *gomock.Controller) *MockMaintenancesService {
+	mock := &MockMaintenancesService{ctrl: ctrl}
+	mock.recorder = &MockMaintenancesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenancesService) EXPECT() *MockMaintenancesServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMaintenancesService) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMaintenancesServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMaintenancesService)(nil).Delete), ctx, id)
+}
+
+// FullUpdate mocks base method.
+func (m *MockMaintenancesService) FullUpdate(ctx context.Context, dm domain.Maintenance) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullUpdate", ctx, dm)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullUpdate indicates an expected call of FullUpdate.
+func (mr *MockMaintenancesServiceMockRecorder) FullUpdate(ctx, dm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullUpdate", reflect.TypeOf((*MockMaintenancesService)(nil).FullUpdate), ctx, dm)
+}
+
+// Get mocks base method.
+func (m *MockMaintenancesService) Get(ctx context.Context, id uuid.UUID) (domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMaintenancesServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMaintenancesService)(nil).Get), ctx, id)
+}
+
+// GetByCarID mocks base method.
+func (m *MockMaintenancesService) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].([]domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockMaintenancesServiceMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockMaintenancesService)(nil).GetByCarID), ctx, carID)
+}
+
+// List mocks base method.
+func (m *MockMaintenancesService) List(ctx context.Context, city, fromMaintenanceID string, startDate, endDate time.Time) ([]domain.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, city, fromMaintenanceID, startDate, endDate)
+	ret0, _ := ret[0].([]domain.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMaintenancesServiceMockRecorder) List(ctx, city, fromMaintenanceID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMaintenancesService)(nil).List), ctx, city, fromMaintenanceID, startDate, endDate)
+}
+
+// Schedule mocks base method.
+func (m *MockMaintenancesService) Schedule(ctx context.Context, maintenance domain.Maintenance) (domain.Maintenance, []domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, maintenance)
+	ret0, _ := ret[0].(domain.Maintenance)
+	ret1, _ := ret[1].([]domain.Reservation)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockMaintenancesServiceMockRecorder) Schedule(ctx, maintenance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockMaintenancesService)(nil).Schedule), ctx, maintenance)
+}
+
+// MockTransfersService is a mock of TransfersService interface.
+type MockTransfersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransfersServiceMockRecorder
+}
+
+// MockTransfersServiceMockRecorder is the mock recorder for MockTransfersService.
+type MockTransfersServiceMockRecorder struct {
+	mock *MockTransfersService
+}
+
+// NewMockTransfersService creates a new mock instance.
+func NewMockTransfersService(ctrl *gomock.Controller) *MockTransfersService {
+	mock := &MockTransfersService{ctrl: ctrl}
+	mock.recorder = &MockTransfersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfersService) EXPECT() *MockTransfersServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockTransfersService) Cancel(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockTransfersServiceMockRecorder) Cancel(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransfersService)(nil).Cancel), ctx, id)
+}
+
+// Complete mocks base method.
+func (m *MockTransfersService) Complete(ctx context.Context, id uuid.UUID) (domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, id)
+	ret0, _ := ret[0].(domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTransfersServiceMockRecorder) Complete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTransfersService)(nil).Complete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockTransfersService) Get(ctx context.Context, id uuid.UUID) (domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransfersServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransfersService)(nil).Get), ctx, id)
+}
+
+// GetByCarID mocks base method.
+func (m *MockTransfersService) GetByCarID(ctx context.Context, carID uuid.UUID) ([]domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCarID", ctx, carID)
+	ret0, _ := ret[0].([]domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCarID indicates an expected call of GetByCarID.
+func (mr *MockTransfersServiceMockRecorder) GetByCarID(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCarID", reflect.TypeOf((*MockTransfersService)(nil).GetByCarID), ctx, carID)
+}
+
+// Schedule mocks base method.
+func (m *MockTransfersService) Schedule(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, transfer)
+	ret0, _ := ret[0].(domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockTransfersServiceMockRecorder) Schedule(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTransfersService)(nil).Schedule), ctx, transfer)
+}
+
+// MockHandoversService is a mock of HandoversService interface.
+type MockHandoversService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandoversServiceMockRecorder
+}
+
+// MockHandoversServiceMockRecorder is the mock recorder for MockHandoversService.
+type MockHandoversServiceMockRecorder struct {
+	mock *MockHandoversService
+}
+
+// NewMockHandoversService creates a new mock instance.
+func NewMockHandoversService(ctrl *gomock.Controller) *MockHandoversService {
+	mock := &MockHandoversService{ctrl: ctrl}
+	mock.recorder = &MockHandoversServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandoversService) EXPECT() *MockHandoversServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockHandoversService) Get(ctx context.Context, reservationID uuid.UUID) (domain.Handover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, reservationID)
+	ret0, _ := ret[0].(domain.Handover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockHandoversServiceMockRecorder) Get(ctx, reservationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockHandoversService)(nil).Get), ctx, reservationID)
+}
+
+// GetCarMileage mocks base method.
+func (m *MockHandoversService) GetCarMileage(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarMileage", ctx, carID)
+	ret0, _ := ret[0].(domain.CarMileage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarMileage indicates an expected call of GetCarMileage.
+func (mr *MockHandoversServiceMockRecorder) GetCarMileage(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarMileage", reflect.TypeOf((*MockHandoversService)(nil).GetCarMileage), ctx, carID)
+}
+
+// RecordInspection mocks base method.
+func (m *MockHandoversService) RecordInspection(ctx context.Context, inspection domain.Inspection, paymentMethod string) (domain.Handover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordInspection", ctx, inspection, paymentMethod)
+	ret0, _ := ret[0].(domain.Handover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordInspection indicates an expected call of RecordInspection.
+func (mr *MockHandoversServiceMockRecorder) RecordInspection(ctx, inspection, paymentMethod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInspection", reflect.TypeOf((*MockHandoversService)(nil).RecordInspection), ctx, inspection, paymentMethod)
+}
+
+// RegisterCarService mocks base method.
+func (m *MockHandoversService) RegisterCarService(ctx context.Context, carID uuid.UUID) (domain.CarMileage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCarService", ctx, carID)
+	ret0, _ := ret[0].(domain.CarMileage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterCarService indicates an expected call of RegisterCarService.
+func (mr *MockHandoversServiceMockRecorder) RegisterCarService(ctx, carID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCarService", reflect.TypeOf((*MockHandoversService)(nil).RegisterCarService), ctx, carID)
+}
+
+// MockLateReturnsService is a mock of LateReturnsService interface.
+type MockLateReturnsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLateReturnsServiceMockRecorder
+}
+
+// MockLateReturnsServiceMockRecorder is the mock recorder for MockLateReturnsService.
+type MockLateReturnsServiceMockRecorder struct {
+	mock *MockLateReturnsService
+}
+
+// NewMockLateReturnsService creates a new mock instance.
+func NewMockLateReturnsService(ctrl *gomock.Controller) *MockLateReturnsService {
+	mock := &MockLateReturnsService{ctrl: ctrl}
+	mock.recorder = &MockLateReturnsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLateReturnsService) EXPECT() *MockLateReturnsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListOverdue mocks base method.
+func (m *MockLateReturnsService) ListOverdue(ctx context.Context) ([]domain.OverdueRental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx)
+	ret0, _ := ret[0].([]domain.OverdueRental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockLateReturnsServiceMockRecorder) ListOverdue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockLateReturnsService)(nil).ListOverdue), ctx)
+}
+
+// NotifyOverdue mocks base method.
+func (m *MockLateReturnsService) NotifyOverdue(ctx context.Context) ([]domain.OverdueRental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyOverdue", ctx)
+	ret0, _ := ret[0].([]domain.OverdueRental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyOverdue indicates an expected call of NotifyOverdue.
+func (mr *MockLateReturnsServiceMockRecorder) NotifyOverdue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyOverdue", reflect.TypeOf((*MockLateReturnsService)(nil).NotifyOverdue), ctx)
+}
+
+// MockDamageReportsService is a mock of DamageReportsService interface.
+type MockDamageReportsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportsServiceMockRecorder
+}
+
+// MockDamageReportsServiceMockRecorder is the mock recorder for MockDamageReportsService.
+type MockDamageReportsServiceMockRecorder struct {
+	mock *MockDamageReportsService
+}
+
+// NewMockDamageReportsService creates a new mock instance.
+func NewMockDamageReportsService(ctrl *gomock.Controller) *MockDamageReportsService {
+	mock := &MockDamageReportsService{ctrl: ctrl}
+	mock.recorder = &MockDamageReportsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportsService) EXPECT() *MockDamageReportsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddPhotos mocks base method.
+func (m *MockDamageReportsService) AddPhotos(ctx context.Context, reportID uuid.UUID, photos []domain.PhotoUpload) (domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPhotos", ctx, reportID, photos)
+	ret0, _ := ret[0].(domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPhotos indicates an expected call of AddPhotos.
+func (mr *MockDamageReportsServiceMockRecorder) AddPhotos(ctx, reportID, photos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPhotos", reflect.TypeOf((*MockDamageReportsService)(nil).AddPhotos), ctx, reportID, photos)
+}
+
+// File mocks base method.
+func (m *MockDamageReportsService) File(ctx context.Context, report domain.DamageReport, photos []domain.PhotoUpload) (domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "File", ctx, report, photos)
+	ret0, _ := ret[0].(domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// File indicates an expected call of File.
+func (mr *MockDamageReportsServiceMockRecorder) File(ctx, report, photos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "File", reflect.TypeOf((*MockDamageReportsService)(nil).File), ctx, report, photos)
+}
+
+// Get mocks base method.
+func (m *MockDamageReportsService) Get(ctx context.Context, id uuid.UUID) (domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDamageReportsServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDamageReportsService)(nil).Get), ctx, id)
+}
+
+// ListByCarID mocks base method.
+func (m *MockDamageReportsService) ListByCarID(ctx context.Context, carID uuid.UUID, status string) ([]domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCarID", ctx, carID, status)
+	ret0, _ := ret[0].([]domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCarID indicates an expected call of ListByCarID.
+func (mr *MockDamageReportsServiceMockRecorder) ListByCarID(ctx, carID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCarID", reflect.TypeOf((*MockDamageReportsService)(nil).ListByCarID), ctx, carID, status)
+}
+
+// OpenPhoto mocks base method.
+func (m *MockDamageReportsService) OpenPhoto(ctx context.Context, reportID, photoID uuid.UUID, thumbnail bool) (io.ReadCloser, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPhoto", ctx, reportID, photoID, thumbnail)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenPhoto indicates an expected call of OpenPhoto.
+func (mr *MockDamageReportsServiceMockRecorder) OpenPhoto(ctx, reportID, photoID, thumbnail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPhoto", reflect.TypeOf((*MockDamageReportsService)(nil).OpenPhoto), ctx, reportID, photoID, thumbnail)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockDamageReportsService) UpdateStatus(ctx context.Context, id uuid.UUID, status, resolution string) (domain.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, resolution)
+	ret0, _ := ret[0].(domain.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockDamageReportsServiceMockRecorder) UpdateStatus(ctx, id, status, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockDamageReportsService)(nil).UpdateStatus), ctx, id, status, resolution)
 }
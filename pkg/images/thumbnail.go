@@ -0,0 +1,67 @@
+package images
+
+import (
+	"image"
+	"image/color"
+)
+
+// Thumbnail scales down the image so its longest side is at most maxSide
+// pixels, keeping its aspect ratio. Every pixel of the thumbnail is the
+// average of the source pixels it covers. Images already smaller are
+// copied without being scaled.
+func Thumbnail(src image.Image, maxSide int) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	thumbWidth, thumbHeight := width, height
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			thumbWidth = maxSide
+			thumbHeight = max(1, height*maxSide/width)
+		} else {
+			thumbHeight = maxSide
+			thumbWidth = max(1, width*maxSide/height)
+		}
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		y0 := bounds.Min.Y + y*height/thumbHeight
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*height/thumbHeight)
+		for x := 0; x < thumbWidth; x++ {
+			x0 := bounds.Min.X + x*width/thumbWidth
+			x1 := max(x0+1, bounds.Min.X+(x+1)*width/thumbWidth)
+
+			thumb.Set(x, y, average(src, x0, y0, x1, y1))
+		}
+	}
+
+	return thumb
+}
+
+// Averages the colors of the pixels in the rectangle [x0, x1) x [y0, y1)
+func average(src image.Image, x0, y0, x1, y1 int) color.RGBA64 {
+	var r, g, b, a, count uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			pr, pg, pb, pa := src.At(x, y).RGBA()
+			r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+			count++
+		}
+	}
+
+	return color.RGBA64{
+		R: uint16(r / count),
+		G: uint16(g / count),
+		B: uint16(b / count),
+		A: uint16(a / count),
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,78 @@
+package images
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSide       int
+		wantsWidth    int
+		wantsHeight   int
+	}{
+		{
+			name:        "scales down landscape images to the maximum width",
+			width:       1024,
+			height:      768,
+			maxSide:     256,
+			wantsWidth:  256,
+			wantsHeight: 192,
+		},
+		{
+			name:        "scales down portrait images to the maximum height",
+			width:       600,
+			height:      1200,
+			maxSide:     256,
+			wantsWidth:  128,
+			wantsHeight: 256,
+		},
+		{
+			name:        "does not scale up small images",
+			width:       100,
+			height:      50,
+			maxSide:     256,
+			wantsWidth:  100,
+			wantsHeight: 50,
+		},
+		{
+			name:        "keeps at least one pixel on the short side",
+			width:       2000,
+			height:      2,
+			maxSide:     100,
+			wantsWidth:  100,
+			wantsHeight: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src := image.NewRGBA(image.Rect(0, 0, test.width, test.height))
+
+			thumb := Thumbnail(src, test.maxSide)
+
+			assert.Equal(t, test.wantsWidth, thumb.Bounds().Dx())
+			assert.Equal(t, test.wantsHeight, thumb.Bounds().Dy())
+		})
+	}
+}
+
+func TestThumbnailAveragesPixels(t *testing.T) {
+	// left half black and right half white
+	src := image.NewGray(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 2; x < 4; x++ {
+			src.SetGray(x, y, color.Gray{Y: 255})
+		}
+	}
+
+	thumb := Thumbnail(src, 2)
+
+	assert.Equal(t, color.RGBA{R: 0, G: 0, B: 0, A: 255}, thumb.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, thumb.RGBAAt(1, 0))
+}
@@ -0,0 +1,168 @@
+// Package pdf writes simple PDF documents made of text and lines. Text uses
+// the standard Helvetica fonts, which every PDF reader provides, so no font
+// is embedded and the output only depends on what was drawn.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Size of an A4 page in points
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+// Document is a PDF document whose pages are drawn before it is written
+type Document struct {
+	pages []*Page
+}
+
+// Page holds the drawing operations of a page. Coordinates are in points
+// from the bottom left corner of the page.
+type Page struct {
+	content bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// Adds a blank A4 page at the end of the document
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+
+	return page
+}
+
+// Draws the text with its baseline starting at x, y. Characters outside of
+// the Windows-1252 encoding are replaced by a question mark.
+func (p *Page) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, number(size), number(x), number(y), escape(text))
+}
+
+// Draws the text so it ends at x
+func (p *Page) TextRight(x, y, size float64, bold bool, text string) {
+	p.Text(x-TextWidth(text, size), y, size, bold, text)
+}
+
+// Draws a straight line of the given width between two points
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", number(width), number(x1), number(y1), number(x2), number(y2))
+}
+
+// Writes the document. The same drawing always produces the same bytes.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// objects 1 to 4 are the catalog, the page tree and the fonts, followed by
+	// a page and its content stream for every page
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			number(PageWidth), number(PageHeight), 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.WriteTo(w)
+}
+
+// Width in points of the text drawn with the regular font. Digits and
+// punctuation are as wide in the bold font, so amounts line up in both.
+func TextWidth(text string, size float64) float64 {
+	units := 0
+	for _, c := range encode(text) {
+		if c >= 32 && c <= 126 {
+			units += helveticaWidths[c-32]
+		} else {
+			units += 556
+		}
+	}
+
+	return float64(units) * size / 1000
+}
+
+// Encodes the text in Windows-1252 and escapes it for a PDF string
+func escape(text string) string {
+	var escaped strings.Builder
+	for _, c := range encode(text) {
+		switch c {
+		case '\\', '(', ')':
+			escaped.WriteByte('\\')
+			escaped.WriteByte(c)
+		default:
+			if c < 32 || c > 126 {
+				fmt.Fprintf(&escaped, "\\%03o", c)
+			} else {
+				escaped.WriteByte(c)
+			}
+		}
+	}
+
+	return escaped.String()
+}
+
+func encode(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '€':
+			encoded = append(encoded, 0x80)
+		case r >= 32 && r <= 126, r >= 160 && r <= 255:
+			encoded = append(encoded, byte(r))
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+
+	return encoded
+}
+
+// Formats a number with up to two decimals and without trailing zeros
+func number(n float64) string {
+	formatted := strings.TrimRight(fmt.Sprintf("%.2f", n), "0")
+
+	return strings.TrimSuffix(formatted, ".")
+}
+
+// Widths of the printable ASCII characters of Helvetica, in thousandths of
+// the font size
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
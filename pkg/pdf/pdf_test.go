@@ -0,0 +1,84 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTo(t *testing.T) {
+	doc := New()
+	page := doc.AddPage()
+	page.Text(50, 800, 20, true, "Invoice (draft)")
+	page.Line(50, 790, 545, 790, 0.5)
+	page.TextRight(545, 770, 10, false, "1,250.00 €")
+	doc.AddPage().Text(50, 800, 10, false, "Page 2")
+
+	var out bytes.Buffer
+	_, err := doc.WriteTo(&out)
+	assert.NoError(t, err)
+	content := out.String()
+
+	assert.Regexp(t, `^%PDF-1\.4\n`, content)
+	assert.Regexp(t, `%%EOF\n$`, content)
+	assert.Contains(t, content, "/Count 2")
+	assert.Contains(t, content, `(Invoice \(draft\)) Tj`)
+	assert.Contains(t, content, `(1,250.00 \200) Tj`)
+
+	// every entry of the cross-reference table points at the object it lists
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(content)
+	assert.Len(t, startxref, 2)
+	xref, _ := strconv.Atoi(startxref[1])
+	assert.True(t, bytes.HasPrefix(out.Bytes()[xref:], []byte("xref\n")))
+
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(content, -1)
+	assert.Len(t, offsets, 8)
+	for i, offset := range offsets {
+		position, _ := strconv.Atoi(offset[1])
+		assert.True(t, bytes.HasPrefix(out.Bytes()[position:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))))
+	}
+
+	// the same drawing produces the same bytes
+	var again bytes.Buffer
+	_, err = doc.WriteTo(&again)
+	assert.NoError(t, err)
+	assert.Equal(t, out.Bytes(), again.Bytes())
+}
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		size  float64
+		wants float64
+	}{
+		{
+			name:  "measures digits and punctuation",
+			text:  "10.50",
+			size:  10,
+			wants: 25.02,
+		},
+		{
+			name:  "measures letters by their own width",
+			text:  "Wil",
+			size:  20,
+			wants: 27.76,
+		},
+		{
+			name:  "measures characters out of ASCII as wide as a digit",
+			text:  "€",
+			size:  10,
+			wants: 5.56,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.InDelta(t, test.wants, TextWidth(test.text, test.size), 0.001)
+		})
+	}
+}
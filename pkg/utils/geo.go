@@ -0,0 +1,45 @@
+package utils
+
+import "math"
+
+// Mean radius of the earth used by the haversine formula
+const earthRadiusKm = 6371.0
+
+// Gets the great-circle distance in kilometers between two points given in
+// decimal degrees, using the haversine formula
+func HaversineDistanceKm(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	dLat := degreesToRadians(lat2 - lat1)
+	dLon := degreesToRadians(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(degreesToRadians(lat1))*math.Cos(degreesToRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Gets the latitude and longitude limits of the box that contains the circle
+// of the given radius around a point. Longitudes are not limited near the poles
+// or when the box crosses the antimeridian.
+func BoundingBox(lat float64, lon float64, radiusKm float64) (minLat float64, maxLat float64, minLon float64, maxLon float64) {
+	latDelta := radiansToDegrees(radiusKm / earthRadiusKm)
+	minLat, maxLat = lat-latDelta, lat+latDelta
+	if minLat <= -90 || maxLat >= 90 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180
+	}
+
+	lonDelta := radiansToDegrees(math.Asin(math.Sin(radiusKm/earthRadiusKm) / math.Cos(degreesToRadians(lat))))
+	minLon, maxLon = lon-lonDelta, lon+lonDelta
+	if minLon < -180 || maxLon > 180 {
+		return minLat, maxLat, -180, 180
+	}
+
+	return minLat, maxLat, minLon, maxLon
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
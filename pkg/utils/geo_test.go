@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaversineDistanceKm(t *testing.T) {
+	type args struct {
+		lat1, lon1, lat2, lon2 float64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		distanceKm float64
+	}{
+		{
+			name:       "returns zero for the same point",
+			args:       args{lat1: 41.8837, lon1: -87.6289, lat2: 41.8837, lon2: -87.6289},
+			distanceKm: 0,
+		},
+		{
+			name:       "returns the length of a degree of longitude on the equator",
+			args:       args{lat1: 0, lon1: 0, lat2: 0, lon2: 1},
+			distanceKm: 111.195,
+		},
+		{
+			name:       "returns the distance between Chicago downtown and O'Hare airport",
+			args:       args{lat1: 41.8837, lon1: -87.6289, lat2: 41.9786, lon2: -87.9048},
+			distanceKm: 25.145,
+		},
+		{
+			name:       "returns the distance between Chicago and New York",
+			args:       args{lat1: 41.8837, lon1: -87.6289, lat2: 40.7549, lon2: -73.9840},
+			distanceKm: 1145.174,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			distanceKm := HaversineDistanceKm(test.args.lat1, test.args.lon1, test.args.lat2, test.args.lon2)
+
+			assert.InDelta(t, test.distanceKm, distanceKm, 0.001)
+			assert.InDelta(t, distanceKm, HaversineDistanceKm(test.args.lat2, test.args.lon2, test.args.lat1, test.args.lon1), 1e-9)
+		})
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	t.Run("contains the points at the radius distance", func(t *testing.T) {
+		lat, lon, radiusKm := 41.8837, -87.6289, 10.0
+		minLat, maxLat, minLon, maxLon := BoundingBox(lat, lon, radiusKm)
+
+		assert.InDelta(t, radiusKm, HaversineDistanceKm(lat, lon, minLat, lon), 0.001)
+		assert.InDelta(t, radiusKm, HaversineDistanceKm(lat, lon, maxLat, lon), 0.001)
+		assert.Less(t, minLon, lon)
+		assert.Greater(t, maxLon, lon)
+		assert.LessOrEqual(t, HaversineDistanceKm(lat, lon, lat, maxLon), radiusKm+0.001)
+	})
+
+	t.Run("does not limit longitudes near the poles", func(t *testing.T) {
+		_, maxLat, minLon, maxLon := BoundingBox(89.99, 0, 10)
+
+		assert.Equal(t, 90.0, maxLat)
+		assert.Equal(t, -180.0, minLon)
+		assert.Equal(t, 180.0, maxLon)
+	})
+}
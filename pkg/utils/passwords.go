@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	passwordHashScheme     = "pbkdf2-sha256"
+	passwordHashIterations = 210000
+	passwordSaltBytes      = 16
+	passwordKeyBytes       = 32
+)
+
+// Hashes the password with PBKDF2-HMAC-SHA256 and a random salt. The result
+// holds the scheme, iterations and salt needed to check it later.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, passwordSaltBytes)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, passwordHashIterations, passwordKeyBytes, sha256.New)
+
+	return fmt.Sprintf("%s$%d$%s$%s", passwordHashScheme, passwordHashIterations,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
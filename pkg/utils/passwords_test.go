@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(hash, "$")
+	if assert.Len(t, parts, 4) {
+		assert.Equal(t, "pbkdf2-sha256", parts[0])
+		iterations, err := strconv.Atoi(parts[1])
+		assert.Nil(t, err)
+		salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+		assert.Nil(t, err)
+		key, err := base64.RawStdEncoding.DecodeString(parts[3])
+		assert.Nil(t, err)
+
+		assert.Equal(t, key, pbkdf2.Key([]byte("correct horse battery staple"), salt, iterations, len(key), sha256.New))
+		assert.NotEqual(t, key, pbkdf2.Key([]byte("Correct horse battery staple"), salt, iterations, len(key), sha256.New))
+	}
+
+	anotherHash, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, hash, anotherHash)
+}
@@ -13,3 +13,24 @@ func IsValidTimeFrame(start time.Time, end time.Time) bool {
 
 	return true
 }
+
+// Gets the time elapsed between start and end as shown by the wall clocks of
+// the given location, so days are 24 hours long even when DST changes
+func WallClockDuration(start time.Time, end time.Time, location *time.Location) time.Duration {
+	return asUTCWallClock(end.In(location)).Sub(asUTCWallClock(start.In(location)))
+}
+
+func asUTCWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// Gets the number of whole years elapsed from the date of since until the date
+// of until, as counted for ages and anniversaries
+func CompletedYears(since time.Time, until time.Time) int {
+	years := until.Year() - since.Year()
+	if until.Month() < since.Month() || (until.Month() == since.Month() && until.Day() < since.Day()) {
+		years--
+	}
+
+	return years
+}
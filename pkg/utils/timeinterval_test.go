@@ -62,3 +62,122 @@ func TestIsValidTimeFrame(t *testing.T) {
 		})
 	}
 }
+
+func TestWallClockDuration(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		start    time.Time
+		end      time.Time
+		location *time.Location
+	}
+	type wants struct {
+		duration time.Duration
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns 24 hours for a day spanning the start of daylight saving time",
+			args: args{
+				start:    time.Date(2027, time.March, 13, 10, 0, 0, 0, chicago),
+				end:      time.Date(2027, time.March, 14, 10, 0, 0, 0, chicago),
+				location: chicago,
+			},
+			wants: wants{
+				duration: 24 * time.Hour,
+			},
+		},
+		{
+			name: "returns 24 hours for a day spanning the end of daylight saving time",
+			args: args{
+				start:    time.Date(2027, time.November, 6, 10, 0, 0, 0, chicago),
+				end:      time.Date(2027, time.November, 7, 10, 0, 0, 0, chicago),
+				location: chicago,
+			},
+			wants: wants{
+				duration: 24 * time.Hour,
+			},
+		},
+		{
+			name: "uses the location of the city even when dates are given in UTC",
+			args: args{
+				start:    time.Date(2027, time.March, 13, 16, 0, 0, 0, time.UTC),
+				end:      time.Date(2027, time.March, 14, 15, 0, 0, 0, time.UTC),
+				location: chicago,
+			},
+			wants: wants{
+				duration: 24 * time.Hour,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duration := WallClockDuration(test.args.start, test.args.end, test.args.location)
+
+			assert.Equal(t, test.wants.duration, duration)
+		})
+	}
+}
+
+func TestCompletedYears(t *testing.T) {
+	birthday := time.Date(1990, time.May, 11, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		since time.Time
+		until time.Time
+	}
+	type wants struct {
+		years int
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns the years when the anniversary has passed",
+			args: args{
+				since: birthday,
+				until: time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wants: wants{
+				years: 25,
+			},
+		},
+		{
+			name: "counts the anniversary day as a completed year",
+			args: args{
+				since: birthday,
+				until: time.Date(2015, time.May, 11, 9, 0, 0, 0, time.UTC),
+			},
+			wants: wants{
+				years: 25,
+			},
+		},
+		{
+			name: "returns one year less the day before the anniversary",
+			args: args{
+				since: birthday,
+				until: time.Date(2015, time.May, 10, 23, 0, 0, 0, time.UTC),
+			},
+			wants: wants{
+				years: 24,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			years := CompletedYears(test.args.since, test.args.until)
+
+			assert.Equal(t, test.wants.years, years)
+		})
+	}
+}
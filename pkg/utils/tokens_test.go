@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyToken(t *testing.T) {
+	secret := []byte("secret")
+	token := SignToken(secret, "b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387")
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		token   string
+		payload string
+		ok      bool
+	}{
+		{
+			name:    "returns the payload of a token signed with the same secret",
+			secret:  secret,
+			token:   token,
+			payload: "b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387",
+			ok:      true,
+		},
+		{
+			name:   "rejects a token signed with another secret",
+			secret: []byte("another secret"),
+			token:  token,
+			ok:     false,
+		},
+		{
+			name:   "rejects a token whose payload was changed",
+			secret: secret,
+			token:  "44ed118f-ec0a-4d15-98e7-794b10d8cbde" + token[36:],
+			ok:     false,
+		},
+		{
+			name:   "rejects a token without signature",
+			secret: secret,
+			token:  "b6dcf3b3-ec0a-9f31-4379-4b8e7b94a387",
+			ok:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload, ok := VerifyToken(test.secret, test.token)
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.payload, payload)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package utils
+
+import "regexp"
+
+var (
+	// 17 characters excluding I, O and Q to avoid confusion with 1 and 0
+	vinRegexp = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+	// letters, digits, spaces and hyphens, without leading or trailing separators
+	licensePlateRegexp = regexp.MustCompile(`^[A-Z0-9]([A-Z0-9 -]{0,13}[A-Z0-9])?$`)
+)
+
+// Checks whether a vehicle identification number is valid. Only upper case letters are accepted.
+func IsValidVIN(vin string) bool {
+	return vinRegexp.MatchString(vin)
+}
+
+// Checks whether a license plate is valid. Only upper case letters are accepted.
+func IsValidLicensePlate(licensePlate string) bool {
+	return licensePlateRegexp.MatchString(licensePlate)
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidVIN(t *testing.T) {
+	type args struct {
+		vin string
+	}
+	type wants struct {
+		isValid bool
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns true when vin has 17 valid characters",
+			args: args{
+				vin: "1HGCM82633A004352",
+			},
+			wants: wants{
+				isValid: true,
+			},
+		},
+		{
+			name: "returns false when vin is shorter than 17 characters",
+			args: args{
+				vin: "1HGCM82633A00435",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+		{
+			name: "returns false when vin contains the letter O",
+			args: args{
+				vin: "1HGCM82633AO04352",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+		{
+			name: "returns false when vin contains lower case letters",
+			args: args{
+				vin: "1hgcm82633a004352",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isValid := IsValidVIN(test.args.vin)
+
+			assert.Equal(t, test.wants.isValid, isValid)
+		})
+	}
+}
+
+func TestIsValidLicensePlate(t *testing.T) {
+	type args struct {
+		licensePlate string
+	}
+	type wants struct {
+		isValid bool
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "returns true when license plate has letters, digits and a hyphen",
+			args: args{
+				licensePlate: "ABC-1234",
+			},
+			wants: wants{
+				isValid: true,
+			},
+		},
+		{
+			name: "returns false when license plate is empty",
+			args: args{
+				licensePlate: "",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+		{
+			name: "returns false when license plate ends with a separator",
+			args: args{
+				licensePlate: "ABC1234-",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+		{
+			name: "returns false when license plate is longer than 15 characters",
+			args: args{
+				licensePlate: "ABCDEFGH12345678",
+			},
+			wants: wants{
+				isValid: false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isValid := IsValidLicensePlate(test.args.licensePlate)
+
+			assert.Equal(t, test.wants.isValid, isValid)
+		})
+	}
+}